@@ -21,9 +21,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Grants_FullMethodName        = "/cosmos.authz.v1beta1.Query/Grants"
-	Query_GranterGrants_FullMethodName = "/cosmos.authz.v1beta1.Query/GranterGrants"
-	Query_GranteeGrants_FullMethodName = "/cosmos.authz.v1beta1.Query/GranteeGrants"
+	Query_Grants_FullMethodName                = "/cosmos.authz.v1beta1.Query/Grants"
+	Query_GranterGrants_FullMethodName         = "/cosmos.authz.v1beta1.Query/GranterGrants"
+	Query_GranteeGrants_FullMethodName         = "/cosmos.authz.v1beta1.Query/GranteeGrants"
+	Query_ScheduledExec_FullMethodName         = "/cosmos.authz.v1beta1.Query/ScheduledExec"
+	Query_GranterScheduledExecs_FullMethodName = "/cosmos.authz.v1beta1.Query/GranterScheduledExecs"
+	Query_ScheduledExecHistory_FullMethodName  = "/cosmos.authz.v1beta1.Query/ScheduledExecHistory"
 )
 
 // QueryClient is the client API for Query service.
@@ -38,6 +41,12 @@ type QueryClient interface {
 	GranterGrants(ctx context.Context, in *QueryGranterGrantsRequest, opts ...grpc.CallOption) (*QueryGranterGrantsResponse, error)
 	// GranteeGrants returns a list of `GrantAuthorization` by grantee.
 	GranteeGrants(ctx context.Context, in *QueryGranteeGrantsRequest, opts ...grpc.CallOption) (*QueryGranteeGrantsResponse, error)
+	// ScheduledExec returns a scheduled execution by its id.
+	ScheduledExec(ctx context.Context, in *QueryScheduledExecRequest, opts ...grpc.CallOption) (*QueryScheduledExecResponse, error)
+	// GranterScheduledExecs returns the scheduled executions funded by a granter.
+	GranterScheduledExecs(ctx context.Context, in *QueryGranterScheduledExecsRequest, opts ...grpc.CallOption) (*QueryGranterScheduledExecsResponse, error)
+	// ScheduledExecHistory returns the latest results of the executions of a scheduled execution.
+	ScheduledExecHistory(ctx context.Context, in *QueryScheduledExecHistoryRequest, opts ...grpc.CallOption) (*QueryScheduledExecHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -78,6 +87,36 @@ func (c *queryClient) GranteeGrants(ctx context.Context, in *QueryGranteeGrantsR
 	return out, nil
 }
 
+func (c *queryClient) ScheduledExec(ctx context.Context, in *QueryScheduledExecRequest, opts ...grpc.CallOption) (*QueryScheduledExecResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryScheduledExecResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledExec_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GranterScheduledExecs(ctx context.Context, in *QueryGranterScheduledExecsRequest, opts ...grpc.CallOption) (*QueryGranterScheduledExecsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGranterScheduledExecsResponse)
+	err := c.cc.Invoke(ctx, Query_GranterScheduledExecs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledExecHistory(ctx context.Context, in *QueryScheduledExecHistoryRequest, opts ...grpc.CallOption) (*QueryScheduledExecHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryScheduledExecHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledExecHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -90,6 +129,12 @@ type QueryServer interface {
 	GranterGrants(context.Context, *QueryGranterGrantsRequest) (*QueryGranterGrantsResponse, error)
 	// GranteeGrants returns a list of `GrantAuthorization` by grantee.
 	GranteeGrants(context.Context, *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error)
+	// ScheduledExec returns a scheduled execution by its id.
+	ScheduledExec(context.Context, *QueryScheduledExecRequest) (*QueryScheduledExecResponse, error)
+	// GranterScheduledExecs returns the scheduled executions funded by a granter.
+	GranterScheduledExecs(context.Context, *QueryGranterScheduledExecsRequest) (*QueryGranterScheduledExecsResponse, error)
+	// ScheduledExecHistory returns the latest results of the executions of a scheduled execution.
+	ScheduledExecHistory(context.Context, *QueryScheduledExecHistoryRequest) (*QueryScheduledExecHistoryResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -109,6 +154,15 @@ func (UnimplementedQueryServer) GranterGrants(context.Context, *QueryGranterGran
 func (UnimplementedQueryServer) GranteeGrants(context.Context, *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GranteeGrants not implemented")
 }
+func (UnimplementedQueryServer) ScheduledExec(context.Context, *QueryScheduledExecRequest) (*QueryScheduledExecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledExec not implemented")
+}
+func (UnimplementedQueryServer) GranterScheduledExecs(context.Context, *QueryGranterScheduledExecsRequest) (*QueryGranterScheduledExecsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GranterScheduledExecs not implemented")
+}
+func (UnimplementedQueryServer) ScheduledExecHistory(context.Context, *QueryScheduledExecHistoryRequest) (*QueryScheduledExecHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledExecHistory not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -184,6 +238,60 @@ func _Query_GranteeGrants_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ScheduledExec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledExecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledExec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledExec_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledExec(ctx, req.(*QueryScheduledExecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GranterScheduledExecs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGranterScheduledExecsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GranterScheduledExecs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GranterScheduledExecs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GranterScheduledExecs(ctx, req.(*QueryGranterScheduledExecsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledExecHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledExecHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledExecHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledExecHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledExecHistory(ctx, req.(*QueryScheduledExecHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -203,6 +311,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GranteeGrants",
 			Handler:    _Query_GranteeGrants_Handler,
 		},
+		{
+			MethodName: "ScheduledExec",
+			Handler:    _Query_ScheduledExec_Handler,
+		},
+		{
+			MethodName: "GranterScheduledExecs",
+			Handler:    _Query_GranterScheduledExecs_Handler,
+		},
+		{
+			MethodName: "ScheduledExecHistory",
+			Handler:    _Query_ScheduledExecHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/query.proto",
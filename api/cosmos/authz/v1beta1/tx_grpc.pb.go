@@ -21,11 +21,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_Grant_FullMethodName              = "/cosmos.authz.v1beta1.Msg/Grant"
-	Msg_Exec_FullMethodName               = "/cosmos.authz.v1beta1.Msg/Exec"
-	Msg_Revoke_FullMethodName             = "/cosmos.authz.v1beta1.Msg/Revoke"
-	Msg_RevokeAll_FullMethodName          = "/cosmos.authz.v1beta1.Msg/RevokeAll"
-	Msg_PruneExpiredGrants_FullMethodName = "/cosmos.authz.v1beta1.Msg/PruneExpiredGrants"
+	Msg_Grant_FullMethodName               = "/cosmos.authz.v1beta1.Msg/Grant"
+	Msg_Exec_FullMethodName                = "/cosmos.authz.v1beta1.Msg/Exec"
+	Msg_Revoke_FullMethodName              = "/cosmos.authz.v1beta1.Msg/Revoke"
+	Msg_RevokeAll_FullMethodName           = "/cosmos.authz.v1beta1.Msg/RevokeAll"
+	Msg_PruneExpiredGrants_FullMethodName  = "/cosmos.authz.v1beta1.Msg/PruneExpiredGrants"
+	Msg_ScheduleExec_FullMethodName        = "/cosmos.authz.v1beta1.Msg/ScheduleExec"
+	Msg_CancelScheduledExec_FullMethodName = "/cosmos.authz.v1beta1.Msg/CancelScheduledExec"
 )
 
 // MsgClient is the client API for Msg service.
@@ -50,6 +52,12 @@ type MsgClient interface {
 	RevokeAll(ctx context.Context, in *MsgRevokeAll, opts ...grpc.CallOption) (*MsgRevokeAllResponse, error)
 	// PruneExpiredGrants prunes the expired grants. Currently up to 75 at a time.
 	PruneExpiredGrants(ctx context.Context, in *MsgPruneExpiredGrants, opts ...grpc.CallOption) (*MsgPruneExpiredGrantsResponse, error)
+	// ScheduleExec schedules the execution of the provided messages, signed by the
+	// granter, using the authorizations granted to the x/authz module account.
+	// The granter funds the executions.
+	ScheduleExec(ctx context.Context, in *MsgScheduleExec, opts ...grpc.CallOption) (*MsgScheduleExecResponse, error)
+	// CancelScheduledExec cancels a scheduled execution of the granter.
+	CancelScheduledExec(ctx context.Context, in *MsgCancelScheduledExec, opts ...grpc.CallOption) (*MsgCancelScheduledExecResponse, error)
 }
 
 type msgClient struct {
@@ -110,6 +118,26 @@ func (c *msgClient) PruneExpiredGrants(ctx context.Context, in *MsgPruneExpiredG
 	return out, nil
 }
 
+func (c *msgClient) ScheduleExec(ctx context.Context, in *MsgScheduleExec, opts ...grpc.CallOption) (*MsgScheduleExecResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgScheduleExecResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleExec_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledExec(ctx context.Context, in *MsgCancelScheduledExec, opts ...grpc.CallOption) (*MsgCancelScheduledExecResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelScheduledExecResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelScheduledExec_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -132,6 +160,12 @@ type MsgServer interface {
 	RevokeAll(context.Context, *MsgRevokeAll) (*MsgRevokeAllResponse, error)
 	// PruneExpiredGrants prunes the expired grants. Currently up to 75 at a time.
 	PruneExpiredGrants(context.Context, *MsgPruneExpiredGrants) (*MsgPruneExpiredGrantsResponse, error)
+	// ScheduleExec schedules the execution of the provided messages, signed by the
+	// granter, using the authorizations granted to the x/authz module account.
+	// The granter funds the executions.
+	ScheduleExec(context.Context, *MsgScheduleExec) (*MsgScheduleExecResponse, error)
+	// CancelScheduledExec cancels a scheduled execution of the granter.
+	CancelScheduledExec(context.Context, *MsgCancelScheduledExec) (*MsgCancelScheduledExecResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -157,6 +191,12 @@ func (UnimplementedMsgServer) RevokeAll(context.Context, *MsgRevokeAll) (*MsgRev
 func (UnimplementedMsgServer) PruneExpiredGrants(context.Context, *MsgPruneExpiredGrants) (*MsgPruneExpiredGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneExpiredGrants not implemented")
 }
+func (UnimplementedMsgServer) ScheduleExec(context.Context, *MsgScheduleExec) (*MsgScheduleExecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleExec not implemented")
+}
+func (UnimplementedMsgServer) CancelScheduledExec(context.Context, *MsgCancelScheduledExec) (*MsgCancelScheduledExecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledExec not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -268,6 +308,42 @@ func _Msg_PruneExpiredGrants_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleExec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleExec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleExec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleExec_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleExec(ctx, req.(*MsgScheduleExec))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledExec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledExec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledExec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelScheduledExec_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledExec(ctx, req.(*MsgCancelScheduledExec))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -295,6 +371,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PruneExpiredGrants",
 			Handler:    _Msg_PruneExpiredGrants_Handler,
 		},
+		{
+			MethodName: "ScheduleExec",
+			Handler:    _Msg_ScheduleExec_Handler,
+		},
+		{
+			MethodName: "CancelScheduledExec",
+			Handler:    _Msg_CancelScheduledExec_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/tx.proto",
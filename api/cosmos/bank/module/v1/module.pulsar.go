@@ -105,11 +105,59 @@ func (x *_Module_3_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Module_4_list)(nil)
+
+type _Module_4_list struct {
+	list *[]string
+}
+
+func (x *_Module_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Module_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Module_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Module_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Module_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Module at list field HooksOrder as it is not of Message kind"))
+}
+
+func (x *_Module_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Module_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Module_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Module                                  protoreflect.MessageDescriptor
 	fd_Module_blocked_module_accounts_override protoreflect.FieldDescriptor
 	fd_Module_authority                        protoreflect.FieldDescriptor
 	fd_Module_restrictions_order               protoreflect.FieldDescriptor
+	fd_Module_hooks_order                      protoreflect.FieldDescriptor
+	fd_Module_hooks_gas_limit                  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -118,6 +166,8 @@ func init() {
 	fd_Module_blocked_module_accounts_override = md_Module.Fields().ByName("blocked_module_accounts_override")
 	fd_Module_authority = md_Module.Fields().ByName("authority")
 	fd_Module_restrictions_order = md_Module.Fields().ByName("restrictions_order")
+	fd_Module_hooks_order = md_Module.Fields().ByName("hooks_order")
+	fd_Module_hooks_gas_limit = md_Module.Fields().ByName("hooks_gas_limit")
 }
 
 var _ protoreflect.Message = (*fastReflection_Module)(nil)
@@ -203,6 +253,18 @@ func (x *fastReflection_Module) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.HooksOrder) != 0 {
+		value := protoreflect.ValueOfList(&_Module_4_list{list: &x.HooksOrder})
+		if !f(fd_Module_hooks_order, value) {
+			return
+		}
+	}
+	if x.HooksGasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.HooksGasLimit)
+		if !f(fd_Module_hooks_gas_limit, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -224,6 +286,10 @@ func (x *fastReflection_Module) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Authority != ""
 	case "cosmos.bank.module.v1.Module.restrictions_order":
 		return len(x.RestrictionsOrder) != 0
+	case "cosmos.bank.module.v1.Module.hooks_order":
+		return len(x.HooksOrder) != 0
+	case "cosmos.bank.module.v1.Module.hooks_gas_limit":
+		return x.HooksGasLimit != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.module.v1.Module"))
@@ -246,6 +312,10 @@ func (x *fastReflection_Module) Clear(fd protoreflect.FieldDescriptor) {
 		x.Authority = ""
 	case "cosmos.bank.module.v1.Module.restrictions_order":
 		x.RestrictionsOrder = nil
+	case "cosmos.bank.module.v1.Module.hooks_order":
+		x.HooksOrder = nil
+	case "cosmos.bank.module.v1.Module.hooks_gas_limit":
+		x.HooksGasLimit = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.module.v1.Module"))
@@ -277,6 +347,15 @@ func (x *fastReflection_Module) Get(descriptor protoreflect.FieldDescriptor) pro
 		}
 		listValue := &_Module_3_list{list: &x.RestrictionsOrder}
 		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.module.v1.Module.hooks_order":
+		if len(x.HooksOrder) == 0 {
+			return protoreflect.ValueOfList(&_Module_4_list{})
+		}
+		listValue := &_Module_4_list{list: &x.HooksOrder}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.bank.module.v1.Module.hooks_gas_limit":
+		value := x.HooksGasLimit
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.module.v1.Module"))
@@ -307,6 +386,12 @@ func (x *fastReflection_Module) Set(fd protoreflect.FieldDescriptor, value proto
 		lv := value.List()
 		clv := lv.(*_Module_3_list)
 		x.RestrictionsOrder = *clv.list
+	case "cosmos.bank.module.v1.Module.hooks_order":
+		lv := value.List()
+		clv := lv.(*_Module_4_list)
+		x.HooksOrder = *clv.list
+	case "cosmos.bank.module.v1.Module.hooks_gas_limit":
+		x.HooksGasLimit = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.module.v1.Module"))
@@ -339,8 +424,16 @@ func (x *fastReflection_Module) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Module_3_list{list: &x.RestrictionsOrder}
 		return protoreflect.ValueOfList(value)
+	case "cosmos.bank.module.v1.Module.hooks_order":
+		if x.HooksOrder == nil {
+			x.HooksOrder = []string{}
+		}
+		value := &_Module_4_list{list: &x.HooksOrder}
+		return protoreflect.ValueOfList(value)
 	case "cosmos.bank.module.v1.Module.authority":
 		panic(fmt.Errorf("field authority of message cosmos.bank.module.v1.Module is not mutable"))
+	case "cosmos.bank.module.v1.Module.hooks_gas_limit":
+		panic(fmt.Errorf("field hooks_gas_limit of message cosmos.bank.module.v1.Module is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.module.v1.Module"))
@@ -362,6 +455,11 @@ func (x *fastReflection_Module) NewField(fd protoreflect.FieldDescriptor) protor
 	case "cosmos.bank.module.v1.Module.restrictions_order":
 		list := []string{}
 		return protoreflect.ValueOfList(&_Module_3_list{list: &list})
+	case "cosmos.bank.module.v1.Module.hooks_order":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Module_4_list{list: &list})
+	case "cosmos.bank.module.v1.Module.hooks_gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.bank.module.v1.Module"))
@@ -447,6 +545,15 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.HooksOrder) > 0 {
+			for _, s := range x.HooksOrder {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.HooksGasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.HooksGasLimit))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -476,6 +583,20 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.HooksGasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HooksGasLimit))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.HooksOrder) > 0 {
+			for iNdEx := len(x.HooksOrder) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.HooksOrder[iNdEx])
+				copy(dAtA[i:], x.HooksOrder[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HooksOrder[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if len(x.RestrictionsOrder) > 0 {
 			for iNdEx := len(x.RestrictionsOrder) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.RestrictionsOrder[iNdEx])
@@ -646,6 +767,57 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 				}
 				x.RestrictionsOrder = append(x.RestrictionsOrder, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HooksOrder", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HooksOrder = append(x.HooksOrder, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HooksGasLimit", wireType)
+				}
+				x.HooksGasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HooksGasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -711,6 +883,13 @@ type Module struct {
 	// order is provided, then restrictions will be applied in alphabetical order
 	// of module names.
 	RestrictionsOrder []string `protobuf:"bytes,3,rep,name=restrictions_order,json=restrictionsOrder,proto3" json:"restrictions_order,omitempty"`
+	// hooks_order specifies the order of bank hooks and should be a list
+	// of module names which provide a bank hooks instance. If no order is
+	// provided, then hooks will be applied in alphabetical order of module names.
+	HooksOrder []string `protobuf:"bytes,4,rep,name=hooks_order,json=hooksOrder,proto3" json:"hooks_order,omitempty"`
+	// hooks_gas_limit is the gas limit of each bank hook call. If not set,
+	// defaults to 200000.
+	HooksGasLimit uint64 `protobuf:"varint,5,opt,name=hooks_gas_limit,json=hooksGasLimit,proto3" json:"hooks_gas_limit,omitempty"`
 }
 
 func (x *Module) Reset() {
@@ -754,6 +933,20 @@ func (x *Module) GetRestrictionsOrder() []string {
 	return nil
 }
 
+func (x *Module) GetHooksOrder() []string {
+	if x != nil {
+		return x.HooksOrder
+	}
+	return nil
+}
+
+func (x *Module) GetHooksGasLimit() uint64 {
+	if x != nil {
+		return x.HooksGasLimit
+	}
+	return 0
+}
+
 var File_cosmos_bank_module_v1_module_proto protoreflect.FileDescriptor
 
 var file_cosmos_bank_module_v1_module_proto_rawDesc = []byte{
@@ -762,7 +955,7 @@ var file_cosmos_bank_module_v1_module_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x6e,
 	0x6b, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x20, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbb, 0x01,
+	0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x84, 0x02,
 	0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x47, 0x0a, 0x20, 0x62, 0x6c, 0x6f, 0x63,
 	0x6b, 0x65, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
 	0x6e, 0x74, 0x73, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x18, 0x01, 0x20, 0x03,
@@ -772,23 +965,27 @@ var file_cosmos_bank_module_v1_module_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
 	0x2d, 0x0a, 0x12, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f,
 	0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x73,
-	0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x3a, 0x1b,
-	0xba, 0xc0, 0x96, 0xda, 0x01, 0x15, 0x0a, 0x13, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x78, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x42, 0xd0, 0x01, 0x0a, 0x19,
-	0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e,
-	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x76, 0x31,
-	0x3b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x42, 0x4d, 0xaa,
-	0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x42, 0x61, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x5c, 0x42, 0x61, 0x6e, 0x6b, 0x5c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2,
-	0x02, 0x21, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61, 0x6e, 0x6b, 0x5c, 0x4d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x42, 0x61,
-	0x6e, 0x6b, 0x3a, 0x3a, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1f,
+	0x0a, 0x0b, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x26, 0x0a, 0x0f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x47,
+	0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x1b, 0xba, 0xc0, 0x96, 0xda, 0x01, 0x15, 0x0a,
+	0x13, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x78, 0x2f,
+	0x62, 0x61, 0x6e, 0x6b, 0x42, 0xd0, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x42, 0x0b, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x42, 0x4d, 0xaa, 0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x42, 0x61, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61, 0x6e, 0x6b, 0x5c, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x5c, 0x42, 0x61, 0x6e, 0x6b, 0x5c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x42, 0x61, 0x6e, 0x6b, 0x3a, 0x3a, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -32,6 +32,9 @@ const (
 	Query_DenomOwners_FullMethodName                = "/cosmos.bank.v1beta1.Query/DenomOwners"
 	Query_DenomOwnersByQuery_FullMethodName         = "/cosmos.bank.v1beta1.Query/DenomOwnersByQuery"
 	Query_SendEnabled_FullMethodName                = "/cosmos.bank.v1beta1.Query/SendEnabled"
+	Query_BalanceAt_FullMethodName                  = "/cosmos.bank.v1beta1.Query/BalanceAt"
+	Query_FactoryDenom_FullMethodName               = "/cosmos.bank.v1beta1.Query/FactoryDenom"
+	Query_FactoryDenomsByCreator_FullMethodName     = "/cosmos.bank.v1beta1.Query/FactoryDenomsByCreator"
 )
 
 // QueryClient is the client API for Query service.
@@ -92,6 +95,13 @@ type QueryClient interface {
 	// Any denomination that does not have a specific setting will use the default
 	// params.default_send_enabled, and will not be returned by this query.
 	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// BalanceAt queries the balance of a single coin for a single account at the end of a past height.
+	// The denom must be one of the balance checkpoint denoms at that height.
+	BalanceAt(ctx context.Context, in *QueryBalanceAtRequest, opts ...grpc.CallOption) (*QueryBalanceAtResponse, error)
+	// FactoryDenom queries the authority of a token factory denom.
+	FactoryDenom(ctx context.Context, in *QueryFactoryDenomRequest, opts ...grpc.CallOption) (*QueryFactoryDenomResponse, error)
+	// FactoryDenomsByCreator queries the token factory denoms created by an account.
+	FactoryDenomsByCreator(ctx context.Context, in *QueryFactoryDenomsByCreatorRequest, opts ...grpc.CallOption) (*QueryFactoryDenomsByCreatorResponse, error)
 }
 
 type queryClient struct {
@@ -232,6 +242,36 @@ func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledReque
 	return out, nil
 }
 
+func (c *queryClient) BalanceAt(ctx context.Context, in *QueryBalanceAtRequest, opts ...grpc.CallOption) (*QueryBalanceAtResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryBalanceAtResponse)
+	err := c.cc.Invoke(ctx, Query_BalanceAt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FactoryDenom(ctx context.Context, in *QueryFactoryDenomRequest, opts ...grpc.CallOption) (*QueryFactoryDenomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFactoryDenomResponse)
+	err := c.cc.Invoke(ctx, Query_FactoryDenom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FactoryDenomsByCreator(ctx context.Context, in *QueryFactoryDenomsByCreatorRequest, opts ...grpc.CallOption) (*QueryFactoryDenomsByCreatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFactoryDenomsByCreatorResponse)
+	err := c.cc.Invoke(ctx, Query_FactoryDenomsByCreator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -290,6 +330,13 @@ type QueryServer interface {
 	// Any denomination that does not have a specific setting will use the default
 	// params.default_send_enabled, and will not be returned by this query.
 	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// BalanceAt queries the balance of a single coin for a single account at the end of a past height.
+	// The denom must be one of the balance checkpoint denoms at that height.
+	BalanceAt(context.Context, *QueryBalanceAtRequest) (*QueryBalanceAtResponse, error)
+	// FactoryDenom queries the authority of a token factory denom.
+	FactoryDenom(context.Context, *QueryFactoryDenomRequest) (*QueryFactoryDenomResponse, error)
+	// FactoryDenomsByCreator queries the token factory denoms created by an account.
+	FactoryDenomsByCreator(context.Context, *QueryFactoryDenomsByCreatorRequest) (*QueryFactoryDenomsByCreatorResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -339,6 +386,15 @@ func (UnimplementedQueryServer) DenomOwnersByQuery(context.Context, *QueryDenomO
 func (UnimplementedQueryServer) SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEnabled not implemented")
 }
+func (UnimplementedQueryServer) BalanceAt(context.Context, *QueryBalanceAtRequest) (*QueryBalanceAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalanceAt not implemented")
+}
+func (UnimplementedQueryServer) FactoryDenom(context.Context, *QueryFactoryDenomRequest) (*QueryFactoryDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FactoryDenom not implemented")
+}
+func (UnimplementedQueryServer) FactoryDenomsByCreator(context.Context, *QueryFactoryDenomsByCreatorRequest) (*QueryFactoryDenomsByCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FactoryDenomsByCreator not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -594,6 +650,60 @@ func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BalanceAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalanceAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BalanceAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BalanceAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BalanceAt(ctx, req.(*QueryBalanceAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FactoryDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFactoryDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FactoryDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FactoryDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FactoryDenom(ctx, req.(*QueryFactoryDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FactoryDenomsByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFactoryDenomsByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FactoryDenomsByCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FactoryDenomsByCreator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FactoryDenomsByCreator(ctx, req.(*QueryFactoryDenomsByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -653,6 +763,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendEnabled",
 			Handler:    _Query_SendEnabled_Handler,
 		},
+		{
+			MethodName: "BalanceAt",
+			Handler:    _Query_BalanceAt_Handler,
+		},
+		{
+			MethodName: "FactoryDenom",
+			Handler:    _Query_FactoryDenom_Handler,
+		},
+		{
+			MethodName: "FactoryDenomsByCreator",
+			Handler:    _Query_FactoryDenomsByCreator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
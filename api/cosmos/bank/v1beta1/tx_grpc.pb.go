@@ -19,11 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_Send_FullMethodName           = "/cosmos.bank.v1beta1.Msg/Send"
-	Msg_MultiSend_FullMethodName      = "/cosmos.bank.v1beta1.Msg/MultiSend"
-	Msg_Burn_FullMethodName           = "/cosmos.bank.v1beta1.Msg/Burn"
-	Msg_UpdateParams_FullMethodName   = "/cosmos.bank.v1beta1.Msg/UpdateParams"
-	Msg_SetSendEnabled_FullMethodName = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_Send_FullMethodName             = "/cosmos.bank.v1beta1.Msg/Send"
+	Msg_MultiSend_FullMethodName        = "/cosmos.bank.v1beta1.Msg/MultiSend"
+	Msg_Burn_FullMethodName             = "/cosmos.bank.v1beta1.Msg/Burn"
+	Msg_UpdateParams_FullMethodName     = "/cosmos.bank.v1beta1.Msg/UpdateParams"
+	Msg_SetSendEnabled_FullMethodName   = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_CreateDenom_FullMethodName      = "/cosmos.bank.v1beta1.Msg/CreateDenom"
+	Msg_MintDenom_FullMethodName        = "/cosmos.bank.v1beta1.Msg/MintDenom"
+	Msg_BurnDenom_FullMethodName        = "/cosmos.bank.v1beta1.Msg/BurnDenom"
+	Msg_ChangeDenomAdmin_FullMethodName = "/cosmos.bank.v1beta1.Msg/ChangeDenomAdmin"
+	Msg_SetDenomMetadata_FullMethodName = "/cosmos.bank.v1beta1.Msg/SetDenomMetadata"
+	Msg_SetDenomSendHook_FullMethodName = "/cosmos.bank.v1beta1.Msg/SetDenomSendHook"
 )
 
 // MsgClient is the client API for Msg service.
@@ -46,6 +52,23 @@ type MsgClient interface {
 	// included. Entries that already exist in the store, but that aren't
 	// included in this message, will be left unchanged.
 	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+	// CreateDenom defines a method for creating a token factory denom, of the
+	// form factory/{creator}/{subdenom}, administrated by its creator.
+	CreateDenom(ctx context.Context, in *MsgCreateDenom, opts ...grpc.CallOption) (*MsgCreateDenomResponse, error)
+	// MintDenom defines a method for the admin of a token factory denom to mint it.
+	MintDenom(ctx context.Context, in *MsgMintDenom, opts ...grpc.CallOption) (*MsgMintDenomResponse, error)
+	// BurnDenom defines a method for the admin of a token factory denom to burn it
+	// from its balance.
+	BurnDenom(ctx context.Context, in *MsgBurnDenom, opts ...grpc.CallOption) (*MsgBurnDenomResponse, error)
+	// ChangeDenomAdmin defines a method for the admin of a token factory denom to
+	// transfer or renounce its authority.
+	ChangeDenomAdmin(ctx context.Context, in *MsgChangeDenomAdmin, opts ...grpc.CallOption) (*MsgChangeDenomAdminResponse, error)
+	// SetDenomMetadata defines a method for the admin of a token factory denom to
+	// set its metadata.
+	SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error)
+	// SetDenomSendHook defines a method for the admin of a token factory denom to
+	// attach one of the send hooks registered by the application to the denom.
+	SetDenomSendHook(ctx context.Context, in *MsgSetDenomSendHook, opts ...grpc.CallOption) (*MsgSetDenomSendHookResponse, error)
 }
 
 type msgClient struct {
@@ -106,6 +129,66 @@ func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, o
 	return out, nil
 }
 
+func (c *msgClient) CreateDenom(ctx context.Context, in *MsgCreateDenom, opts ...grpc.CallOption) (*MsgCreateDenomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCreateDenomResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateDenom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MintDenom(ctx context.Context, in *MsgMintDenom, opts ...grpc.CallOption) (*MsgMintDenomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgMintDenomResponse)
+	err := c.cc.Invoke(ctx, Msg_MintDenom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BurnDenom(ctx context.Context, in *MsgBurnDenom, opts ...grpc.CallOption) (*MsgBurnDenomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgBurnDenomResponse)
+	err := c.cc.Invoke(ctx, Msg_BurnDenom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ChangeDenomAdmin(ctx context.Context, in *MsgChangeDenomAdmin, opts ...grpc.CallOption) (*MsgChangeDenomAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgChangeDenomAdminResponse)
+	err := c.cc.Invoke(ctx, Msg_ChangeDenomAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDenomMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomSendHook(ctx context.Context, in *MsgSetDenomSendHook, opts ...grpc.CallOption) (*MsgSetDenomSendHookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetDenomSendHookResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDenomSendHook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -126,6 +209,23 @@ type MsgServer interface {
 	// included. Entries that already exist in the store, but that aren't
 	// included in this message, will be left unchanged.
 	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// CreateDenom defines a method for creating a token factory denom, of the
+	// form factory/{creator}/{subdenom}, administrated by its creator.
+	CreateDenom(context.Context, *MsgCreateDenom) (*MsgCreateDenomResponse, error)
+	// MintDenom defines a method for the admin of a token factory denom to mint it.
+	MintDenom(context.Context, *MsgMintDenom) (*MsgMintDenomResponse, error)
+	// BurnDenom defines a method for the admin of a token factory denom to burn it
+	// from its balance.
+	BurnDenom(context.Context, *MsgBurnDenom) (*MsgBurnDenomResponse, error)
+	// ChangeDenomAdmin defines a method for the admin of a token factory denom to
+	// transfer or renounce its authority.
+	ChangeDenomAdmin(context.Context, *MsgChangeDenomAdmin) (*MsgChangeDenomAdminResponse, error)
+	// SetDenomMetadata defines a method for the admin of a token factory denom to
+	// set its metadata.
+	SetDenomMetadata(context.Context, *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error)
+	// SetDenomSendHook defines a method for the admin of a token factory denom to
+	// attach one of the send hooks registered by the application to the denom.
+	SetDenomSendHook(context.Context, *MsgSetDenomSendHook) (*MsgSetDenomSendHookResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -151,6 +251,24 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
 }
+func (UnimplementedMsgServer) CreateDenom(context.Context, *MsgCreateDenom) (*MsgCreateDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDenom not implemented")
+}
+func (UnimplementedMsgServer) MintDenom(context.Context, *MsgMintDenom) (*MsgMintDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintDenom not implemented")
+}
+func (UnimplementedMsgServer) BurnDenom(context.Context, *MsgBurnDenom) (*MsgBurnDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnDenom not implemented")
+}
+func (UnimplementedMsgServer) ChangeDenomAdmin(context.Context, *MsgChangeDenomAdmin) (*MsgChangeDenomAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeDenomAdmin not implemented")
+}
+func (UnimplementedMsgServer) SetDenomMetadata(context.Context, *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMetadata not implemented")
+}
+func (UnimplementedMsgServer) SetDenomSendHook(context.Context, *MsgSetDenomSendHook) (*MsgSetDenomSendHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomSendHook not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -262,6 +380,114 @@ func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDenom(ctx, req.(*MsgCreateDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MintDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MintDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_MintDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MintDenom(ctx, req.(*MsgMintDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BurnDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurnDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BurnDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BurnDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BurnDenom(ctx, req.(*MsgBurnDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeDenomAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeDenomAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeDenomAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ChangeDenomAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeDenomAdmin(ctx, req.(*MsgChangeDenomAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDenomMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomMetadata(ctx, req.(*MsgSetDenomMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomSendHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomSendHook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomSendHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDenomSendHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomSendHook(ctx, req.(*MsgSetDenomSendHook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -289,6 +515,30 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetSendEnabled",
 			Handler:    _Msg_SetSendEnabled_Handler,
 		},
+		{
+			MethodName: "CreateDenom",
+			Handler:    _Msg_CreateDenom_Handler,
+		},
+		{
+			MethodName: "MintDenom",
+			Handler:    _Msg_MintDenom_Handler,
+		},
+		{
+			MethodName: "BurnDenom",
+			Handler:    _Msg_BurnDenom_Handler,
+		},
+		{
+			MethodName: "ChangeDenomAdmin",
+			Handler:    _Msg_ChangeDenomAdmin_Handler,
+		},
+		{
+			MethodName: "SetDenomMetadata",
+			Handler:    _Msg_SetDenomMetadata_Handler,
+		},
+		{
+			MethodName: "SetDenomSendHook",
+			Handler:    _Msg_SetDenomSendHook_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
@@ -21,7 +21,8 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Params_FullMethodName = "/cosmos.consensus.v1.Query/Params"
+	Query_Params_FullMethodName               = "/cosmos.consensus.v1.Query/Params"
+	Query_PendingParamsUpdates_FullMethodName = "/cosmos.consensus.v1.Query/PendingParamsUpdates"
 )
 
 // QueryClient is the client API for Query service.
@@ -32,6 +33,8 @@ const (
 type QueryClient interface {
 	// Params queries the parameters of x/consensus module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// PendingParamsUpdates queries the consensus parameters updates scheduled at future heights, by height.
+	PendingParamsUpdates(ctx context.Context, in *QueryPendingParamsUpdatesRequest, opts ...grpc.CallOption) (*QueryPendingParamsUpdatesResponse, error)
 }
 
 type queryClient struct {
@@ -52,6 +55,16 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) PendingParamsUpdates(ctx context.Context, in *QueryPendingParamsUpdatesRequest, opts ...grpc.CallOption) (*QueryPendingParamsUpdatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPendingParamsUpdatesResponse)
+	err := c.cc.Invoke(ctx, Query_PendingParamsUpdates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -60,6 +73,8 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 type QueryServer interface {
 	// Params queries the parameters of x/consensus module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// PendingParamsUpdates queries the consensus parameters updates scheduled at future heights, by height.
+	PendingParamsUpdates(context.Context, *QueryPendingParamsUpdatesRequest) (*QueryPendingParamsUpdatesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -73,6 +88,9 @@ type UnimplementedQueryServer struct{}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) PendingParamsUpdates(context.Context, *QueryPendingParamsUpdatesRequest) (*QueryPendingParamsUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingParamsUpdates not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -112,6 +130,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingParamsUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingParamsUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingParamsUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingParamsUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingParamsUpdates(ctx, req.(*QueryPendingParamsUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -123,6 +159,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "PendingParamsUpdates",
+			Handler:    _Query_PendingParamsUpdates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/consensus/v1/query.proto",
@@ -21,7 +21,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_UpdateParams_FullMethodName = "/cosmos.consensus.v1.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName         = "/cosmos.consensus.v1.Msg/UpdateParams"
+	Msg_ScheduleParamsUpdate_FullMethodName = "/cosmos.consensus.v1.Msg/ScheduleParamsUpdate"
+	Msg_CancelParamsUpdate_FullMethodName   = "/cosmos.consensus.v1.Msg/CancelParamsUpdate"
 )
 
 // MsgClient is the client API for Msg service.
@@ -33,6 +35,12 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/consensus module parameters.
 	// The authority is defined in the keeper.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ScheduleParamsUpdate defines a governance operation for scheduling an update of the x/consensus module
+	// parameters at a future block height. The authority is defined in the keeper.
+	ScheduleParamsUpdate(ctx context.Context, in *MsgScheduleParamsUpdate, opts ...grpc.CallOption) (*MsgScheduleParamsUpdateResponse, error)
+	// CancelParamsUpdate defines a governance operation for cancelling a scheduled update of the x/consensus
+	// module parameters. The authority is defined in the keeper.
+	CancelParamsUpdate(ctx context.Context, in *MsgCancelParamsUpdate, opts ...grpc.CallOption) (*MsgCancelParamsUpdateResponse, error)
 }
 
 type msgClient struct {
@@ -53,6 +61,26 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ScheduleParamsUpdate(ctx context.Context, in *MsgScheduleParamsUpdate, opts ...grpc.CallOption) (*MsgScheduleParamsUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgScheduleParamsUpdateResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleParamsUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelParamsUpdate(ctx context.Context, in *MsgCancelParamsUpdate, opts ...grpc.CallOption) (*MsgCancelParamsUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelParamsUpdateResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelParamsUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -62,6 +90,12 @@ type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/consensus module parameters.
 	// The authority is defined in the keeper.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ScheduleParamsUpdate defines a governance operation for scheduling an update of the x/consensus module
+	// parameters at a future block height. The authority is defined in the keeper.
+	ScheduleParamsUpdate(context.Context, *MsgScheduleParamsUpdate) (*MsgScheduleParamsUpdateResponse, error)
+	// CancelParamsUpdate defines a governance operation for cancelling a scheduled update of the x/consensus
+	// module parameters. The authority is defined in the keeper.
+	CancelParamsUpdate(context.Context, *MsgCancelParamsUpdate) (*MsgCancelParamsUpdateResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -75,6 +109,12 @@ type UnimplementedMsgServer struct{}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) ScheduleParamsUpdate(context.Context, *MsgScheduleParamsUpdate) (*MsgScheduleParamsUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleParamsUpdate not implemented")
+}
+func (UnimplementedMsgServer) CancelParamsUpdate(context.Context, *MsgCancelParamsUpdate) (*MsgCancelParamsUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelParamsUpdate not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -114,6 +154,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleParamsUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleParamsUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleParamsUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleParamsUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleParamsUpdate(ctx, req.(*MsgScheduleParamsUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelParamsUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelParamsUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelParamsUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelParamsUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelParamsUpdate(ctx, req.(*MsgCancelParamsUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -125,6 +201,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ScheduleParamsUpdate",
+			Handler:    _Msg_ScheduleParamsUpdate_Handler,
+		},
+		{
+			MethodName: "CancelParamsUpdate",
+			Handler:    _Msg_CancelParamsUpdate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/consensus/v1/tx.proto",
@@ -29,6 +29,7 @@ const (
 	Query_DelegatorValidators_FullMethodName         = "/cosmos.distribution.v1beta1.Query/DelegatorValidators"
 	Query_DelegatorWithdrawAddress_FullMethodName    = "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddress"
 	Query_CommunityPool_FullMethodName               = "/cosmos.distribution.v1beta1.Query/CommunityPool"
+	Query_AutoCompoundDelegators_FullMethodName      = "/cosmos.distribution.v1beta1.Query/AutoCompoundDelegators"
 )
 
 // QueryClient is the client API for Query service.
@@ -61,6 +62,9 @@ type QueryClient interface {
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// AutoCompoundDelegators queries the delegators opted in to the auto-compounding
+	// of their rewards.
+	AutoCompoundDelegators(ctx context.Context, in *QueryAutoCompoundDelegatorsRequest, opts ...grpc.CallOption) (*QueryAutoCompoundDelegatorsResponse, error)
 }
 
 type queryClient struct {
@@ -172,6 +176,16 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
+func (c *queryClient) AutoCompoundDelegators(ctx context.Context, in *QueryAutoCompoundDelegatorsRequest, opts ...grpc.CallOption) (*QueryAutoCompoundDelegatorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAutoCompoundDelegatorsResponse)
+	err := c.cc.Invoke(ctx, Query_AutoCompoundDelegators_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -202,6 +216,9 @@ type QueryServer interface {
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// AutoCompoundDelegators queries the delegators opted in to the auto-compounding
+	// of their rewards.
+	AutoCompoundDelegators(context.Context, *QueryAutoCompoundDelegatorsRequest) (*QueryAutoCompoundDelegatorsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -242,6 +259,9 @@ func (UnimplementedQueryServer) DelegatorWithdrawAddress(context.Context, *Query
 func (UnimplementedQueryServer) CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (UnimplementedQueryServer) AutoCompoundDelegators(context.Context, *QueryAutoCompoundDelegatorsRequest) (*QueryAutoCompoundDelegatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoCompoundDelegators not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -443,6 +463,24 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AutoCompoundDelegators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoCompoundDelegatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoCompoundDelegators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AutoCompoundDelegators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoCompoundDelegators(ctx, req.(*QueryAutoCompoundDelegatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -490,6 +528,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "AutoCompoundDelegators",
+			Handler:    _Query_AutoCompoundDelegators_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
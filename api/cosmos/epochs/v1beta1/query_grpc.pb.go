@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_EpochInfos_FullMethodName   = "/cosmos.epochs.v1beta1.Query/EpochInfos"
-	Query_CurrentEpoch_FullMethodName = "/cosmos.epochs.v1beta1.Query/CurrentEpoch"
+	Query_EpochInfos_FullMethodName          = "/cosmos.epochs.v1beta1.Query/EpochInfos"
+	Query_CurrentEpoch_FullMethodName        = "/cosmos.epochs.v1beta1.Query/CurrentEpoch"
+	Query_UpcomingEpochStarts_FullMethodName = "/cosmos.epochs.v1beta1.Query/UpcomingEpochStarts"
 )
 
 // QueryClient is the client API for Query service.
@@ -33,6 +34,9 @@ type QueryClient interface {
 	EpochInfos(ctx context.Context, in *QueryEpochsInfoRequest, opts ...grpc.CallOption) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
+	// UpcomingEpochStarts provide the start times of the next epochs, of all identifiers
+	// or of a specified identifier
+	UpcomingEpochStarts(ctx context.Context, in *QueryUpcomingEpochStartsRequest, opts ...grpc.CallOption) (*QueryUpcomingEpochStartsResponse, error)
 }
 
 type queryClient struct {
@@ -63,6 +67,16 @@ func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochReq
 	return out, nil
 }
 
+func (c *queryClient) UpcomingEpochStarts(ctx context.Context, in *QueryUpcomingEpochStartsRequest, opts ...grpc.CallOption) (*QueryUpcomingEpochStartsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryUpcomingEpochStartsResponse)
+	err := c.cc.Invoke(ctx, Query_UpcomingEpochStarts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -73,6 +87,9 @@ type QueryServer interface {
 	EpochInfos(context.Context, *QueryEpochsInfoRequest) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
+	// UpcomingEpochStarts provide the start times of the next epochs, of all identifiers
+	// or of a specified identifier
+	UpcomingEpochStarts(context.Context, *QueryUpcomingEpochStartsRequest) (*QueryUpcomingEpochStartsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -89,6 +106,9 @@ func (UnimplementedQueryServer) EpochInfos(context.Context, *QueryEpochsInfoRequ
 func (UnimplementedQueryServer) CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
 }
+func (UnimplementedQueryServer) UpcomingEpochStarts(context.Context, *QueryUpcomingEpochStartsRequest) (*QueryUpcomingEpochStartsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpcomingEpochStarts not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -146,6 +166,24 @@ func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UpcomingEpochStarts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpcomingEpochStartsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpcomingEpochStarts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UpcomingEpochStarts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpcomingEpochStarts(ctx, req.(*QueryUpcomingEpochStartsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -161,6 +199,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CurrentEpoch",
 			Handler:    _Query_CurrentEpoch_Handler,
 		},
+		{
+			MethodName: "UpcomingEpochStarts",
+			Handler:    _Query_UpcomingEpochStarts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/epochs/v1beta1/query.proto",
@@ -0,0 +1,171 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: cosmos/epochs/v1beta1/tx.proto
+
+package epochsv1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Msg_AddEpochIdentifier_FullMethodName    = "/cosmos.epochs.v1beta1.Msg/AddEpochIdentifier"
+	Msg_RemoveEpochIdentifier_FullMethodName = "/cosmos.epochs.v1beta1.Msg/RemoveEpochIdentifier"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Msg defines the epochs Msg service.
+type MsgClient interface {
+	// AddEpochIdentifier defines a governance operation for adding an epoch identifier.
+	// The authority is defined in the keeper.
+	AddEpochIdentifier(ctx context.Context, in *MsgAddEpochIdentifier, opts ...grpc.CallOption) (*MsgAddEpochIdentifierResponse, error)
+	// RemoveEpochIdentifier defines a governance operation for removing an epoch identifier.
+	// The authority is defined in the keeper.
+	RemoveEpochIdentifier(ctx context.Context, in *MsgRemoveEpochIdentifier, opts ...grpc.CallOption) (*MsgRemoveEpochIdentifierResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) AddEpochIdentifier(ctx context.Context, in *MsgAddEpochIdentifier, opts ...grpc.CallOption) (*MsgAddEpochIdentifierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAddEpochIdentifierResponse)
+	err := c.cc.Invoke(ctx, Msg_AddEpochIdentifier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveEpochIdentifier(ctx context.Context, in *MsgRemoveEpochIdentifier, opts ...grpc.CallOption) (*MsgRemoveEpochIdentifierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveEpochIdentifierResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveEpochIdentifier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility.
+//
+// Msg defines the epochs Msg service.
+type MsgServer interface {
+	// AddEpochIdentifier defines a governance operation for adding an epoch identifier.
+	// The authority is defined in the keeper.
+	AddEpochIdentifier(context.Context, *MsgAddEpochIdentifier) (*MsgAddEpochIdentifierResponse, error)
+	// RemoveEpochIdentifier defines a governance operation for removing an epoch identifier.
+	// The authority is defined in the keeper.
+	RemoveEpochIdentifier(context.Context, *MsgRemoveEpochIdentifier) (*MsgRemoveEpochIdentifierResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMsgServer struct{}
+
+func (UnimplementedMsgServer) AddEpochIdentifier(context.Context, *MsgAddEpochIdentifier) (*MsgAddEpochIdentifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEpochIdentifier not implemented")
+}
+func (UnimplementedMsgServer) RemoveEpochIdentifier(context.Context, *MsgRemoveEpochIdentifier) (*MsgRemoveEpochIdentifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEpochIdentifier not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+func (UnimplementedMsgServer) testEmbeddedByValue()             {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	// If the following call pancis, it indicates UnimplementedMsgServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_AddEpochIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddEpochIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddEpochIdentifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddEpochIdentifier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddEpochIdentifier(ctx, req.(*MsgAddEpochIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveEpochIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveEpochIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveEpochIdentifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveEpochIdentifier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveEpochIdentifier(ctx, req.(*MsgRemoveEpochIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.epochs.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddEpochIdentifier",
+			Handler:    _Msg_AddEpochIdentifier_Handler,
+		},
+		{
+			MethodName: "RemoveEpochIdentifier",
+			Handler:    _Msg_RemoveEpochIdentifier_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/epochs/v1beta1/tx.proto",
+}
@@ -24,6 +24,9 @@ const (
 	Query_Allowance_FullMethodName           = "/cosmos.feegrant.v1beta1.Query/Allowance"
 	Query_Allowances_FullMethodName          = "/cosmos.feegrant.v1beta1.Query/Allowances"
 	Query_AllowancesByGranter_FullMethodName = "/cosmos.feegrant.v1beta1.Query/AllowancesByGranter"
+	Query_Sponsorship_FullMethodName         = "/cosmos.feegrant.v1beta1.Query/Sponsorship"
+	Query_Sponsorships_FullMethodName        = "/cosmos.feegrant.v1beta1.Query/Sponsorships"
+	Query_SponsoredUsage_FullMethodName      = "/cosmos.feegrant.v1beta1.Query/SponsoredUsage"
 )
 
 // QueryClient is the client API for Query service.
@@ -38,6 +41,12 @@ type QueryClient interface {
 	Allowances(ctx context.Context, in *QueryAllowancesRequest, opts ...grpc.CallOption) (*QueryAllowancesResponse, error)
 	// AllowancesByGranter returns all the grants given by an address
 	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+	// Sponsorship returns the sponsorship of a sponsor.
+	Sponsorship(ctx context.Context, in *QuerySponsorshipRequest, opts ...grpc.CallOption) (*QuerySponsorshipResponse, error)
+	// Sponsorships returns all the sponsorships.
+	Sponsorships(ctx context.Context, in *QuerySponsorshipsRequest, opts ...grpc.CallOption) (*QuerySponsorshipsResponse, error)
+	// SponsoredUsage returns the fees paid by a sponsor for an account in the current day.
+	SponsoredUsage(ctx context.Context, in *QuerySponsoredUsageRequest, opts ...grpc.CallOption) (*QuerySponsoredUsageResponse, error)
 }
 
 type queryClient struct {
@@ -78,6 +87,36 @@ func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowanc
 	return out, nil
 }
 
+func (c *queryClient) Sponsorship(ctx context.Context, in *QuerySponsorshipRequest, opts ...grpc.CallOption) (*QuerySponsorshipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySponsorshipResponse)
+	err := c.cc.Invoke(ctx, Query_Sponsorship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Sponsorships(ctx context.Context, in *QuerySponsorshipsRequest, opts ...grpc.CallOption) (*QuerySponsorshipsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySponsorshipsResponse)
+	err := c.cc.Invoke(ctx, Query_Sponsorships_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SponsoredUsage(ctx context.Context, in *QuerySponsoredUsageRequest, opts ...grpc.CallOption) (*QuerySponsoredUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySponsoredUsageResponse)
+	err := c.cc.Invoke(ctx, Query_SponsoredUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -90,6 +129,12 @@ type QueryServer interface {
 	Allowances(context.Context, *QueryAllowancesRequest) (*QueryAllowancesResponse, error)
 	// AllowancesByGranter returns all the grants given by an address
 	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+	// Sponsorship returns the sponsorship of a sponsor.
+	Sponsorship(context.Context, *QuerySponsorshipRequest) (*QuerySponsorshipResponse, error)
+	// Sponsorships returns all the sponsorships.
+	Sponsorships(context.Context, *QuerySponsorshipsRequest) (*QuerySponsorshipsResponse, error)
+	// SponsoredUsage returns the fees paid by a sponsor for an account in the current day.
+	SponsoredUsage(context.Context, *QuerySponsoredUsageRequest) (*QuerySponsoredUsageResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -109,6 +154,15 @@ func (UnimplementedQueryServer) Allowances(context.Context, *QueryAllowancesRequ
 func (UnimplementedQueryServer) AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
 }
+func (UnimplementedQueryServer) Sponsorship(context.Context, *QuerySponsorshipRequest) (*QuerySponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sponsorship not implemented")
+}
+func (UnimplementedQueryServer) Sponsorships(context.Context, *QuerySponsorshipsRequest) (*QuerySponsorshipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sponsorships not implemented")
+}
+func (UnimplementedQueryServer) SponsoredUsage(context.Context, *QuerySponsoredUsageRequest) (*QuerySponsoredUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SponsoredUsage not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -184,6 +238,60 @@ func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Sponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Sponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Sponsorship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Sponsorship(ctx, req.(*QuerySponsorshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Sponsorships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorshipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Sponsorships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Sponsorships_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Sponsorships(ctx, req.(*QuerySponsorshipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SponsoredUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsoredUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SponsoredUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SponsoredUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SponsoredUsage(ctx, req.(*QuerySponsoredUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -203,6 +311,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllowancesByGranter",
 			Handler:    _Query_AllowancesByGranter_Handler,
 		},
+		{
+			MethodName: "Sponsorship",
+			Handler:    _Query_Sponsorship_Handler,
+		},
+		{
+			MethodName: "Sponsorships",
+			Handler:    _Query_Sponsorships_Handler,
+		},
+		{
+			MethodName: "SponsoredUsage",
+			Handler:    _Query_SponsoredUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/query.proto",
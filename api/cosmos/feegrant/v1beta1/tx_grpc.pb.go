@@ -21,9 +21,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_GrantAllowance_FullMethodName  = "/cosmos.feegrant.v1beta1.Msg/GrantAllowance"
-	Msg_RevokeAllowance_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/RevokeAllowance"
-	Msg_PruneAllowances_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/PruneAllowances"
+	Msg_GrantAllowance_FullMethodName    = "/cosmos.feegrant.v1beta1.Msg/GrantAllowance"
+	Msg_RevokeAllowance_FullMethodName   = "/cosmos.feegrant.v1beta1.Msg/RevokeAllowance"
+	Msg_PruneAllowances_FullMethodName   = "/cosmos.feegrant.v1beta1.Msg/PruneAllowances"
+	Msg_GrantSponsorship_FullMethodName  = "/cosmos.feegrant.v1beta1.Msg/GrantSponsorship"
+	Msg_RevokeSponsorship_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/RevokeSponsorship"
 )
 
 // MsgClient is the client API for Msg service.
@@ -40,6 +42,11 @@ type MsgClient interface {
 	RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance, opts ...grpc.CallOption) (*MsgRevokeAllowanceResponse, error)
 	// PruneAllowances prunes expired fee allowances, currently up to 75 at a time.
 	PruneAllowances(ctx context.Context, in *MsgPruneAllowances, opts ...grpc.CallOption) (*MsgPruneAllowancesResponse, error)
+	// GrantSponsorship sets the sponsorship of the granter's account, paying the fees of
+	// any account for the transactions of the allowed messages or contracts.
+	GrantSponsorship(ctx context.Context, in *MsgGrantSponsorship, opts ...grpc.CallOption) (*MsgGrantSponsorshipResponse, error)
+	// RevokeSponsorship revokes the sponsorship of the granter's account.
+	RevokeSponsorship(ctx context.Context, in *MsgRevokeSponsorship, opts ...grpc.CallOption) (*MsgRevokeSponsorshipResponse, error)
 }
 
 type msgClient struct {
@@ -80,6 +87,26 @@ func (c *msgClient) PruneAllowances(ctx context.Context, in *MsgPruneAllowances,
 	return out, nil
 }
 
+func (c *msgClient) GrantSponsorship(ctx context.Context, in *MsgGrantSponsorship, opts ...grpc.CallOption) (*MsgGrantSponsorshipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgGrantSponsorshipResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantSponsorship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeSponsorship(ctx context.Context, in *MsgRevokeSponsorship, opts ...grpc.CallOption) (*MsgRevokeSponsorshipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRevokeSponsorshipResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeSponsorship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -94,6 +121,11 @@ type MsgServer interface {
 	RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error)
 	// PruneAllowances prunes expired fee allowances, currently up to 75 at a time.
 	PruneAllowances(context.Context, *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error)
+	// GrantSponsorship sets the sponsorship of the granter's account, paying the fees of
+	// any account for the transactions of the allowed messages or contracts.
+	GrantSponsorship(context.Context, *MsgGrantSponsorship) (*MsgGrantSponsorshipResponse, error)
+	// RevokeSponsorship revokes the sponsorship of the granter's account.
+	RevokeSponsorship(context.Context, *MsgRevokeSponsorship) (*MsgRevokeSponsorshipResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -113,6 +145,12 @@ func (UnimplementedMsgServer) RevokeAllowance(context.Context, *MsgRevokeAllowan
 func (UnimplementedMsgServer) PruneAllowances(context.Context, *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneAllowances not implemented")
 }
+func (UnimplementedMsgServer) GrantSponsorship(context.Context, *MsgGrantSponsorship) (*MsgGrantSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantSponsorship not implemented")
+}
+func (UnimplementedMsgServer) RevokeSponsorship(context.Context, *MsgRevokeSponsorship) (*MsgRevokeSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSponsorship not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -188,6 +226,42 @@ func _Msg_PruneAllowances_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_GrantSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantSponsorship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantSponsorship(ctx, req.(*MsgGrantSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeSponsorship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeSponsorship(ctx, req.(*MsgRevokeSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -207,6 +281,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PruneAllowances",
 			Handler:    _Msg_PruneAllowances_Handler,
 		},
+		{
+			MethodName: "GrantSponsorship",
+			Handler:    _Msg_GrantSponsorship_Handler,
+		},
+		{
+			MethodName: "RevokeSponsorship",
+			Handler:    _Msg_RevokeSponsorship_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/tx.proto",
@@ -21,17 +21,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Constitution_FullMethodName        = "/cosmos.gov.v1.Query/Constitution"
-	Query_Proposal_FullMethodName            = "/cosmos.gov.v1.Query/Proposal"
-	Query_Proposals_FullMethodName           = "/cosmos.gov.v1.Query/Proposals"
-	Query_Vote_FullMethodName                = "/cosmos.gov.v1.Query/Vote"
-	Query_Votes_FullMethodName               = "/cosmos.gov.v1.Query/Votes"
-	Query_Params_FullMethodName              = "/cosmos.gov.v1.Query/Params"
-	Query_Deposit_FullMethodName             = "/cosmos.gov.v1.Query/Deposit"
-	Query_Deposits_FullMethodName            = "/cosmos.gov.v1.Query/Deposits"
-	Query_TallyResult_FullMethodName         = "/cosmos.gov.v1.Query/TallyResult"
-	Query_ProposalVoteOptions_FullMethodName = "/cosmos.gov.v1.Query/ProposalVoteOptions"
-	Query_MessageBasedParams_FullMethodName  = "/cosmos.gov.v1.Query/MessageBasedParams"
+	Query_Constitution_FullMethodName         = "/cosmos.gov.v1.Query/Constitution"
+	Query_Proposal_FullMethodName             = "/cosmos.gov.v1.Query/Proposal"
+	Query_Proposals_FullMethodName            = "/cosmos.gov.v1.Query/Proposals"
+	Query_Vote_FullMethodName                 = "/cosmos.gov.v1.Query/Vote"
+	Query_Votes_FullMethodName                = "/cosmos.gov.v1.Query/Votes"
+	Query_Params_FullMethodName               = "/cosmos.gov.v1.Query/Params"
+	Query_Deposit_FullMethodName              = "/cosmos.gov.v1.Query/Deposit"
+	Query_Deposits_FullMethodName             = "/cosmos.gov.v1.Query/Deposits"
+	Query_TallyResult_FullMethodName          = "/cosmos.gov.v1.Query/TallyResult"
+	Query_ProposalVoteOptions_FullMethodName  = "/cosmos.gov.v1.Query/ProposalVoteOptions"
+	Query_MessageBasedParams_FullMethodName   = "/cosmos.gov.v1.Query/MessageBasedParams"
+	Query_ConvictionLock_FullMethodName       = "/cosmos.gov.v1.Query/ConvictionLock"
+	Query_ConvictionLocks_FullMethodName      = "/cosmos.gov.v1.Query/ConvictionLocks"
+	Query_Governor_FullMethodName             = "/cosmos.gov.v1.Query/Governor"
+	Query_Governors_FullMethodName            = "/cosmos.gov.v1.Query/Governors"
+	Query_GovernanceDelegation_FullMethodName = "/cosmos.gov.v1.Query/GovernanceDelegation"
+	Query_SimulateProposal_FullMethodName     = "/cosmos.gov.v1.Query/SimulateProposal"
 )
 
 // QueryClient is the client API for Query service.
@@ -62,6 +68,19 @@ type QueryClient interface {
 	ProposalVoteOptions(ctx context.Context, in *QueryProposalVoteOptionsRequest, opts ...grpc.CallOption) (*QueryProposalVoteOptionsResponse, error)
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	MessageBasedParams(ctx context.Context, in *QueryMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryMessageBasedParamsResponse, error)
+	// ConvictionLock queries the conviction lock of a voter.
+	ConvictionLock(ctx context.Context, in *QueryConvictionLockRequest, opts ...grpc.CallOption) (*QueryConvictionLockResponse, error)
+	// ConvictionLocks queries all the conviction locks.
+	ConvictionLocks(ctx context.Context, in *QueryConvictionLocksRequest, opts ...grpc.CallOption) (*QueryConvictionLocksResponse, error)
+	// Governor queries a governor and the voting power delegated to it.
+	Governor(ctx context.Context, in *QueryGovernorRequest, opts ...grpc.CallOption) (*QueryGovernorResponse, error)
+	// Governors queries all the governors and the voting power delegated to them.
+	Governors(ctx context.Context, in *QueryGovernorsRequest, opts ...grpc.CallOption) (*QueryGovernorsResponse, error)
+	// GovernanceDelegation queries the governance delegation of a token holder.
+	GovernanceDelegation(ctx context.Context, in *QueryGovernanceDelegationRequest, opts ...grpc.CallOption) (*QueryGovernanceDelegationResponse, error)
+	// SimulateProposal executes the messages of a proposal against the current state, without
+	// persisting any state change, as if the proposal had passed.
+	SimulateProposal(ctx context.Context, in *QuerySimulateProposalRequest, opts ...grpc.CallOption) (*QuerySimulateProposalResponse, error)
 }
 
 type queryClient struct {
@@ -182,6 +201,66 @@ func (c *queryClient) MessageBasedParams(ctx context.Context, in *QueryMessageBa
 	return out, nil
 }
 
+func (c *queryClient) ConvictionLock(ctx context.Context, in *QueryConvictionLockRequest, opts ...grpc.CallOption) (*QueryConvictionLockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryConvictionLockResponse)
+	err := c.cc.Invoke(ctx, Query_ConvictionLock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConvictionLocks(ctx context.Context, in *QueryConvictionLocksRequest, opts ...grpc.CallOption) (*QueryConvictionLocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryConvictionLocksResponse)
+	err := c.cc.Invoke(ctx, Query_ConvictionLocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Governor(ctx context.Context, in *QueryGovernorRequest, opts ...grpc.CallOption) (*QueryGovernorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGovernorResponse)
+	err := c.cc.Invoke(ctx, Query_Governor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Governors(ctx context.Context, in *QueryGovernorsRequest, opts ...grpc.CallOption) (*QueryGovernorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGovernorsResponse)
+	err := c.cc.Invoke(ctx, Query_Governors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GovernanceDelegation(ctx context.Context, in *QueryGovernanceDelegationRequest, opts ...grpc.CallOption) (*QueryGovernanceDelegationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGovernanceDelegationResponse)
+	err := c.cc.Invoke(ctx, Query_GovernanceDelegation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateProposal(ctx context.Context, in *QuerySimulateProposalRequest, opts ...grpc.CallOption) (*QuerySimulateProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySimulateProposalResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -210,6 +289,19 @@ type QueryServer interface {
 	ProposalVoteOptions(context.Context, *QueryProposalVoteOptionsRequest) (*QueryProposalVoteOptionsResponse, error)
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error)
+	// ConvictionLock queries the conviction lock of a voter.
+	ConvictionLock(context.Context, *QueryConvictionLockRequest) (*QueryConvictionLockResponse, error)
+	// ConvictionLocks queries all the conviction locks.
+	ConvictionLocks(context.Context, *QueryConvictionLocksRequest) (*QueryConvictionLocksResponse, error)
+	// Governor queries a governor and the voting power delegated to it.
+	Governor(context.Context, *QueryGovernorRequest) (*QueryGovernorResponse, error)
+	// Governors queries all the governors and the voting power delegated to them.
+	Governors(context.Context, *QueryGovernorsRequest) (*QueryGovernorsResponse, error)
+	// GovernanceDelegation queries the governance delegation of a token holder.
+	GovernanceDelegation(context.Context, *QueryGovernanceDelegationRequest) (*QueryGovernanceDelegationResponse, error)
+	// SimulateProposal executes the messages of a proposal against the current state, without
+	// persisting any state change, as if the proposal had passed.
+	SimulateProposal(context.Context, *QuerySimulateProposalRequest) (*QuerySimulateProposalResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -253,6 +345,24 @@ func (UnimplementedQueryServer) ProposalVoteOptions(context.Context, *QueryPropo
 func (UnimplementedQueryServer) MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MessageBasedParams not implemented")
 }
+func (UnimplementedQueryServer) ConvictionLock(context.Context, *QueryConvictionLockRequest) (*QueryConvictionLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvictionLock not implemented")
+}
+func (UnimplementedQueryServer) ConvictionLocks(context.Context, *QueryConvictionLocksRequest) (*QueryConvictionLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvictionLocks not implemented")
+}
+func (UnimplementedQueryServer) Governor(context.Context, *QueryGovernorRequest) (*QueryGovernorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Governor not implemented")
+}
+func (UnimplementedQueryServer) Governors(context.Context, *QueryGovernorsRequest) (*QueryGovernorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Governors not implemented")
+}
+func (UnimplementedQueryServer) GovernanceDelegation(context.Context, *QueryGovernanceDelegationRequest) (*QueryGovernanceDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovernanceDelegation not implemented")
+}
+func (UnimplementedQueryServer) SimulateProposal(context.Context, *QuerySimulateProposalRequest) (*QuerySimulateProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateProposal not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -472,6 +582,114 @@ func _Query_MessageBasedParams_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ConvictionLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConvictionLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConvictionLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ConvictionLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConvictionLock(ctx, req.(*QueryConvictionLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConvictionLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConvictionLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConvictionLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ConvictionLocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConvictionLocks(ctx, req.(*QueryConvictionLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Governor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovernorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Governor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Governor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Governor(ctx, req.(*QueryGovernorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Governors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovernorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Governors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Governors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Governors(ctx, req.(*QueryGovernorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GovernanceDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovernanceDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GovernanceDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GovernanceDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GovernanceDelegation(ctx, req.(*QueryGovernanceDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateProposal(ctx, req.(*QuerySimulateProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -523,6 +741,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MessageBasedParams",
 			Handler:    _Query_MessageBasedParams_Handler,
 		},
+		{
+			MethodName: "ConvictionLock",
+			Handler:    _Query_ConvictionLock_Handler,
+		},
+		{
+			MethodName: "ConvictionLocks",
+			Handler:    _Query_ConvictionLocks_Handler,
+		},
+		{
+			MethodName: "Governor",
+			Handler:    _Query_Governor_Handler,
+		},
+		{
+			MethodName: "Governors",
+			Handler:    _Query_Governors_Handler,
+		},
+		{
+			MethodName: "GovernanceDelegation",
+			Handler:    _Query_GovernanceDelegation_Handler,
+		},
+		{
+			MethodName: "SimulateProposal",
+			Handler:    _Query_SimulateProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
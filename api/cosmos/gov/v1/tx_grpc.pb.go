@@ -31,6 +31,11 @@ const (
 	Msg_SubmitMultipleChoiceProposal_FullMethodName = "/cosmos.gov.v1.Msg/SubmitMultipleChoiceProposal"
 	Msg_UpdateMessageParams_FullMethodName          = "/cosmos.gov.v1.Msg/UpdateMessageParams"
 	Msg_SudoExec_FullMethodName                     = "/cosmos.gov.v1.Msg/SudoExec"
+	Msg_LockConviction_FullMethodName               = "/cosmos.gov.v1.Msg/LockConviction"
+	Msg_CreateGovernor_FullMethodName               = "/cosmos.gov.v1.Msg/CreateGovernor"
+	Msg_EditGovernor_FullMethodName                 = "/cosmos.gov.v1.Msg/EditGovernor"
+	Msg_DelegateGovernor_FullMethodName             = "/cosmos.gov.v1.Msg/DelegateGovernor"
+	Msg_UndelegateGovernor_FullMethodName           = "/cosmos.gov.v1.Msg/UndelegateGovernor"
 )
 
 // MsgClient is the client API for Msg service.
@@ -62,6 +67,18 @@ type MsgClient interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.CallOption) (*MsgSudoExecResponse, error)
+	// LockConviction defines a method to lock delegated tokens of a voter for a conviction
+	// multiplying their voting power. The locked tokens cannot be undelegated until the lock expires.
+	LockConviction(ctx context.Context, in *MsgLockConviction, opts ...grpc.CallOption) (*MsgLockConvictionResponse, error)
+	// CreateGovernor defines a method to register an account as a governor.
+	CreateGovernor(ctx context.Context, in *MsgCreateGovernor, opts ...grpc.CallOption) (*MsgCreateGovernorResponse, error)
+	// EditGovernor defines a method to edit the description of a governor.
+	EditGovernor(ctx context.Context, in *MsgEditGovernor, opts ...grpc.CallOption) (*MsgEditGovernorResponse, error)
+	// DelegateGovernor defines a method to delegate the governance voting power of a token holder
+	// to a governor. It replaces any previous governance delegation of the token holder.
+	DelegateGovernor(ctx context.Context, in *MsgDelegateGovernor, opts ...grpc.CallOption) (*MsgDelegateGovernorResponse, error)
+	// UndelegateGovernor defines a method to remove the governance delegation of a token holder.
+	UndelegateGovernor(ctx context.Context, in *MsgUndelegateGovernor, opts ...grpc.CallOption) (*MsgUndelegateGovernorResponse, error)
 }
 
 type msgClient struct {
@@ -172,6 +189,56 @@ func (c *msgClient) SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) LockConviction(ctx context.Context, in *MsgLockConviction, opts ...grpc.CallOption) (*MsgLockConvictionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgLockConvictionResponse)
+	err := c.cc.Invoke(ctx, Msg_LockConviction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateGovernor(ctx context.Context, in *MsgCreateGovernor, opts ...grpc.CallOption) (*MsgCreateGovernorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCreateGovernorResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateGovernor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EditGovernor(ctx context.Context, in *MsgEditGovernor, opts ...grpc.CallOption) (*MsgEditGovernorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgEditGovernorResponse)
+	err := c.cc.Invoke(ctx, Msg_EditGovernor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelegateGovernor(ctx context.Context, in *MsgDelegateGovernor, opts ...grpc.CallOption) (*MsgDelegateGovernorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgDelegateGovernorResponse)
+	err := c.cc.Invoke(ctx, Msg_DelegateGovernor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateGovernor(ctx context.Context, in *MsgUndelegateGovernor, opts ...grpc.CallOption) (*MsgUndelegateGovernorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUndelegateGovernorResponse)
+	err := c.cc.Invoke(ctx, Msg_UndelegateGovernor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -201,6 +268,18 @@ type MsgServer interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error)
+	// LockConviction defines a method to lock delegated tokens of a voter for a conviction
+	// multiplying their voting power. The locked tokens cannot be undelegated until the lock expires.
+	LockConviction(context.Context, *MsgLockConviction) (*MsgLockConvictionResponse, error)
+	// CreateGovernor defines a method to register an account as a governor.
+	CreateGovernor(context.Context, *MsgCreateGovernor) (*MsgCreateGovernorResponse, error)
+	// EditGovernor defines a method to edit the description of a governor.
+	EditGovernor(context.Context, *MsgEditGovernor) (*MsgEditGovernorResponse, error)
+	// DelegateGovernor defines a method to delegate the governance voting power of a token holder
+	// to a governor. It replaces any previous governance delegation of the token holder.
+	DelegateGovernor(context.Context, *MsgDelegateGovernor) (*MsgDelegateGovernorResponse, error)
+	// UndelegateGovernor defines a method to remove the governance delegation of a token holder.
+	UndelegateGovernor(context.Context, *MsgUndelegateGovernor) (*MsgUndelegateGovernorResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -241,6 +320,21 @@ func (UnimplementedMsgServer) UpdateMessageParams(context.Context, *MsgUpdateMes
 func (UnimplementedMsgServer) SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SudoExec not implemented")
 }
+func (UnimplementedMsgServer) LockConviction(context.Context, *MsgLockConviction) (*MsgLockConvictionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockConviction not implemented")
+}
+func (UnimplementedMsgServer) CreateGovernor(context.Context, *MsgCreateGovernor) (*MsgCreateGovernorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGovernor not implemented")
+}
+func (UnimplementedMsgServer) EditGovernor(context.Context, *MsgEditGovernor) (*MsgEditGovernorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditGovernor not implemented")
+}
+func (UnimplementedMsgServer) DelegateGovernor(context.Context, *MsgDelegateGovernor) (*MsgDelegateGovernorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateGovernor not implemented")
+}
+func (UnimplementedMsgServer) UndelegateGovernor(context.Context, *MsgUndelegateGovernor) (*MsgUndelegateGovernorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateGovernor not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -442,6 +536,96 @@ func _Msg_SudoExec_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_LockConviction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLockConviction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LockConviction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_LockConviction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LockConviction(ctx, req.(*MsgLockConviction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateGovernor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateGovernor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateGovernor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateGovernor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateGovernor(ctx, req.(*MsgCreateGovernor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EditGovernor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEditGovernor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EditGovernor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_EditGovernor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EditGovernor(ctx, req.(*MsgEditGovernor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelegateGovernor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateGovernor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateGovernor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DelegateGovernor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateGovernor(ctx, req.(*MsgDelegateGovernor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateGovernor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateGovernor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateGovernor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UndelegateGovernor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateGovernor(ctx, req.(*MsgUndelegateGovernor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -489,6 +673,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SudoExec",
 			Handler:    _Msg_SudoExec_Handler,
 		},
+		{
+			MethodName: "LockConviction",
+			Handler:    _Msg_LockConviction_Handler,
+		},
+		{
+			MethodName: "CreateGovernor",
+			Handler:    _Msg_CreateGovernor_Handler,
+		},
+		{
+			MethodName: "EditGovernor",
+			Handler:    _Msg_EditGovernor_Handler,
+		},
+		{
+			MethodName: "DelegateGovernor",
+			Handler:    _Msg_DelegateGovernor_Handler,
+		},
+		{
+			MethodName: "UndelegateGovernor",
+			Handler:    _Msg_UndelegateGovernor_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
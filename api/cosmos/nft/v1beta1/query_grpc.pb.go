@@ -30,6 +30,9 @@ const (
 	Query_NFTByQueryString_FullMethodName     = "/cosmos.nft.v1beta1.Query/NFTByQueryString"
 	Query_Class_FullMethodName                = "/cosmos.nft.v1beta1.Query/Class"
 	Query_ClassByQueryString_FullMethodName   = "/cosmos.nft.v1beta1.Query/ClassByQueryString"
+	Query_ClassConfig_FullMethodName          = "/cosmos.nft.v1beta1.Query/ClassConfig"
+	Query_Approval_FullMethodName             = "/cosmos.nft.v1beta1.Query/Approval"
+	Query_OperatorApprovals_FullMethodName    = "/cosmos.nft.v1beta1.Query/OperatorApprovals"
 	Query_Classes_FullMethodName              = "/cosmos.nft.v1beta1.Query/Classes"
 )
 
@@ -62,6 +65,12 @@ type QueryClient interface {
 	Class(ctx context.Context, in *QueryClassRequest, opts ...grpc.CallOption) (*QueryClassResponse, error)
 	// Class queries an NFT class based on its id
 	ClassByQueryString(ctx context.Context, in *QueryClassByQueryStringRequest, opts ...grpc.CallOption) (*QueryClassByQueryStringResponse, error)
+	// ClassConfig queries the configuration of an NFT class created with Msg/CreateClass
+	ClassConfig(ctx context.Context, in *QueryClassConfigRequest, opts ...grpc.CallOption) (*QueryClassConfigResponse, error)
+	// Approval queries the spender approved to transfer an NFT
+	Approval(ctx context.Context, in *QueryApprovalRequest, opts ...grpc.CallOption) (*QueryApprovalResponse, error)
+	// OperatorApprovals queries the operators approved to transfer all the NFTs of an owner
+	OperatorApprovals(ctx context.Context, in *QueryOperatorApprovalsRequest, opts ...grpc.CallOption) (*QueryOperatorApprovalsResponse, error)
 	// Classes queries all NFT classes
 	Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error)
 }
@@ -184,6 +193,36 @@ func (c *queryClient) ClassByQueryString(ctx context.Context, in *QueryClassByQu
 	return out, nil
 }
 
+func (c *queryClient) ClassConfig(ctx context.Context, in *QueryClassConfigRequest, opts ...grpc.CallOption) (*QueryClassConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryClassConfigResponse)
+	err := c.cc.Invoke(ctx, Query_ClassConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Approval(ctx context.Context, in *QueryApprovalRequest, opts ...grpc.CallOption) (*QueryApprovalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryApprovalResponse)
+	err := c.cc.Invoke(ctx, Query_Approval_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OperatorApprovals(ctx context.Context, in *QueryOperatorApprovalsRequest, opts ...grpc.CallOption) (*QueryOperatorApprovalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryOperatorApprovalsResponse)
+	err := c.cc.Invoke(ctx, Query_OperatorApprovals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryClassesResponse)
@@ -223,6 +262,12 @@ type QueryServer interface {
 	Class(context.Context, *QueryClassRequest) (*QueryClassResponse, error)
 	// Class queries an NFT class based on its id
 	ClassByQueryString(context.Context, *QueryClassByQueryStringRequest) (*QueryClassByQueryStringResponse, error)
+	// ClassConfig queries the configuration of an NFT class created with Msg/CreateClass
+	ClassConfig(context.Context, *QueryClassConfigRequest) (*QueryClassConfigResponse, error)
+	// Approval queries the spender approved to transfer an NFT
+	Approval(context.Context, *QueryApprovalRequest) (*QueryApprovalResponse, error)
+	// OperatorApprovals queries the operators approved to transfer all the NFTs of an owner
+	OperatorApprovals(context.Context, *QueryOperatorApprovalsRequest) (*QueryOperatorApprovalsResponse, error)
 	// Classes queries all NFT classes
 	Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error)
 	mustEmbedUnimplementedQueryServer()
@@ -268,6 +313,15 @@ func (UnimplementedQueryServer) Class(context.Context, *QueryClassRequest) (*Que
 func (UnimplementedQueryServer) ClassByQueryString(context.Context, *QueryClassByQueryStringRequest) (*QueryClassByQueryStringResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClassByQueryString not implemented")
 }
+func (UnimplementedQueryServer) ClassConfig(context.Context, *QueryClassConfigRequest) (*QueryClassConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassConfig not implemented")
+}
+func (UnimplementedQueryServer) Approval(context.Context, *QueryApprovalRequest) (*QueryApprovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Approval not implemented")
+}
+func (UnimplementedQueryServer) OperatorApprovals(context.Context, *QueryOperatorApprovalsRequest) (*QueryOperatorApprovalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorApprovals not implemented")
+}
 func (UnimplementedQueryServer) Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Classes not implemented")
 }
@@ -490,6 +544,60 @@ func _Query_ClassByQueryString_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClassConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ClassConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassConfig(ctx, req.(*QueryClassConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Approval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Approval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Approval_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Approval(ctx, req.(*QueryApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OperatorApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorApprovalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OperatorApprovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OperatorApprovals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperatorApprovals(ctx, req.(*QueryOperatorApprovalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Classes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryClassesRequest)
 	if err := dec(in); err != nil {
@@ -559,6 +667,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClassByQueryString",
 			Handler:    _Query_ClassByQueryString_Handler,
 		},
+		{
+			MethodName: "ClassConfig",
+			Handler:    _Query_ClassConfig_Handler,
+		},
+		{
+			MethodName: "Approval",
+			Handler:    _Query_Approval_Handler,
+		},
+		{
+			MethodName: "OperatorApprovals",
+			Handler:    _Query_OperatorApprovals_Handler,
+		},
 		{
 			MethodName: "Classes",
 			Handler:    _Query_Classes_Handler,
@@ -19,8 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_CommunityPool_FullMethodName   = "/cosmos.protocolpool.v1.Query/CommunityPool"
-	Query_UnclaimedBudget_FullMethodName = "/cosmos.protocolpool.v1.Query/UnclaimedBudget"
+	Query_CommunityPool_FullMethodName      = "/cosmos.protocolpool.v1.Query/CommunityPool"
+	Query_UnclaimedBudget_FullMethodName    = "/cosmos.protocolpool.v1.Query/UnclaimedBudget"
+	Query_Stream_FullMethodName             = "/cosmos.protocolpool.v1.Query/Stream"
+	Query_StreamsByPayer_FullMethodName     = "/cosmos.protocolpool.v1.Query/StreamsByPayer"
+	Query_StreamsByRecipient_FullMethodName = "/cosmos.protocolpool.v1.Query/StreamsByRecipient"
 )
 
 // QueryClient is the client API for Query service.
@@ -33,6 +36,12 @@ type QueryClient interface {
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
 	// UnclaimedBudget queries the remaining budget left to be claimed and it gives overall budget allocation view.
 	UnclaimedBudget(ctx context.Context, in *QueryUnclaimedBudgetRequest, opts ...grpc.CallOption) (*QueryUnclaimedBudgetResponse, error)
+	// Stream queries a stream by its identifier, with its claimable amount.
+	Stream(ctx context.Context, in *QueryStreamRequest, opts ...grpc.CallOption) (*QueryStreamResponse, error)
+	// StreamsByPayer queries the streams paid by an account.
+	StreamsByPayer(ctx context.Context, in *QueryStreamsByPayerRequest, opts ...grpc.CallOption) (*QueryStreamsByPayerResponse, error)
+	// StreamsByRecipient queries the streams received by an account.
+	StreamsByRecipient(ctx context.Context, in *QueryStreamsByRecipientRequest, opts ...grpc.CallOption) (*QueryStreamsByRecipientResponse, error)
 }
 
 type queryClient struct {
@@ -63,6 +72,36 @@ func (c *queryClient) UnclaimedBudget(ctx context.Context, in *QueryUnclaimedBud
 	return out, nil
 }
 
+func (c *queryClient) Stream(ctx context.Context, in *QueryStreamRequest, opts ...grpc.CallOption) (*QueryStreamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryStreamResponse)
+	err := c.cc.Invoke(ctx, Query_Stream_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StreamsByPayer(ctx context.Context, in *QueryStreamsByPayerRequest, opts ...grpc.CallOption) (*QueryStreamsByPayerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryStreamsByPayerResponse)
+	err := c.cc.Invoke(ctx, Query_StreamsByPayer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StreamsByRecipient(ctx context.Context, in *QueryStreamsByRecipientRequest, opts ...grpc.CallOption) (*QueryStreamsByRecipientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryStreamsByRecipientResponse)
+	err := c.cc.Invoke(ctx, Query_StreamsByRecipient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -73,6 +112,12 @@ type QueryServer interface {
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
 	// UnclaimedBudget queries the remaining budget left to be claimed and it gives overall budget allocation view.
 	UnclaimedBudget(context.Context, *QueryUnclaimedBudgetRequest) (*QueryUnclaimedBudgetResponse, error)
+	// Stream queries a stream by its identifier, with its claimable amount.
+	Stream(context.Context, *QueryStreamRequest) (*QueryStreamResponse, error)
+	// StreamsByPayer queries the streams paid by an account.
+	StreamsByPayer(context.Context, *QueryStreamsByPayerRequest) (*QueryStreamsByPayerResponse, error)
+	// StreamsByRecipient queries the streams received by an account.
+	StreamsByRecipient(context.Context, *QueryStreamsByRecipientRequest) (*QueryStreamsByRecipientResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -89,6 +134,15 @@ func (UnimplementedQueryServer) CommunityPool(context.Context, *QueryCommunityPo
 func (UnimplementedQueryServer) UnclaimedBudget(context.Context, *QueryUnclaimedBudgetRequest) (*QueryUnclaimedBudgetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnclaimedBudget not implemented")
 }
+func (UnimplementedQueryServer) Stream(context.Context, *QueryStreamRequest) (*QueryStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stream not implemented")
+}
+func (UnimplementedQueryServer) StreamsByPayer(context.Context, *QueryStreamsByPayerRequest) (*QueryStreamsByPayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamsByPayer not implemented")
+}
+func (UnimplementedQueryServer) StreamsByRecipient(context.Context, *QueryStreamsByRecipientRequest) (*QueryStreamsByRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamsByRecipient not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -146,6 +200,60 @@ func _Query_UnclaimedBudget_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Stream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Stream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Stream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Stream(ctx, req.(*QueryStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StreamsByPayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamsByPayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamsByPayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StreamsByPayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamsByPayer(ctx, req.(*QueryStreamsByPayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StreamsByRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamsByRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamsByRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StreamsByRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamsByRecipient(ctx, req.(*QueryStreamsByRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -161,6 +269,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnclaimedBudget",
 			Handler:    _Query_UnclaimedBudget_Handler,
 		},
+		{
+			MethodName: "Stream",
+			Handler:    _Query_Stream_Handler,
+		},
+		{
+			MethodName: "StreamsByPayer",
+			Handler:    _Query_StreamsByPayer_Handler,
+		},
+		{
+			MethodName: "StreamsByRecipient",
+			Handler:    _Query_StreamsByRecipient_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/protocolpool/v1/query.proto",
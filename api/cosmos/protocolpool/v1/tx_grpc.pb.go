@@ -26,6 +26,9 @@ const (
 	Msg_CreateContinuousFund_FullMethodName   = "/cosmos.protocolpool.v1.Msg/CreateContinuousFund"
 	Msg_WithdrawContinuousFund_FullMethodName = "/cosmos.protocolpool.v1.Msg/WithdrawContinuousFund"
 	Msg_CancelContinuousFund_FullMethodName   = "/cosmos.protocolpool.v1.Msg/CancelContinuousFund"
+	Msg_CreateStream_FullMethodName           = "/cosmos.protocolpool.v1.Msg/CreateStream"
+	Msg_ClaimStream_FullMethodName            = "/cosmos.protocolpool.v1.Msg/ClaimStream"
+	Msg_CancelStream_FullMethodName           = "/cosmos.protocolpool.v1.Msg/CancelStream"
 )
 
 // MsgClient is the client API for Msg service.
@@ -52,6 +55,13 @@ type MsgClient interface {
 	WithdrawContinuousFund(ctx context.Context, in *MsgWithdrawContinuousFund, opts ...grpc.CallOption) (*MsgWithdrawContinuousFundResponse, error)
 	// CancelContinuousFund defines a method for cancelling continuous fund.
 	CancelContinuousFund(ctx context.Context, in *MsgCancelContinuousFund, opts ...grpc.CallOption) (*MsgCancelContinuousFundResponse, error)
+	// CreateStream defines a method for any account to stream a payment to a
+	// recipient, escrowed from its balance.
+	CreateStream(ctx context.Context, in *MsgCreateStream, opts ...grpc.CallOption) (*MsgCreateStreamResponse, error)
+	// ClaimStream defines a method for the recipient to claim the accrued amount of a stream.
+	ClaimStream(ctx context.Context, in *MsgClaimStream, opts ...grpc.CallOption) (*MsgClaimStreamResponse, error)
+	// CancelStream defines a method for cancelling a stream.
+	CancelStream(ctx context.Context, in *MsgCancelStream, opts ...grpc.CallOption) (*MsgCancelStreamResponse, error)
 }
 
 type msgClient struct {
@@ -132,6 +142,36 @@ func (c *msgClient) CancelContinuousFund(ctx context.Context, in *MsgCancelConti
 	return out, nil
 }
 
+func (c *msgClient) CreateStream(ctx context.Context, in *MsgCreateStream, opts ...grpc.CallOption) (*MsgCreateStreamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCreateStreamResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateStream_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimStream(ctx context.Context, in *MsgClaimStream, opts ...grpc.CallOption) (*MsgClaimStreamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgClaimStreamResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimStream_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelStream(ctx context.Context, in *MsgCancelStream, opts ...grpc.CallOption) (*MsgCancelStreamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelStreamResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelStream_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -156,6 +196,13 @@ type MsgServer interface {
 	WithdrawContinuousFund(context.Context, *MsgWithdrawContinuousFund) (*MsgWithdrawContinuousFundResponse, error)
 	// CancelContinuousFund defines a method for cancelling continuous fund.
 	CancelContinuousFund(context.Context, *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error)
+	// CreateStream defines a method for any account to stream a payment to a
+	// recipient, escrowed from its balance.
+	CreateStream(context.Context, *MsgCreateStream) (*MsgCreateStreamResponse, error)
+	// ClaimStream defines a method for the recipient to claim the accrued amount of a stream.
+	ClaimStream(context.Context, *MsgClaimStream) (*MsgClaimStreamResponse, error)
+	// CancelStream defines a method for cancelling a stream.
+	CancelStream(context.Context, *MsgCancelStream) (*MsgCancelStreamResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -187,6 +234,15 @@ func (UnimplementedMsgServer) WithdrawContinuousFund(context.Context, *MsgWithdr
 func (UnimplementedMsgServer) CancelContinuousFund(context.Context, *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelContinuousFund not implemented")
 }
+func (UnimplementedMsgServer) CreateStream(context.Context, *MsgCreateStream) (*MsgCreateStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStream not implemented")
+}
+func (UnimplementedMsgServer) ClaimStream(context.Context, *MsgClaimStream) (*MsgClaimStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimStream not implemented")
+}
+func (UnimplementedMsgServer) CancelStream(context.Context, *MsgCancelStream) (*MsgCancelStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelStream not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -334,6 +390,60 @@ func _Msg_CancelContinuousFund_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateStream(ctx, req.(*MsgCreateStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimStream(ctx, req.(*MsgClaimStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelStream(ctx, req.(*MsgCancelStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -369,6 +479,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelContinuousFund",
 			Handler:    _Msg_CancelContinuousFund_Handler,
 		},
+		{
+			MethodName: "CreateStream",
+			Handler:    _Msg_CreateStream_Handler,
+		},
+		{
+			MethodName: "ClaimStream",
+			Handler:    _Msg_ClaimStream_Handler,
+		},
+		{
+			MethodName: "CancelStream",
+			Handler:    _Msg_CancelStream_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/protocolpool/v1/tx.proto",
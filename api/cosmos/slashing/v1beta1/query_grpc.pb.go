@@ -19,9 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Params_FullMethodName       = "/cosmos.slashing.v1beta1.Query/Params"
-	Query_SigningInfo_FullMethodName  = "/cosmos.slashing.v1beta1.Query/SigningInfo"
-	Query_SigningInfos_FullMethodName = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_Params_FullMethodName            = "/cosmos.slashing.v1beta1.Query/Params"
+	Query_SigningInfo_FullMethodName       = "/cosmos.slashing.v1beta1.Query/SigningInfo"
+	Query_SigningInfos_FullMethodName      = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_InfractionHistory_FullMethodName = "/cosmos.slashing.v1beta1.Query/InfractionHistory"
 )
 
 // QueryClient is the client API for Query service.
@@ -36,6 +37,9 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// InfractionHistory queries the infractions a validator was slashed for,
+	// oldest first.
+	InfractionHistory(ctx context.Context, in *QueryInfractionHistoryRequest, opts ...grpc.CallOption) (*QueryInfractionHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -76,6 +80,16 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) InfractionHistory(ctx context.Context, in *QueryInfractionHistoryRequest, opts ...grpc.CallOption) (*QueryInfractionHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryInfractionHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_InfractionHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -88,6 +102,9 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// InfractionHistory queries the infractions a validator was slashed for,
+	// oldest first.
+	InfractionHistory(context.Context, *QueryInfractionHistoryRequest) (*QueryInfractionHistoryResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -107,6 +124,9 @@ func (UnimplementedQueryServer) SigningInfo(context.Context, *QuerySigningInfoRe
 func (UnimplementedQueryServer) SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (UnimplementedQueryServer) InfractionHistory(context.Context, *QueryInfractionHistoryRequest) (*QueryInfractionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InfractionHistory not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -182,6 +202,24 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InfractionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInfractionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InfractionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_InfractionHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InfractionHistory(ctx, req.(*QueryInfractionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -201,6 +239,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "InfractionHistory",
+			Handler:    _Query_InfractionHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
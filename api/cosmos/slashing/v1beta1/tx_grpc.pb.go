@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_Unjail_FullMethodName       = "/cosmos.slashing.v1beta1.Msg/Unjail"
-	Msg_UpdateParams_FullMethodName = "/cosmos.slashing.v1beta1.Msg/UpdateParams"
+	Msg_Unjail_FullMethodName          = "/cosmos.slashing.v1beta1.Msg/Unjail"
+	Msg_UpdateParams_FullMethodName    = "/cosmos.slashing.v1beta1.Msg/UpdateParams"
+	Msg_RevertTombstone_FullMethodName = "/cosmos.slashing.v1beta1.Msg/RevertTombstone"
 )
 
 // MsgClient is the client API for Msg service.
@@ -36,6 +37,12 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/slashing module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RevertTombstone defines a governance operation for reverting the tombstoning
+	// of a validator proven to be a false positive, e.g. after a key rotation mishap.
+	// The tokens slashed for the infraction are refunded to the delegators of the
+	// validator from the community pool. The authority defaults to the x/gov module
+	// account.
+	RevertTombstone(ctx context.Context, in *MsgRevertTombstone, opts ...grpc.CallOption) (*MsgRevertTombstoneResponse, error)
 }
 
 type msgClient struct {
@@ -66,6 +73,16 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RevertTombstone(ctx context.Context, in *MsgRevertTombstone, opts ...grpc.CallOption) (*MsgRevertTombstoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRevertTombstoneResponse)
+	err := c.cc.Invoke(ctx, Msg_RevertTombstone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -79,6 +96,12 @@ type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/slashing module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RevertTombstone defines a governance operation for reverting the tombstoning
+	// of a validator proven to be a false positive, e.g. after a key rotation mishap.
+	// The tokens slashed for the infraction are refunded to the delegators of the
+	// validator from the community pool. The authority defaults to the x/gov module
+	// account.
+	RevertTombstone(context.Context, *MsgRevertTombstone) (*MsgRevertTombstoneResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -95,6 +118,9 @@ func (UnimplementedMsgServer) Unjail(context.Context, *MsgUnjail) (*MsgUnjailRes
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) RevertTombstone(context.Context, *MsgRevertTombstone) (*MsgRevertTombstoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertTombstone not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -152,6 +178,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RevertTombstone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevertTombstone)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevertTombstone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevertTombstone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevertTombstone(ctx, req.(*MsgRevertTombstone))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -167,6 +211,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RevertTombstone",
+			Handler:    _Msg_RevertTombstone_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/tx.proto",
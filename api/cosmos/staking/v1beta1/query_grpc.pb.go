@@ -33,6 +33,7 @@ const (
 	Query_HistoricalInfo_FullMethodName                = "/cosmos.staking.v1beta1.Query/HistoricalInfo"
 	Query_Pool_FullMethodName                          = "/cosmos.staking.v1beta1.Query/Pool"
 	Query_Params_FullMethodName                        = "/cosmos.staking.v1beta1.Query/Params"
+	Query_ValidatorScheduledCommission_FullMethodName  = "/cosmos.staking.v1beta1.Query/ValidatorScheduledCommission"
 )
 
 // QueryClient is the client API for Query service.
@@ -95,6 +96,8 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ValidatorScheduledCommission queries the scheduled commission change of a validator.
+	ValidatorScheduledCommission(ctx context.Context, in *QueryValidatorScheduledCommissionRequest, opts ...grpc.CallOption) (*QueryValidatorScheduledCommissionResponse, error)
 }
 
 type queryClient struct {
@@ -246,6 +249,16 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ValidatorScheduledCommission(ctx context.Context, in *QueryValidatorScheduledCommissionRequest, opts ...grpc.CallOption) (*QueryValidatorScheduledCommissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryValidatorScheduledCommissionResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorScheduledCommission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -306,6 +319,8 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ValidatorScheduledCommission queries the scheduled commission change of a validator.
+	ValidatorScheduledCommission(context.Context, *QueryValidatorScheduledCommissionRequest) (*QueryValidatorScheduledCommissionResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -358,6 +373,9 @@ func (UnimplementedQueryServer) Pool(context.Context, *QueryPoolRequest) (*Query
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) ValidatorScheduledCommission(context.Context, *QueryValidatorScheduledCommissionRequest) (*QueryValidatorScheduledCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorScheduledCommission not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -631,6 +649,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorScheduledCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorScheduledCommissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorScheduledCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorScheduledCommission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorScheduledCommission(ctx, req.(*QueryValidatorScheduledCommissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -694,6 +730,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "ValidatorScheduledCommission",
+			Handler:    _Query_ValidatorScheduledCommission_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
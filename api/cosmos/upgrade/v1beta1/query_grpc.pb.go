@@ -24,6 +24,7 @@ const (
 	Query_UpgradedConsensusState_FullMethodName = "/cosmos.upgrade.v1beta1.Query/UpgradedConsensusState"
 	Query_ModuleVersions_FullMethodName         = "/cosmos.upgrade.v1beta1.Query/ModuleVersions"
 	Query_Authority_FullMethodName              = "/cosmos.upgrade.v1beta1.Query/Authority"
+	Query_UpgradeReadiness_FullMethodName       = "/cosmos.upgrade.v1beta1.Query/UpgradeReadiness"
 )
 
 // QueryClient is the client API for Query service.
@@ -48,6 +49,9 @@ type QueryClient interface {
 	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error)
+	// UpgradeReadiness returns the validators ready for the current upgrade plan, and
+	// their share of the voting power.
+	UpgradeReadiness(ctx context.Context, in *QueryUpgradeReadinessRequest, opts ...grpc.CallOption) (*QueryUpgradeReadinessResponse, error)
 }
 
 type queryClient struct {
@@ -109,6 +113,16 @@ func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest,
 	return out, nil
 }
 
+func (c *queryClient) UpgradeReadiness(ctx context.Context, in *QueryUpgradeReadinessRequest, opts ...grpc.CallOption) (*QueryUpgradeReadinessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryUpgradeReadinessResponse)
+	err := c.cc.Invoke(ctx, Query_UpgradeReadiness_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -131,6 +145,9 @@ type QueryServer interface {
 	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error)
+	// UpgradeReadiness returns the validators ready for the current upgrade plan, and
+	// their share of the voting power.
+	UpgradeReadiness(context.Context, *QueryUpgradeReadinessRequest) (*QueryUpgradeReadinessResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -156,6 +173,9 @@ func (UnimplementedQueryServer) ModuleVersions(context.Context, *QueryModuleVers
 func (UnimplementedQueryServer) Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
+func (UnimplementedQueryServer) UpgradeReadiness(context.Context, *QueryUpgradeReadinessRequest) (*QueryUpgradeReadinessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeReadiness not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -267,6 +287,24 @@ func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UpgradeReadiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpgradeReadinessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpgradeReadiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UpgradeReadiness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpgradeReadiness(ctx, req.(*QueryUpgradeReadinessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -294,6 +332,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
 		},
+		{
+			MethodName: "UpgradeReadiness",
+			Handler:    _Query_UpgradeReadiness_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
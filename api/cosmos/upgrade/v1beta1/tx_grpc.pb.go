@@ -21,8 +21,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_SoftwareUpgrade_FullMethodName = "/cosmos.upgrade.v1beta1.Msg/SoftwareUpgrade"
-	Msg_CancelUpgrade_FullMethodName   = "/cosmos.upgrade.v1beta1.Msg/CancelUpgrade"
+	Msg_SoftwareUpgrade_FullMethodName        = "/cosmos.upgrade.v1beta1.Msg/SoftwareUpgrade"
+	Msg_CancelUpgrade_FullMethodName          = "/cosmos.upgrade.v1beta1.Msg/CancelUpgrade"
+	Msg_SignalUpgradeReadiness_FullMethodName = "/cosmos.upgrade.v1beta1.Msg/SignalUpgradeReadiness"
 )
 
 // MsgClient is the client API for Msg service.
@@ -36,6 +37,9 @@ type MsgClient interface {
 	// CancelUpgrade is a governance operation for cancelling a previously
 	// approved software upgrade.
 	CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opts ...grpc.CallOption) (*MsgCancelUpgradeResponse, error)
+	// SignalUpgradeReadiness is used by a validator to signal it is ready for the
+	// scheduled upgrade, having installed and verified its binary.
+	SignalUpgradeReadiness(ctx context.Context, in *MsgSignalUpgradeReadiness, opts ...grpc.CallOption) (*MsgSignalUpgradeReadinessResponse, error)
 }
 
 type msgClient struct {
@@ -66,6 +70,16 @@ func (c *msgClient) CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opt
 	return out, nil
 }
 
+func (c *msgClient) SignalUpgradeReadiness(ctx context.Context, in *MsgSignalUpgradeReadiness, opts ...grpc.CallOption) (*MsgSignalUpgradeReadinessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSignalUpgradeReadinessResponse)
+	err := c.cc.Invoke(ctx, Msg_SignalUpgradeReadiness_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -77,6 +91,9 @@ type MsgServer interface {
 	// CancelUpgrade is a governance operation for cancelling a previously
 	// approved software upgrade.
 	CancelUpgrade(context.Context, *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error)
+	// SignalUpgradeReadiness is used by a validator to signal it is ready for the
+	// scheduled upgrade, having installed and verified its binary.
+	SignalUpgradeReadiness(context.Context, *MsgSignalUpgradeReadiness) (*MsgSignalUpgradeReadinessResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -93,6 +110,9 @@ func (UnimplementedMsgServer) SoftwareUpgrade(context.Context, *MsgSoftwareUpgra
 func (UnimplementedMsgServer) CancelUpgrade(context.Context, *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelUpgrade not implemented")
 }
+func (UnimplementedMsgServer) SignalUpgradeReadiness(context.Context, *MsgSignalUpgradeReadiness) (*MsgSignalUpgradeReadinessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalUpgradeReadiness not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -150,6 +170,24 @@ func _Msg_CancelUpgrade_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SignalUpgradeReadiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSignalUpgradeReadiness)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SignalUpgradeReadiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SignalUpgradeReadiness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SignalUpgradeReadiness(ctx, req.(*MsgSignalUpgradeReadiness))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -165,6 +203,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelUpgrade",
 			Handler:    _Msg_CancelUpgrade_Handler,
 		},
+		{
+			MethodName: "SignalUpgradeReadiness",
+			Handler:    _Msg_SignalUpgradeReadiness_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/tx.proto",
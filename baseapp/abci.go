@@ -12,6 +12,8 @@ import (
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	"github.com/cosmos/gogoproto/proto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
 
@@ -438,6 +440,10 @@ func (app *BaseApp) PrepareProposal(req *abci.PrepareProposalRequest) (resp *abc
 		WithConsensusParams(app.GetConsensusParams(app.prepareProposalState.Context())).
 		WithBlockGasMeter(app.getBlockGasMeter(app.prepareProposalState.Context())))
 
+	ctx, span := startSpan(app.prepareProposalState.Context(), "PrepareProposal", attribute.Int64("height", req.Height))
+	app.prepareProposalState.SetContext(ctx)
+	defer span.End()
+
 	defer func() {
 		if err := recover(); err != nil {
 			app.logger.Error(
@@ -454,6 +460,7 @@ func (app *BaseApp) PrepareProposal(req *abci.PrepareProposalRequest) (resp *abc
 	resp, err = app.prepareProposal(app.prepareProposalState.Context(), req)
 	if err != nil {
 		app.logger.Error("failed to prepare proposal", "height", req.Height, "time", req.Time, "err", err)
+		span.RecordError(err)
 		return &abci.PrepareProposalResponse{Txs: req.Txs}, nil
 	}
 
@@ -532,6 +539,10 @@ func (app *BaseApp) ProcessProposal(req *abci.ProcessProposalRequest) (resp *abc
 		WithConsensusParams(app.GetConsensusParams(app.processProposalState.Context())).
 		WithBlockGasMeter(app.getBlockGasMeter(app.processProposalState.Context())))
 
+	ctx, span := startSpan(app.processProposalState.Context(), "ProcessProposal", attribute.Int64("height", req.Height))
+	app.processProposalState.SetContext(ctx)
+	defer span.End()
+
 	defer func() {
 		if err := recover(); err != nil {
 			app.logger.Error(
@@ -548,6 +559,7 @@ func (app *BaseApp) ProcessProposal(req *abci.ProcessProposalRequest) (resp *abc
 	resp, err = app.processProposal(app.processProposalState.Context(), req)
 	if err != nil {
 		app.logger.Error("failed to process proposal", "height", req.Height, "time", req.Time, "hash", fmt.Sprintf("%X", req.Hash), "err", err)
+		span.RecordError(err)
 		return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_REJECT}, nil
 	}
 
@@ -725,9 +737,15 @@ func (app *BaseApp) VerifyVoteExtension(req *abci.VerifyVoteExtensionRequest) (r
 // Execution flow or by the FinalizeBlock ABCI method. The context received is
 // only used to handle early cancellation, for anything related to state app.finalizeBlockState.Context()
 // must be used.
-func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.FinalizeBlockRequest) (*abci.FinalizeBlockResponse, error) {
+func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.FinalizeBlockRequest) (_ *abci.FinalizeBlockResponse, err error) {
 	var events []abci.Event
 
+	ctx, span := tracer.Start(ctx, "FinalizeBlock", trace.WithAttributes(
+		attribute.Int64("height", req.Height),
+		attribute.Int("txs", len(req.Txs)),
+	))
+	defer func() { endSpan(span, err) }()
+
 	if err := app.checkHalt(req.Height, req.Time); err != nil {
 		return nil, err
 	}
@@ -783,6 +801,14 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Finaliz
 	gasMeter := app.getBlockGasMeter(app.finalizeBlockState.Context())
 	app.finalizeBlockState.SetContext(app.finalizeBlockState.Context().WithBlockGasMeter(gasMeter))
 
+	// The block span is carried by the state context, so that the spans of the
+	// block phases and of the transactions are recorded as its children.
+	// The early cancellation of ctx must not leak into the state context.
+	if span.IsRecording() {
+		app.finalizeBlockState.SetContext(app.finalizeBlockState.Context().
+			WithContext(trace.ContextWithSpan(app.finalizeBlockState.Context().Context(), span)))
+	}
+
 	if app.checkState != nil {
 		app.checkState.SetContext(app.checkState.Context().
 			WithBlockGasMeter(gasMeter).
@@ -822,6 +848,10 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Finaliz
 	// vote extensions, so skip those.
 	txResults := make([]*abci.ExecTxResult, 0, len(req.Txs))
 	for _, rawTx := range req.Txs {
+		if app.injectedTxMatcher != nil && app.injectedTxMatcher(rawTx) {
+			txResults = append(txResults, &abci.ExecTxResult{})
+			continue
+		}
 
 		response := app.deliverTx(rawTx)
 
@@ -942,13 +972,23 @@ func (app *BaseApp) Commit() (*abci.CommitResponse, error) {
 	header := app.finalizeBlockState.Context().BlockHeader()
 	retainHeight := app.GetBlockRetentionHeight(header.Height)
 
+	goCtx, span := tracer.Start(context.Background(), "Commit", trace.WithAttributes(attribute.Int64("height", header.Height)))
+	defer span.End()
+
 	if app.precommiter != nil {
-		app.precommiter(app.finalizeBlockState.Context())
+		ctx := app.finalizeBlockState.Context()
+		if span.IsRecording() {
+			ctx = ctx.WithContext(trace.ContextWithSpan(ctx.Context(), span))
+		}
+		ctx, precommitSpan := startSpan(ctx, "Precommit")
+		app.precommiter(ctx)
+		precommitSpan.End()
 	}
 
 	rms, ok := app.cms.(*rootmulti.Store)
 	if ok {
 		rms.SetCommitHeader(header)
+		rms.SetCommitContext(goCtx)
 	}
 
 	app.cms.Commit()
@@ -686,6 +686,29 @@ func TestABCI_FinalizeBlock_DeliverTx(t *testing.T) {
 	}
 }
 
+func TestABCI_FinalizeBlock_InjectedTx(t *testing.T) {
+	injected := []byte("\x00injected")
+	injectedOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetInjectedTxMatcher(func(tx []byte) bool { return bytes.HasPrefix(tx, injected) })
+	}
+	suite := NewBaseAppSuite(t, injectedOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	// the injected data is not delivered, while the other undecodable txs still fail
+	res, err := suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{
+		Height: 1,
+		Txs:    [][]byte{injected, []byte("\x00invalid")},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.TxResults, 2)
+	require.Equal(t, &abci.ExecTxResult{}, res.TxResults[0])
+	require.False(t, res.TxResults[1].IsOK())
+}
+
 func TestABCI_FinalizeBlock_MultiMsg(t *testing.T) {
 	anteKey := []byte("ante-key")
 	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
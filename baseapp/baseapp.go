@@ -14,6 +14,8 @@ import (
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	"github.com/cosmos/gogoproto/proto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"cosmossdk.io/core/header"
@@ -91,6 +93,7 @@ type BaseApp struct {
 	prepareCheckStater sdk.PrepareCheckStater         // logic to run during commit using the checkState
 	precommiter        sdk.Precommiter                // logic to run during commit using the deliverState
 	versionModifier    server.VersionModifier         // interface to get and set the app version
+	injectedTxMatcher  func(tx []byte) bool           // matches the non-transaction data injected in proposals
 
 	addrPeerFilter sdk.PeerFilter // filter peers by address and port
 	idPeerFilter   sdk.PeerFilter // filter peers by node ID
@@ -707,7 +710,10 @@ func (app *BaseApp) preBlock(req *abci.FinalizeBlockRequest) ([]abci.Event, erro
 	var events []abci.Event
 	if app.preBlocker != nil {
 		ctx := app.finalizeBlockState.Context().WithEventManager(sdk.NewEventManager())
-		if err := app.preBlocker(ctx, req); err != nil {
+		spanCtx, span := startSpan(ctx, "PreBlock")
+		err := app.preBlocker(spanCtx, req)
+		endSpan(span, err)
+		if err != nil {
 			return nil, err
 		}
 		// ConsensusParams can change in preblocker, so we need to
@@ -729,7 +735,9 @@ func (app *BaseApp) beginBlock(_ *abci.FinalizeBlockRequest) (sdk.BeginBlock, er
 	)
 
 	if app.beginBlocker != nil {
-		resp, err = app.beginBlocker(app.finalizeBlockState.Context())
+		ctx, span := startSpan(app.finalizeBlockState.Context(), "BeginBlock")
+		resp, err = app.beginBlocker(ctx)
+		endSpan(span, err)
 		if err != nil {
 			return resp, err
 		}
@@ -791,7 +799,9 @@ func (app *BaseApp) endBlock(_ context.Context) (sdk.EndBlock, error) {
 	var endblock sdk.EndBlock
 
 	if app.endBlocker != nil {
-		eb, err := app.endBlocker(app.finalizeBlockState.Context())
+		ctx, span := startSpan(app.finalizeBlockState.Context(), "EndBlock")
+		eb, err := app.endBlocker(ctx)
+		endSpan(span, err)
 		if err != nil {
 			return endblock, err
 		}
@@ -828,7 +838,12 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 	// meter, so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
+	ctx, span := startSpan(app.getContextForTx(mode, txBytes), txSpanNames[mode])
+	if span.IsRecording() {
+		span.SetAttributes(attribute.String("tx.hash", fmt.Sprintf("%X", tmhash.Sum(txBytes))))
+	}
+	defer func() { endSpan(span, err) }()
+
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -902,7 +917,9 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		if mode == execModeSimulate {
 			anteCtx = anteCtx.WithExecMode(sdk.ExecMode(execModeSimulate))
 		}
+		anteCtx, anteSpan := startSpan(anteCtx, "AnteHandler")
 		newCtx, err := app.anteHandler(anteCtx, tx, mode == execModeSimulate)
+		endSpan(anteSpan, err)
 
 		if !newCtx.IsZero() {
 			// At this point, newCtx.MultiStore() is a store branch, or something else
@@ -911,7 +928,13 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 			// Also, in the case of the tx aborting, we need to track gas consumed via
 			// the instantiated gas meter in the AnteHandler, so we update the context
 			// prior to returning.
+			//
+			// The tx span is restored as the current span, as newCtx carries the
+			// AnteHandler span.
 			ctx = newCtx.WithMultiStore(ms)
+			if span.IsRecording() {
+				ctx = ctx.WithContext(trace.ContextWithSpan(ctx.Context(), span))
+			}
 		}
 
 		events := ctx.EventManager().Events()
@@ -1027,7 +1050,9 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, reflectMsgs []proto
 		}
 
 		// ADR 031 request type routing
-		msgResult, err := handler(ctx, msg)
+		msgCtx, msgSpan := startSpan(ctx, sdk.MsgTypeURL(msg), attribute.Int("msg_index", i))
+		msgResult, err := handler(msgCtx, msg)
+		endSpan(msgSpan, err)
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
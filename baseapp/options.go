@@ -350,6 +350,11 @@ func (app *BaseApp) SetMempool(mempool mempool.Mempool) {
 	app.mempool = mempool
 }
 
+// ProcessProposalHandler returns the process proposal function of the BaseApp.
+func (app *BaseApp) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return app.processProposal
+}
+
 // SetProcessProposal sets the process proposal function for the BaseApp.
 func (app *BaseApp) SetProcessProposal(handler sdk.ProcessProposalHandler) {
 	if app.sealed {
@@ -358,6 +363,11 @@ func (app *BaseApp) SetProcessProposal(handler sdk.ProcessProposalHandler) {
 	app.processProposal = handler
 }
 
+// PrepareProposalHandler returns the prepare proposal function of the BaseApp.
+func (app *BaseApp) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return app.prepareProposal
+}
+
 // SetPrepareProposal sets the prepare proposal function for the BaseApp.
 func (app *BaseApp) SetPrepareProposal(handler sdk.PrepareProposalHandler) {
 	if app.sealed {
@@ -383,6 +393,17 @@ func (app *BaseApp) SetVerifyVoteExtensionHandler(handler sdk.VerifyVoteExtensio
 	app.verifyVoteExt = handler
 }
 
+// SetInjectedTxMatcher sets the matcher of the non-transaction data injected in the
+// proposals, such as vote extensions. The matching transactions are not delivered in
+// FinalizeBlock, an empty successful result being returned for them.
+func (app *BaseApp) SetInjectedTxMatcher(matcher func(tx []byte) bool) {
+	if app.sealed {
+		panic("SetInjectedTxMatcher() on sealed BaseApp")
+	}
+
+	app.injectedTxMatcher = matcher
+}
+
 // SetStoreMetrics sets the prepare proposal function for the BaseApp.
 func (app *BaseApp) SetStoreMetrics(gatherer metrics.StoreMetrics) {
 	if app.sealed {
@@ -0,0 +1,51 @@
+package baseapp
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// tracer is the OpenTelemetry tracer used to trace the ABCI calls, the
+// transactions and the messages executed by BaseApp. It is a no-op unless a
+// tracer provider is registered, see telemetry.EnableTracing.
+var tracer = otel.Tracer("github.com/cosmos/cosmos-sdk/baseapp")
+
+// startSpan starts a span as a child of the span carried by ctx, if any, and
+// returns a copy of ctx carrying the new span. The context is returned
+// untouched when the span is not recorded, e.g. when tracing is disabled.
+func startSpan(ctx sdk.Context, name string, attrs ...attribute.KeyValue) (sdk.Context, trace.Span) {
+	goCtx := ctx.Context()
+	if goCtx == nil {
+		goCtx = context.Background()
+	}
+	goCtx, span := tracer.Start(goCtx, name, trace.WithAttributes(attrs...))
+	if !span.IsRecording() {
+		return ctx, span
+	}
+	return ctx.WithContext(goCtx), span
+}
+
+// endSpan records err, if any, on the span and ends it.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// txSpanNames are the names of the transaction spans by execution mode.
+var txSpanNames = map[execMode]string{
+	execModeCheck:           "CheckTx",
+	execModeReCheck:         "ReCheckTx",
+	execModeSimulate:        "SimulateTx",
+	execModePrepareProposal: "PrepareProposalTx",
+	execModeProcessProposal: "ProcessProposalTx",
+	execModeFinalize:        "DeliverTx",
+}
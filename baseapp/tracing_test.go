@@ -0,0 +1,118 @@
+package baseapp_test
+
+import (
+	"sync"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	spanRecorder     = tracetest.NewSpanRecorder()
+	spanRecorderOnce sync.Once
+)
+
+// recordSpans registers a tracer provider recording the ended spans. The
+// provider is registered once per test binary, as the package level tracers
+// only delegate to the first registered global provider.
+func recordSpans() *tracetest.SpanRecorder {
+	spanRecorderOnce.Do(func() {
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder)))
+	})
+	return spanRecorder
+}
+
+// findSpans returns the ended spans with the given name and parent.
+func findSpans(rec *tracetest.SpanRecorder, name string, parent trace.SpanContext) []sdktrace.ReadOnlySpan {
+	var spans []sdktrace.ReadOnlySpan
+	for _, s := range rec.Ended() {
+		if s.Name() == name && s.Parent().SpanID() == parent.SpanID() {
+			spans = append(spans, s)
+		}
+	}
+	return spans
+}
+
+func TestTracing_FinalizeBlockAndCommit(t *testing.T) {
+	rec := recordSpans()
+
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	blockersOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetBeginBlocker(func(sdk.Context) (sdk.BeginBlock, error) { return sdk.BeginBlock{}, nil })
+		bapp.SetEndBlocker(func(sdk.Context) (sdk.EndBlock, error) { return sdk.EndBlock{}, nil })
+	}
+	suite := NewBaseAppSuite(t, anteOpt, blockersOpt)
+
+	// start at a height no other test of the package finalizes to tell the spans apart
+	const height = 4242
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+		InitialHeight:   height,
+	})
+	require.NoError(t, err)
+
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	txs := [][]byte{}
+	for i := int64(0); i < 2; i++ {
+		txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, suite.ac, i, i))
+		require.NoError(t, err)
+		txs = append(txs, txBytes)
+	}
+
+	_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: height, Txs: txs})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	heightAttr := attribute.Int64("height", height)
+	var finalize, commit sdktrace.ReadOnlySpan
+	for _, s := range rec.Ended() {
+		if !s.Parent().IsValid() && hasAttribute(s, heightAttr) {
+			switch s.Name() {
+			case "FinalizeBlock":
+				finalize = s
+			case "Commit":
+				commit = s
+			}
+		}
+	}
+	require.NotNil(t, finalize, "missing FinalizeBlock span")
+	require.NotNil(t, commit, "missing Commit span")
+
+	require.Len(t, findSpans(rec, "BeginBlock", finalize.SpanContext()), 1)
+	require.Len(t, findSpans(rec, "EndBlock", finalize.SpanContext()), 1)
+
+	deliverTxs := findSpans(rec, "DeliverTx", finalize.SpanContext())
+	require.Len(t, deliverTxs, len(txs))
+	for _, tx := range deliverTxs {
+		require.Len(t, findSpans(rec, "AnteHandler", tx.SpanContext()), 1)
+		require.Len(t, findSpans(rec, sdk.MsgTypeURL(&baseapptestutil.MsgCounter{}), tx.SpanContext()), 1)
+	}
+
+	commitStores := findSpans(rec, "CommitMultiStore", commit.SpanContext())
+	require.Len(t, commitStores, 1)
+	require.Len(t, findSpans(rec, "CommitStores", commitStores[0].SpanContext()), 1)
+}
+
+func hasAttribute(s sdktrace.ReadOnlySpan, attr attribute.KeyValue) bool {
+	for _, a := range s.Attributes() {
+		if a == attr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+package voteext
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+)
+
+// InjectedTxPrefix prefixes the extended commit injected as the first transaction
+// of a proposal. Starting with a zero byte, it is never a valid protobuf transaction.
+var InjectedTxPrefix = []byte("\x00voteext")
+
+// IsInjectedTx returns whether tx is an extended commit injected in a proposal.
+func IsInjectedTx(tx []byte) bool {
+	return bytes.HasPrefix(tx, InjectedTxPrefix)
+}
+
+// encodeExtensions multiplexes the extensions of the handlers into a single vote extension.
+// The extensions are sorted by handler name, and each is encoded as
+// uvarint(len(name)) | name | uvarint(len(extension)) | extension.
+func encodeExtensions(exts map[string][]byte) []byte {
+	names := make([]string, 0, len(exts))
+	for name := range exts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var bz []byte
+	for _, name := range names {
+		bz = binary.AppendUvarint(bz, uint64(len(name)))
+		bz = append(bz, name...)
+		bz = binary.AppendUvarint(bz, uint64(len(exts[name])))
+		bz = append(bz, exts[name]...)
+	}
+
+	return bz
+}
+
+// decodeExtensions decodes a vote extension encoded with encodeExtensions.
+// It rejects unsorted or duplicated handler names, so that the encoding is unique.
+func decodeExtensions(bz []byte) (map[string][]byte, error) {
+	next := func() ([]byte, error) {
+		size, n := binary.Uvarint(bz)
+		if n <= 0 || uint64(len(bz)-n) < size {
+			return nil, errors.New("malformed vote extension")
+		}
+		out := bz[n : n+int(size)]
+		bz = bz[n+int(size):]
+		return out, nil
+	}
+
+	exts := make(map[string][]byte)
+	var last string
+	for len(bz) > 0 {
+		name, err := next()
+		if err != nil {
+			return nil, err
+		}
+		if len(exts) > 0 && string(name) <= last {
+			return nil, fmt.Errorf("vote extension handler %q is not sorted or duplicated", name)
+		}
+		last = string(name)
+
+		ext, err := next()
+		if err != nil {
+			return nil, err
+		}
+		exts[last] = ext
+	}
+
+	return exts, nil
+}
+
+// encodeInjectedTx encodes the extended commit injected in a proposal.
+func encodeInjectedTx(info abci.ExtendedCommitInfo) ([]byte, error) {
+	bz, err := info.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(bytes.Clone(InjectedTxPrefix), bz...), nil
+}
+
+// decodeInjectedTx decodes the extended commit injected in a proposal.
+func decodeInjectedTx(tx []byte) (abci.ExtendedCommitInfo, error) {
+	var info abci.ExtendedCommitInfo
+	bz, ok := bytes.CutPrefix(tx, InjectedTxPrefix)
+	if !ok {
+		return info, errors.New("missing injected vote extensions")
+	}
+
+	if err := info.Unmarshal(bz); err != nil {
+		return info, fmt.Errorf("failed to decode injected vote extensions: %w", err)
+	}
+
+	return info, nil
+}
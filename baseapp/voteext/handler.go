@@ -0,0 +1,130 @@
+// Package voteext provides a framework for ABCI++ vote extensions.
+//
+// Modules register typed vote extension handlers with a Manager, which:
+//   - multiplexes the extensions of all the handlers into the single vote extension of a validator,
+//   - verifies the vote extensions of the other validators with each handler,
+//   - injects the extended commit of the previous height as the first transaction of a proposal,
+//     after verifying its signatures, and verifies it when processing a proposal,
+//   - delivers the vote extensions of the previous height to each handler in PreBlock.
+//
+// The framework is specific to BaseApp, whose Manager.SetHandlers wraps the proposal handlers
+// and the PreBlocker, and skips the injected transaction on delivery. It is not supported by
+// server/v2, whose proposal handlers select decoded transactions: apps built with server/v2
+// set their vote extension and proposal handlers with the cometbft.ServerOptions instead.
+package voteext
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"github.com/cosmos/gogoproto/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Vote is the vote extension of a validator for a given handler.
+type Vote struct {
+	// Validator is the validator that signed the vote extension.
+	Validator abci.Validator
+	// Extension is the vote extension of the handler.
+	Extension []byte
+}
+
+// Handler handles the vote extensions of a module.
+type Handler interface {
+	// Name returns the unique name of the handler, under which its extension is multiplexed.
+	Name() string
+
+	// ExtendVote returns the extension of the handler for the vote of the validator.
+	// An empty extension is not included in the vote.
+	ExtendVote(ctx sdk.Context, req *abci.ExtendVoteRequest) ([]byte, error)
+
+	// VerifyVoteExtension verifies the extension of the handler in the vote of another validator.
+	VerifyVoteExtension(ctx sdk.Context, extension []byte) error
+
+	// PreBlock receives the extensions of the handler in the commit votes of the previous height,
+	// in the order of the extended commit. Validators not having included an extension for the
+	// handler are omitted. The state changes are discarded if an error is returned.
+	PreBlock(ctx sdk.Context, votes []Vote) error
+}
+
+// HasVoteExtensionHandler is implemented by the modules providing a vote extension handler.
+type HasVoteExtensionHandler interface {
+	VoteExtensionHandler() Handler
+}
+
+// TypedVote is the decoded vote extension of a validator for a TypedHandler.
+type TypedVote[E any] struct {
+	Validator abci.Validator
+	Extension E
+}
+
+// TypedHandler is a Handler for protobuf encoded extensions of type E.
+// Extensions that cannot be decoded are rejected by VerifyVoteExtension and skipped in PreBlock.
+type TypedHandler[E any, PE interface {
+	*E
+	proto.Message
+}] struct {
+	// HandlerName is the unique name of the handler.
+	HandlerName string
+	// Extend returns the extension of the validator. A nil extension is not included in the vote.
+	Extend func(ctx sdk.Context, req *abci.ExtendVoteRequest) (PE, error)
+	// Verify verifies the extension of another validator. It is optional.
+	Verify func(ctx sdk.Context, ext PE) error
+	// Aggregate receives the decoded extensions of the previous height.
+	Aggregate func(ctx sdk.Context, votes []TypedVote[PE]) error
+}
+
+// Name implements Handler.
+func (h TypedHandler[E, PE]) Name() string {
+	return h.HandlerName
+}
+
+// ExtendVote implements Handler.
+func (h TypedHandler[E, PE]) ExtendVote(ctx sdk.Context, req *abci.ExtendVoteRequest) ([]byte, error) {
+	ext, err := h.Extend(ctx, req)
+	if err != nil || ext == nil {
+		return nil, err
+	}
+
+	return proto.Marshal(ext)
+}
+
+// VerifyVoteExtension implements Handler.
+func (h TypedHandler[E, PE]) VerifyVoteExtension(ctx sdk.Context, extension []byte) error {
+	ext, err := h.decode(extension)
+	if err != nil {
+		return err
+	}
+
+	if h.Verify == nil {
+		return nil
+	}
+
+	return h.Verify(ctx, ext)
+}
+
+// PreBlock implements Handler.
+func (h TypedHandler[E, PE]) PreBlock(ctx sdk.Context, votes []Vote) error {
+	typed := make([]TypedVote[PE], 0, len(votes))
+	for _, vote := range votes {
+		ext, err := h.decode(vote.Extension)
+		if err != nil {
+			ctx.Logger().Error("skipping invalid vote extension", "handler", h.HandlerName, "validator", sdk.ConsAddress(vote.Validator.Address), "err", err)
+			continue
+		}
+
+		typed = append(typed, TypedVote[PE]{Validator: vote.Validator, Extension: ext})
+	}
+
+	return h.Aggregate(ctx, typed)
+}
+
+func (h TypedHandler[E, PE]) decode(bz []byte) (PE, error) {
+	ext := PE(new(E))
+	if err := proto.Unmarshal(bz, ext); err != nil {
+		return nil, fmt.Errorf("failed to decode %s vote extension %T: %w", h.HandlerName, ext, err)
+	}
+
+	return ext, nil
+}
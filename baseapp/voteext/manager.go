@@ -0,0 +1,257 @@
+package voteext
+
+import (
+	"fmt"
+	"sort"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+
+	"cosmossdk.io/core/appmodule"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Manager multiplexes the vote extension handlers and implements the ABCI handlers
+// extending, verifying, injecting and delivering their vote extensions.
+type Manager struct {
+	valStore baseapp.ValidatorStore
+	handlers []Handler
+}
+
+// NewManager creates a new Manager with the given handlers, which must have unique names.
+// The validator store is used to verify the vote extension signatures.
+func NewManager(valStore baseapp.ValidatorStore, handlers ...Handler) (*Manager, error) {
+	sorted := make([]Handler, len(handlers))
+	copy(sorted, handlers)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name() < sorted[j].Name() })
+
+	for i, h := range sorted {
+		if h.Name() == "" {
+			return nil, fmt.Errorf("vote extension handler %T has no name", h)
+		}
+		if i > 0 && sorted[i-1].Name() == h.Name() {
+			return nil, fmt.Errorf("duplicate vote extension handler %q", h.Name())
+		}
+	}
+
+	return &Manager{valStore: valStore, handlers: sorted}, nil
+}
+
+// HandlersFromModules returns the vote extension handlers of the given modules, in module name order.
+func HandlersFromModules(modules map[string]appmodule.AppModule) []Handler {
+	names := make([]string, 0, len(modules))
+	for name := range modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var handlers []Handler
+	for _, name := range names {
+		if m, ok := modules[name].(HasVoteExtensionHandler); ok {
+			handlers = append(handlers, m.VoteExtensionHandler())
+		}
+	}
+
+	return handlers
+}
+
+// SetHandlers sets the ABCI handlers of the manager on app, wrapping its proposal handlers
+// and PreBlocker, and skips the injected transaction when delivering the transactions.
+// It must be called once the proposal handlers and the PreBlocker of app are set.
+func (m *Manager) SetHandlers(app *baseapp.BaseApp) {
+	app.SetExtendVoteHandler(m.ExtendVoteHandler())
+	app.SetVerifyVoteExtensionHandler(m.VerifyVoteExtensionHandler())
+	app.SetPrepareProposal(m.PrepareProposalHandler(app.PrepareProposalHandler()))
+	app.SetProcessProposal(m.ProcessProposalHandler(app.ProcessProposalHandler()))
+	app.SetPreBlocker(m.PreBlocker(app.PreBlocker()))
+	app.SetInjectedTxMatcher(IsInjectedTx)
+}
+
+// ExtendVoteHandler returns the ExtendVote handler multiplexing the extensions of all the handlers.
+// A handler failing to extend the vote is omitted from the extension, without failing the vote.
+func (m *Manager) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, req *abci.ExtendVoteRequest) (*abci.ExtendVoteResponse, error) {
+		exts := make(map[string][]byte, len(m.handlers))
+		for _, h := range m.handlers {
+			ext, err := h.ExtendVote(ctx, req)
+			if err != nil {
+				ctx.Logger().Error("failed to extend vote", "handler", h.Name(), "height", req.Height, "err", err)
+				continue
+			}
+			if len(ext) > 0 {
+				exts[h.Name()] = ext
+			}
+		}
+
+		return &abci.ExtendVoteResponse{VoteExtension: encodeExtensions(exts)}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler returns the VerifyVoteExtension handler verifying the extension
+// of each handler. The vote is rejected if it is malformed, holds the extension of an unknown
+// handler or if any handler rejects its extension.
+func (m *Manager) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	reject := &abci.VerifyVoteExtensionResponse{Status: abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT}
+
+	return func(ctx sdk.Context, req *abci.VerifyVoteExtensionRequest) (*abci.VerifyVoteExtensionResponse, error) {
+		exts, err := decodeExtensions(req.VoteExtension)
+		if err != nil {
+			ctx.Logger().Info("rejecting vote extension", "validator", sdk.ConsAddress(req.ValidatorAddress), "height", req.Height, "err", err)
+			return reject, nil
+		}
+
+		for name, ext := range exts {
+			h, ok := m.handler(name)
+			if !ok {
+				ctx.Logger().Info("rejecting vote extension of unknown handler", "handler", name, "validator", sdk.ConsAddress(req.ValidatorAddress), "height", req.Height)
+				return reject, nil
+			}
+
+			if err := h.VerifyVoteExtension(ctx, ext); err != nil {
+				ctx.Logger().Info("rejecting vote extension", "handler", name, "validator", sdk.ConsAddress(req.ValidatorAddress), "height", req.Height, "err", err)
+				return reject, nil
+			}
+		}
+
+		return &abci.VerifyVoteExtensionResponse{Status: abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT}, nil
+	}
+}
+
+// PrepareProposalHandler wraps the given PrepareProposal handler to inject the extended commit
+// of the previous height as the first transaction of the proposal, once its signatures are verified.
+// The size of the injected transaction is deducted from the maximum size of the transactions
+// selected by the wrapped handler.
+func (m *Manager) PrepareProposalHandler(next sdk.PrepareProposalHandler) sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.PrepareProposalRequest) (*abci.PrepareProposalResponse, error) {
+		if !extensionsEnabled(ctx, req.Height) {
+			return next(ctx, req)
+		}
+
+		if err := baseapp.ValidateVoteExtensions(ctx, m.valStore, req.LocalLastCommit); err != nil {
+			return nil, fmt.Errorf("invalid vote extensions: %w", err)
+		}
+
+		injected, err := encodeInjectedTx(req.LocalLastCommit)
+		if err != nil {
+			return nil, err
+		}
+		if int64(len(injected)) > req.MaxTxBytes {
+			return nil, fmt.Errorf("injected vote extensions size %d exceeds the maximum txs size %d", len(injected), req.MaxTxBytes)
+		}
+
+		nextReq := *req
+		nextReq.MaxTxBytes -= int64(len(injected))
+		resp, err := next(ctx, &nextReq)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Txs = append([][]byte{injected}, resp.Txs...)
+		return resp, nil
+	}
+}
+
+// ProcessProposalHandler wraps the given ProcessProposal handler to verify the extended commit
+// injected as the first transaction of the proposal. The wrapped handler is called without it.
+func (m *Manager) ProcessProposalHandler(next sdk.ProcessProposalHandler) sdk.ProcessProposalHandler {
+	reject := &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_REJECT}
+
+	return func(ctx sdk.Context, req *abci.ProcessProposalRequest) (*abci.ProcessProposalResponse, error) {
+		if !extensionsEnabled(ctx, req.Height) {
+			return next(ctx, req)
+		}
+
+		if len(req.Txs) == 0 {
+			ctx.Logger().Error("rejecting proposal without injected vote extensions", "height", req.Height)
+			return reject, nil
+		}
+
+		info, err := decodeInjectedTx(req.Txs[0])
+		if err != nil {
+			ctx.Logger().Error("rejecting proposal", "height", req.Height, "err", err)
+			return reject, nil
+		}
+
+		if err := baseapp.ValidateVoteExtensions(ctx, m.valStore, info); err != nil {
+			ctx.Logger().Error("rejecting proposal with invalid vote extensions", "height", req.Height, "err", err)
+			return reject, nil
+		}
+
+		nextReq := *req
+		nextReq.Txs = req.Txs[1:]
+		return next(ctx, &nextReq)
+	}
+}
+
+// PreBlocker wraps the given PreBlocker, which may be nil, to deliver the vote extensions
+// of the previous height to the handlers after it. Each handler is executed in its own
+// cached context, whose changes are discarded if it fails.
+func (m *Manager) PreBlocker(next sdk.PreBlocker) sdk.PreBlocker {
+	return func(ctx sdk.Context, req *abci.FinalizeBlockRequest) error {
+		if next != nil {
+			if err := next(ctx, req); err != nil {
+				return err
+			}
+		}
+
+		if !extensionsEnabled(ctx, req.Height) || len(req.Txs) == 0 {
+			return nil
+		}
+
+		// the injected transaction was verified in ProcessProposal
+		info, err := decodeInjectedTx(req.Txs[0])
+		if err != nil {
+			return err
+		}
+
+		votes := make(map[string][]Vote, len(m.handlers))
+		for _, vote := range info.Votes {
+			if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.VoteExtension) == 0 {
+				continue
+			}
+
+			exts, err := decodeExtensions(vote.VoteExtension)
+			if err != nil {
+				ctx.Logger().Error("skipping malformed vote extension", "validator", sdk.ConsAddress(vote.Validator.Address), "err", err)
+				continue
+			}
+
+			for name, ext := range exts {
+				votes[name] = append(votes[name], Vote{Validator: vote.Validator, Extension: ext})
+			}
+		}
+
+		for _, h := range m.handlers {
+			cacheCtx, write := ctx.CacheContext()
+			if err := h.PreBlock(cacheCtx, votes[h.Name()]); err != nil {
+				ctx.Logger().Error("failed to deliver vote extensions", "handler", h.Name(), "height", req.Height, "err", err)
+				continue
+			}
+			write()
+		}
+
+		return nil
+	}
+}
+
+func (m *Manager) handler(name string) (Handler, bool) {
+	i := sort.Search(len(m.handlers), func(i int) bool { return m.handlers[i].Name() >= name })
+	if i < len(m.handlers) && m.handlers[i].Name() == name {
+		return m.handlers[i], true
+	}
+
+	return nil, false
+}
+
+// extensionsEnabled returns whether the vote extensions of the previous height are
+// available at the given height, i.e. the height is after the enable height.
+func extensionsEnabled(ctx sdk.Context, height int64) bool {
+	cp := ctx.ConsensusParams()
+	if cp.Feature != nil && cp.Feature.VoteExtensionsEnableHeight != nil && cp.Feature.VoteExtensionsEnableHeight.Value != 0 {
+		return height > cp.Feature.VoteExtensionsEnableHeight.Value
+	}
+
+	return cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight != 0 && height > cp.Abci.VoteExtensionsEnableHeight //nolint:staticcheck // the deprecated abci params are still supported
+}
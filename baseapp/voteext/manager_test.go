@@ -0,0 +1,301 @@
+package voteext
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtprotocrypto "github.com/cometbft/cometbft/api/cometbft/crypto/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmtsecp256k1 "github.com/cometbft/cometbft/crypto/secp256k1"
+	protoio "github.com/cosmos/gogoproto/io"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/testutil/mock"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const chainID = "chain-id"
+
+type testValidator struct {
+	consAddr sdk.ConsAddress
+	tmPk     cmtprotocrypto.PublicKey
+	privKey  cmtsecp256k1.PrivKey
+}
+
+func newTestValidator() testValidator {
+	privKey := cmtsecp256k1.GenPrivKey()
+	pubKey := privKey.PubKey()
+	return testValidator{
+		consAddr: sdk.ConsAddress(pubKey.Address()),
+		tmPk:     cmtprotocrypto.PublicKey{Sum: &cmtprotocrypto.PublicKey_Secp256K1{Secp256K1: pubKey.Bytes()}},
+		privKey:  privKey,
+	}
+}
+
+// sumHandler returns a typed handler extending votes with the given value,
+// rejecting negative values and storing the sum of the extensions.
+func sumHandler(name string, value int64, storeKey storetypes.StoreKey, sums map[string]int64) TypedHandler[gogotypes.Int64Value, *gogotypes.Int64Value] {
+	return TypedHandler[gogotypes.Int64Value, *gogotypes.Int64Value]{
+		HandlerName: name,
+		Extend: func(sdk.Context, *abci.ExtendVoteRequest) (*gogotypes.Int64Value, error) {
+			if value == 0 {
+				return nil, nil
+			}
+			return &gogotypes.Int64Value{Value: value}, nil
+		},
+		Verify: func(_ sdk.Context, ext *gogotypes.Int64Value) error {
+			if ext.Value < 0 {
+				return errors.New("negative value")
+			}
+			return nil
+		},
+		Aggregate: func(ctx sdk.Context, votes []TypedVote[*gogotypes.Int64Value]) error {
+			var sum int64
+			for _, vote := range votes {
+				sum += vote.Extension.Value
+			}
+			ctx.KVStore(storeKey).Set([]byte(name), []byte{byte(sum)})
+			if sum > 100 {
+				return errors.New("overflow")
+			}
+			sums[name] = sum
+			return nil
+		},
+	}
+}
+
+type testFixture struct {
+	ctx      sdk.Context
+	storeKey storetypes.StoreKey
+	vals     []testValidator
+	manager  *Manager
+	sums     map[string]int64
+}
+
+func newTestFixture(t *testing.T) *testFixture {
+	t.Helper()
+
+	f := &testFixture{
+		storeKey: storetypes.NewKVStoreKey("voteext"),
+		vals:     []testValidator{newTestValidator(), newTestValidator(), newTestValidator()},
+		sums:     make(map[string]int64),
+	}
+
+	valStore := mock.NewMockValidatorStore(gomock.NewController(t))
+	for _, val := range f.vals {
+		pk, err := cryptocodec.FromCmtProtoPublicKey(val.tmPk)
+		require.NoError(t, err)
+		valStore.EXPECT().GetPubKeyByConsAddr(gomock.Any(), val.consAddr.Bytes()).Return(pk, nil).AnyTimes()
+	}
+
+	f.ctx = testutil.DefaultContext(f.storeKey, storetypes.NewTransientStoreKey("transient_voteext")).
+		WithConsensusParams(cmtproto.ConsensusParams{
+			Feature: &cmtproto.FeatureParams{VoteExtensionsEnableHeight: &gogotypes.Int64Value{Value: 2}},
+		}).
+		WithBlockHeader(cmtproto.Header{ChainID: chainID}).
+		WithBlockHeight(3).
+		WithHeaderInfo(header.Info{Height: 3, ChainID: chainID}).
+		WithLogger(log.NewTestLogger(t))
+
+	var err error
+	f.manager, err = NewManager(valStore,
+		sumHandler("oracle", 10, f.storeKey, f.sums),
+		sumHandler("counter", 1, f.storeKey, f.sums),
+	)
+	require.NoError(t, err)
+
+	return f
+}
+
+// extendedCommit returns the signed extended commit of height 2 with the given vote extensions,
+// and sets the matching last commit in the context.
+func (f *testFixture) extendedCommit(t *testing.T, exts ...[]byte) abci.ExtendedCommitInfo {
+	t.Helper()
+
+	info := abci.ExtendedCommitInfo{}
+	for i, val := range f.vals {
+		bz, err := marshalDelimited(&cmtproto.CanonicalVoteExtension{Extension: exts[i], Height: 2, ChainId: chainID})
+		require.NoError(t, err)
+		sig, err := val.privKey.Sign(bz)
+		require.NoError(t, err)
+
+		info.Votes = append(info.Votes, abci.ExtendedVoteInfo{
+			Validator:          abci.Validator{Address: val.consAddr, Power: 100},
+			VoteExtension:      exts[i],
+			ExtensionSignature: sig,
+			BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+		})
+	}
+	sort.Slice(info.Votes, func(i, j int) bool {
+		return bytes.Compare(info.Votes[i].Validator.Address, info.Votes[j].Validator.Address) < 0
+	})
+
+	lastCommit := comet.CommitInfo{}
+	for _, vote := range info.Votes {
+		lastCommit.Votes = append(lastCommit.Votes, comet.VoteInfo{
+			Validator: comet.Validator{Address: vote.Validator.Address, Power: vote.Validator.Power},
+		})
+	}
+	f.ctx = f.ctx.WithCometInfo(comet.Info{LastCommit: lastCommit})
+
+	return info
+}
+
+func marshalDelimited(msg *cmtproto.CanonicalVoteExtension) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := protoio.NewDelimitedWriter(&buf).WriteMsg(msg); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func TestEncodeExtensions(t *testing.T) {
+	exts := map[string][]byte{"b": []byte("2"), "a": []byte("1"), "c": nil}
+	decoded, err := decodeExtensions(encodeExtensions(exts))
+	require.NoError(t, err)
+	require.Equal(t, map[string][]byte{"a": []byte("1"), "b": []byte("2"), "c": {}}, decoded)
+
+	// duplicated or unsorted names are rejected
+	bz := append(encodeExtensions(map[string][]byte{"b": nil}), encodeExtensions(map[string][]byte{"a": nil})...)
+	_, err = decodeExtensions(bz)
+	require.Error(t, err)
+	bz = append(encodeExtensions(map[string][]byte{"a": nil}), encodeExtensions(map[string][]byte{"a": nil})...)
+	_, err = decodeExtensions(bz)
+	require.Error(t, err)
+
+	// truncated extensions are rejected
+	bz = encodeExtensions(exts)
+	_, err = decodeExtensions(bz[:len(bz)-2])
+	require.Error(t, err)
+}
+
+func TestNewManager(t *testing.T) {
+	_, err := NewManager(nil, sumHandler("a", 1, nil, nil), sumHandler("a", 2, nil, nil))
+	require.ErrorContains(t, err, "duplicate")
+
+	_, err = NewManager(nil, sumHandler("", 1, nil, nil))
+	require.ErrorContains(t, err, "no name")
+}
+
+func TestExtendAndVerifyVoteExtension(t *testing.T) {
+	f := newTestFixture(t)
+
+	resp, err := f.manager.ExtendVoteHandler()(f.ctx, &abci.ExtendVoteRequest{Height: 3})
+	require.NoError(t, err)
+
+	exts, err := decodeExtensions(resp.VoteExtension)
+	require.NoError(t, err)
+	require.Len(t, exts, 2)
+
+	verify := func(ext []byte) abci.VerifyVoteExtensionStatus {
+		resp, err := f.manager.VerifyVoteExtensionHandler()(f.ctx, &abci.VerifyVoteExtensionRequest{Height: 3, VoteExtension: ext})
+		require.NoError(t, err)
+		return resp.Status
+	}
+
+	require.Equal(t, abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT, verify(resp.VoteExtension))
+	require.Equal(t, abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT, verify(nil))
+
+	negative, err := (&gogotypes.Int64Value{Value: -1}).Marshal()
+	require.NoError(t, err)
+	require.Equal(t, abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT, verify(encodeExtensions(map[string][]byte{"oracle": negative})))
+	require.Equal(t, abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT, verify(encodeExtensions(map[string][]byte{"unknown": nil})))
+	require.Equal(t, abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT, verify(encodeExtensions(map[string][]byte{"oracle": {0xff}})))
+	require.Equal(t, abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT, verify([]byte{0xff}))
+}
+
+func TestProposalInjection(t *testing.T) {
+	f := newTestFixture(t)
+
+	ext, err := f.manager.ExtendVoteHandler()(f.ctx, &abci.ExtendVoteRequest{Height: 2})
+	require.NoError(t, err)
+	info := f.extendedCommit(t, ext.VoteExtension, ext.VoteExtension, ext.VoteExtension)
+
+	var maxTxBytes int64
+	prepare := f.manager.PrepareProposalHandler(func(_ sdk.Context, req *abci.PrepareProposalRequest) (*abci.PrepareProposalResponse, error) {
+		maxTxBytes = req.MaxTxBytes
+		return &abci.PrepareProposalResponse{Txs: req.Txs}, nil
+	})
+
+	resp, err := prepare(f.ctx, &abci.PrepareProposalRequest{Height: 3, MaxTxBytes: 1 << 20, Txs: [][]byte{[]byte("tx")}, LocalLastCommit: info})
+	require.NoError(t, err)
+	require.Len(t, resp.Txs, 2)
+	require.True(t, IsInjectedTx(resp.Txs[0]))
+	require.False(t, IsInjectedTx(resp.Txs[1]))
+	require.Equal(t, int64(1<<20-len(resp.Txs[0])), maxTxBytes)
+
+	var processed [][]byte
+	process := f.manager.ProcessProposalHandler(func(_ sdk.Context, req *abci.ProcessProposalRequest) (*abci.ProcessProposalResponse, error) {
+		processed = req.Txs
+		return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_ACCEPT}, nil
+	})
+
+	processResp, err := process(f.ctx, &abci.ProcessProposalRequest{Height: 3, Txs: resp.Txs})
+	require.NoError(t, err)
+	require.Equal(t, abci.PROCESS_PROPOSAL_STATUS_ACCEPT, processResp.Status)
+	require.Equal(t, [][]byte{[]byte("tx")}, processed)
+
+	// a proposal without injected extensions is rejected
+	processResp, err = process(f.ctx, &abci.ProcessProposalRequest{Height: 3, Txs: resp.Txs[1:]})
+	require.NoError(t, err)
+	require.Equal(t, abci.PROCESS_PROPOSAL_STATUS_REJECT, processResp.Status)
+
+	// a proposal with a tampered extension is rejected
+	info.Votes[0].VoteExtension = encodeExtensions(map[string][]byte{"counter": {0x08, 0x05}})
+	tampered, err := encodeInjectedTx(info)
+	require.NoError(t, err)
+	processResp, err = process(f.ctx, &abci.ProcessProposalRequest{Height: 3, Txs: [][]byte{tampered}})
+	require.NoError(t, err)
+	require.Equal(t, abci.PROCESS_PROPOSAL_STATUS_REJECT, processResp.Status)
+
+	// the proposal is left untouched before vote extensions are enabled
+	resp, err = prepare(f.ctx.WithBlockHeight(2), &abci.PrepareProposalRequest{Height: 2, MaxTxBytes: 1 << 20, Txs: [][]byte{[]byte("tx")}})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("tx")}, resp.Txs)
+}
+
+func TestPreBlocker(t *testing.T) {
+	f := newTestFixture(t)
+
+	value := func(v int64) []byte {
+		bz, err := (&gogotypes.Int64Value{Value: v}).Marshal()
+		require.NoError(t, err)
+		return bz
+	}
+
+	info := f.extendedCommit(t,
+		encodeExtensions(map[string][]byte{"oracle": value(10), "counter": value(1)}),
+		encodeExtensions(map[string][]byte{"oracle": value(20)}),
+		// an extension that cannot be decoded is skipped, the overflow discards the counter state
+		encodeExtensions(map[string][]byte{"oracle": {0xff}, "counter": value(100)}),
+	)
+	injected, err := encodeInjectedTx(info)
+	require.NoError(t, err)
+
+	nextCalled := false
+	preBlocker := f.manager.PreBlocker(func(sdk.Context, *abci.FinalizeBlockRequest) error {
+		nextCalled = true
+		return nil
+	})
+
+	require.NoError(t, preBlocker(f.ctx, &abci.FinalizeBlockRequest{Height: 3, Txs: [][]byte{injected, []byte("tx")}}))
+	require.True(t, nextCalled)
+	require.Equal(t, map[string]int64{"oracle": 30}, f.sums)
+
+	store := f.ctx.KVStore(f.storeKey)
+	require.Equal(t, []byte{30}, store.Get([]byte("oracle")))
+	require.Nil(t, store.Get([]byte("counter")))
+}
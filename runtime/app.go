@@ -2,6 +2,7 @@ package runtime
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"slices"
 
@@ -17,6 +18,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/baseapp/voteext"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
 	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
@@ -57,6 +59,7 @@ type App struct {
 	cdc               codec.Codec
 	amino             registry.AminoRegistrar
 	baseAppOptions    []BaseAppOption
+	validatorStore    baseapp.ValidatorStore
 	msgServiceRouter  *baseapp.MsgServiceRouter
 	grpcQueryRouter   *baseapp.GRPCQueryRouter
 	logger            log.Logger
@@ -129,6 +132,18 @@ func (a *App) Load(loadLatest bool) error {
 		}
 	}
 
+	if handlers := voteext.HandlersFromModules(a.ModuleManager.Modules); len(handlers) != 0 {
+		if a.validatorStore == nil {
+			return errors.New("vote extension handlers require a validator store")
+		}
+
+		voteExtManager, err := voteext.NewManager(a.validatorStore, handlers...)
+		if err != nil {
+			return err
+		}
+		voteExtManager.SetHandlers(a.BaseApp)
+	}
+
 	if len(a.config.BeginBlockers) != 0 {
 		a.ModuleManager.SetOrderBeginBlockers(a.config.BeginBlockers...)
 		a.SetBeginBlocker(a.BeginBlocker)
@@ -49,3 +49,18 @@ func (e Events) EmitKV(eventType string, attrs ...event.Attribute) error {
 	e.EventManagerI.EmitEvents(sdk.Events{sdk.NewEvent(eventType, attributes...)})
 	return nil
 }
+
+// EmittedEvents returns the events emitted so far in the context of the event manager.
+func (e Events) EmittedEvents() []event.Event {
+	sdkEvents := e.EventManagerI.Events()
+	events := make([]event.Event, 0, len(sdkEvents))
+	for _, ev := range sdkEvents {
+		attrs := make([]event.Attribute, 0, len(ev.Attributes))
+		for _, attr := range ev.Attributes {
+			attrs = append(attrs, event.NewAttribute(attr.Key, attr.Value))
+		}
+		events = append(events, event.NewEvent(ev.Type, attrs...))
+	}
+
+	return events
+}
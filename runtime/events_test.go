@@ -0,0 +1,24 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/event"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestEmittedEvents(t *testing.T) {
+	ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
+	em := EventService{}.EventManager(ctx)
+	require.NoError(t, em.EmitKV("transfer", event.NewAttribute("amount", "10stake")))
+
+	events := em.(*Events).EmittedEvents()
+	require.Len(t, events, 1)
+	require.Equal(t, "transfer", events[0].Type)
+	attrs, err := events[0].Attributes()
+	require.NoError(t, err)
+	require.Equal(t, []event.Attribute{event.NewAttribute("amount", "10stake")}, attrs)
+}
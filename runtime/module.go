@@ -159,6 +159,7 @@ type AppInputs struct {
 	InterfaceRegistry codectypes.InterfaceRegistry
 	LegacyAmino       registry.AminoRegistrar
 	AppOptions        servertypes.AppOptions `optional:"true"` // can be nil in client wiring
+	ValidatorStore    baseapp.ValidatorStore `optional:"true"` // verifies the vote extensions of the modules
 }
 
 func SetupAppBuilder(inputs AppInputs) {
@@ -167,6 +168,7 @@ func SetupAppBuilder(inputs AppInputs) {
 	app.config = inputs.Config
 	app.logger = inputs.Logger
 	app.ModuleManager = inputs.ModuleManager
+	app.validatorStore = inputs.ValidatorStore
 	app.ModuleManager.RegisterInterfaces(inputs.InterfaceRegistry)
 	app.ModuleManager.RegisterLegacyAminoCodec(inputs.LegacyAmino)
 
@@ -181,12 +181,13 @@ type Config struct {
 	BaseConfig `mapstructure:",squash"`
 
 	// Telemetry defines the application telemetry configuration
-	Telemetry telemetry.Config `mapstructure:"telemetry"`
-	API       APIConfig        `mapstructure:"api"`
-	GRPC      GRPCConfig       `mapstructure:"grpc"`
-	StateSync StateSyncConfig  `mapstructure:"state-sync"`
-	Streaming StreamingConfig  `mapstructure:"streaming"`
-	Mempool   MempoolConfig    `mapstructure:"mempool"`
+	Telemetry telemetry.Config        `mapstructure:"telemetry"`
+	Tracing   telemetry.TracingConfig `mapstructure:"tracing"`
+	API       APIConfig               `mapstructure:"api"`
+	GRPC      GRPCConfig              `mapstructure:"grpc"`
+	StateSync StateSyncConfig         `mapstructure:"state-sync"`
+	Streaming StreamingConfig         `mapstructure:"streaming"`
+	Mempool   MempoolConfig           `mapstructure:"mempool"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -228,6 +229,7 @@ func DefaultConfig() *Config {
 			Enabled:      false,
 			GlobalLabels: [][]string{},
 		},
+		Tracing: telemetry.DefaultTracingConfig(),
 		API: APIConfig{
 			Enable:             false,
 			Swagger:            false,
@@ -277,6 +279,9 @@ func (c Config) ValidateBasic() error {
 			"cannot enable state sync snapshots with '%s' pruning setting", pruningtypes.PruningOptionEverything,
 		)
 	}
+	if err := c.Tracing.Validate(); err != nil {
+		return sdkerrors.ErrAppConfig.Wrap(err.Error())
+	}
 
 	return nil
 }
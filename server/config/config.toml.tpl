@@ -121,6 +121,37 @@ statsd-addr = "{{ .Telemetry.StatsdAddr }}"
 # Datadog. Only utilized if MetricsSink is set to "dogstatsd".
 datadog-hostname = "{{ .Telemetry.DatadogHostname }}"
 
+###############################################################################
+###                         Tracing Configuration                           ###
+###############################################################################
+
+[tracing]
+
+# Enabled enables the OpenTelemetry tracing of ABCI calls, transactions,
+# ante handlers, message handlers and store commits.
+enabled = {{ .Tracing.Enabled }}
+
+# ServiceName defines the service name reported with the spans.
+service-name = "{{ .Tracing.ServiceName }}"
+
+# Exporter defines the type of spans exporter to use, either "otlp" or "file".
+exporter = "{{ .Tracing.Exporter }}"
+
+# OTLPEndpoint defines the address of the OTLP gRPC collector.
+# Only utilized if exporter is set to "otlp".
+otlp-endpoint = "{{ .Tracing.OTLPEndpoint }}"
+
+# OTLPInsecure disables the transport security of the OTLP gRPC connection.
+# Only utilized if exporter is set to "otlp".
+otlp-insecure = {{ .Tracing.OTLPInsecure }}
+
+# FilePath defines the file the spans are written to, as JSON.
+# Only utilized if exporter is set to "file".
+file-path = "{{ .Tracing.FilePath }}"
+
+# SampleRate defines the fraction of traces to record, between 0 and 1.
+sample-rate = {{ .Tracing.SampleRate }}
+
 ###############################################################################
 ###                           API Configuration                             ###
 ###############################################################################
@@ -235,6 +235,16 @@ func start[T types.Application](svrCtx *Context, clientCtx client.Context, appCr
 
 	emitServerInfoMetrics()
 
+	shutdownTracing, err := telemetry.EnableTracing(context.Background(), svrCfg.Tracing)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			svrCtx.Logger.Error("failed to shutdown tracing", "err", err)
+		}
+	}()
+
 	if !withCmt {
 		return startStandAlone[T](svrCtx, svrCfg, clientCtx, app, metrics, opts)
 	}
@@ -1,6 +1,10 @@
 package grpc
 
-import "math"
+import (
+	"math"
+
+	"cosmossdk.io/server/v2/api/ratelimit"
+)
 
 func DefaultConfig() *Config {
 	return &Config{
@@ -13,6 +17,8 @@ func DefaultConfig() *Config {
 		// DefaultGRPCMaxSendMsgSize defines the default gRPC max message size in
 		// bytes the server can send.
 		MaxSendMsgSize: math.MaxInt32,
+		// RateLimit is disabled by default.
+		RateLimit: ratelimit.DefaultConfig(),
 	}
 }
 
@@ -31,6 +37,9 @@ type Config struct {
 	// MaxSendMsgSize defines the max message size in bytes the server can send.
 	// The default value is math.MaxInt32.
 	MaxSendMsgSize int `mapstructure:"max-send-msg-size" toml:"max-send-msg-size" comment:"MaxSendMsgSize defines the max message size in bytes the server can send.\nThe default value is math.MaxInt32."`
+
+	// RateLimit defines the per-client rate limiting and query cost accounting configuration.
+	RateLimit ratelimit.Config `mapstructure:"rate-limit" toml:"rate-limit" comment:"RateLimit defines the per-client rate limiting and query cost accounting configuration."`
 }
 
 // CfgOption is a function that allows to overwrite the default server configuration.
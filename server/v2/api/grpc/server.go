@@ -21,6 +21,7 @@ import (
 	"cosmossdk.io/log"
 	serverv2 "cosmossdk.io/server/v2"
 	"cosmossdk.io/server/v2/api/grpc/gogoreflection"
+	"cosmossdk.io/server/v2/api/ratelimit"
 )
 
 const (
@@ -53,6 +54,15 @@ func (s *Server[T]) Init(appI serverv2.AppI[T], cfg map[string]any, logger log.L
 			return fmt.Errorf("failed to unmarshal config: %w", err)
 		}
 	}
+	if err := serverCfg.RateLimit.Validate(); err != nil {
+		return fmt.Errorf("invalid rate limit config: %w", err)
+	}
+
+	var limiter *ratelimit.Limiter
+	if serverCfg.RateLimit.Enable {
+		limiter = ratelimit.NewLimiter(serverCfg.RateLimit)
+	}
+
 	methodsMap := appI.GetGPRCMethodsToMessageMap()
 
 	grpcSrv := grpc.NewServer(
@@ -60,7 +70,7 @@ func (s *Server[T]) Init(appI serverv2.AppI[T], cfg map[string]any, logger log.L
 		grpc.MaxSendMsgSize(serverCfg.MaxSendMsgSize),
 		grpc.MaxRecvMsgSize(serverCfg.MaxRecvMsgSize),
 		grpc.UnknownServiceHandler(
-			makeUnknownServiceHandler(methodsMap, appI.GetAppManager()),
+			makeUnknownServiceHandler(methodsMap, appI.GetAppManager(), limiter),
 		),
 	)
 
@@ -80,9 +90,11 @@ func (s *Server[T]) StartCmdFlags() *pflag.FlagSet {
 	return flags
 }
 
+// makeUnknownServiceHandler returns a handler routing gRPC queries to the querier.
+// When limiter is not nil, every query is subject to its rate limits and cost accounting.
 func makeUnknownServiceHandler(messageMap map[string]func() proto.Message, querier interface {
 	Query(ctx context.Context, version uint64, msg proto.Message) (proto.Message, error)
-},
+}, limiter *ratelimit.Limiter,
 ) grpc.StreamHandler {
 	return func(srv any, stream grpc.ServerStream) error {
 		method, ok := grpc.MethodFromServerStream(stream)
@@ -109,10 +121,23 @@ func makeUnknownServiceHandler(messageMap map[string]func() proto.Message, queri
 			if err != nil {
 				return status.Errorf(codes.InvalidArgument, "invalid get height from context: %v", err)
 			}
+
+			var client ratelimit.Client
+			if limiter != nil {
+				client = limiter.ClientFromGRPC(ctx)
+				if err := limiter.Admit(client, req); err != nil {
+					return err
+				}
+			}
+
 			resp, err := querier.Query(ctx, height, req)
 			if err != nil {
 				return err
 			}
+
+			if limiter != nil {
+				limiter.Charge(client, proto.Size(resp))
+			}
 			err = stream.SendMsg(resp)
 			if err != nil {
 				return err
@@ -1,14 +1,20 @@
 package grpcgateway
 
+import "cosmossdk.io/server/v2/api/ratelimit"
+
 func DefaultConfig() *Config {
 	return &Config{
-		Enable: true,
+		Enable:    true,
+		RateLimit: ratelimit.DefaultConfig(),
 	}
 }
 
 type Config struct {
 	// Enable defines if the gRPC-gateway should be enabled.
 	Enable bool `mapstructure:"enable" toml:"enable" comment:"Enable defines if the gRPC-gateway should be enabled."`
+
+	// RateLimit defines the per-client rate limiting and query cost accounting configuration.
+	RateLimit ratelimit.Config `mapstructure:"rate-limit" toml:"rate-limit" comment:"RateLimit defines the per-client rate limiting and query cost accounting configuration."`
 }
 
 type CfgOption func(*Config)
@@ -15,6 +15,7 @@ import (
 	"cosmossdk.io/core/transaction"
 	"cosmossdk.io/log"
 	serverv2 "cosmossdk.io/server/v2"
+	"cosmossdk.io/server/v2/api/ratelimit"
 )
 
 var _ serverv2.ServerComponent[transaction.Tx] = (*GRPCGatewayServer[transaction.Tx])(nil)
@@ -30,6 +31,7 @@ type GRPCGatewayServer[T transaction.Tx] struct {
 	logger     log.Logger
 	config     *Config
 	cfgOptions []CfgOption
+	limiter    *ratelimit.Limiter
 
 	GRPCSrv           *grpc.Server
 	GRPCGatewayRouter *runtime.ServeMux
@@ -90,11 +92,18 @@ func (s *GRPCGatewayServer[T]) Init(appI serverv2.AppI[transaction.Tx], cfg map[
 		}
 	}
 
+	if err := serverCfg.RateLimit.Validate(); err != nil {
+		return fmt.Errorf("invalid rate limit config: %w", err)
+	}
+
 	// Register the gRPC-Gateway server.
 	// appI.RegisterGRPCGatewayRoutes(s.GRPCGatewayRouter, s.GRPCSrv)
 
 	s.logger = logger
 	s.config = serverCfg
+	if serverCfg.RateLimit.Enable {
+		s.limiter = ratelimit.NewLimiter(serverCfg.RateLimit)
+	}
 
 	return nil
 }
@@ -120,10 +129,14 @@ func (s *GRPCGatewayServer[T]) Stop(ctx context.Context) error {
 // Register implements registers a grpc-gateway server
 func (s *GRPCGatewayServer[T]) Register(r mux.Router) error {
 	// configure grpc-gatway server
-	r.PathPrefix("/").Handler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		// Fall back to grpc gateway server.
 		s.GRPCGatewayRouter.ServeHTTP(w, req)
-	}))
+	})
+	if s.limiter != nil {
+		handler = s.limiter.HTTPMiddleware(handler)
+	}
+	r.PathPrefix("/").Handler(handler)
 
 	return nil
 }
@@ -0,0 +1,92 @@
+package ratelimit
+
+import "fmt"
+
+// DefaultConfig returns the default rate limit configuration.
+// Rate limiting is disabled by default.
+func DefaultConfig() Config {
+	return Config{
+		Enable:                  false,
+		RequestsPerSecond:       20,
+		Burst:                   40,
+		APIKeyHeader:            "x-api-key",
+		APIKeys:                 []string{},
+		APIKeyRequestsPerSecond: 200,
+		APIKeyBurst:             400,
+		QueryCostPerSecond:      500_000,
+		MaxQueryCost:            1_000_000,
+		BaseQueryCost:           1_000,
+		PageItemCost:            100,
+		ResponseByteCost:        1,
+		MaxPageLimit:            1_000,
+	}
+}
+
+// Config defines the per-client rate limiting and query cost accounting configuration
+// shared by the gRPC and gRPC-gateway servers.
+type Config struct {
+	// Enable defines if rate limiting and query cost accounting should be enabled.
+	Enable bool `mapstructure:"enable" toml:"enable" comment:"Enable defines if rate limiting and query cost accounting should be enabled."`
+
+	// RequestsPerSecond defines the sustained number of requests per second a client, identified by its IP, can issue.
+	RequestsPerSecond float64 `mapstructure:"requests-per-second" toml:"requests-per-second" comment:"RequestsPerSecond defines the sustained number of requests per second a client, identified by its IP, can issue."`
+
+	// Burst defines the maximum number of requests a client, identified by its IP, can issue at once.
+	Burst int `mapstructure:"burst" toml:"burst" comment:"Burst defines the maximum number of requests a client, identified by its IP, can issue at once."`
+
+	// APIKeyHeader defines the request header (or gRPC metadata key) carrying the client API key.
+	APIKeyHeader string `mapstructure:"api-key-header" toml:"api-key-header" comment:"APIKeyHeader defines the request header (or gRPC metadata key) carrying the client API key."`
+
+	// APIKeys defines the API keys granted the API key limits. Requests carrying an unknown key are limited by IP.
+	APIKeys []string `mapstructure:"api-keys" toml:"api-keys" comment:"APIKeys defines the API keys granted the API key limits. Requests carrying an unknown key are limited by IP."`
+
+	// APIKeyRequestsPerSecond defines the sustained number of requests per second a client holding an API key can issue.
+	APIKeyRequestsPerSecond float64 `mapstructure:"api-key-requests-per-second" toml:"api-key-requests-per-second" comment:"APIKeyRequestsPerSecond defines the sustained number of requests per second a client holding an API key can issue."`
+
+	// APIKeyBurst defines the maximum number of requests a client holding an API key can issue at once.
+	APIKeyBurst int `mapstructure:"api-key-burst" toml:"api-key-burst" comment:"APIKeyBurst defines the maximum number of requests a client holding an API key can issue at once."`
+
+	// QueryCostPerSecond defines the query cost budget a client regains every second. 0 disables cost accounting.
+	QueryCostPerSecond uint64 `mapstructure:"query-cost-per-second" toml:"query-cost-per-second" comment:"QueryCostPerSecond defines the query cost budget a client regains every second. 0 disables cost accounting."`
+
+	// MaxQueryCost defines the maximum cost of a single query, which is also the maximum budget a client can accumulate.
+	MaxQueryCost uint64 `mapstructure:"max-query-cost" toml:"max-query-cost" comment:"MaxQueryCost defines the maximum cost of a single query, which is also the maximum budget a client can accumulate."`
+
+	// BaseQueryCost defines the flat cost charged for every query.
+	BaseQueryCost uint64 `mapstructure:"base-query-cost" toml:"base-query-cost" comment:"BaseQueryCost defines the flat cost charged for every query."`
+
+	// PageItemCost defines the cost charged for every item a paginated query may return.
+	PageItemCost uint64 `mapstructure:"page-item-cost" toml:"page-item-cost" comment:"PageItemCost defines the cost charged for every item a paginated query may return."`
+
+	// ResponseByteCost defines the cost charged for every byte of a query response.
+	ResponseByteCost uint64 `mapstructure:"response-byte-cost" toml:"response-byte-cost" comment:"ResponseByteCost defines the cost charged for every byte of a query response."`
+
+	// MaxPageLimit defines the maximum pagination limit a query can request. 0 means unbounded.
+	MaxPageLimit uint64 `mapstructure:"max-page-limit" toml:"max-page-limit" comment:"MaxPageLimit defines the maximum pagination limit a query can request. 0 means unbounded."`
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c Config) Validate() error {
+	if !c.Enable {
+		return nil
+	}
+
+	if c.RequestsPerSecond <= 0 || c.Burst <= 0 {
+		return fmt.Errorf("requests-per-second and burst must be positive, got %f and %d", c.RequestsPerSecond, c.Burst)
+	}
+
+	if len(c.APIKeys) > 0 {
+		if c.APIKeyHeader == "" {
+			return fmt.Errorf("api-key-header must be set when api-keys are configured")
+		}
+		if c.APIKeyRequestsPerSecond <= 0 || c.APIKeyBurst <= 0 {
+			return fmt.Errorf("api-key-requests-per-second and api-key-burst must be positive, got %f and %d", c.APIKeyRequestsPerSecond, c.APIKeyBurst)
+		}
+	}
+
+	if c.QueryCostPerSecond > 0 && c.MaxQueryCost == 0 {
+		return fmt.Errorf("max-query-cost must be set when query-cost-per-second is enabled")
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package ratelimit
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// paginationLimitParam is the query parameter used by the gRPC-gateway to set the pagination limit.
+const paginationLimitParam = "pagination.limit"
+
+// ClientFromHTTP identifies the client of an HTTP request from its headers and remote address.
+// Forwarding headers such as X-Forwarded-For are not trusted, as they can be set by the client.
+func (l *Limiter) ClientFromHTTP(r *http.Request) Client {
+	if l.cfg.APIKeyHeader != "" {
+		if c, ok := l.apiKeyClient(r.Header.Values(l.cfg.APIKeyHeader)); ok {
+			return c
+		}
+	}
+
+	return Client{ID: hostOf(r.RemoteAddr)}
+}
+
+// HTTPMiddleware wraps the given handler with rate limiting and query cost accounting.
+// The pagination limit is read from the pagination.limit query parameter.
+func (l *Limiter) HTTPMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c := l.ClientFromHTTP(r)
+
+		// endpoints do not declare whether they are paginated, so only requests
+		// setting a pagination limit are charged for the page items.
+		var req any
+		if v := r.URL.Query().Get(paginationLimitParam); v != "" {
+			limit, err := strconv.ParseUint(v, 10, 64)
+			if err != nil {
+				writeError(w, status.Errorf(codes.InvalidArgument, "invalid %s: %v", paginationLimitParam, err))
+				return
+			}
+			req = httpRequest{Pagination: &httpPageRequest{Limit: limit}}
+		}
+
+		if err := l.Admit(c, req); err != nil {
+			writeError(w, err)
+			return
+		}
+
+		cw := &countingResponseWriter{ResponseWriter: w}
+		next.ServeHTTP(cw, r)
+		l.Charge(c, cw.written)
+	})
+}
+
+// httpRequest is the view of an HTTP request used to estimate its cost.
+type httpRequest struct {
+	Pagination *httpPageRequest
+}
+
+type httpPageRequest struct {
+	Limit uint64
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+}
+
+// countingResponseWriter counts the number of bytes written to the response.
+type countingResponseWriter struct {
+	http.ResponseWriter
+	written int
+}
+
+func (w *countingResponseWriter) Write(b []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(b)
+	w.written += n
+	return n, err
+}
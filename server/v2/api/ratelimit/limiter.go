@@ -0,0 +1,246 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultPageLimit mirrors the limit applied by the query pagination helpers
+	// when a request does not set one.
+	defaultPageLimit = 100
+
+	// clientTTL is the duration after which an idle client is forgotten.
+	clientTTL = 10 * time.Minute
+)
+
+// Client identifies the originator of a request.
+type Client struct {
+	// ID is either the API key or the IP of the client.
+	ID string
+	// HasAPIKey is true when the client presented a known API key.
+	HasAPIKey bool
+}
+
+// bucket is a token bucket refilled continuously at a fixed rate.
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func (b *bucket) refill(now time.Time, rate, capacity float64) {
+	if b.last.IsZero() {
+		b.tokens = capacity
+	} else if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens += elapsed * rate
+		if b.tokens > capacity {
+			b.tokens = capacity
+		}
+	}
+	b.last = now
+}
+
+type client struct {
+	requests bucket
+	cost     bucket
+	lastSeen time.Time
+}
+
+// Limiter enforces per-client request rates, query cost budgets and pagination limits.
+// It is safe for concurrent use.
+type Limiter struct {
+	cfg     Config
+	apiKeys map[string]struct{}
+	now     func() time.Time
+
+	mu        sync.Mutex
+	clients   map[string]*client
+	lastSweep time.Time
+}
+
+// NewLimiter creates a new Limiter from the given configuration.
+func NewLimiter(cfg Config) *Limiter {
+	apiKeys := make(map[string]struct{}, len(cfg.APIKeys))
+	for _, key := range cfg.APIKeys {
+		apiKeys[key] = struct{}{}
+	}
+
+	return &Limiter{
+		cfg:     cfg,
+		apiKeys: apiKeys,
+		now:     time.Now,
+		clients: make(map[string]*client),
+	}
+}
+
+// ClientFromGRPC identifies the client of a gRPC request from its metadata and peer address.
+func (l *Limiter) ClientFromGRPC(ctx context.Context) Client {
+	if md, ok := metadata.FromIncomingContext(ctx); ok && l.cfg.APIKeyHeader != "" {
+		if c, ok := l.apiKeyClient(md.Get(l.cfg.APIKeyHeader)); ok {
+			return c
+		}
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return Client{ID: hostOf(p.Addr.String())}
+	}
+
+	return Client{}
+}
+
+func (l *Limiter) apiKeyClient(values []string) (Client, bool) {
+	for _, v := range values {
+		if _, ok := l.apiKeys[v]; ok {
+			return Client{ID: v, HasAPIKey: true}, true
+		}
+	}
+
+	return Client{}, false
+}
+
+// Admit checks whether the client is allowed to execute the given query request.
+// It consumes one request token and the estimated cost of the query from the client budget.
+// The returned error is a gRPC status error.
+func (l *Limiter) Admit(c Client, req any) error {
+	limit, hasPagination := pageLimit(req)
+	if hasPagination && l.cfg.MaxPageLimit > 0 && limit > l.cfg.MaxPageLimit {
+		return status.Errorf(codes.InvalidArgument, "pagination limit %d exceeds the maximum of %d", limit, l.cfg.MaxPageLimit)
+	}
+
+	cost := l.cfg.BaseQueryCost
+	if hasPagination {
+		if limit == 0 {
+			limit = defaultPageLimit
+		}
+		cost += limit * l.cfg.PageItemCost
+	}
+
+	if l.costEnabled() && cost > l.cfg.MaxQueryCost {
+		return status.Errorf(codes.ResourceExhausted, "query cost %d exceeds the maximum of %d", cost, l.cfg.MaxQueryCost)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+	cl := l.client(c, now)
+
+	rate, burst := l.cfg.RequestsPerSecond, l.cfg.Burst
+	if c.HasAPIKey {
+		rate, burst = l.cfg.APIKeyRequestsPerSecond, l.cfg.APIKeyBurst
+	}
+
+	cl.requests.refill(now, rate, float64(burst))
+	if cl.requests.tokens < 1 {
+		return status.Error(codes.ResourceExhausted, "request rate limit exceeded")
+	}
+
+	if l.costEnabled() {
+		cl.cost.refill(now, float64(l.cfg.QueryCostPerSecond), float64(l.cfg.MaxQueryCost))
+		if cl.cost.tokens < float64(cost) {
+			return status.Errorf(codes.ResourceExhausted, "query cost budget exceeded: cost %d, remaining %d", cost, uint64(max(cl.cost.tokens, 0)))
+		}
+		cl.cost.tokens -= float64(cost)
+	}
+
+	cl.requests.tokens--
+
+	return nil
+}
+
+// Charge consumes the cost of a query response of the given size from the client budget.
+// The budget may become negative, in which case the client is throttled until it is refilled.
+func (l *Limiter) Charge(c Client, responseSize int) {
+	if !l.costEnabled() || responseSize <= 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	cl := l.client(c, now)
+	cl.cost.refill(now, float64(l.cfg.QueryCostPerSecond), float64(l.cfg.MaxQueryCost))
+	cl.cost.tokens -= float64(uint64(responseSize) * l.cfg.ResponseByteCost)
+}
+
+func (l *Limiter) costEnabled() bool {
+	return l.cfg.QueryCostPerSecond > 0
+}
+
+// client returns the state of the given client, creating it if needed.
+// The caller must hold the lock.
+func (l *Limiter) client(c Client, now time.Time) *client {
+	cl, ok := l.clients[c.ID]
+	if !ok {
+		cl = &client{}
+		l.clients[c.ID] = cl
+	}
+	cl.lastSeen = now
+
+	return cl
+}
+
+// sweep forgets clients that have been idle for longer than clientTTL.
+// The caller must hold the lock.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < time.Minute {
+		return
+	}
+	l.lastSweep = now
+
+	for id, cl := range l.clients {
+		if now.Sub(cl.lastSeen) > clientTTL {
+			delete(l.clients, id)
+		}
+	}
+}
+
+// pageLimit returns the pagination limit of a request and whether the request
+// is paginated, i.e. has a Pagination field. A nil pagination has a limit of 0.
+func pageLimit(req any) (uint64, bool) {
+	v := reflect.ValueOf(req)
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return 0, false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return 0, false
+	}
+
+	pagination := v.FieldByName("Pagination")
+	if !pagination.IsValid() || pagination.Kind() != reflect.Pointer {
+		return 0, false
+	}
+	if pagination.IsNil() {
+		return 0, true
+	}
+
+	limit := pagination.Elem().FieldByName("Limit")
+	if !limit.IsValid() || limit.Kind() != reflect.Uint64 {
+		return 0, false
+	}
+
+	return limit.Uint(), true
+}
+
+// hostOf strips the port from an address, if any.
+func hostOf(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+
+	return host
+}
@@ -0,0 +1,136 @@
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type pageRequest struct {
+	Limit uint64
+}
+
+type paginatedRequest struct {
+	Pagination *pageRequest
+}
+
+func newTestLimiter(cfg Config) (*Limiter, *time.Time) {
+	now := time.Unix(0, 0)
+	l := NewLimiter(cfg)
+	l.now = func() time.Time { return now }
+	return l, &now
+}
+
+func TestLimiterRequestRate(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Enable = true
+	cfg.RequestsPerSecond = 1
+	cfg.Burst = 2
+	cfg.QueryCostPerSecond = 0
+	l, now := newTestLimiter(cfg)
+
+	alice, bob := Client{ID: "1.1.1.1"}, Client{ID: "2.2.2.2"}
+	require.NoError(t, l.Admit(alice, nil))
+	require.NoError(t, l.Admit(alice, nil))
+	err := l.Admit(alice, nil)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// other clients are not affected
+	require.NoError(t, l.Admit(bob, nil))
+
+	*now = now.Add(time.Second)
+	require.NoError(t, l.Admit(alice, nil))
+	require.Error(t, l.Admit(alice, nil))
+}
+
+func TestLimiterAPIKey(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Enable = true
+	cfg.RequestsPerSecond = 1
+	cfg.Burst = 1
+	cfg.APIKeys = []string{"secret"}
+	cfg.APIKeyRequestsPerSecond = 10
+	cfg.APIKeyBurst = 3
+	cfg.QueryCostPerSecond = 0
+	l, _ := newTestLimiter(cfg)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(cfg.APIKeyHeader, "secret")
+	c := l.ClientFromHTTP(req)
+	require.Equal(t, Client{ID: "secret", HasAPIKey: true}, c)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, l.Admit(c, nil))
+	}
+	require.Error(t, l.Admit(c, nil))
+
+	// unknown keys fall back to the IP
+	req.Header.Set(cfg.APIKeyHeader, "unknown")
+	require.Equal(t, Client{ID: "192.0.2.1"}, l.ClientFromHTTP(req))
+}
+
+func TestLimiterQueryCost(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Enable = true
+	cfg.QueryCostPerSecond = 1_000
+	cfg.MaxQueryCost = 5_000
+	cfg.BaseQueryCost = 1_000
+	cfg.PageItemCost = 10
+	cfg.ResponseByteCost = 1
+	cfg.MaxPageLimit = 1_000
+	l, now := newTestLimiter(cfg)
+	c := Client{ID: "1.1.1.1"}
+
+	// page limit above the maximum
+	err := l.Admit(c, &paginatedRequest{Pagination: &pageRequest{Limit: 1_001}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// single query above the maximum cost: 1000 + 500 * 10
+	err = l.Admit(c, &paginatedRequest{Pagination: &pageRequest{Limit: 500}})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// nil pagination is charged the default page limit: 1000 + 100 * 10
+	require.NoError(t, l.Admit(c, &paginatedRequest{}))
+	// 3000 left, charge a 1500 bytes response
+	l.Charge(c, 1_500)
+	require.NoError(t, l.Admit(c, nil))
+	err = l.Admit(c, nil)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	*now = now.Add(time.Second)
+	require.NoError(t, l.Admit(c, nil))
+}
+
+func TestHTTPMiddleware(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Enable = true
+	cfg.MaxPageLimit = 10
+	l, _ := newTestLimiter(cfg)
+
+	handler := l.HTTPMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/cosmos/bank/v1beta1/denom_owners/stake?pagination.limit=5", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/cosmos/bank/v1beta1/denom_owners/stake?pagination.limit=11", nil))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestConfigValidate(t *testing.T) {
+	require.NoError(t, DefaultConfig().Validate())
+
+	cfg := DefaultConfig()
+	cfg.Enable = true
+	require.NoError(t, cfg.Validate())
+
+	cfg.Burst = 0
+	require.Error(t, cfg.Validate())
+}
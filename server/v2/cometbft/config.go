@@ -4,6 +4,10 @@ import (
 	cmtcfg "github.com/cometbft/cometbft/config"
 
 	"cosmossdk.io/server/v2/cometbft/mempool"
+	"cosmossdk.io/server/v2/streaming"
+	"cosmossdk.io/server/v2/streaming/filesink"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
 // Config is the configuration for the CometBFT application
@@ -23,6 +27,16 @@ func DefaultAppTomlConfig() *AppTomlConfig {
 		Trace:           false,
 		Standalone:      false,
 		Mempool:         mempool.DefaultConfig(),
+		Streaming: StreamingConfig{
+			FileSink: filesink.DefaultConfig(),
+			ABCI: streaming.ListenerConfig{
+				Keys:          []string{},
+				Plugin:        "",
+				StopNodeOnErr: true,
+			},
+			Delivery: streaming.DefaultDeliveryConfig(),
+		},
+		Tracing: telemetry.DefaultTracingConfig(),
 	}
 }
 
@@ -37,7 +51,16 @@ type AppTomlConfig struct {
 	Standalone      bool     `mapstructure:"standalone" toml:"standalone" comment:"standalone starts the application without the CometBFT node. The node should be started separately."`
 
 	// Sub configs
-	Mempool mempool.Config `mapstructure:"mempool" toml:"mempool" comment:"mempool defines the configuration for the SDK built-in app-side mempool implementations."`
+	Mempool   mempool.Config          `mapstructure:"mempool" toml:"mempool" comment:"mempool defines the configuration for the SDK built-in app-side mempool implementations."`
+	Streaming StreamingConfig         `mapstructure:"streaming" toml:"streaming" comment:"streaming defines the configuration of the state streaming listeners."`
+	Tracing   telemetry.TracingConfig `mapstructure:"tracing" toml:"tracing" comment:"tracing defines the OpenTelemetry tracing of ABCI calls, state transitions and store commits."`
+}
+
+// StreamingConfig defines the configuration of the state streaming listeners.
+type StreamingConfig struct {
+	FileSink filesink.Config          `mapstructure:"file-sink" toml:"file-sink" comment:"file-sink writes the delivered blocks and state changes to rotating local files. It is enabled when dir is set, a relative dir being resolved against the node home."`
+	ABCI     streaming.ListenerConfig `mapstructure:"abci" toml:"abci" comment:"abci streams the delivered blocks and the state changes of the given keys to a gRPC plugin. It is enabled when plugin is set."`
+	Delivery streaming.DeliveryConfig `mapstructure:"delivery" toml:"delivery" comment:"delivery wraps the listeners above with at-least-once delivery, persisting the blocks before delivering them in the background. It is enabled when dir is set, a relative dir being resolved against the node home."`
 }
 
 // CfgOption is a function that allows to overwrite the default server configuration.
@@ -15,6 +15,8 @@ type keyGenF = func() (cmtcrypto.PrivKey, error)
 
 // ServerOptions defines the options for the CometBFT server.
 // When an option takes a map[string]any, it can access the app.tom's cometbft section and the config.toml config.
+// The vote extension framework of baseapp/voteext is not supported, the vote extensions are handled by the
+// VerifyVoteExtensionHandler and ExtendVoteHandler, and propagated by the proposal handlers.
 type ServerOptions[T transaction.Tx] struct {
 	PrepareProposalHandler     handlers.PrepareHandler[T]
 	ProcessProposalHandler     handlers.ProcessHandler[T]
@@ -4,7 +4,9 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 
@@ -26,6 +28,7 @@ import (
 	"cosmossdk.io/server/v2/cometbft/types"
 	"cosmossdk.io/store/v2/snapshots"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
@@ -46,6 +49,11 @@ type CometBFTServer[T transaction.Tx] struct {
 	serverOptions ServerOptions[T]
 	config        Config
 	cfgOptions    []CfgOption
+
+	// streamingClosers are the streaming listeners to close when the server stops.
+	streamingClosers []io.Closer
+	// shutdownTracing flushes the pending spans when the server stops.
+	shutdownTracing func(context.Context) error
 }
 
 func New[T transaction.Tx](txCodec transaction.Codec[T], serverOptions ServerOptions[T], cfgOptions ...CfgOption) *CometBFTServer[T] {
@@ -92,6 +100,10 @@ func (s *CometBFTServer[T]) Init(appI serverv2.AppI[T], cfg map[string]any, logg
 		}
 	}
 
+	if err := s.config.AppTomlConfig.Tracing.Validate(); err != nil {
+		return fmt.Errorf("invalid tracing config: %w", err)
+	}
+
 	indexEvents := make(map[string]struct{}, len(s.config.AppTomlConfig.IndexEvents))
 	for _, e := range s.config.AppTomlConfig.IndexEvents {
 		indexEvents[e] = struct{}{}
@@ -127,6 +139,13 @@ func (s *CometBFTServer[T]) Init(appI serverv2.AppI[T], cfg map[string]any, logg
 	}
 	consensus.snapshotManager = snapshots.NewManager(snapshotStore, s.serverOptions.SnapshotOptions(cfg), sc, ss, nil, s.logger)
 
+	sm, streamingClosers, err := newStreamingManager(s.config.AppTomlConfig.Streaming, home, s.logger)
+	if err != nil {
+		return err
+	}
+	consensus.SetStreamingManager(sm)
+	s.streamingClosers = streamingClosers
+
 	s.Consensus = consensus
 
 	return nil
@@ -137,6 +156,14 @@ func (s *CometBFTServer[T]) Name() string {
 }
 
 func (s *CometBFTServer[T]) Start(ctx context.Context) error {
+	// register the tracer provider before the first block so that the state
+	// transition and store commit spans are exported.
+	shutdownTracing, err := telemetry.EnableTracing(context.Background(), s.config.AppTomlConfig.Tracing)
+	if err != nil {
+		return fmt.Errorf("failed to enable tracing: %w", err)
+	}
+	s.shutdownTracing = shutdownTracing
+
 	wrappedLogger := cometlog.CometLoggerWrapper{Logger: s.logger}
 	if s.config.AppTomlConfig.Standalone {
 		svr, err := abciserver.NewServer(s.config.AppTomlConfig.Address, s.config.AppTomlConfig.Transport, s.Consensus)
@@ -181,12 +208,23 @@ func (s *CometBFTServer[T]) Start(ctx context.Context) error {
 	return s.Node.Start()
 }
 
-func (s *CometBFTServer[T]) Stop(context.Context) error {
+func (s *CometBFTServer[T]) Stop(ctx context.Context) error {
 	if s.Node != nil && s.Node.IsRunning() {
-		return s.Node.Stop()
+		if err := s.Node.Stop(); err != nil {
+			return err
+		}
 	}
 
-	return nil
+	var errs []error
+	for _, c := range s.streamingClosers {
+		errs = append(errs, c.Close())
+	}
+
+	if s.shutdownTracing != nil {
+		errs = append(errs, s.shutdownTracing(ctx))
+	}
+
+	return errors.Join(errs...)
 }
 
 // returns a function which returns the genesis doc from the genesis file.
@@ -2,13 +2,145 @@ package cometbft
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+
+	"github.com/hashicorp/go-plugin"
 
 	"cosmossdk.io/core/event"
 	"cosmossdk.io/core/server"
 	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
 	"cosmossdk.io/server/v2/streaming"
+	"cosmossdk.io/server/v2/streaming/filesink"
 )
 
+// newStreamingPlugin loads a streaming plugin, it is replaced in tests.
+var newStreamingPlugin = streaming.NewStreamingPlugin
+
+// newStreamingManager creates a streaming manager with the built-in and plugin listeners enabled in the
+// configuration, wrapped with at-least-once delivery when it is configured. It returns the listeners to close when the
+// server stops, in closing order. Relative directories are resolved against the node home.
+func newStreamingManager(cfg StreamingConfig, home string, logger log.Logger) (streaming.Manager, []io.Closer, error) {
+	var (
+		sm      streaming.Manager
+		closers []io.Closer
+	)
+
+	addListener := func(name string, l streaming.Listener) error {
+		if cfg.Delivery.Dir == "" {
+			sm.Listeners = append(sm.Listeners, l)
+			return nil
+		}
+
+		deliveryCfg := cfg.Delivery
+		deliveryCfg.Dir = resolvePath(home, deliveryCfg.Dir)
+		gl, err := streaming.NewGuaranteedListener(name, l, deliveryCfg, logger)
+		if err != nil {
+			return fmt.Errorf("failed to create %s guaranteed listener: %w", name, err)
+		}
+		sm.Listeners = append(sm.Listeners, gl)
+		// the guaranteed listener stops delivering before the wrapped listener is closed
+		closers = append([]io.Closer{gl}, closers...)
+		return nil
+	}
+
+	if cfg.FileSink.Dir != "" {
+		fileSinkCfg := cfg.FileSink
+		fileSinkCfg.Dir = resolvePath(home, fileSinkCfg.Dir)
+
+		l, err := filesink.NewListener(fileSinkCfg)
+		if err != nil {
+			return streaming.Manager{}, nil, fmt.Errorf("failed to create file sink listener: %w", err)
+		}
+		closers = append(closers, l)
+
+		if err := addListener("file-sink", l); err != nil {
+			_ = l.Close()
+			return streaming.Manager{}, nil, err
+		}
+	}
+
+	if cfg.ABCI.Plugin != "" {
+		l, err := newPluginListener(cfg.ABCI)
+		if err != nil {
+			closeAll(closers)
+			return streaming.Manager{}, nil, err
+		}
+		closers = append(closers, closerFunc(func() error {
+			plugin.CleanupClients()
+			return nil
+		}))
+
+		if err := addListener("abci-"+cfg.ABCI.Plugin, l); err != nil {
+			closeAll(closers)
+			return streaming.Manager{}, nil, err
+		}
+		sm.StopNodeOnErr = cfg.ABCI.StopNodeOnErr
+	}
+
+	return sm, closers, nil
+}
+
+// newPluginListener loads the streaming plugin of the configuration, streaming the state changes of its keys only.
+func newPluginListener(cfg streaming.ListenerConfig) (streaming.Listener, error) {
+	raw, err := newStreamingPlugin(cfg.Plugin, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load streaming plugin %s: %w", cfg.Plugin, err)
+	}
+
+	l, ok := raw.(streaming.Listener)
+	if !ok {
+		plugin.CleanupClients()
+		return nil, fmt.Errorf("streaming plugin %s does not implement streaming.Listener", cfg.Plugin)
+	}
+
+	if slices.Contains(cfg.Keys, "*") {
+		return l, nil
+	}
+
+	return keysListener{Listener: l, keys: cfg.Keys}, nil
+}
+
+// keysListener only streams the state changes of the given store keys to the wrapped listener.
+type keysListener struct {
+	streaming.Listener
+	keys []string
+}
+
+// ListenStateChanges implements streaming.Listener.
+func (l keysListener) ListenStateChanges(ctx context.Context, changeSet []*streaming.StoreKVPair) error {
+	filtered := make([]*streaming.StoreKVPair, 0, len(changeSet))
+	for _, kv := range changeSet {
+		if slices.Contains(l.keys, string(kv.Address)) {
+			filtered = append(filtered, kv)
+		}
+	}
+
+	return l.Listener.ListenStateChanges(ctx, filtered)
+}
+
+type closerFunc func() error
+
+func (f closerFunc) Close() error { return f() }
+
+// closeAll closes the given listeners, in order, ignoring their errors.
+func closeAll(closers []io.Closer) {
+	for _, c := range closers {
+		_ = c.Close()
+	}
+}
+
+func resolvePath(home, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(home, path)
+}
+
 // streamDeliverBlockChanges will stream all the changes happened during deliver block.
 func (c *Consensus[T]) streamDeliverBlockChanges(
 	ctx context.Context,
@@ -45,10 +177,16 @@ func (c *Consensus[T]) streamDeliverBlockChanges(
 			Events:      events,
 		}); err != nil {
 			c.logger.Error("ListenDeliverBlock listening hook failed", "height", height, "err", err)
+			if c.streaming.StopNodeOnErr {
+				return err
+			}
 		}
 
 		if err := streamingListener.ListenStateChanges(ctx, intoStreamingKVPairs(stateChanges)); err != nil {
 			c.logger.Error("ListenStateChanges listening hook failed", "height", height, "err", err)
+			if c.streaming.StopNodeOnErr {
+				return err
+			}
 		}
 	}
 	return nil
@@ -0,0 +1,102 @@
+package cometbft
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/server/v2/streaming"
+	"cosmossdk.io/server/v2/streaming/filesink"
+)
+
+func TestNewStreamingManager(t *testing.T) {
+	home := t.TempDir()
+	cfg := DefaultAppTomlConfig().Streaming
+
+	// no listener is enabled by default
+	sm, closers, err := newStreamingManager(cfg, home, log.NewNopLogger())
+	require.NoError(t, err)
+	require.Empty(t, sm.Listeners)
+	require.Empty(t, closers)
+
+	cfg.FileSink.Dir = "data/file-sink"
+	sm, closers, err = newStreamingManager(cfg, home, log.NewNopLogger())
+	require.NoError(t, err)
+	require.Len(t, sm.Listeners, 1)
+	require.IsType(t, &filesink.Listener{}, sm.Listeners[0])
+	require.DirExists(t, filepath.Join(home, "data/file-sink"))
+	for _, c := range closers {
+		require.NoError(t, c.Close())
+	}
+
+	// the listeners are wrapped with at-least-once delivery
+	cfg.Delivery.Dir = "data/streaming"
+	sm, closers, err = newStreamingManager(cfg, home, log.NewNopLogger())
+	require.NoError(t, err)
+	require.Len(t, sm.Listeners, 1)
+	require.IsType(t, &streaming.GuaranteedListener{}, sm.Listeners[0])
+	require.DirExists(t, filepath.Join(home, "data/streaming/file-sink"))
+	require.Len(t, closers, 2)
+	for _, c := range closers {
+		require.NoError(t, c.Close())
+	}
+}
+
+// recordingListener records the state changes streamed to a plugin.
+type recordingListener struct {
+	changeSet []*streaming.StoreKVPair
+}
+
+func (l *recordingListener) ListenDeliverBlock(context.Context, streaming.ListenDeliverBlockRequest) error {
+	return nil
+}
+
+func (l *recordingListener) ListenStateChanges(_ context.Context, changeSet []*streaming.StoreKVPair) error {
+	l.changeSet = changeSet
+	return nil
+}
+
+func TestNewStreamingManagerPlugin(t *testing.T) {
+	plugin := &recordingListener{}
+	newStreamingPlugin = func(name, _ string) (interface{}, error) {
+		require.Equal(t, "grpc", name)
+		return plugin, nil
+	}
+	t.Cleanup(func() { newStreamingPlugin = streaming.NewStreamingPlugin })
+
+	home := t.TempDir()
+	cfg := DefaultAppTomlConfig().Streaming
+	cfg.ABCI.Plugin = "grpc"
+	cfg.ABCI.Keys = []string{"bank"}
+	cfg.ABCI.StopNodeOnErr = false
+
+	// only the state changes of the configured keys are streamed to the plugin
+	sm, closers, err := newStreamingManager(cfg, home, log.NewNopLogger())
+	require.NoError(t, err)
+	require.Len(t, sm.Listeners, 1)
+	require.False(t, sm.StopNodeOnErr)
+	changeSet := []*streaming.StoreKVPair{{Address: []byte("bank"), Key: []byte{1}}, {Address: []byte("acc"), Key: []byte{2}}}
+	require.NoError(t, sm.Listeners[0].ListenStateChanges(context.Background(), changeSet))
+	require.Equal(t, changeSet[:1], plugin.changeSet)
+	for _, c := range closers {
+		require.NoError(t, c.Close())
+	}
+
+	// the plugin is wrapped with at-least-once delivery along with the built-in listeners
+	cfg.ABCI.Keys = []string{"*"}
+	cfg.FileSink.Dir = "data/file-sink"
+	cfg.Delivery.Dir = "data/streaming"
+	sm, closers, err = newStreamingManager(cfg, home, log.NewNopLogger())
+	require.NoError(t, err)
+	require.Len(t, sm.Listeners, 2)
+	for _, l := range sm.Listeners {
+		require.IsType(t, &streaming.GuaranteedListener{}, l)
+	}
+	require.DirExists(t, filepath.Join(home, "data/streaming/abci-grpc"))
+	for _, c := range closers {
+		require.NoError(t, c.Close())
+	}
+}
@@ -54,6 +54,11 @@ func (em *eventManager) EmitKV(eventType string, attrs ...event.Attribute) error
 	return nil
 }
 
+// EmittedEvents returns the events emitted so far in the execution context of the event manager.
+func (em *eventManager) EmittedEvents() []event.Event {
+	return slices.Clone(em.executionContext.events)
+}
+
 // TypedEventToEvent takes typed event and converts to Event object
 func TypedEventToEvent(tev transaction.Msg) (event.Event, error) {
 	evtType := gogoproto.MessageName(tev)
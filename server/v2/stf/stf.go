@@ -5,6 +5,9 @@ import (
 	"errors"
 	"fmt"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
 	appmodulev2 "cosmossdk.io/core/appmodule/v2"
 	corecontext "cosmossdk.io/core/context"
 	"cosmossdk.io/core/event"
@@ -90,6 +93,12 @@ func (s STF[T]) DeliverBlock(
 	block *server.BlockRequest[T],
 	state store.ReaderMap,
 ) (blockResult *server.BlockResponse, newState store.WriterMap, err error) {
+	ctx, span := tracer.Start(ctx, "DeliverBlock", trace.WithAttributes(
+		attribute.Int64("height", int64(block.Height)),
+		attribute.Int("txs", len(block.Txs)),
+	))
+	defer func() { endSpan(span, err) }()
+
 	// creates a new branchFn state, from the readonly view of the state
 	// that can be written to.
 	newState = s.branchFn(state)
@@ -174,6 +183,12 @@ func (s STF[T]) deliverTx(
 	execMode transaction.ExecMode,
 	hi header.Info,
 ) server.TxResult {
+	ctx, span := tracer.Start(ctx, "DeliverTx", trace.WithAttributes(attribute.Int("mode", int(execMode))))
+	if span.IsRecording() {
+		span.SetAttributes(attribute.String("tx.hash", fmt.Sprintf("%X", tx.Hash())))
+	}
+	defer span.End()
+
 	// recover in the case of a panic
 	var recoveryError error
 	defer func() {
@@ -198,12 +213,16 @@ func (s STF[T]) deliverTx(
 
 	validateGas, validationEvents, err := s.validateTx(ctx, state, gasLimit, tx, execMode)
 	if err != nil {
+		span.RecordError(err)
 		return server.TxResult{
 			Error: err,
 		}
 	}
 
 	execResp, execGas, execEvents, err := s.execTx(ctx, state, gasLimit-validateGas, tx, execMode, hi)
+	if err != nil {
+		span.RecordError(err)
+	}
 	return server.TxResult{
 		Events:    append(validationEvents, execEvents...),
 		GasUsed:   execGas + validateGas,
@@ -222,6 +241,9 @@ func (s STF[T]) validateTx(
 	tx T,
 	execMode transaction.ExecMode,
 ) (gasUsed uint64, events []event.Event, err error) {
+	ctx, span := tracer.Start(ctx, "ValidateTx")
+	defer func() { endSpan(span, err) }()
+
 	validateState := s.branchFn(state)
 	hi, err := s.getHeaderInfo(validateState)
 	if err != nil {
@@ -318,7 +340,10 @@ func (s STF[T]) runTxMsgs(
 	execCtx.setGasLimit(gasLimit)
 	for i, msg := range msgs {
 		execCtx.sender = txSenders[i]
+		// the span is not set on execCtx, as handlers expect the execution context itself.
+		_, span := tracer.Start(execCtx, msgTypeURL(msg), trace.WithAttributes(attribute.Int("msg_index", i)))
 		resp, err := s.msgRouter.Invoke(execCtx, msg)
+		endSpan(span, err)
 		if err != nil {
 			return nil, 0, nil, fmt.Errorf("message execution at index %d failed: %w", i, err)
 		}
@@ -334,7 +359,9 @@ func (s STF[T]) preBlock(
 	ctx *executionContext,
 	txs []T,
 ) ([]event.Event, error) {
+	_, span := tracer.Start(ctx, "PreBlock")
 	err := s.doPreBlock(ctx, txs)
+	endSpan(span, err)
 	if err != nil {
 		return nil, err
 	}
@@ -350,7 +377,9 @@ func (s STF[T]) preBlock(
 func (s STF[T]) beginBlock(
 	ctx *executionContext,
 ) (beginBlockEvents []event.Event, err error) {
+	_, span := tracer.Start(ctx, "BeginBlock")
 	err = s.doBeginBlock(ctx)
+	endSpan(span, err)
 	if err != nil {
 		return nil, err
 	}
@@ -366,7 +395,9 @@ func (s STF[T]) beginBlock(
 func (s STF[T]) endBlock(
 	ctx *executionContext,
 ) ([]event.Event, []appmodulev2.ValidatorUpdate, error) {
+	_, span := tracer.Start(ctx, "EndBlock")
 	err := s.doEndBlock(ctx)
+	endSpan(span, err)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,20 @@
+package stf
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer is the OpenTelemetry tracer used to trace the block, transaction and
+// message execution. It is a no-op unless a tracer provider is registered.
+var tracer = otel.Tracer("cosmossdk.io/server/v2/stf")
+
+// endSpan records err, if any, on the span and ends it.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
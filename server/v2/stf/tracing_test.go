@@ -0,0 +1,116 @@
+package stf
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"testing"
+	"time"
+
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	"cosmossdk.io/core/server"
+	"cosmossdk.io/server/v2/stf/branch"
+	"cosmossdk.io/server/v2/stf/gas"
+	"cosmossdk.io/server/v2/stf/mock"
+)
+
+// recordSpans replaces the package tracer with one recording the ended spans
+// for the duration of the test.
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	rec := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec))
+	prev := tracer
+	tracer = provider.Tracer("cosmossdk.io/server/v2/stf")
+	t.Cleanup(func() { tracer = prev })
+	return rec
+}
+
+func childSpans(rec *tracetest.SpanRecorder, parent trace.SpanContext) map[string][]sdktrace.ReadOnlySpan {
+	spans := make(map[string][]sdktrace.ReadOnlySpan)
+	for _, s := range rec.Ended() {
+		if s.Parent().SpanID() == parent.SpanID() {
+			spans[s.Name()] = append(spans[s.Name()], s)
+		}
+	}
+	return spans
+}
+
+func TestTracing(t *testing.T) {
+	rec := recordSpans(t)
+
+	s := &STF[mock.Tx]{
+		doPreBlock:        func(ctx context.Context, txs []mock.Tx) error { return nil },
+		doBeginBlock:      func(ctx context.Context) error { return nil },
+		doEndBlock:        func(ctx context.Context) error { return nil },
+		doValidatorUpdate: func(ctx context.Context) ([]appmodulev2.ValidatorUpdate, error) { return nil, nil },
+		doTxValidation: func(ctx context.Context, tx mock.Tx) error {
+			if tx.GasLimit == 0 {
+				return errors.New("no gas")
+			}
+			return nil
+		},
+		postTxExec:          func(ctx context.Context, tx mock.Tx, success bool) error { return nil },
+		branchFn:            branch.DefaultNewWriterMap,
+		makeGasMeter:        gas.DefaultGasMeter,
+		makeGasMeteredState: gas.DefaultWrapWithGasMeter,
+	}
+	addMsgHandlerToSTF(t, s, func(ctx context.Context, msg *gogotypes.BoolValue) (*gogotypes.BoolValue, error) {
+		return nil, nil
+	})
+
+	sum := sha256.Sum256([]byte("test-hash"))
+	okTx := mock.Tx{Sender: []byte("sender"), Msg: &gogotypes.BoolValue{Value: true}, GasLimit: 100_000}
+	failingTx := mock.Tx{Sender: []byte("sender"), Msg: &gogotypes.BoolValue{Value: true}}
+	_, _, err := s.DeliverBlock(context.Background(), &server.BlockRequest[mock.Tx]{
+		Height:  uint64(1),
+		Time:    time.Date(2024, 2, 3, 18, 23, 0, 0, time.UTC),
+		AppHash: sum[:],
+		Hash:    sum[:],
+		Txs:     []mock.Tx{okTx, failingTx},
+	}, mock.DB())
+	if err != nil {
+		t.Fatalf("DeliverBlock error: %v", err)
+	}
+
+	var block sdktrace.ReadOnlySpan
+	for _, s := range rec.Ended() {
+		if s.Name() == "DeliverBlock" {
+			block = s
+		}
+	}
+	if block == nil {
+		t.Fatal("missing DeliverBlock span")
+	}
+
+	children := childSpans(rec, block.SpanContext())
+	for _, name := range []string{"PreBlock", "BeginBlock", "EndBlock"} {
+		if len(children[name]) != 1 {
+			t.Errorf("expected 1 %s span, got %d", name, len(children[name]))
+		}
+	}
+	txs := children["DeliverTx"]
+	if len(txs) != 2 {
+		t.Fatalf("expected 2 DeliverTx spans, got %d", len(txs))
+	}
+
+	okChildren := childSpans(rec, txs[0].SpanContext())
+	if len(okChildren["ValidateTx"]) != 1 || len(okChildren[msgTypeURL(okTx.Msg)]) != 1 {
+		t.Errorf("expected the ValidateTx and message spans under the first tx, got %v", okChildren)
+	}
+
+	failedChildren := childSpans(rec, txs[1].SpanContext())
+	validate := failedChildren["ValidateTx"]
+	if len(validate) != 1 || validate[0].Status().Code != codes.Error {
+		t.Errorf("expected a failed ValidateTx span under the second tx, got %v", failedChildren)
+	}
+	if len(failedChildren[msgTypeURL(failingTx.Msg)]) != 0 {
+		t.Error("expected no message span for the tx failing validation")
+	}
+}
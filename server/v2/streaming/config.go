@@ -1,5 +1,10 @@
 package streaming
 
+import (
+	"fmt"
+	"time"
+)
+
 // State Streaming configuration
 
 // StreamingConfig defines application configuration for external streaming services
@@ -23,3 +28,48 @@ type ListenerConfig struct {
 	// stop-node-on-err specifies whether to stop the node on message delivery error.
 	StopNodeOnErr bool `mapstructure:"stop-node-on-err" toml:"stop-node-on-err" comment:"stop-node-on-err specifies whether to stop the node on message delivery error."`
 }
+
+// DeliveryConfig defines the configuration of the at-least-once delivery mode of a listener.
+type DeliveryConfig struct {
+	// Dir defines the directory where the undelivered blocks and the last acknowledged height are persisted.
+	Dir string `mapstructure:"dir" toml:"dir" comment:"Dir defines the directory where the undelivered blocks and the last acknowledged height are persisted."`
+	// MaxLag defines the number of undelivered blocks after which the node waits for the listener to catch up.
+	// 0 means the node never waits.
+	MaxLag int `mapstructure:"max-lag" toml:"max-lag" comment:"MaxLag defines the number of undelivered blocks after which the node waits for the listener to catch up. 0 means the node never waits."`
+	// MaxWait defines how long the node waits at most for the listener to catch up once MaxLag is exceeded.
+	// The blocks are persisted, so the node then moves on and the listener delivers them later.
+	MaxWait time.Duration `mapstructure:"max-wait" toml:"max-wait" comment:"MaxWait defines how long the node waits at most for the listener to catch up once MaxLag is exceeded. The blocks are persisted, so the node then moves on and the listener delivers them later."`
+	// InitialBackoff defines the delay before retrying a failed delivery. It is doubled after every failure.
+	InitialBackoff time.Duration `mapstructure:"initial-backoff" toml:"initial-backoff" comment:"InitialBackoff defines the delay before retrying a failed delivery. It is doubled after every failure."`
+	// MaxBackoff defines the maximum delay between two delivery attempts.
+	MaxBackoff time.Duration `mapstructure:"max-backoff" toml:"max-backoff" comment:"MaxBackoff defines the maximum delay between two delivery attempts."`
+}
+
+// DefaultDeliveryConfig returns the default at-least-once delivery configuration.
+func DefaultDeliveryConfig() DeliveryConfig {
+	return DeliveryConfig{
+		Dir:            "",
+		MaxLag:         1000,
+		MaxWait:        5 * time.Second,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     30 * time.Second,
+	}
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c DeliveryConfig) Validate() error {
+	if c.Dir == "" {
+		return fmt.Errorf("delivery dir must be set")
+	}
+	if c.MaxLag < 0 {
+		return fmt.Errorf("delivery max-lag must not be negative, got %d", c.MaxLag)
+	}
+	if c.MaxLag > 0 && c.MaxWait <= 0 {
+		return fmt.Errorf("delivery max-wait must be positive when max-lag is set, got %s", c.MaxWait)
+	}
+	if c.InitialBackoff <= 0 || c.MaxBackoff < c.InitialBackoff {
+		return fmt.Errorf("delivery initial-backoff must be positive and not greater than max-backoff, got %s and %s", c.InitialBackoff, c.MaxBackoff)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package filesink
+
+import "fmt"
+
+// Supported record encodings.
+const (
+	// FormatProtobuf writes length-prefixed protobuf records.
+	FormatProtobuf = "protobuf"
+	// FormatJSONL writes one JSON record per line.
+	FormatJSONL = "jsonl"
+)
+
+// Supported fsync policies.
+const (
+	// FsyncBlock syncs the active file to disk at the end of every block.
+	FsyncBlock = "block"
+	// FsyncRotate syncs a file to disk only when it is rotated or closed.
+	FsyncRotate = "rotate"
+	// FsyncNone never explicitly syncs files to disk and relies on the operating system.
+	FsyncNone = "none"
+)
+
+// DefaultConfig returns the default file sink configuration.
+func DefaultConfig() Config {
+	return Config{
+		Dir:              "",
+		Prefix:           "blocks",
+		Format:           FormatProtobuf,
+		MaxFileSize:      256 << 20, // 256 MiB
+		MaxBlocksPerFile: 10_000,
+		Fsync:            FsyncBlock,
+	}
+}
+
+// Config defines the configuration of the file sink listener.
+type Config struct {
+	// Dir defines the directory the files are written to.
+	Dir string `mapstructure:"dir" toml:"dir" comment:"Dir defines the directory the files are written to."`
+
+	// Prefix defines the prefix of the file names.
+	Prefix string `mapstructure:"prefix" toml:"prefix" comment:"Prefix defines the prefix of the file names."`
+
+	// Format defines the record encoding, either "protobuf" (length-prefixed) or "jsonl".
+	Format string `mapstructure:"format" toml:"format" comment:"Format defines the record encoding, either \"protobuf\" (length-prefixed) or \"jsonl\"."`
+
+	// MaxFileSize defines the size in bytes after which a file is rotated. 0 disables size-based rotation.
+	MaxFileSize int64 `mapstructure:"max-file-size" toml:"max-file-size" comment:"MaxFileSize defines the size in bytes after which a file is rotated. 0 disables size-based rotation."`
+
+	// MaxBlocksPerFile defines the number of blocks after which a file is rotated. 0 disables height-based rotation.
+	MaxBlocksPerFile int64 `mapstructure:"max-blocks-per-file" toml:"max-blocks-per-file" comment:"MaxBlocksPerFile defines the number of blocks after which a file is rotated. 0 disables height-based rotation."`
+
+	// Fsync defines when files are synced to disk: "block", "rotate" or "none".
+	Fsync string `mapstructure:"fsync" toml:"fsync" comment:"Fsync defines when files are synced to disk: \"block\", \"rotate\" or \"none\"."`
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c Config) Validate() error {
+	if c.Dir == "" {
+		return fmt.Errorf("file sink dir must be set")
+	}
+
+	if c.Prefix == "" {
+		return fmt.Errorf("file sink prefix must be set")
+	}
+
+	switch c.Format {
+	case FormatProtobuf, FormatJSONL:
+	default:
+		return fmt.Errorf("unknown file sink format %q, expected %q or %q", c.Format, FormatProtobuf, FormatJSONL)
+	}
+
+	switch c.Fsync {
+	case FsyncBlock, FsyncRotate, FsyncNone:
+	default:
+		return fmt.Errorf("unknown file sink fsync policy %q, expected %q, %q or %q", c.Fsync, FsyncBlock, FsyncRotate, FsyncNone)
+	}
+
+	if c.MaxFileSize < 0 || c.MaxBlocksPerFile < 0 {
+		return fmt.Errorf("file sink max-file-size and max-blocks-per-file must not be negative")
+	}
+
+	return nil
+}
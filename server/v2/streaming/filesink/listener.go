@@ -0,0 +1,329 @@
+// Package filesink provides a streaming.Listener writing the delivered blocks
+// and the state changes to rotating local files, and a library to read them.
+//
+// Each file, or segment, holds the records of consecutive blocks, starting at the
+// height encoded in its name. A block is written as a KindDeliverBlock record
+// followed by a KindStateChanges record. When a segment is rotated, the SHA-256
+// checksum of its content is written next to it in a ".sha256" file. Segments
+// left without checksum by a previous run are finalized when the listener is created.
+package filesink
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"cosmossdk.io/server/v2/streaming"
+)
+
+var _ streaming.Listener = (*Listener)(nil)
+
+// Listener is a streaming.Listener writing block data and state changes to
+// rotating files. It is safe for concurrent use.
+type Listener struct {
+	cfg Config
+
+	mu sync.Mutex
+	// height is the height of the block being streamed.
+	height int64
+	// active is the segment being written, nil until the first record.
+	active *segmentWriter
+}
+
+// segmentWriter writes records to a segment file.
+type segmentWriter struct {
+	path        string
+	startHeight int64
+	file        *os.File
+	w           *bufio.Writer
+	hash        hash.Hash
+	size        int64
+}
+
+// NewListener creates a new file sink listener from the given configuration.
+func NewListener(cfg Config) (*Listener, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(cfg.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create file sink directory: %w", err)
+	}
+
+	l := &Listener{cfg: cfg}
+	if err := l.finalizeOrphans(); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// finalizeOrphans finalizes the segments left without checksum by a previous run,
+// e.g. when the node was stopped without closing the listener. A partially written
+// block at the end of such a segment is truncated, as the node streams it again,
+// so that the segment only holds complete blocks. The segment is then finalized
+// like a rotated one, and removed if it has no complete block.
+func (l *Listener) finalizeOrphans() error {
+	segments, err := ListSegments(l.cfg.Dir, l.cfg.Prefix)
+	if err != nil {
+		return fmt.Errorf("failed to list segments: %w", err)
+	}
+
+	for _, s := range segments {
+		if _, err := os.Stat(s.Path + checksumExt); err == nil {
+			continue
+		} else if !os.IsNotExist(err) {
+			return err
+		}
+
+		if err := l.finalizeOrphan(s); err != nil {
+			return fmt.Errorf("failed to finalize segment %s: %w", s.Path, err)
+		}
+	}
+
+	return nil
+}
+
+func (l *Listener) finalizeOrphan(s Segment) error {
+	end, err := completeBlocksSize(s)
+	if err != nil {
+		return err
+	}
+
+	if end == 0 {
+		return os.Remove(s.Path)
+	}
+
+	f, err := os.OpenFile(s.Path, os.O_RDWR, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if err := f.Truncate(end); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	if l.cfg.Fsync != FsyncNone {
+		if err := f.Sync(); err != nil {
+			_ = f.Close()
+			return err
+		}
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return writeChecksum(s.Path, h.Sum(nil))
+}
+
+// completeBlocksSize returns the size of the leading part of the segment holding
+// complete blocks, i.e. ending with a KindStateChanges record.
+func completeBlocksSize(s Segment) (int64, error) {
+	f, err := os.Open(s.Path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	cr := &countingReader{r: f}
+	br := bufio.NewReader(cr)
+	dec, err := newDecoder(s.Format, br)
+	if err != nil {
+		return 0, err
+	}
+
+	var end int64
+	for {
+		rec, err := dec.next()
+		switch {
+		case err == nil:
+			if rec.Kind == KindStateChanges {
+				end = cr.n - int64(br.Buffered())
+			}
+		case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+			return end, nil
+		default:
+			return 0, err
+		}
+	}
+}
+
+// countingReader counts the bytes read from the underlying reader.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// ListenDeliverBlock implements streaming.Listener.
+// It rotates the active segment if needed, so that a block is never split across segments.
+func (l *Listener) ListenDeliverBlock(_ context.Context, req streaming.ListenDeliverBlockRequest) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.height = req.BlockHeight
+	if l.active != nil && l.shouldRotate(req.BlockHeight) {
+		if err := l.closeActive(); err != nil {
+			return err
+		}
+	}
+
+	return l.write(Record{Kind: KindDeliverBlock, DeliverBlock: &req})
+}
+
+// ListenStateChanges implements streaming.Listener.
+// The state changes are attributed to the last delivered block. As they complete
+// the block, the segment is flushed and, depending on the fsync policy, synced.
+func (l *Listener) ListenStateChanges(_ context.Context, changeSet []*streaming.StoreKVPair) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := l.write(Record{Kind: KindStateChanges, StateChanges: &streaming.ListenStateChangesRequest{
+		BlockHeight: l.height,
+		ChangeSet:   changeSet,
+	}}); err != nil {
+		return err
+	}
+
+	if err := l.active.w.Flush(); err != nil {
+		return fmt.Errorf("failed to flush %s: %w", l.active.path, err)
+	}
+
+	if l.cfg.Fsync == FsyncBlock {
+		if err := l.active.file.Sync(); err != nil {
+			return fmt.Errorf("failed to sync %s: %w", l.active.path, err)
+		}
+	}
+
+	return nil
+}
+
+// Close flushes and closes the active segment and writes its checksum.
+func (l *Listener) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.active == nil {
+		return nil
+	}
+
+	return l.closeActive()
+}
+
+func (l *Listener) shouldRotate(height int64) bool {
+	if l.cfg.MaxFileSize > 0 && l.active.size >= l.cfg.MaxFileSize {
+		return true
+	}
+
+	return l.cfg.MaxBlocksPerFile > 0 && height-l.active.startHeight >= l.cfg.MaxBlocksPerFile
+}
+
+// write appends a record to the active segment, opening a new one if needed.
+// The caller must hold the lock.
+func (l *Listener) write(r Record) error {
+	if l.active == nil {
+		if err := l.openActive(l.height); err != nil {
+			return err
+		}
+	}
+
+	bz, err := encodeRecord(l.cfg.Format, r)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s record at height %d: %w", r.Kind, r.Height(), err)
+	}
+
+	n, err := l.active.w.Write(bz)
+	l.active.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("failed to write to %s: %w", l.active.path, err)
+	}
+	_, _ = l.active.hash.Write(bz)
+
+	return nil
+}
+
+// openActive creates a new segment starting at the given height.
+// A segment left over by a previous run at the same height, e.g. when the node
+// is restarted and replays a block, is overwritten.
+// The caller must hold the lock.
+func (l *Listener) openActive(height int64) error {
+	path := filepath.Join(l.cfg.Dir, segmentName(l.cfg.Prefix, height, l.cfg.Format))
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to create segment: %w", err)
+	}
+
+	// remove the checksum of an overwritten segment
+	if err := os.Remove(path + checksumExt); err != nil && !os.IsNotExist(err) {
+		_ = f.Close()
+		return fmt.Errorf("failed to remove stale checksum: %w", err)
+	}
+
+	l.active = &segmentWriter{
+		path:        path,
+		startHeight: height,
+		file:        f,
+		w:           bufio.NewWriter(f),
+		hash:        sha256.New(),
+	}
+
+	return nil
+}
+
+// closeActive flushes, syncs and closes the active segment, then writes its checksum.
+// The caller must hold the lock.
+func (l *Listener) closeActive() error {
+	s := l.active
+	l.active = nil
+
+	if err := s.w.Flush(); err != nil {
+		_ = s.file.Close()
+		return fmt.Errorf("failed to flush %s: %w", s.path, err)
+	}
+
+	if l.cfg.Fsync != FsyncNone {
+		if err := s.file.Sync(); err != nil {
+			_ = s.file.Close()
+			return fmt.Errorf("failed to sync %s: %w", s.path, err)
+		}
+	}
+
+	if err := s.file.Close(); err != nil {
+		return fmt.Errorf("failed to close %s: %w", s.path, err)
+	}
+
+	return writeChecksum(s.path, s.hash.Sum(nil))
+}
+
+// writeChecksum writes the checksum of the segment at path next to it.
+// The checksum is written to a temporary file first, so that it is never partially written.
+func writeChecksum(path string, sum []byte) error {
+	line := fmt.Sprintf("%s  %s\n", hex.EncodeToString(sum), filepath.Base(path))
+	tmp := path + checksumExt + ".tmp"
+	if err := os.WriteFile(tmp, []byte(line), 0o600); err != nil {
+		return fmt.Errorf("failed to write checksum of %s: %w", path, err)
+	}
+
+	return os.Rename(tmp, path+checksumExt)
+}
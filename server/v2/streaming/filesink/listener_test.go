@@ -0,0 +1,217 @@
+package filesink
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/server/v2/streaming"
+)
+
+func writeBlocks(t *testing.T, l *Listener, from, to int64) {
+	t.Helper()
+	ctx := context.Background()
+	for h := from; h <= to; h++ {
+		require.NoError(t, l.ListenDeliverBlock(ctx, streaming.ListenDeliverBlockRequest{
+			BlockHeight: h,
+			Txs:         [][]byte{[]byte("tx")},
+			TxResults:   []*streaming.ExecTxResult{{Code: 0, GasUsed: 10}},
+		}))
+		require.NoError(t, l.ListenStateChanges(ctx, []*streaming.StoreKVPair{
+			{Address: []byte("bank"), Key: []byte{byte(h)}, Value: []byte("value")},
+		}))
+	}
+}
+
+func readAll(t *testing.T, dir string, fromHeight int64) []Record {
+	t.Helper()
+	r, err := NewReader(dir, "blocks", fromHeight)
+	require.NoError(t, err)
+	defer r.Close()
+
+	var records []Record
+	for {
+		rec, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return records
+		}
+		require.NoError(t, err)
+		records = append(records, rec)
+	}
+}
+
+func TestListenerRotationAndReader(t *testing.T) {
+	for _, format := range []string{FormatProtobuf, FormatJSONL} {
+		t.Run(format, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.Dir = t.TempDir()
+			cfg.Format = format
+			cfg.MaxBlocksPerFile = 3
+			l, err := NewListener(cfg)
+			require.NoError(t, err)
+
+			writeBlocks(t, l, 1, 7)
+
+			segments, err := ListSegments(cfg.Dir, cfg.Prefix)
+			require.NoError(t, err)
+			require.Len(t, segments, 3)
+			require.Equal(t, []int64{1, 4, 7}, []int64{segments[0].StartHeight, segments[1].StartHeight, segments[2].StartHeight})
+
+			// rotated segments have a checksum, the active one does not
+			require.NoError(t, segments[0].Verify())
+			require.NoError(t, segments[1].Verify())
+			require.ErrorIs(t, segments[2].Verify(), ErrNoChecksum)
+
+			records := readAll(t, cfg.Dir, 0)
+			require.Len(t, records, 14)
+			for i, rec := range records {
+				require.Equal(t, int64(i/2+1), rec.Height())
+				if i%2 == 0 {
+					require.Equal(t, KindDeliverBlock, rec.Kind)
+					require.Equal(t, [][]byte{[]byte("tx")}, rec.DeliverBlock.Txs)
+				} else {
+					require.Equal(t, KindStateChanges, rec.Kind)
+					require.Equal(t, []byte{byte(rec.Height())}, rec.StateChanges.ChangeSet[0].Key)
+				}
+			}
+
+			// resume from a given height
+			records = readAll(t, cfg.Dir, 5)
+			require.Len(t, records, 6)
+			require.Equal(t, int64(5), records[0].Height())
+
+			require.NoError(t, l.Close())
+			segments, err = ListSegments(cfg.Dir, cfg.Prefix)
+			require.NoError(t, err)
+			require.NoError(t, segments[2].Verify())
+		})
+	}
+}
+
+func TestListenerSizeRotation(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Dir = t.TempDir()
+	cfg.MaxBlocksPerFile = 0
+	cfg.MaxFileSize = 1
+	l, err := NewListener(cfg)
+	require.NoError(t, err)
+
+	writeBlocks(t, l, 1, 3)
+
+	segments, err := ListSegments(cfg.Dir, cfg.Prefix)
+	require.NoError(t, err)
+	require.Len(t, segments, 3)
+}
+
+func TestReaderPartialAndCorruptedRecords(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Dir = t.TempDir()
+	cfg.MaxBlocksPerFile = 2
+	l, err := NewListener(cfg)
+	require.NoError(t, err)
+	writeBlocks(t, l, 1, 3)
+
+	segments, err := ListSegments(cfg.Dir, cfg.Prefix)
+	require.NoError(t, err)
+	require.Len(t, segments, 2)
+
+	// a partially written record at the end of the last segment is not returned
+	f, err := os.OpenFile(segments[1].Path, os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{0x10, byte(KindDeliverBlock)})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	require.Len(t, readAll(t, cfg.Dir, 0), 6)
+
+	// a corrupted record fails the checksum
+	bz, err := os.ReadFile(segments[0].Path)
+	require.NoError(t, err)
+	bz[len(bz)-5] ^= 0xff
+	require.NoError(t, os.WriteFile(segments[0].Path, bz, 0o600))
+	require.ErrorIs(t, segments[0].Verify(), ErrChecksumMismatch)
+
+	r, err := NewReader(cfg.Dir, cfg.Prefix, 0)
+	require.NoError(t, err)
+	defer r.Close()
+	for {
+		_, err = r.Next()
+		if err != nil {
+			break
+		}
+	}
+	require.ErrorIs(t, err, ErrChecksumMismatch)
+}
+
+func TestListenerFinalizesOrphanedSegments(t *testing.T) {
+	for _, format := range []string{FormatProtobuf, FormatJSONL} {
+		t.Run(format, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.Dir = t.TempDir()
+			cfg.Format = format
+			cfg.MaxBlocksPerFile = 3
+			l, err := NewListener(cfg)
+			require.NoError(t, err)
+			writeBlocks(t, l, 1, 5)
+
+			// the node stops while writing block 6, without closing the listener
+			segments, err := ListSegments(cfg.Dir, cfg.Prefix)
+			require.NoError(t, err)
+			require.Len(t, segments, 2)
+			partial, err := encodeRecord(format, Record{Kind: KindDeliverBlock, DeliverBlock: &streaming.ListenDeliverBlockRequest{BlockHeight: 6}})
+			require.NoError(t, err)
+			f, err := os.OpenFile(segments[1].Path, os.O_APPEND|os.O_WRONLY, 0o600)
+			require.NoError(t, err)
+			_, err = f.Write(append(partial, partial[:len(partial)/2]...))
+			require.NoError(t, err)
+			require.NoError(t, f.Close())
+
+			// an orphaned segment without a complete block is removed
+			empty := Segment{Path: filepath.Join(cfg.Dir, segmentName(cfg.Prefix, 100, format)), Format: format}
+			require.NoError(t, os.WriteFile(empty.Path, partial, 0o600))
+
+			l, err = NewListener(cfg)
+			require.NoError(t, err)
+
+			segments, err = ListSegments(cfg.Dir, cfg.Prefix)
+			require.NoError(t, err)
+			require.Len(t, segments, 2)
+			require.NoError(t, segments[1].Verify())
+			require.Len(t, readAll(t, cfg.Dir, 0), 10)
+
+			// the node streams block 6 again in a new segment
+			writeBlocks(t, l, 6, 7)
+			require.NoError(t, l.Close())
+
+			segments, err = ListSegments(cfg.Dir, cfg.Prefix)
+			require.NoError(t, err)
+			require.Len(t, segments, 3)
+			require.Equal(t, int64(6), segments[2].StartHeight)
+			records := readAll(t, cfg.Dir, 0)
+			require.Len(t, records, 14)
+			for i, rec := range records {
+				require.Equal(t, int64(i/2+1), rec.Height())
+			}
+		})
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	cfg := DefaultConfig()
+	require.Error(t, cfg.Validate())
+
+	cfg.Dir = t.TempDir()
+	require.NoError(t, cfg.Validate())
+
+	cfg.Format = "xml"
+	require.Error(t, cfg.Validate())
+
+	cfg = DefaultConfig()
+	cfg.Dir = t.TempDir()
+	cfg.Fsync = "sometimes"
+	require.Error(t, cfg.Validate())
+}
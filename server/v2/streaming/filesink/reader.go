@@ -0,0 +1,229 @@
+package filesink
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const checksumExt = ".sha256"
+
+// ErrNoChecksum is returned when verifying a segment without checksum, i.e.
+// the segment being written by the node.
+var ErrNoChecksum = errors.New("segment has no checksum")
+
+var formatExts = map[string]string{
+	FormatProtobuf: ".pb",
+	FormatJSONL:    ".jsonl",
+}
+
+// segmentName returns the file name of the segment starting at the given height.
+// The height is zero padded so that the segments are sorted by name.
+func segmentName(prefix string, startHeight int64, format string) string {
+	return fmt.Sprintf("%s-%020d%s", prefix, startHeight, formatExts[format])
+}
+
+// Segment is a file written by the file sink listener.
+type Segment struct {
+	// Path is the path of the segment file.
+	Path string
+	// StartHeight is the height of the first block of the segment.
+	StartHeight int64
+	// Format is the record encoding of the segment.
+	Format string
+}
+
+// ListSegments returns the segments with the given prefix found in dir, sorted by start height.
+func ListSegments(dir, prefix string) ([]Segment, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var segments []Segment
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+
+		name := e.Name()
+		rest, ok := strings.CutPrefix(name, prefix+"-")
+		if !ok {
+			continue
+		}
+
+		for format, ext := range formatExts {
+			height, ok := strings.CutSuffix(rest, ext)
+			if !ok {
+				continue
+			}
+
+			startHeight, err := strconv.ParseInt(height, 10, 64)
+			if err != nil {
+				continue
+			}
+
+			segments = append(segments, Segment{
+				Path:        filepath.Join(dir, name),
+				StartHeight: startHeight,
+				Format:      format,
+			})
+		}
+	}
+
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].StartHeight < segments[j].StartHeight
+	})
+
+	return segments, nil
+}
+
+// Verify checks the content of the segment against its checksum.
+// It returns ErrNoChecksum if the segment has not been rotated yet.
+func (s Segment) Verify() error {
+	bz, err := os.ReadFile(s.Path + checksumExt)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ErrNoChecksum
+		}
+		return err
+	}
+
+	fields := strings.Fields(string(bz))
+	if len(fields) == 0 {
+		return fmt.Errorf("%w: empty checksum file", ErrChecksumMismatch)
+	}
+
+	f, err := os.Open(s.Path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return err
+	}
+
+	if hex.EncodeToString(h.Sum(nil)) != fields[0] {
+		return fmt.Errorf("%w: %s", ErrChecksumMismatch, s.Path)
+	}
+
+	return nil
+}
+
+// SegmentReader reads the records of a segment.
+type SegmentReader struct {
+	file *os.File
+	dec  decoder
+}
+
+// OpenSegment opens the given segment for reading.
+func OpenSegment(s Segment) (*SegmentReader, error) {
+	f, err := os.Open(s.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	dec, err := newDecoder(s.Format, bufio.NewReader(f))
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	return &SegmentReader{file: f, dec: dec}, nil
+}
+
+// Next returns the next record of the segment. It returns io.EOF at the end
+// of the segment, and io.ErrUnexpectedEOF if the segment ends with a partially
+// written record, which happens while the node is writing the segment.
+func (r *SegmentReader) Next() (Record, error) {
+	return r.dec.next()
+}
+
+// Close closes the segment file.
+func (r *SegmentReader) Close() error {
+	return r.file.Close()
+}
+
+// Reader reads the records of all the segments of a directory, in height order.
+type Reader struct {
+	segments   []Segment
+	fromHeight int64
+	current    *SegmentReader
+}
+
+// NewReader returns a reader of the records of the segments with the given
+// prefix found in dir, skipping the records below fromHeight.
+//
+// The segments are listed when the reader is created. Once the reader reaches
+// io.EOF, consumers following a running node should create a new reader starting
+// at the height following the last complete block they processed.
+func NewReader(dir, prefix string, fromHeight int64) (*Reader, error) {
+	segments, err := ListSegments(dir, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	// skip the segments ending before fromHeight
+	for len(segments) > 1 && segments[1].StartHeight <= fromHeight {
+		segments = segments[1:]
+	}
+
+	return &Reader{segments: segments, fromHeight: fromHeight}, nil
+}
+
+// Next returns the next record. It returns io.EOF once all the records have been read.
+// A partially written record at the end of the last segment is treated as the end of
+// the records, as it is still being written by the node.
+func (r *Reader) Next() (Record, error) {
+	for {
+		if r.current == nil {
+			if len(r.segments) == 0 {
+				return Record{}, io.EOF
+			}
+
+			sr, err := OpenSegment(r.segments[0])
+			if err != nil {
+				return Record{}, err
+			}
+			r.current = sr
+		}
+
+		rec, err := r.current.Next()
+		switch {
+		case err == nil:
+			if rec.Height() < r.fromHeight {
+				continue
+			}
+			return rec, nil
+
+		case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF) && len(r.segments) == 1:
+			if err := r.current.Close(); err != nil {
+				return Record{}, err
+			}
+			r.current = nil
+			r.segments = r.segments[1:]
+
+		default:
+			return Record{}, fmt.Errorf("failed to read %s: %w", r.segments[0].Path, err)
+		}
+	}
+}
+
+// Close closes the segment being read, if any.
+func (r *Reader) Close() error {
+	if r.current == nil {
+		return nil
+	}
+
+	return r.current.Close()
+}
@@ -0,0 +1,262 @@
+package filesink
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+
+	"cosmossdk.io/server/v2/streaming"
+)
+
+// Kind is the kind of data held by a record.
+type Kind byte
+
+const (
+	// KindDeliverBlock is a record holding a streaming.ListenDeliverBlockRequest.
+	KindDeliverBlock Kind = 1
+	// KindStateChanges is a record holding a streaming.ListenStateChangesRequest.
+	KindStateChanges Kind = 2
+)
+
+// String implements fmt.Stringer.
+func (k Kind) String() string {
+	switch k {
+	case KindDeliverBlock:
+		return "deliver_block"
+	case KindStateChanges:
+		return "state_changes"
+	default:
+		return fmt.Sprintf("unknown(%d)", byte(k))
+	}
+}
+
+func parseKind(s string) (Kind, error) {
+	switch s {
+	case KindDeliverBlock.String():
+		return KindDeliverBlock, nil
+	case KindStateChanges.String():
+		return KindStateChanges, nil
+	default:
+		return 0, fmt.Errorf("unknown record kind %q", s)
+	}
+}
+
+// maxRecordSize bounds the size of a single record, to protect readers from
+// allocating arbitrary amounts of memory when reading corrupted files.
+const maxRecordSize = 1 << 30
+
+var (
+	// ErrChecksumMismatch is returned when the checksum of a record or of a file does not match its content.
+	ErrChecksumMismatch = errors.New("checksum mismatch")
+
+	// ErrCorruptedRecord is returned when a record cannot be decoded.
+	ErrCorruptedRecord = errors.New("corrupted record")
+
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
+// Record is a single entry of a file sink file.
+// Exactly one of DeliverBlock and StateChanges is set, depending on Kind.
+type Record struct {
+	Kind         Kind
+	DeliverBlock *streaming.ListenDeliverBlockRequest
+	StateChanges *streaming.ListenStateChangesRequest
+}
+
+// Height returns the block height of the record.
+func (r Record) Height() int64 {
+	switch r.Kind {
+	case KindDeliverBlock:
+		return r.DeliverBlock.BlockHeight
+	case KindStateChanges:
+		return r.StateChanges.BlockHeight
+	default:
+		return 0
+	}
+}
+
+// jsonRecord is the JSONL encoding of a record.
+// The checksum is computed over the raw bytes of data.
+type jsonRecord struct {
+	Kind   string          `json:"kind"`
+	Height int64           `json:"height"`
+	CRC32  uint32          `json:"crc32"`
+	Data   json.RawMessage `json:"data"`
+}
+
+// encodeRecord encodes a record in the given format.
+//
+// A protobuf record is encoded as uvarint(len(kind|payload)) | kind | payload | crc32c(kind|payload),
+// where the checksum is a big endian uint32.
+// A JSONL record is a JSON object followed by a new line.
+func encodeRecord(format string, r Record) ([]byte, error) {
+	switch format {
+	case FormatProtobuf:
+		var (
+			payload []byte
+			err     error
+		)
+		switch r.Kind {
+		case KindDeliverBlock:
+			payload, err = r.DeliverBlock.Marshal()
+		case KindStateChanges:
+			payload, err = r.StateChanges.Marshal()
+		default:
+			return nil, fmt.Errorf("unknown record kind %s", r.Kind)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		body := make([]byte, 0, 1+len(payload))
+		body = append(body, byte(r.Kind))
+		body = append(body, payload...)
+
+		out := make([]byte, 0, binary.MaxVarintLen64+len(body)+4)
+		out = binary.AppendUvarint(out, uint64(len(body)))
+		out = append(out, body...)
+		out = binary.BigEndian.AppendUint32(out, crc32.Checksum(body, crcTable))
+		return out, nil
+
+	case FormatJSONL:
+		var (
+			data []byte
+			err  error
+		)
+		switch r.Kind {
+		case KindDeliverBlock:
+			data, err = json.Marshal(r.DeliverBlock)
+		case KindStateChanges:
+			data, err = json.Marshal(r.StateChanges)
+		default:
+			return nil, fmt.Errorf("unknown record kind %s", r.Kind)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, err := json.Marshal(jsonRecord{
+			Kind:   r.Kind.String(),
+			Height: r.Height(),
+			CRC32:  crc32.Checksum(data, crcTable),
+			Data:   data,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return append(line, '\n'), nil
+
+	default:
+		return nil, fmt.Errorf("unknown format %q", format)
+	}
+}
+
+// decoder decodes the records of a file.
+type decoder interface {
+	// next returns the next record, io.EOF at the end of the input, or
+	// io.ErrUnexpectedEOF if the input ends in the middle of a record.
+	next() (Record, error)
+}
+
+// newDecoder returns a decoder of the records encoded in the given format.
+func newDecoder(format string, r *bufio.Reader) (decoder, error) {
+	switch format {
+	case FormatProtobuf:
+		return &protobufDecoder{r: r}, nil
+	case FormatJSONL:
+		return &jsonlDecoder{r: r}, nil
+	default:
+		return nil, fmt.Errorf("unknown format %q", format)
+	}
+}
+
+type protobufDecoder struct {
+	r *bufio.Reader
+}
+
+func (d *protobufDecoder) next() (Record, error) {
+	size, err := binary.ReadUvarint(d.r)
+	if err != nil {
+		return Record{}, err // io.EOF at a record boundary, io.ErrUnexpectedEOF otherwise
+	}
+	if size == 0 || size > maxRecordSize {
+		return Record{}, fmt.Errorf("%w: invalid record size %d", ErrCorruptedRecord, size)
+	}
+
+	body := make([]byte, size+4)
+	if _, err := io.ReadFull(d.r, body); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return Record{}, err
+	}
+
+	body, sum := body[:size], binary.BigEndian.Uint32(body[size:])
+	if crc32.Checksum(body, crcTable) != sum {
+		return Record{}, ErrChecksumMismatch
+	}
+
+	r := Record{Kind: Kind(body[0])}
+	switch r.Kind {
+	case KindDeliverBlock:
+		r.DeliverBlock = &streaming.ListenDeliverBlockRequest{}
+		err = r.DeliverBlock.Unmarshal(body[1:])
+	case KindStateChanges:
+		r.StateChanges = &streaming.ListenStateChangesRequest{}
+		err = r.StateChanges.Unmarshal(body[1:])
+	default:
+		return Record{}, fmt.Errorf("%w: unknown record kind %s", ErrCorruptedRecord, r.Kind)
+	}
+	if err != nil {
+		return Record{}, fmt.Errorf("%w: %w", ErrCorruptedRecord, err)
+	}
+
+	return r, nil
+}
+
+type jsonlDecoder struct {
+	r *bufio.Reader
+}
+
+func (d *jsonlDecoder) next() (Record, error) {
+	line, err := d.r.ReadBytes('\n')
+	if err != nil {
+		if errors.Is(err, io.EOF) && len(line) > 0 {
+			// the last line is only complete once its new line is written
+			return Record{}, io.ErrUnexpectedEOF
+		}
+		return Record{}, err
+	}
+
+	var jr jsonRecord
+	if err := json.Unmarshal(line, &jr); err != nil {
+		return Record{}, fmt.Errorf("%w: %w", ErrCorruptedRecord, err)
+	}
+	if crc32.Checksum(jr.Data, crcTable) != jr.CRC32 {
+		return Record{}, ErrChecksumMismatch
+	}
+
+	kind, err := parseKind(jr.Kind)
+	if err != nil {
+		return Record{}, fmt.Errorf("%w: %w", ErrCorruptedRecord, err)
+	}
+
+	r := Record{Kind: kind}
+	switch kind {
+	case KindDeliverBlock:
+		r.DeliverBlock = &streaming.ListenDeliverBlockRequest{}
+		err = json.Unmarshal(jr.Data, r.DeliverBlock)
+	case KindStateChanges:
+		r.StateChanges = &streaming.ListenStateChangesRequest{}
+		err = json.Unmarshal(jr.Data, r.StateChanges)
+	}
+	if err != nil {
+		return Record{}, fmt.Errorf("%w: %w", ErrCorruptedRecord, err)
+	}
+
+	return r, nil
+}
@@ -3,6 +3,7 @@ package streaming
 import (
 	"context"
 	"os"
+	"sync/atomic"
 
 	"github.com/hashicorp/go-plugin"
 )
@@ -12,19 +13,20 @@ var _ Listener = (*GRPCClient)(nil)
 // GRPCClient is an implementation of the ABCIListener interface that talks over RPC.
 type GRPCClient struct {
 	client ListenerServiceClient
+	// height is the height of the last delivered block, streamed along with its state changes
+	// when the context does not carry it.
+	height atomic.Int64
 }
 
 // ListenDeliverBlock listens for block delivery requests and responses.
-// It retrieves a types.Context from the provided context.Context.
-// If the node is configured to stop on listening errors, it will terminate
-// and exit with a non-zero code upon encountering an error.
-//
-// Panics if a types.Context is not properly attached to the provided context.Context.
+// If a types.Context is attached to the provided context.Context and the node
+// is configured to stop on listening errors, it will terminate and exit with a
+// non-zero code upon encountering an error. Otherwise, the error is returned,
+// e.g. for a GuaranteedListener to retry the delivery.
 func (m *GRPCClient) ListenDeliverBlock(goCtx context.Context, req ListenDeliverBlockRequest) error {
-	ctx := goCtx.(Context)
-	sm := ctx.StreamingManager()
+	m.height.Store(req.BlockHeight)
 	_, err := m.client.ListenDeliverBlock(goCtx, &req)
-	if err != nil && sm.StopNodeOnErr {
+	if ctx, ok := goCtx.(Context); ok && err != nil && ctx.StreamingManager().StopNodeOnErr {
 		ctx.Logger().Error("DeliverBLock listening hook failed", "height", ctx.BlockHeight(), "err", err)
 		cleanupAndExit()
 	}
@@ -32,18 +34,20 @@ func (m *GRPCClient) ListenDeliverBlock(goCtx context.Context, req ListenDeliver
 }
 
 // ListenStateChanges listens for state changes in the current block.
-// It retrieves a types.Context from the provided context.Context.
-// If the node is configured to stop on listening errors, it will terminate
-// and exit with a non-zero code upon encountering an error.
-//
-// Panics if a types.Context is not properly attached to the provided context.Context.
+// The block height is retrieved from the types.Context attached to the provided
+// context.Context, or is the height of the last delivered block otherwise.
+// If a types.Context is attached and the node is configured to stop on listening
+// errors, it will terminate and exit with a non-zero code upon encountering an error.
 func (m *GRPCClient) ListenStateChanges(goCtx context.Context, changeSet []*StoreKVPair) error {
-	ctx := goCtx.(Context)
-	sm := ctx.StreamingManager()
-	request := &ListenStateChangesRequest{BlockHeight: ctx.BlockHeight(), ChangeSet: changeSet}
+	height := m.height.Load()
+	ctx, ok := goCtx.(Context)
+	if ok {
+		height = ctx.BlockHeight()
+	}
+	request := &ListenStateChangesRequest{BlockHeight: height, ChangeSet: changeSet}
 	_, err := m.client.ListenStateChanges(goCtx, request)
-	if err != nil && sm.StopNodeOnErr {
-		ctx.Logger().Error("Commit listening hook failed", "height", ctx.BlockHeight(), "err", err)
+	if ok && err != nil && ctx.StreamingManager().StopNodeOnErr {
+		ctx.Logger().Error("Commit listening hook failed", "height", height, "err", err)
 		cleanupAndExit()
 	}
 	return err
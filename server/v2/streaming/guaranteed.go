@@ -0,0 +1,435 @@
+package streaming
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"cosmossdk.io/core/log"
+)
+
+const (
+	blockFileExt    = ".blk"
+	ackFileName     = "acked"
+	tmpFileSuffix   = ".tmp"
+	blockFileFormat = "%020d" + blockFileExt
+)
+
+var _ Listener = (*GuaranteedListener)(nil)
+
+// GuaranteedListener wraps a Listener to deliver the blocks at least once.
+//
+// Every block is persisted to disk before being handed to a background worker,
+// which delivers the blocks in height order to the wrapped listener, retrying
+// with exponential backoff until it succeeds. The height of the last delivered
+// block is persisted as well, so that undelivered blocks are replayed when the
+// node restarts. As a block may be delivered again if the node stops between its
+// delivery and the persistence of its acknowledgement, the wrapped listener must
+// handle duplicates.
+//
+// The node is not blocked by the delivery unless the number of undelivered blocks
+// exceeds the configured maximum lag, in which case the node waits for the wrapped
+// listener to catch up, for at most the configured maximum wait. As the blocks are
+// persisted, the node then moves on and the undelivered blocks accumulate on disk.
+type GuaranteedListener struct {
+	name   string
+	inner  Listener
+	cfg    DeliveryConfig
+	dir    string
+	logger log.Logger
+
+	mu   sync.Mutex
+	cond *sync.Cond
+	// progress is closed and replaced when a block is acknowledged or the listener is closed.
+	progress chan struct{}
+	// block is the block being streamed, until its state changes are received.
+	block *ListenDeliverBlockRequest
+	// pending are the heights of the persisted, undelivered blocks, in increasing order.
+	pending []int64
+	// acked is the height of the last delivered block.
+	acked  int64
+	closed bool
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewGuaranteedListener wraps the given listener with at-least-once delivery.
+// The name identifies the listener and must be unique among the listeners
+// sharing the same delivery directory. The blocks persisted by a previous run
+// and not acknowledged yet are replayed.
+func NewGuaranteedListener(name string, inner Listener, cfg DeliveryConfig, logger log.Logger) (*GuaranteedListener, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	if name == "" || strings.ContainsAny(name, `/\`) {
+		return nil, fmt.Errorf("invalid listener name %q", name)
+	}
+
+	dir := filepath.Join(cfg.Dir, name)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create delivery directory: %w", err)
+	}
+
+	acked, err := readAckedHeight(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := listPendingHeights(dir, acked)
+	if err != nil {
+		return nil, err
+	}
+	if len(pending) > 0 {
+		logger.Info("replaying undelivered blocks", "listener", name, "from", pending[0], "to", pending[len(pending)-1])
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	l := &GuaranteedListener{
+		name:     name,
+		inner:    inner,
+		cfg:      cfg,
+		dir:      dir,
+		logger:   logger,
+		pending:  pending,
+		acked:    acked,
+		progress: make(chan struct{}),
+		ctx:      ctx,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+	l.cond = sync.NewCond(&l.mu)
+
+	go l.deliverLoop()
+
+	return l, nil
+}
+
+// ListenDeliverBlock implements Listener. The block is buffered until its state changes are received.
+func (l *GuaranteedListener) ListenDeliverBlock(_ context.Context, req ListenDeliverBlockRequest) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.block = &req
+	return nil
+}
+
+// ListenStateChanges implements Listener. It persists the block and queues it for delivery,
+// waiting for the wrapped listener to catch up if the maximum lag is exceeded, until the
+// maximum wait elapses or the context is canceled.
+func (l *GuaranteedListener) ListenStateChanges(ctx context.Context, changeSet []*StoreKVPair) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed {
+		return errors.New("listener is closed")
+	}
+	if l.block == nil {
+		return errors.New("state changes received before the block")
+	}
+
+	block := l.block
+	l.block = nil
+
+	if block.BlockHeight <= l.acked {
+		// the block was already delivered, e.g. when the node replays blocks after a restart
+		return nil
+	}
+
+	if err := writeBlockFile(l.dir, block, &ListenStateChangesRequest{
+		BlockHeight: block.BlockHeight,
+		ChangeSet:   changeSet,
+	}); err != nil {
+		return err
+	}
+
+	if n := len(l.pending); n == 0 || l.pending[n-1] < block.BlockHeight {
+		l.pending = append(l.pending, block.BlockHeight)
+	}
+	l.cond.Broadcast()
+
+	return l.waitForDeliveries(ctx)
+}
+
+// waitForDeliveries waits while the maximum lag is exceeded, for at most the maximum wait.
+// The caller must hold the lock.
+func (l *GuaranteedListener) waitForDeliveries(ctx context.Context) error {
+	if !l.lagging() {
+		return nil
+	}
+
+	l.logger.Warn("streaming listener is lagging, waiting for deliveries", "listener", l.name, "pending", len(l.pending), "max_wait", l.cfg.MaxWait)
+
+	timer := time.NewTimer(l.cfg.MaxWait)
+	defer timer.Stop()
+
+	for l.lagging() {
+		progress := l.progress
+		l.mu.Unlock()
+
+		select {
+		case <-progress:
+			l.mu.Lock()
+		case <-ctx.Done():
+			l.mu.Lock()
+			return ctx.Err()
+		case <-timer.C:
+			l.mu.Lock()
+			l.logger.Warn("streaming listener is still lagging, moving on", "listener", l.name, "pending", len(l.pending))
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// lagging returns whether the number of undelivered blocks exceeds the maximum lag.
+// The caller must hold the lock.
+func (l *GuaranteedListener) lagging() bool {
+	return l.cfg.MaxLag > 0 && len(l.pending) > l.cfg.MaxLag && !l.closed
+}
+
+// notifyProgress wakes up the node waiting for deliveries.
+// The caller must hold the lock.
+func (l *GuaranteedListener) notifyProgress() {
+	close(l.progress)
+	l.progress = make(chan struct{})
+}
+
+// LastAckedHeight returns the height of the last block delivered to the wrapped listener.
+func (l *GuaranteedListener) LastAckedHeight() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.acked
+}
+
+// Pending returns the number of blocks waiting to be delivered.
+func (l *GuaranteedListener) Pending() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return len(l.pending)
+}
+
+// Close stops the delivery. Undelivered blocks are kept on disk and replayed on the next start.
+func (l *GuaranteedListener) Close() error {
+	l.mu.Lock()
+	l.closed = true
+	l.cond.Broadcast()
+	l.notifyProgress()
+	l.mu.Unlock()
+
+	l.cancel()
+	<-l.done
+
+	return nil
+}
+
+// deliverLoop delivers the pending blocks in order until the listener is closed.
+func (l *GuaranteedListener) deliverLoop() {
+	defer close(l.done)
+
+	backoff := l.cfg.InitialBackoff
+	for {
+		l.mu.Lock()
+		for len(l.pending) == 0 && !l.closed {
+			l.cond.Wait()
+		}
+		if l.closed {
+			l.mu.Unlock()
+			return
+		}
+		height := l.pending[0]
+		l.mu.Unlock()
+
+		if err := l.deliver(height); err != nil {
+			l.logger.Error("failed to deliver block to streaming listener, retrying", "listener", l.name, "height", height, "backoff", backoff, "err", err)
+
+			select {
+			case <-l.ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+
+			backoff = min(2*backoff, l.cfg.MaxBackoff)
+			continue
+		}
+		backoff = l.cfg.InitialBackoff
+
+		if err := l.ack(height); err != nil {
+			// the block is delivered again, which the wrapped listener must tolerate
+			l.logger.Error("failed to acknowledge streamed block", "listener", l.name, "height", height, "err", err)
+		}
+	}
+}
+
+func (l *GuaranteedListener) deliver(height int64) error {
+	block, changes, err := readBlockFile(l.dir, height)
+	if err != nil {
+		return err
+	}
+
+	if err := l.inner.ListenDeliverBlock(l.ctx, *block); err != nil {
+		return err
+	}
+
+	return l.inner.ListenStateChanges(l.ctx, changes.ChangeSet)
+}
+
+// ack persists the delivery of the block at the given height and removes it from the queue.
+func (l *GuaranteedListener) ack(height int64) error {
+	l.mu.Lock()
+	l.acked = height
+	l.pending = l.pending[1:]
+	l.notifyProgress()
+	l.mu.Unlock()
+
+	if err := writeFileAtomic(filepath.Join(l.dir, ackFileName), []byte(strconv.FormatInt(height, 10))); err != nil {
+		return err
+	}
+
+	return os.Remove(filepath.Join(l.dir, fmt.Sprintf(blockFileFormat, height)))
+}
+
+func readAckedHeight(dir string) (int64, error) {
+	bz, err := os.ReadFile(filepath.Join(dir, ackFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	height, err := strconv.ParseInt(strings.TrimSpace(string(bz)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid acknowledged height: %w", err)
+	}
+
+	return height, nil
+}
+
+// listPendingHeights returns the heights of the persisted blocks above acked, in
+// increasing order. Blocks at or below acked are leftovers and are removed.
+func listPendingHeights(dir string, acked int64) ([]int64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var heights []int64
+	for _, e := range entries {
+		name, ok := strings.CutSuffix(e.Name(), blockFileExt)
+		if !ok {
+			continue
+		}
+
+		height, err := strconv.ParseInt(name, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		if height <= acked {
+			if err := os.Remove(filepath.Join(dir, e.Name())); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		heights = append(heights, height)
+	}
+
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+
+	return heights, nil
+}
+
+// writeBlockFile persists a block as the length-prefixed encoding of its deliver
+// block request followed by the encoding of its state changes.
+func writeBlockFile(dir string, block *ListenDeliverBlockRequest, changes *ListenStateChangesRequest) error {
+	blockBz, err := block.Marshal()
+	if err != nil {
+		return err
+	}
+	changesBz, err := changes.Marshal()
+	if err != nil {
+		return err
+	}
+
+	bz := make([]byte, 0, 2*binary.MaxVarintLen64+len(blockBz)+len(changesBz))
+	bz = binary.AppendUvarint(bz, uint64(len(blockBz)))
+	bz = append(bz, blockBz...)
+	bz = binary.AppendUvarint(bz, uint64(len(changesBz)))
+	bz = append(bz, changesBz...)
+
+	return writeFileAtomic(filepath.Join(dir, fmt.Sprintf(blockFileFormat, block.BlockHeight)), bz)
+}
+
+func readBlockFile(dir string, height int64) (*ListenDeliverBlockRequest, *ListenStateChangesRequest, error) {
+	bz, err := os.ReadFile(filepath.Join(dir, fmt.Sprintf(blockFileFormat, height)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	next := func() ([]byte, error) {
+		size, n := binary.Uvarint(bz)
+		if n <= 0 || uint64(len(bz)-n) < size {
+			return nil, fmt.Errorf("corrupted block file at height %d", height)
+		}
+		out := bz[n : n+int(size)]
+		bz = bz[n+int(size):]
+		return out, nil
+	}
+
+	blockBz, err := next()
+	if err != nil {
+		return nil, nil, err
+	}
+	changesBz, err := next()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	block := &ListenDeliverBlockRequest{}
+	if err := block.Unmarshal(blockBz); err != nil {
+		return nil, nil, err
+	}
+	changes := &ListenStateChangesRequest{}
+	if err := changes.Unmarshal(changesBz); err != nil {
+		return nil, nil, err
+	}
+
+	return block, changes, nil
+}
+
+// writeFileAtomic writes and syncs the data to a temporary file, then renames it to path.
+func writeFileAtomic(path string, data []byte) error {
+	tmp := path + tmpFileSuffix
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
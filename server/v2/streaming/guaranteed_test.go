@@ -0,0 +1,249 @@
+package streaming
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc"
+
+	coretesting "cosmossdk.io/core/testing"
+)
+
+// flakyListener records the delivered heights and fails while failing is set.
+type flakyListener struct {
+	mu        sync.Mutex
+	failing   bool
+	delivered []int64
+	current   int64
+	block     chan struct{}
+}
+
+func (f *flakyListener) ListenDeliverBlock(_ context.Context, req ListenDeliverBlockRequest) error {
+	if f.block != nil {
+		<-f.block
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.failing {
+		return errors.New("unavailable")
+	}
+	f.current = req.BlockHeight
+	return nil
+}
+
+func (f *flakyListener) ListenStateChanges(_ context.Context, changeSet []*StoreKVPair) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.failing {
+		return errors.New("unavailable")
+	}
+	if len(changeSet) != 1 || changeSet[0].Key[0] != byte(f.current) {
+		return errors.New("unexpected change set")
+	}
+	f.delivered = append(f.delivered, f.current)
+	return nil
+}
+
+func (f *flakyListener) setFailing(failing bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.failing = failing
+}
+
+func (f *flakyListener) deliveredHeights() []int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]int64(nil), f.delivered...)
+}
+
+// pluginClient records the state changes streamed to a plugin and fails while failing is set.
+type pluginClient struct {
+	mu      sync.Mutex
+	failing bool
+	heights []int64
+}
+
+func (c *pluginClient) ListenDeliverBlock(context.Context, *ListenDeliverBlockRequest, ...grpc.CallOption) (*ListenDeliverBlockResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.failing {
+		return nil, errors.New("unavailable")
+	}
+	return &ListenDeliverBlockResponse{}, nil
+}
+
+func (c *pluginClient) ListenStateChanges(_ context.Context, req *ListenStateChangesRequest, _ ...grpc.CallOption) (*ListenStateChangesResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.heights = append(c.heights, req.BlockHeight)
+	return &ListenStateChangesResponse{}, nil
+}
+
+func (c *pluginClient) setFailing(failing bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.failing = failing
+}
+
+func (c *pluginClient) streamedHeights() []int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]int64(nil), c.heights...)
+}
+
+func testDeliveryConfig(t *testing.T) DeliveryConfig {
+	t.Helper()
+	cfg := DefaultDeliveryConfig()
+	cfg.Dir = t.TempDir()
+	cfg.InitialBackoff = time.Millisecond
+	cfg.MaxBackoff = 5 * time.Millisecond
+	return cfg
+}
+
+func streamBlock(t *testing.T, l Listener, height int64) {
+	t.Helper()
+	ctx := context.Background()
+	require.NoError(t, l.ListenDeliverBlock(ctx, ListenDeliverBlockRequest{BlockHeight: height}))
+	require.NoError(t, l.ListenStateChanges(ctx, []*StoreKVPair{{Key: []byte{byte(height)}}}))
+}
+
+func TestGuaranteedListenerRetries(t *testing.T) {
+	inner := &flakyListener{failing: true}
+	l, err := NewGuaranteedListener("test", inner, testDeliveryConfig(t), coretesting.NewNopLogger())
+	require.NoError(t, err)
+	defer l.Close()
+
+	for h := int64(1); h <= 3; h++ {
+		streamBlock(t, l, h)
+	}
+	require.Equal(t, int64(0), l.LastAckedHeight())
+
+	inner.setFailing(false)
+	require.Eventually(t, func() bool { return l.LastAckedHeight() == 3 }, time.Second, time.Millisecond)
+	require.Equal(t, []int64{1, 2, 3}, inner.deliveredHeights())
+	require.Equal(t, 0, l.Pending())
+}
+
+func TestGuaranteedListenerReplay(t *testing.T) {
+	cfg := testDeliveryConfig(t)
+	inner := &flakyListener{failing: true}
+	l, err := NewGuaranteedListener("test", inner, cfg, coretesting.NewNopLogger())
+	require.NoError(t, err)
+
+	streamBlock(t, l, 1)
+	streamBlock(t, l, 2)
+	require.NoError(t, l.Close())
+
+	// the undelivered blocks are replayed by a new listener
+	inner = &flakyListener{}
+	l, err = NewGuaranteedListener("test", inner, cfg, coretesting.NewNopLogger())
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return l.LastAckedHeight() == 2 }, time.Second, time.Millisecond)
+
+	// already acknowledged blocks are not delivered again
+	streamBlock(t, l, 2)
+	streamBlock(t, l, 3)
+	require.Eventually(t, func() bool { return l.LastAckedHeight() == 3 }, time.Second, time.Millisecond)
+	require.NoError(t, l.Close())
+	require.Equal(t, []int64{1, 2, 3}, inner.deliveredHeights())
+
+	// the acknowledged height is persisted
+	l, err = NewGuaranteedListener("test", &flakyListener{}, cfg, coretesting.NewNopLogger())
+	require.NoError(t, err)
+	require.Equal(t, int64(3), l.LastAckedHeight())
+	require.Equal(t, 0, l.Pending())
+	require.NoError(t, l.Close())
+}
+
+func TestGuaranteedListenerBackpressure(t *testing.T) {
+	cfg := testDeliveryConfig(t)
+	cfg.MaxLag = 2
+	inner := &flakyListener{block: make(chan struct{})}
+	l, err := NewGuaranteedListener("test", inner, cfg, coretesting.NewNopLogger())
+	require.NoError(t, err)
+	defer l.Close()
+
+	// the first block is being delivered, the two next ones are within the lag
+	streamBlock(t, l, 1)
+	streamBlock(t, l, 2)
+
+	done := make(chan struct{})
+	go func() {
+		streamBlock(t, l, 3)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("the node should wait for the listener to catch up")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(inner.block)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("the node should be unblocked once the listener catches up")
+	}
+	require.Eventually(t, func() bool { return l.LastAckedHeight() == 3 }, time.Second, time.Millisecond)
+}
+
+func TestGuaranteedListenerBoundedWait(t *testing.T) {
+	cfg := testDeliveryConfig(t)
+	cfg.MaxLag = 1
+	cfg.MaxWait = 20 * time.Millisecond
+	inner := &flakyListener{block: make(chan struct{})}
+	l, err := NewGuaranteedListener("test", inner, cfg, coretesting.NewNopLogger())
+	require.NoError(t, err)
+	defer l.Close()
+	defer close(inner.block)
+
+	streamBlock(t, l, 1)
+
+	// the node moves on once the maximum wait elapses, the blocks stay queued
+	start := time.Now()
+	streamBlock(t, l, 2)
+	streamBlock(t, l, 3)
+	require.GreaterOrEqual(t, time.Since(start), 2*cfg.MaxWait)
+	require.Equal(t, 3, l.Pending())
+
+	// a canceled context stops the wait
+	cfg.MaxWait = time.Hour
+	inner2 := &flakyListener{block: make(chan struct{})}
+	l2, err := NewGuaranteedListener("test2", inner2, cfg, coretesting.NewNopLogger())
+	require.NoError(t, err)
+	defer l2.Close()
+	defer close(inner2.block)
+
+	streamBlock(t, l2, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, l2.ListenDeliverBlock(ctx, ListenDeliverBlockRequest{BlockHeight: 2}))
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	require.ErrorIs(t, l2.ListenStateChanges(ctx, []*StoreKVPair{{Key: []byte{2}}}), context.Canceled)
+	require.Equal(t, 2, l2.Pending())
+}
+
+func TestGuaranteedListenerPlugin(t *testing.T) {
+	client := &pluginClient{failing: true}
+	l, err := NewGuaranteedListener("abci-grpc", &GRPCClient{client: client}, testDeliveryConfig(t), coretesting.NewNopLogger())
+	require.NoError(t, err)
+	defer l.Close()
+
+	// the plugin is retried until it is available, with the heights of the persisted blocks
+	streamBlock(t, l, 1)
+	streamBlock(t, l, 2)
+	require.Never(t, func() bool { return len(client.streamedHeights()) > 0 }, 20*time.Millisecond, time.Millisecond)
+
+	client.setFailing(false)
+	require.Eventually(t, func() bool { return l.Pending() == 0 }, time.Second, time.Millisecond)
+	require.Equal(t, []int64{1, 2}, client.streamedHeights())
+}
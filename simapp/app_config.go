@@ -83,10 +83,12 @@ var (
 		{Account: distrtypes.ModuleName},
 		{Account: pooltypes.ModuleName},
 		{Account: pooltypes.StreamAccount},
+		{Account: pooltypes.StreamEscrowAccount},
 		{Account: pooltypes.ProtocolPoolDistrAccount},
 		{Account: minttypes.ModuleName, Permissions: []string{authtypes.Minter}},
 		{Account: stakingtypes.BondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
 		{Account: stakingtypes.NotBondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
+		{Account: stakingtypes.LiquidStakingPoolName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
 		{Account: govtypes.ModuleName, Permissions: []string{authtypes.Burner}},
 		{Account: nft.ModuleName},
 	}
@@ -102,6 +104,7 @@ var (
 		// We allow the following module accounts to receive funds:
 		// govtypes.ModuleName
 		// pooltypes.ModuleName
+		// stakingtypes.LiquidStakingPoolName
 	}
 
 	// application configuration (used by depinject)
@@ -128,10 +131,12 @@ var (
 						stakingtypes.ModuleName,
 						authz.ModuleName,
 						epochstypes.ModuleName,
+						consensustypes.ModuleName,
 					},
 					EndBlockers: []string{
 						govtypes.ModuleName,
 						stakingtypes.ModuleName,
+						banktypes.ModuleName,
 						feegrant.ModuleName,
 						group.ModuleName,
 						pooltypes.ModuleName,
@@ -229,8 +234,10 @@ var (
 				Config: appconfig.WrapAny(&genutilmodulev1.Module{}),
 			},
 			{
-				Name:   authz.ModuleName,
-				Config: appconfig.WrapAny(&authzmodulev1.Module{}),
+				Name: authz.ModuleName,
+				Config: appconfig.WrapAny(&authzmodulev1.Module{
+					ScheduledExecMinGasPrices: "0.001stake",
+				}),
 			},
 			{
 				Name:   upgradetypes.ModuleName,
@@ -23,6 +23,7 @@ import (
 	_ "cosmossdk.io/x/protocolpool"
 	slashingkeeper "cosmossdk.io/x/slashing/keeper"
 	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	stakingtypes "cosmossdk.io/x/staking/types"
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -92,6 +93,7 @@ func AppConfig() depinject.Config {
 	return depinject.Configs(
 		appConfig,                               // Alternatively use appconfig.LoadYAML(AppConfigYAML)
 		depinject.Provide(ProvideExampleMintFn), // optional: override the mint module's mint function with epoched minting
+		depinject.ProvideInModule(stakingtypes.ModuleName, ProvideStakingEpochHooks),
 	)
 }
 
@@ -13,6 +13,7 @@ import (
 	"cosmossdk.io/log"
 	"cosmossdk.io/runtime/v2"
 	"cosmossdk.io/store/v2/root"
+	stakingtypes "cosmossdk.io/x/staking/types"
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -52,6 +53,7 @@ func init() {
 func AppConfig() depinject.Config {
 	return depinject.Configs(
 		appConfig, // Alternatively use appconfig.LoadYAML(AppConfigYAML)
+		depinject.ProvideInModule(stakingtypes.ModuleName, ProvideStakingEpochHooks),
 	)
 }
 
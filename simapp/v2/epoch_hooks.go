@@ -0,0 +1,12 @@
+package simapp
+
+import (
+	epochstypes "cosmossdk.io/x/epochs/types"
+	stakingkeeper "cosmossdk.io/x/staking/keeper"
+)
+
+// ProvideStakingEpochHooks returns the staking epoch hooks applying the scheduled commission changes.
+// x/staking does not depend on x/epochs, so they are provided by the app in the staking module scope.
+func ProvideStakingEpochHooks(stakingKeeper *stakingkeeper.Keeper) epochstypes.EpochHooksWrapper {
+	return epochstypes.EpochHooksWrapper{EpochHooks: stakingKeeper.EpochHooks()}
+}
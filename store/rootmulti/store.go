@@ -1,6 +1,7 @@
 package rootmulti
 
 import (
+	"context"
 	"crypto/sha256"
 	"errors"
 	"fmt"
@@ -14,6 +15,9 @@ import (
 	protoio "github.com/cosmos/gogoproto/io"
 	gogotypes "github.com/cosmos/gogoproto/types"
 	iavltree "github.com/cosmos/iavl"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	corestore "cosmossdk.io/core/store"
 	coretesting "cosmossdk.io/core/testing"
@@ -33,6 +37,9 @@ import (
 	"cosmossdk.io/store/types"
 )
 
+// tracer is the OpenTelemetry tracer used to trace the commit phases.
+var tracer = otel.Tracer("cosmossdk.io/store/rootmulti")
+
 const (
 	latestVersionKey = "s/latest"
 	commitInfoKeyFmt = "s/%d" // s/<version>
@@ -75,6 +82,7 @@ type Store struct {
 	listeners           map[types.StoreKey]*types.MemoryListener
 	metrics             metrics.StoreMetrics
 	commitHeader        cmtproto.Header
+	commitContext       context.Context
 }
 
 var (
@@ -99,6 +107,7 @@ func NewStore(db corestore.KVStoreWithBatch, logger iavltree.Logger, metricGathe
 		removalMap:          make(map[types.StoreKey]bool),
 		pruningManager:      pruning.NewManager(db, logger),
 		metrics:             metricGatherer,
+		commitContext:       context.Background(),
 	}
 }
 
@@ -490,7 +499,13 @@ func (rs *Store) Commit() types.CommitID {
 		rs.logger.Debug("commit header and version mismatch", "header_height", rs.commitHeader.Height, "version", version)
 	}
 
+	ctx, span := tracer.Start(rs.commitContext, "CommitMultiStore", trace.WithAttributes(attribute.Int64("version", version)))
+	defer span.End()
+
 	func() { // ensure unpause
+		_, span := tracer.Start(ctx, "CommitStores", trace.WithAttributes(attribute.Int("stores", len(rs.stores))))
+		defer span.End()
+
 		// set the committing flag on all stores to block the pruning
 		rs.PausePruning(true)
 		// unset the committing flag on all stores to continue the pruning
@@ -499,7 +514,11 @@ func (rs *Store) Commit() types.CommitID {
 	}()
 
 	rs.lastCommitInfo.Timestamp = rs.commitHeader.Time
-	defer rs.flushMetadata(rs.db, version, rs.lastCommitInfo)
+	defer func() {
+		_, span := tracer.Start(ctx, "FlushMetadata")
+		defer span.End()
+		rs.flushMetadata(rs.db, version, rs.lastCommitInfo)
+	}()
 
 	// remove remnants of removed stores
 	for sk := range rs.removalMap {
@@ -513,7 +532,10 @@ func (rs *Store) Commit() types.CommitID {
 	// reset the removalMap
 	rs.removalMap = make(map[types.StoreKey]bool)
 
-	if err := rs.handlePruning(version); err != nil {
+	_, pruneSpan := tracer.Start(ctx, "Prune")
+	err := rs.handlePruning(version)
+	pruneSpan.End()
+	if err != nil {
 		rs.logger.Error(
 			"failed to prune store, please check your pruning configuration",
 			"err", err,
@@ -1122,6 +1144,12 @@ func (rs *Store) SetCommitHeader(h cmtproto.Header) {
 	rs.commitHeader = h
 }
 
+// SetCommitContext sets the context of the next commit, used as the parent
+// of the commit tracing spans.
+func (rs *Store) SetCommitContext(ctx context.Context) {
+	rs.commitContext = ctx
+}
+
 // GetCommitInfo attempts to retrieve CommitInfo for a given version/height. It
 // will return an error if no CommitInfo exists, we fail to unmarshal the record
 // or if we cannot retrieve the object from the DB.
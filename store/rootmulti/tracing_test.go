@@ -0,0 +1,47 @@
+package rootmulti
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	coretesting "cosmossdk.io/core/testing"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+)
+
+func TestCommitTracing(t *testing.T) {
+	rec := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec))
+	prev := tracer
+	tracer = provider.Tracer("cosmossdk.io/store/rootmulti")
+	t.Cleanup(func() { tracer = prev })
+
+	store := newMultiStoreWithMounts(coretesting.NewMemDB(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, store.LoadLatestVersion())
+
+	// the commit spans are children of the span carried by the commit context
+	parentCtx, parent := provider.Tracer("test").Start(context.Background(), "Commit")
+	store.SetCommitContext(parentCtx)
+	store.Commit()
+	parent.End()
+
+	spans := make(map[string]sdktrace.ReadOnlySpan)
+	for _, s := range rec.Ended() {
+		spans[s.Name()] = s
+	}
+
+	commit := spans["CommitMultiStore"]
+	require.NotNil(t, commit)
+	require.Equal(t, parent.SpanContext().SpanID(), commit.Parent().SpanID())
+	require.Contains(t, commit.Attributes(), attribute.Int64("version", 1))
+
+	for _, name := range []string{"CommitStores", "FlushMetadata", "Prune"} {
+		require.Contains(t, spans, name)
+		require.Equal(t, commit.SpanContext().SpanID(), spans[name].Parent().SpanID(), name)
+	}
+	require.Contains(t, spans["CommitStores"].Attributes(), attribute.Int("stores", len(store.stores)))
+}
@@ -0,0 +1,142 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Tracing supported exporter types.
+const (
+	TraceExporterOTLP = "otlp"
+	TraceExporterFile = "file"
+)
+
+// TracingConfig defines the configuration options for OpenTelemetry tracing.
+type TracingConfig struct {
+	// Enabled enables the OpenTelemetry tracing of ABCI calls, transactions,
+	// ante handlers, message handlers and store commits.
+	Enabled bool `mapstructure:"enabled" toml:"enabled" comment:"enabled enables the OpenTelemetry tracing of ABCI calls, transactions, ante handlers, message handlers and store commits."`
+
+	// ServiceName defines the service name reported with the spans.
+	ServiceName string `mapstructure:"service-name" toml:"service-name" comment:"service-name defines the service name reported with the spans."`
+
+	// Exporter defines the type of spans exporter to use, either "otlp" or "file".
+	Exporter string `mapstructure:"exporter" toml:"exporter" comment:"exporter defines the type of spans exporter to use, either \"otlp\" or \"file\"."`
+
+	// OTLPEndpoint defines the address of the OTLP gRPC collector.
+	// Only utilized if Exporter is set to "otlp".
+	OTLPEndpoint string `mapstructure:"otlp-endpoint" toml:"otlp-endpoint" comment:"otlp-endpoint defines the address of the OTLP gRPC collector."`
+
+	// OTLPInsecure disables the transport security of the OTLP gRPC connection.
+	// Only utilized if Exporter is set to "otlp".
+	OTLPInsecure bool `mapstructure:"otlp-insecure" toml:"otlp-insecure" comment:"otlp-insecure disables the transport security of the OTLP gRPC connection."`
+
+	// FilePath defines the file the spans are written to, as JSON.
+	// Only utilized if Exporter is set to "file".
+	FilePath string `mapstructure:"file-path" toml:"file-path" comment:"file-path defines the file the spans are written to, as JSON, when using the file exporter."`
+
+	// SampleRate defines the fraction of traces to record, between 0 and 1.
+	SampleRate float64 `mapstructure:"sample-rate" toml:"sample-rate" comment:"sample-rate defines the fraction of traces to record, between 0 and 1."`
+}
+
+// DefaultTracingConfig returns the default tracing configuration.
+func DefaultTracingConfig() TracingConfig {
+	return TracingConfig{
+		Enabled:      false,
+		ServiceName:  "cosmos-sdk",
+		Exporter:     TraceExporterOTLP,
+		OTLPEndpoint: "localhost:4317",
+		OTLPInsecure: true,
+		FilePath:     "",
+		SampleRate:   1,
+	}
+}
+
+// Validate returns an error if the tracing configuration is invalid.
+func (c TracingConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	switch c.Exporter {
+	case TraceExporterOTLP:
+		if c.OTLPEndpoint == "" {
+			return errors.New("tracing otlp-endpoint must be set when using the otlp exporter")
+		}
+	case TraceExporterFile:
+		if c.FilePath == "" {
+			return errors.New("tracing file-path must be set when using the file exporter")
+		}
+	default:
+		return fmt.Errorf("unknown tracing exporter %q, expected %q or %q", c.Exporter, TraceExporterOTLP, TraceExporterFile)
+	}
+
+	if c.SampleRate < 0 || c.SampleRate > 1 {
+		return fmt.Errorf("tracing sample-rate must be between 0 and 1, got %f", c.SampleRate)
+	}
+
+	return nil
+}
+
+// EnableTracing creates an OpenTelemetry tracer provider from the given
+// configuration and registers it as the global tracer provider. The returned
+// function flushes the pending spans and releases the exporter resources.
+// When tracing is disabled, the global no-op tracer provider is left untouched.
+func EnableTracing(ctx context.Context, cfg TracingConfig) (func(context.Context) error, error) {
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	var (
+		exporter sdktrace.SpanExporter
+		closer   func() error
+		err      error
+	)
+	switch cfg.Exporter {
+	case TraceExporterOTLP:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.OTLPEndpoint)}
+		if cfg.OTLPInsecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(ctx, opts...)
+	case TraceExporterFile:
+		var f *os.File
+		f, err = os.OpenFile(cfg.FilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open tracing file: %w", err)
+		}
+		closer = f.Close
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(f))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s tracing exporter: %w", cfg.Exporter, err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRate))),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", cfg.ServiceName))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if closer != nil {
+			err = errors.Join(err, closer())
+		}
+		return err
+	}, nil
+}
@@ -0,0 +1,66 @@
+package telemetry
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+)
+
+func TestTracingConfig_Validate(t *testing.T) {
+	require.NoError(t, TracingConfig{Exporter: "unknown"}.Validate(), "disabled config is not validated")
+	require.NoError(t, DefaultTracingConfig().Validate())
+
+	cfg := DefaultTracingConfig()
+	cfg.Enabled = true
+	require.NoError(t, cfg.Validate())
+
+	cfg.OTLPEndpoint = ""
+	require.ErrorContains(t, cfg.Validate(), "otlp-endpoint")
+
+	cfg.Exporter = TraceExporterFile
+	require.ErrorContains(t, cfg.Validate(), "file-path")
+
+	cfg.FilePath = "spans.json"
+	require.NoError(t, cfg.Validate())
+
+	cfg.SampleRate = 2
+	require.ErrorContains(t, cfg.Validate(), "sample-rate")
+
+	cfg.Exporter = "jaeger"
+	require.ErrorContains(t, cfg.Validate(), "unknown tracing exporter")
+}
+
+func TestEnableTracing_Disabled(t *testing.T) {
+	prev := otel.GetTracerProvider()
+	shutdown, err := EnableTracing(context.Background(), DefaultTracingConfig())
+	require.NoError(t, err)
+	require.Equal(t, prev, otel.GetTracerProvider())
+	require.NoError(t, shutdown(context.Background()))
+}
+
+func TestEnableTracing_File(t *testing.T) {
+	prev := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	path := filepath.Join(t.TempDir(), "spans.json")
+	cfg := DefaultTracingConfig()
+	cfg.Enabled = true
+	cfg.Exporter = TraceExporterFile
+	cfg.FilePath = path
+
+	shutdown, err := EnableTracing(context.Background(), cfg)
+	require.NoError(t, err)
+
+	_, span := otel.Tracer("test").Start(context.Background(), "FinalizeBlock")
+	span.End()
+	require.NoError(t, shutdown(context.Background()))
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"Name":"FinalizeBlock"`)
+	require.Contains(t, string(bz), cfg.ServiceName)
+}
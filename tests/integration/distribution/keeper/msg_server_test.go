@@ -90,6 +90,7 @@ func initFixture(t *testing.T) *fixture {
 	maccPerms := map[string][]string{
 		pooltypes.ModuleName:               {},
 		pooltypes.StreamAccount:            {},
+		pooltypes.StreamEscrowAccount:      {},
 		pooltypes.ProtocolPoolDistrAccount: {},
 		distrtypes.ModuleName:              {authtypes.Minter},
 		stakingtypes.BondedPoolName:        {authtypes.Burner, authtypes.Staking},
@@ -792,10 +793,12 @@ func TestMsgUpdateParams(t *testing.T) {
 			msg: &distrtypes.MsgUpdateParams{
 				Authority: f.distrKeeper.GetAuthority(),
 				Params: distrtypes.Params{
-					CommunityTax:        communityTax,
-					BaseProposerReward:  math.LegacyZeroDec(),
-					BonusProposerReward: math.LegacyZeroDec(),
-					WithdrawAddrEnabled: withdrawAddrEnabled,
+					CommunityTax:                communityTax,
+					BaseProposerReward:          math.LegacyZeroDec(),
+					BonusProposerReward:         math.LegacyZeroDec(),
+					WithdrawAddrEnabled:         withdrawAddrEnabled,
+					AutoCompoundEpochIdentifier: distrtypes.DefaultAutoCompoundEpochIdentifier,
+					AutoCompoundBatchSize:       distrtypes.DefaultAutoCompoundBatchSize,
 				},
 			},
 			expErr: false,
@@ -72,6 +72,7 @@ func initFixture(tb testing.TB) *fixture {
 	maccPerms := map[string][]string{
 		pooltypes.ModuleName:               {},
 		pooltypes.StreamAccount:            {},
+		pooltypes.StreamEscrowAccount:      {},
 		pooltypes.ProtocolPoolDistrAccount: {},
 		minttypes.ModuleName:               {authtypes.Minter},
 		stakingtypes.BondedPoolName:        {authtypes.Burner, authtypes.Staking},
@@ -165,6 +165,7 @@ func AuthModule() ModuleOption {
 					{Account: testutil.NFTModuleName},
 					{Account: testutil.ProtocolPoolModuleName},
 					{Account: "stream_acc"},
+					{Account: "stream_escrow"},
 					{Account: "protocolpool_distr"},
 				},
 			}),
@@ -38,6 +38,7 @@ const (
 	EnvTimeFormatLogs           = "COSMOVISOR_TIMEFORMAT_LOGS"
 	EnvCustomPreupgrade         = "COSMOVISOR_CUSTOM_PREUPGRADE"
 	EnvDisableRecase            = "COSMOVISOR_DISABLE_RECASE"
+	EnvPreDownloadBinaries      = "COSMOVISOR_PREDOWNLOAD_BINARIES"
 )
 
 const (
@@ -68,6 +69,7 @@ type Config struct {
 	TimeFormatLogs           string        `toml:"cosmovisor_timeformat_logs" mapstructure:"cosmovisor_timeformat_logs" default:"kitchen"`
 	CustomPreUpgrade         string        `toml:"cosmovisor_custom_preupgrade" mapstructure:"cosmovisor_custom_preupgrade" default:""`
 	DisableRecase            bool          `toml:"cosmovisor_disable_recase" mapstructure:"cosmovisor_disable_recase" default:"false"`
+	PreDownloadBinaries      bool          `toml:"cosmovisor_predownload_binaries" mapstructure:"cosmovisor_predownload_binaries" default:"false"`
 
 	// currently running upgrade
 	currentUpgrade upgradetypes.Plan
@@ -242,6 +244,9 @@ func GetConfigFromEnv(skipValidate bool) (*Config, error) {
 	if cfg.DisableRecase, err = BooleanOption(EnvDisableRecase, false); err != nil {
 		errs = append(errs, err)
 	}
+	if cfg.PreDownloadBinaries, err = BooleanOption(EnvPreDownloadBinaries, false); err != nil {
+		errs = append(errs, err)
+	}
 
 	interval := os.Getenv(EnvInterval)
 	if interval != "" {
@@ -345,6 +350,10 @@ func (cfg *Config) validate() []error {
 		}
 	}
 
+	if cfg.PreDownloadBinaries && !cfg.AllowDownloadBinaries {
+		errs = append(errs, fmt.Errorf("%s requires %s to be set", EnvPreDownloadBinaries, EnvDownloadBin))
+	}
+
 	// check the DataBackupPath
 	if cfg.UnsafeSkipBackup {
 		return errs
@@ -548,6 +557,7 @@ func (cfg Config) DetailString() string {
 		{EnvTimeFormatLogs, cfg.TimeFormatLogs},
 		{EnvCustomPreupgrade, cfg.CustomPreUpgrade},
 		{EnvDisableRecase, fmt.Sprintf("%t", cfg.DisableRecase)},
+		{EnvPreDownloadBinaries, fmt.Sprintf("%t", cfg.PreDownloadBinaries)},
 	}
 
 	derivedEntries := []struct{ name, value string }{
@@ -213,6 +213,10 @@ func (s *argsTestSuite) TestValidate() {
 			cfg:   Config{Home: absPath, Name: "bind", AllowDownloadBinaries: true, DataBackupPath: absPath},
 			valid: true,
 		},
+		"happy with pre-download": {
+			cfg:   Config{Home: absPath, Name: "bind", AllowDownloadBinaries: true, PreDownloadBinaries: true, DataBackupPath: absPath},
+			valid: true,
+		},
 		"happy with skip data backup": {
 			cfg:   Config{Home: absPath, Name: "bind", UnsafeSkipBackup: true, DataBackupPath: absPath},
 			valid: true,
@@ -261,6 +265,10 @@ func (s *argsTestSuite) TestValidate() {
 			cfg:   Config{Home: absPath, Name: "bind", DataBackupPath: relPath},
 			valid: false,
 		},
+		"pre-download without download": {
+			cfg:   Config{Home: absPath, Name: "bind", PreDownloadBinaries: true, DataBackupPath: absPath},
+			valid: false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -430,6 +438,7 @@ func (s *argsTestSuite) TestDetailString() {
 		fmt.Sprintf("%s: %t", EnvDisableLogs, cfg.DisableLogs),
 		fmt.Sprintf("%s: %t", EnvColorLogs, cfg.ColorLogs),
 		fmt.Sprintf("%s: %s", EnvTimeFormatLogs, cfg.TimeFormatLogs),
+		fmt.Sprintf("%s: %t", EnvPreDownloadBinaries, cfg.PreDownloadBinaries),
 		"Derived Values:",
 		fmt.Sprintf("Root Dir: %s", home),
 		fmt.Sprintf("Upgrade Dir: %s", home),
@@ -0,0 +1,177 @@
+package cosmovisor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	neturl "net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/upgrade/plan"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+)
+
+// PendingUpgradeInfoFilename is the file to which x/upgrade writes the scheduled upgrade plan before its height.
+// It should be synced with the x/upgrade types.PendingUpgradeInfoFilename if it is ever changed.
+const PendingUpgradeInfoFilename = "upgrade-info.pending.json"
+
+// binaryDescriptor mirrors the x/upgrade plan.BinaryDescriptor, describing a binary with its checksum.
+type binaryDescriptor struct {
+	URL      string `json:"url"`
+	Checksum string `json:"checksum"`
+}
+
+// PendingUpgradeInfoFilePath is the expected pending upgrade-info filename created by `x/upgrade/keeper`.
+func (cfg *Config) PendingUpgradeInfoFilePath() string {
+	return filepath.Join(cfg.Home, "data", PendingUpgradeInfoFilename)
+}
+
+// ParseUpgradeInfo parses the info of an upgrade plan like plan.ParseInfo, adding the binaries of
+// the plan binary descriptors with their checksum to the binaries.
+func ParseUpgradeInfo(info string, enforceChecksum bool) (*plan.Info, error) {
+	info = strings.TrimSpace(info)
+
+	// If it's a url, download it and parse the result instead, to read its binary descriptors.
+	if _, err := neturl.ParseRequestURI(info); err == nil {
+		if err := plan.ValidateURL(info, enforceChecksum); err != nil {
+			return nil, err
+		}
+
+		if info, err = plan.DownloadURL(info); err != nil {
+			return nil, err
+		}
+	}
+
+	upgradeInfo, err := plan.ParseInfo(info, plan.ParseOptionEnforceChecksum(enforceChecksum))
+	if err != nil {
+		return nil, err
+	}
+
+	var descriptors struct {
+		BinaryDescriptors map[string]binaryDescriptor `json:"binary_descriptors"`
+	}
+	if err := json.Unmarshal([]byte(info), &descriptors); err != nil {
+		return nil, fmt.Errorf("could not parse binary descriptors: %w", err)
+	}
+
+	if len(descriptors.BinaryDescriptors) > 0 && upgradeInfo.Binaries == nil {
+		upgradeInfo.Binaries = make(plan.BinaryDownloadURLMap, len(descriptors.BinaryDescriptors))
+	}
+
+	for key, descriptor := range descriptors.BinaryDescriptors {
+		if _, ok := upgradeInfo.Binaries[key]; ok {
+			return nil, fmt.Errorf("binaries[%s] is also defined in binary_descriptors", key)
+		}
+
+		if descriptor.Checksum == "" {
+			return nil, fmt.Errorf("binary_descriptors[%s] has no checksum", key)
+		}
+
+		url, err := neturl.Parse(descriptor.URL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid binary_descriptors[%s] url: %w", key, err)
+		}
+		query := url.Query()
+		query.Set("checksum", descriptor.Checksum)
+		url.RawQuery = query.Encode()
+		upgradeInfo.Binaries[key] = url.String()
+	}
+
+	return upgradeInfo, nil
+}
+
+// PreDownloadUpgrade downloads and verifies the binary of an upgrade before its height is reached,
+// so that it is already installed when the chain halts. Nothing is done if the upgrade directory
+// already exists.
+// The binary is downloaded in a temporary directory, only moved to the upgrade directory once verified.
+func PreDownloadUpgrade(logger log.Logger, cfg *Config, p upgradetypes.Plan) error {
+	if !cfg.AllowDownloadBinaries {
+		return errors.New("downloading binaries is disabled")
+	}
+
+	switch fi, err := os.Stat(cfg.UpgradeDir(p.Name)); {
+	case fi != nil: // The directory exists, the binary is installed or being installed manually.
+		return nil
+
+	case os.IsNotExist(err): // In this case the directory doesn't exist, continue below.
+
+	default: // Otherwise an unexpected error
+		return fmt.Errorf("unhandled error: %w", err)
+	}
+
+	upgradeInfo, err := ParseUpgradeInfo(p.Info, cfg.DownloadMustHaveChecksum)
+	if err != nil {
+		return fmt.Errorf("cannot parse upgrade info: %w", err)
+	}
+
+	if err := upgradeInfo.Binaries.ValidateBasic(cfg.DownloadMustHaveChecksum); err != nil {
+		return fmt.Errorf("invalid binaries: %w", err)
+	}
+
+	url, err := GetBinaryURL(upgradeInfo.Binaries)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(cfg.BaseUpgradeDir(), 0o750); err != nil {
+		return err
+	}
+
+	tmpDir, err := os.MkdirTemp(cfg.BaseUpgradeDir(), ".predownload-*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	logger.Info("pre-downloading upgrade binary", "name", p.Name, "height", p.Height)
+	if err := plan.DownloadUpgrade(tmpDir, url, cfg.Name); err != nil {
+		return fmt.Errorf("cannot download binary. %w", err)
+	}
+
+	if err := plan.EnsureBinary(filepath.Join(tmpDir, "bin", cfg.Name)); err != nil {
+		return fmt.Errorf("downloaded binary doesn't check out: %w", err)
+	}
+
+	if err := os.Rename(tmpDir, cfg.UpgradeDir(p.Name)); err != nil {
+		return fmt.Errorf("cannot install downloaded binary: %w", err)
+	}
+	logger.Info("pre-downloading binary complete", "name", p.Name, "path", cfg.UpgradeBin(p.Name))
+
+	return nil
+}
+
+// preDownloader polls the pending upgrade info file and pre-downloads the binary of each new scheduled upgrade.
+type preDownloader struct {
+	logger   log.Logger
+	cfg      *Config
+	lastPlan string // name of the last plan a pre-download was attempted for
+}
+
+// run polls the pending upgrade info file until done is closed. A failed pre-download isn't retried,
+// the binary being downloaded again at the upgrade height.
+func (d *preDownloader) run(done <-chan struct{}) {
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			// the file doesn't exist if no upgrade is scheduled, or can be partially written
+			p, err := parseUpgradeInfoFile(d.cfg.PendingUpgradeInfoFilePath(), d.cfg.DisableRecase)
+			if err != nil || p.Name == d.lastPlan {
+				continue
+			}
+			d.lastPlan = p.Name
+
+			if err := PreDownloadUpgrade(d.logger, d.cfg, p); err != nil {
+				d.logger.Error("pre-downloading upgrade binary failed", "name", p.Name, "error", err)
+			}
+		}
+	}
+}
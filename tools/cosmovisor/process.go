@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -68,7 +69,26 @@ func (l Launcher) Run(args []string, stdin io.Reader, stdout, stderr io.Writer)
 		}
 	}()
 
-	if needsUpdate, err := l.WaitForUpgradeOrExit(cmd); err != nil || !needsUpdate {
+	stopPreDownload := func() {}
+	if l.cfg.PreDownloadBinaries {
+		done := make(chan struct{})
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			(&preDownloader{logger: l.logger, cfg: l.cfg}).run(done)
+		}()
+		stopPreDownload = func() {
+			close(done)
+			wg.Wait()
+		}
+	}
+
+	needsUpdate, err := l.WaitForUpgradeOrExit(cmd)
+	// the pre-download is stopped as soon as the app exits. A pre-download in progress is
+	// completed first, as it installs the binary in the upgrade directory used below.
+	stopPreDownload()
+	if err != nil || !needsUpdate {
 		return false, err
 	}
 
@@ -197,6 +197,82 @@ func (s *upgradeTestSuite) TestUpgradeBinary() {
 	}
 }
 
+func (s *upgradeTestSuite) TestUpgradeBinaryWithDescriptors() {
+	logger := log.NewLogger(os.Stdout).With(log.ModuleKey, "cosmovisor")
+	home := copyTestData(s.T(), "download")
+	cfg := &cosmovisor.Config{Home: home, Name: "autod", AllowDownloadBinaries: true, DownloadMustHaveChecksum: true}
+
+	url, err := filepath.Abs("./testdata/repo/raw_binary/autod")
+	s.Require().NoError(err)
+
+	// sha256sum ./testdata/repo/raw_binary/autod
+	plan := upgradetypes.Plan{
+		Name: "amazonas",
+		Info: fmt.Sprintf(`{"binary_descriptors":{"%s":{"url":"%s","checksum":"sha256:e6bc7851600a2a9917f7bf88eb7bdee1ec162c671101485690b4deb089077b0d"}}}`, cosmovisor.OSArch(), url),
+	}
+	s.Require().NoError(cosmovisor.UpgradeBinary(logger, cfg, plan))
+
+	currentBin, err := cfg.CurrentBin()
+	s.Require().NoError(err)
+	s.Require().Equal(cfg.UpgradeBin("amazonas"), currentBin)
+}
+
+func (s *upgradeTestSuite) TestPreDownloadUpgrade() {
+	logger := log.NewLogger(os.Stdout).With(log.ModuleKey, "cosmovisor")
+
+	url, err := filepath.Abs("./testdata/repo/raw_binary/autod")
+	s.Require().NoError(err)
+
+	cases := map[string]struct {
+		checksum    string
+		canDownload bool
+	}{
+		"valid checksum": {
+			// sha256sum ./testdata/repo/raw_binary/autod
+			checksum:    "sha256:e6bc7851600a2a9917f7bf88eb7bdee1ec162c671101485690b4deb089077b0d",
+			canDownload: true,
+		},
+		"invalid checksum": {
+			checksum:    "sha256:73e2bd6cbb99261733caf137015d5cc58e3f96248d8b01da68be8564989dd906",
+			canDownload: false,
+		},
+	}
+
+	for label, tc := range cases {
+		s.Run(label, func() {
+			home := copyTestData(s.T(), "download")
+			cfg := &cosmovisor.Config{Home: home, Name: "autod", AllowDownloadBinaries: true}
+
+			plan := upgradetypes.Plan{
+				Name:   "amazonas",
+				Height: 100,
+				Info:   fmt.Sprintf(`{"binary_descriptors":{"%s":{"url":"%s","checksum":"%s"}}}`, cosmovisor.OSArch(), url, tc.checksum),
+			}
+
+			err := cosmovisor.PreDownloadUpgrade(logger, cfg, plan)
+			if !tc.canDownload {
+				s.Require().Error(err)
+				// nothing is left in the upgrade directory
+				_, err = os.Stat(cfg.UpgradeDir(plan.Name))
+				s.Require().True(os.IsNotExist(err))
+				return
+			}
+			s.Require().NoError(err)
+
+			// the binary is installed, but the current binary is unchanged until the upgrade
+			currentBin, err := cfg.CurrentBin()
+			s.Require().NoError(err)
+			s.Require().Equal(cfg.GenesisBin(), currentBin)
+
+			// the pre-downloaded binary is used at the upgrade
+			s.Require().NoError(cosmovisor.UpgradeBinary(logger, &cosmovisor.Config{Home: home, Name: "autod"}, plan))
+			currentBin, err = cfg.CurrentBin()
+			s.Require().NoError(err)
+			s.Require().Equal(cfg.UpgradeBin("amazonas"), currentBin)
+		})
+	}
+}
+
 func (s *upgradeTestSuite) TestOsArch() {
 	// all download tests will fail if we are not on linux...
 	s.Require().Equal("linux/amd64", cosmovisor.OSArch())
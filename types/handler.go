@@ -1,5 +1,16 @@
 package types
 
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// anteTracer is the OpenTelemetry tracer used to trace the AnteDecorators.
+var anteTracer = otel.Tracer("github.com/cosmos/cosmos-sdk/types")
+
 // AnteHandler authenticates transactions, before their internal messages are
 // executed. The provided ctx is expected to contain all relevant information
 // needed to process the transaction, e.g. fee payment information. If new data
@@ -39,6 +50,9 @@ type PostDecorator interface {
 // transactions to be processed with an infinite gasmeter and open a DOS attack vector.
 // Use `ante.SetUpContextDecorator` or a custom Decorator with similar functionality.
 // Returns nil when no AnteDecorator are supplied.
+//
+// Each decorator is traced with a span named after its type, which is the parent
+// of the spans of the decorators further along the chain.
 func ChainAnteDecorators(chain ...AnteDecorator) AnteHandler {
 	if len(chain) == 0 {
 		return nil
@@ -51,8 +65,26 @@ func ChainAnteDecorators(chain ...AnteDecorator) AnteHandler {
 	}
 	for i := 0; i < len(chain); i++ {
 		ii := i
+		spanName := fmt.Sprintf("%T", chain[ii])
 		handlerChain[ii] = func(ctx Context, tx Tx, _ bool) (Context, error) {
-			return chain[ii].AnteHandle(ctx, tx, ctx.ExecMode() == ExecModeSimulate, handlerChain[ii+1])
+			goCtx := ctx.Context()
+			if goCtx == nil {
+				goCtx = context.Background()
+			}
+			goCtx, span := anteTracer.Start(goCtx, spanName)
+			defer span.End()
+
+			// the context is left untouched when tracing is disabled
+			if span.IsRecording() {
+				ctx = ctx.WithContext(goCtx)
+			}
+
+			newCtx, err := chain[ii].AnteHandle(ctx, tx, ctx.ExecMode() == ExecModeSimulate, handlerChain[ii+1])
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			return newCtx, err
 		}
 	}
 
@@ -27,6 +27,15 @@ type FeegrantKeeper interface {
 	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
 
+// FeeSponsorKeeper defines the expected feegrant keeper paying the fees of sponsored
+// transactions, without grant to their fee payer. It is optionally implemented by the
+// FeegrantKeeper.
+type FeeSponsorKeeper interface {
+	// UseSponsoredFees returns the sponsor paying the fee of the msgs of the grantee, or
+	// nil if there is none.
+	UseSponsoredFees(ctx context.Context, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) (sdk.AccAddress, error)
+}
+
 type ConsensusKeeper interface {
 	BlockParams(context.Context) (uint64, uint64, error)
 }
@@ -18,7 +18,8 @@ import (
 // The effective fee should be deducted later, and the priority should be returned in the ABCI response.
 type TxFeeChecker func(ctx context.Context, tx transaction.Tx) (sdk.Coins, int64, error)
 
-// DeductFeeDecorator deducts fees from the fee payer. The fee payer is the fee granter (if specified) or first signer of the tx.
+// DeductFeeDecorator deducts fees from the fee payer. The fee payer is the fee granter (if specified), the sponsor of
+// the tx msgs (if the FeegrantKeeper implements FeeSponsorKeeper and one is found) or first signer of the tx.
 // If the fee payer does not have the funds to pay for the fees, return an InsufficientFunds error.
 // Call next AnteHandler if fees are successfully deducted.
 // CONTRACT: The Tx must implement the FeeTx interface to use DeductFeeDecorator.
@@ -128,6 +129,15 @@ func (dfd *DeductFeeDecorator) checkDeductFee(ctx context.Context, feeTx sdk.Fee
 			}
 		}
 		deductFeesFrom = feeGranter
+	} else if sponsorKeeper, ok := dfd.feegrantKeeper.(FeeSponsorKeeper); ok && !fee.IsZero() {
+		// without feegranter, deduct fee from the sponsor of the msgs, if any.
+		sponsor, err := sponsorKeeper.UseSponsoredFees(ctx, feePayer, fee, feeTx.GetMsgs())
+		if err != nil {
+			return err
+		}
+		if sponsor != nil {
+			deductFeesFrom = sponsor
+		}
 	}
 
 	// deduct the fees
@@ -6,11 +6,14 @@ package authz
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	any "github.com/cosmos/gogoproto/types/any"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -70,6 +73,140 @@ func (m *GenericAuthorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenericAuthorization proto.InternalMessageInfo
 
+// ExecutionLimit limits the number and the frequency of the executions allowed by an authorization.
+type ExecutionLimit struct {
+	// remaining_executions is the number of executions left, the authorization is deleted
+	// after the last one. Zero means no limit.
+	RemainingExecutions uint64 `protobuf:"varint,1,opt,name=remaining_executions,json=remainingExecutions,proto3" json:"remaining_executions,omitempty"`
+	// period is the minimum duration between two executions. Zero means no limit.
+	Period time.Duration `protobuf:"bytes,2,opt,name=period,proto3,stdduration" json:"period"`
+	// last_execution is the time of the last execution, only tracked when period is set.
+	LastExecution *time.Time `protobuf:"bytes,3,opt,name=last_execution,json=lastExecution,proto3,stdtime" json:"last_execution,omitempty"`
+}
+
+func (m *ExecutionLimit) Reset()         { *m = ExecutionLimit{} }
+func (m *ExecutionLimit) String() string { return proto.CompactTextString(m) }
+func (*ExecutionLimit) ProtoMessage()    {}
+func (*ExecutionLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{1}
+}
+func (m *ExecutionLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionLimit.Merge(m, src)
+}
+func (m *ExecutionLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionLimit proto.InternalMessageInfo
+
+// RateLimitedAuthorization gives the grantee permissions to execute the provided method
+// on behalf of the granter's account, a limited number of times and at most once per period.
+type RateLimitedAuthorization struct {
+	// Msg, identified by it's type URL, to grant permissions to execute
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// limit of the executions of the msg
+	Limit ExecutionLimit `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit"`
+}
+
+func (m *RateLimitedAuthorization) Reset()         { *m = RateLimitedAuthorization{} }
+func (m *RateLimitedAuthorization) String() string { return proto.CompactTextString(m) }
+func (*RateLimitedAuthorization) ProtoMessage()    {}
+func (*RateLimitedAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{2}
+}
+func (m *RateLimitedAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitedAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitedAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitedAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitedAuthorization.Merge(m, src)
+}
+func (m *RateLimitedAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitedAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitedAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitedAuthorization proto.InternalMessageInfo
+
+// CompositeAuthorization gives the grantee permissions to execute any of the provided methods
+// on behalf of the granter's account, sharing a spend limit and an execution limit.
+// A granter can give a single CompositeAuthorization to a grantee, it is stored and revoked
+// under the type URL of CompositeAuthorization.
+type CompositeAuthorization struct {
+	// msgs, identified by their type URL, to grant permissions to execute
+	Msgs []string `protobuf:"bytes,1,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// spend_limit is the budget shared by the bank sends and the delegations executed
+	// with this authorization. Empty means no limit. It can only be set when all the msgs
+	// are bank sends, bank multi sends or delegations.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// limit of the executions of all the msgs
+	Limit ExecutionLimit `protobuf:"bytes,3,opt,name=limit,proto3" json:"limit"`
+}
+
+func (m *CompositeAuthorization) Reset()         { *m = CompositeAuthorization{} }
+func (m *CompositeAuthorization) String() string { return proto.CompactTextString(m) }
+func (*CompositeAuthorization) ProtoMessage()    {}
+func (*CompositeAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{3}
+}
+func (m *CompositeAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompositeAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompositeAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompositeAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompositeAuthorization.Merge(m, src)
+}
+func (m *CompositeAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompositeAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompositeAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompositeAuthorization proto.InternalMessageInfo
+
 // Grant gives permissions to execute
 // the provide method with expiration time.
 type Grant struct {
@@ -84,7 +221,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{1}
+	return fileDescriptor_544dc2e84b61c637, []int{4}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -126,7 +263,7 @@ func (m *GrantAuthorization) Reset()         { *m = GrantAuthorization{} }
 func (m *GrantAuthorization) String() string { return proto.CompactTextString(m) }
 func (*GrantAuthorization) ProtoMessage()    {}
 func (*GrantAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{2}
+	return fileDescriptor_544dc2e84b61c637, []int{5}
 }
 func (m *GrantAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -165,7 +302,7 @@ func (m *GrantQueueItem) Reset()         { *m = GrantQueueItem{} }
 func (m *GrantQueueItem) String() string { return proto.CompactTextString(m) }
 func (*GrantQueueItem) ProtoMessage()    {}
 func (*GrantQueueItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{3}
+	return fileDescriptor_544dc2e84b61c637, []int{6}
 }
 func (m *GrantQueueItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -194,45 +331,241 @@ func (m *GrantQueueItem) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GrantQueueItem proto.InternalMessageInfo
 
+// ScheduledExec is a list of messages executed by the x/authz module on behalf of their
+// granter, at the block heights or the epochs of a schedule. The messages are executed
+// with the authorizations granted by the granter to the x/authz module account, and each
+// execution is funded by the granter.
+type ScheduledExec struct {
+	// id is the unique identifier of the scheduled execution.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// granter is the signer of all the msgs, funding the executions.
+	Granter string `protobuf:"bytes,2,opt,name=granter,proto3" json:"granter,omitempty"`
+	// msgs executed, the x/authz will try to find a grant matching
+	// (granter, x/authz module account, MsgTypeURL(msg)) and validate it at each execution.
+	Msgs []*any.Any `protobuf:"bytes,3,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// schedule of the executions.
+	Schedule ExecSchedule `protobuf:"bytes,4,opt,name=schedule,proto3" json:"schedule"`
+	// gas_limit is the maximum amount of gas consumed by an execution.
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// fee paid by the granter to the fee collector for each execution.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// executions is the number of executions done so far.
+	Executions uint64 `protobuf:"varint,7,opt,name=executions,proto3" json:"executions,omitempty"`
+}
+
+func (m *ScheduledExec) Reset()         { *m = ScheduledExec{} }
+func (m *ScheduledExec) String() string { return proto.CompactTextString(m) }
+func (*ScheduledExec) ProtoMessage()    {}
+func (*ScheduledExec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{7}
+}
+func (m *ScheduledExec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledExec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledExec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledExec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledExec.Merge(m, src)
+}
+func (m *ScheduledExec) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledExec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledExec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledExec proto.InternalMessageInfo
+
+// ExecSchedule defines when the msgs of a ScheduledExec are executed, either at block
+// heights or at the start of the epochs of an x/epochs epoch identifier.
+type ExecSchedule struct {
+	// epoch_identifier is the identifier of the epochs at the start of which the msgs are
+	// executed. When empty, the msgs are executed at block heights.
+	EpochIdentifier string `protobuf:"bytes,1,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// next is the block height, or the epoch number, of the next execution. For an epoch
+	// schedule, zero means the start of the next epoch.
+	Next int64 `protobuf:"varint,2,opt,name=next,proto3" json:"next,omitempty"`
+	// interval is the number of blocks, or of epochs, between two executions.
+	// Zero means a single execution.
+	Interval int64 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	// max_executions is the number of executions after which the schedule ends.
+	// Zero means no limit.
+	MaxExecutions uint64 `protobuf:"varint,4,opt,name=max_executions,json=maxExecutions,proto3" json:"max_executions,omitempty"`
+}
+
+func (m *ExecSchedule) Reset()         { *m = ExecSchedule{} }
+func (m *ExecSchedule) String() string { return proto.CompactTextString(m) }
+func (*ExecSchedule) ProtoMessage()    {}
+func (*ExecSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{8}
+}
+func (m *ExecSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecSchedule.Merge(m, src)
+}
+func (m *ExecSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecSchedule proto.InternalMessageInfo
+
+// ScheduledExecResult is the outcome of an execution of a ScheduledExec.
+type ScheduledExecResult struct {
+	// scheduled_exec_id is the id of the executed ScheduledExec.
+	ScheduledExecId uint64 `protobuf:"varint,1,opt,name=scheduled_exec_id,json=scheduledExecId,proto3" json:"scheduled_exec_id,omitempty"`
+	// execution is the sequence number of the execution, starting at 1.
+	Execution uint64 `protobuf:"varint,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	// height of the block of the execution.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// time of the block of the execution.
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// gas_used by the execution.
+	GasUsed uint64 `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// error of the execution, empty when the execution succeeded.
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ScheduledExecResult) Reset()         { *m = ScheduledExecResult{} }
+func (m *ScheduledExecResult) String() string { return proto.CompactTextString(m) }
+func (*ScheduledExecResult) ProtoMessage()    {}
+func (*ScheduledExecResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{9}
+}
+func (m *ScheduledExecResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledExecResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledExecResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledExecResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledExecResult.Merge(m, src)
+}
+func (m *ScheduledExecResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledExecResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledExecResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledExecResult proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GenericAuthorization)(nil), "cosmos.authz.v1beta1.GenericAuthorization")
+	proto.RegisterType((*ExecutionLimit)(nil), "cosmos.authz.v1beta1.ExecutionLimit")
+	proto.RegisterType((*RateLimitedAuthorization)(nil), "cosmos.authz.v1beta1.RateLimitedAuthorization")
+	proto.RegisterType((*CompositeAuthorization)(nil), "cosmos.authz.v1beta1.CompositeAuthorization")
 	proto.RegisterType((*Grant)(nil), "cosmos.authz.v1beta1.Grant")
 	proto.RegisterType((*GrantAuthorization)(nil), "cosmos.authz.v1beta1.GrantAuthorization")
 	proto.RegisterType((*GrantQueueItem)(nil), "cosmos.authz.v1beta1.GrantQueueItem")
+	proto.RegisterType((*ScheduledExec)(nil), "cosmos.authz.v1beta1.ScheduledExec")
+	proto.RegisterType((*ExecSchedule)(nil), "cosmos.authz.v1beta1.ExecSchedule")
+	proto.RegisterType((*ScheduledExecResult)(nil), "cosmos.authz.v1beta1.ScheduledExecResult")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/authz.proto", fileDescriptor_544dc2e84b61c637) }
 
 var fileDescriptor_544dc2e84b61c637 = []byte{
-	// 439 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0xe3, 0x75, 0xfc, 0x99, 0xa7, 0x21, 0x88, 0x72, 0x28, 0x3d, 0x24, 0x55, 0x0e, 0x68,
-	0x42, 0x6a, 0xac, 0x15, 0x4e, 0x9c, 0x68, 0x84, 0x34, 0xc1, 0x8d, 0x30, 0x2e, 0x5c, 0x2a, 0x67,
-	0x31, 0x9e, 0xb5, 0x3a, 0x8e, 0x6c, 0x07, 0x2d, 0xfb, 0x08, 0x9c, 0xf6, 0x19, 0xf8, 0x04, 0x20,
-	0xed, 0x43, 0x54, 0x9c, 0x2a, 0x4e, 0x9c, 0xf8, 0xd3, 0x1e, 0xf8, 0x1a, 0xa8, 0x76, 0x22, 0x1a,
-	0x5a, 0x89, 0x1e, 0xb8, 0x44, 0x7e, 0xfd, 0x3e, 0xcf, 0xfb, 0x3e, 0xf9, 0x45, 0x81, 0xfd, 0x53,
-	0xa1, 0xb8, 0x50, 0x08, 0x97, 0xfa, 0xec, 0x12, 0xbd, 0x3b, 0x4a, 0x89, 0xc6, 0x47, 0xb6, 0x8a,
-	0x0a, 0x29, 0xb4, 0x70, 0x3d, 0xab, 0x88, 0xec, 0x5d, 0xad, 0xe8, 0xdd, 0xc3, 0x9c, 0xe5, 0x02,
-	0x99, 0xa7, 0x15, 0xf6, 0xee, 0x5b, 0xe1, 0xd8, 0x54, 0xa8, 0x76, 0xd9, 0x56, 0x40, 0x85, 0xa0,
-	0x13, 0x82, 0x4c, 0x95, 0x96, 0x6f, 0x91, 0x66, 0x9c, 0x28, 0x8d, 0x79, 0x51, 0x0b, 0x3c, 0x2a,
-	0xa8, 0xb0, 0xc6, 0xe5, 0xa9, 0x99, 0xf8, 0xb7, 0x0d, 0xe7, 0x95, 0x6d, 0x85, 0x1a, 0x7a, 0xc7,
-	0x24, 0x27, 0x92, 0x9d, 0x8e, 0x4a, 0x7d, 0x26, 0x24, 0xbb, 0xc4, 0x9a, 0x89, 0xdc, 0xbd, 0x0b,
-	0x3b, 0x5c, 0xd1, 0x2e, 0xe8, 0x83, 0xc3, 0xbd, 0x64, 0x79, 0x7c, 0xf2, 0xe2, 0xf3, 0xf5, 0x20,
-	0xdc, 0xf4, 0x0e, 0x51, 0xcb, 0xf9, 0xfe, 0xd7, 0xc7, 0x87, 0x81, 0x95, 0x0d, 0x54, 0x76, 0x8e,
-	0x36, 0x4d, 0x0f, 0x3f, 0x01, 0x78, 0xe3, 0x58, 0xe2, 0x5c, 0xbb, 0x29, 0x3c, 0xc0, 0xab, 0x2d,
-	0xb3, 0x71, 0x7f, 0xe8, 0x45, 0x36, 0x72, 0xd4, 0x44, 0x8e, 0x46, 0x79, 0x15, 0x3f, 0xd8, 0x2e,
-	0x42, 0xd2, 0x1e, 0xe9, 0x3e, 0x83, 0x90, 0x5c, 0x14, 0x4c, 0xda, 0x05, 0x3b, 0x66, 0x41, 0x6f,
-	0x6d, 0xc1, 0x49, 0x83, 0x32, 0xbe, 0x3d, 0xfd, 0x16, 0x80, 0xab, 0xef, 0x01, 0x48, 0x56, 0x7c,
-	0xe1, 0x87, 0x1d, 0xe8, 0x9a, 0xcc, 0x6d, 0x50, 0x43, 0x78, 0x8b, 0x2e, 0x6f, 0x89, 0xb4, 0xb0,
-	0xe2, 0xee, 0x97, 0xeb, 0x41, 0xf3, 0xad, 0x47, 0x59, 0x26, 0x89, 0x52, 0xaf, 0xb4, 0x64, 0x39,
-	0x4d, 0x1a, 0xe1, 0x1f, 0x0f, 0x31, 0x69, 0xb6, 0xf0, 0x90, 0x75, 0x50, 0x9d, 0xff, 0x0f, 0xea,
-	0x69, 0x0b, 0xd4, 0xee, 0x3f, 0x41, 0xed, 0xae, 0x41, 0x7a, 0x0c, 0xef, 0x18, 0x46, 0x2f, 0x4b,
-	0x52, 0x92, 0xe7, 0x9a, 0x70, 0x37, 0x84, 0x07, 0x5c, 0xd1, 0xb1, 0xae, 0x0a, 0x32, 0x2e, 0xe5,
-	0x44, 0x75, 0x41, 0xbf, 0x73, 0xb8, 0x97, 0xec, 0x73, 0x45, 0x4f, 0xaa, 0x82, 0xbc, 0x96, 0x13,
-	0x15, 0x0f, 0xa7, 0x3f, 0x7d, 0x67, 0x3a, 0xf7, 0xc1, 0x6c, 0xee, 0x83, 0x1f, 0x73, 0x1f, 0x5c,
-	0x2d, 0x7c, 0x67, 0xb6, 0xf0, 0x9d, 0xaf, 0x0b, 0xdf, 0x79, 0x53, 0x83, 0x51, 0xd9, 0x79, 0xc4,
-	0x04, 0xba, 0xb0, 0x3f, 0x55, 0x7a, 0xd3, 0xe4, 0x79, 0xf4, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x60,
-	0x57, 0xde, 0x7c, 0x79, 0x03, 0x00, 0x00,
+	// 1009 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x6f, 0xdb, 0xc6,
+	0x13, 0x15, 0x45, 0x59, 0xb6, 0xd6, 0x91, 0x12, 0x6f, 0x84, 0xfc, 0x64, 0xe7, 0x07, 0x49, 0x65,
+	0xff, 0xc0, 0x35, 0x20, 0x2a, 0x76, 0x7b, 0x32, 0x50, 0x20, 0x56, 0xe2, 0x06, 0xee, 0x9f, 0x43,
+	0x99, 0xe4, 0x52, 0xa0, 0x20, 0x28, 0x71, 0x4c, 0x2d, 0x22, 0x72, 0x05, 0xee, 0x32, 0x90, 0x72,
+	0xec, 0xb1, 0x27, 0x1f, 0x8b, 0x1e, 0xdb, 0x4b, 0x91, 0x93, 0x0b, 0xf8, 0x43, 0x18, 0x39, 0x05,
+	0x41, 0x81, 0xf6, 0x14, 0xb7, 0x36, 0x0a, 0x7f, 0x87, 0x9e, 0x0a, 0xee, 0x2e, 0x69, 0x2a, 0x92,
+	0x1b, 0x15, 0x29, 0x7a, 0x11, 0xb8, 0x3b, 0xf3, 0x56, 0x6f, 0xde, 0xbc, 0xd9, 0x45, 0xcd, 0x1e,
+	0x65, 0x3e, 0x65, 0x6d, 0x27, 0xe2, 0xfd, 0x27, 0xed, 0xc7, 0x9b, 0x5d, 0xe0, 0xce, 0xa6, 0x5c,
+	0x99, 0xc3, 0x90, 0x72, 0x8a, 0xab, 0x32, 0xc3, 0x94, 0x7b, 0x2a, 0x63, 0x6d, 0xc5, 0xf1, 0x49,
+	0x40, 0xdb, 0xe2, 0x57, 0x26, 0xae, 0xad, 0xca, 0x44, 0x5b, 0xac, 0xda, 0x0a, 0x25, 0x43, 0x0d,
+	0x8f, 0x52, 0x6f, 0x00, 0x6d, 0xb1, 0xea, 0x46, 0xfb, 0x6d, 0x4e, 0x7c, 0x60, 0xdc, 0xf1, 0x87,
+	0x2a, 0xa1, 0xea, 0x51, 0x8f, 0x4a, 0x60, 0xfc, 0x95, 0x9c, 0xf8, 0x2a, 0xcc, 0x09, 0xc6, 0x2a,
+	0x54, 0x7f, 0x35, 0xe4, 0x46, 0xa1, 0xc3, 0x09, 0x0d, 0x92, 0xb8, 0xaa, 0xab, 0xeb, 0x30, 0x48,
+	0xcb, 0xea, 0x51, 0xa2, 0xe2, 0x06, 0x47, 0xd5, 0x7b, 0x10, 0x40, 0x48, 0x7a, 0x3b, 0x11, 0xef,
+	0xd3, 0x90, 0x3c, 0x11, 0x68, 0x7c, 0x0d, 0xe9, 0x3e, 0xf3, 0x6a, 0x5a, 0x53, 0x5b, 0x2f, 0x59,
+	0xf1, 0xe7, 0xf6, 0x27, 0xcf, 0x8e, 0x5a, 0xc6, 0x2c, 0x0d, 0xcc, 0x09, 0xe4, 0x37, 0xe7, 0x87,
+	0x1b, 0x0d, 0x99, 0xd6, 0x62, 0xee, 0xa3, 0xf6, 0xac, 0xd3, 0x8d, 0x3f, 0x34, 0x54, 0xd9, 0x1d,
+	0x41, 0x2f, 0x8a, 0x57, 0x9f, 0x11, 0x9f, 0x70, 0xbc, 0x89, 0xaa, 0x21, 0xf8, 0x0e, 0x09, 0x48,
+	0xe0, 0xd9, 0x90, 0xc4, 0x98, 0x60, 0x50, 0xb0, 0xae, 0xa7, 0xb1, 0x14, 0xc6, 0xf0, 0x6d, 0x54,
+	0x1c, 0x42, 0x48, 0xa8, 0x5b, 0xcb, 0x37, 0xb5, 0xf5, 0xe5, 0xad, 0x55, 0x53, 0x8a, 0x61, 0x26,
+	0x62, 0x98, 0x77, 0x95, 0x18, 0x9d, 0xf2, 0xf1, 0xcb, 0x46, 0xee, 0xdb, 0x93, 0x86, 0xf6, 0xe3,
+	0xf9, 0xe1, 0x86, 0x66, 0x29, 0x1c, 0xfe, 0x14, 0x55, 0x06, 0x0e, 0xe3, 0x17, 0xff, 0x57, 0xd3,
+	0xc5, 0x49, 0x6b, 0x53, 0x27, 0x3d, 0x48, 0x1a, 0xd5, 0x59, 0x3a, 0x7e, 0xd9, 0xd0, 0x0e, 0x4e,
+	0x1a, 0x9a, 0x55, 0x8e, 0xb1, 0x29, 0x9f, 0xed, 0x95, 0x17, 0x47, 0xad, 0xf2, 0x48, 0x7a, 0xa6,
+	0xb9, 0x69, 0xde, 0x32, 0x6f, 0x19, 0x3f, 0x6b, 0xa8, 0x66, 0x39, 0x1c, 0x44, 0x89, 0xe0, 0xbe,
+	0x46, 0x62, 0xbc, 0x8b, 0x16, 0x06, 0x71, 0xa6, 0xaa, 0xe7, 0x1d, 0x73, 0xa6, 0xdc, 0x93, 0xc2,
+	0x75, 0x4a, 0x71, 0x69, 0xb2, 0x2c, 0x89, 0xde, 0xb6, 0xe7, 0xeb, 0xd4, 0x14, 0xdd, 0xb8, 0x75,
+	0x6f, 0x67, 0x5a, 0x77, 0x19, 0x73, 0xe3, 0x97, 0x3c, 0xba, 0x71, 0x87, 0xfa, 0x43, 0xca, 0x08,
+	0x87, 0xc9, 0xa2, 0x30, 0x2a, 0xf8, 0xcc, 0x8b, 0xdb, 0xa6, 0xaf, 0x97, 0x2c, 0xf1, 0x8d, 0xbf,
+	0xd6, 0xd0, 0x32, 0x1b, 0x42, 0xe0, 0xda, 0x49, 0x75, 0xba, 0xe8, 0x96, 0xa2, 0x18, 0x5b, 0x33,
+	0x65, 0x78, 0x87, 0x92, 0xa0, 0xf3, 0x71, 0x5c, 0xd2, 0xd3, 0x93, 0xc6, 0xba, 0x47, 0x78, 0x3f,
+	0xea, 0x9a, 0x3d, 0xea, 0xab, 0x39, 0x6a, 0x67, 0xe8, 0xf1, 0xf1, 0x10, 0x98, 0x00, 0xb0, 0xef,
+	0xce, 0x0f, 0x37, 0xae, 0x0c, 0xc0, 0x73, 0x7a, 0x63, 0x3b, 0x36, 0x37, 0x93, 0x7a, 0x20, 0xf1,
+	0xaf, 0xd2, 0x5f, 0xa9, 0xb6, 0xfa, 0x1b, 0x69, 0xfb, 0xd5, 0x1b, 0x68, 0xfb, 0x56, 0x86, 0xfc,
+	0x6c, 0xf9, 0x8c, 0x9f, 0x34, 0xb4, 0x70, 0x2f, 0x74, 0x02, 0x8e, 0xbb, 0xa8, 0xec, 0x64, 0x43,
+	0xc2, 0x27, 0xcb, 0x5b, 0xd5, 0x29, 0x67, 0xee, 0x04, 0xe3, 0xce, 0x7b, 0xf3, 0xb1, 0xb2, 0x26,
+	0x8f, 0xc4, 0x77, 0x11, 0x82, 0xd1, 0x90, 0xc8, 0x19, 0xa9, 0xe5, 0xff, 0x81, 0xf5, 0x33, 0x38,
+	0xe3, 0xfb, 0x3c, 0xc2, 0x82, 0xf3, 0xa4, 0x13, 0xb6, 0xd0, 0xa2, 0x17, 0xef, 0x42, 0x28, 0x2d,
+	0xde, 0xa9, 0xbd, 0x38, 0x6a, 0x25, 0x97, 0xe8, 0x8e, 0xeb, 0x86, 0xc0, 0xd8, 0x7d, 0x1e, 0x92,
+	0xc0, 0xb3, 0x92, 0xc4, 0x0b, 0x0c, 0xd4, 0xf2, 0xf3, 0x61, 0x60, 0x5a, 0x28, 0xfd, 0xdf, 0x17,
+	0xea, 0xf6, 0x84, 0x50, 0x85, 0xd7, 0x0a, 0x55, 0x98, 0x12, 0xe9, 0x43, 0x54, 0x11, 0x1a, 0x7d,
+	0x11, 0x41, 0x04, 0x7b, 0x1c, 0x7c, 0x6c, 0xa0, 0xb2, 0xcf, 0x3c, 0x3b, 0x76, 0xb1, 0x1d, 0x85,
+	0x83, 0x64, 0x64, 0x96, 0x7d, 0xe6, 0x3d, 0x18, 0x0f, 0xe1, 0x61, 0x38, 0x60, 0xc6, 0x53, 0x1d,
+	0x95, 0xef, 0xf7, 0xfa, 0xe0, 0x46, 0x03, 0x70, 0x63, 0x6f, 0xe2, 0x0a, 0xca, 0x13, 0x57, 0x5d,
+	0x8a, 0x79, 0xe2, 0x66, 0x55, 0xce, 0xcf, 0xab, 0xf2, 0xae, 0x9a, 0x51, 0xbd, 0xa9, 0x5f, 0x2a,
+	0xd4, 0xcd, 0x67, 0x47, 0xad, 0xff, 0xcd, 0x1a, 0xd0, 0xcf, 0x99, 0xa7, 0xc6, 0x7a, 0x0f, 0x2d,
+	0x31, 0xc5, 0x4d, 0x49, 0x62, 0x5c, 0x3e, 0x54, 0x49, 0x15, 0xd9, 0x91, 0x4a, 0xe1, 0xf8, 0x26,
+	0x2a, 0x79, 0x0e, 0x53, 0xd7, 0xc3, 0x82, 0x28, 0x6e, 0xc9, 0x73, 0x98, 0x9c, 0x5c, 0x86, 0xf4,
+	0x7d, 0x80, 0x5a, 0xf1, 0xbf, 0xba, 0x35, 0xe2, 0x7f, 0xc3, 0xf5, 0xb8, 0xe3, 0xe9, 0x23, 0xb4,
+	0x28, 0x28, 0x65, 0x76, 0x66, 0x5d, 0xf6, 0x3f, 0x68, 0xe8, 0x4a, 0xb6, 0x54, 0xfc, 0x3e, 0xba,
+	0x06, 0x43, 0xda, 0xeb, 0xdb, 0xc4, 0x85, 0x80, 0x93, 0x7d, 0x92, 0x8c, 0x82, 0x75, 0x55, 0xec,
+	0xef, 0xa5, 0xdb, 0xf1, 0xb5, 0x19, 0xc0, 0x48, 0x5e, 0xfc, 0xba, 0x25, 0xbe, 0xf1, 0x1a, 0x5a,
+	0x22, 0x71, 0xbf, 0x1e, 0x3b, 0x03, 0xe1, 0x69, 0xdd, 0x4a, 0xd7, 0xf8, 0x5d, 0x54, 0xf1, 0x9d,
+	0x51, 0xf6, 0x9d, 0x2c, 0x08, 0x8a, 0x65, 0xdf, 0x19, 0xed, 0xfe, 0x2d, 0xcb, 0x3f, 0x35, 0x74,
+	0x7d, 0xc2, 0x52, 0x16, 0xb0, 0x68, 0xc0, 0xf1, 0x06, 0x5a, 0x49, 0xda, 0xe1, 0x8a, 0x73, 0xed,
+	0xd4, 0x67, 0x57, 0x59, 0x36, 0x7f, 0xcf, 0xc5, 0xff, 0x47, 0xa5, 0x8b, 0x17, 0x33, 0x2f, 0x72,
+	0x2e, 0x36, 0xf0, 0x0d, 0x54, 0xec, 0x03, 0xf1, 0xfa, 0x5c, 0xb1, 0x56, 0x2b, 0xfc, 0x11, 0x2a,
+	0x70, 0xe2, 0xc3, 0x1c, 0xe3, 0x23, 0x5e, 0xeb, 0x83, 0xf4, 0xb5, 0x16, 0x30, 0xbc, 0x8a, 0x62,
+	0x4b, 0xd8, 0x11, 0x03, 0x57, 0x59, 0x64, 0xd1, 0x73, 0xd8, 0x43, 0x06, 0x2e, 0xae, 0xa2, 0x05,
+	0x08, 0x43, 0x1a, 0xd6, 0x8a, 0x42, 0x5d, 0xb9, 0x98, 0x51, 0x7c, 0x67, 0xeb, 0xf8, 0xf7, 0x7a,
+	0xee, 0xf8, 0xb4, 0xae, 0x3d, 0x3f, 0xad, 0x6b, 0xbf, 0x9d, 0xd6, 0xb5, 0x83, 0xb3, 0x7a, 0xee,
+	0xf9, 0x59, 0x3d, 0xf7, 0xeb, 0x59, 0x3d, 0xf7, 0xa5, 0x1a, 0x1b, 0xe6, 0x3e, 0x32, 0x09, 0x6d,
+	0x2b, 0x64, 0xb7, 0x28, 0x08, 0x7e, 0xf0, 0xd7, 0x00, 0xde, 0x49, 0x19, 0x12, 0x22, 0x0a, 0x00,
+	0x00,
 }
 
 func (m *GenericAuthorization) Marshal() (dAtA []byte, err error) {
@@ -265,7 +598,7 @@ func (m *GenericAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Grant) Marshal() (dAtA []byte, err error) {
+func (m *ExecutionLimit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -275,24 +608,166 @@ func (m *Grant) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Grant) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecutionLimit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExecutionLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Expiration != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+	if m.LastExecution != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastExecution, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastExecution):])
 		if err1 != nil {
 			return 0, err1
 		}
 		i -= n1
 		i = encodeVarintAuthz(dAtA, i, uint64(n1))
 		i--
+		dAtA[i] = 0x1a
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAuthz(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.RemainingExecutions != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.RemainingExecutions))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitedAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitedAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitedAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuthz(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompositeAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompositeAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompositeAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuthz(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Msgs[iNdEx])
+			copy(dAtA[i:], m.Msgs[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Msgs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Grant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Grant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintAuthz(dAtA, i, uint64(n5))
+		i--
 		dAtA[i] = 0x12
 	}
 	if m.Authorization != nil {
@@ -331,12 +806,12 @@ func (m *GrantAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Expiration != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
-		if err3 != nil {
-			return 0, err3
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n3
-		i = encodeVarintAuthz(dAtA, i, uint64(n3))
+		i -= n7
+		i = encodeVarintAuthz(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -401,27 +876,269 @@ func (m *GrantQueueItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAuthz(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ScheduledExec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenericAuthorization) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ScheduledExec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executions != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.Executions))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuthz(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxExecutions != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MaxExecutions))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Interval != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Next != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.Next))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledExecResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledExecResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledExecResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x28
+	}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintAuthz(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Execution != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.Execution))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ScheduledExecId != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.ScheduledExecId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenericAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutionLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RemainingExecutions != 0 {
+		n += 1 + sovAuthz(uint64(m.RemainingExecutions))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovAuthz(uint64(l))
+	if m.LastExecution != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastExecution)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *RateLimitedAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = len(m.Msg)
 	if l > 0 {
 		n += 1 + l + sovAuthz(uint64(l))
 	}
+	l = m.Limit.Size()
+	n += 1 + l + sovAuthz(uint64(l))
+	return n
+}
+
+func (m *CompositeAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for _, s := range m.Msgs {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovAuthz(uint64(l))
 	return n
 }
 
@@ -482,6 +1199,91 @@ func (m *GrantQueueItem) Size() (n int) {
 	return n
 }
 
+func (m *ScheduledExec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAuthz(uint64(m.Id))
+	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = m.Schedule.Size()
+	n += 1 + l + sovAuthz(uint64(l))
+	if m.GasLimit != 0 {
+		n += 1 + sovAuthz(uint64(m.GasLimit))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.Executions != 0 {
+		n += 1 + sovAuthz(uint64(m.Executions))
+	}
+	return n
+}
+
+func (m *ExecSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Next != 0 {
+		n += 1 + sovAuthz(uint64(m.Next))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovAuthz(uint64(m.Interval))
+	}
+	if m.MaxExecutions != 0 {
+		n += 1 + sovAuthz(uint64(m.MaxExecutions))
+	}
+	return n
+}
+
+func (m *ScheduledExecResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduledExecId != 0 {
+		n += 1 + sovAuthz(uint64(m.ScheduledExecId))
+	}
+	if m.Execution != 0 {
+		n += 1 + sovAuthz(uint64(m.Execution))
+	}
+	if m.Height != 0 {
+		n += 1 + sovAuthz(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovAuthz(uint64(l))
+	if m.GasUsed != 0 {
+		n += 1 + sovAuthz(uint64(m.GasUsed))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
 func sovAuthz(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -570,7 +1372,7 @@ func (m *GenericAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Grant) Unmarshal(dAtA []byte) error {
+func (m *ExecutionLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -593,15 +1395,34 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Grant: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutionLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Grant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutionLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingExecutions", wireType)
+			}
+			m.RemainingExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -628,16 +1449,13 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Authorization == nil {
-				m.Authorization = &any.Any{}
-			}
-			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -664,10 +1482,10 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Expiration == nil {
-				m.Expiration = new(time.Time)
+			if m.LastExecution == nil {
+				m.LastExecution = new(time.Time)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastExecution, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -692,7 +1510,7 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GrantAuthorization) Unmarshal(dAtA []byte) error {
+func (m *RateLimitedAuthorization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -715,15 +1533,15 @@ func (m *GrantAuthorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GrantAuthorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: RateLimitedAuthorization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GrantAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RateLimitedAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -751,13 +1569,13 @@ func (m *GrantAuthorization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthz
@@ -767,33 +1585,233 @@ func (m *GrantAuthorization) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuthz
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuthz
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuthz
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompositeAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompositeAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompositeAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Grant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Grant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Grant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
@@ -821,7 +1839,7 @@ func (m *GrantAuthorization) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
@@ -878,7 +1896,7 @@ func (m *GrantAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GrantQueueItem) Unmarshal(dAtA []byte) error {
+func (m *GrantAuthorization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -901,15 +1919,15 @@ func (m *GrantQueueItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GrantQueueItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: GrantAuthorization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GrantQueueItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GrantAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -937,7 +1955,763 @@ func (m *GrantQueueItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Authorization == nil {
+				m.Authorization = &any.Any{}
+			}
+			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GrantQueueItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrantQueueItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrantQueueItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledExec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledExec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledExec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &any.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			m.Executions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
+			}
+			m.Next = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Next |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutions", wireType)
+			}
+			m.MaxExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledExecResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledExecResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledExecResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledExecId", wireType)
+			}
+			m.ScheduledExecId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledExecId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			m.Execution = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Execution |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28,6 +28,9 @@ const (
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
 	FlagAllowList         = "allow-list"
+	FlagMsgTypes          = "msg-types"
+	FlagMaxExecutions     = "max-executions"
+	FlagPeriod            = "period"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
 	unbond                = "unbond"
@@ -95,12 +98,14 @@ func NewCmdExecAuthorization() *cobra.Command {
 // Migrating this command to AutoCLI is possible but would be CLI breaking.
 func NewCmdGrantAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
+		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"rate-limited\"|\"composite\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
 		Short: "Grant authorization to an address",
 		Long: fmt.Sprintf(`create a new grant authorization to an address to execute a transaction on your behalf:
 Examples:
  $ %[1]s tx authz grant cosmos1skjw.. send --spend-limit=1000stake --from=cosmos1skl..
  $ %[1]s tx authz grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1.MsgVote --from=cosmos1sk..
+ $ %[1]s tx authz grant cosmos1skjw.. rate-limited --msg-type=/cosmos.gov.v1.MsgVote --max-executions=10 --period=24h --from=cosmos1sk..
+ $ %[1]s tx authz grant cosmos1skjw.. composite --msg-types=/cosmos.bank.v1beta1.MsgSend,/cosmos.staking.v1beta1.MsgDelegate --spend-limit=1000stake --from=cosmos1sk..
 	`, version.AppName),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -168,6 +173,38 @@ Examples:
 				}
 
 				authorization = authz.NewGenericAuthorization(msgType)
+			case "rate-limited", "composite":
+				limit, err := getExecutionLimit(cmd)
+				if err != nil {
+					return err
+				}
+
+				if args[1] == "rate-limited" {
+					msgType, err := cmd.Flags().GetString(FlagMsgType)
+					if err != nil {
+						return err
+					}
+
+					authorization = authz.NewRateLimitedAuthorization(msgType, limit.RemainingExecutions, limit.Period)
+					break
+				}
+
+				msgTypes, err := cmd.Flags().GetStringSlice(FlagMsgTypes)
+				if err != nil {
+					return err
+				}
+
+				spendLimit, err := cmd.Flags().GetString(FlagSpendLimit)
+				if err != nil {
+					return err
+				}
+
+				coins, err := sdk.ParseCoinsNormalized(spendLimit)
+				if err != nil {
+					return err
+				}
+
+				authorization = authz.NewCompositeAuthorization(msgTypes, coins, limit)
 			case delegate, unbond, redelegate:
 				limit, err := cmd.Flags().GetString(FlagSpendLimit)
 				if err != nil {
@@ -247,8 +284,11 @@ Examples:
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
-	cmd.Flags().String(FlagMsgType, "", "The Msg method name for which we are creating a GenericAuthorization")
-	cmd.Flags().String(FlagSpendLimit, "", "SpendLimit for Send Authorization, an array of Coins allowed spend")
+	cmd.Flags().String(FlagMsgType, "", "The Msg method name for which we are creating a GenericAuthorization or a RateLimitedAuthorization")
+	cmd.Flags().StringSlice(FlagMsgTypes, []string{}, "The Msg method names for which we are creating a CompositeAuthorization separated by ,")
+	cmd.Flags().String(FlagSpendLimit, "", "SpendLimit for Send or Composite Authorization, an array of Coins allowed spend")
+	cmd.Flags().Uint64(FlagMaxExecutions, 0, "Maximum number of executions for Rate-Limited or Composite Authorization. Set zero (0) for no limit.")
+	cmd.Flags().Duration(FlagPeriod, 0, "Minimum duration between two executions for Rate-Limited or Composite Authorization. Set zero (0) for no limit.")
 	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Allowed validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagAllowList, []string{}, "Allowed addresses grantee is allowed to send funds separated by ,")
@@ -256,6 +296,18 @@ Examples:
 	return cmd
 }
 
+func getExecutionLimit(cmd *cobra.Command) (authz.ExecutionLimit, error) {
+	maxExecutions, err := cmd.Flags().GetUint64(FlagMaxExecutions)
+	if err != nil {
+		return authz.ExecutionLimit{}, err
+	}
+	period, err := cmd.Flags().GetDuration(FlagPeriod)
+	if err != nil {
+		return authz.ExecutionLimit{}, err
+	}
+	return authz.ExecutionLimit{RemainingExecutions: maxExecutions, Period: period}, nil
+}
+
 func getExpireTime(cmd *cobra.Command) (*time.Time, error) {
 	exp, err := cmd.Flags().GetInt64(FlagExpiration)
 	if err != nil {
@@ -16,9 +16,13 @@ func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	legacy.RegisterAminoMsg(registrar, &MsgGrant{}, "cosmos-sdk/MsgGrant")
 	legacy.RegisterAminoMsg(registrar, &MsgRevoke{}, "cosmos-sdk/MsgRevoke")
 	legacy.RegisterAminoMsg(registrar, &MsgExec{}, "cosmos-sdk/MsgExec")
+	legacy.RegisterAminoMsg(registrar, &MsgScheduleExec{}, "cosmos-sdk/MsgScheduleExec")
+	legacy.RegisterAminoMsg(registrar, &MsgCancelScheduledExec{}, "cosmos-sdk/MsgCancelScheduledExec")
 
 	registrar.RegisterInterface((*Authorization)(nil), nil)
 	registrar.RegisterConcrete(&GenericAuthorization{}, "cosmos-sdk/GenericAuthorization")
+	registrar.RegisterConcrete(&RateLimitedAuthorization{}, "cosmos-sdk/RateLimitedAuthorization")
+	registrar.RegisterConcrete(&CompositeAuthorization{}, "cosmos-sdk/CompositeAuthorization")
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry
@@ -27,6 +31,8 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgGrant{},
 		&MsgRevoke{},
 		&MsgExec{},
+		&MsgScheduleExec{},
+		&MsgCancelScheduledExec{},
 	)
 
 	// since bank.SendAuthorization and staking.StakeAuthorization both implement Authorization
@@ -37,6 +43,8 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		"cosmos.authz.v1beta1.Authorization",
 		(*Authorization)(nil),
 		&GenericAuthorization{},
+		&RateLimitedAuthorization{},
+		&CompositeAuthorization{},
 		&bank.SendAuthorization{},
 		&staking.StakeAuthorization{},
 	)
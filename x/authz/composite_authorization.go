@@ -0,0 +1,126 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	bank "cosmossdk.io/x/bank/types"
+	staking "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/authz"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CompositeAuthorizationTypeURL is the key under which a CompositeAuthorization is stored,
+// and the msg type URL to use to revoke it.
+var CompositeAuthorizationTypeURL = sdk.MsgTypeURL(&CompositeAuthorization{})
+
+// spendLimitMsgTypeURLs are the msg type URLs whose spent amount is charged on the spend limit.
+// A spend limit can only be set when all the msgs of the authorization are among them.
+var spendLimitMsgTypeURLs = []string{
+	sdk.MsgTypeURL(&bank.MsgSend{}),
+	sdk.MsgTypeURL(&bank.MsgMultiSend{}),
+	sdk.MsgTypeURL(&staking.MsgDelegate{}),
+}
+
+// NewCompositeAuthorization creates a new CompositeAuthorization object.
+// An empty spendLimit means no spend limit.
+func NewCompositeAuthorization(msgTypeURLs []string, spendLimit sdk.Coins, limit ExecutionLimit) *CompositeAuthorization {
+	return &CompositeAuthorization{
+		Msgs:       msgTypeURLs,
+		SpendLimit: spendLimit,
+		Limit:      limit,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+// A CompositeAuthorization is stored under its own type URL and accepts each msg type of Msgs.
+func (a CompositeAuthorization) MsgTypeURL() string {
+	return CompositeAuthorizationTypeURL
+}
+
+// Accept implements Authorization.Accept.
+// The bank sends and the delegations are charged on the spend limit, the other msgs are
+// rejected when a spend limit is set as the coins they move cannot be accounted for.
+func (a CompositeAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	if !slices.Contains(a.Msgs, sdk.MsgTypeURL(msg)) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("%s is not allowed by the composite authorization", sdk.MsgTypeURL(msg))
+	}
+
+	spendLimit := a.SpendLimit
+	if len(spendLimit) > 0 {
+		spent, ok := spentAmount(msg)
+		if !ok {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("%s cannot be charged on the spend limit", sdk.MsgTypeURL(msg))
+		}
+
+		var isNegative bool
+		spendLimit, isNegative = spendLimit.SafeSub(spent...)
+		if isNegative {
+			return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than spend limit")
+		}
+	}
+
+	limit, last, err := a.Limit.accept(ctx)
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	if last || (len(a.SpendLimit) > 0 && spendLimit.IsZero()) {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Updated: &CompositeAuthorization{
+		Msgs:       a.Msgs,
+		SpendLimit: spendLimit,
+		Limit:      limit,
+	}}, nil
+}
+
+// spentAmount returns the amount of coins spent from the granter's account by a msg,
+// and false if the msg is not one of the spendLimitMsgTypeURLs.
+func spentAmount(msg sdk.Msg) (sdk.Coins, bool) {
+	switch msg := msg.(type) {
+	case *bank.MsgSend:
+		return msg.Amount, true
+	case *bank.MsgMultiSend:
+		var amount sdk.Coins
+		for _, input := range msg.Inputs {
+			amount = amount.Add(input.Coins...)
+		}
+		return amount, true
+	case *staking.MsgDelegate:
+		return sdk.NewCoins(msg.Amount), true
+	default:
+		return nil, false
+	}
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a CompositeAuthorization) ValidateBasic() error {
+	if len(a.Msgs) == 0 {
+		return errors.New("msg types cannot be empty")
+	}
+	for i, msgType := range a.Msgs {
+		if msgType == "" {
+			return errors.New("msg type cannot be empty")
+		}
+		if slices.Contains(a.Msgs[i+1:], msgType) {
+			return fmt.Errorf("duplicate msg type %s", msgType)
+		}
+	}
+	if len(a.SpendLimit) > 0 {
+		if !a.SpendLimit.IsValid() || !a.SpendLimit.IsAllPositive() {
+			return sdkerrors.ErrInvalidCoins.Wrap("spend limit must be positive")
+		}
+		for _, msgType := range a.Msgs {
+			if !slices.Contains(spendLimitMsgTypeURLs, msgType) {
+				return fmt.Errorf("spend limit cannot be set with msg type %s, only with %v", msgType, spendLimitMsgTypeURLs)
+			}
+		}
+	}
+	return a.Limit.ValidateBasic()
+}
@@ -0,0 +1,70 @@
+package authz_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/authz"
+	banktypes "cosmossdk.io/x/bank/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCompositeAuthorization(t *testing.T) {
+	sendType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	delegateType := sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+	undelegateType := sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{})
+	coins100 := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	require.Error(t, authz.NewCompositeAuthorization(nil, nil, authz.ExecutionLimit{}).ValidateBasic())
+	require.Error(t, authz.NewCompositeAuthorization([]string{sendType, sendType}, nil, authz.ExecutionLimit{}).ValidateBasic())
+	require.Error(t, authz.NewCompositeAuthorization([]string{sendType}, sdk.Coins{sdk.NewInt64Coin("stake", 0)}, authz.ExecutionLimit{}).ValidateBasic())
+	require.ErrorContains(t, authz.NewCompositeAuthorization([]string{sendType, undelegateType}, coins100, authz.ExecutionLimit{}).ValidateBasic(), "spend limit cannot be set with msg type")
+	require.NoError(t, authz.NewCompositeAuthorization([]string{sendType, undelegateType}, nil, authz.ExecutionLimit{}).ValidateBasic())
+
+	t.Log("verify the msgs which cannot be charged on the spend limit are rejected")
+	unaccounted := authz.CompositeAuthorization{Msgs: []string{undelegateType}, SpendLimit: coins100}
+	_, err := unaccounted.Accept(authzContext(t, time.Now()), &stakingtypes.MsgUndelegate{})
+	require.ErrorContains(t, err, "cannot be charged on the spend limit")
+
+	a := authz.NewCompositeAuthorization([]string{sendType, delegateType}, coins100, authz.ExecutionLimit{Period: time.Hour})
+	require.NoError(t, a.ValidateBasic())
+	require.Equal(t, authz.CompositeAuthorizationTypeURL, a.MsgTypeURL())
+
+	now := time.Now().UTC()
+	_, err = a.Accept(authzContext(t, now), &banktypes.MsgMultiSend{})
+	require.ErrorContains(t, err, "is not allowed by the composite authorization")
+
+	t.Log("verify the bank sends and the delegations share the spend limit")
+	resp, err := a.Accept(authzContext(t, now), &banktypes.MsgSend{Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 60))})
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	updated := resp.Updated.(*authz.CompositeAuthorization)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 40)), updated.SpendLimit)
+
+	later := now.Add(time.Hour)
+	_, err = updated.Accept(authzContext(t, later), &stakingtypes.MsgDelegate{Amount: sdk.NewInt64Coin("stake", 50)})
+	require.ErrorContains(t, err, "requested amount is more than spend limit")
+
+	t.Log("verify the period is shared by all the msg types")
+	_, err = updated.Accept(authzContext(t, now.Add(time.Minute)), &stakingtypes.MsgDelegate{Amount: sdk.NewInt64Coin("stake", 10)})
+	require.ErrorContains(t, err, "next execution is allowed at")
+
+	resp, err = updated.Accept(authzContext(t, later), &stakingtypes.MsgDelegate{Amount: sdk.NewInt64Coin("stake", 10)})
+	require.NoError(t, err)
+	updated = resp.Updated.(*authz.CompositeAuthorization)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), updated.SpendLimit)
+
+	t.Log("verify the msgs without coins do not consume the spend limit")
+	resp, err = updated.Accept(authzContext(t, later.Add(time.Hour)), &banktypes.MsgSend{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), resp.Updated.(*authz.CompositeAuthorization).SpendLimit)
+
+	t.Log("verify the authorization is deleted when the spend limit is used up")
+	resp, err = updated.Accept(authzContext(t, later.Add(time.Hour)), &banktypes.MsgSend{Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 30))})
+	require.NoError(t, err)
+	require.True(t, resp.Delete)
+}
@@ -20,4 +20,8 @@ var (
 	ErrAuthorizationNumOfSigners = errors.Register(ModuleName, 9, "authorization can be given to msg with only one signer")
 	// ErrNegativeMaxTokens error if the max tokens is negative
 	ErrNegativeMaxTokens = errors.Register(ModuleName, 12, "max tokens should be positive")
+	// ErrScheduledExecNotFound error if the scheduled execution does not exist
+	ErrScheduledExecNotFound = errors.Register(ModuleName, 13, "scheduled execution not found")
+	// ErrInvalidSchedule error if the schedule of a scheduled execution is invalid
+	ErrInvalidSchedule = errors.Register(ModuleName, 14, "invalid schedule")
 )
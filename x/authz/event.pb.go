@@ -252,37 +252,106 @@ func (m *EventPruneExpiredGrants) GetPruner() string {
 	return ""
 }
 
+// EventScheduledExec is emitted on each execution of a scheduled execution
+type EventScheduledExec struct {
+	// id of the scheduled execution
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Granter account address
+	Granter string `protobuf:"bytes,2,opt,name=granter,proto3" json:"granter,omitempty"`
+	// error of the execution, empty when the execution succeeded
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventScheduledExec) Reset()         { *m = EventScheduledExec{} }
+func (m *EventScheduledExec) String() string { return proto.CompactTextString(m) }
+func (*EventScheduledExec) ProtoMessage()    {}
+func (*EventScheduledExec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f88cbc71a8baf1f, []int{4}
+}
+func (m *EventScheduledExec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScheduledExec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScheduledExec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventScheduledExec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScheduledExec.Merge(m, src)
+}
+func (m *EventScheduledExec) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScheduledExec) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScheduledExec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventScheduledExec proto.InternalMessageInfo
+
+func (m *EventScheduledExec) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventScheduledExec) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *EventScheduledExec) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventGrant)(nil), "cosmos.authz.v1beta1.EventGrant")
 	proto.RegisterType((*EventRevoke)(nil), "cosmos.authz.v1beta1.EventRevoke")
 	proto.RegisterType((*EventRevokeAll)(nil), "cosmos.authz.v1beta1.EventRevokeAll")
 	proto.RegisterType((*EventPruneExpiredGrants)(nil), "cosmos.authz.v1beta1.EventPruneExpiredGrants")
+	proto.RegisterType((*EventScheduledExec)(nil), "cosmos.authz.v1beta1.EventScheduledExec")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/event.proto", fileDescriptor_1f88cbc71a8baf1f) }
 
 var fileDescriptor_1f88cbc71a8baf1f = []byte{
-	// 317 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xa8, 0xd2, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x81, 0xa8,
-	0xd0, 0x03, 0xab, 0xd0, 0x83, 0xaa, 0x90, 0x92, 0x84, 0x88, 0xc6, 0x83, 0xd5, 0xe8, 0x43, 0x95,
-	0x80, 0x39, 0x4a, 0xab, 0x19, 0xb9, 0xb8, 0x5c, 0x41, 0x06, 0xb8, 0x17, 0x25, 0xe6, 0x95, 0x08,
-	0x29, 0x70, 0xf1, 0xe4, 0x16, 0xa7, 0xc7, 0x97, 0x54, 0x16, 0xa4, 0xc6, 0x97, 0x16, 0xe5, 0x48,
-	0x30, 0x29, 0x30, 0x6a, 0x70, 0x06, 0x71, 0xe5, 0x16, 0xa7, 0x87, 0x54, 0x16, 0xa4, 0x86, 0x16,
-	0xe5, 0x08, 0x19, 0x71, 0xb1, 0xa7, 0x83, 0x94, 0xa6, 0x16, 0x49, 0x30, 0x83, 0x24, 0x9d, 0x24,
-	0x2e, 0x6d, 0xd1, 0x85, 0x59, 0xeb, 0x98, 0x92, 0x52, 0x94, 0x5a, 0x5c, 0x1c, 0x5c, 0x52, 0x94,
-	0x99, 0x97, 0x1e, 0x04, 0x53, 0x88, 0xd0, 0x93, 0x2a, 0xc1, 0x42, 0x9c, 0x9e, 0x54, 0x2b, 0xe1,
-	0x4b, 0x5b, 0x74, 0xf9, 0x21, 0x4a, 0x74, 0x8b, 0x53, 0xb2, 0x15, 0x0c, 0xf4, 0x4c, 0x8c, 0x95,
-	0xd6, 0x30, 0x72, 0x71, 0x83, 0x5d, 0x1b, 0x94, 0x5a, 0x96, 0x9f, 0x9d, 0x3a, 0xd8, 0x9d, 0x5b,
-	0xcd, 0xc5, 0x87, 0xe4, 0x5a, 0xc7, 0x9c, 0x1c, 0xda, 0x38, 0x18, 0x8b, 0xe5, 0xa6, 0x86, 0x4a,
-	0x71, 0x5c, 0xe2, 0x60, 0xcb, 0x03, 0x8a, 0x4a, 0xf3, 0x52, 0x5d, 0x2b, 0x0a, 0x32, 0x8b, 0x52,
-	0x53, 0xc0, 0x91, 0x5c, 0x2c, 0x64, 0xc0, 0xc5, 0x56, 0x00, 0x12, 0x2d, 0x82, 0xd8, 0x8f, 0xc7,
-	0x0a, 0xa8, 0x3a, 0x2b, 0xc1, 0x4b, 0x5b, 0x74, 0x79, 0x2b, 0x20, 0x09, 0x4f, 0xc1, 0x50, 0xcf,
-	0x40, 0xcf, 0xc0, 0x49, 0xef, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92,
-	0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xa0,
-	0x46, 0x15, 0xa7, 0x64, 0xeb, 0x65, 0xe6, 0xeb, 0x43, 0x75, 0x25, 0xb1, 0x81, 0x13, 0x9c, 0x31,
-	0x20, 0x00, 0x00, 0xff, 0xff, 0xd2, 0xfe, 0x86, 0xc9, 0xc5, 0x02, 0x00, 0x00,
+	// 369 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x92, 0xc1, 0x4e, 0xea, 0x40,
+	0x14, 0x86, 0x99, 0x5e, 0x2e, 0x37, 0x77, 0x54, 0x8c, 0x95, 0xc4, 0xea, 0xa2, 0x69, 0xba, 0x62,
+	0xd3, 0x69, 0x2b, 0xba, 0x61, 0x07, 0x09, 0x71, 0x6b, 0x8a, 0x6e, 0x5c, 0x48, 0x80, 0x39, 0x29,
+	0x0d, 0xa5, 0x6d, 0x66, 0x5a, 0x02, 0xba, 0xf2, 0x0d, 0x7c, 0x0f, 0x5d, 0xf2, 0x10, 0x2e, 0x09,
+	0x2b, 0x97, 0x06, 0x5e, 0xc4, 0x74, 0x5a, 0x22, 0x0b, 0x12, 0xd9, 0x98, 0xb8, 0x3c, 0x67, 0xbe,
+	0x7f, 0xfe, 0x7f, 0xe6, 0x1c, 0xac, 0xf5, 0x43, 0x3e, 0x0a, 0xb9, 0xd9, 0x4d, 0xe2, 0xc1, 0x83,
+	0x39, 0xb6, 0x7b, 0x10, 0x77, 0x6d, 0x13, 0xc6, 0x10, 0xc4, 0x24, 0x62, 0x61, 0x1c, 0xca, 0x95,
+	0x8c, 0x20, 0x82, 0x20, 0x39, 0x71, 0x76, 0x9a, 0x75, 0x3b, 0x82, 0x31, 0x73, 0x44, 0x14, 0xfa,
+	0x0b, 0xc2, 0xb8, 0x95, 0x5e, 0x70, 0xc5, 0xba, 0x41, 0x2c, 0x6b, 0x78, 0x7f, 0xc4, 0xdd, 0x4e,
+	0x3c, 0x8d, 0xa0, 0x93, 0x30, 0x5f, 0x91, 0x34, 0x54, 0xfd, 0xef, 0xe0, 0x11, 0x77, 0x6f, 0xa6,
+	0x11, 0xdc, 0x32, 0x5f, 0x3e, 0xc7, 0xff, 0xdc, 0x14, 0x05, 0xa6, 0xfc, 0x49, 0x0f, 0x9b, 0xca,
+	0x62, 0x66, 0xac, 0x6d, 0x1b, 0x94, 0x32, 0xe0, 0xbc, 0x1d, 0x33, 0x2f, 0x70, 0x9d, 0x35, 0xf8,
+	0xa5, 0x01, 0xa5, 0xb8, 0x9b, 0x06, 0xea, 0xc7, 0x8b, 0x99, 0x71, 0x98, 0x21, 0x06, 0xa7, 0x43,
+	0xcd, 0x22, 0x17, 0x35, 0xfd, 0x15, 0xe1, 0x3d, 0x91, 0xd6, 0x81, 0x71, 0x38, 0x84, 0xdf, 0x1e,
+	0xf7, 0x11, 0x97, 0x37, 0xd2, 0x36, 0x7c, 0xff, 0x67, 0x02, 0x6f, 0x31, 0xbf, 0xb4, 0xf5, 0x7b,
+	0x7c, 0x22, 0xcc, 0xaf, 0x59, 0x12, 0x40, 0x6b, 0x12, 0x79, 0x0c, 0xa8, 0x18, 0x32, 0x97, 0x2d,
+	0x5c, 0x8a, 0xd2, 0x2e, 0x53, 0xa4, 0x6f, 0x2c, 0x72, 0xae, 0x7e, 0xb4, 0x98, 0x19, 0x07, 0x93,
+	0x6c, 0xf1, 0x34, 0x9b, 0x58, 0xc4, 0xd2, 0x9f, 0x10, 0x96, 0x85, 0x41, 0xbb, 0x3f, 0x00, 0x9a,
+	0xf8, 0x40, 0x5b, 0x13, 0xe8, 0xcb, 0x65, 0x2c, 0x79, 0x54, 0x41, 0x1a, 0xaa, 0x16, 0x1d, 0xc9,
+	0xa3, 0x9b, 0xef, 0x91, 0x76, 0x1d, 0x40, 0x05, 0xff, 0x05, 0xc6, 0xc2, 0xfc, 0x07, 0x9c, 0xac,
+	0xd8, 0x92, 0xa1, 0x49, 0xde, 0x96, 0x2a, 0x9a, 0x2f, 0x55, 0xf4, 0xb1, 0x54, 0xd1, 0xf3, 0x4a,
+	0x2d, 0xcc, 0x57, 0x6a, 0xe1, 0x7d, 0xa5, 0x16, 0xee, 0x72, 0x07, 0x4e, 0x87, 0xc4, 0x0b, 0xcd,
+	0x5c, 0xd5, 0x2b, 0x89, 0xa5, 0xaf, 0x7d, 0x0e, 0x00, 0x73, 0x14, 0xcb, 0xc3, 0x49, 0x03, 0x00,
+	0x00,
 }
 
 func (m *EventGrant) Marshal() (dAtA []byte, err error) {
@@ -440,6 +509,48 @@ func (m *EventPruneExpiredGrants) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventScheduledExec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScheduledExec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScheduledExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -523,6 +634,26 @@ func (m *EventPruneExpiredGrants) Size() (n int) {
 	return n
 }
 
+func (m *EventScheduledExec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvent(uint64(m.Id))
+	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1017,6 +1148,139 @@ func (m *EventPruneExpiredGrants) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventScheduledExec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScheduledExec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScheduledExec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
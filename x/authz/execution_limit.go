@@ -0,0 +1,43 @@
+package authz
+
+import (
+	"context"
+	"errors"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	corecontext "cosmossdk.io/core/context"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// accept checks that an execution is allowed by the limit and returns the updated limit,
+// along with whether it was the last allowed execution.
+func (l ExecutionLimit) accept(ctx context.Context) (ExecutionLimit, bool, error) {
+	if l.Period > 0 {
+		authzEnv, ok := ctx.Value(corecontext.EnvironmentContextKey).(appmodulev2.Environment)
+		if !ok {
+			return l, false, sdkerrors.ErrUnauthorized.Wrap("environment not set")
+		}
+
+		now := authzEnv.HeaderService.HeaderInfo(ctx).Time
+		if l.LastExecution != nil && now.Before(l.LastExecution.Add(l.Period)) {
+			return l, false, sdkerrors.ErrUnauthorized.Wrapf("next execution is allowed at %s", l.LastExecution.Add(l.Period))
+		}
+		l.LastExecution = &now
+	}
+
+	if l.RemainingExecutions == 0 {
+		return l, false, nil
+	}
+
+	l.RemainingExecutions--
+	return l, l.RemainingExecutions == 0, nil
+}
+
+// ValidateBasic performs a stateless validation of the execution limit.
+func (l ExecutionLimit) ValidateBasic() error {
+	if l.Period < 0 {
+		return errors.New("period cannot be negative")
+	}
+	return nil
+}
@@ -24,6 +24,30 @@ func ValidateGenesis(data GenesisState) error {
 		}
 
 	}
+
+	ids := make(map[uint64]bool, len(data.ScheduledExecs))
+	for i, s := range data.ScheduledExecs {
+		if ids[s.Id] {
+			return fmt.Errorf("scheduled execution: %d, duplicate id %d", i, s.Id)
+		}
+		if s.Id >= data.NextScheduledExecId {
+			return fmt.Errorf("scheduled execution: %d, id %d must be lower than the next id %d", i, s.Id, data.NextScheduledExecId)
+		}
+		ids[s.Id] = true
+
+		if err := s.ValidateBasic(); err != nil {
+			return fmt.Errorf("scheduled execution: %d, %w", i, err)
+		}
+	}
+
+	for i, r := range data.ScheduledExecHistory {
+		if r.ScheduledExecId >= data.NextScheduledExecId {
+			return fmt.Errorf("scheduled execution result: %d, id %d must be lower than the next id %d", i, r.ScheduledExecId, data.NextScheduledExecId)
+		}
+		if r.Execution == 0 {
+			return fmt.Errorf("scheduled execution result: %d, execution must be positive", i)
+		}
+	}
 	return nil
 }
 
@@ -42,6 +66,12 @@ func (data GenesisState) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) err
 			return err
 		}
 	}
+	for _, s := range data.ScheduledExecs {
+		err := s.UnpackInterfaces(unpacker)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
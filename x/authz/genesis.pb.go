@@ -27,6 +27,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the authz module's genesis state.
 type GenesisState struct {
 	Authorization []GrantAuthorization `protobuf:"bytes,1,rep,name=authorization,proto3" json:"authorization"`
+	// scheduled_execs are the pending scheduled executions.
+	ScheduledExecs []ScheduledExec `protobuf:"bytes,2,rep,name=scheduled_execs,json=scheduledExecs,proto3" json:"scheduled_execs"`
+	// scheduled_exec_history are the results of the latest executions of the scheduled executions.
+	ScheduledExecHistory []ScheduledExecResult `protobuf:"bytes,3,rep,name=scheduled_exec_history,json=scheduledExecHistory,proto3" json:"scheduled_exec_history"`
+	// next_scheduled_exec_id is the id of the next scheduled execution.
+	NextScheduledExecId uint64 `protobuf:"varint,4,opt,name=next_scheduled_exec_id,json=nextScheduledExecId,proto3" json:"next_scheduled_exec_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +75,27 @@ func (m *GenesisState) GetAuthorization() []GrantAuthorization {
 	return nil
 }
 
+func (m *GenesisState) GetScheduledExecs() []ScheduledExec {
+	if m != nil {
+		return m.ScheduledExecs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledExecHistory() []ScheduledExecResult {
+	if m != nil {
+		return m.ScheduledExecHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextScheduledExecId() uint64 {
+	if m != nil {
+		return m.NextScheduledExecId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.authz.v1beta1.GenesisState")
 }
@@ -78,21 +105,27 @@ func init() {
 }
 
 var fileDescriptor_4c2fbb971da7c892 = []byte{
-	// 211 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xa8, 0xd2, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x81, 0xa8, 0xd1, 0x03, 0xab, 0xd1, 0x83, 0xaa, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
-	0xd0, 0x07, 0xb1, 0x20, 0x6a, 0xa5, 0x14, 0xb0, 0x9a, 0x07, 0xd1, 0x09, 0x51, 0x21, 0x98, 0x98,
-	0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0x42, 0x4a, 0x99, 0x5c, 0x3c, 0xee, 0x10, 0x1b, 0x83,
-	0x4b, 0x12, 0x4b, 0x52, 0x85, 0x22, 0xb9, 0x78, 0x41, 0x3a, 0xf2, 0x8b, 0x32, 0xab, 0x12, 0x4b,
-	0x32, 0xf3, 0xf3, 0x24, 0x18, 0x15, 0x98, 0x35, 0xb8, 0x8d, 0x34, 0xf4, 0xb0, 0x39, 0x44, 0xcf,
-	0xbd, 0x28, 0x31, 0xaf, 0xc4, 0x11, 0x59, 0xbd, 0x13, 0xe7, 0x89, 0x7b, 0xf2, 0x0c, 0x2b, 0x9e,
-	0x6f, 0xd0, 0x62, 0x0c, 0x42, 0x35, 0xc9, 0x49, 0xef, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4,
-	0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f,
-	0xe5, 0x18, 0xa2, 0xa0, 0xbe, 0x2c, 0x4e, 0xc9, 0xd6, 0xcb, 0xcc, 0xd7, 0xaf, 0x80, 0xb8, 0x39,
-	0x89, 0x0d, 0xec, 0x42, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xad, 0xcf, 0xc0, 0x1c, 0x28,
-	0x01, 0x00, 0x00,
+	// 317 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xcf, 0x4a, 0xc3, 0x30,
+	0x1c, 0xc7, 0x9b, 0x6d, 0x08, 0xc6, 0x7f, 0x58, 0xc7, 0x28, 0x3b, 0xc4, 0x31, 0x2f, 0xd3, 0x43,
+	0xc2, 0xdc, 0x13, 0x38, 0x90, 0xe9, 0xb5, 0x3b, 0xe9, 0x65, 0x64, 0xed, 0x8f, 0x2d, 0xb8, 0x35,
+	0xd2, 0x64, 0x52, 0xf7, 0x14, 0x3e, 0x86, 0x47, 0x1f, 0x63, 0xc7, 0x1d, 0xf5, 0x22, 0xb2, 0x1e,
+	0x7c, 0x0d, 0x69, 0x52, 0x61, 0x95, 0x1e, 0xbc, 0x84, 0xf0, 0xcb, 0xe7, 0xf7, 0xf9, 0x06, 0xbe,
+	0xb8, 0x1d, 0x48, 0x35, 0x97, 0x8a, 0xf1, 0x85, 0x9e, 0x2e, 0xd9, 0x53, 0x77, 0x0c, 0x9a, 0x77,
+	0xd9, 0x04, 0x22, 0x50, 0x42, 0xd1, 0xc7, 0x58, 0x6a, 0xe9, 0xd6, 0x2d, 0x43, 0x0d, 0x43, 0x73,
+	0xa6, 0x59, 0x9f, 0xc8, 0x89, 0x34, 0x00, 0xcb, 0x6e, 0x96, 0x6d, 0xb6, 0x4a, 0x7d, 0x76, 0xd3,
+	0x12, 0xc7, 0x7c, 0x2e, 0x22, 0xc9, 0xcc, 0x69, 0x47, 0xed, 0x8f, 0x0a, 0xde, 0x1f, 0xd8, 0xc8,
+	0xa1, 0xe6, 0x1a, 0xdc, 0x3b, 0x7c, 0x90, 0xad, 0xc8, 0x58, 0x2c, 0xb9, 0x16, 0x32, 0xf2, 0x50,
+	0xab, 0xda, 0xd9, 0xbb, 0xec, 0xd0, 0xb2, 0x9f, 0xd0, 0x41, 0xcc, 0x23, 0x7d, 0xb5, 0xcd, 0xf7,
+	0x77, 0x57, 0x9f, 0xa7, 0xce, 0xeb, 0xf7, 0xdb, 0x05, 0xf2, 0x8b, 0x26, 0xd7, 0xc7, 0x47, 0x2a,
+	0x98, 0x42, 0xb8, 0x98, 0x41, 0x38, 0x82, 0x04, 0x02, 0xe5, 0x55, 0x8c, 0xfc, 0xac, 0x5c, 0x3e,
+	0xfc, 0x85, 0xaf, 0x13, 0x08, 0xfa, 0xb5, 0xcc, 0xeb, 0x1f, 0xaa, 0xed, 0xa1, 0x72, 0x01, 0x37,
+	0x8a, 0xce, 0xd1, 0x54, 0x28, 0x2d, 0xe3, 0x67, 0xaf, 0x6a, 0xd4, 0xe7, 0xff, 0x50, 0xfb, 0xa0,
+	0x16, 0x33, 0x9d, 0x07, 0xd4, 0x0b, 0x01, 0x37, 0x56, 0xe6, 0xf6, 0x70, 0x23, 0x82, 0x44, 0x8f,
+	0xfe, 0x64, 0x89, 0xd0, 0xab, 0xb5, 0x50, 0xa7, 0xe6, 0x9f, 0x64, 0xaf, 0x05, 0xe9, 0x6d, 0xd8,
+	0xa7, 0xab, 0x0d, 0x41, 0xeb, 0x0d, 0x41, 0x5f, 0x1b, 0x82, 0x5e, 0x52, 0xe2, 0xac, 0x53, 0xe2,
+	0xbc, 0xa7, 0xc4, 0xb9, 0xcf, 0x6b, 0x55, 0xe1, 0x03, 0x15, 0x92, 0x25, 0xb6, 0xa4, 0xf1, 0x8e,
+	0xa9, 0xa4, 0xf7, 0x33, 0x00, 0x54, 0x35, 0x48, 0xf2, 0x19, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -115,6 +148,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextScheduledExecId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextScheduledExecId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ScheduledExecHistory) > 0 {
+		for iNdEx := len(m.ScheduledExecHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledExecHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ScheduledExecs) > 0 {
+		for iNdEx := len(m.ScheduledExecs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledExecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Authorization) > 0 {
 		for iNdEx := len(m.Authorization) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -155,6 +221,21 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ScheduledExecs) > 0 {
+		for _, e := range m.ScheduledExecs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduledExecHistory) > 0 {
+		for _, e := range m.ScheduledExecHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextScheduledExecId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextScheduledExecId))
+	}
 	return n
 }
 
@@ -227,6 +308,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledExecs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledExecs = append(m.ScheduledExecs, ScheduledExec{})
+			if err := m.ScheduledExecs[len(m.ScheduledExecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledExecHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledExecHistory = append(m.ScheduledExecHistory, ScheduledExecResult{})
+			if err := m.ScheduledExecHistory[len(m.ScheduledExecHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduledExecId", wireType)
+			}
+			m.NextScheduledExecId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextScheduledExecId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/authz"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -37,7 +38,20 @@ func (k Keeper) InitGenesis(ctx context.Context, data *authz.GenesisState) error
 			return err
 		}
 	}
-	return nil
+
+	for _, scheduledExec := range data.ScheduledExecs {
+		if err := k.setScheduledExec(ctx, scheduledExec); err != nil {
+			return err
+		}
+	}
+
+	for _, result := range data.ScheduledExecHistory {
+		if err := k.ScheduledExecResults.Set(ctx, collections.Join(result.ScheduledExecId, result.Execution), result); err != nil {
+			return err
+		}
+	}
+
+	return k.ScheduledExecSeq.Set(ctx, data.NextScheduledExecId)
 }
 
 // ExportGenesis returns a GenesisState for a given context.
@@ -63,5 +77,28 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*authz.GenesisState, error)
 	if err != nil {
 		return nil, err
 	}
-	return authz.NewGenesisState(entries), nil
+
+	genState := authz.NewGenesisState(entries)
+	err = k.ScheduledExecs.Walk(ctx, nil, func(_ uint64, scheduledExec authz.ScheduledExec) (bool, error) {
+		genState.ScheduledExecs = append(genState.ScheduledExecs, scheduledExec)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.ScheduledExecResults.Walk(ctx, nil, func(_ collections.Pair[uint64, uint64], result authz.ScheduledExecResult) (bool, error) {
+		genState.ScheduledExecHistory = append(genState.ScheduledExecHistory, result)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genState.NextScheduledExecId, err = k.ScheduledExecSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return genState, nil
 }
@@ -71,7 +71,7 @@ func (suite *GenesisTestSuite) SetupTest() {
 	msr.SetInterfaceRegistry(suite.encCfg.InterfaceRegistry)
 	env := runtime.NewEnvironment(storeService, coretesting.NewNopLogger(), runtime.EnvWithMsgRouterService(msr))
 
-	suite.keeper = keeper.NewKeeper(env, suite.encCfg.Codec, suite.accountKeeper)
+	suite.keeper = keeper.NewKeeper(env, suite.encCfg.Codec, suite.accountKeeper, authztestutil.NewMockBankKeeper(ctrl), nil)
 }
 
 func (suite *GenesisTestSuite) TestImportExportGenesis() {
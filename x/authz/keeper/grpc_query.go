@@ -7,12 +7,14 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
 	"cosmossdk.io/x/authz"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
@@ -192,3 +194,73 @@ func (k Keeper) GranteeGrants(ctx context.Context, req *authz.QueryGranteeGrants
 		Pagination: pageRes,
 	}, nil
 }
+
+// ScheduledExec implements the Query/ScheduledExec gRPC method.
+func (k Keeper) ScheduledExec(ctx context.Context, req *authz.QueryScheduledExecRequest) (*authz.QueryScheduledExecResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	scheduledExec, err := k.ScheduledExecs.Get(ctx, req.Id)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "scheduled execution %d not found", req.Id)
+		}
+		return nil, err
+	}
+
+	return &authz.QueryScheduledExecResponse{ScheduledExec: &scheduledExec}, nil
+}
+
+// GranterScheduledExecs implements the Query/GranterScheduledExecs gRPC method.
+func (k Keeper) GranterScheduledExecs(ctx context.Context, req *authz.QueryGranterScheduledExecsRequest) (*authz.QueryGranterScheduledExecsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	granter, err := k.authKeeper.AddressCodec().StringToBytes(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduledExecs, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.ScheduledExecsByGranter,
+		req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (*authz.ScheduledExec, error) {
+			scheduledExec, err := k.ScheduledExecs.Get(ctx, key.K2())
+			if err != nil {
+				return nil, err
+			}
+			return &scheduledExec, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](granter),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.QueryGranterScheduledExecsResponse{ScheduledExecs: scheduledExecs, Pagination: pageRes}, nil
+}
+
+// ScheduledExecHistory implements the Query/ScheduledExecHistory gRPC method.
+func (k Keeper) ScheduledExecHistory(ctx context.Context, req *authz.QueryScheduledExecHistoryRequest) (*authz.QueryScheduledExecHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	results, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.ScheduledExecResults,
+		req.Pagination,
+		func(_ collections.Pair[uint64, uint64], result authz.ScheduledExecResult) (*authz.ScheduledExecResult, error) {
+			return &result, nil
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, uint64](req.Id),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.QueryScheduledExecHistoryResponse{Results: results, Pagination: pageRes}, nil
+}
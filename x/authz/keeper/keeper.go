@@ -9,6 +9,7 @@ import (
 	gogoproto "github.com/cosmos/gogoproto/proto"
 	gogoprotoany "github.com/cosmos/gogoproto/types/any"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	corecontext "cosmossdk.io/core/context"
 	errorsmod "cosmossdk.io/errors"
@@ -32,15 +33,56 @@ type Keeper struct {
 
 	cdc        codec.Codec
 	authKeeper authz.AccountKeeper
+	bankKeeper authz.BankKeeper
+
+	// scheduledExecMinGasPrices are the minimum gas prices the fee of a scheduled execution
+	// must cover for its gas limit
+	scheduledExecMinGasPrices sdk.DecCoins
+
+	Schema collections.Schema
+	// ScheduledExecs key: id | value: ScheduledExec
+	ScheduledExecs collections.Map[uint64, authz.ScheduledExec]
+	// ScheduledExecSeq is the sequence of the scheduled execution ids
+	ScheduledExecSeq collections.Sequence
+	// ScheduledExecQueue key: height+id | value: none, the height scheduled executions
+	// ordered by next execution height
+	ScheduledExecQueue collections.KeySet[collections.Pair[int64, uint64]]
+	// EpochScheduledExecs key: epochIdentifier+epoch+id | value: none, the epoch scheduled
+	// executions by epoch identifier ordered by next execution epoch
+	EpochScheduledExecs collections.KeySet[collections.Triple[string, int64, uint64]]
+	// ScheduledExecsByGranter key: granter+id | value: none
+	ScheduledExecsByGranter collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	// ScheduledExecResults key: id+execution | value: ScheduledExecResult
+	ScheduledExecResults collections.Map[collections.Pair[uint64, uint64], authz.ScheduledExecResult]
 }
 
-// NewKeeper constructs a message authorization Keeper
-func NewKeeper(env appmodule.Environment, cdc codec.Codec, ak authz.AccountKeeper) Keeper {
-	return Keeper{
+// NewKeeper constructs a message authorization Keeper. The fees of the scheduled executions
+// must cover their gas limit at scheduledExecMinGasPrices, which may be empty.
+func NewKeeper(env appmodule.Environment, cdc codec.Codec, ak authz.AccountKeeper, bk authz.BankKeeper, scheduledExecMinGasPrices sdk.DecCoins) Keeper {
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+	k := Keeper{
 		Environment: env,
 		cdc:         cdc,
 		authKeeper:  ak,
+		bankKeeper:  bk,
+
+		scheduledExecMinGasPrices: scheduledExecMinGasPrices,
+
+		ScheduledExecs:          collections.NewMap(sb, ScheduledExecsPrefix, "scheduled_execs", collections.Uint64Key, codec.CollValue[authz.ScheduledExec](cdc)),
+		ScheduledExecSeq:        collections.NewSequence(sb, ScheduledExecSeqKey, "scheduled_exec_seq"),
+		ScheduledExecQueue:      collections.NewKeySet(sb, ScheduledExecQueuePrefix, "scheduled_exec_queue", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		EpochScheduledExecs:     collections.NewKeySet(sb, EpochScheduledExecsPrefix, "epoch_scheduled_execs", collections.TripleKeyCodec(collections.StringKey, collections.Int64Key, collections.Uint64Key)),
+		ScheduledExecsByGranter: collections.NewKeySet(sb, ScheduledExecsByGranterPrefix, "scheduled_execs_by_granter", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+		ScheduledExecResults:    collections.NewMap(sb, ScheduledExecResultsPrefix, "scheduled_exec_results", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[authz.ScheduledExecResult](cdc)),
 	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
 }
 
 // getGrant returns grant stored at skey.
@@ -105,6 +147,10 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 			skey := grantStoreKey(grantee, granter, sdk.MsgTypeURL(msg))
 
 			grant, found := k.getGrant(ctx, skey)
+			if !found {
+				// fallback on the composite authorization, stored under its own type URL
+				grant, found = k.getGrant(ctx, grantStoreKey(grantee, granter, authz.CompositeAuthorizationTypeURL))
+			}
 			if !found {
 				return nil, errorsmod.Wrapf(authz.ErrNoAuthorizationFound,
 					"failed to get grant with given granter: %s, grantee: %s & msgType: %s ", sdk.AccAddress(granter), grantee, sdk.MsgTypeURL(msg))
@@ -128,7 +174,7 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 			}
 
 			if resp.Delete {
-				err = k.DeleteGrant(ctx, grantee, granter, sdk.MsgTypeURL(msg))
+				err = k.DeleteGrant(ctx, grantee, granter, authorization.MsgTypeURL())
 			} else if resp.Updated != nil {
 				updated, ok := resp.Updated.(authz.Authorization)
 				if !ok {
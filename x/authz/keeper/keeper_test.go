@@ -79,7 +79,7 @@ func (s *TestSuite) SetupTest() {
 	banktypes.RegisterMsgServer(s.baseApp.MsgServiceRouter(), s.bankKeeper)
 
 	env := runtime.NewEnvironment(storeService, coretesting.NewNopLogger(), runtime.EnvWithQueryRouterService(s.baseApp.GRPCQueryRouter()), runtime.EnvWithMsgRouterService(s.baseApp.MsgServiceRouter()))
-	s.authzKeeper = authzkeeper.NewKeeper(env, s.encCfg.Codec, s.accountKeeper)
+	s.authzKeeper = authzkeeper.NewKeeper(env, s.encCfg.Codec, s.accountKeeper, s.bankKeeper, nil)
 
 	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, s.encCfg.InterfaceRegistry)
 	authz.RegisterQueryServer(queryHelper, s.authzKeeper)
@@ -395,6 +395,49 @@ func (s *TestSuite) TestDispatchAction() {
 	}
 }
 
+func (s *TestSuite) TestDispatchCompositeAction() {
+	require := s.Require()
+	granterAddr := s.addrs[0]
+	granteeAddr := s.addrs[1]
+	granterStrAddr, err := s.accountKeeper.AddressCodec().BytesToString(s.addrs[0])
+	require.NoError(err)
+	recipientStrAddr, err := s.accountKeeper.AddressCodec().BytesToString(s.addrs[2])
+	require.NoError(err)
+
+	a := authz.NewCompositeAuthorization([]string{bankSendAuthMsgType}, coins100, authz.ExecutionLimit{RemainingExecutions: 2})
+	require.NoError(s.authzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, a, nil))
+
+	// the composite authorization is found for each of its msg types
+	send := &banktypes.MsgSend{Amount: coins10, FromAddress: granterStrAddr, ToAddress: recipientStrAddr}
+	_, err = s.authzKeeper.DispatchActions(s.ctx, granteeAddr, []sdk.Msg{send})
+	require.NoError(err)
+
+	authorization, _ := s.authzKeeper.GetAuthorization(s.ctx, granteeAddr, granterAddr, authz.CompositeAuthorizationTypeURL)
+	require.NotNil(authorization)
+	updated := authorization.(*authz.CompositeAuthorization)
+	require.Equal(coins100.Sub(coins10...), updated.SpendLimit)
+	require.Equal(uint64(1), updated.Limit.RemainingExecutions)
+
+	_, err = s.authzKeeper.DispatchActions(s.ctx, granteeAddr, []sdk.Msg{
+		&banktypes.MsgSend{Amount: coins1000, FromAddress: granterStrAddr, ToAddress: recipientStrAddr},
+	})
+	require.ErrorContains(err, "requested amount is more than spend limit")
+
+	_, err = s.authzKeeper.DispatchActions(s.ctx, granteeAddr, []sdk.Msg{
+		&banktypes.MsgMultiSend{
+			Inputs:  []banktypes.Input{{Address: granterStrAddr, Coins: coins10}},
+			Outputs: []banktypes.Output{{Address: recipientStrAddr, Coins: coins10}},
+		},
+	})
+	require.ErrorContains(err, "is not allowed by the composite authorization")
+
+	// the grant is deleted after the last allowed execution
+	_, err = s.authzKeeper.DispatchActions(s.ctx, granteeAddr, []sdk.Msg{send})
+	require.NoError(err)
+	authorization, _ = s.authzKeeper.GetAuthorization(s.ctx, granteeAddr, granterAddr, authz.CompositeAuthorizationTypeURL)
+	require.Nil(authorization)
+}
+
 // Tests that all msg events included in an authz MsgExec tx
 // Ref: https://github.com/cosmos/cosmos-sdk/issues/9501
 func (s *TestSuite) TestDispatchedEvents() {
@@ -3,6 +3,7 @@ package keeper
 import (
 	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/authz"
 	"cosmossdk.io/x/authz/internal/conv"
 
@@ -16,9 +17,28 @@ import (
 //
 // - 0x01<grant_Bytes>: Grant
 // - 0x02<grant_expiration_Bytes>: GrantQueueItem
+//
+// - 0x03<id>: ScheduledExec
+//
+// - 0x04: ScheduledExecSeq
+//
+// - 0x05<height><id>: none, height scheduled executions queue
+//
+// - 0x06<epoch_identifier><id>: none, epoch scheduled executions
+//
+// - 0x07<granter><id>: none, scheduled executions by granter
+//
+// - 0x08<id><execution>: ScheduledExecResult
 var (
 	GrantKey         = []byte{0x01} // prefix for each key
 	GrantQueuePrefix = []byte{0x02}
+
+	ScheduledExecsPrefix          = collections.NewPrefix(3)
+	ScheduledExecSeqKey           = collections.NewPrefix(4)
+	ScheduledExecQueuePrefix      = collections.NewPrefix(5)
+	EpochScheduledExecsPrefix     = collections.NewPrefix(6)
+	ScheduledExecsByGranterPrefix = collections.NewPrefix(7)
+	ScheduledExecResultsPrefix    = collections.NewPrefix(8)
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -39,15 +39,21 @@ func (k Keeper) Grant(ctx context.Context, msg *authz.MsgGrant) (*authz.MsgGrant
 		return nil, err
 	}
 
-	t := authorization.MsgTypeURL()
-	if err := k.MsgRouterService.CanInvoke(ctx, t); err != nil {
-		return nil, sdkerrors.ErrInvalidType.Wrapf("%s doesn't exist", t)
+	msgTypes := []string{authorization.MsgTypeURL()}
+	if composite, ok := authorization.(*authz.CompositeAuthorization); ok {
+		msgTypes = composite.Msgs
 	}
 
-	// Disable granting other accounts with grant permission.
-	// Preventing user from accidentally authorizing their entire account to a different account.
-	if t == sdk.MsgTypeURL(&authz.MsgGrant{}) {
-		return nil, sdkerrors.ErrInvalidType.Wrap("authz msgGrant is not allowed")
+	for _, t := range msgTypes {
+		if err := k.MsgRouterService.CanInvoke(ctx, t); err != nil {
+			return nil, sdkerrors.ErrInvalidType.Wrapf("%s doesn't exist", t)
+		}
+
+		// Disable granting other accounts with grant permission.
+		// Preventing user from accidentally authorizing their entire account to a different account.
+		if t == sdk.MsgTypeURL(&authz.MsgGrant{}) {
+			return nil, sdkerrors.ErrInvalidType.Wrap("authz msgGrant is not allowed")
+		}
 	}
 
 	err = k.SaveGrant(ctx, grantee, granter, authorization, msg.Grant.Expiration)
@@ -144,6 +150,48 @@ func (k Keeper) PruneExpiredGrants(ctx context.Context, msg *authz.MsgPruneExpir
 	return &authz.MsgPruneExpiredGrantsResponse{}, nil
 }
 
+// ScheduleExec implements the MsgServer.ScheduleExec method.
+func (k Keeper) ScheduleExec(ctx context.Context, msg *authz.MsgScheduleExec) (*authz.MsgScheduleExecResponse, error) {
+	granter, err := k.authKeeper.AddressCodec().StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+
+	if len(msg.Msgs) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("messages cannot be empty")
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateMsgs(msgs); err != nil {
+		return nil, err
+	}
+
+	id, err := k.CreateScheduledExec(ctx, granter, msgs, msg.Schedule, msg.GasLimit, msg.Fee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.MsgScheduleExecResponse{Id: id}, nil
+}
+
+// CancelScheduledExec implements the MsgServer.CancelScheduledExec method.
+func (k Keeper) CancelScheduledExec(ctx context.Context, msg *authz.MsgCancelScheduledExec) (*authz.MsgCancelScheduledExecResponse, error) {
+	granter, err := k.authKeeper.AddressCodec().StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+
+	if err := k.DeleteScheduledExec(ctx, granter, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &authz.MsgCancelScheduledExecResponse{}, nil
+}
+
 func validateMsgs(msgs []sdk.Msg) error {
 	for i, msg := range msgs {
 		m, ok := msg.(sdk.HasValidateBasic)
@@ -0,0 +1,349 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/authz"
+	epochstypes "cosmossdk.io/x/epochs/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// EpochHooks wrapper struct for the x/epochs hooks of the authz keeper
+type EpochHooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+// EpochHooks returns the x/epochs hooks of the authz keeper, executing the epoch
+// scheduled executions at the start of their epochs.
+func (k Keeper) EpochHooks() EpochHooks {
+	return EpochHooks{k}
+}
+
+// BeforeEpochStart executes the scheduled executions of the epoch identifier which
+// are due at the starting epoch, up to MaxEpochScheduledExecsGas. The executions
+// delayed by the limit are executed first at the next epoch start.
+func (h EpochHooks) BeforeEpochStart(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	// the longest overdue executions go first, in order of creation
+	rng := new(collections.Range[collections.Triple[string, int64, uint64]]).
+		StartInclusive(collections.TriplePrefix[string, int64, uint64](epochIdentifier)).
+		EndInclusive(collections.Join3(epochIdentifier, epochNumber, uint64(math.MaxUint64)))
+
+	due, err := dueScheduledExecs(ctx, h.k, h.k.EpochScheduledExecs, rng, collections.Triple[string, int64, uint64].K3, authz.MaxEpochScheduledExecsGas)
+	if err != nil {
+		return err
+	}
+
+	for _, scheduledExec := range due {
+		if err := h.k.unscheduleExec(ctx, scheduledExec); err != nil {
+			return err
+		}
+
+		if err := h.k.executeScheduledExec(ctx, scheduledExec, epochNumber); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AfterEpochEnd is a noop
+func (h EpochHooks) AfterEpochEnd(_ context.Context, _ string, _ int64) error {
+	return nil
+}
+
+// ModuleAddress returns the address of the x/authz module account, the grantee of the
+// authorizations used by the scheduled executions.
+func (k Keeper) ModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(authz.ModuleName)
+}
+
+// CreateScheduledExec stores the scheduled execution of msgs, which must all be signed
+// by the granter, and returns its id.
+func (k Keeper) CreateScheduledExec(ctx context.Context, granter sdk.AccAddress, msgs []sdk.Msg, schedule authz.ExecSchedule, gasLimit uint64, fee sdk.Coins) (uint64, error) {
+	scheduleExecTypeURL := sdk.MsgTypeURL(&authz.MsgScheduleExec{})
+	for i, msg := range msgs {
+		signers, _, err := k.cdc.GetMsgSigners(msg)
+		if err != nil {
+			return 0, err
+		}
+
+		if len(signers) != 1 {
+			return 0, authz.ErrAuthorizationNumOfSigners
+		}
+
+		if !granter.Equals(sdk.AccAddress(signers[0])) {
+			return 0, sdkerrors.ErrUnauthorized.Wrapf("msg %d must be signed by the granter", i)
+		}
+
+		if sdk.MsgTypeURL(msg) == scheduleExecTypeURL {
+			return 0, sdkerrors.ErrInvalidType.Wrap("scheduled executions cannot schedule executions")
+		}
+	}
+
+	if !schedule.IsEpochSchedule() && schedule.Next <= k.HeaderService.HeaderInfo(ctx).Height {
+		return 0, authz.ErrInvalidSchedule.Wrap("next execution height must be in the future")
+	}
+
+	if err := k.checkScheduledExecFee(fee, gasLimit); err != nil {
+		return 0, err
+	}
+
+	granterAddr, err := k.authKeeper.AddressCodec().BytesToString(granter)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := k.ScheduledExecSeq.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	scheduledExec := authz.NewScheduledExec(id, granterAddr, msgs, schedule, gasLimit, fee)
+	if err := scheduledExec.ValidateBasic(); err != nil {
+		return 0, err
+	}
+
+	return id, k.setScheduledExec(ctx, scheduledExec)
+}
+
+// DeleteScheduledExec removes a scheduled execution of the granter.
+func (k Keeper) DeleteScheduledExec(ctx context.Context, granter sdk.AccAddress, id uint64) error {
+	scheduledExec, err := k.ScheduledExecs.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrapf(authz.ErrScheduledExecNotFound, "id %d", id)
+		}
+		return err
+	}
+
+	granterAddr, err := k.authKeeper.AddressCodec().BytesToString(granter)
+	if err != nil {
+		return err
+	}
+
+	if scheduledExec.Granter != granterAddr {
+		return sdkerrors.ErrUnauthorized.Wrapf("scheduled execution %d is not owned by %s", id, granterAddr)
+	}
+
+	return k.removeScheduledExec(ctx, scheduledExec)
+}
+
+// ExecuteDueScheduledExecs executes the height scheduled executions due at the current
+// block height, up to maxGas, counting the BlockGas of each execution.
+func (k Keeper) ExecuteDueScheduledExecs(ctx context.Context, maxGas uint64) error {
+	height := k.HeaderService.HeaderInfo(ctx).Height
+	rng := new(collections.Range[collections.Pair[int64, uint64]]).
+		EndInclusive(collections.Join(height, uint64(math.MaxUint64)))
+
+	due, err := dueScheduledExecs(ctx, k, k.ScheduledExecQueue, rng, collections.Pair[int64, uint64].K2, maxGas)
+	if err != nil {
+		return err
+	}
+
+	for _, scheduledExec := range due {
+		if err := k.unscheduleExec(ctx, scheduledExec); err != nil {
+			return err
+		}
+
+		// executions delayed by the limit keep their initial schedule
+		if err := k.executeScheduledExec(ctx, scheduledExec, scheduledExec.Schedule.Next); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dueScheduledExecs returns the scheduled executions of the schedule index in rng, in
+// order, until the total BlockGas of the executions would exceed maxGas. Only the
+// returned executions are read.
+func dueScheduledExecs[K any](
+	ctx context.Context,
+	k Keeper,
+	index collections.KeySet[K],
+	rng collections.Ranger[K],
+	id func(K) uint64,
+	maxGas uint64,
+) ([]authz.ScheduledExec, error) {
+	var (
+		due []authz.ScheduledExec
+		gas uint64
+	)
+	err := index.Walk(ctx, rng, func(key K) (bool, error) {
+		scheduledExec, err := k.ScheduledExecs.Get(ctx, id(key))
+		if err != nil {
+			return true, err
+		}
+
+		gas += scheduledExec.BlockGas()
+		if gas > maxGas {
+			return true, nil
+		}
+
+		due = append(due, scheduledExec)
+		return false, nil
+	})
+
+	return due, err
+}
+
+// executeScheduledExec executes the msgs of a scheduled execution due at current, which
+// is a block height or an epoch number, records the result and schedules the next execution.
+// A failure of the msgs doesn't end the schedule, while a failure to fund the execution, or a
+// fee no longer covering the gas limit, does.
+func (k Keeper) executeScheduledExec(ctx context.Context, scheduledExec authz.ScheduledExec, current int64) error {
+	granter, err := k.authKeeper.AddressCodec().StringToBytes(scheduledExec.Granter)
+	if err != nil {
+		return err
+	}
+
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+	scheduledExec.Executions++
+	result := authz.ScheduledExecResult{
+		ScheduledExecId: scheduledExec.Id,
+		Execution:       scheduledExec.Executions,
+		Height:          headerInfo.Height,
+		Time:            headerInfo.Time,
+	}
+
+	ended := false
+	if err := k.fundScheduledExec(ctx, granter, scheduledExec.Fee, scheduledExec.GasLimit); err != nil {
+		result.Error = err.Error()
+		ended = true
+	} else {
+		gasUsed, err := k.BranchService.ExecuteWithGasLimit(ctx, scheduledExec.GasLimit, func(ctx context.Context) error {
+			msgs, err := scheduledExec.GetMessages()
+			if err != nil {
+				return err
+			}
+
+			_, err = k.DispatchActions(ctx, k.ModuleAddress(), msgs)
+			return err
+		})
+		result.GasUsed = gasUsed
+		if err != nil {
+			result.Error = err.Error()
+		}
+	}
+
+	if err := k.ScheduledExecResults.Set(ctx, collections.Join(scheduledExec.Id, scheduledExec.Executions), result); err != nil {
+		return err
+	}
+
+	if scheduledExec.Executions > authz.MaxScheduledExecHistory {
+		if err := k.ScheduledExecResults.Remove(ctx, collections.Join(scheduledExec.Id, scheduledExec.Executions-authz.MaxScheduledExecHistory)); err != nil {
+			return err
+		}
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&authz.EventScheduledExec{
+		Id:      scheduledExec.Id,
+		Granter: scheduledExec.Granter,
+		Error:   result.Error,
+	}); err != nil {
+		return err
+	}
+
+	next, done := scheduledExec.Schedule.Advance(current, scheduledExec.Executions)
+	if ended || done {
+		return k.removeScheduledExec(ctx, scheduledExec)
+	}
+
+	scheduledExec.Schedule = next
+	return k.setScheduledExec(ctx, scheduledExec)
+}
+
+// fundScheduledExec pays the fee of an execution from the granter to the fee collector,
+// after checking it still covers the gas limit of the execution.
+func (k Keeper) fundScheduledExec(ctx context.Context, granter sdk.AccAddress, fee sdk.Coins, gasLimit uint64) error {
+	if err := k.checkScheduledExecFee(fee, gasLimit); err != nil {
+		return err
+	}
+
+	if fee.IsZero() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, granter, authtypes.FeeCollectorName, fee)
+}
+
+// checkScheduledExecFee checks the fee of a scheduled execution covers its gas limit at
+// the minimum gas prices of the scheduled executions, in any of their denoms.
+func (k Keeper) checkScheduledExecFee(fee sdk.Coins, gasLimit uint64) error {
+	if k.scheduledExecMinGasPrices.IsZero() {
+		return nil
+	}
+
+	glDec := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(gasLimit))
+	requiredFees := make(sdk.Coins, len(k.scheduledExecMinGasPrices))
+	for i, gp := range k.scheduledExecMinGasPrices {
+		requiredFees[i] = sdk.NewCoin(gp.Denom, gp.Amount.Mul(glDec).Ceil().RoundInt())
+	}
+
+	if !fee.IsAnyGTE(requiredFees) {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient scheduled execution fee; got: %s required: %s", fee, requiredFees)
+	}
+
+	return nil
+}
+
+// setScheduledExec stores a scheduled execution and indexes it by granter and by
+// schedule.
+func (k Keeper) setScheduledExec(ctx context.Context, scheduledExec authz.ScheduledExec) error {
+	granter, err := k.authKeeper.AddressCodec().StringToBytes(scheduledExec.Granter)
+	if err != nil {
+		return err
+	}
+
+	if err := k.ScheduledExecs.Set(ctx, scheduledExec.Id, scheduledExec); err != nil {
+		return err
+	}
+
+	if err := k.ScheduledExecsByGranter.Set(ctx, collections.Join(sdk.AccAddress(granter), scheduledExec.Id)); err != nil {
+		return err
+	}
+
+	if scheduledExec.Schedule.IsEpochSchedule() {
+		return k.EpochScheduledExecs.Set(ctx, collections.Join3(scheduledExec.Schedule.EpochIdentifier, scheduledExec.Schedule.Next, scheduledExec.Id))
+	}
+
+	return k.ScheduledExecQueue.Set(ctx, collections.Join(scheduledExec.Schedule.Next, scheduledExec.Id))
+}
+
+// removeScheduledExec removes a scheduled execution and its indexes, its history is kept.
+func (k Keeper) removeScheduledExec(ctx context.Context, scheduledExec authz.ScheduledExec) error {
+	granter, err := k.authKeeper.AddressCodec().StringToBytes(scheduledExec.Granter)
+	if err != nil {
+		return err
+	}
+
+	if err := k.ScheduledExecs.Remove(ctx, scheduledExec.Id); err != nil {
+		return err
+	}
+
+	if err := k.ScheduledExecsByGranter.Remove(ctx, collections.Join(sdk.AccAddress(granter), scheduledExec.Id)); err != nil {
+		return err
+	}
+
+	return k.unscheduleExec(ctx, scheduledExec)
+}
+
+// unscheduleExec removes a scheduled execution from its schedule index, it is indexed
+// again with its next schedule when stored.
+func (k Keeper) unscheduleExec(ctx context.Context, scheduledExec authz.ScheduledExec) error {
+	if scheduledExec.Schedule.IsEpochSchedule() {
+		return k.EpochScheduledExecs.Remove(ctx, collections.Join3(scheduledExec.Schedule.EpochIdentifier, scheduledExec.Schedule.Next, scheduledExec.Id))
+	}
+
+	return k.ScheduledExecQueue.Remove(ctx, collections.Join(scheduledExec.Schedule.Next, scheduledExec.Id))
+}
@@ -0,0 +1,278 @@
+package keeper_test
+
+import (
+	"errors"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/core/header"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/authz"
+	authzkeeper "cosmossdk.io/x/authz/keeper"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (s *TestSuite) TestScheduledExec() {
+	require := s.Require()
+	granterAddr := s.addrs[0]
+	granterStrAddr, err := s.accountKeeper.AddressCodec().BytesToString(granterAddr)
+	require.NoError(err)
+	recipientStrAddr, err := s.accountKeeper.AddressCodec().BytesToString(s.addrs[2])
+	require.NoError(err)
+	otherStrAddr, err := s.accountKeeper.AddressCodec().BytesToString(s.addrs[3])
+	require.NoError(err)
+
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 10, Time: s.ctx.HeaderInfo().Time})
+	send := &banktypes.MsgSend{Amount: coins10, FromAddress: granterStrAddr, ToAddress: recipientStrAddr}
+	schedule := authz.ExecSchedule{Next: 11, Interval: 2, MaxExecutions: 2}
+
+	// the msgs must be signed by the granter
+	_, err = s.msgSrvr.ScheduleExec(ctx, &authz.MsgScheduleExec{
+		Granter:  otherStrAddr,
+		Msgs:     authz.NewMsgExec(otherStrAddr, []sdk.Msg{send}).Msgs,
+		Schedule: schedule,
+		GasLimit: 200_000,
+	})
+	require.ErrorContains(err, "must be signed by the granter")
+
+	// the next execution height must be in the future
+	_, err = s.authzKeeper.CreateScheduledExec(ctx, granterAddr, []sdk.Msg{send}, authz.ExecSchedule{Next: 10}, 200_000, coins10)
+	require.ErrorIs(err, authz.ErrInvalidSchedule)
+
+	_, err = s.authzKeeper.CreateScheduledExec(ctx, granterAddr, []sdk.Msg{send}, schedule, 0, coins10)
+	require.ErrorIs(err, authz.ErrInvalidSchedule)
+
+	// the msgs are executed with the authorizations granted to the module account
+	a := banktypes.NewSendAuthorization(coins100, nil, s.accountKeeper.AddressCodec())
+	require.NoError(s.authzKeeper.SaveGrant(ctx, s.authzKeeper.ModuleAddress(), granterAddr, a, nil))
+
+	res, err := s.msgSrvr.ScheduleExec(ctx, &authz.MsgScheduleExec{
+		Granter:  granterStrAddr,
+		Msgs:     authz.NewMsgExec(granterStrAddr, []sdk.Msg{send}).Msgs,
+		Schedule: schedule,
+		GasLimit: 200_000,
+		Fee:      coins10,
+	})
+	require.NoError(err)
+	id := res.Id
+
+	granterExecs, err := s.queryClient.GranterScheduledExecs(ctx, &authz.QueryGranterScheduledExecsRequest{Granter: granterStrAddr})
+	require.NoError(err)
+	require.Len(granterExecs.ScheduledExecs, 1)
+	require.Equal(id, granterExecs.ScheduledExecs[0].Id)
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), granterAddr, authtypes.FeeCollectorName, coins10).Return(nil).Times(2)
+
+	// executed at the next height
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 11, Time: ctx.HeaderInfo().Time})
+	require.NoError(s.authzKeeper.ExecuteDueScheduledExecs(ctx, authz.MaxScheduledExecsBlockGas))
+
+	scheduledExec, err := s.queryClient.ScheduledExec(ctx, &authz.QueryScheduledExecRequest{Id: id})
+	require.NoError(err)
+	require.Equal(uint64(1), scheduledExec.ScheduledExec.Executions)
+	require.Equal(int64(13), scheduledExec.ScheduledExec.Schedule.Next)
+
+	authorization, _ := s.authzKeeper.GetAuthorization(ctx, s.authzKeeper.ModuleAddress(), granterAddr, bankSendAuthMsgType)
+	require.Equal(coins100.Sub(coins10...), authorization.(*banktypes.SendAuthorization).SpendLimit)
+
+	// not executed before the interval
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 12, Time: ctx.HeaderInfo().Time})
+	require.NoError(s.authzKeeper.ExecuteDueScheduledExecs(ctx, authz.MaxScheduledExecsBlockGas))
+	scheduledExec, err = s.queryClient.ScheduledExec(ctx, &authz.QueryScheduledExecRequest{Id: id})
+	require.NoError(err)
+	require.Equal(uint64(1), scheduledExec.ScheduledExec.Executions)
+
+	// the schedule ends after the max executions
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 13, Time: ctx.HeaderInfo().Time})
+	require.NoError(s.authzKeeper.ExecuteDueScheduledExecs(ctx, authz.MaxScheduledExecsBlockGas))
+	_, err = s.queryClient.ScheduledExec(ctx, &authz.QueryScheduledExecRequest{Id: id})
+	require.ErrorContains(err, "not found")
+
+	history, err := s.queryClient.ScheduledExecHistory(ctx, &authz.QueryScheduledExecHistoryRequest{Id: id})
+	require.NoError(err)
+	require.Len(history.Results, 2)
+	for i, result := range history.Results {
+		require.Equal(uint64(i+1), result.Execution)
+		require.Empty(result.Error)
+		require.NotZero(result.GasUsed)
+	}
+	require.Equal(int64(13), history.Results[1].Height)
+}
+
+func (s *TestSuite) TestScheduledExecFailures() {
+	require := s.Require()
+	granterAddr := s.addrs[0]
+	granterStrAddr, err := s.accountKeeper.AddressCodec().BytesToString(granterAddr)
+	require.NoError(err)
+	recipientStrAddr, err := s.accountKeeper.AddressCodec().BytesToString(s.addrs[2])
+	require.NoError(err)
+
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 10, Time: s.ctx.HeaderInfo().Time})
+	send := &banktypes.MsgSend{Amount: coins10, FromAddress: granterStrAddr, ToAddress: recipientStrAddr}
+
+	// a failure of the msgs is recorded and doesn't end the schedule
+	id, err := s.authzKeeper.CreateScheduledExec(ctx, granterAddr, []sdk.Msg{send}, authz.ExecSchedule{EpochIdentifier: "day", Interval: 1}, 200_000, nil)
+	require.NoError(err)
+
+	require.NoError(s.authzKeeper.EpochHooks().BeforeEpochStart(ctx, "week", 1))
+	require.NoError(s.authzKeeper.EpochHooks().BeforeEpochStart(ctx, "day", 1))
+
+	history, err := s.queryClient.ScheduledExecHistory(ctx, &authz.QueryScheduledExecHistoryRequest{Id: id})
+	require.NoError(err)
+	require.Len(history.Results, 1)
+	require.Contains(history.Results[0].Error, "authorization not found")
+
+	scheduledExec, err := s.queryClient.ScheduledExec(ctx, &authz.QueryScheduledExecRequest{Id: id})
+	require.NoError(err)
+	require.Equal(int64(2), scheduledExec.ScheduledExec.Schedule.Next)
+
+	// a failure to fund the execution ends the schedule
+	require.NoError(s.authzKeeper.DeleteScheduledExec(ctx, granterAddr, id))
+	id, err = s.authzKeeper.CreateScheduledExec(ctx, granterAddr, []sdk.Msg{send}, authz.ExecSchedule{EpochIdentifier: "day", Interval: 1}, 200_000, coins10)
+	require.NoError(err)
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), granterAddr, authtypes.FeeCollectorName, coins10).Return(errors.New("insufficient funds"))
+	require.NoError(s.authzKeeper.EpochHooks().BeforeEpochStart(ctx, "day", 2))
+
+	_, err = s.queryClient.ScheduledExec(ctx, &authz.QueryScheduledExecRequest{Id: id})
+	require.ErrorContains(err, "not found")
+	history, err = s.queryClient.ScheduledExecHistory(ctx, &authz.QueryScheduledExecHistoryRequest{Id: id})
+	require.NoError(err)
+	require.Len(history.Results, 1)
+	require.Equal("insufficient funds", history.Results[0].Error)
+
+	// only the granter can cancel its scheduled executions
+	id, err = s.authzKeeper.CreateScheduledExec(ctx, granterAddr, []sdk.Msg{send}, authz.ExecSchedule{Next: 20}, 200_000, nil)
+	require.NoError(err)
+	require.ErrorContains(s.authzKeeper.DeleteScheduledExec(ctx, s.addrs[1], id), "is not owned by")
+	require.NoError(s.authzKeeper.DeleteScheduledExec(ctx, granterAddr, id))
+	require.ErrorIs(s.authzKeeper.DeleteScheduledExec(ctx, granterAddr, id), authz.ErrScheduledExecNotFound)
+}
+
+func (s *TestSuite) TestScheduledExecFee() {
+	require := s.Require()
+	granterAddr := s.addrs[0]
+	granterStrAddr, err := s.accountKeeper.AddressCodec().BytesToString(granterAddr)
+	require.NoError(err)
+	recipientStrAddr, err := s.accountKeeper.AddressCodec().BytesToString(s.addrs[2])
+	require.NoError(err)
+
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 10, Time: s.ctx.HeaderInfo().Time})
+	send := &banktypes.MsgSend{Amount: coins10, FromAddress: granterStrAddr, ToAddress: recipientStrAddr}
+	schedule := authz.ExecSchedule{EpochIdentifier: "day", Interval: 1}
+
+	// the fee must cover the gas limit at the minimum gas prices
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdkmath.LegacyNewDecWithPrec(1, 4)))
+	k := authzkeeper.NewKeeper(s.authzKeeper.Environment, s.encCfg.Codec, s.accountKeeper, s.bankKeeper, minGasPrices)
+	_, err = k.CreateScheduledExec(ctx, granterAddr, []sdk.Msg{send}, schedule, 200_000, coins10)
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFee)
+	_, err = k.CreateScheduledExec(ctx, granterAddr, []sdk.Msg{send}, schedule, 200_000, nil)
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFee)
+	id, err := k.CreateScheduledExec(ctx, granterAddr, []sdk.Msg{send}, schedule, 200_000, coins100)
+	require.NoError(err)
+
+	// a fee no longer covering the gas limit ends the schedule without funding the execution
+	minGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdkmath.LegacyNewDecWithPrec(1, 3)))
+	k = authzkeeper.NewKeeper(s.authzKeeper.Environment, s.encCfg.Codec, s.accountKeeper, s.bankKeeper, minGasPrices)
+	require.NoError(k.EpochHooks().BeforeEpochStart(ctx, "day", 1))
+
+	_, err = s.queryClient.ScheduledExec(ctx, &authz.QueryScheduledExecRequest{Id: id})
+	require.ErrorContains(err, "not found")
+	history, err := s.queryClient.ScheduledExecHistory(ctx, &authz.QueryScheduledExecHistoryRequest{Id: id})
+	require.NoError(err)
+	require.Len(history.Results, 1)
+	require.Contains(history.Results[0].Error, "insufficient scheduled execution fee")
+}
+
+func (s *TestSuite) TestScheduledExecsBlockGasLimit() {
+	require := s.Require()
+	granterAddr := s.addrs[0]
+	granterStrAddr, err := s.accountKeeper.AddressCodec().BytesToString(granterAddr)
+	require.NoError(err)
+	recipientStrAddr, err := s.accountKeeper.AddressCodec().BytesToString(s.addrs[2])
+	require.NoError(err)
+
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 10, Time: s.ctx.HeaderInfo().Time})
+	send := &banktypes.MsgSend{Amount: coins10, FromAddress: granterStrAddr, ToAddress: recipientStrAddr}
+
+	// the executions at the max gas limit fitting in a block
+	perBlock := int(authz.MaxScheduledExecsBlockGas / (authz.MaxScheduledExecGasLimit + authz.ScheduledExecBaseGas))
+	ids := make([]uint64, perBlock+1)
+	for i := range ids {
+		// the last execution is the longest overdue one
+		next := int64(12)
+		if i == len(ids)-1 {
+			next = 11
+		}
+		ids[i], err = s.authzKeeper.CreateScheduledExec(ctx, granterAddr, []sdk.Msg{send}, authz.ExecSchedule{Next: next, MaxExecutions: 1}, authz.MaxScheduledExecGasLimit, nil)
+		require.NoError(err)
+	}
+
+	executed := func(id uint64) bool {
+		_, err := s.queryClient.ScheduledExec(ctx, &authz.QueryScheduledExecRequest{Id: id})
+		return err != nil
+	}
+
+	// the executions over the block gas are delayed
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 12, Time: ctx.HeaderInfo().Time})
+	require.NoError(s.authzKeeper.ExecuteDueScheduledExecs(ctx, authz.MaxScheduledExecsBlockGas))
+	require.True(executed(ids[perBlock]))
+	for _, id := range ids[:perBlock-1] {
+		require.True(executed(id))
+	}
+	require.False(executed(ids[perBlock-1]))
+
+	// and executed in the next block
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 13, Time: ctx.HeaderInfo().Time})
+	require.NoError(s.authzKeeper.ExecuteDueScheduledExecs(ctx, authz.MaxScheduledExecsBlockGas))
+	require.True(executed(ids[perBlock-1]))
+}
+
+func (s *TestSuite) TestEpochScheduledExecsGasLimit() {
+	require := s.Require()
+	granterAddr := s.addrs[0]
+	granterStrAddr, err := s.accountKeeper.AddressCodec().BytesToString(granterAddr)
+	require.NoError(err)
+	recipientStrAddr, err := s.accountKeeper.AddressCodec().BytesToString(s.addrs[2])
+	require.NoError(err)
+
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 10, Time: s.ctx.HeaderInfo().Time})
+	send := &banktypes.MsgSend{Amount: coins10, FromAddress: granterStrAddr, ToAddress: recipientStrAddr}
+
+	perEpoch := int(authz.MaxEpochScheduledExecsGas / (authz.MaxScheduledExecGasLimit + authz.ScheduledExecBaseGas))
+	ids := make([]uint64, perEpoch+1)
+	for i := range ids {
+		ids[i], err = s.authzKeeper.CreateScheduledExec(ctx, granterAddr, []sdk.Msg{send}, authz.ExecSchedule{EpochIdentifier: "day", Interval: 1}, authz.MaxScheduledExecGasLimit, nil)
+		require.NoError(err)
+	}
+
+	// not due executions, and executions of other identifiers, are not executed
+	notDue, err := s.authzKeeper.CreateScheduledExec(ctx, granterAddr, []sdk.Msg{send}, authz.ExecSchedule{EpochIdentifier: "day", Next: 3}, 200_000, nil)
+	require.NoError(err)
+	otherIdentifier, err := s.authzKeeper.CreateScheduledExec(ctx, granterAddr, []sdk.Msg{send}, authz.ExecSchedule{EpochIdentifier: "week"}, 200_000, nil)
+	require.NoError(err)
+
+	executions := func(id uint64) uint64 {
+		res, err := s.queryClient.ScheduledExec(ctx, &authz.QueryScheduledExecRequest{Id: id})
+		require.NoError(err)
+		return res.ScheduledExec.Executions
+	}
+
+	// the executions over the gas limit are delayed
+	require.NoError(s.authzKeeper.EpochHooks().BeforeEpochStart(ctx, "day", 1))
+	require.Equal(uint64(1), executions(ids[0]))
+	require.Equal(uint64(0), executions(ids[perEpoch]))
+	require.Equal(uint64(0), executions(notDue))
+	require.Equal(uint64(0), executions(otherIdentifier))
+
+	// and executed first at the next epoch start
+	require.NoError(s.authzKeeper.EpochHooks().BeforeEpochStart(ctx, "day", 2))
+	require.Equal(uint64(1), executions(ids[perEpoch]))
+	require.Equal(uint64(2), executions(ids[0]))
+	require.Equal(uint64(1), executions(ids[perEpoch-1]))
+	require.Equal(uint64(0), executions(notDue))
+}
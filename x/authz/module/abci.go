@@ -3,6 +3,7 @@ package module
 import (
 	"context"
 
+	"cosmossdk.io/x/authz"
 	"cosmossdk.io/x/authz/keeper"
 )
 
@@ -10,5 +11,10 @@ import (
 func BeginBlocker(ctx context.Context, keeper keeper.Keeper) error {
 	// delete all the mature grants
 	// 200 is an arbitrary value, we can change it later if needed
-	return keeper.DequeueAndDeleteExpiredGrants(ctx, 200)
+	if err := keeper.DequeueAndDeleteExpiredGrants(ctx, 200); err != nil {
+		return err
+	}
+
+	// execute the due scheduled executions, the remaining ones are executed in the next blocks
+	return keeper.ExecuteDueScheduledExecs(ctx, authz.MaxScheduledExecsBlockGas)
 }
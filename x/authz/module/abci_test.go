@@ -68,7 +68,7 @@ func TestExpiredGrantsQueue(t *testing.T) {
 	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
 
 	env := runtime.NewEnvironment(storeService, coretesting.NewNopLogger(), runtime.EnvWithQueryRouterService(baseApp.GRPCQueryRouter()), runtime.EnvWithMsgRouterService(baseApp.MsgServiceRouter()))
-	authzKeeper := keeper.NewKeeper(env, encCfg.Codec, accountKeeper)
+	authzKeeper := keeper.NewKeeper(env, encCfg.Codec, accountKeeper, authztestutil.NewMockBankKeeper(ctrl), nil)
 
 	save := func(grantee sdk.AccAddress, exp *time.Time) {
 		err := authzKeeper.SaveGrant(ctx, grantee, granter, sendAuthz, exp)
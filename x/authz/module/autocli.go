@@ -44,6 +44,30 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "grantee"},
 					},
 				},
+				{
+					RpcMethod: "ScheduledExec",
+					Use:       "scheduled-exec <id>",
+					Short:     "Query a scheduled execution by its id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "GranterScheduledExecs",
+					Use:       "scheduled-execs-by-granter <granter-addr>",
+					Short:     "Query the scheduled executions funded by a granter",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+					},
+				},
+				{
+					RpcMethod: "ScheduledExecHistory",
+					Use:       "scheduled-exec-history <id>",
+					Short:     "Query the latest results of the executions of a scheduled execution",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -76,6 +100,24 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Revoke all authorizations from the signer",
 					Example:   fmt.Sprintf("%s tx authz revoke-all --from=cosmos1skj..", version.AppName),
 				},
+				{
+					RpcMethod: "ScheduleExec",
+					Use:       "schedule-exec <msg-json-file> --schedule <schedule-json> --gas-limit <gas> --fee <coins> --from <granter>",
+					Short:     "Schedule the execution of msgs with the authorizations granted to the authz module account",
+					Long:      "Schedule the execution of msgs, at block heights or at the start of epochs. The msgs are executed with the authorizations granted by the granter to the authz module account, and each execution is funded by the granter.",
+					Example:   fmt.Sprintf(`$ %s tx authz schedule-exec msg.json --schedule '{"next":"1000","interval":"100800","max_executions":"12"}' --gas-limit 200000 --fee 1000stake --from granter`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "msgs", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "CancelScheduledExec",
+					Use:       "cancel-scheduled-exec <id> --from <granter>",
+					Short:     "Cancel a scheduled execution",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
 				{
 					RpcMethod: "PruneExpiredGrants",
 					Use:       "prune-grants --from <granter>",
@@ -1,15 +1,19 @@
 package module
 
 import (
+	"fmt"
+
 	modulev1 "cosmossdk.io/api/cosmos/authz/module/v1"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
 	"cosmossdk.io/x/authz"
 	"cosmossdk.io/x/authz/keeper"
+	epochstypes "cosmossdk.io/x/epochs/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var _ depinject.OnePerModuleType = AppModule{}
@@ -27,8 +31,10 @@ func init() {
 type ModuleInputs struct {
 	depinject.In
 
+	Config        *modulev1.Module
 	Cdc           codec.Codec
 	AccountKeeper authz.AccountKeeper
+	BankKeeper    authz.BankKeeper
 	Registry      cdctypes.InterfaceRegistry
 	Environment   appmodule.Environment
 }
@@ -38,10 +44,16 @@ type ModuleOutputs struct {
 
 	AuthzKeeper keeper.Keeper
 	Module      appmodule.AppModule
+	EpochHooks  epochstypes.EpochHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
-	k := keeper.NewKeeper(in.Environment, in.Cdc, in.AccountKeeper)
+	scheduledExecMinGasPrices, err := sdk.ParseDecCoins(in.Config.ScheduledExecMinGasPrices)
+	if err != nil {
+		panic(fmt.Sprintf("invalid scheduled execution minimum gas prices: %v", err))
+	}
+
+	k := keeper.NewKeeper(in.Environment, in.Cdc, in.AccountKeeper, in.BankKeeper, scheduledExecMinGasPrices)
 	m := NewAppModule(in.Cdc, k, in.Registry)
-	return ModuleOutputs{AuthzKeeper: k, Module: m}
+	return ModuleOutputs{AuthzKeeper: k, Module: m, EpochHooks: epochstypes.EpochHooksWrapper{EpochHooks: k.EpochHooks()}}
 }
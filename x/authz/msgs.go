@@ -15,9 +15,12 @@ var (
 	_ sdk.Msg = &MsgGrant{}
 	_ sdk.Msg = &MsgRevoke{}
 	_ sdk.Msg = &MsgExec{}
+	_ sdk.Msg = &MsgScheduleExec{}
+	_ sdk.Msg = &MsgCancelScheduledExec{}
 
 	_ gogoprotoany.UnpackInterfacesMessage = &MsgGrant{}
 	_ gogoprotoany.UnpackInterfacesMessage = &MsgExec{}
+	_ gogoprotoany.UnpackInterfacesMessage = &MsgScheduleExec{}
 )
 
 // NewMsgGrant creates a new MsgGrant
@@ -55,15 +58,7 @@ func (msg *MsgGrant) SetAuthorization(a Authorization) error {
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (msg MsgExec) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
-	for _, x := range msg.Msgs {
-		var msgExecAuthorized sdk.Msg
-		err := unpacker.UnpackAny(x, &msgExecAuthorized)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return unpackMessages(unpacker, msg.Msgs)
 }
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
@@ -82,6 +77,39 @@ func NewMsgRevoke(granter, grantee, msgTypeURL string) MsgRevoke {
 
 // NewMsgExec creates a new MsgExecAuthorized
 func NewMsgExec(grantee string, msgs []sdk.Msg) MsgExec {
+	return MsgExec{
+		Grantee: grantee,
+		Msgs:    packMessages(msgs),
+	}
+}
+
+// GetMessages returns the cache values from the MsgExecAuthorized.Msgs if present.
+func (msg MsgExec) GetMessages() ([]sdk.Msg, error) {
+	return getMessages(msg.Msgs)
+}
+
+// NewMsgScheduleExec creates a new MsgScheduleExec
+func NewMsgScheduleExec(granter string, msgs []sdk.Msg, schedule ExecSchedule, gasLimit uint64, fee sdk.Coins) MsgScheduleExec {
+	return MsgScheduleExec{
+		Granter:  granter,
+		Msgs:     packMessages(msgs),
+		Schedule: schedule,
+		GasLimit: gasLimit,
+		Fee:      fee,
+	}
+}
+
+// GetMessages returns the cache values from the MsgScheduleExec.Msgs if present.
+func (msg MsgScheduleExec) GetMessages() ([]sdk.Msg, error) {
+	return getMessages(msg.Msgs)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgScheduleExec) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	return unpackMessages(unpacker, msg.Msgs)
+}
+
+func packMessages(msgs []sdk.Msg) []*cdctypes.Any {
 	msgsAny := make([]*cdctypes.Any, len(msgs))
 	for i, msg := range msgs {
 		any, err := cdctypes.NewAnyWithValue(msg)
@@ -92,16 +120,12 @@ func NewMsgExec(grantee string, msgs []sdk.Msg) MsgExec {
 		msgsAny[i] = any
 	}
 
-	return MsgExec{
-		Grantee: grantee,
-		Msgs:    msgsAny,
-	}
+	return msgsAny
 }
 
-// GetMessages returns the cache values from the MsgExecAuthorized.Msgs if present.
-func (msg MsgExec) GetMessages() ([]sdk.Msg, error) {
-	msgs := make([]sdk.Msg, len(msg.Msgs))
-	for i, msgAny := range msg.Msgs {
+func getMessages(msgsAny []*cdctypes.Any) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(msgsAny))
+	for i, msgAny := range msgsAny {
 		msg, ok := msgAny.GetCachedValue().(sdk.Msg)
 		if !ok {
 			return nil, sdkerrors.ErrInvalidRequest.Wrapf("messages contains %T which is not a sdk.Msg", msgAny)
@@ -111,3 +135,15 @@ func (msg MsgExec) GetMessages() ([]sdk.Msg, error) {
 
 	return msgs, nil
 }
+
+func unpackMessages(unpacker gogoprotoany.AnyUnpacker, msgsAny []*cdctypes.Any) error {
+	for _, x := range msgsAny {
+		var msg sdk.Msg
+		err := unpacker.UnpackAny(x, &msg)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
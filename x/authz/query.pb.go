@@ -374,6 +374,316 @@ func (m *QueryGranteeGrantsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryScheduledExecRequest is the request type for the Query/ScheduledExec RPC method.
+type QueryScheduledExecRequest struct {
+	// id of the scheduled execution.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryScheduledExecRequest) Reset()         { *m = QueryScheduledExecRequest{} }
+func (m *QueryScheduledExecRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledExecRequest) ProtoMessage()    {}
+func (*QueryScheduledExecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376d714ffdeb1545, []int{6}
+}
+func (m *QueryScheduledExecRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledExecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledExecRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledExecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledExecRequest.Merge(m, src)
+}
+func (m *QueryScheduledExecRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledExecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledExecRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledExecRequest proto.InternalMessageInfo
+
+func (m *QueryScheduledExecRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryScheduledExecResponse is the response type for the Query/ScheduledExec RPC method.
+type QueryScheduledExecResponse struct {
+	ScheduledExec *ScheduledExec `protobuf:"bytes,1,opt,name=scheduled_exec,json=scheduledExec,proto3" json:"scheduled_exec,omitempty"`
+}
+
+func (m *QueryScheduledExecResponse) Reset()         { *m = QueryScheduledExecResponse{} }
+func (m *QueryScheduledExecResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledExecResponse) ProtoMessage()    {}
+func (*QueryScheduledExecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376d714ffdeb1545, []int{7}
+}
+func (m *QueryScheduledExecResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledExecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledExecResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledExecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledExecResponse.Merge(m, src)
+}
+func (m *QueryScheduledExecResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledExecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledExecResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledExecResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledExecResponse) GetScheduledExec() *ScheduledExec {
+	if m != nil {
+		return m.ScheduledExec
+	}
+	return nil
+}
+
+// QueryGranterScheduledExecsRequest is the request type for the Query/GranterScheduledExecs RPC method.
+type QueryGranterScheduledExecsRequest struct {
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// pagination defines an pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGranterScheduledExecsRequest) Reset()         { *m = QueryGranterScheduledExecsRequest{} }
+func (m *QueryGranterScheduledExecsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGranterScheduledExecsRequest) ProtoMessage()    {}
+func (*QueryGranterScheduledExecsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376d714ffdeb1545, []int{8}
+}
+func (m *QueryGranterScheduledExecsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGranterScheduledExecsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGranterScheduledExecsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGranterScheduledExecsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGranterScheduledExecsRequest.Merge(m, src)
+}
+func (m *QueryGranterScheduledExecsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGranterScheduledExecsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGranterScheduledExecsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGranterScheduledExecsRequest proto.InternalMessageInfo
+
+func (m *QueryGranterScheduledExecsRequest) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *QueryGranterScheduledExecsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGranterScheduledExecsResponse is the response type for the Query/GranterScheduledExecs RPC method.
+type QueryGranterScheduledExecsResponse struct {
+	// scheduled_execs is a list of the scheduled executions funded by the granter.
+	ScheduledExecs []*ScheduledExec `protobuf:"bytes,1,rep,name=scheduled_execs,json=scheduledExecs,proto3" json:"scheduled_execs,omitempty"`
+	// pagination defines an pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGranterScheduledExecsResponse) Reset()         { *m = QueryGranterScheduledExecsResponse{} }
+func (m *QueryGranterScheduledExecsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGranterScheduledExecsResponse) ProtoMessage()    {}
+func (*QueryGranterScheduledExecsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376d714ffdeb1545, []int{9}
+}
+func (m *QueryGranterScheduledExecsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGranterScheduledExecsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGranterScheduledExecsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGranterScheduledExecsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGranterScheduledExecsResponse.Merge(m, src)
+}
+func (m *QueryGranterScheduledExecsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGranterScheduledExecsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGranterScheduledExecsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGranterScheduledExecsResponse proto.InternalMessageInfo
+
+func (m *QueryGranterScheduledExecsResponse) GetScheduledExecs() []*ScheduledExec {
+	if m != nil {
+		return m.ScheduledExecs
+	}
+	return nil
+}
+
+func (m *QueryGranterScheduledExecsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryScheduledExecHistoryRequest is the request type for the Query/ScheduledExecHistory RPC method.
+type QueryScheduledExecHistoryRequest struct {
+	// id of the scheduled execution.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pagination defines an pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledExecHistoryRequest) Reset()         { *m = QueryScheduledExecHistoryRequest{} }
+func (m *QueryScheduledExecHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledExecHistoryRequest) ProtoMessage()    {}
+func (*QueryScheduledExecHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376d714ffdeb1545, []int{10}
+}
+func (m *QueryScheduledExecHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledExecHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledExecHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledExecHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledExecHistoryRequest.Merge(m, src)
+}
+func (m *QueryScheduledExecHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledExecHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledExecHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledExecHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryScheduledExecHistoryRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QueryScheduledExecHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryScheduledExecHistoryResponse is the response type for the Query/ScheduledExecHistory RPC method.
+type QueryScheduledExecHistoryResponse struct {
+	// results of the executions, oldest first.
+	Results []*ScheduledExecResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// pagination defines an pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledExecHistoryResponse) Reset()         { *m = QueryScheduledExecHistoryResponse{} }
+func (m *QueryScheduledExecHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledExecHistoryResponse) ProtoMessage()    {}
+func (*QueryScheduledExecHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376d714ffdeb1545, []int{11}
+}
+func (m *QueryScheduledExecHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledExecHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledExecHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledExecHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledExecHistoryResponse.Merge(m, src)
+}
+func (m *QueryScheduledExecHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledExecHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledExecHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledExecHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledExecHistoryResponse) GetResults() []*ScheduledExecResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *QueryScheduledExecHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGrantsRequest)(nil), "cosmos.authz.v1beta1.QueryGrantsRequest")
 	proto.RegisterType((*QueryGrantsResponse)(nil), "cosmos.authz.v1beta1.QueryGrantsResponse")
@@ -381,46 +691,68 @@ func init() {
 	proto.RegisterType((*QueryGranterGrantsResponse)(nil), "cosmos.authz.v1beta1.QueryGranterGrantsResponse")
 	proto.RegisterType((*QueryGranteeGrantsRequest)(nil), "cosmos.authz.v1beta1.QueryGranteeGrantsRequest")
 	proto.RegisterType((*QueryGranteeGrantsResponse)(nil), "cosmos.authz.v1beta1.QueryGranteeGrantsResponse")
+	proto.RegisterType((*QueryScheduledExecRequest)(nil), "cosmos.authz.v1beta1.QueryScheduledExecRequest")
+	proto.RegisterType((*QueryScheduledExecResponse)(nil), "cosmos.authz.v1beta1.QueryScheduledExecResponse")
+	proto.RegisterType((*QueryGranterScheduledExecsRequest)(nil), "cosmos.authz.v1beta1.QueryGranterScheduledExecsRequest")
+	proto.RegisterType((*QueryGranterScheduledExecsResponse)(nil), "cosmos.authz.v1beta1.QueryGranterScheduledExecsResponse")
+	proto.RegisterType((*QueryScheduledExecHistoryRequest)(nil), "cosmos.authz.v1beta1.QueryScheduledExecHistoryRequest")
+	proto.RegisterType((*QueryScheduledExecHistoryResponse)(nil), "cosmos.authz.v1beta1.QueryScheduledExecHistoryResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/query.proto", fileDescriptor_376d714ffdeb1545) }
 
 var fileDescriptor_376d714ffdeb1545 = []byte{
-	// 534 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0xeb, 0x6e, 0x14, 0xe1, 0x81, 0x90, 0x0c, 0x87, 0x2c, 0x4c, 0x51, 0x54, 0x21, 0x28,
-	0x48, 0xb5, 0xbb, 0x0e, 0x71, 0x66, 0x3b, 0x6c, 0xe2, 0x06, 0x01, 0x2e, 0x5c, 0xaa, 0x94, 0xbe,
-	0xca, 0xa2, 0xb5, 0x71, 0x66, 0x3b, 0x88, 0x0e, 0xed, 0x02, 0x67, 0x24, 0xa4, 0x1d, 0xf8, 0x08,
-	0x7c, 0x81, 0x5d, 0xb9, 0x23, 0x4e, 0x13, 0x5c, 0x38, 0xa2, 0x16, 0xf1, 0x39, 0x50, 0x6d, 0x97,
-	0xae, 0x23, 0xdb, 0xc2, 0x9f, 0x4a, 0xbb, 0xc5, 0xd1, 0xf3, 0xbc, 0xef, 0xef, 0x7d, 0x1c, 0x3b,
-	0xd8, 0x7f, 0xc6, 0x65, 0x8f, 0x4b, 0x16, 0x66, 0x6a, 0x73, 0x87, 0x3d, 0x5f, 0x6e, 0x83, 0x0a,
-	0x97, 0xd9, 0x76, 0x06, 0xa2, 0x4f, 0x53, 0xc1, 0x15, 0x27, 0x57, 0x8d, 0x82, 0x6a, 0x05, 0xb5,
-	0x0a, 0x77, 0x29, 0xe2, 0x3c, 0xea, 0x02, 0x0b, 0xd3, 0x98, 0x85, 0x49, 0xc2, 0x55, 0xa8, 0x62,
-	0x9e, 0x48, 0xe3, 0x71, 0x6f, 0xdb, 0xaa, 0xed, 0x50, 0x82, 0x29, 0xf6, 0xab, 0x74, 0x1a, 0x46,
-	0x71, 0xa2, 0xc5, 0x56, 0x9b, 0x4f, 0x60, 0xba, 0x19, 0xc5, 0xa2, 0x51, 0xb4, 0xf4, 0x8a, 0x59,
-	0x1c, 0xbd, 0xa8, 0xfe, 0x40, 0x98, 0x3c, 0x1c, 0xd5, 0xdf, 0x10, 0x61, 0xa2, 0x64, 0x00, 0xdb,
-	0x19, 0x48, 0x45, 0x9a, 0xf8, 0x7c, 0x34, 0x7a, 0x01, 0xc2, 0x41, 0x3e, 0xaa, 0x5d, 0x58, 0x73,
-	0x3e, 0xef, 0xd7, 0xc7, 0x83, 0xac, 0x76, 0x3a, 0x02, 0xa4, 0x7c, 0xa4, 0x44, 0x9c, 0x44, 0xc1,
-	0x58, 0x38, 0xf1, 0x80, 0x53, 0x2e, 0xe6, 0x01, 0xe2, 0xe3, 0x8b, 0x3d, 0x19, 0xb5, 0x54, 0x3f,
-	0x85, 0x56, 0x26, 0xba, 0xce, 0xdc, 0xc8, 0x18, 0xe0, 0x9e, 0x8c, 0x1e, 0xf7, 0x53, 0x78, 0x22,
-	0xba, 0x64, 0x1d, 0xe3, 0xc9, 0xc4, 0xce, 0xbc, 0x8f, 0x6a, 0x0b, 0xcd, 0x1b, 0xd4, 0x56, 0x1d,
-	0xc5, 0x43, 0x4d, 0xd6, 0x76, 0x6e, 0xfa, 0x20, 0x8c, 0xc0, 0x4e, 0x11, 0x1c, 0x72, 0x56, 0xf7,
-	0x10, 0xbe, 0x32, 0x35, 0xa8, 0x4c, 0x79, 0x22, 0x81, 0xac, 0xe0, 0x8a, 0x86, 0x91, 0x0e, 0xf2,
-	0xe7, 0x6a, 0x0b, 0xcd, 0x6b, 0x34, 0x6f, 0xbb, 0xa8, 0x76, 0x05, 0x56, 0x4a, 0x36, 0xa6, 0xa0,
-	0xca, 0x1a, 0xea, 0xe6, 0xa9, 0x50, 0xa6, 0xe3, 0x14, 0xd5, 0x3b, 0x84, 0x17, 0x27, 0x54, 0x20,
-	0xfe, 0x7d, 0x17, 0xd6, 0x73, 0xd0, 0xfe, 0x26, 0xaf, 0xf7, 0x08, 0xbb, 0x79, 0x64, 0x36, 0xb6,
-	0x7b, 0x47, 0x62, 0xab, 0x9d, 0x10, 0xdb, 0x6a, 0xa6, 0x36, 0xb9, 0x88, 0x77, 0x74, 0xe1, 0x99,
-	0x67, 0x08, 0xc7, 0x64, 0x08, 0x45, 0x33, 0x84, 0x59, 0x65, 0x08, 0x67, 0x36, 0xc3, 0xe6, 0x9b,
-	0x79, 0x7c, 0x4e, 0x93, 0x92, 0xd7, 0x08, 0x57, 0x0c, 0x27, 0x39, 0x86, 0xe7, 0xf7, 0xeb, 0xc2,
-	0xbd, 0x55, 0x40, 0x69, 0xba, 0x56, 0xaf, 0xbf, 0xfa, 0xf2, 0x7d, 0xaf, 0xec, 0x91, 0x25, 0x96,
-	0x7b, 0x6d, 0xd9, 0xc1, 0x3e, 0x20, 0x7c, 0x69, 0xea, 0xc3, 0x23, 0xec, 0xb4, 0x16, 0x47, 0x0e,
-	0x8f, 0xdb, 0x28, 0x6e, 0xb0, 0x68, 0xf7, 0x3f, 0xed, 0xd7, 0x2f, 0x1b, 0x53, 0x5d, 0x76, 0xb6,
-	0xfc, 0x06, 0xbd, 0x73, 0x57, 0xd3, 0x36, 0x08, 0x3d, 0x89, 0x96, 0xd9, 0xb3, 0xc7, 0x5e, 0xda,
-	0x87, 0xdd, 0x43, 0xfc, 0x50, 0x98, 0x1f, 0xfe, 0x94, 0x1f, 0xfe, 0x2f, 0x3f, 0x8c, 0xf9, 0x61,
-	0x77, 0x8d, 0x7e, 0x1c, 0x78, 0xe8, 0x60, 0xe0, 0xa1, 0x6f, 0x03, 0x0f, 0xbd, 0x1d, 0x7a, 0xa5,
-	0x83, 0xa1, 0x57, 0xfa, 0x3a, 0xf4, 0x4a, 0x4f, 0xed, 0xd1, 0x91, 0x9d, 0x2d, 0x1a, 0x73, 0xf6,
-	0xc2, 0x14, 0x6c, 0x57, 0xf4, 0xdf, 0x64, 0xe5, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x08, 0x92,
-	0xc5, 0x36, 0x0e, 0x07, 0x00, 0x00,
+	// 797 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0xcf, 0x4f, 0x13, 0x4f,
+	0x18, 0xc6, 0x99, 0xc2, 0x17, 0xbe, 0x0e, 0x16, 0xe2, 0x88, 0x49, 0x59, 0x49, 0xb3, 0x56, 0xa3,
+	0x85, 0xd8, 0xdd, 0x52, 0x08, 0xa8, 0x07, 0x23, 0x10, 0xc1, 0x5f, 0x07, 0x2c, 0x7a, 0xf1, 0xd2,
+	0x2c, 0xdd, 0x37, 0xdb, 0x0d, 0xa5, 0x5b, 0x76, 0xb6, 0x86, 0x42, 0xb8, 0xe8, 0xd9, 0xc4, 0x84,
+	0x83, 0x7f, 0x82, 0x77, 0x43, 0xe2, 0xc9, 0x9b, 0x07, 0xc3, 0xc1, 0x10, 0xf4, 0xe0, 0xc1, 0x83,
+	0x01, 0xe3, 0x7f, 0x61, 0x62, 0x3a, 0x33, 0x0b, 0xdd, 0x32, 0x94, 0x45, 0x69, 0xc2, 0x8d, 0x65,
+	0xdf, 0xe7, 0x9d, 0xcf, 0xf3, 0xec, 0xbb, 0xef, 0x02, 0x56, 0xf3, 0x0e, 0x5d, 0x74, 0xa8, 0x6e,
+	0x54, 0xbc, 0xc2, 0x8a, 0xfe, 0x7c, 0x78, 0x1e, 0x3c, 0x63, 0x58, 0x5f, 0xaa, 0x80, 0x5b, 0xd5,
+	0xca, 0xae, 0xe3, 0x39, 0xa4, 0x8f, 0x57, 0x68, 0xac, 0x42, 0x13, 0x15, 0xca, 0x80, 0xe5, 0x38,
+	0x56, 0x11, 0x74, 0xa3, 0x6c, 0xeb, 0x46, 0xa9, 0xe4, 0x78, 0x86, 0x67, 0x3b, 0x25, 0xca, 0x35,
+	0xca, 0x90, 0xe8, 0x3a, 0x6f, 0x50, 0xe0, 0xcd, 0xf6, 0x5a, 0x97, 0x0d, 0xcb, 0x2e, 0xb1, 0x62,
+	0x51, 0x2b, 0x27, 0xe0, 0xa7, 0xf1, 0x8a, 0x7e, 0x5e, 0x91, 0x63, 0x57, 0x3a, 0xbf, 0xe0, 0xb7,
+	0x12, 0xbf, 0x10, 0x26, 0x8f, 0x6b, 0xfd, 0x67, 0x5c, 0xa3, 0xe4, 0xd1, 0x2c, 0x2c, 0x55, 0x80,
+	0x7a, 0x24, 0x83, 0xbb, 0xac, 0xda, 0x2f, 0xc0, 0x8d, 0x21, 0x15, 0x25, 0xcf, 0x4c, 0xc6, 0xb6,
+	0x37, 0x52, 0xbe, 0x91, 0x09, 0xd3, 0x74, 0x81, 0xd2, 0x39, 0xcf, 0xb5, 0x4b, 0x56, 0xd6, 0x2f,
+	0xdc, 0xd7, 0x40, 0x2c, 0x12, 0x4e, 0x03, 0x44, 0xc5, 0x67, 0x17, 0xa9, 0x95, 0xf3, 0xaa, 0x65,
+	0xc8, 0x55, 0xdc, 0x62, 0xac, 0xbd, 0x26, 0xcc, 0xe2, 0x45, 0x6a, 0x3d, 0xa9, 0x96, 0xe1, 0xa9,
+	0x5b, 0x24, 0xd3, 0x18, 0xef, 0x3b, 0x8e, 0x75, 0xa8, 0x28, 0xd9, 0x9d, 0xb9, 0xaa, 0x89, 0xae,
+	0xb5, 0x78, 0x34, 0x9e, 0xb5, 0xf0, 0xad, 0xcd, 0x1a, 0x16, 0x08, 0x17, 0xd9, 0x3a, 0x65, 0x62,
+	0x1d, 0xe1, 0xf3, 0x01, 0xa3, 0xb4, 0xec, 0x94, 0x28, 0x90, 0x11, 0xdc, 0xc9, 0x60, 0x68, 0x0c,
+	0xa9, 0xed, 0xc9, 0xee, 0xcc, 0x45, 0x4d, 0xf6, 0xb8, 0x34, 0xa6, 0xca, 0x8a, 0x52, 0x32, 0x13,
+	0x80, 0x8a, 0x30, 0xa8, 0x6b, 0x47, 0x42, 0xf1, 0x13, 0x03, 0x54, 0x6f, 0x10, 0xee, 0xdf, 0xa7,
+	0x02, 0xf7, 0xdf, 0x9f, 0xc2, 0xb4, 0x04, 0xed, 0x6f, 0xf2, 0x7a, 0x8b, 0xb0, 0x22, 0x23, 0x13,
+	0xb1, 0xdd, 0x69, 0x88, 0x2d, 0xd9, 0x24, 0xb6, 0x89, 0x8a, 0x57, 0x70, 0x5c, 0x7b, 0x85, 0x35,
+	0x6e, 0x79, 0x86, 0x70, 0x48, 0x86, 0x10, 0x36, 0x43, 0x68, 0x55, 0x86, 0x70, 0x7a, 0x33, 0xbc,
+	0x2d, 0x22, 0x9c, 0xcb, 0x17, 0xc0, 0xac, 0x14, 0xc1, 0xbc, 0xbb, 0x0c, 0x79, 0x3f, 0xc2, 0x1e,
+	0x1c, 0xb1, 0x4d, 0x96, 0x5e, 0x47, 0x36, 0x62, 0x9b, 0xb7, 0xce, 0x6d, 0x6f, 0xa4, 0xa2, 0xcb,
+	0x7c, 0xc3, 0xa8, 0xc3, 0x5a, 0x5a, 0x4b, 0x27, 0x56, 0xb1, 0x22, 0xd3, 0x0b, 0xa3, 0x0f, 0x70,
+	0x0f, 0xf5, 0x6f, 0xe4, 0x60, 0x19, 0xf2, 0xac, 0x59, 0x77, 0xe6, 0xb2, 0xdc, 0x70, 0xb0, 0x49,
+	0x94, 0xd6, 0x5f, 0xca, 0x0e, 0x7f, 0x87, 0xf0, 0xa5, 0xfa, 0x51, 0x0d, 0xe8, 0x4f, 0xc3, 0xcb,
+	0x24, 0x83, 0xfe, 0x8c, 0x70, 0xa2, 0x19, 0xb4, 0x88, 0xee, 0x11, 0xee, 0x0d, 0x46, 0xe7, 0x0f,
+	0x4b, 0xa8, 0xec, 0x7a, 0x02, 0xd9, 0x9d, 0xdc, 0xbc, 0xc8, 0x0c, 0xbd, 0x42, 0x58, 0x3d, 0x38,
+	0x03, 0xf7, 0x6c, 0xea, 0x39, 0x6e, 0xf5, 0x90, 0x51, 0x6a, 0x65, 0xc0, 0x1f, 0xfd, 0xa9, 0x90,
+	0xf3, 0x88, 0x7c, 0xa7, 0x70, 0x97, 0x0b, 0xb4, 0x52, 0xdc, 0x7b, 0x09, 0x07, 0xc3, 0xe4, 0xca,
+	0x14, 0x59, 0x5f, 0xd9, 0xca, 0x58, 0x33, 0xbf, 0xff, 0xc7, 0xff, 0x31, 0x1b, 0xe4, 0x25, 0xc2,
+	0x9d, 0x7c, 0x83, 0x90, 0x43, 0x36, 0xc5, 0xc1, 0x0f, 0xb9, 0x32, 0x18, 0xa2, 0x92, 0x83, 0x24,
+	0xae, 0xbc, 0xf8, 0xf2, 0x73, 0x3d, 0x12, 0x27, 0x03, 0xba, 0xf4, 0x0f, 0x0a, 0xb1, 0x72, 0x3e,
+	0x20, 0x1c, 0x0d, 0x7c, 0x12, 0x88, 0x7e, 0xd4, 0x11, 0x0d, 0x9f, 0x35, 0x25, 0x1d, 0x5e, 0x20,
+	0xd0, 0xee, 0x6f, 0x6e, 0xa4, 0x7a, 0xb9, 0x28, 0x45, 0xcd, 0x05, 0x35, 0xad, 0x8d, 0x8e, 0x31,
+	0xda, 0x34, 0xd1, 0x9a, 0xd1, 0xea, 0xe2, 0x45, 0xd6, 0x57, 0xc5, 0x0f, 0x6b, 0x75, 0xfc, 0x10,
+	0x9a, 0x1f, 0x8e, 0xcb, 0x0f, 0x27, 0xcb, 0x0f, 0x3e, 0x3f, 0xac, 0x91, 0xf7, 0x08, 0x47, 0x03,
+	0xc3, 0xd8, 0x94, 0x5f, 0xb6, 0xcf, 0x95, 0x74, 0x78, 0x81, 0xe0, 0x9f, 0xda, 0x6c, 0x9c, 0x4b,
+	0x46, 0x7f, 0x9d, 0x0c, 0xc9, 0xe9, 0x1b, 0x56, 0x96, 0xbe, 0x6a, 0x9b, 0x6b, 0xe4, 0x3b, 0xc2,
+	0x17, 0xa4, 0xcb, 0x8e, 0x8c, 0x1f, 0x3d, 0x10, 0xd2, 0x9d, 0xae, 0xdc, 0x38, 0xbe, 0x50, 0x38,
+	0x9a, 0x95, 0x3b, 0xba, 0x49, 0xc6, 0xc3, 0x39, 0x3a, 0x38, 0x58, 0x5f, 0x11, 0xee, 0x93, 0xad,
+	0x1a, 0x32, 0x16, 0x36, 0xee, 0xe0, 0xae, 0x54, 0xc6, 0x8f, 0xad, 0x13, 0xde, 0x1e, 0xca, 0xbd,
+	0x8d, 0x92, 0x4c, 0xf8, 0xa7, 0xa5, 0x17, 0x78, 0xd3, 0x49, 0xed, 0xd3, 0x4e, 0x1c, 0x6d, 0xed,
+	0xc4, 0xd1, 0x8f, 0x9d, 0x38, 0x7a, 0xbd, 0x1b, 0x6f, 0xdb, 0xda, 0x8d, 0xb7, 0x7d, 0xdb, 0x8d,
+	0xb7, 0x3d, 0x13, 0xdf, 0x4e, 0x6a, 0x2e, 0x68, 0xb6, 0xa3, 0x8b, 0xf3, 0xe6, 0x3b, 0xd9, 0xff,
+	0x15, 0x23, 0x7f, 0x06, 0x00, 0x4a, 0xc8, 0xa8, 0x80, 0x18, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -441,6 +773,12 @@ type QueryClient interface {
 	GranterGrants(ctx context.Context, in *QueryGranterGrantsRequest, opts ...grpc.CallOption) (*QueryGranterGrantsResponse, error)
 	// GranteeGrants returns a list of `GrantAuthorization` by grantee.
 	GranteeGrants(ctx context.Context, in *QueryGranteeGrantsRequest, opts ...grpc.CallOption) (*QueryGranteeGrantsResponse, error)
+	// ScheduledExec returns a scheduled execution by its id.
+	ScheduledExec(ctx context.Context, in *QueryScheduledExecRequest, opts ...grpc.CallOption) (*QueryScheduledExecResponse, error)
+	// GranterScheduledExecs returns the scheduled executions funded by a granter.
+	GranterScheduledExecs(ctx context.Context, in *QueryGranterScheduledExecsRequest, opts ...grpc.CallOption) (*QueryGranterScheduledExecsResponse, error)
+	// ScheduledExecHistory returns the latest results of the executions of a scheduled execution.
+	ScheduledExecHistory(ctx context.Context, in *QueryScheduledExecHistoryRequest, opts ...grpc.CallOption) (*QueryScheduledExecHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -478,6 +816,33 @@ func (c *queryClient) GranteeGrants(ctx context.Context, in *QueryGranteeGrantsR
 	return out, nil
 }
 
+func (c *queryClient) ScheduledExec(ctx context.Context, in *QueryScheduledExecRequest, opts ...grpc.CallOption) (*QueryScheduledExecResponse, error) {
+	out := new(QueryScheduledExecResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Query/ScheduledExec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GranterScheduledExecs(ctx context.Context, in *QueryGranterScheduledExecsRequest, opts ...grpc.CallOption) (*QueryGranterScheduledExecsResponse, error) {
+	out := new(QueryGranterScheduledExecsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Query/GranterScheduledExecs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledExecHistory(ctx context.Context, in *QueryScheduledExecHistoryRequest, opts ...grpc.CallOption) (*QueryScheduledExecHistoryResponse, error) {
+	out := new(QueryScheduledExecHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Query/ScheduledExecHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Returns list of `Authorization`, granted to the grantee by the granter.
@@ -486,6 +851,12 @@ type QueryServer interface {
 	GranterGrants(context.Context, *QueryGranterGrantsRequest) (*QueryGranterGrantsResponse, error)
 	// GranteeGrants returns a list of `GrantAuthorization` by grantee.
 	GranteeGrants(context.Context, *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error)
+	// ScheduledExec returns a scheduled execution by its id.
+	ScheduledExec(context.Context, *QueryScheduledExecRequest) (*QueryScheduledExecResponse, error)
+	// GranterScheduledExecs returns the scheduled executions funded by a granter.
+	GranterScheduledExecs(context.Context, *QueryGranterScheduledExecsRequest) (*QueryGranterScheduledExecsResponse, error)
+	// ScheduledExecHistory returns the latest results of the executions of a scheduled execution.
+	ScheduledExecHistory(context.Context, *QueryScheduledExecHistoryRequest) (*QueryScheduledExecHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -501,6 +872,15 @@ func (*UnimplementedQueryServer) GranterGrants(ctx context.Context, req *QueryGr
 func (*UnimplementedQueryServer) GranteeGrants(ctx context.Context, req *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GranteeGrants not implemented")
 }
+func (*UnimplementedQueryServer) ScheduledExec(ctx context.Context, req *QueryScheduledExecRequest) (*QueryScheduledExecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledExec not implemented")
+}
+func (*UnimplementedQueryServer) GranterScheduledExecs(ctx context.Context, req *QueryGranterScheduledExecsRequest) (*QueryGranterScheduledExecsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GranterScheduledExecs not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledExecHistory(ctx context.Context, req *QueryScheduledExecHistoryRequest) (*QueryScheduledExecHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledExecHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -560,6 +940,60 @@ func _Query_GranteeGrants_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ScheduledExec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledExecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledExec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Query/ScheduledExec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledExec(ctx, req.(*QueryScheduledExecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GranterScheduledExecs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGranterScheduledExecsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GranterScheduledExecs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Query/GranterScheduledExecs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GranterScheduledExecs(ctx, req.(*QueryGranterScheduledExecsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledExecHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledExecHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledExecHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Query/ScheduledExecHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledExecHistory(ctx, req.(*QueryScheduledExecHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.authz.v1beta1.Query",
@@ -577,6 +1011,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GranteeGrants",
 			Handler:    _Query_GranteeGrants_Handler,
 		},
+		{
+			MethodName: "ScheduledExec",
+			Handler:    _Query_ScheduledExec_Handler,
+		},
+		{
+			MethodName: "GranterScheduledExecs",
+			Handler:    _Query_GranterScheduledExecs_Handler,
+		},
+		{
+			MethodName: "ScheduledExecHistory",
+			Handler:    _Query_ScheduledExecHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/query.proto",
@@ -869,8 +1315,251 @@ func (m *QueryGranteeGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
+func (m *QueryScheduledExecRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledExecRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledExecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledExecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledExecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScheduledExec != nil {
+		{
+			size, err := m.ScheduledExec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGranterScheduledExecsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGranterScheduledExecsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGranterScheduledExecsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGranterScheduledExecsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGranterScheduledExecsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGranterScheduledExecsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScheduledExecs) > 0 {
+		for iNdEx := len(m.ScheduledExecs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledExecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledExecHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledExecHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledExecHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledExecHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledExecHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledExecHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
 	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -953,56 +1642,692 @@ func (m *QueryGranterGrantsResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryGranteeGrantsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGranteeGrantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGranteeGrantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledExecRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryScheduledExecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduledExec != nil {
+		l = m.ScheduledExec.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGranterScheduledExecsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGranterScheduledExecsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScheduledExecs) > 0 {
+		for _, e := range m.ScheduledExecs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledExecHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledExecHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGrantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGrantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGrantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGrantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, &Grant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGranterGrantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGranterGrantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGranterGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGranterGrantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGranterGrantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGranterGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, &GrantAuthorization{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryGranteeGrantsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Grants) > 0 {
-		for _, e := range m.Grants {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryGrantsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGranteeGrantsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1025,15 +2350,15 @@ func (m *QueryGrantsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGrantsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGranteeGrantsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGranteeGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1061,13 +2386,13 @@ func (m *QueryGrantsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1077,29 +2402,83 @@ func (m *QueryGrantsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGranteeGrantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGranteeGrantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGranteeGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1109,25 +2488,27 @@ func (m *QueryGrantsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			m.Grants = append(m.Grants, &GrantAuthorization{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1157,7 +2538,7 @@ func (m *QueryGrantsRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1184,7 +2565,7 @@ func (m *QueryGrantsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGrantsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledExecRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1207,17 +2588,17 @@ func (m *QueryGrantsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGrantsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledExecRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledExecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1227,29 +2608,64 @@ func (m *QueryGrantsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grants = append(m.Grants, &Grant{})
-			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledExecResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 2:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledExecResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledExec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1276,10 +2692,10 @@ func (m *QueryGrantsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.ScheduledExec == nil {
+				m.ScheduledExec = &ScheduledExec{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScheduledExec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1304,7 +2720,7 @@ func (m *QueryGrantsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGranterGrantsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGranterScheduledExecsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1327,10 +2743,10 @@ func (m *QueryGranterGrantsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGranterGrantsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGranterScheduledExecsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGranterGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGranterScheduledExecsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1422,7 +2838,7 @@ func (m *QueryGranterGrantsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGranterGrantsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGranterScheduledExecsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1445,15 +2861,15 @@ func (m *QueryGranterGrantsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGranterGrantsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGranterScheduledExecsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGranterGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGranterScheduledExecsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledExecs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1480,8 +2896,8 @@ func (m *QueryGranterGrantsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grants = append(m.Grants, &GrantAuthorization{})
-			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ScheduledExecs = append(m.ScheduledExecs, &ScheduledExec{})
+			if err := m.ScheduledExecs[len(m.ScheduledExecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1542,7 +2958,7 @@ func (m *QueryGranterGrantsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGranteeGrantsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledExecHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1565,17 +2981,17 @@ func (m *QueryGranteeGrantsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGranteeGrantsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledExecHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGranteeGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledExecHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1585,24 +3001,11 @@ func (m *QueryGranteeGrantsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -1660,7 +3063,7 @@ func (m *QueryGranteeGrantsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGranteeGrantsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledExecHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1683,15 +3086,15 @@ func (m *QueryGranteeGrantsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGranteeGrantsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledExecHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGranteeGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledExecHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1718,8 +3121,8 @@ func (m *QueryGranteeGrantsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grants = append(m.Grants, &GrantAuthorization{})
-			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, &ScheduledExecResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
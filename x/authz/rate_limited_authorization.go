@@ -0,0 +1,57 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/authz"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewRateLimitedAuthorization creates a new RateLimitedAuthorization object.
+// A zero maxExecutions or period means no limit.
+func NewRateLimitedAuthorization(msgTypeURL string, maxExecutions uint64, period time.Duration) *RateLimitedAuthorization {
+	return &RateLimitedAuthorization{
+		Msg: msgTypeURL,
+		Limit: ExecutionLimit{
+			RemainingExecutions: maxExecutions,
+			Period:              period,
+		},
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a RateLimitedAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept.
+func (a RateLimitedAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	if sdk.MsgTypeURL(msg) != a.Msg {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	limit, last, err := a.Limit.accept(ctx)
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	if last {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Updated: &RateLimitedAuthorization{Msg: a.Msg, Limit: limit}}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a RateLimitedAuthorization) ValidateBasic() error {
+	if a.Msg == "" {
+		return errors.New("msg type cannot be empty")
+	}
+	if a.Limit.RemainingExecutions == 0 && a.Limit.Period == 0 {
+		return errors.New("max executions or period must be set")
+	}
+	return a.Limit.ValidateBasic()
+}
@@ -0,0 +1,69 @@
+package authz_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	corecontext "cosmossdk.io/core/context"
+	coreheader "cosmossdk.io/core/header"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/authz"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type headerService struct{}
+
+func (h headerService) HeaderInfo(ctx context.Context) coreheader.Info {
+	return sdk.UnwrapSDKContext(ctx).HeaderInfo()
+}
+
+// authzContext returns a context at the provided time, with the environment set as in Keeper.DispatchActions.
+func authzContext(t *testing.T, now time.Time) context.Context {
+	t.Helper()
+	sdkCtx := testutil.DefaultContextWithDB(t, storetypes.NewKVStoreKey(authz.ModuleName), storetypes.NewTransientStoreKey("transient_test")).Ctx.WithHeaderInfo(coreheader.Info{Time: now})
+	return context.WithValue(sdkCtx, corecontext.EnvironmentContextKey, appmodulev2.Environment{
+		HeaderService: headerService{},
+	})
+}
+
+func TestRateLimitedAuthorization(t *testing.T) {
+	msgType := banktypes.SendAuthorization{}.MsgTypeURL()
+	require.Error(t, authz.NewRateLimitedAuthorization("", 1, 0).ValidateBasic())
+	require.Error(t, authz.NewRateLimitedAuthorization(msgType, 0, 0).ValidateBasic())
+	require.Error(t, authz.NewRateLimitedAuthorization(msgType, 0, -time.Hour).ValidateBasic())
+
+	a := authz.NewRateLimitedAuthorization(msgType, 2, time.Hour)
+	require.NoError(t, a.ValidateBasic())
+	require.Equal(t, msgType, a.MsgTypeURL())
+
+	now := time.Now().UTC()
+	send := &banktypes.MsgSend{}
+	_, err := a.Accept(authzContext(t, now), &banktypes.MsgMultiSend{})
+	require.ErrorContains(t, err, "type mismatch")
+
+	t.Log("verify the first execution is accepted and tracked")
+	resp, err := a.Accept(authzContext(t, now), send)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	updated := resp.Updated.(*authz.RateLimitedAuthorization)
+	require.Equal(t, uint64(1), updated.Limit.RemainingExecutions)
+	require.Equal(t, now, *updated.Limit.LastExecution)
+
+	t.Log("verify an execution within the period is rejected")
+	_, err = updated.Accept(authzContext(t, now.Add(time.Minute)), send)
+	require.ErrorContains(t, err, "next execution is allowed at")
+
+	t.Log("verify the authorization is deleted after the last execution")
+	resp, err = updated.Accept(authzContext(t, now.Add(time.Hour)), send)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+}
@@ -0,0 +1,117 @@
+package authz
+
+import (
+	"errors"
+
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MaxScheduledExecGasLimit is the maximum gas limit of an execution of a scheduled execution.
+	MaxScheduledExecGasLimit = uint64(10_000_000)
+	// MaxScheduledExecHistory is the number of execution results kept per scheduled execution.
+	MaxScheduledExecHistory = uint64(100)
+	// MaxScheduledExecsBlockGas is the maximum total gas of the height scheduled executions
+	// executed in a block.
+	MaxScheduledExecsBlockGas = uint64(100_000_000)
+	// MaxEpochScheduledExecsGas is the maximum total gas of the epoch scheduled executions
+	// executed at the start of an epoch, per epoch identifier.
+	MaxEpochScheduledExecsGas = uint64(100_000_000)
+	// ScheduledExecBaseGas is the gas counted for an execution on top of its gas limit against
+	// the maximum total gas above, accounting for the funding and the recording of the execution.
+	ScheduledExecBaseGas = uint64(100_000)
+)
+
+var _ gogoprotoany.UnpackInterfacesMessage = ScheduledExec{}
+
+// BlockGas returns the gas counted for an execution of the scheduled execution against the
+// maximum total gas of the executions of a block or an epoch start.
+func (s ScheduledExec) BlockGas() uint64 {
+	return s.GasLimit + ScheduledExecBaseGas
+}
+
+// NewScheduledExec creates a new ScheduledExec
+func NewScheduledExec(id uint64, granter string, msgs []sdk.Msg, schedule ExecSchedule, gasLimit uint64, fee sdk.Coins) ScheduledExec {
+	return ScheduledExec{
+		Id:       id,
+		Granter:  granter,
+		Msgs:     packMessages(msgs),
+		Schedule: schedule,
+		GasLimit: gasLimit,
+		Fee:      fee,
+	}
+}
+
+// IsEpochSchedule returns true if the msgs are executed at the start of epochs
+// instead of block heights.
+func (s ExecSchedule) IsEpochSchedule() bool {
+	return s.EpochIdentifier != ""
+}
+
+// ValidateBasic performs a stateless validation of the schedule.
+func (s ExecSchedule) ValidateBasic() error {
+	if s.Next < 0 {
+		return errors.New("next execution cannot be negative")
+	}
+	if !s.IsEpochSchedule() && s.Next == 0 {
+		return errors.New("next execution height must be set")
+	}
+	if s.Interval < 0 {
+		return errors.New("interval cannot be negative")
+	}
+	return nil
+}
+
+// Advance returns the schedule of the execution following the one at current, which
+// is the block height or the epoch number of the execution, along with whether the
+// schedule ended.
+func (s ExecSchedule) Advance(current int64, executions uint64) (ExecSchedule, bool) {
+	if s.Interval == 0 || (s.MaxExecutions != 0 && executions >= s.MaxExecutions) {
+		return s, true
+	}
+
+	s.Next = current + s.Interval
+	return s, false
+}
+
+// GetMessages returns the cache values from the ScheduledExec.Msgs if present.
+func (s ScheduledExec) GetMessages() ([]sdk.Msg, error) {
+	return getMessages(s.Msgs)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (s ScheduledExec) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	return unpackMessages(unpacker, s.Msgs)
+}
+
+// ValidateBasic performs a stateless validation of the scheduled execution.
+func (s ScheduledExec) ValidateBasic() error {
+	if s.Granter == "" {
+		return errors.New("missing granter")
+	}
+	if len(s.Msgs) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("messages cannot be empty")
+	}
+	if err := validateExecParams(s.Schedule, s.GasLimit, s.Fee); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateExecParams validates the execution parameters shared by MsgScheduleExec
+// and ScheduledExec.
+func validateExecParams(schedule ExecSchedule, gasLimit uint64, fee sdk.Coins) error {
+	if err := schedule.ValidateBasic(); err != nil {
+		return ErrInvalidSchedule.Wrap(err.Error())
+	}
+	if gasLimit == 0 || gasLimit > MaxScheduledExecGasLimit {
+		return ErrInvalidSchedule.Wrapf("gas limit must be between 1 and %d", MaxScheduledExecGasLimit)
+	}
+	if err := fee.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrap(err.Error())
+	}
+	return nil
+}
@@ -27,3 +27,27 @@ func (k MockBankKeeper) UpdateParams(ctx context.Context, req *bank.MsgUpdatePar
 func (k MockBankKeeper) SetSendEnabled(ctx context.Context, req *bank.MsgSetSendEnabled) (*bank.MsgSetSendEnabledResponse, error) {
 	return nil, nil
 }
+
+func (k MockBankKeeper) CreateDenom(ctx context.Context, req *bank.MsgCreateDenom) (*bank.MsgCreateDenomResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) MintDenom(ctx context.Context, req *bank.MsgMintDenom) (*bank.MsgMintDenomResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) BurnDenom(ctx context.Context, req *bank.MsgBurnDenom) (*bank.MsgBurnDenomResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) ChangeDenomAdmin(ctx context.Context, req *bank.MsgChangeDenomAdmin) (*bank.MsgChangeDenomAdminResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) SetDenomMetadata(ctx context.Context, req *bank.MsgSetDenomMetadata) (*bank.MsgSetDenomMetadataResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) SetDenomSendHook(ctx context.Context, req *bank.MsgSetDenomSendHook) (*bank.MsgSetDenomSendHookResponse, error) {
+	return nil, nil
+}
@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -420,6 +422,171 @@ func (m *MsgPruneExpiredGrantsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPruneExpiredGrantsResponse proto.InternalMessageInfo
 
+// MsgScheduleExec schedules the execution of the provided messages by the x/authz
+// module. The messages are executed with the authorizations granted by the granter
+// to the x/authz module account, which must exist at each execution.
+type MsgScheduleExec struct {
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// msgs to execute, all with the granter as single signer.
+	Msgs []*any.Any `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// schedule of the executions.
+	Schedule ExecSchedule `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule"`
+	// gas_limit is the maximum amount of gas consumed by an execution.
+	GasLimit uint64 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// fee paid by the granter to the fee collector for each execution.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+}
+
+func (m *MsgScheduleExec) Reset()         { *m = MsgScheduleExec{} }
+func (m *MsgScheduleExec) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleExec) ProtoMessage()    {}
+func (*MsgScheduleExec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{10}
+}
+func (m *MsgScheduleExec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleExec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleExec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleExec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleExec.Merge(m, src)
+}
+func (m *MsgScheduleExec) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleExec) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleExec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleExec proto.InternalMessageInfo
+
+// MsgScheduleExecResponse defines the Msg/ScheduleExec response type.
+type MsgScheduleExecResponse struct {
+	// id of the scheduled execution.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgScheduleExecResponse) Reset()         { *m = MsgScheduleExecResponse{} }
+func (m *MsgScheduleExecResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleExecResponse) ProtoMessage()    {}
+func (*MsgScheduleExecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{11}
+}
+func (m *MsgScheduleExecResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleExecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleExecResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleExecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleExecResponse.Merge(m, src)
+}
+func (m *MsgScheduleExecResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleExecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleExecResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleExecResponse proto.InternalMessageInfo
+
+// MsgCancelScheduledExec cancels a scheduled execution.
+type MsgCancelScheduledExec struct {
+	// granter of the scheduled execution.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// id of the scheduled execution.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelScheduledExec) Reset()         { *m = MsgCancelScheduledExec{} }
+func (m *MsgCancelScheduledExec) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledExec) ProtoMessage()    {}
+func (*MsgCancelScheduledExec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{12}
+}
+func (m *MsgCancelScheduledExec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledExec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledExec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledExec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledExec.Merge(m, src)
+}
+func (m *MsgCancelScheduledExec) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledExec) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledExec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledExec proto.InternalMessageInfo
+
+// MsgCancelScheduledExecResponse defines the Msg/CancelScheduledExec response type.
+type MsgCancelScheduledExecResponse struct {
+}
+
+func (m *MsgCancelScheduledExecResponse) Reset()         { *m = MsgCancelScheduledExecResponse{} }
+func (m *MsgCancelScheduledExecResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledExecResponse) ProtoMessage()    {}
+func (*MsgCancelScheduledExecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{13}
+}
+func (m *MsgCancelScheduledExecResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledExecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledExecResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledExecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledExecResponse.Merge(m, src)
+}
+func (m *MsgCancelScheduledExecResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledExecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledExecResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledExecResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgGrant)(nil), "cosmos.authz.v1beta1.MsgGrant")
 	proto.RegisterType((*MsgGrantResponse)(nil), "cosmos.authz.v1beta1.MsgGrantResponse")
@@ -431,55 +598,76 @@ func init() {
 	proto.RegisterType((*MsgRevokeAllResponse)(nil), "cosmos.authz.v1beta1.MsgRevokeAllResponse")
 	proto.RegisterType((*MsgPruneExpiredGrants)(nil), "cosmos.authz.v1beta1.MsgPruneExpiredGrants")
 	proto.RegisterType((*MsgPruneExpiredGrantsResponse)(nil), "cosmos.authz.v1beta1.MsgPruneExpiredGrantsResponse")
+	proto.RegisterType((*MsgScheduleExec)(nil), "cosmos.authz.v1beta1.MsgScheduleExec")
+	proto.RegisterType((*MsgScheduleExecResponse)(nil), "cosmos.authz.v1beta1.MsgScheduleExecResponse")
+	proto.RegisterType((*MsgCancelScheduledExec)(nil), "cosmos.authz.v1beta1.MsgCancelScheduledExec")
+	proto.RegisterType((*MsgCancelScheduledExecResponse)(nil), "cosmos.authz.v1beta1.MsgCancelScheduledExecResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/tx.proto", fileDescriptor_3ceddab7d8589ad1) }
 
 var fileDescriptor_3ceddab7d8589ad1 = []byte{
-	// 687 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xcf, 0x35, 0xfd, 0x43, 0xae, 0x15, 0xa5, 0x4e, 0x50, 0x5d, 0x57, 0x71, 0x2d, 0x43, 0x21,
-	0x4a, 0x95, 0x73, 0x92, 0x8a, 0x25, 0xb0, 0x24, 0x52, 0xc5, 0x42, 0x04, 0x32, 0xb0, 0xb0, 0x54,
-	0x49, 0x73, 0x5c, 0xa3, 0x3a, 0xb6, 0xe5, 0x73, 0xa2, 0x84, 0x05, 0xc4, 0x08, 0x0b, 0x1f, 0x03,
-	0xb6, 0x0e, 0x19, 0xf9, 0x00, 0x55, 0xa7, 0xaa, 0x03, 0x62, 0x42, 0xd0, 0x0e, 0xfd, 0x18, 0x20,
-	0xdf, 0x9d, 0xdd, 0x7f, 0x6e, 0x52, 0x18, 0x58, 0xda, 0xf7, 0xe7, 0xf7, 0xde, 0xfd, 0x5e, 0xde,
-	0xef, 0x19, 0x66, 0xb7, 0x1c, 0xda, 0x71, 0xa8, 0xd1, 0xe8, 0xfa, 0xdb, 0x6f, 0x8c, 0x5e, 0xa9,
-	0x89, 0xfd, 0x46, 0xc9, 0xf0, 0xfb, 0xc8, 0xf5, 0x1c, 0xdf, 0x91, 0x32, 0x3c, 0x8d, 0x58, 0x1a,
-	0x89, 0xb4, 0xb2, 0xc4, 0xa3, 0x9b, 0x0c, 0x63, 0x08, 0x08, 0x73, 0x94, 0x0c, 0x71, 0x88, 0xc3,
-	0xe3, 0x81, 0x25, 0xa2, 0x4b, 0xc4, 0x71, 0x88, 0x85, 0x0d, 0xe6, 0x35, 0xbb, 0xaf, 0x8d, 0x86,
-	0x3d, 0x10, 0x29, 0x2d, 0x96, 0x00, 0x7f, 0x8f, 0x23, 0x16, 0x05, 0xa2, 0x43, 0x89, 0xd1, 0x2b,
-	0x05, 0xff, 0x44, 0x62, 0xa1, 0xd1, 0x69, 0xdb, 0x8e, 0xc1, 0xfe, 0xf2, 0x90, 0xfe, 0x0d, 0xc0,
-	0x1b, 0x75, 0x4a, 0x1e, 0x7b, 0x0d, 0xdb, 0x97, 0xca, 0x70, 0x86, 0x04, 0x06, 0xf6, 0x64, 0xa0,
-	0x81, 0x5c, 0xaa, 0x26, 0x1f, 0x0e, 0x0b, 0xe1, 0x44, 0xd5, 0x56, 0xcb, 0xc3, 0x94, 0x3e, 0xf7,
-	0xbd, 0xb6, 0x4d, 0xcc, 0x10, 0x78, 0x5a, 0x83, 0xe5, 0x89, 0xeb, 0xd5, 0x60, 0xe9, 0x11, 0x9c,
-	0x62, 0xa6, 0x9c, 0xd4, 0x40, 0x6e, 0xb6, 0xbc, 0x8c, 0xe2, 0x7e, 0x34, 0xc4, 0x38, 0xd5, 0x52,
-	0x7b, 0x3f, 0x56, 0x12, 0x9f, 0x4f, 0x76, 0xf3, 0xc0, 0xe4, 0x45, 0x95, 0xbb, 0xef, 0x4f, 0x76,
-	0xf3, 0xe1, 0xfb, 0x1f, 0x4e, 0x76, 0xf3, 0x69, 0x5e, 0x5e, 0xa0, 0xad, 0x1d, 0x23, 0x9c, 0x45,
-	0x97, 0xe0, 0xad, 0xd0, 0x36, 0x31, 0x75, 0x1d, 0x9b, 0x62, 0xfd, 0x0b, 0x80, 0x33, 0x75, 0x4a,
-	0x36, 0xfa, 0x78, 0xeb, 0x2c, 0x6f, 0x70, 0x5d, 0xde, 0x1b, 0x70, 0xb2, 0x43, 0x09, 0x95, 0x27,
-	0xb4, 0x64, 0x6e, 0xb6, 0x9c, 0x41, 0x7c, 0x49, 0x28, 0x5c, 0x12, 0xaa, 0xda, 0x83, 0xda, 0xf2,
-	0xfe, 0xb0, 0x20, 0x16, 0x80, 0x9a, 0x0d, 0x8a, 0xa3, 0x71, 0xea, 0x94, 0x98, 0xac, 0xbc, 0x72,
-	0xe7, 0xcc, 0x00, 0x38, 0x18, 0x40, 0x3a, 0x3f, 0x40, 0xc0, 0x4f, 0x5f, 0x83, 0xf3, 0xc2, 0x0c,
-	0xe9, 0x4b, 0x32, 0x9c, 0xf1, 0x30, 0xed, 0x5a, 0x3e, 0x95, 0x81, 0x96, 0xcc, 0xcd, 0x99, 0xa1,
-	0xab, 0x7f, 0x05, 0x30, 0x15, 0xf4, 0xc7, 0x3d, 0x67, 0x07, 0xff, 0xb7, 0x35, 0x6a, 0x70, 0xae,
-	0x43, 0xc9, 0xa6, 0x3f, 0x70, 0xf1, 0x66, 0xd7, 0xb3, 0xd8, 0x36, 0x53, 0x26, 0xec, 0x50, 0xf2,
-	0x62, 0xe0, 0xe2, 0x97, 0x9e, 0x55, 0x59, 0xbd, 0xb8, 0xaa, 0xcc, 0xf9, 0x49, 0x39, 0x61, 0x3d,
-	0x0d, 0x17, 0x22, 0x27, 0x5a, 0xd6, 0x5b, 0x38, 0x17, 0x05, 0xab, 0x96, 0xf5, 0x2f, 0x53, 0x55,
-	0x1e, 0x1e, 0x0e, 0x0b, 0xf3, 0xa7, 0x4f, 0x6a, 0x45, 0xf4, 0xa0, 0x74, 0x91, 0xd2, 0x62, 0x1c,
-	0xa5, 0xaa, 0x65, 0xe9, 0x6b, 0x30, 0x73, 0xd6, 0x0f, 0x89, 0x55, 0xd2, 0x31, 0x4d, 0xf5, 0x6d,
-	0x78, 0xbb, 0x4e, 0xc9, 0x33, 0xaf, 0x6b, 0xe3, 0x8d, 0xbe, 0xdb, 0xf6, 0x70, 0x8b, 0x49, 0x8f,
-	0x4a, 0x45, 0x38, 0xed, 0x06, 0xd1, 0xf1, 0xac, 0x05, 0xae, 0x92, 0x3d, 0x1c, 0x16, 0x6e, 0xf6,
-	0xf9, 0x45, 0x6b, 0xbd, 0x22, 0x2a, 0xa3, 0x62, 0xc0, 0x59, 0xa4, 0xf5, 0x75, 0x98, 0x8d, 0x7d,
-	0x29, 0xe2, 0x27, 0x5d, 0xae, 0x2f, 0xff, 0x4e, 0xc2, 0x64, 0x9d, 0x12, 0xe9, 0x29, 0x9c, 0xe2,
-	0xa7, 0xae, 0xc6, 0xdf, 0x5c, 0x78, 0x32, 0xca, 0xbd, 0xd1, 0xf9, 0x48, 0x93, 0x4f, 0xe0, 0x24,
-	0x3b, 0xa7, 0xec, 0x95, 0xf8, 0x20, 0xad, 0xac, 0x8e, 0x4c, 0x47, 0xdd, 0x4c, 0x38, 0x2d, 0x34,
-	0xbc, 0x72, 0x65, 0x01, 0x07, 0x28, 0xf7, 0xc7, 0x00, 0xa2, 0x9e, 0x2e, 0x4c, 0x9d, 0x8a, 0x48,
-	0x1f, 0x53, 0x55, 0xb5, 0x2c, 0x25, 0x3f, 0x1e, 0x13, 0x89, 0x34, 0xbd, 0x7f, 0x59, 0x0b, 0xd2,
-	0x47, 0x00, 0xa5, 0x18, 0x25, 0xac, 0x5d, 0xd9, 0xf7, 0x32, 0x58, 0x59, 0xff, 0x0b, 0xf0, 0x48,
-	0x36, 0xca, 0xd4, 0xbb, 0xe0, 0xe3, 0x59, 0x2b, 0xef, 0xfd, 0x52, 0x13, 0x7b, 0x47, 0x2a, 0x38,
-	0x38, 0x52, 0xc1, 0xcf, 0x23, 0x15, 0x7c, 0x3a, 0x56, 0x13, 0x07, 0xc7, 0x6a, 0xe2, 0xfb, 0xb1,
-	0x9a, 0x78, 0x25, 0x14, 0x49, 0x5b, 0x3b, 0xa8, 0xed, 0x18, 0x42, 0x3c, 0xcd, 0x69, 0xf6, 0x65,
-	0x5b, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0xb1, 0x34, 0x9d, 0x5f, 0xf4, 0x06, 0x00, 0x00,
+	// 953 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcd, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0x93, 0xf4, 0x23, 0x6f, 0xc3, 0x2e, 0x75, 0x02, 0x4d, 0x5d, 0xd5, 0x35, 0x86, 0x85,
+	0x28, 0x25, 0x76, 0x92, 0xc2, 0x25, 0xf4, 0x92, 0x54, 0x05, 0x21, 0x6d, 0x04, 0xf2, 0xc2, 0x85,
+	0x4b, 0xe4, 0xc4, 0xb3, 0x53, 0xab, 0x8e, 0x1d, 0x79, 0x9c, 0xd2, 0x70, 0x01, 0x71, 0x41, 0x02,
+	0x0e, 0x9c, 0xb9, 0x71, 0xe2, 0xe3, 0xd4, 0x43, 0x8e, 0xfc, 0x01, 0x55, 0x4f, 0xab, 0x1e, 0x10,
+	0x27, 0x3e, 0xda, 0x43, 0xff, 0x0d, 0x34, 0xf6, 0xd8, 0x4d, 0x1a, 0xa7, 0x69, 0x2b, 0xb4, 0x97,
+	0x64, 0x66, 0xde, 0xef, 0xbd, 0xf7, 0x7b, 0xf3, 0xde, 0xfc, 0x0c, 0x1b, 0x5d, 0x87, 0xf4, 0x1c,
+	0xa2, 0xea, 0x03, 0x6f, 0xff, 0x0b, 0xf5, 0xb0, 0xda, 0x41, 0x9e, 0x5e, 0x55, 0xbd, 0x23, 0xa5,
+	0xef, 0x3a, 0x9e, 0xc3, 0xe7, 0x03, 0xb3, 0xe2, 0x9b, 0x15, 0x66, 0x16, 0xd6, 0x82, 0xd3, 0xb6,
+	0x8f, 0x51, 0x19, 0xc4, 0xdf, 0x08, 0x79, 0xec, 0x60, 0x27, 0x38, 0xa7, 0x2b, 0x76, 0xba, 0x86,
+	0x1d, 0x07, 0x5b, 0x48, 0xf5, 0x77, 0x9d, 0xc1, 0x33, 0x55, 0xb7, 0x87, 0xcc, 0x24, 0xc5, 0x12,
+	0x08, 0xf2, 0x05, 0x88, 0x55, 0x86, 0xe8, 0x11, 0xac, 0x1e, 0x56, 0xe9, 0x1f, 0x33, 0xac, 0xe8,
+	0x3d, 0xd3, 0x76, 0x54, 0xff, 0x97, 0x1d, 0x89, 0x0c, 0xdb, 0xd1, 0x09, 0x8a, 0x82, 0x75, 0x1d,
+	0xd3, 0x0e, 0xec, 0xf2, 0x1f, 0x1c, 0x2c, 0xb7, 0x08, 0xfe, 0xc0, 0xd5, 0x6d, 0x8f, 0xaf, 0xc1,
+	0x12, 0xa6, 0x0b, 0xe4, 0x16, 0x38, 0x89, 0x2b, 0x66, 0x9a, 0x85, 0xb3, 0x51, 0x39, 0xac, 0xb8,
+	0x61, 0x18, 0x2e, 0x22, 0xe4, 0xa9, 0xe7, 0x9a, 0x36, 0xd6, 0x42, 0xe0, 0x95, 0x0f, 0x2a, 0x24,
+	0x6f, 0xe7, 0x83, 0xf8, 0x1d, 0x58, 0xf0, 0x97, 0x85, 0x94, 0xc4, 0x15, 0x1f, 0xd4, 0xd6, 0x95,
+	0xb8, 0x4b, 0x55, 0x7c, 0x4e, 0xcd, 0xcc, 0xc9, 0x5f, 0x9b, 0x89, 0x5f, 0x2e, 0x8f, 0x4b, 0x9c,
+	0x16, 0x38, 0xd5, 0xdf, 0xf8, 0xfa, 0xf2, 0xb8, 0x14, 0xe6, 0xff, 0xf6, 0xf2, 0xb8, 0x94, 0x0b,
+	0xdc, 0xcb, 0xc4, 0x38, 0x50, 0xc3, 0x5a, 0x64, 0x1e, 0x5e, 0x0e, 0xd7, 0x1a, 0x22, 0x7d, 0xc7,
+	0x26, 0x48, 0xfe, 0x95, 0x83, 0xa5, 0x16, 0xc1, 0x7b, 0x47, 0xa8, 0x3b, 0xce, 0x9b, 0xbb, 0x2d,
+	0xef, 0x3d, 0x48, 0xf7, 0x08, 0x26, 0x85, 0xa4, 0x94, 0x2a, 0x3e, 0xa8, 0xe5, 0x95, 0xa0, 0x89,
+	0x4a, 0xd8, 0x44, 0xa5, 0x61, 0x0f, 0x9b, 0xeb, 0xa7, 0xa3, 0x32, 0x6b, 0x90, 0x42, 0x2f, 0x3d,
+	0x2a, 0xa7, 0x45, 0xb0, 0xe6, 0xbb, 0xd7, 0x5f, 0x1f, 0x2b, 0x00, 0xd1, 0x02, 0xf8, 0xc9, 0x02,
+	0x28, 0x3f, 0x79, 0x0b, 0x1e, 0xb1, 0x65, 0x48, 0x9f, 0x2f, 0xc0, 0x92, 0x8b, 0xc8, 0xc0, 0xf2,
+	0x48, 0x81, 0x93, 0x52, 0xc5, 0xac, 0x16, 0x6e, 0xe5, 0xdf, 0x39, 0xc8, 0xd0, 0xf8, 0xe8, 0xd0,
+	0x39, 0x40, 0x2f, 0xac, 0x8d, 0x12, 0x64, 0x7b, 0x04, 0xb7, 0xbd, 0x61, 0x1f, 0xb5, 0x07, 0xae,
+	0xe5, 0x77, 0x33, 0xa3, 0x41, 0x8f, 0xe0, 0x4f, 0x86, 0x7d, 0xf4, 0xa9, 0x6b, 0xd5, 0x1f, 0x5f,
+	0x6f, 0x55, 0x7e, 0xb2, 0xd2, 0x80, 0xb0, 0x9c, 0x83, 0x95, 0x68, 0x13, 0x35, 0xeb, 0x4b, 0xc8,
+	0x46, 0x87, 0x0d, 0xcb, 0xba, 0x4f, 0x55, 0xf5, 0xf7, 0xce, 0x46, 0xe5, 0x47, 0x57, 0x29, 0xa5,
+	0x8a, 0xf2, 0x6e, 0xf5, 0x3a, 0xa5, 0xd5, 0x38, 0x4a, 0x0d, 0xcb, 0x92, 0xb7, 0x20, 0x3f, 0xbe,
+	0x0f, 0x89, 0xd5, 0x73, 0x31, 0x41, 0xe5, 0x7d, 0x78, 0xa5, 0x45, 0xf0, 0xc7, 0xee, 0xc0, 0x46,
+	0x7b, 0x47, 0x7d, 0xd3, 0x45, 0x86, 0x3f, 0x7a, 0x84, 0xaf, 0xc0, 0x62, 0x9f, 0x9e, 0xce, 0x67,
+	0xcd, 0x70, 0xf5, 0x8d, 0xb3, 0x51, 0xf9, 0xe1, 0x51, 0xf0, 0xe2, 0xa5, 0xc3, 0x8a, 0x52, 0x53,
+	0x2a, 0x94, 0x33, 0x33, 0xcb, 0xdb, 0xb0, 0x11, 0x9b, 0x29, 0xe2, 0xc7, 0x4f, 0xfb, 0xcb, 0x3f,
+	0xa7, 0xfc, 0x71, 0x7a, 0xda, 0xdd, 0x47, 0xc6, 0xc0, 0x42, 0x93, 0x2f, 0xe0, 0x0e, 0x63, 0xf2,
+	0xff, 0xbc, 0x00, 0xfe, 0x43, 0x58, 0x26, 0x8c, 0x0a, 0xd3, 0x00, 0x39, 0x5e, 0x03, 0x28, 0xd1,
+	0x90, 0xf4, 0xb8, 0x14, 0x44, 0xee, 0xfc, 0x3a, 0x64, 0xb0, 0x4e, 0xda, 0x96, 0xd9, 0x33, 0xbd,
+	0x42, 0x5a, 0xe2, 0x8a, 0x69, 0x6d, 0x19, 0xeb, 0xe4, 0x09, 0xdd, 0xf3, 0x04, 0x52, 0xcf, 0x10,
+	0x2a, 0x2c, 0xf8, 0x6c, 0xd7, 0x94, 0x38, 0x52, 0xbb, 0x8e, 0x69, 0x37, 0xdf, 0xa7, 0x91, 0x7f,
+	0xfb, 0x7b, 0xb3, 0x88, 0x4d, 0x6f, 0x7f, 0xd0, 0x51, 0xba, 0x4e, 0x8f, 0xa9, 0xb8, 0x3a, 0x36,
+	0x1d, 0x74, 0xd0, 0x89, 0xef, 0x40, 0x7e, 0xbc, 0x3c, 0x2e, 0x65, 0x2d, 0x84, 0xf5, 0xee, 0xb0,
+	0x4d, 0xd5, 0x94, 0x04, 0xb4, 0x68, 0xb6, 0xfa, 0xce, 0xd9, 0xa8, 0xfc, 0x52, 0x78, 0xff, 0x55,
+	0xa5, 0x12, 0xb4, 0x6f, 0x7c, 0xe4, 0x84, 0xc9, 0x91, 0x1b, 0xef, 0x8a, 0xbc, 0x03, 0xab, 0xd7,
+	0x8e, 0xa2, 0xf7, 0xff, 0x10, 0x92, 0xa6, 0xe1, 0xf7, 0x2a, 0xad, 0x25, 0x4d, 0xa3, 0xbe, 0x32,
+	0x95, 0x48, 0xfe, 0x89, 0x83, 0x57, 0x5b, 0x04, 0xef, 0xea, 0x76, 0x17, 0x59, 0x61, 0x10, 0xe3,
+	0xde, 0xed, 0x0e, 0x32, 0x26, 0xa3, 0x8c, 0xbb, 0x73, 0x4b, 0x7b, 0x6d, 0xb2, 0xb4, 0x18, 0x22,
+	0xf2, 0x36, 0x88, 0xf1, 0x96, 0x68, 0x82, 0xa7, 0x0b, 0xab, 0x7d, 0xb3, 0x08, 0xa9, 0x16, 0xc1,
+	0xfc, 0x47, 0xb0, 0x10, 0x7c, 0xab, 0xc4, 0xf8, 0x81, 0x09, 0x35, 0x5f, 0x78, 0xf3, 0x66, 0x7b,
+	0x74, 0xa9, 0x4f, 0x20, 0xed, 0x5f, 0xcf, 0xc6, 0x4c, 0x3c, 0x35, 0x0b, 0x8f, 0x6f, 0x34, 0x47,
+	0xd1, 0x34, 0x58, 0x64, 0x22, 0xbc, 0x39, 0xd3, 0x21, 0x00, 0x08, 0x6f, 0xcd, 0x01, 0x44, 0x31,
+	0xfb, 0x90, 0xb9, 0x52, 0x41, 0x79, 0x8e, 0x57, 0xc3, 0xb2, 0x84, 0xd2, 0x7c, 0x4c, 0xa4, 0xb2,
+	0xb9, 0xd3, 0x69, 0x31, 0xe3, 0xbf, 0xe3, 0x80, 0x8f, 0x91, 0xb2, 0xad, 0x99, 0x71, 0xa7, 0xc1,
+	0xc2, 0xf6, 0x1d, 0xc0, 0x37, 0xb3, 0xf9, 0x1c, 0xb2, 0x13, 0xba, 0x35, 0xbb, 0x15, 0xe3, 0x30,
+	0xa1, 0x7c, 0x2b, 0x58, 0x94, 0x7a, 0xe5, 0xf4, 0xfa, 0xcc, 0xf1, 0xdf, 0x73, 0x90, 0x8b, 0x7b,
+	0x49, 0x6f, 0xcf, 0x8c, 0x1c, 0x83, 0x16, 0xde, 0xb9, 0x0b, 0xfa, 0x06, 0x3a, 0xc2, 0xc2, 0x57,
+	0x54, 0x63, 0x9a, 0xb5, 0x93, 0x7f, 0xc5, 0xc4, 0xc9, 0xb9, 0xc8, 0x3d, 0x3f, 0x17, 0xb9, 0x7f,
+	0xce, 0x45, 0xee, 0x87, 0x0b, 0x31, 0xf1, 0xfc, 0x42, 0x4c, 0xfc, 0x79, 0x21, 0x26, 0x3e, 0x63,
+	0x0f, 0x9a, 0x18, 0x07, 0x8a, 0xe9, 0xa8, 0x2c, 0x40, 0x67, 0xd1, 0x17, 0xe8, 0xed, 0xff, 0x06,
+	0x00, 0xe4, 0x0f, 0xc0, 0xfc, 0xdd, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -510,6 +698,12 @@ type MsgClient interface {
 	RevokeAll(ctx context.Context, in *MsgRevokeAll, opts ...grpc.CallOption) (*MsgRevokeAllResponse, error)
 	// PruneExpiredGrants prunes the expired grants. Currently up to 75 at a time.
 	PruneExpiredGrants(ctx context.Context, in *MsgPruneExpiredGrants, opts ...grpc.CallOption) (*MsgPruneExpiredGrantsResponse, error)
+	// ScheduleExec schedules the execution of the provided messages, signed by the
+	// granter, using the authorizations granted to the x/authz module account.
+	// The granter funds the executions.
+	ScheduleExec(ctx context.Context, in *MsgScheduleExec, opts ...grpc.CallOption) (*MsgScheduleExecResponse, error)
+	// CancelScheduledExec cancels a scheduled execution of the granter.
+	CancelScheduledExec(ctx context.Context, in *MsgCancelScheduledExec, opts ...grpc.CallOption) (*MsgCancelScheduledExecResponse, error)
 }
 
 type msgClient struct {
@@ -565,6 +759,24 @@ func (c *msgClient) PruneExpiredGrants(ctx context.Context, in *MsgPruneExpiredG
 	return out, nil
 }
 
+func (c *msgClient) ScheduleExec(ctx context.Context, in *MsgScheduleExec, opts ...grpc.CallOption) (*MsgScheduleExecResponse, error) {
+	out := new(MsgScheduleExecResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Msg/ScheduleExec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledExec(ctx context.Context, in *MsgCancelScheduledExec, opts ...grpc.CallOption) (*MsgCancelScheduledExecResponse, error) {
+	out := new(MsgCancelScheduledExecResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Msg/CancelScheduledExec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Grant grants the provided authorization to the grantee on the granter's
@@ -583,6 +795,12 @@ type MsgServer interface {
 	RevokeAll(context.Context, *MsgRevokeAll) (*MsgRevokeAllResponse, error)
 	// PruneExpiredGrants prunes the expired grants. Currently up to 75 at a time.
 	PruneExpiredGrants(context.Context, *MsgPruneExpiredGrants) (*MsgPruneExpiredGrantsResponse, error)
+	// ScheduleExec schedules the execution of the provided messages, signed by the
+	// granter, using the authorizations granted to the x/authz module account.
+	// The granter funds the executions.
+	ScheduleExec(context.Context, *MsgScheduleExec) (*MsgScheduleExecResponse, error)
+	// CancelScheduledExec cancels a scheduled execution of the granter.
+	CancelScheduledExec(context.Context, *MsgCancelScheduledExec) (*MsgCancelScheduledExecResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -604,6 +822,12 @@ func (*UnimplementedMsgServer) RevokeAll(ctx context.Context, req *MsgRevokeAll)
 func (*UnimplementedMsgServer) PruneExpiredGrants(ctx context.Context, req *MsgPruneExpiredGrants) (*MsgPruneExpiredGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneExpiredGrants not implemented")
 }
+func (*UnimplementedMsgServer) ScheduleExec(ctx context.Context, req *MsgScheduleExec) (*MsgScheduleExecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleExec not implemented")
+}
+func (*UnimplementedMsgServer) CancelScheduledExec(ctx context.Context, req *MsgCancelScheduledExec) (*MsgCancelScheduledExecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledExec not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -699,6 +923,42 @@ func _Msg_PruneExpiredGrants_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleExec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleExec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleExec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Msg/ScheduleExec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleExec(ctx, req.(*MsgScheduleExec))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledExec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledExec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledExec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Msg/CancelScheduledExec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledExec(ctx, req.(*MsgCancelScheduledExec))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.authz.v1beta1.Msg",
@@ -724,6 +984,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PruneExpiredGrants",
 			Handler:    _Msg_PruneExpiredGrants_Handler,
 		},
+		{
+			MethodName: "ScheduleExec",
+			Handler:    _Msg_ScheduleExec_Handler,
+		},
+		{
+			MethodName: "CancelScheduledExec",
+			Handler:    _Msg_CancelScheduledExec_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/tx.proto",
@@ -1048,85 +1316,244 @@ func (m *MsgPruneExpiredGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgGrant) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgScheduleExec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Grant.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgGrantResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgScheduleExec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgExec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgScheduleExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Msgs) > 0 {
-		for _, e := range m.Msgs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgExecResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgScheduleExecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleExecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Results) > 0 {
-		for _, b := range m.Results {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevoke) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *MsgCancelScheduledExec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledExec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledExecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledExecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Grant.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, b := range m.Results {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRevoke) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.Granter)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
@@ -1195,6 +1622,73 @@ func (m *MsgPruneExpiredGrantsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgScheduleExec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.Schedule.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgScheduleExecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledExec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledExecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2056,6 +2550,428 @@ func (m *MsgPruneExpiredGrantsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgScheduleExec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleExec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleExec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &any.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleExecResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleExecResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduledExec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledExec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledExec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduledExecResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledExecResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
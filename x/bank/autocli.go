@@ -22,6 +22,13 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Query an account balance by address and denom",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "denom"}},
 				},
+				{
+					RpcMethod:      "BalanceAt",
+					Use:            "balance-at <address> <denom> <height>",
+					Short:          "Query an account balance by address and denom at the end of a past height",
+					Long:           "Query an account balance by address and denom at the end of a past height. The balances of the denom must be checkpointed, see the balance_checkpoint_denoms parameter.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "denom"}, {ProtoField: "height"}},
+				},
 				{
 					RpcMethod:      "AllBalances",
 					Use:            "balances <address>",
@@ -87,6 +94,18 @@ To look up all denoms, do not provide any arguments.`,
 					),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denoms", Varargs: true}},
 				},
+				{
+					RpcMethod:      "FactoryDenom",
+					Use:            "factory-denom <denom>",
+					Short:          "Query the admin and send hook of a token factory denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "FactoryDenomsByCreator",
+					Use:            "factory-denoms <creator>",
+					Short:          "Query the token factory denoms created by an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "creator"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -128,6 +147,53 @@ Note: multiple coins can be send by space separated.`,
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod:      "CreateDenom",
+					Use:            "create-denom <subdenom>",
+					Short:          "Create a token factory denom of the form factory/{creator}/{subdenom}, administrated by the creator",
+					Long:           "Create a token factory denom of the form factory/{creator}/{subdenom}, administrated by the creator. The denom creation fee defined in the bank parameters is burned from the balance of the creator.",
+					Example:        fmt.Sprintf(`%s tx bank create-denom mytoken --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "subdenom"}},
+				},
+				{
+					RpcMethod: "MintDenom",
+					Use:       "mint-denom <amount> [recipient]",
+					Short:     "Mint a token factory denom administrated by the sender, to the sender or the given recipient",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "amount"},
+						{ProtoField: "recipient", Optional: true},
+					},
+				},
+				{
+					RpcMethod:      "BurnDenom",
+					Use:            "burn-denom <amount>",
+					Short:          "Burn a token factory denom administrated by the sender from its balance",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod: "ChangeDenomAdmin",
+					Use:       "change-denom-admin <denom> [new_admin]",
+					Short:     "Transfer the admin of a token factory denom, or renounce it if no new admin is given",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "new_admin", Optional: true},
+					},
+				},
+				{
+					RpcMethod:      "SetDenomMetadata",
+					Use:            "set-denom-metadata <metadata>",
+					Short:          "Set the metadata of a token factory denom administrated by the sender",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "metadata"}},
+				},
+				{
+					RpcMethod: "SetDenomSendHook",
+					Use:       "set-denom-send-hook <denom> [send_hook]",
+					Short:     "Attach a send hook registered by the application to a token factory denom, or remove it if no send hook is given",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "send_hook", Optional: true},
+					},
+				},
 			},
 		},
 	}
@@ -27,6 +27,7 @@ func init() {
 		&modulev1.Module{},
 		appconfig.Provide(ProvideModule),
 		appconfig.Invoke(InvokeSetSendRestrictions),
+		appconfig.Invoke(InvokeSetBankHooks),
 	)
 }
 
@@ -129,3 +130,45 @@ func InvokeSetSendRestrictions(
 
 	return nil
 }
+
+func InvokeSetBankHooks(
+	config *modulev1.Module,
+	keeper keeper.BaseKeeper,
+	bankHooks map[string]types.BankHooksWrapper,
+) error {
+	if config == nil {
+		return nil
+	}
+
+	if config.HooksGasLimit != 0 {
+		keeper.SetHooksGasLimit(config.HooksGasLimit)
+	}
+
+	if len(bankHooks) == 0 {
+		return nil
+	}
+
+	modNames := slices.Collect(maps.Keys(bankHooks))
+	order := config.HooksOrder
+	if len(order) == 0 {
+		order = modNames
+		sort.Strings(order)
+	}
+
+	if len(order) != len(modNames) {
+		return fmt.Errorf("len(hooks_order: %v) != len(hooks modules: %v)", order, modNames)
+	}
+
+	var multiHooks types.MultiBankHooks
+	for _, modName := range order {
+		hook, ok := bankHooks[modName]
+		if !ok {
+			return fmt.Errorf("can't find bank hooks for module %s", modName)
+		}
+
+		multiHooks = append(multiHooks, hook)
+	}
+
+	keeper.SetHooks(multiHooks)
+	return nil
+}
@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetBalanceAt returns the balance of an account at the end of a past height, for a denom whose
+// balances are checkpointed since that height at least.
+func (k BaseViewKeeper) GetBalanceAt(ctx context.Context, addr sdk.AccAddress, denom string, height int64) (sdk.Coin, error) {
+	current := k.HeaderService.HeaderInfo(ctx).Height
+	if height < 0 || height > current {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInvalidHeight, "height %d is not between 0 and the current height %d", height, current)
+	}
+
+	start, err := k.CheckpointDenoms.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdk.Coin{}, errorsmod.Wrapf(types.ErrNoBalanceCheckpoints, "balances of %s are not checkpointed", denom)
+		}
+		return sdk.Coin{}, err
+	}
+	if uint64(height) < start {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrNoBalanceCheckpoints, "balances of %s are checkpointed since height %d", denom, start)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if oldest := oldestRetainedHeight(current, params.BalanceCheckpointRetention); height < oldest {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrNoBalanceCheckpoints, "balances are retained since height %d", oldest)
+	}
+
+	// the first checkpoint after the height holds the balance before the first change
+	// following it, otherwise the balance has not changed since the height
+	rng := new(collections.Range[collections.Triple[string, sdk.AccAddress, uint64]]).
+		StartExclusive(collections.Join3(denom, addr, uint64(height))).
+		EndInclusive(collections.Join3(denom, addr, uint64(math.MaxUint64)))
+	iter, err := k.BalanceCheckpoints.Iterate(ctx, rng)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return k.GetBalance(ctx, addr, denom), nil
+	}
+
+	amount, err := iter.Value()
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(denom, amount), nil
+}
+
+// checkpointBalance records the balance of an account before its first change at the
+// current height, if the balances of the denom are checkpointed.
+func (k BaseSendKeeper) checkpointBalance(ctx context.Context, addr sdk.AccAddress, denom string) error {
+	if has, err := k.CheckpointDenoms.Has(ctx, denom); err != nil || !has {
+		return err
+	}
+
+	key := collections.Join3(denom, addr, uint64(k.HeaderService.HeaderInfo(ctx).Height))
+	if has, err := k.BalanceCheckpoints.Has(ctx, key); err != nil || has {
+		return err
+	}
+
+	return k.BalanceCheckpoints.Set(ctx, key, k.GetBalance(ctx, addr, denom).Amount)
+}
+
+// setCheckpointDenoms starts checkpointing the balances of the new checkpoint denoms at the
+// current height, and stops checkpointing the balances of the removed ones.
+// The checkpoints of the removed denoms are not queryable anymore, and are pruned with the
+// balance checkpoint retention.
+func (k BaseSendKeeper) setCheckpointDenoms(ctx context.Context, denoms []string) error {
+	checkpointed := make(map[string]bool, len(denoms))
+	for _, denom := range denoms {
+		checkpointed[denom] = true
+	}
+
+	var removed []string
+	err := k.CheckpointDenoms.Walk(ctx, nil, func(denom string, _ uint64) (bool, error) {
+		if !checkpointed[denom] {
+			removed = append(removed, denom)
+		}
+		delete(checkpointed, denom)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, denom := range removed {
+		if err := k.CheckpointDenoms.Remove(ctx, denom); err != nil {
+			return err
+		}
+	}
+
+	height := uint64(k.HeaderService.HeaderInfo(ctx).Height)
+	for _, denom := range denoms {
+		if !checkpointed[denom] {
+			continue
+		}
+		if err := k.CheckpointDenoms.Set(ctx, denom, height); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PruneBalanceCheckpoints removes the balance checkpoints which are not needed anymore to query the
+// balances of the heights retained by the balance checkpoint retention.
+func (k BaseSendKeeper) PruneBalanceCheckpoints(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	oldest := oldestRetainedHeight(k.HeaderService.HeaderInfo(ctx).Height, params.BalanceCheckpointRetention)
+	if oldest <= 0 {
+		return nil
+	}
+
+	// the balance at a height is held by the first checkpoint after it, so the checkpoints up to
+	// the oldest retained height are not needed anymore
+	rng := new(collections.Range[collections.Pair[uint64, collections.Triple[string, sdk.AccAddress, uint64]]]).
+		EndExclusive(collections.PairPrefix[uint64, collections.Triple[string, sdk.AccAddress, uint64]](uint64(oldest) + 1))
+	iter, err := k.BalanceCheckpoints.Indexes.Height.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.PrimaryKeys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := k.BalanceCheckpoints.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// oldestRetainedHeight returns the oldest height whose balances are retained at the current height,
+// or 0 if the balances of all heights are retained.
+func oldestRetainedHeight(current int64, retention uint64) int64 {
+	if retention == 0 || retention >= uint64(current) {
+		return 0
+	}
+
+	return current - int64(retention)
+}
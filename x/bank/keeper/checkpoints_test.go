@@ -0,0 +1,190 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestBalanceCheckpoints() {
+	require := suite.Require()
+	atHeight := func(height int64) sdk.Context {
+		return sdk.UnwrapSDKContext(suite.ctx).WithHeaderInfo(header.Info{Height: height})
+	}
+
+	suite.authKeeper.EXPECT().GetModuleAccount(gomock.Any(), mintAcc.Name).Return(mintAcc).AnyTimes()
+	suite.authKeeper.EXPECT().GetModuleAddress(mintAcc.Name).Return(mintAcc.GetAddress()).AnyTimes()
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), mintAcc.GetAddress()).Return(mintAcc).AnyTimes()
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), accAddrs[0]).Return(authtypes.NewBaseAccountWithAddress(accAddrs[0])).AnyTimes()
+
+	// the balances of foo are checkpointed from height 10
+	ctx := atHeight(10)
+	params := suite.bankKeeper.GetParams(ctx)
+	params.BalanceCheckpointDenoms = []string{fooDenom}
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+
+	coins := sdk.NewCoins(newFooCoin(100), newBarCoin(100))
+	require.NoError(suite.bankKeeper.MintCoins(ctx, banktypes.MintModuleName, coins))
+	require.NoError(suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, banktypes.MintModuleName, accAddrs[0], coins))
+
+	ctx = atHeight(12)
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(20))))
+
+	ctx = atHeight(15)
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(20), newBarCoin(20))))
+
+	ctx = atHeight(20)
+	for _, tc := range []struct {
+		addr     sdk.AccAddress
+		height   int64
+		expected int64
+	}{
+		{accAddrs[0], 10, 100},
+		{accAddrs[0], 11, 100},
+		{accAddrs[0], 12, 70},
+		{accAddrs[0], 14, 70},
+		{accAddrs[0], 15, 50},
+		{accAddrs[0], 20, 50},
+		{accAddrs[1], 10, 0},
+		{accAddrs[1], 12, 30},
+		{accAddrs[1], 15, 50},
+	} {
+		balance, err := suite.bankKeeper.GetBalanceAt(ctx, tc.addr, fooDenom, tc.height)
+		require.NoError(err)
+		require.Equal(newFooCoin(tc.expected), balance, "height %d", tc.height)
+	}
+
+	_, err := suite.bankKeeper.GetBalanceAt(ctx, accAddrs[0], fooDenom, 9)
+	require.ErrorIs(err, banktypes.ErrNoBalanceCheckpoints)
+	_, err = suite.bankKeeper.GetBalanceAt(ctx, accAddrs[0], fooDenom, 21)
+	require.ErrorIs(err, sdkerrors.ErrInvalidHeight)
+	_, err = suite.bankKeeper.GetBalanceAt(ctx, accAddrs[0], barDenom, 15)
+	require.ErrorIs(err, banktypes.ErrNoBalanceCheckpoints)
+
+	addrStr, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[0])
+	require.NoError(err)
+	res, err := suite.mockQueryClient(ctx).BalanceAt(ctx, &banktypes.QueryBalanceAtRequest{Address: addrStr, Denom: fooDenom, Height: 12})
+	require.NoError(err)
+	require.Equal(newFooCoin(70), res.Balance)
+
+	// the balances of foo are not queryable once they are not checkpointed anymore
+	params.BalanceCheckpointDenoms = []string{barDenom}
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+	_, err = suite.bankKeeper.GetBalanceAt(ctx, accAddrs[0], fooDenom, 15)
+	require.ErrorIs(err, banktypes.ErrNoBalanceCheckpoints)
+	_, err = suite.bankKeeper.GetBalanceAt(ctx, accAddrs[0], barDenom, 19)
+	require.ErrorIs(err, banktypes.ErrNoBalanceCheckpoints)
+	balance, err := suite.bankKeeper.GetBalanceAt(ctx, accAddrs[0], barDenom, 20)
+	require.NoError(err)
+	require.Equal(newBarCoin(80), balance)
+}
+
+func (suite *KeeperTestSuite) TestBalanceCheckpointsGenesis() {
+	require := suite.Require()
+	atHeight := func(height int64) sdk.Context {
+		return sdk.UnwrapSDKContext(suite.ctx).WithHeaderInfo(header.Info{Height: height})
+	}
+
+	suite.authKeeper.EXPECT().GetModuleAccount(gomock.Any(), mintAcc.Name).Return(mintAcc).AnyTimes()
+	suite.authKeeper.EXPECT().GetModuleAddress(mintAcc.Name).Return(mintAcc.GetAddress()).AnyTimes()
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), mintAcc.GetAddress()).Return(mintAcc).AnyTimes()
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), accAddrs[0]).Return(authtypes.NewBaseAccountWithAddress(accAddrs[0])).AnyTimes()
+
+	ctx := atHeight(10)
+	params := suite.bankKeeper.GetParams(ctx)
+	params.BalanceCheckpointDenoms = []string{fooDenom}
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+	require.NoError(suite.bankKeeper.MintCoins(ctx, banktypes.MintModuleName, sdk.NewCoins(newFooCoin(100))))
+	require.NoError(suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, banktypes.MintModuleName, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+
+	ctx = atHeight(12)
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(30))))
+
+	ctx = atHeight(20)
+	genState, err := suite.bankKeeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.NoError(genState.Validate())
+	require.Equal([]banktypes.CheckpointDenom{{Denom: fooDenom, StartHeight: 10}}, genState.CheckpointDenoms)
+	require.NotEmpty(genState.BalanceCheckpoints)
+
+	// the checkpoints and their start heights are restored on import
+	suite.SetupTest()
+	ctx = atHeight(20)
+	require.NoError(suite.bankKeeper.InitGenesis(ctx, genState))
+
+	for height, expected := range map[int64]int64{10: 100, 11: 100, 12: 70, 20: 70} {
+		balance, err := suite.bankKeeper.GetBalanceAt(ctx, accAddrs[0], fooDenom, height)
+		require.NoError(err)
+		require.Equal(newFooCoin(expected), balance, "height %d", height)
+	}
+
+	exported, err := suite.bankKeeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.Equal(genState.CheckpointDenoms, exported.CheckpointDenoms)
+	require.Equal(genState.BalanceCheckpoints, exported.BalanceCheckpoints)
+}
+
+func (suite *KeeperTestSuite) TestPruneBalanceCheckpoints() {
+	require := suite.Require()
+	atHeight := func(height int64) sdk.Context {
+		return sdk.UnwrapSDKContext(suite.ctx).WithHeaderInfo(header.Info{Height: height})
+	}
+
+	suite.authKeeper.EXPECT().GetModuleAccount(gomock.Any(), mintAcc.Name).Return(mintAcc).AnyTimes()
+	suite.authKeeper.EXPECT().GetModuleAddress(mintAcc.Name).Return(mintAcc.GetAddress()).AnyTimes()
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), mintAcc.GetAddress()).Return(mintAcc).AnyTimes()
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), accAddrs[0]).Return(authtypes.NewBaseAccountWithAddress(accAddrs[0])).AnyTimes()
+
+	ctx := atHeight(10)
+	params := suite.bankKeeper.GetParams(ctx)
+	params.BalanceCheckpointDenoms = []string{fooDenom}
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+	require.NoError(suite.bankKeeper.MintCoins(ctx, banktypes.MintModuleName, sdk.NewCoins(newFooCoin(100))))
+	require.NoError(suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, banktypes.MintModuleName, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+
+	ctx = atHeight(12)
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(30))))
+
+	ctx = atHeight(15)
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(20))))
+
+	checkpointHeights := func(ctx sdk.Context) []uint64 {
+		var heights []uint64
+		err := suite.bankKeeper.BalanceCheckpoints.Walk(ctx, nil, func(key collections.Triple[string, sdk.AccAddress, uint64], _ math.Int) (bool, error) {
+			heights = append(heights, key.K3())
+			return false, nil
+		})
+		require.NoError(err)
+		return heights
+	}
+
+	// the checkpoints are retained forever by default
+	ctx = atHeight(20)
+	require.NoError(suite.bankKeeper.PruneBalanceCheckpoints(ctx))
+	require.Contains(checkpointHeights(ctx), uint64(10))
+
+	// only the checkpoints after the oldest retained height are kept
+	params.BalanceCheckpointRetention = 7
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+	require.NoError(suite.bankKeeper.PruneBalanceCheckpoints(ctx))
+	for _, height := range checkpointHeights(ctx) {
+		require.Greater(height, uint64(13))
+	}
+
+	for height, expected := range map[int64]int64{13: 70, 14: 70, 15: 50, 20: 50} {
+		balance, err := suite.bankKeeper.GetBalanceAt(ctx, accAddrs[0], fooDenom, height)
+		require.NoError(err)
+		require.Equal(newFooCoin(expected), balance, "height %d", height)
+	}
+
+	_, err := suite.bankKeeper.GetBalanceAt(ctx, accAddrs[0], fooDenom, 12)
+	require.ErrorIs(err, banktypes.ErrNoBalanceCheckpoints)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/bank/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -57,6 +58,30 @@ func (k BaseKeeper) InitGenesis(ctx context.Context, genState *types.GenesisStat
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	for _, fd := range genState.FactoryDenoms {
+		if err := k.setFactoryDenom(ctx, fd); err != nil {
+			return err
+		}
+	}
+
+	// the checkpoint denoms were set from the params at the genesis height, restore their start heights
+	for _, cd := range genState.CheckpointDenoms {
+		if err := k.CheckpointDenoms.Set(ctx, cd.Denom, cd.StartHeight); err != nil {
+			return err
+		}
+	}
+
+	for _, bc := range genState.BalanceCheckpoints {
+		addr, err := k.ak.AddressCodec().StringToBytes(bc.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.BalanceCheckpoints.Set(ctx, collections.Join3(bc.Denom, sdk.AccAddress(addr), bc.Height), bc.Amount); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -74,5 +99,36 @@ func (k BaseKeeper) ExportGenesis(ctx context.Context) (*types.GenesisState, err
 		k.GetAllDenomMetaData(ctx),
 		k.GetAllSendEnabledEntries(ctx),
 	)
+
+	rv.FactoryDenoms, err = k.GetAllFactoryDenoms(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch factory denoms %w", err)
+	}
+
+	err = k.CheckpointDenoms.Walk(ctx, nil, func(denom string, startHeight uint64) (bool, error) {
+		rv.CheckpointDenoms = append(rv.CheckpointDenoms, types.CheckpointDenom{Denom: denom, StartHeight: startHeight})
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch checkpoint denoms %w", err)
+	}
+
+	err = k.BalanceCheckpoints.Walk(ctx, nil, func(key collections.Triple[string, sdk.AccAddress, uint64], amount math.Int) (bool, error) {
+		addr, err := k.ak.AddressCodec().BytesToString(key.K2())
+		if err != nil {
+			return true, err
+		}
+
+		rv.BalanceCheckpoints = append(rv.BalanceCheckpoints, types.BalanceCheckpoint{
+			Denom:   key.K1(),
+			Address: addr,
+			Height:  key.K3(),
+			Amount:  amount,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch balance checkpoints %w", err)
+	}
 	return rv, nil
 }
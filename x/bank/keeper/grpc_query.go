@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -47,6 +48,29 @@ func (k BaseKeeper) Balance(ctx context.Context, req *types.QueryBalanceRequest)
 	return &types.QueryBalanceResponse{Balance: &balance}, nil
 }
 
+// BalanceAt implements the Query/BalanceAt gRPC method
+func (k BaseKeeper) BalanceAt(ctx context.Context, req *types.QueryBalanceAtRequest) (*types.QueryBalanceAtResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	address, err := k.ak.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	balance, err := k.GetBalanceAt(ctx, address, req.Denom, req.Height)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryBalanceAtResponse{Balance: balance}, nil
+}
+
 // AllBalances implements the Query/AllBalances gRPC method
 func (k BaseKeeper) AllBalances(ctx context.Context, req *types.QueryAllBalancesRequest) (*types.QueryAllBalancesResponse, error) {
 	if req == nil {
@@ -354,3 +378,47 @@ func (k BaseKeeper) DenomOwnersByQuery(ctx context.Context, req *types.QueryDeno
 
 	return &types.QueryDenomOwnersByQueryResponse{DenomOwners: resp.DenomOwners, Pagination: resp.Pagination}, nil
 }
+
+// FactoryDenom implements the Query/FactoryDenom gRPC method
+func (k BaseKeeper) FactoryDenom(ctx context.Context, req *types.QueryFactoryDenomRequest) (*types.QueryFactoryDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	fd, err := k.GetFactoryDenom(ctx, req.Denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "factory denom %s not found", req.Denom)
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryFactoryDenomResponse{FactoryDenom: fd}, nil
+}
+
+// FactoryDenomsByCreator implements the Query/FactoryDenomsByCreator gRPC method
+func (k BaseKeeper) FactoryDenomsByCreator(ctx context.Context, req *types.QueryFactoryDenomsByCreatorRequest) (*types.QueryFactoryDenomsByCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	creator, err := k.ak.AddressCodec().StringToBytes(req.Creator)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	denoms, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.FactoryDenoms,
+		req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, string], value types.FactoryDenom) (types.FactoryDenom, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, string](creator),
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryFactoryDenomsByCreatorResponse{FactoryDenoms: denoms, Pagination: pageRes}, nil
+}
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetHooks sets the bank hooks, called after the balances of any denom changed.
+// It must be called once, before the chain starts.
+func (k BaseSendKeeper) SetHooks(hooks types.BankHooks) {
+	if k.hooks.hooks != nil {
+		panic("cannot set bank hooks twice")
+	}
+
+	k.hooks.hooks = hooks
+}
+
+// SetDenomHooks sets the bank hooks of a denom, called after the bank hooks with the coins of
+// that denom only. It must be called once per denom, before the chain starts.
+func (k BaseSendKeeper) SetDenomHooks(denom string, hooks types.BankHooks) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		panic(err)
+	}
+	if _, ok := k.hooks.denomHooks[denom]; ok {
+		panic(fmt.Sprintf("cannot set bank hooks of denom %s twice", denom))
+	}
+
+	k.hooks.denomHooks[denom] = hooks
+}
+
+// SetHooksGasLimit sets the gas limit of each bank hook call, DefaultHooksGasLimit by default.
+func (k BaseSendKeeper) SetHooksGasLimit(gasLimit uint64) {
+	k.hooks.gasLimit = gasLimit
+}
+
+func (k BaseSendKeeper) afterCoinsSent(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.callHooks(ctx, amt, func(ctx context.Context, hooks types.BankHooks, amt sdk.Coins) error {
+		return hooks.AfterCoinsSent(ctx, fromAddr, toAddr, amt)
+	})
+}
+
+func (k BaseSendKeeper) afterCoinsMinted(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	return k.callHooks(ctx, amt, func(ctx context.Context, hooks types.BankHooks, amt sdk.Coins) error {
+		return hooks.AfterCoinsMinted(ctx, addr, amt)
+	})
+}
+
+func (k BaseSendKeeper) afterCoinsBurned(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	return k.callHooks(ctx, amt, func(ctx context.Context, hooks types.BankHooks, amt sdk.Coins) error {
+		return hooks.AfterCoinsBurned(ctx, addr, amt)
+	})
+}
+
+func (k BaseSendKeeper) afterCoinsDelegated(ctx context.Context, delegatorAddr, moduleAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.callHooks(ctx, amt, func(ctx context.Context, hooks types.BankHooks, amt sdk.Coins) error {
+		return hooks.AfterCoinsDelegated(ctx, delegatorAddr, moduleAddr, amt)
+	})
+}
+
+func (k BaseSendKeeper) afterCoinsUndelegated(ctx context.Context, moduleAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.callHooks(ctx, amt, func(ctx context.Context, hooks types.BankHooks, amt sdk.Coins) error {
+		return hooks.AfterCoinsUndelegated(ctx, moduleAddr, delegatorAddr, amt)
+	})
+}
+
+// callHooks calls the bank hooks with amt, then the hooks of each denom of amt with its coins.
+// Each call is executed in a branched context limited to the hooks gas limit, whose changes
+// are discarded if the call fails.
+func (k BaseSendKeeper) callHooks(ctx context.Context, amt sdk.Coins, call func(context.Context, types.BankHooks, sdk.Coins) error) error {
+	if k.hooks == nil {
+		return nil
+	}
+
+	if k.hooks.hooks != nil {
+		if err := k.executeHook(ctx, k.hooks.hooks, amt, call); err != nil {
+			return err
+		}
+	}
+
+	for _, coin := range amt {
+		hooks, ok := k.hooks.denomHooks[coin.Denom]
+		if !ok {
+			continue
+		}
+
+		if err := k.executeHook(ctx, hooks, sdk.Coins{coin}, call); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k BaseSendKeeper) executeHook(ctx context.Context, hooks types.BankHooks, amt sdk.Coins, call func(context.Context, types.BankHooks, sdk.Coins) error) error {
+	_, err := k.BranchService.ExecuteWithGasLimit(ctx, k.hooks.gasLimit, func(ctx context.Context) error {
+		return call(ctx, hooks, amt)
+	})
+	if err != nil {
+		return errorsmod.Wrapf(err, "bank hook failed for %s", amt)
+	}
+
+	return nil
+}
+
+// bankHooks houses the bank hooks of the keeper.
+// Like sendRestriction, it exists so that hooks can be set without a pointer receiver.
+type bankHooks struct {
+	hooks      types.BankHooks
+	denomHooks map[string]types.BankHooks
+	gasLimit   uint64
+}
+
+func newBankHooks() *bankHooks {
+	return &bankHooks{
+		denomHooks: make(map[string]types.BankHooks),
+		gasLimit:   types.DefaultHooksGasLimit,
+	}
+}
@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var _ banktypes.BankHooks = (*mockBankHooks)(nil)
+
+// mockBankHooks records the calls of the bank hooks, failing them when err is set.
+type mockBankHooks struct {
+	calls  []string
+	err    error
+	onCall func(ctx context.Context)
+}
+
+func (h *mockBankHooks) record(ctx context.Context, call string) error {
+	h.calls = append(h.calls, call)
+	if h.onCall != nil {
+		h.onCall(ctx)
+	}
+	return h.err
+}
+
+func (h *mockBankHooks) AfterCoinsSent(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return h.record(ctx, fmt.Sprintf("sent %s %s->%s", amt, fromAddr, toAddr))
+}
+
+func (h *mockBankHooks) AfterCoinsMinted(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	return h.record(ctx, fmt.Sprintf("minted %s %s", amt, addr))
+}
+
+func (h *mockBankHooks) AfterCoinsBurned(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	return h.record(ctx, fmt.Sprintf("burned %s %s", amt, addr))
+}
+
+func (h *mockBankHooks) AfterCoinsDelegated(ctx context.Context, delegatorAddr, moduleAddr sdk.AccAddress, amt sdk.Coins) error {
+	return h.record(ctx, fmt.Sprintf("delegated %s %s->%s", amt, delegatorAddr, moduleAddr))
+}
+
+func (h *mockBankHooks) AfterCoinsUndelegated(ctx context.Context, moduleAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error {
+	return h.record(ctx, fmt.Sprintf("undelegated %s %s->%s", amt, moduleAddr, delegatorAddr))
+}
+
+func (suite *KeeperTestSuite) TestBankHooks() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	hooks, fooHooks := &mockBankHooks{}, &mockBankHooks{}
+	suite.bankKeeper.SetHooks(hooks)
+	suite.bankKeeper.SetDenomHooks(fooDenom, fooHooks)
+	require.Panics(func() { suite.bankKeeper.SetHooks(hooks) })
+	require.Panics(func() { suite.bankKeeper.SetDenomHooks(fooDenom, hooks) })
+
+	coins := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	suite.mockMintCoins(mintAcc)
+	require.NoError(suite.bankKeeper.MintCoins(ctx, banktypes.MintModuleName, coins))
+	suite.mockSendCoinsFromModuleToAccount(mintAcc, accAddrs[0])
+	require.NoError(suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, banktypes.MintModuleName, accAddrs[0], coins))
+
+	suite.mockDelegateCoinsFromAccountToModule(acc0, burnerAcc)
+	require.NoError(suite.bankKeeper.DelegateCoinsFromAccountToModule(ctx, accAddrs[0], authtypes.Burner, coins))
+	suite.mockUndelegateCoinsFromModuleToAccount(burnerAcc, acc0)
+	require.NoError(suite.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, authtypes.Burner, accAddrs[0], coins))
+
+	suite.mockSendCoinsFromAccountToModule(acc0, burnerAcc)
+	require.NoError(suite.bankKeeper.SendCoinsFromAccountToModule(ctx, accAddrs[0], authtypes.Burner, coins))
+	suite.mockBurnCoins(burnerAcc)
+	require.NoError(suite.bankKeeper.BurnCoins(ctx, burnerAcc.GetAddress(), coins))
+
+	mintAddr, burnerAddr := mintAcc.GetAddress(), burnerAcc.GetAddress()
+	require.Equal([]string{
+		fmt.Sprintf("minted %s %s", coins, mintAddr),
+		fmt.Sprintf("sent %s %s->%s", coins, mintAddr, accAddrs[0]),
+		fmt.Sprintf("delegated %s %s->%s", coins, accAddrs[0], burnerAddr),
+		fmt.Sprintf("undelegated %s %s->%s", coins, burnerAddr, accAddrs[0]),
+		fmt.Sprintf("sent %s %s->%s", coins, accAddrs[0], burnerAddr),
+		fmt.Sprintf("burned %s %s", coins, burnerAddr),
+	}, hooks.calls)
+
+	// the denom hooks are only called with the coins of their denom
+	fooCoins := sdk.NewCoins(newFooCoin(100))
+	require.Equal([]string{
+		fmt.Sprintf("minted %s %s", fooCoins, mintAddr),
+		fmt.Sprintf("sent %s %s->%s", fooCoins, mintAddr, accAddrs[0]),
+		fmt.Sprintf("delegated %s %s->%s", fooCoins, accAddrs[0], burnerAddr),
+		fmt.Sprintf("undelegated %s %s->%s", fooCoins, burnerAddr, accAddrs[0]),
+		fmt.Sprintf("sent %s %s->%s", fooCoins, accAddrs[0], burnerAddr),
+		fmt.Sprintf("burned %s %s", fooCoins, burnerAddr),
+	}, fooHooks.calls)
+
+	// the bar coins don't call the foo hooks
+	fooHooks.calls = nil
+	suite.mockMintCoins(mintAcc)
+	require.NoError(suite.bankKeeper.MintCoins(ctx, banktypes.MintModuleName, sdk.NewCoins(newBarCoin(10))))
+	require.Empty(fooHooks.calls)
+}
+
+func (suite *KeeperTestSuite) TestBankHooksFailure() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	hooks := &mockBankHooks{}
+	suite.bankKeeper.SetHooks(hooks)
+	suite.bankKeeper.SetHooksGasLimit(10_000)
+
+	coins := sdk.NewCoins(newFooCoin(100))
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(suite.bankKeeper.MintCoins(ctx, banktypes.MintModuleName, coins))
+	require.NoError(suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, banktypes.MintModuleName, accAddrs[0], coins))
+
+	// the error of a hook fails the operation and discards the changes of the hook
+	hooks.err = errors.New("hook error")
+	hooks.onCall = func(ctx context.Context) {
+		suite.bankKeeper.SetSendEnabled(ctx, barDenom, false)
+	}
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	cacheCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+	suite.authKeeper.EXPECT().GetAccount(cacheCtx, accAddrs[0]).Return(acc0)
+	err := suite.bankKeeper.SendCoins(cacheCtx, accAddrs[0], accAddrs[1], coins)
+	require.ErrorContains(err, "hook error")
+	_, found := suite.bankKeeper.GetSendEnabledEntry(cacheCtx, barDenom)
+	require.False(found)
+
+	// a hook is limited to the hooks gas limit
+	hooks.err = nil
+	hooks.onCall = func(ctx context.Context) {
+		sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(10_001, "test")
+	}
+	cacheCtx, _ = sdk.UnwrapSDKContext(ctx).CacheContext()
+	suite.authKeeper.EXPECT().GetAccount(cacheCtx, accAddrs[0]).Return(acc0)
+	err = suite.bankKeeper.SendCoins(cacheCtx, accAddrs[0], accAddrs[1], coins)
+	require.ErrorIs(err, sdkerrors.ErrOutOfGas)
+}
@@ -49,6 +49,16 @@ type Keeper interface {
 	DelegateCoins(ctx context.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx context.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
 
+	GetFactoryDenom(ctx context.Context, denom string) (types.FactoryDenom, error)
+	CreateFactoryDenom(ctx context.Context, creator sdk.AccAddress, subdenom string) (string, error)
+	MintFactoryDenom(ctx context.Context, admin, recipient sdk.AccAddress, coin sdk.Coin) error
+	BurnFactoryDenom(ctx context.Context, admin sdk.AccAddress, coin sdk.Coin) error
+	ChangeFactoryDenomAdmin(ctx context.Context, denom string, admin, newAdmin sdk.AccAddress) error
+	SetFactoryDenomMetadata(ctx context.Context, admin sdk.AccAddress, metadata types.Metadata) error
+	SetFactoryDenomSendHook(ctx context.Context, denom string, admin sdk.AccAddress, hook string) error
+
+	PruneBalanceCheckpoints(ctx context.Context) error
+
 	types.QueryServer
 }
 
@@ -158,7 +168,11 @@ func (k BaseKeeper) DelegateCoins(ctx context.Context, delegatorAddr, moduleAccA
 		return err
 	}
 
-	return k.addCoins(ctx, moduleAccAddr, amt)
+	if err := k.addCoins(ctx, moduleAccAddr, amt); err != nil {
+		return err
+	}
+
+	return k.afterCoinsDelegated(ctx, delegatorAddr, moduleAccAddr, amt)
 }
 
 // UndelegateCoins performs undelegation by crediting amt coins to an account with
@@ -184,7 +198,11 @@ func (k BaseKeeper) UndelegateCoins(ctx context.Context, moduleAccAddr, delegato
 		return errorsmod.Wrap(err, "failed to track undelegation")
 	}
 
-	return k.addCoins(ctx, delegatorAddr, amt)
+	if err := k.addCoins(ctx, delegatorAddr, amt); err != nil {
+		return err
+	}
+
+	return k.afterCoinsUndelegated(ctx, moduleAccAddr, delegatorAddr, amt)
 }
 
 // GetSupply retrieves the Supply from store
@@ -368,11 +386,15 @@ func (k BaseKeeper) MintCoins(ctx context.Context, moduleName string, amounts sd
 	}
 
 	// emit mint event
-	return k.EventService.EventManager(ctx).EmitKV(
+	if err := k.EventService.EventManager(ctx).EmitKV(
 		types.EventTypeCoinMint,
 		event.NewAttribute(types.AttributeKeyMinter, addrStr),
 		event.NewAttribute(sdk.AttributeKeyAmount, amounts.String()),
-	)
+	); err != nil {
+		return err
+	}
+
+	return k.afterCoinsMinted(ctx, acc.GetAddress(), amounts)
 }
 
 // BurnCoins burns coins deletes coins from the balance of an account.
@@ -411,11 +433,15 @@ func (k BaseKeeper) BurnCoins(ctx context.Context, address []byte, amounts sdk.C
 	k.Logger.Debug("burned tokens from account", "amount", amounts.String(), "from", addrStr)
 
 	// emit burn event
-	return k.EventService.EventManager(ctx).EmitKV(
+	if err := k.EventService.EventManager(ctx).EmitKV(
 		types.EventTypeCoinBurn,
 		event.NewAttribute(types.AttributeKeyBurner, addrStr),
 		event.NewAttribute(sdk.AttributeKeyAmount, amounts.String()),
-	)
+	); err != nil {
+		return err
+	}
+
+	return k.afterCoinsBurned(ctx, acc.GetAddress(), amounts)
 }
 
 // setSupply sets the supply for the given coin
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/go-metrics"
 
@@ -212,3 +213,108 @@ func (k msgServer) Burn(ctx context.Context, msg *types.MsgBurn) (*types.MsgBurn
 
 	return &types.MsgBurnResponse{}, nil
 }
+
+func (k msgServer) CreateDenom(ctx context.Context, msg *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	creator, err := k.stringToBytes(msg.Creator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	denom, err := k.CreateFactoryDenom(ctx, creator, msg.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateDenomResponse{NewDenom: denom}, nil
+}
+
+func (k msgServer) MintDenom(ctx context.Context, msg *types.MsgMintDenom) (*types.MsgMintDenomResponse, error) {
+	admin, err := k.stringToBytes(msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+
+	recipient := admin
+	if msg.Recipient != "" {
+		recipient, err = k.stringToBytes(msg.Recipient)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+		}
+	}
+
+	if err := k.MintFactoryDenom(ctx, admin, recipient, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMintDenomResponse{}, nil
+}
+
+func (k msgServer) BurnDenom(ctx context.Context, msg *types.MsgBurnDenom) (*types.MsgBurnDenomResponse, error) {
+	admin, err := k.stringToBytes(msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+
+	if err := k.BurnFactoryDenom(ctx, admin, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnDenomResponse{}, nil
+}
+
+func (k msgServer) ChangeDenomAdmin(ctx context.Context, msg *types.MsgChangeDenomAdmin) (*types.MsgChangeDenomAdminResponse, error) {
+	admin, err := k.stringToBytes(msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+
+	var newAdmin []byte
+	if msg.NewAdmin != "" {
+		newAdmin, err = k.stringToBytes(msg.NewAdmin)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid new admin address: %s", err)
+		}
+	}
+
+	if err := k.ChangeFactoryDenomAdmin(ctx, msg.Denom, admin, newAdmin); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgChangeDenomAdminResponse{}, nil
+}
+
+func (k msgServer) SetDenomMetadata(ctx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	admin, err := k.stringToBytes(msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+
+	if err := k.SetFactoryDenomMetadata(ctx, admin, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDenomMetadataResponse{}, nil
+}
+
+func (k msgServer) SetDenomSendHook(ctx context.Context, msg *types.MsgSetDenomSendHook) (*types.MsgSetDenomSendHookResponse, error) {
+	admin, err := k.stringToBytes(msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+
+	if err := k.SetFactoryDenomSendHook(ctx, msg.Denom, admin, msg.SendHook); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDenomSendHookResponse{}, nil
+}
+
+// stringToBytes decodes an address with the address codec of the underlying BaseKeeper.
+func (k msgServer) stringToBytes(addr string) ([]byte, error) {
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, fmt.Errorf("invalid keeper type: %T", k.Keeper)
+	}
+
+	return base.ak.AddressCodec().StringToBytes(addr)
+}
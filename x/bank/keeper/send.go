@@ -67,6 +67,8 @@ type BaseSendKeeper struct {
 	authority string
 
 	sendRestriction *sendRestriction
+	sendHooks       *sendHooks
+	hooks           *bankHooks
 }
 
 func NewBaseSendKeeper(
@@ -88,6 +90,8 @@ func NewBaseSendKeeper(
 		blockedAddrs:    blockedAddrs,
 		authority:       authority,
 		sendRestriction: newSendRestriction(),
+		sendHooks:       newSendHooks(),
+		hooks:           newBankHooks(),
 	}
 }
 
@@ -128,8 +132,13 @@ func (k BaseSendKeeper) SetParams(ctx context.Context, params types.Params) erro
 		k.SetAllSendEnabled(ctx, params.SendEnabled)
 
 		// override params without SendEnabled
-		params = types.NewParams(params.DefaultSendEnabled)
+		params.SendEnabled = nil
 	}
+
+	if err := k.setCheckpointDenoms(ctx, params.BalanceCheckpointDenoms); err != nil {
+		return err
+	}
+
 	return k.Params.Set(ctx, params)
 }
 
@@ -165,6 +174,11 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 			return err
 		}
 
+		outAddress, err = k.applySendHooks(ctx, inAddress, outAddress, out.Coins)
+		if err != nil {
+			return err
+		}
+
 		if err := k.addCoins(ctx, outAddress, out.Coins); err != nil {
 			return err
 		}
@@ -177,6 +191,10 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 		); err != nil {
 			return err
 		}
+
+		if err := k.afterCoinsSent(ctx, inAddress, outAddress, out.Coins); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -195,6 +213,11 @@ func (k BaseSendKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccA
 		return err
 	}
 
+	toAddr, err = k.applySendHooks(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
 	err = k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
@@ -214,12 +237,16 @@ func (k BaseSendKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccA
 		return err
 	}
 
-	return k.EventService.EventManager(ctx).EmitKV(
+	if err := k.EventService.EventManager(ctx).EmitKV(
 		types.EventTypeTransfer,
 		event.NewAttribute(types.AttributeKeyRecipient, toAddrString),
 		event.NewAttribute(types.AttributeKeySender, fromAddrString),
 		event.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
-	)
+	); err != nil {
+		return err
+	}
+
+	return k.afterCoinsSent(ctx, fromAddr, toAddr, amt)
 }
 
 // subUnlockedCoins removes the unlocked amt coins of the given account.
@@ -308,6 +335,10 @@ func (k BaseSendKeeper) setBalance(ctx context.Context, addr sdk.AccAddress, bal
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, balance.String())
 	}
 
+	if err := k.checkpointBalance(ctx, addr, balance.Denom); err != nil {
+		return err
+	}
+
 	// x/bank invariants prohibit persistence of zero balances
 	if balance.IsZero() {
 		err := k.Balances.Remove(ctx, collections.Join(addr, balance.Denom))
@@ -0,0 +1,336 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RegisterSendHook registers a send hook which the admins of factory denoms can attach to their denom.
+// The hook is applied, after the send restrictions, to the transfers of the denoms it is attached to.
+// It must be registered before the chain starts, under a name unique to the application.
+func (k BaseSendKeeper) RegisterSendHook(name string, hook types.SendRestrictionFn) {
+	k.sendHooks.register(name, hook)
+}
+
+// SendHooks returns the names of the registered send hooks, sorted.
+func (k BaseSendKeeper) SendHooks() []string {
+	return k.sendHooks.names()
+}
+
+// applySendHooks applies the send hooks attached to the factory denoms of amt.
+// A transfer of a denom whose send hook is not registered is rejected.
+func (k BaseSendKeeper) applySendHooks(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	for _, coin := range amt {
+		if !types.IsFactoryDenom(coin.Denom) {
+			continue
+		}
+
+		fd, err := k.GetFactoryDenom(ctx, coin.Denom)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		if fd.SendHook == "" {
+			continue
+		}
+
+		hook, ok := k.sendHooks.get(fd.SendHook)
+		if !ok {
+			return nil, errorsmod.Wrapf(types.ErrUnknownSendHook, "send hook %q of denom %s is not registered", fd.SendHook, fd.Denom)
+		}
+
+		toAddr, err = hook(ctx, fromAddr, toAddr, sdk.NewCoins(coin))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return toAddr, nil
+}
+
+// GetFactoryDenom returns the authority of a factory denom.
+func (k BaseViewKeeper) GetFactoryDenom(ctx context.Context, denom string) (types.FactoryDenom, error) {
+	creator, subdenom, err := types.ParseFactoryDenom(denom)
+	if err != nil {
+		return types.FactoryDenom{}, err
+	}
+
+	creatorAddr, err := k.ak.AddressCodec().StringToBytes(creator)
+	if err != nil {
+		return types.FactoryDenom{}, errorsmod.Wrapf(types.ErrInvalidFactoryDenom, "invalid creator: %s", err)
+	}
+
+	fd, err := k.FactoryDenoms.Get(ctx, collections.Join(sdk.AccAddress(creatorAddr), subdenom))
+	if err != nil {
+		return types.FactoryDenom{}, err
+	}
+
+	// the creator must be in its canonical form
+	if fd.Denom != denom {
+		return types.FactoryDenom{}, collections.ErrNotFound
+	}
+
+	return fd, nil
+}
+
+// GetAllFactoryDenoms returns the denoms created with the token factory.
+func (k BaseViewKeeper) GetAllFactoryDenoms(ctx context.Context) ([]types.FactoryDenom, error) {
+	iter, err := k.FactoryDenoms.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Values()
+}
+
+// CreateFactoryDenom creates the factory denom factory/{creator}/{subdenom}, administrated by its creator.
+// The denom creation fee is burned from the balance of the creator.
+func (k BaseKeeper) CreateFactoryDenom(ctx context.Context, creator sdk.AccAddress, subdenom string) (string, error) {
+	creatorStr, err := k.ak.AddressCodec().BytesToString(creator)
+	if err != nil {
+		return "", err
+	}
+
+	denom, err := types.BuildFactoryDenom(creatorStr, subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	key := collections.Join(creator, subdenom)
+	if has, err := k.FactoryDenoms.Has(ctx, key); err != nil {
+		return "", err
+	} else if has || k.HasSupply(ctx, denom) || k.HasDenomMetaData(ctx, denom) {
+		return "", errorsmod.Wrapf(types.ErrDenomExists, "denom %s", denom)
+	}
+
+	if fee := k.GetParams(ctx).DenomCreationFee; !fee.IsZero() {
+		if err := k.BurnCoins(ctx, creator, fee); err != nil {
+			return "", errorsmod.Wrap(err, "failed to pay the denom creation fee")
+		}
+	}
+
+	if err := k.FactoryDenoms.Set(ctx, key, types.FactoryDenom{Denom: denom, Admin: creatorStr}); err != nil {
+		return "", err
+	}
+
+	// the admin can replace the default metadata with MsgSetDenomMetadata
+	k.SetDenomMetaData(ctx, types.Metadata{
+		Base:       denom,
+		Display:    denom,
+		Name:       denom,
+		Symbol:     subdenom,
+		DenomUnits: []*types.DenomUnit{{Denom: denom, Exponent: 0}},
+	})
+
+	return denom, k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCreateDenom,
+		event.NewAttribute(types.AttributeKeyCreator, creatorStr),
+		event.NewAttribute(types.AttributeKeyNewDenom, denom),
+	)
+}
+
+// MintFactoryDenom mints coins of a factory denom to the recipient. The admin must be the admin of the denom.
+// Minting does not apply the send hook of the denom.
+func (k BaseKeeper) MintFactoryDenom(ctx context.Context, admin, recipient sdk.AccAddress, coin sdk.Coin) error {
+	fd, err := k.getFactoryDenomForAdmin(ctx, coin.Denom, admin)
+	if err != nil {
+		return err
+	}
+
+	if !coin.IsValid() || !coin.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, coin.String())
+	}
+
+	if k.BlockedAddr(recipient) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipient)
+	}
+
+	amounts := sdk.NewCoins(coin)
+	if err := k.addCoins(ctx, recipient, amounts); err != nil {
+		return err
+	}
+
+	k.setSupply(ctx, k.GetSupply(ctx, coin.Denom).Add(coin))
+
+	k.Logger.Debug("minted factory denom", "amount", amounts.String(), "admin", fd.Admin)
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCoinMint,
+		event.NewAttribute(types.AttributeKeyMinter, fd.Admin),
+		event.NewAttribute(sdk.AttributeKeyAmount, amounts.String()),
+	); err != nil {
+		return err
+	}
+
+	return k.afterCoinsMinted(ctx, recipient, amounts)
+}
+
+// BurnFactoryDenom burns coins of a factory denom from the balance of its admin.
+func (k BaseKeeper) BurnFactoryDenom(ctx context.Context, admin sdk.AccAddress, coin sdk.Coin) error {
+	if _, err := k.getFactoryDenomForAdmin(ctx, coin.Denom, admin); err != nil {
+		return err
+	}
+
+	if !coin.IsValid() || !coin.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, coin.String())
+	}
+
+	return k.BurnCoins(ctx, admin, sdk.NewCoins(coin))
+}
+
+// ChangeFactoryDenomAdmin transfers the admin of a factory denom to newAdmin.
+// An empty newAdmin renounces the admin, making the supply and metadata of the denom immutable.
+func (k BaseKeeper) ChangeFactoryDenomAdmin(ctx context.Context, denom string, admin, newAdmin sdk.AccAddress) error {
+	fd, err := k.getFactoryDenomForAdmin(ctx, denom, admin)
+	if err != nil {
+		return err
+	}
+
+	fd.Admin = ""
+	if len(newAdmin) > 0 {
+		if fd.Admin, err = k.ak.AddressCodec().BytesToString(newAdmin); err != nil {
+			return err
+		}
+	}
+
+	if err := k.setFactoryDenom(ctx, fd); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeChangeDenomAdmin,
+		event.NewAttribute(types.AttributeKeyDenom, denom),
+		event.NewAttribute(types.AttributeKeyAdmin, fd.Admin),
+	)
+}
+
+// SetFactoryDenomMetadata sets the metadata of a factory denom, which is the base of the metadata.
+func (k BaseKeeper) SetFactoryDenomMetadata(ctx context.Context, admin sdk.AccAddress, metadata types.Metadata) error {
+	if _, err := k.getFactoryDenomForAdmin(ctx, metadata.Base, admin); err != nil {
+		return err
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	k.SetDenomMetaData(ctx, metadata)
+	return nil
+}
+
+// SetFactoryDenomSendHook attaches the registered send hook with the given name to a factory denom.
+// An empty name removes the send hook of the denom.
+func (k BaseKeeper) SetFactoryDenomSendHook(ctx context.Context, denom string, admin sdk.AccAddress, hook string) error {
+	fd, err := k.getFactoryDenomForAdmin(ctx, denom, admin)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := k.sendHooks.get(hook); hook != "" && !ok {
+		return errorsmod.Wrapf(types.ErrUnknownSendHook, "%q", hook)
+	}
+
+	fd.SendHook = hook
+	if err := k.setFactoryDenom(ctx, fd); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSetDenomSendHook,
+		event.NewAttribute(types.AttributeKeyDenom, denom),
+		event.NewAttribute(types.AttributeKeySendHook, hook),
+	)
+}
+
+// getFactoryDenomForAdmin returns the factory denom, checking that admin is its admin.
+func (k BaseKeeper) getFactoryDenomForAdmin(ctx context.Context, denom string, admin sdk.AccAddress) (types.FactoryDenom, error) {
+	fd, err := k.GetFactoryDenom(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return fd, errorsmod.Wrapf(types.ErrInvalidFactoryDenom, "denom %s does not exist", denom)
+		}
+		return fd, err
+	}
+
+	adminStr, err := k.ak.AddressCodec().BytesToString(admin)
+	if err != nil {
+		return fd, err
+	}
+
+	if fd.Admin == "" || fd.Admin != adminStr {
+		return fd, errorsmod.Wrapf(types.ErrNotDenomAdmin, "%s is not the admin of %s", adminStr, denom)
+	}
+
+	return fd, nil
+}
+
+// setFactoryDenom stores a factory denom.
+func (k BaseKeeper) setFactoryDenom(ctx context.Context, fd types.FactoryDenom) error {
+	creator, subdenom, err := types.ParseFactoryDenom(fd.Denom)
+	if err != nil {
+		return err
+	}
+
+	creatorAddr, err := k.ak.AddressCodec().StringToBytes(creator)
+	if err != nil {
+		return fmt.Errorf("invalid creator of denom %s: %w", fd.Denom, err)
+	}
+
+	return k.FactoryDenoms.Set(ctx, collections.Join(sdk.AccAddress(creatorAddr), subdenom), fd)
+}
+
+// sendHooks is the registry of the send hooks which can be attached to factory denoms.
+// Like sendRestriction, it exists so that hooks can be registered without a pointer receiver.
+type sendHooks struct {
+	hooks map[string]types.SendRestrictionFn
+}
+
+func newSendHooks() *sendHooks {
+	return &sendHooks{hooks: make(map[string]types.SendRestrictionFn)}
+}
+
+func (h *sendHooks) register(name string, hook types.SendRestrictionFn) {
+	if name == "" || hook == nil {
+		panic("send hook must have a name and a function")
+	}
+	if _, ok := h.hooks[name]; ok {
+		panic(fmt.Sprintf("send hook %q already registered", name))
+	}
+
+	h.hooks[name] = hook
+}
+
+func (h *sendHooks) get(name string) (types.SendRestrictionFn, bool) {
+	if h == nil {
+		return nil, false
+	}
+
+	hook, ok := h.hooks[name]
+	return hook, ok
+}
+
+func (h *sendHooks) names() []string {
+	if h == nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(h.hooks))
+	for name := range h.hooks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
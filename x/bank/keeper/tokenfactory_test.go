@@ -0,0 +1,175 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/mock/gomock"
+
+	banktestutil "cosmossdk.io/x/bank/testutil"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) createFactoryDenom(creator sdk.AccAddress, subdenom string) string {
+	creatorStr, err := suite.authKeeper.AddressCodec().BytesToString(creator)
+	suite.Require().NoError(err)
+
+	res, err := suite.msgServer.CreateDenom(suite.ctx, &banktypes.MsgCreateDenom{Creator: creatorStr, Subdenom: subdenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal("factory/"+creatorStr+"/"+subdenom, res.NewDenom)
+
+	return res.NewDenom
+}
+
+func (suite *KeeperTestSuite) TestTokenFactory() {
+	ctx := suite.ctx
+	require := suite.Require()
+	ac := suite.authKeeper.AddressCodec()
+
+	creator, other := accAddrs[0], accAddrs[1]
+	creatorStr, err := ac.BytesToString(creator)
+	require.NoError(err)
+	otherStr, err := ac.BytesToString(other)
+	require.NoError(err)
+	creatorAcc := authtypes.NewBaseAccountWithAddress(creator)
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), creator).Return(creatorAcc).AnyTimes()
+
+	// the denom creation fee is burned
+	suite.mockFundAccount(creator)
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, creator, initCoins))
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	params := suite.bankKeeper.GetParams(ctx)
+	params.DenomCreationFee = fee
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+
+	denom := suite.createFactoryDenom(creator, "token")
+	require.Equal(initCoins.Sub(fee...), suite.bankKeeper.GetAllBalances(ctx, creator))
+	require.Equal(initCoins.Sub(fee...)[0], suite.bankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+	require.True(suite.bankKeeper.HasDenomMetaData(ctx, denom))
+
+	_, err = suite.msgServer.CreateDenom(ctx, &banktypes.MsgCreateDenom{Creator: creatorStr, Subdenom: "token"})
+	require.ErrorIs(err, banktypes.ErrDenomExists)
+
+	res, err := suite.queryClient.FactoryDenom(ctx, &banktypes.QueryFactoryDenomRequest{Denom: denom})
+	require.NoError(err)
+	require.Equal(banktypes.FactoryDenom{Denom: denom, Admin: creatorStr}, res.FactoryDenom)
+
+	// only the admin mints and burns
+	_, err = suite.msgServer.MintDenom(ctx, &banktypes.MsgMintDenom{Admin: otherStr, Amount: sdk.NewInt64Coin(denom, 100)})
+	require.ErrorIs(err, banktypes.ErrNotDenomAdmin)
+
+	_, err = suite.msgServer.MintDenom(ctx, &banktypes.MsgMintDenom{Admin: creatorStr, Amount: sdk.NewInt64Coin(denom, 100)})
+	require.NoError(err)
+	_, err = suite.msgServer.MintDenom(ctx, &banktypes.MsgMintDenom{Admin: creatorStr, Amount: sdk.NewInt64Coin(denom, 50), Recipient: otherStr})
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(denom, 100), suite.bankKeeper.GetBalance(ctx, creator, denom))
+	require.Equal(sdk.NewInt64Coin(denom, 50), suite.bankKeeper.GetBalance(ctx, other, denom))
+	require.Equal(sdk.NewInt64Coin(denom, 150), suite.bankKeeper.GetSupply(ctx, denom))
+
+	_, err = suite.msgServer.BurnDenom(ctx, &banktypes.MsgBurnDenom{Admin: creatorStr, Amount: sdk.NewInt64Coin(denom, 30)})
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(denom, 70), suite.bankKeeper.GetBalance(ctx, creator, denom))
+	require.Equal(sdk.NewInt64Coin(denom, 120), suite.bankKeeper.GetSupply(ctx, denom))
+
+	// the metadata base must be the denom
+	metadata := banktypes.Metadata{
+		Base:       denom,
+		Display:    "TOKEN",
+		Name:       "Token",
+		Symbol:     "TKN",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}, {Denom: "TOKEN", Exponent: 6}},
+	}
+	_, err = suite.msgServer.SetDenomMetadata(ctx, &banktypes.MsgSetDenomMetadata{Admin: creatorStr, Metadata: metadata})
+	require.NoError(err)
+	stored, _ := suite.bankKeeper.GetDenomMetaData(ctx, denom)
+	require.Equal(metadata, stored)
+
+	metadata.Base = sdk.DefaultBondDenom
+	_, err = suite.msgServer.SetDenomMetadata(ctx, &banktypes.MsgSetDenomMetadata{Admin: creatorStr, Metadata: metadata})
+	require.Error(err)
+
+	// the admin is transferred, then renounced
+	_, err = suite.msgServer.ChangeDenomAdmin(ctx, &banktypes.MsgChangeDenomAdmin{Admin: creatorStr, Denom: denom, NewAdmin: otherStr})
+	require.NoError(err)
+	_, err = suite.msgServer.MintDenom(ctx, &banktypes.MsgMintDenom{Admin: creatorStr, Amount: sdk.NewInt64Coin(denom, 1)})
+	require.ErrorIs(err, banktypes.ErrNotDenomAdmin)
+
+	_, err = suite.msgServer.ChangeDenomAdmin(ctx, &banktypes.MsgChangeDenomAdmin{Admin: otherStr, Denom: denom})
+	require.NoError(err)
+	_, err = suite.msgServer.MintDenom(ctx, &banktypes.MsgMintDenom{Admin: otherStr, Amount: sdk.NewInt64Coin(denom, 1)})
+	require.ErrorIs(err, banktypes.ErrNotDenomAdmin)
+
+	// the denoms are queried by creator and exported
+	suite.createFactoryDenom(creator, "other")
+	byCreator, err := suite.queryClient.FactoryDenomsByCreator(ctx, &banktypes.QueryFactoryDenomsByCreatorRequest{Creator: creatorStr})
+	require.NoError(err)
+	require.Len(byCreator.FactoryDenoms, 2)
+	byCreator, err = suite.queryClient.FactoryDenomsByCreator(ctx, &banktypes.QueryFactoryDenomsByCreatorRequest{Creator: otherStr})
+	require.NoError(err)
+	require.Empty(byCreator.FactoryDenoms)
+
+	genesis, err := suite.bankKeeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.Len(genesis.FactoryDenoms, 2)
+	require.NoError(genesis.Validate())
+}
+
+func (suite *KeeperTestSuite) TestTokenFactoryInvalidDenoms() {
+	creatorStr, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[0])
+	suite.Require().NoError(err)
+
+	for _, subdenom := range []string{"", "a!b", "thissubdenomislongerthanthemaximumof44characters"} {
+		_, err := suite.msgServer.CreateDenom(suite.ctx, &banktypes.MsgCreateDenom{Creator: creatorStr, Subdenom: subdenom})
+		suite.Require().ErrorIs(err, banktypes.ErrInvalidFactoryDenom, subdenom)
+	}
+
+	_, err = suite.queryClient.FactoryDenom(suite.ctx, &banktypes.QueryFactoryDenomRequest{Denom: "factory/" + creatorStr + "/unknown"})
+	suite.Require().ErrorContains(err, "not found")
+}
+
+func (suite *KeeperTestSuite) TestTokenFactorySendHook() {
+	ctx := suite.ctx
+	require := suite.Require()
+	ac := suite.authKeeper.AddressCodec()
+
+	admin, recipient, blocked := accAddrs[0], accAddrs[1], accAddrs[2]
+	adminStr, err := ac.BytesToString(admin)
+	require.NoError(err)
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), admin).Return(authtypes.NewBaseAccountWithAddress(admin)).AnyTimes()
+
+	suite.bankKeeper.RegisterSendHook("denylist", func(_ context.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if toAddr.Equals(blocked) {
+			return nil, errors.New("recipient is denied")
+		}
+		return toAddr, nil
+	})
+	require.Equal([]string{"denylist"}, suite.bankKeeper.SendHooks())
+
+	denom := suite.createFactoryDenom(admin, "token")
+	_, err = suite.msgServer.MintDenom(ctx, &banktypes.MsgMintDenom{Admin: adminStr, Amount: sdk.NewInt64Coin(denom, 100)})
+	require.NoError(err)
+
+	_, err = suite.msgServer.SetDenomSendHook(ctx, &banktypes.MsgSetDenomSendHook{Admin: adminStr, Denom: denom, SendHook: "unknown"})
+	require.ErrorIs(err, banktypes.ErrUnknownSendHook)
+	_, err = suite.msgServer.SetDenomSendHook(ctx, &banktypes.MsgSetDenomSendHook{Admin: adminStr, Denom: denom, SendHook: "denylist"})
+	require.NoError(err)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 10))
+	require.NoError(suite.bankKeeper.SendCoins(ctx, admin, recipient, coins))
+	require.ErrorContains(suite.bankKeeper.SendCoins(ctx, admin, blocked, coins), "recipient is denied")
+
+	blockedStr, err := ac.BytesToString(blocked)
+	require.NoError(err)
+	cacheCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+	err = suite.bankKeeper.InputOutputCoins(cacheCtx, banktypes.Input{Address: adminStr, Coins: coins}, []banktypes.Output{{Address: blockedStr, Coins: coins}})
+	require.ErrorContains(err, "recipient is denied")
+
+	// the send hook is removed
+	_, err = suite.msgServer.SetDenomSendHook(ctx, &banktypes.MsgSetDenomSendHook{Admin: adminStr, Denom: denom})
+	require.NoError(err)
+	require.NoError(suite.bankKeeper.SendCoins(ctx, admin, blocked, coins))
+	require.Equal(sdk.NewInt64Coin(denom, 80), suite.bankKeeper.GetBalance(ctx, admin, denom))
+}
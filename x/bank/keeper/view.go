@@ -30,6 +30,7 @@ type ViewKeeper interface {
 	LockedCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SpendableCoin(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetBalanceAt(ctx context.Context, addr sdk.AccAddress, denom string, height int64) (sdk.Coin, error)
 
 	IterateAccountBalances(ctx context.Context, addr sdk.AccAddress, cb func(coin sdk.Coin) (stop bool))
 	IterateAllBalances(ctx context.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
@@ -53,6 +54,29 @@ func (b BalancesIndexes) IndexesList() []collections.Index[collections.Pair[sdk.
 	return []collections.Index[collections.Pair[sdk.AccAddress, string], math.Int]{b.Denom}
 }
 
+var balanceCheckpointsKeyCodec = collections.TripleKeyCodec(collections.StringKey, sdk.AccAddressKey, collections.Uint64Key)
+
+func newBalanceCheckpointsIndexes(sb *collections.SchemaBuilder) BalanceCheckpointsIndexes {
+	return BalanceCheckpointsIndexes{
+		Height: indexes.NewMulti(
+			sb, types.BalanceCheckpointsByHeightPrefix, "balance_checkpoints_by_height_index",
+			collections.Uint64Key, balanceCheckpointsKeyCodec,
+			func(key collections.Triple[string, sdk.AccAddress, uint64], _ math.Int) (uint64, error) {
+				return key.K3(), nil
+			},
+		),
+	}
+}
+
+// BalanceCheckpointsIndexes indexes the balance checkpoints by height, to prune them.
+type BalanceCheckpointsIndexes struct {
+	Height *indexes.Multi[uint64, collections.Triple[string, sdk.AccAddress, uint64], math.Int]
+}
+
+func (b BalanceCheckpointsIndexes) IndexesList() []collections.Index[collections.Triple[string, sdk.AccAddress, uint64], math.Int] {
+	return []collections.Index[collections.Triple[string, sdk.AccAddress, uint64], math.Int]{b.Height}
+}
+
 // BaseViewKeeper implements a read only keeper implementation of ViewKeeper.
 type BaseViewKeeper struct {
 	appmodule.Environment
@@ -66,20 +90,30 @@ type BaseViewKeeper struct {
 	SendEnabled   collections.Map[string, bool]
 	Balances      *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], math.Int, BalancesIndexes]
 	Params        collections.Item[types.Params]
+	// FactoryDenoms are the denoms created with the token factory, keyed by creator and subdenom.
+	FactoryDenoms collections.Map[collections.Pair[sdk.AccAddress, string], types.FactoryDenom]
+	// BalanceCheckpoints are the balances of the accounts before their first change at a height,
+	// for the balance checkpoint denoms, keyed by denom, address and height.
+	BalanceCheckpoints *collections.IndexedMap[collections.Triple[string, sdk.AccAddress, uint64], math.Int, BalanceCheckpointsIndexes]
+	// CheckpointDenoms are the heights from which the balances of the balance checkpoint denoms are checkpointed.
+	CheckpointDenoms collections.Map[string, uint64]
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
 func NewBaseViewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, ak types.AccountKeeper) BaseViewKeeper {
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 	k := BaseViewKeeper{
-		Environment:   env,
-		cdc:           cdc,
-		ak:            ak,
-		Supply:        collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey, sdk.IntValue),
-		DenomMetadata: collections.NewMap(sb, types.DenomMetadataPrefix, "denom_metadata", collections.StringKey, codec.CollValue[types.Metadata](cdc)),
-		SendEnabled:   collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
-		Balances:      collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
-		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Environment:        env,
+		cdc:                cdc,
+		ak:                 ak,
+		Supply:             collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey, sdk.IntValue),
+		DenomMetadata:      collections.NewMap(sb, types.DenomMetadataPrefix, "denom_metadata", collections.StringKey, codec.CollValue[types.Metadata](cdc)),
+		SendEnabled:        collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
+		Balances:           collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
+		Params:             collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		FactoryDenoms:      collections.NewMap(sb, types.FactoryDenomsPrefix, "factory_denoms", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), codec.CollValue[types.FactoryDenom](cdc)),
+		BalanceCheckpoints: collections.NewIndexedMap(sb, types.BalanceCheckpointsPrefix, "balance_checkpoints", balanceCheckpointsKeyCodec, sdk.IntValue, newBalanceCheckpointsIndexes(sb)),
+		CheckpointDenoms:   collections.NewMap(sb, types.CheckpointDenomsPrefix, "checkpoint_denoms", collections.StringKey, collections.Uint64Value),
 	}
 
 	schema, err := sb.Build()
@@ -34,6 +34,7 @@ var (
 	_ module.HasInvariants       = AppModule{}
 
 	_ appmodule.AppModule             = AppModule{}
+	_ appmodule.HasEndBlocker         = AppModule{}
 	_ appmodule.HasMigrations         = AppModule{}
 	_ appmodule.HasGenesis            = AppModule{}
 	_ appmodule.HasRegisterInterfaces = AppModule{}
@@ -151,6 +152,11 @@ func (am AppModule) ExportGenesis(ctx context.Context) (json.RawMessage, error)
 	return am.cdc.MarshalJSON(gs)
 }
 
+// EndBlock prunes the balance checkpoints which are not retained anymore.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.keeper.PruneBalanceCheckpoints(ctx)
+}
+
 // ConsensusVersion implements HasConsensusVersion
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
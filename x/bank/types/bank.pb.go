@@ -37,6 +37,14 @@ type Params struct {
 	// As of cosmos-sdk 0.47, this only exists for backwards compatibility of genesis files.
 	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"` // Deprecated: Do not use.
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty"`
+	// denom_creation_fee is the fee charged, and burned, for creating a token factory denom.
+	DenomCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=denom_creation_fee,json=denomCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"denom_creation_fee"`
+	// balance_checkpoint_denoms are the denoms whose historical balances are checkpointed,
+	// allowing to query the balance of an account at a past height with Query/BalanceAt.
+	BalanceCheckpointDenoms []string `protobuf:"bytes,4,rep,name=balance_checkpoint_denoms,json=balanceCheckpointDenoms,proto3" json:"balance_checkpoint_denoms,omitempty"`
+	// balance_checkpoint_retention is the number of past heights whose balances remain queryable
+	// with Query/BalanceAt, the older checkpoints being pruned. 0 retains the checkpoints forever.
+	BalanceCheckpointRetention uint64 `protobuf:"varint,5,opt,name=balance_checkpoint_retention,json=balanceCheckpointRetention,proto3" json:"balance_checkpoint_retention,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -87,6 +95,27 @@ func (m *Params) GetDefaultSendEnabled() bool {
 	return false
 }
 
+func (m *Params) GetDenomCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DenomCreationFee
+	}
+	return nil
+}
+
+func (m *Params) GetBalanceCheckpointDenoms() []string {
+	if m != nil {
+		return m.BalanceCheckpointDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetBalanceCheckpointRetention() uint64 {
+	if m != nil {
+		return m.BalanceCheckpointRetention
+	}
+	return 0
+}
+
 // SendEnabled maps coin denom to a send_enabled status (whether a denom is
 // sendable).
 type SendEnabled struct {
@@ -443,6 +472,72 @@ func (m *Metadata) GetURIHash() string {
 	return ""
 }
 
+// FactoryDenom defines the authority over a denom created with the token factory.
+type FactoryDenom struct {
+	// denom is the factory denom, of the form factory/{creator}/{subdenom}.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// admin is the account allowed to mint, burn and administrate the denom.
+	// It is empty once the admin has been renounced.
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// send_hook is the name of the send hook applied to the transfers of the denom, if any.
+	// Send hooks are registered by the application.
+	SendHook string `protobuf:"bytes,3,opt,name=send_hook,json=sendHook,proto3" json:"send_hook,omitempty"`
+}
+
+func (m *FactoryDenom) Reset()         { *m = FactoryDenom{} }
+func (m *FactoryDenom) String() string { return proto.CompactTextString(m) }
+func (*FactoryDenom) ProtoMessage()    {}
+func (*FactoryDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{7}
+}
+func (m *FactoryDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FactoryDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FactoryDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FactoryDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FactoryDenom.Merge(m, src)
+}
+func (m *FactoryDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FactoryDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FactoryDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FactoryDenom proto.InternalMessageInfo
+
+func (m *FactoryDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FactoryDenom) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *FactoryDenom) GetSendHook() string {
+	if m != nil {
+		return m.SendHook
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
@@ -451,57 +546,67 @@ func init() {
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
 	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
 	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*FactoryDenom)(nil), "cosmos.bank.v1beta1.FactoryDenom")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 706 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbd, 0x6f, 0x13, 0x4b,
-	0x10, 0xf7, 0xfa, 0x3b, 0xeb, 0x3c, 0x3d, 0xbd, 0x8d, 0xa5, 0x77, 0xf1, 0xd3, 0x3b, 0x5b, 0x6e,
-	0x30, 0x06, 0xdb, 0xf9, 0x40, 0x48, 0xb8, 0x41, 0x38, 0x7c, 0xb9, 0x40, 0xa0, 0x8b, 0x22, 0x24,
-	0x1a, 0x6b, 0xed, 0x5b, 0xec, 0x55, 0xee, 0x76, 0x4f, 0xb7, 0x7b, 0x21, 0x6e, 0xa9, 0x10, 0x15,
-	0x35, 0x55, 0x44, 0x85, 0x10, 0x85, 0x8b, 0xf4, 0xb4, 0x51, 0xaa, 0x88, 0x0a, 0xa5, 0x08, 0xc8,
-	0x29, 0x9c, 0x3f, 0x03, 0xdd, 0xde, 0x9d, 0xed, 0x08, 0xa7, 0x45, 0xa2, 0xb1, 0x77, 0xe6, 0xf7,
-	0x9b, 0x99, 0xdf, 0xcc, 0xce, 0x2d, 0xd4, 0x7b, 0x5c, 0xd8, 0x5c, 0x34, 0xba, 0x98, 0xed, 0x36,
-	0xf6, 0xd6, 0xbb, 0x44, 0xe2, 0x75, 0x65, 0xd4, 0x1d, 0x97, 0x4b, 0x8e, 0x56, 0x02, 0xbc, 0xae,
-	0x5c, 0x21, 0x5e, 0xc8, 0xf7, 0x79, 0x9f, 0x2b, 0xbc, 0xe1, 0x9f, 0x02, 0x6a, 0x61, 0x35, 0xa0,
-	0x76, 0x02, 0x20, 0x8c, 0x0b, 0xa0, 0x59, 0x15, 0x41, 0xa6, 0x55, 0x7a, 0x9c, 0xb2, 0x10, 0xff,
-	0x37, 0xc4, 0x6d, 0xd1, 0x6f, 0xec, 0xad, 0xfb, 0x7f, 0x21, 0xf0, 0x0f, 0xb6, 0x29, 0xe3, 0x0d,
-	0xf5, 0x1b, 0xb8, 0xca, 0x1f, 0x00, 0x4c, 0x3f, 0xc3, 0x2e, 0xb6, 0x05, 0x7a, 0x04, 0x97, 0x05,
-	0x61, 0x66, 0x87, 0x30, 0xdc, 0xb5, 0x88, 0xa9, 0x81, 0x52, 0xa2, 0x92, 0xdb, 0x28, 0xd5, 0x17,
-	0x68, 0xae, 0x6f, 0x13, 0x66, 0x3e, 0x08, 0x78, 0xad, 0xb8, 0x06, 0x8c, 0x9c, 0x98, 0x39, 0xd0,
-	0x1a, 0xcc, 0x9b, 0xe4, 0x25, 0xf6, 0x2c, 0xd9, 0xb9, 0x94, 0x30, 0x5e, 0x02, 0x95, 0xac, 0x81,
-	0x42, 0x6c, 0x2e, 0x45, 0xf3, 0xff, 0xb7, 0x93, 0x51, 0x55, 0x0b, 0x0a, 0xd5, 0x84, 0xb9, 0xdb,
-	0xd8, 0x0f, 0x46, 0x18, 0x28, 0x2b, 0x6f, 0xc1, 0xdc, 0x1c, 0x1b, 0xe5, 0x61, 0xca, 0x24, 0x8c,
-	0xdb, 0x1a, 0x28, 0x81, 0xca, 0x92, 0x11, 0x18, 0x48, 0x83, 0x99, 0xcb, 0x85, 0x22, 0xb3, 0x99,
-	0xbc, 0x38, 0x28, 0x82, 0xf2, 0x31, 0x80, 0xa9, 0x36, 0x73, 0x3c, 0x89, 0x36, 0x60, 0x06, 0x9b,
-	0xa6, 0x4b, 0x84, 0x08, 0x32, 0xb4, 0xb4, 0xaf, 0x87, 0xb5, 0x7c, 0xd8, 0xe6, 0xbd, 0x00, 0xd9,
-	0x96, 0x2e, 0x65, 0x7d, 0x23, 0x22, 0xa2, 0x57, 0x30, 0xe5, 0x4f, 0x58, 0x68, 0x71, 0x35, 0x95,
-	0xd5, 0xd9, 0x54, 0x04, 0x99, 0x4e, 0x65, 0x8b, 0x53, 0xd6, 0x7a, 0x78, 0x74, 0x56, 0x8c, 0x7d,
-	0xfa, 0x5e, 0xac, 0xf4, 0xa9, 0x1c, 0x78, 0xdd, 0x7a, 0x8f, 0xdb, 0xe1, 0xf5, 0x35, 0xe6, 0x1a,
-	0x94, 0x43, 0x87, 0x08, 0x15, 0x20, 0xde, 0x4f, 0x46, 0xd5, 0x65, 0x8b, 0xf4, 0x71, 0x6f, 0xd8,
-	0x51, 0x35, 0x3e, 0x4e, 0x46, 0x55, 0x60, 0x04, 0xf5, 0x9a, 0xf9, 0x37, 0x07, 0xc5, 0xd8, 0xc5,
-	0x41, 0x31, 0xf6, 0x7a, 0x32, 0xaa, 0x46, 0x72, 0xca, 0x5f, 0x00, 0x4c, 0x3f, 0xf5, 0xe4, 0x1f,
-	0xd7, 0x4d, 0x36, 0xea, 0xa6, 0xfc, 0x19, 0xc0, 0xf4, 0xb6, 0xe7, 0x38, 0xd6, 0xd0, 0x57, 0x23,
-	0xb9, 0xc4, 0x56, 0xb8, 0x71, 0xbf, 0x43, 0x8d, 0xaa, 0xd7, 0xbc, 0x1e, 0xaa, 0x01, 0xc7, 0x87,
-	0xb5, 0xff, 0x16, 0xae, 0xb9, 0x12, 0xd8, 0xd6, 0x40, 0xf9, 0x39, 0x5c, 0xba, 0xef, 0xaf, 0xd9,
-	0x0e, 0xa3, 0xf2, 0x8a, 0x05, 0x2c, 0xc0, 0x2c, 0xd9, 0x77, 0x38, 0x23, 0x4c, 0xaa, 0x0d, 0xfc,
-	0xcb, 0x98, 0xda, 0xfe, 0x72, 0x62, 0x8b, 0x62, 0x41, 0x84, 0x96, 0x28, 0x25, 0x2a, 0x4b, 0x46,
-	0x64, 0x96, 0x4f, 0xe3, 0x30, 0xfb, 0x84, 0x48, 0x6c, 0x62, 0x89, 0x51, 0x09, 0xe6, 0x4c, 0x22,
-	0x7a, 0x2e, 0x75, 0x24, 0xe5, 0x2c, 0x4c, 0x3f, 0xef, 0x42, 0x77, 0x7d, 0x06, 0xe3, 0x76, 0xc7,
-	0x63, 0x54, 0x46, 0xf7, 0xa7, 0x2f, 0xfc, 0x46, 0xa7, 0x7a, 0x0d, 0x68, 0x46, 0x47, 0x81, 0x10,
-	0x4c, 0xfa, 0x73, 0xd5, 0x12, 0x2a, 0xb7, 0x3a, 0xfb, 0xea, 0x4c, 0x2a, 0x1c, 0x0b, 0x0f, 0xb5,
-	0xa4, 0x72, 0x47, 0x26, 0xba, 0x06, 0x93, 0x0c, 0xdb, 0x44, 0x4b, 0xa9, 0xcd, 0x5a, 0x39, 0x3d,
-	0xac, 0xfd, 0x3d, 0x1b, 0x74, 0x69, 0xad, 0x7e, 0x6b, 0xd3, 0x50, 0x04, 0x74, 0x03, 0xa6, 0xc5,
-	0xd0, 0xee, 0x72, 0x4b, 0x4b, 0x5f, 0x4d, 0x0d, 0x29, 0xe8, 0x26, 0x4c, 0x78, 0x2e, 0xd5, 0x32,
-	0x8a, 0x59, 0x18, 0x9f, 0x15, 0x13, 0x3b, 0x46, 0xfb, 0xd7, 0x80, 0xdb, 0x86, 0x4f, 0x43, 0x77,
-	0x60, 0xd6, 0x73, 0x69, 0x67, 0x80, 0xc5, 0x40, 0xcb, 0xaa, 0x10, 0x7d, 0x7c, 0x56, 0xcc, 0xec,
-	0x18, 0xed, 0xc7, 0x58, 0x0c, 0x16, 0x85, 0x65, 0x3c, 0x97, 0xfa, 0x58, 0x6b, 0xf3, 0x68, 0xac,
-	0x83, 0x93, 0xb1, 0x0e, 0x7e, 0x8c, 0x75, 0xf0, 0xee, 0x5c, 0x8f, 0x9d, 0x9c, 0xeb, 0xb1, 0x6f,
-	0xe7, 0x7a, 0xec, 0x45, 0xf8, 0xbc, 0x0a, 0x73, 0xb7, 0x4e, 0x79, 0xf4, 0xdc, 0xa8, 0xc5, 0xe9,
-	0xa6, 0xd5, 0xcb, 0xb8, 0xf9, 0x33, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x68, 0x6d, 0x04, 0xcd, 0x05,
-	0x00, 0x00,
+	// 852 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xbf, 0x6f, 0x23, 0x45,
+	0x14, 0xf6, 0xf8, 0xb7, 0xc7, 0x41, 0xc0, 0xc4, 0xd2, 0x4d, 0x7c, 0xb0, 0xb6, 0xdc, 0x60, 0x02,
+	0xb1, 0x2f, 0x17, 0x40, 0xc2, 0x0d, 0xc2, 0x81, 0x70, 0x29, 0xd0, 0xa1, 0x8d, 0x22, 0x04, 0xcd,
+	0x6a, 0xbc, 0x3b, 0x67, 0x8f, 0xbc, 0x3b, 0xb3, 0xda, 0x99, 0x3d, 0xce, 0x2d, 0x05, 0x42, 0x54,
+	0xd4, 0x54, 0x11, 0x15, 0x42, 0x14, 0x29, 0xd2, 0xd3, 0x9e, 0xae, 0x3a, 0x5d, 0x85, 0xae, 0x08,
+	0xc8, 0x29, 0x72, 0x1d, 0xff, 0x02, 0xda, 0x99, 0x5d, 0x27, 0xa7, 0x6c, 0x14, 0xd1, 0x20, 0xd1,
+	0xd8, 0xfb, 0xde, 0xf7, 0xbd, 0x37, 0xdf, 0xbc, 0x37, 0xf3, 0x06, 0x5a, 0xae, 0x90, 0x81, 0x90,
+	0xc3, 0x09, 0xe1, 0xf3, 0xe1, 0xc3, 0xed, 0x09, 0x55, 0x64, 0x5b, 0x1b, 0x83, 0x30, 0x12, 0x4a,
+	0xa0, 0x75, 0x83, 0x0f, 0xb4, 0x2b, 0xc5, 0xdb, 0xad, 0xa9, 0x98, 0x0a, 0x8d, 0x0f, 0x93, 0x2f,
+	0x43, 0x6d, 0x6f, 0x18, 0xaa, 0x63, 0x80, 0x34, 0xce, 0x40, 0x17, 0xab, 0x48, 0xba, 0x5a, 0xc5,
+	0x15, 0x8c, 0xa7, 0xf8, 0xad, 0x14, 0x0f, 0xe4, 0x74, 0xf8, 0x70, 0x3b, 0xf9, 0x4b, 0x81, 0xd7,
+	0x49, 0xc0, 0xb8, 0x18, 0xea, 0x5f, 0xe3, 0xea, 0xfd, 0x5d, 0x82, 0xd5, 0x2f, 0x48, 0x44, 0x02,
+	0x89, 0x3e, 0x83, 0x6b, 0x92, 0x72, 0xcf, 0xa1, 0x9c, 0x4c, 0x7c, 0xea, 0x61, 0xd0, 0x2d, 0xf5,
+	0x9b, 0x77, 0xbb, 0x83, 0x1c, 0xcd, 0x83, 0x03, 0xca, 0xbd, 0x4f, 0x0d, 0x6f, 0x5c, 0xc4, 0xc0,
+	0x6e, 0xca, 0x0b, 0x07, 0xba, 0x03, 0x5b, 0x1e, 0x7d, 0x40, 0x62, 0x5f, 0x39, 0x2f, 0x25, 0x2c,
+	0x76, 0x41, 0xbf, 0x6e, 0xa3, 0x14, 0xbb, 0x94, 0x02, 0xfd, 0x0c, 0x20, 0xf2, 0x28, 0x17, 0x81,
+	0xe3, 0x46, 0x94, 0x28, 0x26, 0xb8, 0xf3, 0x80, 0x52, 0x5c, 0xd2, 0x0a, 0x36, 0x2e, 0x14, 0x48,
+	0xba, 0x52, 0xb0, 0x2b, 0x18, 0x1f, 0x7f, 0xf5, 0xf8, 0xb4, 0x53, 0xf8, 0xf5, 0xcf, 0x4e, 0x7f,
+	0xca, 0xd4, 0x2c, 0x9e, 0x0c, 0x5c, 0x11, 0xa4, 0xa5, 0x4a, 0xff, 0xb6, 0xa4, 0x37, 0x1f, 0xaa,
+	0x45, 0x48, 0xa5, 0x0e, 0x90, 0xcf, 0x4f, 0xb6, 0x5e, 0xbd, 0x40, 0xba, 0x77, 0x06, 0xef, 0xef,
+	0xfc, 0x74, 0x7e, 0xbc, 0xb9, 0xe6, 0xd3, 0x29, 0x71, 0x17, 0x4e, 0x52, 0x44, 0xf9, 0xcb, 0xf9,
+	0xf1, 0x26, 0xb0, 0x5f, 0xd3, 0x7a, 0x76, 0x53, 0x39, 0x7b, 0x94, 0xa2, 0xfb, 0x70, 0x63, 0x42,
+	0x7c, 0xc2, 0x5d, 0xea, 0xb8, 0x33, 0xea, 0xce, 0x43, 0xc1, 0xb8, 0x72, 0x34, 0x4d, 0xe2, 0x72,
+	0xb7, 0xd4, 0x6f, 0x8c, 0xd7, 0x73, 0xf2, 0xdb, 0xb7, 0xd2, 0xa8, 0xdd, 0x55, 0xd0, 0x27, 0x3a,
+	0x06, 0x1d, 0xc2, 0x37, 0x72, 0x12, 0x46, 0x54, 0x51, 0x9e, 0xac, 0x89, 0x2b, 0x5d, 0xd0, 0x2f,
+	0xe7, 0xe7, 0x6c, 0x5f, 0xc9, 0x69, 0x67, 0x61, 0xa3, 0x37, 0x7f, 0x38, 0x3f, 0xde, 0xc4, 0x97,
+	0xf6, 0xff, 0xc8, 0x9c, 0x47, 0xd3, 0xe6, 0xde, 0x2e, 0x6c, 0x5e, 0x2e, 0x7d, 0x0b, 0x56, 0xf4,
+	0x16, 0x30, 0xe8, 0x82, 0x7e, 0xc3, 0x36, 0x06, 0xc2, 0xb0, 0xf6, 0x72, 0xd7, 0x32, 0x73, 0x54,
+	0x7e, 0x71, 0xd4, 0x01, 0xbd, 0x27, 0x00, 0x56, 0xf6, 0x79, 0x18, 0x2b, 0x74, 0x17, 0xd6, 0x88,
+	0xe7, 0x45, 0x54, 0x4a, 0x93, 0x61, 0x8c, 0x9f, 0x9d, 0x6c, 0xb5, 0xd2, 0x8e, 0x7d, 0x6c, 0x90,
+	0x03, 0x15, 0x31, 0x3e, 0xb5, 0x33, 0x22, 0xfa, 0x06, 0x56, 0x74, 0xa5, 0x71, 0xf1, 0xa6, 0x06,
+	0xef, 0xfd, 0xdb, 0x06, 0x5f, 0xd3, 0x4d, 0xb3, 0xde, 0xa8, 0xf5, 0xfd, 0x51, 0xa7, 0xf0, 0xe2,
+	0xa8, 0x53, 0xf8, 0xf6, 0xfc, 0x78, 0x33, 0x93, 0xd3, 0xfb, 0x1d, 0xc0, 0xea, 0xfd, 0x58, 0xfd,
+	0xef, 0x76, 0x53, 0xcf, 0x76, 0xd3, 0xfb, 0x0d, 0xc0, 0xea, 0x41, 0x1c, 0x86, 0xfe, 0x22, 0x51,
+	0xa3, 0x84, 0x22, 0x3e, 0x06, 0xff, 0x99, 0x1a, 0xbd, 0xde, 0xe8, 0xed, 0x54, 0x0d, 0x78, 0x72,
+	0xb2, 0x75, 0x3b, 0x77, 0x66, 0x68, 0x81, 0xfb, 0x18, 0xf4, 0xbe, 0x84, 0x0d, 0x7d, 0x05, 0x0e,
+	0x39, 0x53, 0xd7, 0x1c, 0xc0, 0x36, 0xac, 0xd3, 0x47, 0xa1, 0xe0, 0x94, 0x2b, 0x7d, 0x02, 0x5f,
+	0xb1, 0x57, 0x76, 0x72, 0x38, 0x89, 0xcf, 0x88, 0xa4, 0x52, 0x4f, 0x88, 0x86, 0x9d, 0x99, 0xbd,
+	0xe7, 0x45, 0x58, 0xff, 0x9c, 0x2a, 0xe2, 0x11, 0x45, 0x50, 0x17, 0x36, 0x3d, 0x2a, 0xdd, 0x88,
+	0x85, 0xfa, 0x36, 0x99, 0xf4, 0x97, 0x5d, 0xe8, 0x23, 0xd8, 0x34, 0x53, 0x27, 0xe6, 0x4c, 0x65,
+	0xfd, 0xb3, 0x72, 0x07, 0xde, 0x4a, 0xaf, 0x0d, 0xbd, 0xec, 0x53, 0x22, 0x04, 0xcb, 0x49, 0x5d,
+	0x71, 0x49, 0xe7, 0xd6, 0xdf, 0x89, 0x3a, 0x8f, 0xc9, 0xd0, 0x27, 0x0b, 0x5c, 0xd6, 0xee, 0xcc,
+	0x44, 0x6f, 0xc1, 0x32, 0x27, 0x01, 0xd5, 0xf7, 0x3a, 0x6f, 0x56, 0xbc, 0xb7, 0x63, 0x6b, 0x02,
+	0x7a, 0x07, 0x56, 0xe5, 0x22, 0x98, 0x08, 0x1f, 0x57, 0xaf, 0xa7, 0xa6, 0x14, 0xf4, 0x2e, 0x2c,
+	0xc5, 0x11, 0xc3, 0x35, 0xcd, 0x6c, 0x2f, 0x4f, 0x3b, 0xa5, 0x43, 0x7b, 0xff, 0x6a, 0xc0, 0x07,
+	0x76, 0x42, 0x43, 0x1f, 0xc2, 0x7a, 0x1c, 0x31, 0x67, 0x46, 0xe4, 0x0c, 0xd7, 0x75, 0x88, 0xb5,
+	0x3c, 0xed, 0xd4, 0x0e, 0xed, 0xfd, 0x7b, 0x44, 0xce, 0xf2, 0xc2, 0x6a, 0x71, 0xc4, 0x12, 0xac,
+	0xf7, 0x1d, 0x80, 0x6b, 0x7b, 0xc4, 0x55, 0x22, 0x5a, 0xe8, 0x6a, 0x5c, 0xd3, 0xb9, 0x01, 0xac,
+	0x10, 0x2f, 0x60, 0x1c, 0x17, 0x6f, 0xb8, 0x40, 0x86, 0x86, 0x6e, 0xc3, 0x86, 0x7e, 0x25, 0x66,
+	0x42, 0xcc, 0xd3, 0x42, 0xd6, 0x13, 0xc7, 0x3d, 0x21, 0xe6, 0xa3, 0xf5, 0x67, 0x57, 0x87, 0xdf,
+	0x78, 0xe7, 0xf1, 0xd2, 0x02, 0x4f, 0x97, 0x16, 0xf8, 0x6b, 0x69, 0x81, 0x1f, 0xcf, 0xac, 0xc2,
+	0xd3, 0x33, 0xab, 0xf0, 0xc7, 0x99, 0x55, 0xf8, 0x3a, 0x7d, 0x34, 0xa5, 0x37, 0x1f, 0x30, 0x91,
+	0xcd, 0x3d, 0x7d, 0x82, 0x27, 0x55, 0xfd, 0xde, 0xed, 0xfc, 0x33, 0x00, 0x62, 0x9e, 0x13, 0x83,
+	0xa3, 0x07, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -580,6 +685,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BalanceCheckpointRetention != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.BalanceCheckpointRetention))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BalanceCheckpointDenoms) > 0 {
+		for iNdEx := len(m.BalanceCheckpointDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BalanceCheckpointDenoms[iNdEx])
+			copy(dAtA[i:], m.BalanceCheckpointDenoms[iNdEx])
+			i = encodeVarintBank(dAtA, i, uint64(len(m.BalanceCheckpointDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DenomCreationFee) > 0 {
+		for iNdEx := len(m.DenomCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.DefaultSendEnabled {
 		i--
 		if m.DefaultSendEnabled {
@@ -902,6 +1035,50 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FactoryDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FactoryDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FactoryDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SendHook) > 0 {
+		i -= len(m.SendHook)
+		copy(dAtA[i:], m.SendHook)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.SendHook)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBank(v)
 	base := offset
@@ -928,6 +1105,21 @@ func (m *Params) Size() (n int) {
 	if m.DefaultSendEnabled {
 		n += 2
 	}
+	if len(m.DenomCreationFee) > 0 {
+		for _, e := range m.DenomCreationFee {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if len(m.BalanceCheckpointDenoms) > 0 {
+		for _, s := range m.BalanceCheckpointDenoms {
+			l = len(s)
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if m.BalanceCheckpointRetention != 0 {
+		n += 1 + sovBank(uint64(m.BalanceCheckpointRetention))
+	}
 	return n
 }
 
@@ -1065,6 +1257,27 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
+func (m *FactoryDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.SendHook)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	return n
+}
+
 func sovBank(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1154,6 +1367,91 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DefaultSendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomCreationFee = append(m.DenomCreationFee, types.Coin{})
+			if err := m.DenomCreationFee[len(m.DenomCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceCheckpointDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BalanceCheckpointDenoms = append(m.BalanceCheckpointDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceCheckpointRetention", wireType)
+			}
+			m.BalanceCheckpointRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BalanceCheckpointRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
@@ -2034,6 +2332,152 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FactoryDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FactoryDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FactoryDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendHook", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendHook = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBank(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -13,4 +13,9 @@ var (
 	ErrDuplicateEntry        = errors.Register(ModuleName, 8, "duplicate entry")
 	ErrMultipleSenders       = errors.Register(ModuleName, 9, "multiple senders not allowed")
 	ErrInvalidSigner         = errors.Register(ModuleName, 10, "expected authority account as only signer for proposal message")
+	ErrInvalidFactoryDenom   = errors.Register(ModuleName, 11, "invalid factory denom")
+	ErrDenomExists           = errors.Register(ModuleName, 12, "denom already exists")
+	ErrNotDenomAdmin         = errors.Register(ModuleName, 13, "not the denom admin")
+	ErrUnknownSendHook       = errors.Register(ModuleName, 14, "unknown send hook")
+	ErrNoBalanceCheckpoints  = errors.Register(ModuleName, 15, "no balance checkpoints")
 )
@@ -21,4 +21,15 @@ const (
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
 	AttributeKeyBurner   = "burner"
+
+	// token factory events name and attributes
+	EventTypeCreateDenom      = "create_denom"
+	EventTypeChangeDenomAdmin = "change_denom_admin"
+	EventTypeSetDenomSendHook = "set_denom_send_hook"
+
+	AttributeKeyCreator  = "creator"
+	AttributeKeyNewDenom = "new_denom"
+	AttributeKeyDenom    = "denom"
+	AttributeKeyAdmin    = "admin"
+	AttributeKeySendHook = "send_hook"
 )
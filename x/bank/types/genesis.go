@@ -62,6 +62,55 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	seenFactoryDenoms := make(map[string]bool)
+	for _, fd := range gs.FactoryDenoms {
+		if seenFactoryDenoms[fd.Denom] {
+			return fmt.Errorf("duplicate factory denom %s", fd.Denom)
+		}
+
+		if err := fd.Validate(); err != nil {
+			return err
+		}
+
+		seenFactoryDenoms[fd.Denom] = true
+	}
+
+	checkpointed := make(map[string]bool, len(gs.Params.BalanceCheckpointDenoms))
+	for _, denom := range gs.Params.BalanceCheckpointDenoms {
+		checkpointed[denom] = true
+	}
+
+	seenCheckpointDenoms := make(map[string]bool)
+	for _, cd := range gs.CheckpointDenoms {
+		if seenCheckpointDenoms[cd.Denom] {
+			return fmt.Errorf("duplicate checkpoint denom %s", cd.Denom)
+		}
+
+		if !checkpointed[cd.Denom] {
+			return fmt.Errorf("checkpoint denom %s is not a balance checkpoint denom of the params", cd.Denom)
+		}
+
+		seenCheckpointDenoms[cd.Denom] = true
+	}
+
+	seenCheckpoints := make(map[string]bool)
+	for _, bc := range gs.BalanceCheckpoints {
+		if err := sdk.ValidateDenom(bc.Denom); err != nil {
+			return err
+		}
+
+		if bc.Amount.IsNil() || bc.Amount.IsNegative() {
+			return fmt.Errorf("invalid balance checkpoint amount %s for %s at height %d", bc.Amount, bc.Address, bc.Height)
+		}
+
+		key := fmt.Sprintf("%s/%s/%d", bc.Denom, bc.Address, bc.Height)
+		if seenCheckpoints[key] {
+			return fmt.Errorf("duplicate balance checkpoint of %s for %s at height %d", bc.Denom, bc.Address, bc.Height)
+		}
+
+		seenCheckpoints[key] = true
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -40,6 +41,12 @@ type GenesisState struct {
 	DenomMetadata []Metadata `protobuf:"bytes,4,rep,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata"`
 	// send_enabled defines the denoms where send is enabled or disabled.
 	SendEnabled []SendEnabled `protobuf:"bytes,5,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled"`
+	// factory_denoms defines the denoms created with the token factory.
+	FactoryDenoms []FactoryDenom `protobuf:"bytes,6,rep,name=factory_denoms,json=factoryDenoms,proto3" json:"factory_denoms"`
+	// checkpoint_denoms defines the denoms whose balances are checkpointed, with the height from which they are.
+	CheckpointDenoms []CheckpointDenom `protobuf:"bytes,7,rep,name=checkpoint_denoms,json=checkpointDenoms,proto3" json:"checkpoint_denoms"`
+	// balance_checkpoints defines the historical balances of the accounts for the checkpointed denoms.
+	BalanceCheckpoints []BalanceCheckpoint `protobuf:"bytes,8,rep,name=balance_checkpoints,json=balanceCheckpoints,proto3" json:"balance_checkpoints"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -110,6 +117,149 @@ func (m *GenesisState) GetSendEnabled() []SendEnabled {
 	return nil
 }
 
+func (m *GenesisState) GetFactoryDenoms() []FactoryDenom {
+	if m != nil {
+		return m.FactoryDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCheckpointDenoms() []CheckpointDenom {
+	if m != nil {
+		return m.CheckpointDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBalanceCheckpoints() []BalanceCheckpoint {
+	if m != nil {
+		return m.BalanceCheckpoints
+	}
+	return nil
+}
+
+// CheckpointDenom defines a denom whose balances are checkpointed, used in the bank module's genesis state.
+type CheckpointDenom struct {
+	// denom is the checkpointed denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// start_height is the height from which the balances of the denom are checkpointed.
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+}
+
+func (m *CheckpointDenom) Reset()         { *m = CheckpointDenom{} }
+func (m *CheckpointDenom) String() string { return proto.CompactTextString(m) }
+func (*CheckpointDenom) ProtoMessage()    {}
+func (*CheckpointDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f007de11b420c6e, []int{1}
+}
+func (m *CheckpointDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckpointDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointDenom.Merge(m, src)
+}
+func (m *CheckpointDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointDenom proto.InternalMessageInfo
+
+func (m *CheckpointDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *CheckpointDenom) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+// BalanceCheckpoint defines the balance of an account before its first change at a height,
+// used in the bank module's genesis state.
+type BalanceCheckpoint struct {
+	// denom is the denom of the balance.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// address is the address of the balance holder.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// height is the height at which the balance changed.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// amount is the balance before its first change at the height.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *BalanceCheckpoint) Reset()         { *m = BalanceCheckpoint{} }
+func (m *BalanceCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*BalanceCheckpoint) ProtoMessage()    {}
+func (*BalanceCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f007de11b420c6e, []int{2}
+}
+func (m *BalanceCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BalanceCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BalanceCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BalanceCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceCheckpoint.Merge(m, src)
+}
+func (m *BalanceCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *BalanceCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceCheckpoint proto.InternalMessageInfo
+
+func (m *BalanceCheckpoint) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *BalanceCheckpoint) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BalanceCheckpoint) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -123,7 +273,7 @@ func (m *Balance) Reset()         { *m = Balance{} }
 func (m *Balance) String() string { return proto.CompactTextString(m) }
 func (*Balance) ProtoMessage()    {}
 func (*Balance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f007de11b420c6e, []int{1}
+	return fileDescriptor_8f007de11b420c6e, []int{3}
 }
 func (m *Balance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -154,43 +304,58 @@ var xxx_messageInfo_Balance proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.bank.v1beta1.GenesisState")
+	proto.RegisterType((*CheckpointDenom)(nil), "cosmos.bank.v1beta1.CheckpointDenom")
+	proto.RegisterType((*BalanceCheckpoint)(nil), "cosmos.bank.v1beta1.BalanceCheckpoint")
 	proto.RegisterType((*Balance)(nil), "cosmos.bank.v1beta1.Balance")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x6d, 0x42, 0xd3, 0xf6, 0x12, 0x40, 0x98, 0x0e, 0x6e, 0x29, 0x4e, 0xe8, 0x14, 0x55,
-	0xca, 0x99, 0xa6, 0x48, 0x48, 0x0c, 0x48, 0xb8, 0x02, 0x26, 0x04, 0x6a, 0x36, 0x96, 0xe8, 0xec,
-	0x3b, 0x19, 0x2b, 0xf1, 0x9d, 0x95, 0x77, 0x05, 0xf2, 0x0d, 0x18, 0x99, 0x99, 0x3a, 0x22, 0xa6,
-	0x0e, 0xfd, 0x00, 0x88, 0xa9, 0x63, 0xc5, 0x84, 0x18, 0x00, 0x25, 0x43, 0xf9, 0x18, 0xc8, 0xf7,
-	0xae, 0x49, 0x24, 0x3c, 0xb3, 0xd8, 0x96, 0xff, 0xff, 0xf7, 0xfb, 0xbf, 0xf7, 0xee, 0xc8, 0xdd,
-	0x44, 0x41, 0xae, 0x20, 0x8c, 0x99, 0x1c, 0x86, 0x6f, 0xf6, 0x62, 0xa1, 0xd9, 0x5e, 0x98, 0x0a,
-	0x29, 0x20, 0x03, 0x5a, 0x8c, 0x95, 0x56, 0xde, 0x2d, 0xb4, 0xd0, 0xd2, 0x42, 0xad, 0x65, 0x6b,
-	0x23, 0x55, 0xa9, 0x32, 0x7a, 0x58, 0x7e, 0xa1, 0x75, 0x2b, 0x98, 0xd3, 0x40, 0xcc, 0x69, 0x89,
-	0xca, 0xe4, 0x3f, 0xfa, 0x52, 0x9a, 0xe1, 0xa2, 0xbe, 0x89, 0xfa, 0x00, 0xc1, 0x36, 0x17, 0xa5,
-	0x9b, 0x2c, 0xcf, 0xa4, 0x0a, 0xcd, 0x13, 0x7f, 0xed, 0x7c, 0xad, 0x91, 0xe6, 0x33, 0x6c, 0xb5,
-	0xaf, 0x99, 0x16, 0xde, 0x23, 0x52, 0x2f, 0xd8, 0x98, 0xe5, 0xe0, 0xbb, 0x6d, 0xb7, 0xd3, 0xe8,
-	0xdd, 0xa6, 0x15, 0xad, 0xd3, 0x97, 0xc6, 0x12, 0xad, 0x9f, 0xfd, 0x6c, 0x39, 0x9f, 0x2e, 0x4e,
-	0x76, 0xdd, 0x43, 0x5b, 0xe5, 0x1d, 0x90, 0xb5, 0x98, 0x8d, 0x98, 0x4c, 0x04, 0xf8, 0x57, 0xda,
-	0xb5, 0x4e, 0xa3, 0xb7, 0x5d, 0x49, 0x88, 0xd0, 0xb4, 0x8c, 0x98, 0x17, 0x7a, 0x13, 0x52, 0x87,
-	0xa3, 0xa2, 0x18, 0x4d, 0xfc, 0x9a, 0x41, 0x6c, 0x2e, 0x10, 0x20, 0xe6, 0x88, 0x03, 0x95, 0xc9,
-	0xe8, 0x69, 0x59, 0xff, 0xf9, 0x57, 0xab, 0x93, 0x66, 0xfa, 0xf5, 0x51, 0x4c, 0x13, 0x95, 0xdb,
-	0xa1, 0xed, 0xab, 0x0b, 0x7c, 0x18, 0xea, 0x49, 0x21, 0xc0, 0x14, 0xc0, 0xc7, 0x8b, 0x93, 0xdd,
-	0xe6, 0x48, 0xa4, 0x2c, 0x99, 0x0c, 0xca, 0xb5, 0x82, 0xed, 0x1f, 0x03, 0xbd, 0x17, 0xe4, 0x3a,
-	0x17, 0x52, 0xe5, 0x83, 0x5c, 0x68, 0xc6, 0x99, 0x66, 0xfe, 0x55, 0xd3, 0xc2, 0x9d, 0xca, 0x29,
-	0x9e, 0x5b, 0xd3, 0xf2, 0x18, 0xd7, 0x4c, 0xfd, 0xa5, 0xe2, 0x31, 0xd2, 0x04, 0x21, 0xf9, 0x40,
-	0x48, 0x16, 0x8f, 0x04, 0xf7, 0x57, 0x0c, 0xae, 0x5d, 0x89, 0xeb, 0x0b, 0xc9, 0x9f, 0xa0, 0x2f,
-	0xda, 0x2e, 0x89, 0x3f, 0x4e, 0xbb, 0x37, 0x16, 0x63, 0xb4, 0xef, 0xd1, 0xfb, 0x0f, 0x30, 0xa4,
-	0x01, 0x0b, 0xeb, 0xce, 0x17, 0x97, 0xac, 0xda, 0x7d, 0x7a, 0x3d, 0xb2, 0xca, 0x38, 0x1f, 0x0b,
-	0xc0, 0x03, 0x5c, 0x8f, 0xfc, 0x6f, 0xa7, 0xdd, 0x0d, 0x1b, 0xf6, 0x18, 0x95, 0xbe, 0x1e, 0x67,
-	0x32, 0x3d, 0xbc, 0x34, 0x7a, 0x6f, 0xc9, 0x8a, 0xd9, 0x84, 0x3d, 0xb0, 0xff, 0xb0, 0x6d, 0xcc,
-	0x7b, 0xb8, 0xf6, 0xfe, 0xb8, 0xe5, 0xfc, 0x39, 0x6e, 0x39, 0xd1, 0xfe, 0xd9, 0x34, 0x70, 0xcf,
-	0xa7, 0x81, 0xfb, 0x7b, 0x1a, 0xb8, 0x1f, 0x66, 0x81, 0x73, 0x3e, 0x0b, 0x9c, 0xef, 0xb3, 0xc0,
-	0x79, 0x65, 0xef, 0x33, 0xf0, 0x21, 0xcd, 0x54, 0xf8, 0x0e, 0xef, 0xbd, 0x49, 0x88, 0xeb, 0xe6,
-	0x0e, 0xef, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x8e, 0xe3, 0x0e, 0x29, 0x81, 0x03, 0x00, 0x00,
+	// 676 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x4f, 0x4f, 0x13, 0x41,
+	0x18, 0xc6, 0xbb, 0xfc, 0x59, 0x60, 0x5a, 0x41, 0x06, 0x34, 0x0b, 0xe2, 0xb6, 0x10, 0x63, 0x1a,
+	0x92, 0xee, 0xf2, 0x47, 0x63, 0xc2, 0xc1, 0xc4, 0xa2, 0x08, 0x07, 0xa3, 0x29, 0x37, 0x2f, 0x9b,
+	0xd9, 0xdd, 0x61, 0xbb, 0x69, 0x77, 0x66, 0xb3, 0x33, 0x55, 0xfb, 0x0d, 0x3c, 0x1a, 0x8f, 0x9e,
+	0x38, 0x1a, 0x4f, 0x1c, 0xf8, 0x00, 0x1e, 0x39, 0x12, 0xe2, 0xc1, 0x78, 0x40, 0x03, 0x07, 0xfc,
+	0x18, 0x66, 0x67, 0x86, 0xb6, 0xd0, 0x45, 0x39, 0x79, 0x69, 0x77, 0xe7, 0x7d, 0xde, 0xe7, 0xf7,
+	0xbc, 0x93, 0x9d, 0x01, 0xf3, 0x1e, 0x65, 0x11, 0x65, 0xb6, 0x8b, 0x48, 0xc3, 0x7e, 0xb3, 0xec,
+	0x62, 0x8e, 0x96, 0xed, 0x00, 0x13, 0xcc, 0x42, 0x66, 0xc5, 0x09, 0xe5, 0x14, 0x4e, 0x49, 0x89,
+	0x95, 0x4a, 0x2c, 0x25, 0x99, 0x9d, 0x0e, 0x68, 0x40, 0x45, 0xdd, 0x4e, 0x9f, 0xa4, 0x74, 0xd6,
+	0xec, 0xb8, 0x31, 0xdc, 0x71, 0xf3, 0x68, 0x48, 0xfa, 0xea, 0x3d, 0x34, 0xe1, 0x2b, 0xeb, 0x33,
+	0xb2, 0xee, 0x48, 0x63, 0xc5, 0x95, 0xa5, 0x49, 0x14, 0x85, 0x84, 0xda, 0xe2, 0x57, 0x2e, 0x2d,
+	0x7c, 0xd4, 0x41, 0xe1, 0xb9, 0x8c, 0xba, 0xcd, 0x11, 0xc7, 0xf0, 0x31, 0xd0, 0x63, 0x94, 0xa0,
+	0x88, 0x19, 0x5a, 0x49, 0x2b, 0xe7, 0x57, 0xee, 0x58, 0x19, 0xd1, 0xad, 0x57, 0x42, 0x52, 0x1d,
+	0x3b, 0x38, 0x2e, 0xe6, 0x3e, 0x9f, 0xed, 0x2d, 0x6a, 0x35, 0xd5, 0x05, 0xd7, 0xc1, 0xa8, 0x8b,
+	0x9a, 0x88, 0x78, 0x98, 0x19, 0x03, 0xa5, 0xc1, 0x72, 0x7e, 0x65, 0x2e, 0xd3, 0xa1, 0x2a, 0x45,
+	0xbd, 0x16, 0x9d, 0x46, 0xd8, 0x06, 0x3a, 0x6b, 0xc5, 0x71, 0xb3, 0x6d, 0x0c, 0x0a, 0x8b, 0x99,
+	0xae, 0x05, 0xc3, 0x1d, 0x8b, 0x75, 0x1a, 0x92, 0xea, 0x46, 0xda, 0xff, 0xe5, 0x67, 0xb1, 0x1c,
+	0x84, 0xbc, 0xde, 0x72, 0x2d, 0x8f, 0x46, 0x6a, 0x68, 0xf5, 0x57, 0x61, 0x7e, 0xc3, 0xe6, 0xed,
+	0x18, 0x33, 0xd1, 0xc0, 0x3e, 0x9d, 0xed, 0x2d, 0x16, 0x9a, 0x38, 0x40, 0x5e, 0xdb, 0x49, 0xb7,
+	0x95, 0xa9, 0xfc, 0x12, 0x08, 0x5f, 0x82, 0x71, 0x1f, 0x13, 0x1a, 0x39, 0x11, 0xe6, 0xc8, 0x47,
+	0x1c, 0x19, 0x43, 0x22, 0xc2, 0xdd, 0xcc, 0x29, 0x5e, 0x28, 0x51, 0xef, 0x18, 0x37, 0x44, 0xff,
+	0x79, 0x05, 0x22, 0x50, 0x60, 0x98, 0xf8, 0x0e, 0x26, 0xc8, 0x6d, 0x62, 0xdf, 0x18, 0x16, 0x76,
+	0xa5, 0x4c, 0xbb, 0x6d, 0x4c, 0xfc, 0x67, 0x52, 0x57, 0x9d, 0x4b, 0x1d, 0x7f, 0xec, 0x57, 0x26,
+	0xba, 0x63, 0x94, 0x96, 0xac, 0x07, 0x8f, 0x24, 0x24, 0xcf, 0xba, 0x52, 0xb8, 0x03, 0xc6, 0x77,
+	0x90, 0xc7, 0x69, 0xd2, 0x76, 0x04, 0x9b, 0x19, 0xba, 0x80, 0xcc, 0x67, 0x42, 0x36, 0xa4, 0xf4,
+	0x69, 0xaa, 0xbc, 0x82, 0xf2, 0x70, 0x55, 0x8d, 0xb2, 0xd3, 0xa3, 0x65, 0x90, 0x82, 0x49, 0xaf,
+	0x8e, 0xbd, 0x46, 0x4c, 0x43, 0xc2, 0xcf, 0x51, 0x23, 0x02, 0x75, 0x2f, 0x13, 0xb5, 0xde, 0x51,
+	0x5f, 0x87, 0x76, 0xd3, 0xbb, 0x28, 0x67, 0xb0, 0x05, 0xa6, 0xd4, 0x37, 0xe1, 0x74, 0x6b, 0xcc,
+	0x18, 0x15, 0xc8, 0xfb, 0x7f, 0xfb, 0xae, 0xba, 0xe4, 0x7f, 0x40, 0xa1, 0x7b, 0xb9, 0x81, 0x2d,
+	0x38, 0x60, 0xe2, 0x52, 0x72, 0x38, 0x0d, 0x86, 0xc5, 0xbc, 0xe2, 0x54, 0x8c, 0xd5, 0xe4, 0x0b,
+	0x9c, 0x07, 0x05, 0xc6, 0x51, 0xc2, 0x9d, 0x3a, 0x0e, 0x83, 0x3a, 0x37, 0x06, 0x4a, 0x5a, 0x79,
+	0xa8, 0x96, 0x17, 0x6b, 0x9b, 0x62, 0x69, 0x6d, 0xea, 0xa8, 0x9f, 0xbc, 0xf0, 0x4d, 0x03, 0x93,
+	0x7d, 0x41, 0xaf, 0x60, 0xac, 0x80, 0x11, 0xe4, 0xfb, 0x09, 0x66, 0x4c, 0xd8, 0x8f, 0x55, 0x8d,
+	0xa3, 0xfd, 0xca, 0xb4, 0x1a, 0xfd, 0x89, 0xac, 0x6c, 0xf3, 0x24, 0x24, 0x41, 0xed, 0x5c, 0x08,
+	0x6f, 0x03, 0x5d, 0x25, 0x1a, 0x14, 0x89, 0xd4, 0x1b, 0xdc, 0x04, 0x3a, 0x8a, 0x68, 0x8b, 0x70,
+	0x63, 0x48, 0x58, 0x2d, 0x89, 0xad, 0x39, 0x2e, 0xde, 0x92, 0x76, 0xcc, 0x6f, 0x58, 0x21, 0xb5,
+	0x23, 0xc4, 0xeb, 0xd6, 0x16, 0xe1, 0x47, 0xfb, 0x15, 0xa0, 0x38, 0x5b, 0x84, 0xab, 0x63, 0x22,
+	0xfb, 0xb3, 0xc7, 0xfa, 0xaa, 0x81, 0x11, 0x35, 0x56, 0x6f, 0x6c, 0xed, 0xba, 0xb1, 0xdf, 0x82,
+	0x61, 0x71, 0x22, 0x8d, 0x81, 0xff, 0x75, 0xea, 0x25, 0x6f, 0x6d, 0xf4, 0xfd, 0x6e, 0x31, 0xf7,
+	0x7b, 0xb7, 0x98, 0xab, 0xae, 0x1e, 0x9c, 0x98, 0xda, 0xe1, 0x89, 0xa9, 0xfd, 0x3a, 0x31, 0xb5,
+	0x0f, 0xa7, 0x66, 0xee, 0xf0, 0xd4, 0xcc, 0x7d, 0x3f, 0x35, 0x73, 0xaf, 0x67, 0x2e, 0xec, 0xd1,
+	0x3b, 0x79, 0xff, 0x0a, 0x82, 0xab, 0x8b, 0xbb, 0x74, 0xf5, 0xcf, 0x00, 0x3e, 0x2a, 0x09, 0xc9,
+	0x09, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -213,6 +378,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BalanceCheckpoints) > 0 {
+		for iNdEx := len(m.BalanceCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BalanceCheckpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.CheckpointDenoms) > 0 {
+		for iNdEx := len(m.CheckpointDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CheckpointDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.FactoryDenoms) > 0 {
+		for iNdEx := len(m.FactoryDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FactoryDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.SendEnabled) > 0 {
 		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -282,6 +489,93 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CheckpointDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BalanceCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BalanceCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Balance) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -369,6 +663,62 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FactoryDenoms) > 0 {
+		for _, e := range m.FactoryDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CheckpointDenoms) > 0 {
+		for _, e := range m.CheckpointDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BalanceCheckpoints) > 0 {
+		for _, e := range m.BalanceCheckpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CheckpointDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.StartHeight))
+	}
+	return n
+}
+
+func (m *BalanceCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -595,6 +945,376 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FactoryDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FactoryDenoms = append(m.FactoryDenoms, FactoryDenom{})
+			if err := m.FactoryDenoms[len(m.FactoryDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CheckpointDenoms = append(m.CheckpointDenoms, CheckpointDenom{})
+			if err := m.CheckpointDenoms[len(m.CheckpointDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceCheckpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BalanceCheckpoints = append(m.BalanceCheckpoints, BalanceCheckpoint{})
+			if err := m.BalanceCheckpoints[len(m.BalanceCheckpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckpointDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BalanceCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -143,6 +143,35 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"checkpoint denom not in params",
+			GenesisState{
+				Params:           DefaultParams(),
+				CheckpointDenoms: []CheckpointDenom{{Denom: "uatom", StartHeight: 1}},
+			},
+			true,
+		},
+		{
+			"valid balance checkpoints",
+			GenesisState{
+				Params:             Params{BalanceCheckpointDenoms: []string{"uatom"}},
+				CheckpointDenoms:   []CheckpointDenom{{Denom: "uatom", StartHeight: 1}},
+				BalanceCheckpoints: []BalanceCheckpoint{{Denom: "uatom", Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Height: 2, Amount: math.OneInt()}},
+			},
+			false,
+		},
+		{
+			"duplicate balance checkpoint",
+			GenesisState{
+				Params:           Params{BalanceCheckpointDenoms: []string{"uatom"}},
+				CheckpointDenoms: []CheckpointDenom{{Denom: "uatom", StartHeight: 1}},
+				BalanceCheckpoints: []BalanceCheckpoint{
+					{Denom: "uatom", Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Height: 2, Amount: math.OneInt()},
+					{Denom: "uatom", Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Height: 2, Amount: math.ZeroInt()},
+				},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
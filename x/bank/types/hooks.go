@@ -0,0 +1,89 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultHooksGasLimit is the default gas limit of each bank hook call.
+const DefaultHooksGasLimit uint64 = 200_000
+
+// BankHooks defines the hooks called by the bank keeper after the balances of accounts changed.
+//
+// Each hook is executed in a branched context, limited to the hooks gas limit of the keeper.
+// If a hook returns an error or runs out of gas, its state changes are discarded and the error
+// is returned by the keeper method which changed the balances. As for any keeper error, the
+// balance changes themselves are reverted with the transaction or branch executing that method.
+type BankHooks interface {
+	// AfterCoinsSent is called after amt coins were transferred from fromAddr to toAddr.
+	AfterCoinsSent(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	// AfterCoinsMinted is called after amt coins were minted to addr.
+	AfterCoinsMinted(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins) error
+	// AfterCoinsBurned is called after amt coins were burned from addr.
+	AfterCoinsBurned(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins) error
+	// AfterCoinsDelegated is called after amt coins were delegated from delegatorAddr to moduleAddr.
+	AfterCoinsDelegated(ctx context.Context, delegatorAddr, moduleAddr sdk.AccAddress, amt sdk.Coins) error
+	// AfterCoinsUndelegated is called after amt coins were undelegated from moduleAddr to delegatorAddr.
+	AfterCoinsUndelegated(ctx context.Context, moduleAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// BankHooksWrapper is a wrapper for modules to inject BankHooks using depinject.
+type BankHooksWrapper struct{ BankHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (BankHooksWrapper) IsOnePerModuleType() {}
+
+// combine multiple bank hooks, all hook functions are run in array sequence
+var _ BankHooks = MultiBankHooks{}
+
+type MultiBankHooks []BankHooks
+
+func NewMultiBankHooks(hooks ...BankHooks) MultiBankHooks {
+	return hooks
+}
+
+func (h MultiBankHooks) AfterCoinsSent(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	for i := range h {
+		if err := h[i].AfterCoinsSent(ctx, fromAddr, toAddr, amt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiBankHooks) AfterCoinsMinted(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	for i := range h {
+		if err := h[i].AfterCoinsMinted(ctx, addr, amt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiBankHooks) AfterCoinsBurned(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	for i := range h {
+		if err := h[i].AfterCoinsBurned(ctx, addr, amt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiBankHooks) AfterCoinsDelegated(ctx context.Context, delegatorAddr, moduleAddr sdk.AccAddress, amt sdk.Coins) error {
+	for i := range h {
+		if err := h[i].AfterCoinsDelegated(ctx, delegatorAddr, moduleAddr, amt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiBankHooks) AfterCoinsUndelegated(ctx context.Context, moduleAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error {
+	for i := range h {
+		if err := h[i].AfterCoinsUndelegated(ctx, moduleAddr, delegatorAddr, amt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
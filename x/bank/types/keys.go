@@ -39,6 +39,16 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = collections.NewPrefix(5)
+
+	// FactoryDenomsPrefix is the prefix for the denoms created with the token factory.
+	FactoryDenomsPrefix = collections.NewPrefix(6)
+
+	// BalanceCheckpointsPrefix is the prefix for the historical balances of the balance checkpoint denoms.
+	BalanceCheckpointsPrefix = collections.NewPrefix(7)
+	// CheckpointDenomsPrefix is the prefix for the heights at which the balances of the checkpoint denoms started being checkpointed.
+	CheckpointDenomsPrefix = collections.NewPrefix(8)
+	// BalanceCheckpointsByHeightPrefix is the prefix for the index of the balance checkpoints by height.
+	BalanceCheckpointsByHeightPrefix = collections.NewPrefix(9)
 )
 
 // BalanceValueCodec is a codec for encoding bank balances in a backwards compatible way.
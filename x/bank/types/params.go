@@ -31,7 +31,26 @@ func (p Params) Validate() error {
 	if len(p.SendEnabled) > 0 {
 		return errors.New("use of send_enabled in params is no longer supported")
 	}
-	return validateIsBool(p.DefaultSendEnabled)
+	if err := validateIsBool(p.DefaultSendEnabled); err != nil {
+		return err
+	}
+
+	if err := p.DenomCreationFee.Validate(); err != nil {
+		return fmt.Errorf("invalid denom creation fee: %w", err)
+	}
+
+	seen := make(map[string]bool, len(p.BalanceCheckpointDenoms))
+	for _, denom := range p.BalanceCheckpointDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid balance checkpoint denom: %w", err)
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate balance checkpoint denom %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
 }
 
 // Validate gets any errors with this SendEnabled entry.
@@ -38,22 +38,22 @@ func Test_ParamsString(t *testing.T) {
 	}{
 		{
 			name:     "default true empty send enabled",
-			params:   Params{[]*SendEnabled{}, true},
+			params:   Params{[]*SendEnabled{}, true, nil, nil, 0},
 			expected: "default_send_enabled:true ",
 		},
 		{
 			name:     "default false empty send enabled",
-			params:   Params{[]*SendEnabled{}, false},
+			params:   Params{[]*SendEnabled{}, false, nil, nil, 0},
 			expected: "",
 		},
 		{
 			name:     "default true one true send enabled",
-			params:   Params{[]*SendEnabled{{"foocoin", true}}, true},
+			params:   Params{[]*SendEnabled{{"foocoin", true}}, true, nil, nil, 0},
 			expected: "send_enabled:<denom:\"foocoin\" enabled:true > default_send_enabled:true ",
 		},
 		{
 			name:     "default true one false send enabled",
-			params:   Params{[]*SendEnabled{{"barcoin", false}}, true},
+			params:   Params{[]*SendEnabled{{"barcoin", false}}, true, nil, nil, 0},
 			expected: "send_enabled:<denom:\"barcoin\" > default_send_enabled:true ",
 		},
 	}
@@ -69,5 +69,8 @@ func Test_validateParams(t *testing.T) {
 	assert.NoError(t, DefaultParams().Validate(), "default")
 	assert.NoError(t, NewParams(true).Validate(), "true")
 	assert.NoError(t, NewParams(false).Validate(), "false")
-	assert.Error(t, Params{[]*SendEnabled{{"foocoing", false}}, true}.Validate(), "with SendEnabled entry")
+	assert.Error(t, Params{[]*SendEnabled{{"foocoing", false}}, true, nil, nil, 0}.Validate(), "with SendEnabled entry")
+	assert.NoError(t, Params{BalanceCheckpointDenoms: []string{"foo", "bar"}}.Validate(), "with balance checkpoint denoms")
+	assert.Error(t, Params{BalanceCheckpointDenoms: []string{"foo", "foo"}}.Validate(), "with duplicate balance checkpoint denoms")
+	assert.Error(t, Params{BalanceCheckpointDenoms: []string{"f"}}.Validate(), "with invalid balance checkpoint denom")
 }
@@ -1361,6 +1361,318 @@ func (m *QuerySendEnabledResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryBalanceAtRequest defines the request type for the Query/BalanceAt RPC method.
+type QueryBalanceAtRequest struct {
+	// address is the address to query the balance for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// denom is the coin denom to query the balance for.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// height is the height at the end of which the balance is queried.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryBalanceAtRequest) Reset()         { *m = QueryBalanceAtRequest{} }
+func (m *QueryBalanceAtRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBalanceAtRequest) ProtoMessage()    {}
+func (*QueryBalanceAtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{27}
+}
+func (m *QueryBalanceAtRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBalanceAtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBalanceAtRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBalanceAtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBalanceAtRequest.Merge(m, src)
+}
+func (m *QueryBalanceAtRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBalanceAtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBalanceAtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBalanceAtRequest proto.InternalMessageInfo
+
+func (m *QueryBalanceAtRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryBalanceAtRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryBalanceAtRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryBalanceAtResponse defines the response type for the Query/BalanceAt RPC method.
+type QueryBalanceAtResponse struct {
+	// balance is the balance of the coin at the height.
+	Balance types.Coin `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance"`
+}
+
+func (m *QueryBalanceAtResponse) Reset()         { *m = QueryBalanceAtResponse{} }
+func (m *QueryBalanceAtResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBalanceAtResponse) ProtoMessage()    {}
+func (*QueryBalanceAtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{28}
+}
+func (m *QueryBalanceAtResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBalanceAtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBalanceAtResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBalanceAtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBalanceAtResponse.Merge(m, src)
+}
+func (m *QueryBalanceAtResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBalanceAtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBalanceAtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBalanceAtResponse proto.InternalMessageInfo
+
+func (m *QueryBalanceAtResponse) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
+	}
+	return types.Coin{}
+}
+
+// QueryFactoryDenomRequest defines the request type for the Query/FactoryDenom RPC method.
+type QueryFactoryDenomRequest struct {
+	// denom is the factory denom to query, of the form factory/{creator}/{subdenom}.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryFactoryDenomRequest) Reset()         { *m = QueryFactoryDenomRequest{} }
+func (m *QueryFactoryDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFactoryDenomRequest) ProtoMessage()    {}
+func (*QueryFactoryDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{29}
+}
+func (m *QueryFactoryDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFactoryDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFactoryDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFactoryDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFactoryDenomRequest.Merge(m, src)
+}
+func (m *QueryFactoryDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFactoryDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFactoryDenomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFactoryDenomRequest proto.InternalMessageInfo
+
+func (m *QueryFactoryDenomRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryFactoryDenomResponse defines the response type for the Query/FactoryDenom RPC method.
+type QueryFactoryDenomResponse struct {
+	// factory_denom is the authority of the denom.
+	FactoryDenom FactoryDenom `protobuf:"bytes,1,opt,name=factory_denom,json=factoryDenom,proto3" json:"factory_denom"`
+}
+
+func (m *QueryFactoryDenomResponse) Reset()         { *m = QueryFactoryDenomResponse{} }
+func (m *QueryFactoryDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFactoryDenomResponse) ProtoMessage()    {}
+func (*QueryFactoryDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{30}
+}
+func (m *QueryFactoryDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFactoryDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFactoryDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFactoryDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFactoryDenomResponse.Merge(m, src)
+}
+func (m *QueryFactoryDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFactoryDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFactoryDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFactoryDenomResponse proto.InternalMessageInfo
+
+func (m *QueryFactoryDenomResponse) GetFactoryDenom() FactoryDenom {
+	if m != nil {
+		return m.FactoryDenom
+	}
+	return FactoryDenom{}
+}
+
+// QueryFactoryDenomsByCreatorRequest defines the request type for the Query/FactoryDenomsByCreator RPC method.
+type QueryFactoryDenomsByCreatorRequest struct {
+	// creator is the address of the creator of the denoms.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFactoryDenomsByCreatorRequest) Reset()         { *m = QueryFactoryDenomsByCreatorRequest{} }
+func (m *QueryFactoryDenomsByCreatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFactoryDenomsByCreatorRequest) ProtoMessage()    {}
+func (*QueryFactoryDenomsByCreatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{31}
+}
+func (m *QueryFactoryDenomsByCreatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFactoryDenomsByCreatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFactoryDenomsByCreatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFactoryDenomsByCreatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFactoryDenomsByCreatorRequest.Merge(m, src)
+}
+func (m *QueryFactoryDenomsByCreatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFactoryDenomsByCreatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFactoryDenomsByCreatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFactoryDenomsByCreatorRequest proto.InternalMessageInfo
+
+func (m *QueryFactoryDenomsByCreatorRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *QueryFactoryDenomsByCreatorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFactoryDenomsByCreatorResponse defines the response type for the Query/FactoryDenomsByCreator RPC method.
+type QueryFactoryDenomsByCreatorResponse struct {
+	// factory_denoms are the denoms created by the account.
+	FactoryDenoms []FactoryDenom `protobuf:"bytes,1,rep,name=factory_denoms,json=factoryDenoms,proto3" json:"factory_denoms"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFactoryDenomsByCreatorResponse) Reset()         { *m = QueryFactoryDenomsByCreatorResponse{} }
+func (m *QueryFactoryDenomsByCreatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFactoryDenomsByCreatorResponse) ProtoMessage()    {}
+func (*QueryFactoryDenomsByCreatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{32}
+}
+func (m *QueryFactoryDenomsByCreatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFactoryDenomsByCreatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFactoryDenomsByCreatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFactoryDenomsByCreatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFactoryDenomsByCreatorResponse.Merge(m, src)
+}
+func (m *QueryFactoryDenomsByCreatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFactoryDenomsByCreatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFactoryDenomsByCreatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFactoryDenomsByCreatorResponse proto.InternalMessageInfo
+
+func (m *QueryFactoryDenomsByCreatorResponse) GetFactoryDenoms() []FactoryDenom {
+	if m != nil {
+		return m.FactoryDenoms
+	}
+	return nil
+}
+
+func (m *QueryFactoryDenomsByCreatorResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.bank.v1beta1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.bank.v1beta1.QueryBalanceResponse")
@@ -1389,102 +1701,124 @@ func init() {
 	proto.RegisterType((*QueryDenomOwnersByQueryResponse)(nil), "cosmos.bank.v1beta1.QueryDenomOwnersByQueryResponse")
 	proto.RegisterType((*QuerySendEnabledRequest)(nil), "cosmos.bank.v1beta1.QuerySendEnabledRequest")
 	proto.RegisterType((*QuerySendEnabledResponse)(nil), "cosmos.bank.v1beta1.QuerySendEnabledResponse")
+	proto.RegisterType((*QueryBalanceAtRequest)(nil), "cosmos.bank.v1beta1.QueryBalanceAtRequest")
+	proto.RegisterType((*QueryBalanceAtResponse)(nil), "cosmos.bank.v1beta1.QueryBalanceAtResponse")
+	proto.RegisterType((*QueryFactoryDenomRequest)(nil), "cosmos.bank.v1beta1.QueryFactoryDenomRequest")
+	proto.RegisterType((*QueryFactoryDenomResponse)(nil), "cosmos.bank.v1beta1.QueryFactoryDenomResponse")
+	proto.RegisterType((*QueryFactoryDenomsByCreatorRequest)(nil), "cosmos.bank.v1beta1.QueryFactoryDenomsByCreatorRequest")
+	proto.RegisterType((*QueryFactoryDenomsByCreatorResponse)(nil), "cosmos.bank.v1beta1.QueryFactoryDenomsByCreatorResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/query.proto", fileDescriptor_9c6fc1939682df13) }
 
 var fileDescriptor_9c6fc1939682df13 = []byte{
-	// 1437 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x4f, 0x68, 0x1c, 0x55,
-	0x18, 0xcf, 0x6b, 0x69, 0x9a, 0x7c, 0x9b, 0x2a, 0x7d, 0x49, 0x6d, 0x3a, 0xb1, 0xbb, 0x71, 0x5a,
-	0x9a, 0x6d, 0x4c, 0x66, 0x92, 0xdd, 0xd8, 0xb4, 0xb1, 0x16, 0xb2, 0xad, 0x11, 0x51, 0x69, 0xbb,
-	0xb1, 0x97, 0x2a, 0x2c, 0xb3, 0x3b, 0xe3, 0xba, 0x74, 0x77, 0x66, 0xbb, 0x6f, 0xd2, 0xba, 0x94,
-	0x82, 0x08, 0x42, 0x0f, 0x22, 0x82, 0xed, 0x45, 0x10, 0x7a, 0x12, 0xb1, 0x28, 0x3d, 0x54, 0xf0,
-	0xa0, 0x47, 0xa1, 0x14, 0xc4, 0x52, 0x2f, 0xda, 0x83, 0x4a, 0x2a, 0x54, 0xbc, 0x7a, 0x16, 0x64,
-	0xdf, 0x9f, 0x9d, 0x99, 0x9d, 0xb7, 0xb3, 0x93, 0x3f, 0x96, 0xe2, 0x25, 0xec, 0xbe, 0x79, 0xdf,
-	0xfb, 0x7e, 0xbf, 0xdf, 0xfb, 0xe6, 0x7b, 0xbf, 0xb7, 0x81, 0x54, 0xc9, 0x21, 0x35, 0x87, 0xe8,
-	0x45, 0xc3, 0x3e, 0xa7, 0x5f, 0x98, 0x2d, 0x5a, 0xae, 0x31, 0xab, 0x9f, 0x5f, 0xb1, 0x1a, 0x4d,
-	0xad, 0xde, 0x70, 0x5c, 0x07, 0x0f, 0xb3, 0x09, 0x5a, 0x6b, 0x82, 0xc6, 0x27, 0x28, 0x93, 0xed,
-	0x28, 0x62, 0xb1, 0xd9, 0xed, 0xd8, 0xba, 0x51, 0xae, 0xd8, 0x86, 0x5b, 0x71, 0x6c, 0xb6, 0x80,
-	0x32, 0x52, 0x76, 0xca, 0x0e, 0xfd, 0xa8, 0xb7, 0x3e, 0xf1, 0xd1, 0xa7, 0xcb, 0x8e, 0x53, 0xae,
-	0x5a, 0xba, 0x51, 0xaf, 0xe8, 0x86, 0x6d, 0x3b, 0x2e, 0x0d, 0x21, 0xfc, 0x69, 0xd2, 0xbf, 0xbe,
-	0x58, 0xb9, 0xe4, 0x54, 0xec, 0xd0, 0x73, 0x1f, 0x6a, 0x8a, 0x90, 0x3d, 0xdf, 0xc3, 0x9e, 0x17,
-	0x58, 0x5a, 0xce, 0x80, 0x3d, 0x1a, 0xe3, 0xa1, 0x02, 0xb5, 0x9f, 0xac, 0xb2, 0xd3, 0xa8, 0x55,
-	0x6c, 0x47, 0xa7, 0x7f, 0xd9, 0x90, 0x5a, 0x81, 0xe1, 0xd3, 0xad, 0x19, 0x39, 0xa3, 0x6a, 0xd8,
-	0x25, 0x2b, 0x6f, 0x9d, 0x5f, 0xb1, 0x88, 0x8b, 0x33, 0xb0, 0xdd, 0x30, 0xcd, 0x86, 0x45, 0xc8,
-	0x28, 0x1a, 0x47, 0xe9, 0xc1, 0xdc, 0xe8, 0xbd, 0x5b, 0xd3, 0x23, 0x3c, 0xd3, 0x22, 0x7b, 0xb2,
-	0xec, 0x36, 0x2a, 0x76, 0x39, 0x2f, 0x26, 0xe2, 0x11, 0xd8, 0x66, 0x5a, 0xb6, 0x53, 0x1b, 0xdd,
-	0xd2, 0x8a, 0xc8, 0xb3, 0x2f, 0x0b, 0x03, 0x57, 0xae, 0xa7, 0xfa, 0xfe, 0xbc, 0x9e, 0xea, 0x53,
-	0x5f, 0x81, 0x91, 0x60, 0x2a, 0x52, 0x77, 0x6c, 0x62, 0xe1, 0x2c, 0x6c, 0x2f, 0xb2, 0x21, 0x9a,
-	0x2b, 0x91, 0xd9, 0xa3, 0xb5, 0x37, 0x85, 0x58, 0x62, 0x53, 0xb4, 0xe3, 0x4e, 0xc5, 0xce, 0x8b,
-	0x99, 0xea, 0x2f, 0x08, 0x76, 0xd3, 0xd5, 0x16, 0xab, 0x55, 0xbe, 0x20, 0xd9, 0x08, 0xf8, 0x25,
-	0x00, 0x6f, 0x6b, 0x29, 0x83, 0x44, 0xe6, 0x40, 0x00, 0x07, 0x13, 0x52, 0xa0, 0x39, 0x65, 0x94,
-	0x85, 0x58, 0x79, 0x5f, 0x24, 0x3e, 0x0c, 0x3b, 0x1a, 0x16, 0x71, 0xaa, 0x17, 0xac, 0x02, 0x13,
-	0x63, 0xeb, 0x38, 0x4a, 0x0f, 0xe4, 0x86, 0xef, 0xdf, 0x9a, 0x7e, 0x92, 0xad, 0x36, 0x4d, 0xcc,
-	0x73, 0xe3, 0x33, 0xda, 0x73, 0x33, 0xf9, 0x21, 0x3e, 0xf3, 0x44, 0x87, 0x50, 0xab, 0x08, 0x46,
-	0xc3, 0xdc, 0xb8, 0x5a, 0x97, 0x61, 0x80, 0x6b, 0xd0, 0x62, 0xb7, 0x35, 0x52, 0xae, 0xdc, 0xd2,
-	0xed, 0x5f, 0x53, 0x7d, 0x5f, 0xfc, 0x96, 0x4a, 0x97, 0x2b, 0xee, 0xdb, 0x2b, 0x45, 0xad, 0xe4,
-	0xd4, 0x78, 0xb9, 0xe8, 0x1e, 0x18, 0xdd, 0x6d, 0xd6, 0x2d, 0x42, 0x03, 0xc8, 0x27, 0x0f, 0x6f,
-	0x4e, 0x0e, 0x55, 0xad, 0xb2, 0x51, 0x6a, 0x16, 0x5a, 0x05, 0x49, 0x3e, 0x7f, 0x78, 0x73, 0x12,
-	0xe5, 0xdb, 0x29, 0xf1, 0x4b, 0x12, 0x9d, 0x26, 0x7a, 0xea, 0xc4, 0xb0, 0xfb, 0x85, 0x52, 0xbf,
-	0x41, 0xb0, 0x97, 0x92, 0x5c, 0xae, 0x5b, 0xb6, 0x69, 0x14, 0xab, 0xd6, 0x63, 0xb4, 0x8d, 0x0b,
-	0x63, 0x62, 0x33, 0xee, 0x75, 0xee, 0xdb, 0xdc, 0x21, 0xf5, 0x1f, 0x04, 0xc9, 0x6e, 0xd0, 0xff,
-	0x5f, 0xbb, 0xb4, 0x30, 0x2c, 0xe3, 0xff, 0x01, 0x82, 0x7d, 0x52, 0xfe, 0xb9, 0x26, 0x2d, 0xe5,
-	0xcd, 0x6f, 0x22, 0x11, 0xdb, 0x31, 0xaf, 0xd6, 0x61, 0x7f, 0x34, 0x9a, 0x0d, 0xf4, 0x19, 0x99,
-	0x00, 0xf3, 0xea, 0xbb, 0xa2, 0xf9, 0xbc, 0xee, 0xb8, 0x46, 0x75, 0x79, 0xa5, 0x5e, 0xaf, 0x36,
-	0x05, 0xe9, 0x37, 0x02, 0xd2, 0xa3, 0xb5, 0x54, 0xa0, 0xa4, 0x4b, 0xcc, 0x65, 0x03, 0xdb, 0xe1,
-	0xf5, 0x88, 0xbf, 0x45, 0x8f, 0x08, 0x40, 0xe0, 0x4c, 0x9b, 0xd0, 0x4f, 0xe8, 0xc8, 0xa3, 0xab,
-	0x3d, 0x9e, 0x10, 0xbf, 0xb9, 0x81, 0xca, 0xeb, 0xc9, 0x5f, 0x9d, 0xe2, 0x47, 0x08, 0xe3, 0x7b,
-	0xf2, 0x2d, 0x21, 0x7a, 0xbb, 0x6a, 0x90, 0xaf, 0x6a, 0xd4, 0x33, 0xb0, 0xab, 0x63, 0x36, 0xd7,
-	0xe7, 0x28, 0xf4, 0x1b, 0x35, 0x67, 0xc5, 0x76, 0x7b, 0x16, 0x42, 0x6e, 0xb0, 0xa5, 0x0f, 0xa7,
-	0xc8, 0x62, 0xd4, 0x11, 0xc0, 0x74, 0xd9, 0x53, 0x46, 0xc3, 0xa8, 0x89, 0x6e, 0xa5, 0x9e, 0xe1,
-	0x07, 0xa9, 0x18, 0xe5, 0xa9, 0x8e, 0x41, 0x7f, 0x9d, 0x8e, 0xf0, 0x54, 0x63, 0x9a, 0xc4, 0x70,
-	0x68, 0x2c, 0x28, 0x90, 0x8c, 0x45, 0xa9, 0x26, 0x28, 0x74, 0x59, 0x5a, 0xca, 0xe4, 0x35, 0xcb,
-	0x35, 0x4c, 0xc3, 0x35, 0x04, 0xef, 0xa5, 0xf5, 0x17, 0x5b, 0x40, 0xd7, 0xaf, 0x10, 0x8c, 0x49,
-	0xd3, 0x70, 0x16, 0x4b, 0x30, 0x58, 0xe3, 0x63, 0xa2, 0x9f, 0xed, 0x95, 0x12, 0x11, 0x91, 0x7e,
-	0x2a, 0x5e, 0xe8, 0xe6, 0x9d, 0x1e, 0xb3, 0xb0, 0xc7, 0xc3, 0xdb, 0xa9, 0x8a, 0xbc, 0x1a, 0x8a,
-	0x7e, 0x25, 0x43, 0x0c, 0x4f, 0xc0, 0x80, 0x80, 0xc9, 0x75, 0x8c, 0x4f, 0xb0, 0x1d, 0xa9, 0x1e,
-	0x83, 0x03, 0xe1, 0x1c, 0xb9, 0x26, 0xab, 0x42, 0xd6, 0xe9, 0x22, 0x31, 0x3a, 0x30, 0xd1, 0x33,
-	0x7e, 0x53, 0x01, 0x5f, 0xe4, 0x8d, 0x8c, 0x26, 0x3c, 0x79, 0xd1, 0xb6, 0x1a, 0x24, 0x12, 0xe1,
-	0x66, 0x1d, 0xb0, 0xea, 0x35, 0x04, 0xe0, 0x25, 0x5d, 0xd7, 0x51, 0x71, 0xcc, 0xeb, 0xe7, 0x5b,
-	0xd6, 0xf0, 0x1a, 0x47, 0xb5, 0xf6, 0x43, 0xea, 0xb7, 0xa2, 0xaf, 0x06, 0x14, 0xe1, 0x9a, 0xe7,
-	0x60, 0x88, 0xaa, 0x50, 0x70, 0xe8, 0x38, 0x7f, 0x13, 0x52, 0x52, 0xdd, 0xbd, 0xf8, 0x7c, 0xc2,
-	0xf4, 0xd6, 0xfa, 0x8f, 0x8f, 0xe6, 0x6b, 0xc2, 0x9a, 0xf8, 0xe0, 0xf3, 0xfa, 0x79, 0x24, 0xfb,
-	0xba, 0xb0, 0xeb, 0xde, 0xad, 0xe9, 0x9d, 0x1d, 0x46, 0x57, 0xcb, 0xaa, 0xdf, 0x23, 0x48, 0x75,
-	0xc5, 0xf5, 0x38, 0xaa, 0xdb, 0x85, 0xc7, 0x87, 0xe2, 0xe4, 0x5f, 0xb6, 0x6c, 0xf3, 0x45, 0xbb,
-	0xe5, 0x36, 0x4c, 0x21, 0xec, 0x53, 0xd0, 0x4f, 0xa1, 0x30, 0xe4, 0x83, 0x79, 0xfe, 0xad, 0x43,
-	0xda, 0xd2, 0xba, 0xa5, 0x95, 0x5a, 0x91, 0xef, 0x44, 0xbd, 0x06, 0x00, 0x71, 0x45, 0x8f, 0xc3,
-	0x10, 0xb1, 0x6c, 0xb3, 0x60, 0xb1, 0x71, 0xae, 0xe8, 0xb8, 0x54, 0x51, 0x7f, 0x7c, 0x82, 0x78,
-	0x5f, 0x3a, 0x24, 0x2d, 0x6d, 0x6a, 0xc1, 0xce, 0x67, 0x6e, 0x60, 0xd8, 0x46, 0xf1, 0xe3, 0x4f,
-	0x11, 0x6c, 0xe7, 0xce, 0x0d, 0xa7, 0xa5, 0x10, 0x25, 0x17, 0x55, 0xe5, 0x60, 0x8c, 0x99, 0x0c,
-	0x8b, 0xfa, 0xc2, 0x95, 0xd6, 0xfb, 0xff, 0xde, 0x4f, 0x7f, 0x7c, 0xbc, 0x25, 0x83, 0x67, 0x74,
-	0xf9, 0x1d, 0x9b, 0x19, 0x68, 0xfd, 0x12, 0x6f, 0x32, 0x97, 0xf5, 0x62, 0x93, 0x5d, 0xe4, 0xf0,
-	0x75, 0x04, 0x09, 0xdf, 0x85, 0x0c, 0x4f, 0x75, 0xcf, 0x1c, 0xbe, 0x93, 0x2a, 0xd3, 0x31, 0x67,
-	0x73, 0xac, 0x73, 0x1e, 0xd6, 0x83, 0x78, 0x22, 0x26, 0x56, 0xfc, 0x23, 0x82, 0x9d, 0xa1, 0x3b,
-	0x09, 0xce, 0x74, 0x4f, 0xdd, 0xed, 0xee, 0xa5, 0x64, 0xd7, 0x14, 0xc3, 0x41, 0x9f, 0xbe, 0x13,
-	0xee, 0x48, 0x1e, 0x8f, 0x2c, 0x9e, 0x95, 0xf2, 0x20, 0x62, 0xbd, 0x82, 0x84, 0xd1, 0x5f, 0x08,
-	0x76, 0x77, 0xf1, 0xf5, 0xf8, 0x70, 0x7c, 0x8c, 0xc1, 0x8b, 0x89, 0x72, 0x64, 0x1d, 0x91, 0x9c,
-	0xe3, 0xd9, 0x30, 0xc7, 0x79, 0x8f, 0xe3, 0x51, 0xbc, 0xb0, 0x66, 0x8e, 0x5e, 0x85, 0x5d, 0x45,
-	0x90, 0xf0, 0xd9, 0xf9, 0xa8, 0x0a, 0x0b, 0x5f, 0x3c, 0xa2, 0x2a, 0x4c, 0x72, 0x47, 0x50, 0xd3,
-	0x1e, 0xea, 0xbd, 0x78, 0x4c, 0x8e, 0x9a, 0xc1, 0xb8, 0x8a, 0x60, 0x40, 0x58, 0x68, 0x1c, 0xf1,
-	0xbe, 0x75, 0x98, 0x72, 0x65, 0x32, 0xce, 0x54, 0x8e, 0x66, 0xd6, 0x43, 0x73, 0x00, 0xef, 0x8f,
-	0x40, 0xe3, 0xa9, 0xf5, 0x3e, 0x82, 0x7e, 0xe6, 0x9b, 0xf1, 0x44, 0xf7, 0x4c, 0x01, 0x93, 0xae,
-	0xa4, 0x7b, 0x4f, 0x8c, 0x2f, 0x0f, 0x73, 0xe8, 0xf8, 0x06, 0x82, 0x1d, 0x01, 0xbf, 0x86, 0xb5,
-	0xee, 0x59, 0x64, 0x7e, 0x55, 0xd1, 0x63, 0xcf, 0xe7, 0xe0, 0x8e, 0x78, 0xe0, 0x34, 0x3c, 0x25,
-	0x05, 0xc7, 0xce, 0x9e, 0x82, 0x30, 0x7a, 0xfa, 0x25, 0x3a, 0x70, 0x19, 0xdf, 0x47, 0xa0, 0x74,
-	0x77, 0x97, 0xf8, 0xf9, 0x98, 0x50, 0x64, 0x9e, 0x56, 0x39, 0xba, 0xbe, 0x60, 0x4e, 0x6a, 0xd1,
-	0x23, 0x75, 0x08, 0xcf, 0xc5, 0x21, 0x55, 0x28, 0x36, 0x0b, 0xf4, 0xec, 0x29, 0x10, 0x86, 0xfe,
-	0x33, 0x04, 0x4f, 0x04, 0x6f, 0x30, 0xb8, 0x97, 0xb6, 0x9d, 0x57, 0x2a, 0x65, 0x26, 0x7e, 0x40,
-	0xfc, 0xda, 0xed, 0x00, 0x8e, 0xbf, 0x46, 0x90, 0xf0, 0x39, 0xa1, 0xa8, 0x37, 0x3d, 0xec, 0xcc,
-	0xa3, 0xde, 0x74, 0x89, 0x6b, 0x55, 0x5f, 0x8e, 0x6c, 0xcb, 0xcf, 0xe2, 0x83, 0xdd, 0x21, 0x73,
-	0x2b, 0xd6, 0xae, 0x9e, 0x1f, 0x10, 0xe0, 0xb0, 0x83, 0xc3, 0xd9, 0x58, 0x80, 0x82, 0x3e, 0x54,
-	0x99, 0x5b, 0x5b, 0x10, 0x27, 0xf3, 0xea, 0x1d, 0x99, 0x2f, 0xf3, 0xe8, 0x4c, 0xe1, 0xc9, 0x9e,
-	0x74, 0xda, 0x75, 0x83, 0xbf, 0x44, 0x90, 0xf0, 0x19, 0x9f, 0xa8, 0x7d, 0x08, 0x1b, 0xbe, 0xa8,
-	0x7d, 0x90, 0xb8, 0x31, 0x75, 0x31, 0xf2, 0xe8, 0xd8, 0x87, 0x9f, 0x91, 0xb7, 0x3d, 0x9f, 0x81,
-	0xcb, 0x65, 0x6f, 0xaf, 0x26, 0xd1, 0xdd, 0xd5, 0x24, 0xfa, 0x7d, 0x35, 0x89, 0x3e, 0x7a, 0x90,
-	0xec, 0xbb, 0xfb, 0x20, 0xd9, 0xf7, 0xf3, 0x83, 0x64, 0xdf, 0x59, 0xfe, 0x2f, 0x01, 0x62, 0x9e,
-	0xd3, 0x2a, 0x8e, 0xfe, 0x0e, 0x5b, 0x83, 0xfe, 0x6c, 0x53, 0xec, 0xa7, 0xbf, 0xf4, 0x67, 0xff,
-	0x0d, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x03, 0x25, 0x97, 0x0c, 0x19, 0x00, 0x00,
+	// 1682 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x51, 0x68, 0x14, 0x57,
+	0x17, 0xce, 0x55, 0x8c, 0xc9, 0xd9, 0xc4, 0x1f, 0x6f, 0x62, 0x4c, 0x26, 0xbf, 0xbb, 0x71, 0x14,
+	0x13, 0x63, 0x76, 0x26, 0xd9, 0x4d, 0x8c, 0xe6, 0xf7, 0x17, 0xb2, 0x6a, 0xa4, 0xb4, 0xa2, 0x6e,
+	0xea, 0x8b, 0x2d, 0x2c, 0xb3, 0xbb, 0xe3, 0xba, 0xb8, 0x3b, 0xb3, 0xee, 0x9d, 0x68, 0x97, 0x10,
+	0x28, 0x2d, 0x05, 0x1f, 0x4a, 0x29, 0x54, 0x5f, 0x0a, 0x05, 0x9f, 0x4a, 0x69, 0x69, 0xb1, 0xa0,
+	0xd0, 0x87, 0x16, 0xfa, 0x52, 0x10, 0xa1, 0x54, 0xec, 0x8b, 0xf5, 0xa1, 0x2d, 0xb1, 0x60, 0xe9,
+	0x6b, 0x9f, 0x4b, 0xcb, 0xce, 0xbd, 0x77, 0x67, 0x66, 0xf7, 0xee, 0xec, 0x6c, 0x5c, 0x45, 0xfa,
+	0xa2, 0x99, 0x3b, 0xe7, 0xdc, 0xf3, 0x7d, 0xdf, 0x3d, 0x73, 0xee, 0x3d, 0x77, 0x21, 0x92, 0x31,
+	0x49, 0xd1, 0x24, 0x6a, 0x5a, 0x33, 0x2e, 0xa9, 0x57, 0x66, 0xd2, 0xba, 0xa5, 0xcd, 0xa8, 0x97,
+	0x57, 0xf4, 0x72, 0x45, 0x29, 0x95, 0x4d, 0xcb, 0xc4, 0x03, 0xd4, 0x40, 0xa9, 0x1a, 0x28, 0xcc,
+	0x40, 0x9a, 0xac, 0x79, 0x11, 0x9d, 0x5a, 0xd7, 0x7c, 0x4b, 0x5a, 0x2e, 0x6f, 0x68, 0x56, 0xde,
+	0x34, 0xe8, 0x04, 0xd2, 0x60, 0xce, 0xcc, 0x99, 0xf6, 0x9f, 0x6a, 0xf5, 0x2f, 0x36, 0xfa, 0xdf,
+	0x9c, 0x69, 0xe6, 0x0a, 0xba, 0xaa, 0x95, 0xf2, 0xaa, 0x66, 0x18, 0xa6, 0x65, 0xbb, 0x10, 0xf6,
+	0x36, 0xec, 0x9e, 0x9f, 0xcf, 0x9c, 0x31, 0xf3, 0x46, 0xc3, 0x7b, 0x17, 0x6a, 0x1b, 0x21, 0x7d,
+	0x3f, 0x42, 0xdf, 0xa7, 0x68, 0x58, 0xc6, 0x80, 0xbe, 0x1a, 0x65, 0xae, 0x1c, 0xb5, 0x9b, 0xac,
+	0xb4, 0x5d, 0x2b, 0xe6, 0x0d, 0x53, 0xb5, 0xff, 0xa5, 0x43, 0x72, 0x1e, 0x06, 0xce, 0x56, 0x2d,
+	0x12, 0x5a, 0x41, 0x33, 0x32, 0x7a, 0x52, 0xbf, 0xbc, 0xa2, 0x13, 0x0b, 0xc7, 0x60, 0xab, 0x96,
+	0xcd, 0x96, 0x75, 0x42, 0x86, 0xd1, 0x18, 0x9a, 0xe8, 0x4d, 0x0c, 0x3f, 0xb8, 0x1d, 0x1d, 0x64,
+	0x91, 0x16, 0xe9, 0x9b, 0x65, 0xab, 0x9c, 0x37, 0x72, 0x49, 0x6e, 0x88, 0x07, 0x61, 0x4b, 0x56,
+	0x37, 0xcc, 0xe2, 0xf0, 0xa6, 0xaa, 0x47, 0x92, 0x3e, 0x2c, 0xf4, 0x5c, 0xbb, 0x19, 0xe9, 0xfa,
+	0xfd, 0x66, 0xa4, 0x4b, 0x7e, 0x19, 0x06, 0xbd, 0xa1, 0x48, 0xc9, 0x34, 0x88, 0x8e, 0xe3, 0xb0,
+	0x35, 0x4d, 0x87, 0xec, 0x58, 0xa1, 0xd8, 0x88, 0x52, 0x5b, 0x14, 0xa2, 0xf3, 0x45, 0x51, 0x8e,
+	0x99, 0x79, 0x23, 0xc9, 0x2d, 0xe5, 0x9f, 0x10, 0xec, 0xb4, 0x67, 0x5b, 0x2c, 0x14, 0xd8, 0x84,
+	0xe4, 0x69, 0xc0, 0x2f, 0x01, 0x38, 0x4b, 0x6b, 0x33, 0x08, 0xc5, 0xf6, 0x79, 0x70, 0x50, 0x21,
+	0x39, 0x9a, 0x33, 0x5a, 0x8e, 0x8b, 0x95, 0x74, 0x79, 0xe2, 0x43, 0xd0, 0x5f, 0xd6, 0x89, 0x59,
+	0xb8, 0xa2, 0xa7, 0xa8, 0x18, 0x9b, 0xc7, 0xd0, 0x44, 0x4f, 0x62, 0xe0, 0xd1, 0xed, 0xe8, 0x7f,
+	0xe8, 0x6c, 0x51, 0x92, 0xbd, 0x34, 0x36, 0xad, 0xcc, 0x4d, 0x27, 0xfb, 0x98, 0xe5, 0xf1, 0x3a,
+	0xa1, 0xd6, 0x11, 0x0c, 0x37, 0x72, 0x63, 0x6a, 0xad, 0x41, 0x0f, 0xd3, 0xa0, 0xca, 0x6e, 0xb3,
+	0xaf, 0x5c, 0x89, 0xa5, 0xbb, 0x3f, 0x47, 0xba, 0x3e, 0xfd, 0x25, 0x32, 0x91, 0xcb, 0x5b, 0x17,
+	0x57, 0xd2, 0x4a, 0xc6, 0x2c, 0xb2, 0x74, 0x51, 0x1d, 0x30, 0xaa, 0x55, 0x29, 0xe9, 0xc4, 0x76,
+	0x20, 0x1f, 0x3e, 0xb9, 0x35, 0xd9, 0x57, 0xd0, 0x73, 0x5a, 0xa6, 0x92, 0xaa, 0x26, 0x24, 0xf9,
+	0xe4, 0xc9, 0xad, 0x49, 0x94, 0xac, 0x85, 0xc4, 0x27, 0x05, 0x3a, 0x8d, 0xb7, 0xd4, 0x89, 0x62,
+	0x77, 0x0b, 0x25, 0x7f, 0x85, 0x60, 0x97, 0x4d, 0x72, 0xb9, 0xa4, 0x1b, 0x59, 0x2d, 0x5d, 0xd0,
+	0x5f, 0xa0, 0x65, 0x5c, 0x18, 0xe5, 0x8b, 0xf1, 0xa0, 0x7e, 0xdd, 0x66, 0x0f, 0xca, 0x7f, 0x21,
+	0x08, 0x37, 0x83, 0xfe, 0xef, 0x5a, 0xa5, 0x85, 0x01, 0x11, 0xff, 0x77, 0x11, 0xec, 0x11, 0xf2,
+	0x4f, 0x54, 0xec, 0x54, 0xee, 0x7c, 0x11, 0xf1, 0x59, 0x8e, 0x79, 0xb9, 0x04, 0x7b, 0xfd, 0xd1,
+	0x3c, 0x45, 0x9d, 0x11, 0x09, 0x30, 0x2f, 0xbf, 0xc9, 0x8b, 0xcf, 0xab, 0xa6, 0xa5, 0x15, 0x96,
+	0x57, 0x4a, 0xa5, 0x42, 0x85, 0x93, 0x7e, 0xcd, 0x23, 0x3d, 0x6a, 0x27, 0x03, 0x05, 0x55, 0x62,
+	0x36, 0xee, 0x59, 0x0e, 0xa7, 0x46, 0xfc, 0xc9, 0x6b, 0x84, 0x07, 0x02, 0x63, 0x5a, 0x81, 0x6e,
+	0x62, 0x8f, 0x3c, 0xbf, 0xdc, 0x63, 0x01, 0xf1, 0xeb, 0x4f, 0x91, 0x79, 0x2d, 0xf9, 0xcb, 0x53,
+	0x6c, 0x0b, 0xa1, 0x7c, 0x4f, 0x5f, 0xe0, 0xa2, 0xd7, 0xb2, 0x06, 0xb9, 0xb2, 0x46, 0x3e, 0x07,
+	0x3b, 0xea, 0xac, 0x99, 0x3e, 0x47, 0xa0, 0x5b, 0x2b, 0x9a, 0x2b, 0x86, 0xd5, 0x32, 0x11, 0x12,
+	0xbd, 0x55, 0x7d, 0x18, 0x45, 0xea, 0x23, 0x0f, 0x02, 0xb6, 0xa7, 0x3d, 0xa3, 0x95, 0xb5, 0x22,
+	0xaf, 0x56, 0xf2, 0x39, 0x18, 0xf0, 0x8c, 0xb2, 0x50, 0x47, 0xa1, 0xbb, 0x64, 0x8f, 0xb0, 0x50,
+	0xa3, 0x8a, 0xe0, 0xc0, 0xa1, 0x50, 0x27, 0x4f, 0x30, 0xea, 0x25, 0x67, 0x41, 0xb2, 0xa7, 0xb5,
+	0x53, 0x99, 0x9c, 0xd2, 0x2d, 0x2d, 0xab, 0x59, 0x1a, 0xe7, 0xbd, 0xb4, 0xf1, 0x64, 0xf3, 0xe8,
+	0xfa, 0x05, 0x82, 0x51, 0x61, 0x18, 0xc6, 0x62, 0x09, 0x7a, 0x8b, 0x6c, 0x8c, 0xd7, 0xb3, 0x5d,
+	0x42, 0x22, 0xdc, 0xd3, 0x4d, 0xc5, 0x71, 0xed, 0xdc, 0xee, 0x31, 0x03, 0x23, 0x0e, 0xde, 0x7a,
+	0x55, 0xc4, 0xd9, 0x90, 0x06, 0x49, 0xe4, 0xc2, 0x18, 0x1e, 0x87, 0x1e, 0x0e, 0x93, 0xe9, 0x18,
+	0x9c, 0x60, 0xcd, 0x53, 0x3e, 0x0a, 0xfb, 0x1a, 0x63, 0x24, 0x2a, 0x34, 0x0b, 0x69, 0xa5, 0xf3,
+	0xc5, 0x68, 0xc2, 0x78, 0x4b, 0xff, 0x8e, 0x02, 0xbe, 0x0a, 0x3b, 0x9d, 0x80, 0xa7, 0xaf, 0x1a,
+	0x7a, 0x99, 0xf8, 0x22, 0xec, 0xd4, 0x06, 0x2b, 0xdf, 0x40, 0x00, 0x4e, 0xd0, 0x0d, 0x6d, 0x15,
+	0x47, 0x9d, 0x7a, 0xbe, 0xa9, 0x8d, 0xcf, 0xd8, 0xaf, 0xb4, 0x1f, 0x94, 0xbf, 0xe6, 0x75, 0xd5,
+	0xa3, 0x08, 0xd3, 0x3c, 0x01, 0x7d, 0xb6, 0x0a, 0x29, 0xd3, 0x1e, 0x67, 0x5f, 0x42, 0x44, 0xa8,
+	0xbb, 0xe3, 0x9f, 0x0c, 0x65, 0x9d, 0xb9, 0x9e, 0xf1, 0xd6, 0x7c, 0x83, 0x1f, 0x4d, 0x5c, 0xf0,
+	0x59, 0xfe, 0x3c, 0x97, 0x75, 0x5d, 0xd8, 0xf1, 0xe0, 0x76, 0x74, 0x7b, 0xdd, 0x41, 0x57, 0x89,
+	0xcb, 0xdf, 0x21, 0x88, 0x34, 0xc5, 0xf5, 0x22, 0xaa, 0xdb, 0x84, 0xc7, 0x7b, 0x7c, 0xe7, 0x5f,
+	0xd6, 0x8d, 0xec, 0x09, 0xa3, 0x7a, 0xda, 0xc8, 0x72, 0x61, 0x87, 0xa0, 0xdb, 0x86, 0x42, 0x91,
+	0xf7, 0x26, 0xd9, 0x53, 0x9d, 0xb4, 0x99, 0x0d, 0x4b, 0x2b, 0x3c, 0x8a, 0x7c, 0xc3, 0xf3, 0xd5,
+	0x03, 0x88, 0x29, 0x7a, 0x0c, 0xfa, 0x88, 0x6e, 0x64, 0x53, 0x3a, 0x1d, 0x67, 0x8a, 0x8e, 0x09,
+	0x15, 0x75, 0xfb, 0x87, 0x88, 0xf3, 0x80, 0x4f, 0x0a, 0xe0, 0x77, 0x2a, 0x61, 0xe7, 0xab, 0x82,
+	0xee, 0x70, 0x77, 0x85, 0x8b, 0x56, 0xc7, 0x4f, 0x8f, 0xd5, 0x85, 0xb9, 0xa8, 0xe7, 0x73, 0x17,
+	0x2d, 0xbb, 0x19, 0xdb, 0x9c, 0x64, 0x4f, 0x02, 0x40, 0x73, 0x71, 0xb9, 0x08, 0x43, 0xf5, 0x78,
+	0x6a, 0x5b, 0x79, 0xe0, 0xf3, 0x63, 0xc0, 0x7a, 0x33, 0x17, 0x97, 0x4f, 0xb0, 0xe5, 0x5b, 0xd2,
+	0x32, 0x96, 0x59, 0xf6, 0x9e, 0x9f, 0x85, 0x5f, 0xaa, 0x78, 0x9a, 0xb7, 0x11, 0x8c, 0x08, 0xe6,
+	0x61, 0xc8, 0xcf, 0x42, 0xff, 0x05, 0x3a, 0x9e, 0x72, 0x26, 0x0c, 0xc5, 0x76, 0x0b, 0x13, 0xc1,
+	0x3d, 0x83, 0x9b, 0x47, 0xdf, 0x05, 0xd7, 0x0b, 0x31, 0x8a, 0x3b, 0x08, 0xe4, 0x06, 0x14, 0x24,
+	0x51, 0x39, 0x56, 0xd6, 0x35, 0xcb, 0x2c, 0xbb, 0x56, 0x36, 0x43, 0x47, 0x5a, 0xaf, 0x2c, 0x33,
+	0xec, 0x58, 0x7d, 0x12, 0xe2, 0x7e, 0xc8, 0x1b, 0x9a, 0x66, 0xb8, 0x99, 0x8e, 0xcb, 0xb0, 0xcd,
+	0xa3, 0x23, 0xaf, 0x51, 0xed, 0x09, 0xd9, 0xef, 0x16, 0xf2, 0x99, 0x6e, 0x08, 0x73, 0xf1, 0xd8,
+	0xdf, 0x43, 0xb0, 0xc5, 0xa6, 0x86, 0x3f, 0x42, 0xb0, 0x95, 0x25, 0x35, 0x9e, 0x10, 0x02, 0x16,
+	0x5c, 0x04, 0x49, 0xfb, 0x03, 0x58, 0x52, 0x2c, 0xf2, 0xff, 0xaf, 0x55, 0xe9, 0xbd, 0xf5, 0xe3,
+	0x6f, 0x1f, 0x6c, 0x8a, 0xe1, 0x69, 0x55, 0x7c, 0x87, 0x65, 0xbb, 0x10, 0x75, 0x95, 0x7d, 0xb1,
+	0x6b, 0x6a, 0x9a, 0x69, 0x89, 0x6f, 0x22, 0x08, 0xb9, 0x2e, 0x3c, 0xf0, 0x54, 0xf3, 0xc8, 0x8d,
+	0x77, 0x3e, 0x52, 0x34, 0xa0, 0x35, 0xc3, 0x3a, 0xeb, 0x60, 0xdd, 0x8f, 0xc7, 0x03, 0x62, 0xc5,
+	0x3f, 0x20, 0xd8, 0xde, 0xd0, 0xf3, 0xe3, 0x58, 0xf3, 0xd0, 0xcd, 0xee, 0x36, 0xa4, 0x78, 0x5b,
+	0x3e, 0x0c, 0xf4, 0xd9, 0x7b, 0x8d, 0x3b, 0xbe, 0xc3, 0x23, 0x8e, 0x67, 0x84, 0x3c, 0x08, 0x9f,
+	0x2f, 0x25, 0x60, 0xf4, 0x07, 0x82, 0x9d, 0x4d, 0xfa, 0x66, 0x7c, 0x28, 0x38, 0x46, 0x6f, 0xe3,
+	0x2f, 0x1d, 0xde, 0x80, 0x27, 0xe3, 0x78, 0xbe, 0x91, 0xe3, 0xbc, 0xc3, 0xf1, 0x08, 0x5e, 0x68,
+	0x9b, 0xa3, 0x93, 0x61, 0xd7, 0x11, 0x84, 0x5c, 0xed, 0xb2, 0x5f, 0x86, 0x35, 0x36, 0xf6, 0x52,
+	0x34, 0xa0, 0x35, 0x23, 0x32, 0xe1, 0xa0, 0xde, 0x85, 0x47, 0xc5, 0xa8, 0x29, 0x8c, 0xeb, 0x08,
+	0x7a, 0x78, 0x8b, 0x8a, 0x7d, 0xbe, 0xb7, 0xba, 0xa6, 0x57, 0x9a, 0x0c, 0x62, 0xca, 0xd0, 0xcc,
+	0x38, 0x68, 0xf6, 0xe1, 0xbd, 0x3e, 0x68, 0x1c, 0xb5, 0xde, 0x41, 0xd0, 0x4d, 0xfb, 0x52, 0x3c,
+	0xde, 0x3c, 0x92, 0xa7, 0x09, 0x96, 0x26, 0x5a, 0x1b, 0x06, 0x97, 0x87, 0x76, 0xc0, 0xf8, 0x33,
+	0x04, 0xfd, 0x9e, 0x7e, 0x08, 0x2b, 0xcd, 0xa3, 0x88, 0xfa, 0x41, 0x49, 0x0d, 0x6c, 0xcf, 0xc0,
+	0x1d, 0x76, 0xc0, 0x29, 0x78, 0x4a, 0x08, 0x8e, 0xd6, 0xff, 0x14, 0x6f, 0xa4, 0xd4, 0x55, 0x7b,
+	0x60, 0x0d, 0x3f, 0x42, 0x20, 0x35, 0xef, 0xde, 0xf0, 0xff, 0x02, 0x42, 0x11, 0xf5, 0x8c, 0xd2,
+	0x91, 0x8d, 0x39, 0x33, 0x52, 0x8b, 0x0e, 0xa9, 0x83, 0x78, 0x36, 0x08, 0xa9, 0x54, 0xba, 0x92,
+	0xb2, 0xf7, 0x9e, 0x14, 0xa1, 0xe8, 0x3f, 0x46, 0xb0, 0xcd, 0x7b, 0x43, 0x80, 0x5b, 0x69, 0x5b,
+	0x7f, 0x65, 0x21, 0x4d, 0x07, 0x77, 0x08, 0x9e, 0xbb, 0x75, 0xc0, 0xf1, 0x1d, 0x04, 0x21, 0x57,
+	0xa7, 0xe1, 0xf7, 0xa5, 0x37, 0x76, 0xbe, 0x52, 0x34, 0xa0, 0x35, 0xc3, 0xf7, 0x92, 0x6f, 0x59,
+	0x3e, 0x80, 0xf7, 0x37, 0x87, 0xcc, 0x5a, 0x9d, 0x5a, 0xf6, 0x7c, 0x8f, 0x00, 0x37, 0x76, 0x48,
+	0x38, 0x1e, 0x08, 0x90, 0xb7, 0xcf, 0x93, 0x66, 0xdb, 0x73, 0x62, 0x64, 0x5e, 0xb9, 0x27, 0xea,
+	0x7b, 0x1c, 0x3a, 0x53, 0x78, 0xb2, 0x25, 0x9d, 0x5a, 0xde, 0xe0, 0xcf, 0x11, 0x84, 0x5c, 0x8d,
+	0x85, 0xdf, 0x3a, 0x34, 0x36, 0x54, 0x52, 0x34, 0xa0, 0x35, 0x4f, 0x70, 0xdf, 0xad, 0x63, 0x0f,
+	0xde, 0x2d, 0x2e, 0x7b, 0xae, 0x06, 0x09, 0x7f, 0x8b, 0xa0, 0xb7, 0x76, 0xf0, 0xc7, 0x93, 0x2d,
+	0xcf, 0x3e, 0xb5, 0x6e, 0x45, 0x3a, 0x10, 0xc8, 0xb6, 0xe9, 0x26, 0x37, 0x17, 0x6f, 0xbd, 0xc9,
+	0x09, 0xb6, 0x36, 0xcd, 0x4a, 0xd1, 0x5e, 0x46, 0x5d, 0xa5, 0xff, 0xaf, 0xe1, 0x2f, 0x11, 0xf4,
+	0xb9, 0x4f, 0x9e, 0xd8, 0x47, 0x45, 0x41, 0xd3, 0x21, 0x29, 0x41, 0xcd, 0x19, 0x97, 0x84, 0x2f,
+	0x97, 0xbd, 0x58, 0x16, 0x72, 0xf1, 0x1c, 0xa3, 0xab, 0x45, 0x73, 0x48, 0x7c, 0xf4, 0xc6, 0xf3,
+	0xc1, 0xe0, 0x34, 0x34, 0x19, 0xd2, 0xa1, 0xf6, 0x1d, 0x19, 0xa3, 0x53, 0xbe, 0x8c, 0x54, 0x1c,
+	0x6d, 0xcd, 0x88, 0xa8, 0xab, 0xac, 0x71, 0x59, 0x4b, 0xc4, 0xef, 0xae, 0x87, 0xd1, 0xfd, 0xf5,
+	0x30, 0xfa, 0x75, 0x3d, 0x8c, 0xde, 0x7f, 0x1c, 0xee, 0xba, 0xff, 0x38, 0xdc, 0xf5, 0xf0, 0x71,
+	0xb8, 0xeb, 0x3c, 0xfb, 0x19, 0x97, 0x64, 0x2f, 0x29, 0x79, 0x53, 0x7d, 0x83, 0xce, 0x67, 0x5f,
+	0xb5, 0xa7, 0xbb, 0xed, 0x5f, 0x67, 0xe3, 0xff, 0x0c, 0x00, 0xc5, 0xfa, 0x9a, 0x6f, 0xc0, 0x1e,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1551,6 +1885,13 @@ type QueryClient interface {
 	// Any denomination that does not have a specific setting will use the default
 	// params.default_send_enabled, and will not be returned by this query.
 	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// BalanceAt queries the balance of a single coin for a single account at the end of a past height.
+	// The denom must be one of the balance checkpoint denoms at that height.
+	BalanceAt(ctx context.Context, in *QueryBalanceAtRequest, opts ...grpc.CallOption) (*QueryBalanceAtResponse, error)
+	// FactoryDenom queries the authority of a token factory denom.
+	FactoryDenom(ctx context.Context, in *QueryFactoryDenomRequest, opts ...grpc.CallOption) (*QueryFactoryDenomResponse, error)
+	// FactoryDenomsByCreator queries the token factory denoms created by an account.
+	FactoryDenomsByCreator(ctx context.Context, in *QueryFactoryDenomsByCreatorRequest, opts ...grpc.CallOption) (*QueryFactoryDenomsByCreatorResponse, error)
 }
 
 type queryClient struct {
@@ -1678,6 +2019,33 @@ func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledReque
 	return out, nil
 }
 
+func (c *queryClient) BalanceAt(ctx context.Context, in *QueryBalanceAtRequest, opts ...grpc.CallOption) (*QueryBalanceAtResponse, error) {
+	out := new(QueryBalanceAtResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/BalanceAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FactoryDenom(ctx context.Context, in *QueryFactoryDenomRequest, opts ...grpc.CallOption) (*QueryFactoryDenomResponse, error) {
+	out := new(QueryFactoryDenomResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/FactoryDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FactoryDenomsByCreator(ctx context.Context, in *QueryFactoryDenomsByCreatorRequest, opts ...grpc.CallOption) (*QueryFactoryDenomsByCreatorResponse, error) {
+	out := new(QueryFactoryDenomsByCreatorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/FactoryDenomsByCreator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balance queries the balance of a single coin for a single account.
@@ -1732,6 +2100,13 @@ type QueryServer interface {
 	// Any denomination that does not have a specific setting will use the default
 	// params.default_send_enabled, and will not be returned by this query.
 	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// BalanceAt queries the balance of a single coin for a single account at the end of a past height.
+	// The denom must be one of the balance checkpoint denoms at that height.
+	BalanceAt(context.Context, *QueryBalanceAtRequest) (*QueryBalanceAtResponse, error)
+	// FactoryDenom queries the authority of a token factory denom.
+	FactoryDenom(context.Context, *QueryFactoryDenomRequest) (*QueryFactoryDenomResponse, error)
+	// FactoryDenomsByCreator queries the token factory denoms created by an account.
+	FactoryDenomsByCreator(context.Context, *QueryFactoryDenomsByCreatorRequest) (*QueryFactoryDenomsByCreatorResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1777,6 +2152,15 @@ func (*UnimplementedQueryServer) DenomOwnersByQuery(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) SendEnabled(ctx context.Context, req *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEnabled not implemented")
 }
+func (*UnimplementedQueryServer) BalanceAt(ctx context.Context, req *QueryBalanceAtRequest) (*QueryBalanceAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalanceAt not implemented")
+}
+func (*UnimplementedQueryServer) FactoryDenom(ctx context.Context, req *QueryFactoryDenomRequest) (*QueryFactoryDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FactoryDenom not implemented")
+}
+func (*UnimplementedQueryServer) FactoryDenomsByCreator(ctx context.Context, req *QueryFactoryDenomsByCreatorRequest) (*QueryFactoryDenomsByCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FactoryDenomsByCreator not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2016,6 +2400,60 @@ func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BalanceAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalanceAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BalanceAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/BalanceAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BalanceAt(ctx, req.(*QueryBalanceAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FactoryDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFactoryDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FactoryDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/FactoryDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FactoryDenom(ctx, req.(*QueryFactoryDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FactoryDenomsByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFactoryDenomsByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FactoryDenomsByCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/FactoryDenomsByCreator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FactoryDenomsByCreator(ctx, req.(*QueryFactoryDenomsByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.bank.v1beta1.Query",
@@ -2073,6 +2511,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendEnabled",
 			Handler:    _Query_SendEnabled_Handler,
 		},
+		{
+			MethodName: "BalanceAt",
+			Handler:    _Query_BalanceAt_Handler,
+		},
+		{
+			MethodName: "FactoryDenom",
+			Handler:    _Query_FactoryDenom_Handler,
+		},
+		{
+			MethodName: "FactoryDenomsByCreator",
+			Handler:    _Query_FactoryDenomsByCreator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
@@ -3146,9 +3596,238 @@ func (m *QuerySendEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
+func (m *QueryBalanceAtRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBalanceAtRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBalanceAtRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBalanceAtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBalanceAtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBalanceAtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFactoryDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFactoryDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFactoryDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFactoryDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFactoryDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFactoryDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FactoryDenom.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFactoryDenomsByCreatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFactoryDenomsByCreatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFactoryDenomsByCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFactoryDenomsByCreatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFactoryDenomsByCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFactoryDenomsByCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FactoryDenoms) > 0 {
+		for iNdEx := len(m.FactoryDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FactoryDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
@@ -3566,20 +4245,449 @@ func (m *QuerySendEnabledResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalanceAtRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryBalanceAtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFactoryDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFactoryDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FactoryDenom.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFactoryDenomsByCreatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFactoryDenomsByCreatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FactoryDenoms) > 0 {
+		for _, e := range m.FactoryDenoms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Balance == nil {
+				m.Balance = &types.Coin{}
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveDenom", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ResolveDenom = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBalancesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3602,17 +4710,17 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBalancesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3622,29 +4730,31 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3654,23 +4764,27 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3693,7 +4807,7 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySpendableBalancesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3716,15 +4830,47 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpendableBalancesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpendableBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3751,10 +4897,10 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Balance == nil {
-				m.Balance = &types.Coin{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3779,7 +4925,7 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBalancesRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySpendableBalancesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3802,17 +4948,17 @@ func (m *QueryAllBalancesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBalancesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpendableBalancesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpendableBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3822,23 +4968,25 @@ func (m *QueryAllBalancesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3870,32 +5018,12 @@ func (m *QueryAllBalancesRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResolveDenom", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ResolveDenom = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3917,7 +5045,7 @@ func (m *QueryAllBalancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBalancesResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySpendableBalanceByDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3940,17 +5068,17 @@ func (m *QueryAllBalancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBalancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpendableBalanceByDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpendableBalanceByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3960,31 +5088,29 @@ func (m *QueryAllBalancesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, types.Coin{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3994,27 +5120,23 @@ func (m *QueryAllBalancesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4037,7 +5159,7 @@ func (m *QueryAllBalancesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpendableBalancesRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySpendableBalanceByDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4060,17 +5182,17 @@ func (m *QuerySpendableBalancesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpendableBalancesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpendableBalanceByDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpendableBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpendableBalanceByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4080,25 +5202,79 @@ func (m *QuerySpendableBalancesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			if m.Balance == nil {
+				m.Balance = &types.Coin{}
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4155,7 +5331,7 @@ func (m *QuerySpendableBalancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpendableBalancesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4178,15 +5354,15 @@ func (m *QuerySpendableBalancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpendableBalancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalSupplyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpendableBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4213,8 +5389,8 @@ func (m *QuerySpendableBalancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, types.Coin{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Supply = append(m.Supply, types.Coin{})
+			if err := m.Supply[len(m.Supply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4275,7 +5451,7 @@ func (m *QuerySpendableBalancesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpendableBalanceByDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySupplyOfRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4298,45 +5474,13 @@ func (m *QuerySpendableBalanceByDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpendableBalanceByDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySupplyOfRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpendableBalanceByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySupplyOfRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
@@ -4389,7 +5533,7 @@ func (m *QuerySpendableBalanceByDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpendableBalanceByDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySupplyOfResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4412,15 +5556,15 @@ func (m *QuerySpendableBalanceByDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpendableBalanceByDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySupplyOfResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpendableBalanceByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySupplyOfResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4447,10 +5591,7 @@ func (m *QuerySpendableBalanceByDenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Balance == nil {
-				m.Balance = &types.Coin{}
-			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4475,7 +5616,7 @@ func (m *QuerySpendableBalanceByDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalSupplyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4498,15 +5639,65 @@ func (m *QueryTotalSupplyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalSupplyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4533,10 +5724,7 @@ func (m *QueryTotalSupplyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4561,7 +5749,7 @@ func (m *QueryTotalSupplyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomsMetadataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4584,47 +5772,13 @@ func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalSupplyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomsMetadataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomsMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Supply = append(m.Supply, types.Coin{})
-			if err := m.Supply[len(m.Supply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4654,7 +5808,7 @@ func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -4681,7 +5835,7 @@ func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySupplyOfRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomsMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4704,17 +5858,17 @@ func (m *QuerySupplyOfRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupplyOfRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomsMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupplyOfRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomsMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadatas", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4724,23 +5878,61 @@ func (m *QuerySupplyOfRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Metadatas = append(m.Metadatas, Metadata{})
+			if err := m.Metadatas[len(m.Metadatas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4763,7 +5955,7 @@ func (m *QuerySupplyOfRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySupplyOfResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4786,17 +5978,17 @@ func (m *QuerySupplyOfResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupplyOfResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomMetadataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupplyOfResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4806,24 +5998,23 @@ func (m *QuerySupplyOfResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4846,7 +6037,7 @@ func (m *QuerySupplyOfResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4869,12 +6060,45 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4896,7 +6120,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomMetadataByQueryStringRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4919,17 +6143,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomMetadataByQueryStringRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomMetadataByQueryStringRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4939,24 +6163,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4979,7 +6202,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomMetadataByQueryStringResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5002,15 +6225,15 @@ func (m *QueryDenomsMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomMetadataByQueryStringResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomMetadataByQueryStringResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5037,10 +6260,7 @@ func (m *QueryDenomsMetadataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5065,7 +6285,7 @@ func (m *QueryDenomsMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomOwnersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5088,17 +6308,17 @@ func (m *QueryDenomsMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomOwnersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomOwnersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadatas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5108,25 +6328,23 @@ func (m *QueryDenomsMetadataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadatas = append(m.Metadatas, Metadata{})
-			if err := m.Metadatas[len(m.Metadatas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -5158,7 +6376,7 @@ func (m *QueryDenomsMetadataResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5185,7 +6403,7 @@ func (m *QueryDenomsMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *DenomOwner) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5208,15 +6426,15 @@ func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomOwner: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomOwner: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5244,7 +6462,40 @@ func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5267,7 +6518,7 @@ func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomOwnersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5290,15 +6541,15 @@ func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomOwnersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomOwnersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomOwners", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5325,7 +6576,44 @@ func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DenomOwners = append(m.DenomOwners, &DenomOwner{})
+			if err := m.DenomOwners[len(m.DenomOwners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5350,7 +6638,7 @@ func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomMetadataByQueryStringRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomOwnersByQueryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5373,10 +6661,10 @@ func (m *QueryDenomMetadataByQueryStringRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomMetadataByQueryStringRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomOwnersByQueryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomMetadataByQueryStringRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomOwnersByQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5411,59 +6699,9 @@ func (m *QueryDenomMetadataByQueryStringRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDenomMetadataByQueryStringResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomMetadataByQueryStringResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomMetadataByQueryStringResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5490,7 +6728,10 @@ func (m *QueryDenomMetadataByQueryStringResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5515,7 +6756,7 @@ func (m *QueryDenomMetadataByQueryStringResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomOwnersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomOwnersByQueryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5538,17 +6779,17 @@ func (m *QueryDenomOwnersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomOwnersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomOwnersByQueryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomOwnersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomOwnersByQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomOwners", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5558,23 +6799,25 @@ func (m *QueryDenomOwnersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.DenomOwners = append(m.DenomOwners, &DenomOwner{})
+			if err := m.DenomOwners[len(m.DenomOwners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -5606,7 +6849,7 @@ func (m *QueryDenomOwnersRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5633,7 +6876,7 @@ func (m *QueryDenomOwnersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DenomOwner) Unmarshal(dAtA []byte) error {
+func (m *QuerySendEnabledRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5656,15 +6899,15 @@ func (m *DenomOwner) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomOwner: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySendEnabledRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySendEnabledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5692,11 +6935,11 @@ func (m *DenomOwner) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5723,7 +6966,10 @@ func (m *DenomOwner) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5748,7 +6994,7 @@ func (m *DenomOwner) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomOwnersResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySendEnabledResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5771,15 +7017,15 @@ func (m *QueryDenomOwnersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomOwnersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySendEnabledResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomOwnersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySendEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomOwners", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5806,12 +7052,12 @@ func (m *QueryDenomOwnersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomOwners = append(m.DenomOwners, &DenomOwner{})
-			if err := m.DenomOwners[len(m.DenomOwners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SendEnabled = append(m.SendEnabled, &SendEnabled{})
+			if err := m.SendEnabled[len(m.SendEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -5868,7 +7114,7 @@ func (m *QueryDenomOwnersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomOwnersByQueryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBalanceAtRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5891,13 +7137,45 @@ func (m *QueryDenomOwnersByQueryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomOwnersByQueryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBalanceAtRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomOwnersByQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBalanceAtRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
@@ -5929,9 +7207,78 @@ func (m *QueryDenomOwnersByQueryRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalanceAtResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalanceAtResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalanceAtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5958,10 +7305,7 @@ func (m *QueryDenomOwnersByQueryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5986,7 +7330,7 @@ func (m *QueryDenomOwnersByQueryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomOwnersByQueryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFactoryDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6009,17 +7353,17 @@ func (m *QueryDenomOwnersByQueryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomOwnersByQueryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFactoryDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomOwnersByQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFactoryDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomOwners", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6029,29 +7373,77 @@ func (m *QueryDenomOwnersByQueryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomOwners = append(m.DenomOwners, &DenomOwner{})
-			if err := m.DenomOwners[len(m.DenomOwners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFactoryDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFactoryDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFactoryDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FactoryDenom", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6078,10 +7470,7 @@ func (m *QueryDenomOwnersByQueryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FactoryDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6106,7 +7495,7 @@ func (m *QueryDenomOwnersByQueryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySendEnabledRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFactoryDenomsByCreatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6129,15 +7518,15 @@ func (m *QuerySendEnabledRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySendEnabledRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFactoryDenomsByCreatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySendEnabledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFactoryDenomsByCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6165,9 +7554,9 @@ func (m *QuerySendEnabledRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 99:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -6224,7 +7613,7 @@ func (m *QuerySendEnabledRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySendEnabledResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFactoryDenomsByCreatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6247,15 +7636,15 @@ func (m *QuerySendEnabledResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySendEnabledResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFactoryDenomsByCreatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySendEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFactoryDenomsByCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FactoryDenoms", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6282,12 +7671,12 @@ func (m *QuerySendEnabledResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SendEnabled = append(m.SendEnabled, &SendEnabled{})
-			if err := m.SendEnabled[len(m.SendEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FactoryDenoms = append(m.FactoryDenoms, FactoryDenom{})
+			if err := m.FactoryDenoms[len(m.FactoryDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 99:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
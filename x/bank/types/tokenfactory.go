@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// FactoryDenomPrefix is the prefix of the denoms created with the token factory.
+	FactoryDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of the creator defined part of a factory denom.
+	MaxSubdenomLength = 44
+)
+
+// BuildFactoryDenom returns the factory denom created by the given creator with the given subdenom.
+func BuildFactoryDenom(creator, subdenom string) (string, error) {
+	if creator == "" || strings.Contains(creator, "/") {
+		return "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "invalid creator %q", creator)
+	}
+	if subdenom == "" {
+		return "", errorsmod.Wrap(ErrInvalidFactoryDenom, "empty subdenom")
+	}
+	if len(subdenom) > MaxSubdenomLength {
+		return "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "subdenom is longer than %d characters", MaxSubdenomLength)
+	}
+
+	denom := strings.Join([]string{FactoryDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", errorsmod.Wrap(ErrInvalidFactoryDenom, err.Error())
+	}
+
+	return denom, nil
+}
+
+// ParseFactoryDenom returns the creator and subdenom of a factory denom.
+func ParseFactoryDenom(denom string) (creator, subdenom string, err error) {
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) != 3 || parts[0] != FactoryDenomPrefix {
+		return "", "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "%s is not of the form %s/{creator}/{subdenom}", denom, FactoryDenomPrefix)
+	}
+
+	if _, err := BuildFactoryDenom(parts[1], parts[2]); err != nil {
+		return "", "", err
+	}
+
+	return parts[1], parts[2], nil
+}
+
+// IsFactoryDenom returns whether the denom has the factory denom prefix.
+func IsFactoryDenom(denom string) bool {
+	return strings.HasPrefix(denom, FactoryDenomPrefix+"/")
+}
+
+// Validate performs a basic validation of the factory denom.
+func (fd FactoryDenom) Validate() error {
+	if _, _, err := ParseFactoryDenom(fd.Denom); err != nil {
+		return err
+	}
+
+	if fd.SendHook != "" && strings.TrimSpace(fd.SendHook) != fd.SendHook {
+		return fmt.Errorf("invalid send hook name %q for denom %s", fd.SendHook, fd.Denom)
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/bank/types"
+)
+
+func TestFactoryDenom(t *testing.T) {
+	creator := "cosmos1v9jxgu33ta047h6lta047h6lta047h6lxd3res"
+
+	denom, err := types.BuildFactoryDenom(creator, "token/v1")
+	require.NoError(t, err)
+	require.Equal(t, "factory/"+creator+"/token/v1", denom)
+	require.True(t, types.IsFactoryDenom(denom))
+
+	gotCreator, subdenom, err := types.ParseFactoryDenom(denom)
+	require.NoError(t, err)
+	require.Equal(t, creator, gotCreator)
+	require.Equal(t, "token/v1", subdenom)
+
+	for _, invalid := range []string{"stake", "factory/" + creator, "ibc/" + creator + "/token", "factory//token", "factory/" + creator + "/"} {
+		_, _, err := types.ParseFactoryDenom(invalid)
+		require.ErrorIs(t, err, types.ErrInvalidFactoryDenom, invalid)
+	}
+
+	require.NoError(t, types.FactoryDenom{Denom: denom, Admin: creator}.Validate())
+	require.Error(t, types.FactoryDenom{Denom: "stake"}.Validate())
+}
@@ -21,6 +21,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Query the current consensus parameters",
 				},
+				{
+					RpcMethod: "PendingParamsUpdates",
+					Use:       "pending-params-updates",
+					Short:     "Query the consensus parameters updates scheduled at future heights",
+				},
 			},
 			SubCommands: map[string]*autocliv1.ServiceCommandDescriptor{
 				"comet": cmtservice.CometBFTAutoCLIDescriptor,
@@ -42,6 +47,27 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod: "ScheduleParamsUpdate",
+					Use:       "schedule-params-update-proposal <height> <block> <evidence> <validator>",
+					Short:     "Submit a proposal to update consensus module params at a future height. Note: the entire params must be provided.",
+					Example:   fmt.Sprintf(`%s tx consensus schedule-params-update-proposal 1000 '{ block }' '{ evidence }' '{ validator }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "height"},
+						{ProtoField: "block"},
+						{ProtoField: "evidence"},
+						{ProtoField: "validator"},
+					},
+					GovProposal: true,
+				},
+				{
+					RpcMethod:      "CancelParamsUpdate",
+					Use:            "cancel-params-update-proposal <height>",
+					Short:          "Submit a proposal to cancel the consensus module params update scheduled at a height",
+					Example:        fmt.Sprintf(`%s tx consensus cancel-params-update-proposal 1000`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "height"}},
+					GovProposal:    true,
+				},
 			},
 		},
 	}
@@ -26,6 +26,8 @@ type Keeper struct {
 
 	authority   string
 	ParamsStore collections.Item[cmtproto.ConsensusParams]
+	// ScheduledUpdates key: height | value: the consensus params update scheduled at the height
+	ScheduledUpdates collections.Map[int64, types.ScheduledParamsUpdate]
 }
 
 var _ exported.ConsensusParamSetter = Keeper{}.ParamsStore
@@ -37,6 +39,13 @@ func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, authority strin
 		Environment: env,
 		authority:   authority,
 		ParamsStore: collections.NewItem(sb, collections.NewPrefix("Consensus"), "params", codec.CollValue[cmtproto.ConsensusParams](cdc)),
+		ScheduledUpdates: collections.NewMap(
+			sb,
+			collections.NewPrefix("ScheduledUpdates"),
+			"scheduled_updates",
+			collections.Int64Key,
+			codec.CollValue[types.ScheduledParamsUpdate](cdc),
+		),
 	}
 }
 
@@ -117,6 +126,11 @@ func (k Keeper) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*
 
 // paramCheck validates the consensus params
 func (k Keeper) paramCheck(ctx context.Context, consensusParams cmtproto.ConsensusParams) (*cmttypes.ConsensusParams, error) {
+	return k.paramCheckAtHeight(ctx, consensusParams, k.HeaderService.HeaderInfo(ctx).Height)
+}
+
+// paramCheckAtHeight validates the consensus params as if they were updated at the given height.
+func (k Keeper) paramCheckAtHeight(ctx context.Context, consensusParams cmtproto.ConsensusParams, height int64) (*cmttypes.ConsensusParams, error) {
 	var params cmttypes.ConsensusParams
 
 	paramsProto, err := k.ParamsStore.Get(ctx)
@@ -138,7 +152,11 @@ func (k Keeper) paramCheck(ctx context.Context, consensusParams cmtproto.Consens
 		return nil, err
 	}
 
-	if err := params.ValidateUpdate(&consensusParams, k.HeaderService.HeaderInfo(ctx).Height); err != nil {
+	if err := types.ValidateLiveness(nextParams); err != nil {
+		return nil, err
+	}
+
+	if err := params.ValidateUpdate(&consensusParams, height); err != nil {
 		return nil, err
 	}
 
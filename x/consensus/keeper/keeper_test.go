@@ -532,6 +532,37 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 			expErr:    true,
 			expErrMsg: "synchrony.MessageDelay must be greater than 0",
 		},
+		{
+			name: "invalid Block update - 0 max gas",
+			input: &types.MsgUpdateParams{
+				Authority: s.consensusParamsKeeper.GetAuthority(),
+				Block:     &cmtproto.BlockParams{MaxGas: 0, MaxBytes: defaultConsensusParams.Block.MaxBytes},
+				Validator: defaultConsensusParams.Validator,
+				Evidence:  defaultConsensusParams.Evidence,
+			},
+			expErr:    true,
+			expErrMsg: "block.MaxGas cannot be 0",
+		},
+		{
+			name: "invalid Block update - max bytes equal to evidence max bytes",
+			input: &types.MsgUpdateParams{
+				Authority: s.consensusParamsKeeper.GetAuthority(),
+				Block:     &cmtproto.BlockParams{MaxGas: -1, MaxBytes: defaultConsensusParams.Evidence.MaxBytes},
+				Validator: defaultConsensusParams.Validator,
+				Evidence:  defaultConsensusParams.Evidence,
+			},
+			expErr:    true,
+			expErrMsg: "block.MaxBytes must be greater than evidence.MaxBytes plus the block header size",
+		},
+		{
+			name: "valid Block update - unlimited max bytes",
+			input: &types.MsgUpdateParams{
+				Authority: s.consensusParamsKeeper.GetAuthority(),
+				Block:     &cmtproto.BlockParams{MaxGas: -1, MaxBytes: -1},
+				Validator: defaultConsensusParams.Validator,
+				Evidence:  defaultConsensusParams.Evidence,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -578,3 +609,234 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestScheduleParamsUpdate() {
+	defaultConsensusParams := cmttypes.DefaultConsensusParams().ToProto()
+	scheduleMsg := func(height int64) *types.MsgScheduleParamsUpdate {
+		return &types.MsgScheduleParamsUpdate{
+			Authority: s.consensusParamsKeeper.GetAuthority(),
+			Height:    height,
+			Block:     &cmtproto.BlockParams{MaxGas: 100, MaxBytes: defaultConsensusParams.Block.MaxBytes},
+			Validator: defaultConsensusParams.Validator,
+			Evidence:  defaultConsensusParams.Evidence,
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		input     *types.MsgScheduleParamsUpdate
+		expErrMsg string
+	}{
+		{
+			name:  "valid update",
+			input: scheduleMsg(10),
+		},
+		{
+			name: "invalid authority",
+			input: func() *types.MsgScheduleParamsUpdate {
+				msg := scheduleMsg(10)
+				msg.Authority = "invalid"
+				return msg
+			}(),
+			expErrMsg: "invalid authority",
+		},
+		{
+			name:      "current height",
+			input:     scheduleMsg(5),
+			expErrMsg: "scheduled height 5 must be greater than the current height 5",
+		},
+		{
+			name: "already scheduled",
+			malleate: func() {
+				_, err := s.consensusParamsKeeper.ScheduleParamsUpdate(s.ctx, scheduleMsg(10))
+				s.Require().NoError(err)
+			},
+			input:     scheduleMsg(10),
+			expErrMsg: "a consensus params update is already scheduled at height 10",
+		},
+		{
+			name: "nil evidence params",
+			input: func() *types.MsgScheduleParamsUpdate {
+				msg := scheduleMsg(10)
+				msg.Evidence = nil
+				return msg
+			}(),
+			expErrMsg: "all parameters must be present",
+		},
+		{
+			name: "halting max gas",
+			input: func() *types.MsgScheduleParamsUpdate {
+				msg := scheduleMsg(10)
+				msg.Block = &cmtproto.BlockParams{MaxGas: 0, MaxBytes: defaultConsensusParams.Block.MaxBytes}
+				return msg
+			}(),
+			expErrMsg: "block.MaxGas cannot be 0",
+		},
+		{
+			name: "halting max bytes lower than evidence max bytes",
+			input: func() *types.MsgScheduleParamsUpdate {
+				msg := scheduleMsg(10)
+				msg.Block = &cmtproto.BlockParams{MaxGas: -1, MaxBytes: defaultConsensusParams.Evidence.MaxBytes - 1}
+				return msg
+			}(),
+			expErrMsg: "evidence.MaxBytesEvidence is greater than upper bound",
+		},
+		{
+			name: "vote extensions enabled at the scheduled height",
+			input: func() *types.MsgScheduleParamsUpdate {
+				msg := scheduleMsg(10)
+				msg.Feature = &cmtproto.FeatureParams{VoteExtensionsEnableHeight: &gogotypes.Int64Value{Value: 10}}
+				return msg
+			}(),
+			expErrMsg: "Vote Extensions cannot be updated to a past or current height",
+		},
+		{
+			name: "vote extensions enabled after the scheduled height",
+			input: func() *types.MsgScheduleParamsUpdate {
+				msg := scheduleMsg(10)
+				msg.Feature = &cmtproto.FeatureParams{VoteExtensionsEnableHeight: &gogotypes.Int64Value{Value: 11}}
+				return msg
+			}(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest(false)
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+
+			_, err := s.consensusParamsKeeper.ScheduleParamsUpdate(s.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			update, err := s.consensusParamsKeeper.ScheduledUpdates.Get(s.ctx, tc.input.Height)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.input.Height, update.Height)
+			s.Require().Equal(tc.input.Block, update.Params.Block)
+
+			// the params are not updated before the height
+			params, err := s.consensusParamsKeeper.ParamsStore.Get(s.ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(defaultConsensusParams.Block, params.Block)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCancelParamsUpdate() {
+	s.SetupTest(false)
+	defaultConsensusParams := cmttypes.DefaultConsensusParams().ToProto()
+	authority := s.consensusParamsKeeper.GetAuthority()
+
+	_, err := s.consensusParamsKeeper.ScheduleParamsUpdate(s.ctx, &types.MsgScheduleParamsUpdate{
+		Authority: authority,
+		Height:    10,
+		Block:     defaultConsensusParams.Block,
+		Validator: defaultConsensusParams.Validator,
+		Evidence:  defaultConsensusParams.Evidence,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.consensusParamsKeeper.CancelParamsUpdate(s.ctx, &types.MsgCancelParamsUpdate{Authority: "invalid", Height: 10})
+	s.Require().ErrorContains(err, "invalid authority")
+
+	_, err = s.consensusParamsKeeper.CancelParamsUpdate(s.ctx, &types.MsgCancelParamsUpdate{Authority: authority, Height: 11})
+	s.Require().ErrorContains(err, "no consensus params update is scheduled at height 11")
+
+	_, err = s.consensusParamsKeeper.CancelParamsUpdate(s.ctx, &types.MsgCancelParamsUpdate{Authority: authority, Height: 10})
+	s.Require().NoError(err)
+
+	has, err := s.consensusParamsKeeper.ScheduledUpdates.Has(s.ctx, 10)
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryPendingParamsUpdates() {
+	s.SetupTest(false)
+	defaultConsensusParams := cmttypes.DefaultConsensusParams().ToProto()
+
+	res, err := s.queryClient.PendingParamsUpdates(s.ctx, &types.QueryPendingParamsUpdatesRequest{})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Updates)
+
+	for _, height := range []int64{20, 10, 30} {
+		_, err := s.consensusParamsKeeper.ScheduleParamsUpdate(s.ctx, &types.MsgScheduleParamsUpdate{
+			Authority: s.consensusParamsKeeper.GetAuthority(),
+			Height:    height,
+			Block:     defaultConsensusParams.Block,
+			Validator: defaultConsensusParams.Validator,
+			Evidence:  defaultConsensusParams.Evidence,
+		})
+		s.Require().NoError(err)
+	}
+
+	res, err = s.queryClient.PendingParamsUpdates(s.ctx, &types.QueryPendingParamsUpdatesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Updates, 3)
+	for i, height := range []int64{10, 20, 30} {
+		s.Require().Equal(height, res.Updates[i].Height)
+		s.Require().Equal(defaultConsensusParams.Block, res.Updates[i].Params.Block)
+	}
+}
+
+func (s *KeeperTestSuite) TestBeginBlockerScheduledUpdates() {
+	s.SetupTest(false)
+	defaultConsensusParams := cmttypes.DefaultConsensusParams().ToProto()
+	authority := s.consensusParamsKeeper.GetAuthority()
+
+	schedule := func(height, maxGas int64, feature *cmtproto.FeatureParams) {
+		_, err := s.consensusParamsKeeper.ScheduleParamsUpdate(s.ctx, &types.MsgScheduleParamsUpdate{
+			Authority: authority,
+			Height:    height,
+			Block:     &cmtproto.BlockParams{MaxGas: maxGas, MaxBytes: defaultConsensusParams.Block.MaxBytes},
+			Validator: defaultConsensusParams.Validator,
+			Evidence:  defaultConsensusParams.Evidence,
+			Feature:   feature,
+		})
+		s.Require().NoError(err)
+	}
+
+	schedule(10, 100, nil)
+	// valid when scheduled, but no longer once vote extensions are enabled by the update below
+	schedule(20, 200, &cmtproto.FeatureParams{VoteExtensionsEnableHeight: &gogotypes.Int64Value{Value: 25}})
+	_, err := s.consensusParamsKeeper.UpdateParams(s.ctx, &types.MsgUpdateParams{
+		Authority: authority,
+		Block:     defaultConsensusParams.Block,
+		Validator: defaultConsensusParams.Validator,
+		Evidence:  defaultConsensusParams.Evidence,
+		Feature:   &cmtproto.FeatureParams{VoteExtensionsEnableHeight: &gogotypes.Int64Value{Value: 8}},
+	})
+	s.Require().NoError(err)
+
+	// nothing is applied before the height
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 9})
+	s.Require().NoError(s.consensusParamsKeeper.BeginBlocker(ctx))
+	params, err := s.consensusParamsKeeper.ParamsStore.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(defaultConsensusParams.Block, params.Block)
+
+	ctx = s.ctx.WithHeaderInfo(header.Info{Height: 10})
+	s.Require().NoError(s.consensusParamsKeeper.BeginBlocker(ctx))
+	params, err = s.consensusParamsKeeper.ParamsStore.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(100), params.Block.MaxGas)
+	has, err := s.consensusParamsKeeper.ScheduledUpdates.Has(ctx, 10)
+	s.Require().NoError(err)
+	s.Require().False(has)
+
+	// the update at height 20 is dropped instead of halting the chain
+	ctx = s.ctx.WithHeaderInfo(header.Info{Height: 20})
+	s.Require().NoError(s.consensusParamsKeeper.BeginBlocker(ctx))
+	params, err = s.consensusParamsKeeper.ParamsStore.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(100), params.Block.MaxGas)
+	s.Require().Equal(int64(8), params.Feature.VoteExtensionsEnableHeight.GetValue())
+	has, err = s.consensusParamsKeeper.ScheduledUpdates.Has(ctx, 20)
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
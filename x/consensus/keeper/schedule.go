@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/consensus/types"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// ScheduleParamsUpdate schedules an update of the consensus parameters at a future height.
+// The parameters are validated against the current ones, and validated again when they are
+// applied, as the current parameters can change in the meantime.
+func (k Keeper) ScheduleParamsUpdate(ctx context.Context, msg *types.MsgScheduleParamsUpdate) (*types.MsgScheduleParamsUpdateResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, fmt.Errorf("invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if height := k.HeaderService.HeaderInfo(ctx).Height; msg.Height <= height {
+		return nil, fmt.Errorf("scheduled height %d must be greater than the current height %d", msg.Height, height)
+	}
+
+	has, err := k.ScheduledUpdates.Has(ctx, msg.Height)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, fmt.Errorf("a consensus params update is already scheduled at height %d", msg.Height)
+	}
+
+	consensusParams, err := msg.ToProtoConsensusParams()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := k.paramCheckAtHeight(ctx, consensusParams, msg.Height); err != nil {
+		return nil, err
+	}
+
+	if err := k.ScheduledUpdates.Set(ctx, msg.Height, types.ScheduledParamsUpdate{
+		Height: msg.Height,
+		Params: &consensusParams,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		"schedule_consensus_params_update",
+		event.NewAttribute("authority", msg.Authority),
+		event.NewAttribute("height", strconv.FormatInt(msg.Height, 10)),
+		event.NewAttribute("parameters", consensusParams.String())); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleParamsUpdateResponse{}, nil
+}
+
+// CancelParamsUpdate cancels the consensus parameters update scheduled at a height.
+func (k Keeper) CancelParamsUpdate(ctx context.Context, msg *types.MsgCancelParamsUpdate) (*types.MsgCancelParamsUpdateResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, fmt.Errorf("invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	has, err := k.ScheduledUpdates.Has(ctx, msg.Height)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, fmt.Errorf("no consensus params update is scheduled at height %d", msg.Height)
+	}
+
+	if err := k.ScheduledUpdates.Remove(ctx, msg.Height); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		"cancel_consensus_params_update",
+		event.NewAttribute("authority", msg.Authority),
+		event.NewAttribute("height", strconv.FormatInt(msg.Height, 10))); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelParamsUpdateResponse{}, nil
+}
+
+// PendingParamsUpdates queries the consensus parameters updates scheduled at future heights.
+func (k Keeper) PendingParamsUpdates(ctx context.Context, req *types.QueryPendingParamsUpdatesRequest) (*types.QueryPendingParamsUpdatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	updates, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.ScheduledUpdates,
+		req.Pagination,
+		func(_ int64, update types.ScheduledParamsUpdate) (types.ScheduledParamsUpdate, error) {
+			return update, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingParamsUpdatesResponse{Updates: updates, Pagination: pageRes}, nil
+}
+
+// BeginBlocker applies the consensus parameters updates scheduled at the current height.
+// An update which is no longer valid for the current parameters is dropped instead of
+// halting the chain.
+func (k Keeper) BeginBlocker(ctx context.Context) error {
+	height := k.HeaderService.HeaderInfo(ctx).Height
+
+	var updates []types.ScheduledParamsUpdate
+	rng := new(collections.Range[int64]).EndInclusive(height)
+	if err := k.ScheduledUpdates.Walk(ctx, rng, func(_ int64, update types.ScheduledParamsUpdate) (bool, error) {
+		updates = append(updates, update)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, update := range updates {
+		if err := k.ScheduledUpdates.Remove(ctx, update.Height); err != nil {
+			return err
+		}
+
+		if err := k.applyScheduledUpdate(ctx, update); err != nil {
+			k.Logger.Error("failed to apply scheduled consensus params update", "height", update.Height, "err", err)
+
+			if err := k.EventService.EventManager(ctx).EmitKV(
+				"scheduled_consensus_params_update_failed",
+				event.NewAttribute("height", strconv.FormatInt(update.Height, 10)),
+				event.NewAttribute("error", err.Error())); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// applyScheduledUpdate validates the scheduled update against the current parameters and sets the updated parameters.
+func (k Keeper) applyScheduledUpdate(ctx context.Context, update types.ScheduledParamsUpdate) error {
+	if update.Params == nil {
+		return errors.New("scheduled params are nil")
+	}
+
+	nextParams, err := k.paramCheck(ctx, *update.Params)
+	if err != nil {
+		return err
+	}
+
+	if err := k.ParamsStore.Set(ctx, nextParams.ToProto()); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		"update_consensus_params",
+		event.NewAttribute("height", strconv.FormatInt(update.Height, 10)),
+		event.NewAttribute("parameters", update.Params.String()))
+}
@@ -13,6 +13,8 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	registrar.RegisterImplementations(
 		(*coretransaction.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgScheduleParamsUpdate{},
+		&MsgCancelParamsUpdate{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
@@ -22,4 +24,6 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	legacy.RegisterAminoMsg(registrar, &MsgUpdateParams{}, "cosmos-sdk/x/consensus/MsgUpdateParams")
+	legacy.RegisterAminoMsg(registrar, &MsgScheduleParamsUpdate{}, "cosmos-sdk/MsgScheduleParamsUpdate")
+	legacy.RegisterAminoMsg(registrar, &MsgCancelParamsUpdate{}, "cosmos-sdk/MsgCancelParamsUpdate")
 }
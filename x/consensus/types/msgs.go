@@ -50,3 +50,15 @@ func (msg MsgUpdateParams) ToProtoConsensusParams() (cmtproto.ConsensusParams, e
 
 	return cp, nil
 }
+
+// ToProtoConsensusParams converts MsgScheduleParamsUpdate to cmtproto.ConsensusParams.
+// It returns an error if any required parameters are missing.
+func (msg MsgScheduleParamsUpdate) ToProtoConsensusParams() (cmtproto.ConsensusParams, error) {
+	return MsgUpdateParams{
+		Block:     msg.Block,
+		Evidence:  msg.Evidence,
+		Validator: msg.Validator,
+		Synchrony: msg.Synchrony,
+		Feature:   msg.Feature,
+	}.ToProtoConsensusParams()
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// ValidateLiveness rejects the consensus params combinations which are valid for CometBFT but would
+// halt the chain, as no block with transactions could be proposed anymore, including the
+// governance transactions which could fix them.
+func ValidateLiveness(params cmttypes.ConsensusParams) error {
+	if params.Block.MaxGas == 0 {
+		return errors.New("block.MaxGas cannot be 0, no transaction could be included in a block")
+	}
+
+	// -1 means the block size is only limited by cmttypes.MaxBlockSizeBytes
+	if params.Block.MaxBytes == -1 {
+		return nil
+	}
+
+	// a block must fit its header and the evidence, with room left for the transactions
+	minMaxBytes := params.Evidence.MaxBytes + cmttypes.MaxHeaderBytes + cmttypes.MaxOverheadForBlock
+	if params.Block.MaxBytes <= minMaxBytes {
+		return fmt.Errorf(
+			"block.MaxBytes must be greater than evidence.MaxBytes plus the block header size, %d <= %d",
+			params.Block.MaxBytes, minMaxBytes,
+		)
+	}
+
+	return nil
+}
@@ -7,6 +7,9 @@ import (
 	context "context"
 	fmt "fmt"
 	v1 "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -114,32 +117,149 @@ func (m *QueryParamsResponse) GetParams() *v1.ConsensusParams {
 	return nil
 }
 
+// QueryPendingParamsUpdatesRequest defines the request type for querying the scheduled consensus parameters updates.
+type QueryPendingParamsUpdatesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingParamsUpdatesRequest) Reset()         { *m = QueryPendingParamsUpdatesRequest{} }
+func (m *QueryPendingParamsUpdatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingParamsUpdatesRequest) ProtoMessage()    {}
+func (*QueryPendingParamsUpdatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf54d1e5df04cee9, []int{2}
+}
+func (m *QueryPendingParamsUpdatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingParamsUpdatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingParamsUpdatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingParamsUpdatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingParamsUpdatesRequest.Merge(m, src)
+}
+func (m *QueryPendingParamsUpdatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingParamsUpdatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingParamsUpdatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingParamsUpdatesRequest proto.InternalMessageInfo
+
+func (m *QueryPendingParamsUpdatesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingParamsUpdatesResponse defines the response type for querying the scheduled consensus parameters updates.
+type QueryPendingParamsUpdatesResponse struct {
+	// updates are the scheduled consensus parameters updates, ordered by height.
+	Updates []ScheduledParamsUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingParamsUpdatesResponse) Reset()         { *m = QueryPendingParamsUpdatesResponse{} }
+func (m *QueryPendingParamsUpdatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingParamsUpdatesResponse) ProtoMessage()    {}
+func (*QueryPendingParamsUpdatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf54d1e5df04cee9, []int{3}
+}
+func (m *QueryPendingParamsUpdatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingParamsUpdatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingParamsUpdatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingParamsUpdatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingParamsUpdatesResponse.Merge(m, src)
+}
+func (m *QueryPendingParamsUpdatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingParamsUpdatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingParamsUpdatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingParamsUpdatesResponse proto.InternalMessageInfo
+
+func (m *QueryPendingParamsUpdatesResponse) GetUpdates() []ScheduledParamsUpdate {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
+}
+
+func (m *QueryPendingParamsUpdatesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.consensus.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.consensus.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryPendingParamsUpdatesRequest)(nil), "cosmos.consensus.v1.QueryPendingParamsUpdatesRequest")
+	proto.RegisterType((*QueryPendingParamsUpdatesResponse)(nil), "cosmos.consensus.v1.QueryPendingParamsUpdatesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/consensus/v1/query.proto", fileDescriptor_bf54d1e5df04cee9) }
 
 var fileDescriptor_bf54d1e5df04cee9 = []byte{
-	// 271 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0xce, 0xcf, 0x2b, 0x4e, 0xcd, 0x2b, 0x2e, 0x2d, 0xd6, 0x2f, 0x33, 0xd4,
-	0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0x28, 0xd0,
-	0x83, 0x2b, 0xd0, 0x2b, 0x33, 0x94, 0x92, 0x49, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x4f, 0x2c,
-	0xc8, 0xd4, 0x4f, 0xcc, 0xcb, 0xcb, 0x2f, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0x86, 0x68, 0x91,
-	0x92, 0x4b, 0xce, 0xcf, 0x4d, 0x2d, 0x49, 0x4a, 0x2b, 0xd1, 0x2f, 0xa9, 0x2c, 0x48, 0x05, 0x9b,
-	0x58, 0x90, 0x58, 0x94, 0x98, 0x0b, 0x95, 0x57, 0x12, 0xe1, 0x12, 0x0a, 0x04, 0xd9, 0x10, 0x00,
-	0x16, 0x0c, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x51, 0x0a, 0xe4, 0x12, 0x46, 0x11, 0x2d, 0x2e,
-	0x00, 0xd9, 0x28, 0x64, 0xc5, 0xc5, 0x06, 0xd1, 0x2c, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0xa4,
-	0xa4, 0x07, 0x33, 0x5d, 0x0f, 0x6c, 0xba, 0x5e, 0x99, 0xa1, 0x9e, 0x33, 0xcc, 0x6d, 0x50, 0xbd,
-	0x50, 0x1d, 0x46, 0x5d, 0x8c, 0x5c, 0xac, 0x60, 0x33, 0x85, 0x1a, 0x18, 0xb9, 0xd8, 0x20, 0x92,
-	0x42, 0xea, 0x7a, 0x58, 0x7c, 0xa4, 0x87, 0xe9, 0x20, 0x29, 0x0d, 0xc2, 0x0a, 0x21, 0x6e, 0x54,
-	0x52, 0x6e, 0xba, 0xfc, 0x64, 0x32, 0x93, 0xac, 0x90, 0xb4, 0x3e, 0xb6, 0xd0, 0x84, 0x38, 0xc6,
-	0xc9, 0xe2, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0,
-	0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xe4, 0x20, 0xba, 0x8a,
-	0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x2b, 0x90, 0x74, 0x83, 0x7d, 0x98, 0xc4, 0x06, 0x0e, 0x36,
-	0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x63, 0xcb, 0xf0, 0x2b, 0xac, 0x01, 0x00, 0x00,
+	// 485 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0xaf, 0x0b, 0x14, 0xc9, 0x3b, 0xe1, 0x15, 0x69, 0x14, 0xf0, 0x4a, 0x26, 0xb1, 0x6a, 0x68,
+	0xf6, 0x5a, 0x04, 0x42, 0x3b, 0x0e, 0x09, 0x10, 0xa7, 0xad, 0x88, 0x0b, 0x97, 0xca, 0x49, 0x4c,
+	0x88, 0x58, 0xed, 0xac, 0x76, 0x22, 0x76, 0x03, 0x3e, 0x01, 0x12, 0x5f, 0x85, 0x0f, 0x31, 0x71,
+	0xd9, 0x04, 0x17, 0x0e, 0x08, 0xa1, 0x96, 0x0f, 0x82, 0xe2, 0xe7, 0x6c, 0x9d, 0x08, 0x1b, 0xdc,
+	0x12, 0xbf, 0xf7, 0x7e, 0xff, 0x9e, 0x8d, 0x97, 0x23, 0x6d, 0xc6, 0xda, 0xf0, 0x48, 0x2b, 0x23,
+	0x95, 0xc9, 0x0d, 0x2f, 0xfa, 0x7c, 0x2f, 0x97, 0x93, 0x7d, 0x96, 0x4d, 0xb4, 0xd5, 0x64, 0x11,
+	0x1a, 0xd8, 0x71, 0x03, 0x2b, 0xfa, 0x9d, 0x1b, 0x89, 0xd6, 0xc9, 0xae, 0xe4, 0x22, 0x4b, 0xb9,
+	0x50, 0x4a, 0x5b, 0x61, 0x53, 0xad, 0x0c, 0x8c, 0x74, 0x68, 0xa4, 0xc7, 0xd2, 0x86, 0x2f, 0x2d,
+	0xb7, 0xfb, 0x99, 0x74, 0x88, 0x99, 0x98, 0x88, 0x71, 0x55, 0xbf, 0x06, 0x90, 0x23, 0xf7, 0xc7,
+	0x3d, 0x3e, 0x94, 0xd6, 0xbc, 0x9c, 0x50, 0x18, 0x09, 0x32, 0x78, 0xd1, 0x0f, 0xa5, 0x15, 0x25,
+	0x44, 0x92, 0x2a, 0xc7, 0xe3, 0x7b, 0x57, 0xea, 0xa4, 0x1f, 0xff, 0xf8, 0xa6, 0x76, 0xa2, 0x13,
+	0x0d, 0x44, 0xe5, 0x17, 0x9c, 0x06, 0x6d, 0x4c, 0x76, 0x4a, 0xf0, 0x6d, 0x27, 0x6b, 0x28, 0xf7,
+	0x72, 0x69, 0x6c, 0xb0, 0x83, 0x17, 0x4f, 0x9d, 0x9a, 0xac, 0x04, 0x23, 0x9b, 0xb8, 0x05, 0xf2,
+	0x97, 0x50, 0x17, 0xf5, 0x16, 0x06, 0x01, 0xab, 0xfc, 0x31, 0xe7, 0x8f, 0x15, 0x7d, 0xf6, 0xb0,
+	0xa2, 0xf5, 0xb3, 0x7e, 0x22, 0x78, 0x87, 0x70, 0x17, 0x30, 0xa5, 0x8a, 0x53, 0x95, 0x40, 0xf9,
+	0x79, 0x16, 0x0b, 0x2b, 0x2b, 0x5e, 0xf2, 0x08, 0xe3, 0x13, 0x73, 0x9e, 0xe4, 0x36, 0xf3, 0xb9,
+	0x94, 0x49, 0x30, 0x58, 0x88, 0x4f, 0x82, 0x6d, 0x8b, 0x44, 0xfa, 0xd9, 0xe1, 0xdc, 0xe4, 0xe6,
+	0xd5, 0x2f, 0x9f, 0xd6, 0xaf, 0xbc, 0x39, 0x89, 0xa0, 0xbb, 0xc1, 0x06, 0x6c, 0x23, 0x38, 0x44,
+	0xf8, 0xd6, 0x19, 0x1a, 0xbc, 0xcb, 0xa7, 0xf8, 0x72, 0x0e, 0x47, 0x4b, 0xa8, 0x7b, 0xa1, 0xb7,
+	0x30, 0x58, 0x63, 0x35, 0x9b, 0x67, 0xcf, 0xa2, 0x57, 0x32, 0xce, 0x77, 0x65, 0x3c, 0x8f, 0xb2,
+	0x75, 0xf1, 0xe0, 0xc7, 0x72, 0x63, 0x58, 0x01, 0x90, 0xc7, 0xa7, 0x0c, 0x35, 0x9d, 0xa1, 0xd5,
+	0x73, 0x0d, 0x81, 0x90, 0x7f, 0x70, 0x34, 0xf8, 0xde, 0xc4, 0x97, 0x9c, 0x23, 0xf2, 0x16, 0xe1,
+	0x16, 0x28, 0x21, 0xab, 0xb5, 0x7a, 0xff, 0x5c, 0x73, 0xa7, 0x77, 0x7e, 0x23, 0x48, 0x09, 0x56,
+	0xde, 0x7f, 0xfd, 0xf5, 0xb1, 0x79, 0x93, 0x5c, 0xe7, 0x75, 0x57, 0x0d, 0x56, 0x4c, 0x0e, 0x11,
+	0x6e, 0xd7, 0x25, 0x4b, 0xee, 0x9d, 0xc1, 0xf3, 0xf7, 0xdb, 0xd0, 0xb9, 0xff, 0xbf, 0x63, 0x5e,
+	0xec, 0x93, 0xcf, 0x75, 0x59, 0x39, 0x07, 0xeb, 0xe4, 0x4e, 0xbd, 0x03, 0xc0, 0x1b, 0x81, 0x93,
+	0x91, 0x5f, 0xdf, 0xd6, 0x83, 0x83, 0x29, 0x45, 0x47, 0x53, 0x8a, 0x7e, 0x4e, 0x29, 0xfa, 0x30,
+	0xa3, 0x8d, 0xa3, 0x19, 0x6d, 0x7c, 0x9b, 0xd1, 0xc6, 0x0b, 0x0a, 0x28, 0x26, 0x7e, 0xcd, 0x52,
+	0xcd, 0xe7, 0xc8, 0xe0, 0xa5, 0x87, 0x2d, 0xf7, 0xbc, 0xee, 0xfe, 0x1e, 0x00, 0xef, 0xe1, 0xca,
+	0x2f, 0x56, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -156,6 +276,8 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params queries the parameters of x/consensus module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// PendingParamsUpdates queries the consensus parameters updates scheduled at future heights, by height.
+	PendingParamsUpdates(ctx context.Context, in *QueryPendingParamsUpdatesRequest, opts ...grpc.CallOption) (*QueryPendingParamsUpdatesResponse, error)
 }
 
 type queryClient struct {
@@ -175,10 +297,21 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) PendingParamsUpdates(ctx context.Context, in *QueryPendingParamsUpdatesRequest, opts ...grpc.CallOption) (*QueryPendingParamsUpdatesResponse, error) {
+	out := new(QueryPendingParamsUpdatesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.consensus.v1.Query/PendingParamsUpdates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/consensus module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// PendingParamsUpdates queries the consensus parameters updates scheduled at future heights, by height.
+	PendingParamsUpdates(context.Context, *QueryPendingParamsUpdatesRequest) (*QueryPendingParamsUpdatesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -188,6 +321,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) PendingParamsUpdates(ctx context.Context, req *QueryPendingParamsUpdatesRequest) (*QueryPendingParamsUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingParamsUpdates not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -211,6 +347,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingParamsUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingParamsUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingParamsUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.consensus.v1.Query/PendingParamsUpdates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingParamsUpdates(ctx, req.(*QueryPendingParamsUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.consensus.v1.Query",
@@ -220,6 +374,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "PendingParamsUpdates",
+			Handler:    _Query_PendingParamsUpdates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/consensus/v1/query.proto",
@@ -283,6 +441,90 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPendingParamsUpdatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingParamsUpdatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingParamsUpdatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingParamsUpdatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingParamsUpdatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingParamsUpdatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -316,6 +558,38 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPendingParamsUpdatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingParamsUpdatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -458,6 +732,212 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPendingParamsUpdatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingParamsUpdatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingParamsUpdatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingParamsUpdatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingParamsUpdatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingParamsUpdatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updates = append(m.Updates, ScheduledParamsUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
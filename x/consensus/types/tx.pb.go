@@ -169,46 +169,292 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgScheduleParamsUpdate is the Msg/ScheduleParamsUpdate request type.
+type MsgScheduleParamsUpdate struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// height is the block height at which the parameters are updated. The update is
+	// applied at the beginning of the block, so that it is taken into account by
+	// CometBFT from the next block, as a MsgUpdateParams included in that block would.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// params defines the x/consensus parameters to update at the height.
+	//
+	// NOTE: All parameters must be supplied.
+	Block     *v1.BlockParams     `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
+	Evidence  *v1.EvidenceParams  `protobuf:"bytes,4,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	Validator *v1.ValidatorParams `protobuf:"bytes,5,opt,name=validator,proto3" json:"validator,omitempty"`
+	Synchrony *v1.SynchronyParams `protobuf:"bytes,6,opt,name=synchrony,proto3" json:"synchrony,omitempty"`
+	Feature   *v1.FeatureParams   `protobuf:"bytes,7,opt,name=feature,proto3" json:"feature,omitempty"`
+}
+
+func (m *MsgScheduleParamsUpdate) Reset()         { *m = MsgScheduleParamsUpdate{} }
+func (m *MsgScheduleParamsUpdate) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleParamsUpdate) ProtoMessage()    {}
+func (*MsgScheduleParamsUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2135c60575ab504d, []int{2}
+}
+func (m *MsgScheduleParamsUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleParamsUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleParamsUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleParamsUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleParamsUpdate.Merge(m, src)
+}
+func (m *MsgScheduleParamsUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleParamsUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleParamsUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleParamsUpdate proto.InternalMessageInfo
+
+func (m *MsgScheduleParamsUpdate) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgScheduleParamsUpdate) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MsgScheduleParamsUpdate) GetBlock() *v1.BlockParams {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *MsgScheduleParamsUpdate) GetEvidence() *v1.EvidenceParams {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
+func (m *MsgScheduleParamsUpdate) GetValidator() *v1.ValidatorParams {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
+func (m *MsgScheduleParamsUpdate) GetSynchrony() *v1.SynchronyParams {
+	if m != nil {
+		return m.Synchrony
+	}
+	return nil
+}
+
+func (m *MsgScheduleParamsUpdate) GetFeature() *v1.FeatureParams {
+	if m != nil {
+		return m.Feature
+	}
+	return nil
+}
+
+// MsgScheduleParamsUpdateResponse defines the response structure for executing a
+// MsgScheduleParamsUpdate message.
+type MsgScheduleParamsUpdateResponse struct {
+}
+
+func (m *MsgScheduleParamsUpdateResponse) Reset()         { *m = MsgScheduleParamsUpdateResponse{} }
+func (m *MsgScheduleParamsUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleParamsUpdateResponse) ProtoMessage()    {}
+func (*MsgScheduleParamsUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2135c60575ab504d, []int{3}
+}
+func (m *MsgScheduleParamsUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleParamsUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleParamsUpdateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleParamsUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleParamsUpdateResponse.Merge(m, src)
+}
+func (m *MsgScheduleParamsUpdateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleParamsUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleParamsUpdateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleParamsUpdateResponse proto.InternalMessageInfo
+
+// MsgCancelParamsUpdate is the Msg/CancelParamsUpdate request type.
+type MsgCancelParamsUpdate struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// height is the block height of the scheduled update to cancel.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *MsgCancelParamsUpdate) Reset()         { *m = MsgCancelParamsUpdate{} }
+func (m *MsgCancelParamsUpdate) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelParamsUpdate) ProtoMessage()    {}
+func (*MsgCancelParamsUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2135c60575ab504d, []int{4}
+}
+func (m *MsgCancelParamsUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelParamsUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelParamsUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelParamsUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelParamsUpdate.Merge(m, src)
+}
+func (m *MsgCancelParamsUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelParamsUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelParamsUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelParamsUpdate proto.InternalMessageInfo
+
+func (m *MsgCancelParamsUpdate) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCancelParamsUpdate) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// MsgCancelParamsUpdateResponse defines the response structure for executing a
+// MsgCancelParamsUpdate message.
+type MsgCancelParamsUpdateResponse struct {
+}
+
+func (m *MsgCancelParamsUpdateResponse) Reset()         { *m = MsgCancelParamsUpdateResponse{} }
+func (m *MsgCancelParamsUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelParamsUpdateResponse) ProtoMessage()    {}
+func (*MsgCancelParamsUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2135c60575ab504d, []int{5}
+}
+func (m *MsgCancelParamsUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelParamsUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelParamsUpdateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelParamsUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelParamsUpdateResponse.Merge(m, src)
+}
+func (m *MsgCancelParamsUpdateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelParamsUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelParamsUpdateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelParamsUpdateResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.consensus.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.consensus.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgScheduleParamsUpdate)(nil), "cosmos.consensus.v1.MsgScheduleParamsUpdate")
+	proto.RegisterType((*MsgScheduleParamsUpdateResponse)(nil), "cosmos.consensus.v1.MsgScheduleParamsUpdateResponse")
+	proto.RegisterType((*MsgCancelParamsUpdate)(nil), "cosmos.consensus.v1.MsgCancelParamsUpdate")
+	proto.RegisterType((*MsgCancelParamsUpdateResponse)(nil), "cosmos.consensus.v1.MsgCancelParamsUpdateResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/consensus/v1/tx.proto", fileDescriptor_2135c60575ab504d) }
 
 var fileDescriptor_2135c60575ab504d = []byte{
-	// 496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0xc6, 0x1b, 0xb7, 0xdd, 0xb5, 0xa3, 0xb0, 0x98, 0x2a, 0x3b, 0x5b, 0x74, 0xa8, 0x45, 0x64,
-	0x29, 0x76, 0xb2, 0xad, 0xeb, 0x5f, 0x10, 0xdc, 0x88, 0xa2, 0x87, 0x45, 0xc9, 0xb2, 0x1e, 0xbc,
-	0xc8, 0x34, 0x99, 0xcd, 0x86, 0x6e, 0x32, 0x21, 0x33, 0x8d, 0xdb, 0x9b, 0x08, 0x5e, 0x3c, 0xf9,
-	0x45, 0x84, 0x1e, 0xf6, 0x43, 0x88, 0xa7, 0xc5, 0x93, 0x78, 0x92, 0xf6, 0xd0, 0xaf, 0x21, 0x9d,
-	0x99, 0x6c, 0x6c, 0x8d, 0xb2, 0x97, 0x40, 0xf2, 0x3c, 0xbf, 0xe7, 0xc9, 0xbc, 0x79, 0x03, 0xae,
-	0xba, 0x8c, 0x87, 0x8c, 0x5b, 0x2e, 0x8b, 0x38, 0x8d, 0xf8, 0x80, 0x5b, 0x69, 0xc7, 0x12, 0x47,
-	0x38, 0x4e, 0x98, 0x60, 0x66, 0x4d, 0xa9, 0xf8, 0x54, 0xc5, 0x69, 0xa7, 0x7e, 0x89, 0x84, 0x41,
-	0xc4, 0x2c, 0x79, 0x55, 0xbe, 0xfa, 0xba, 0xf2, 0xbd, 0x95, 0x77, 0x96, 0x86, 0x94, 0xb4, 0xa6,
-	0x0b, 0x42, 0xee, 0xcf, 0xa2, 0x43, 0xee, 0x6b, 0x01, 0xb9, 0x2c, 0xa4, 0xa2, 0xb7, 0x2f, 0x2c,
-	0x31, 0x8c, 0xa9, 0xec, 0x8d, 0x49, 0x42, 0x42, 0x0d, 0x36, 0xbf, 0x94, 0xc1, 0xea, 0x0e, 0xf7,
-	0xf7, 0x62, 0x8f, 0x08, 0xfa, 0x4a, 0x2a, 0xe6, 0x5d, 0x50, 0x25, 0x03, 0x71, 0xc0, 0x92, 0x40,
-	0x0c, 0xa1, 0xd1, 0x30, 0x36, 0xaa, 0x36, 0xfc, 0x7e, 0xdc, 0xbe, 0xac, 0x1b, 0xb7, 0x3d, 0x2f,
-	0xa1, 0x9c, 0xef, 0x8a, 0x24, 0x88, 0x7c, 0x27, 0xb7, 0x9a, 0x5b, 0xa0, 0xd2, 0x3b, 0x64, 0x6e,
-	0x1f, 0x9e, 0x6b, 0x18, 0x1b, 0x17, 0xba, 0x08, 0x67, 0xdd, 0x58, 0x76, 0xe3, 0xb4, 0x83, 0xed,
-	0x99, 0xae, 0x6a, 0x1c, 0x65, 0x36, 0x1f, 0x81, 0xf3, 0x34, 0x0d, 0x3c, 0x1a, 0xb9, 0x14, 0x2e,
-	0x49, 0xf0, 0x7a, 0x01, 0xf8, 0x54, 0x5b, 0x34, 0x7b, 0x8a, 0x98, 0x8f, 0x41, 0x35, 0x25, 0x87,
-	0x81, 0x47, 0x04, 0x4b, 0x60, 0x59, 0xf2, 0xcd, 0x02, 0xfe, 0x75, 0xe6, 0xd1, 0x01, 0x39, 0x64,
-	0x3e, 0x07, 0x65, 0xd2, 0x73, 0x03, 0x58, 0x91, 0xf0, 0xb5, 0x02, 0x78, 0xdb, 0x7e, 0xf2, 0x42,
-	0x71, 0xf6, 0x95, 0x9f, 0xc7, 0xed, 0x55, 0x35, 0x88, 0x36, 0xf7, 0xfa, 0x8d, 0x4d, 0x7c, 0x67,
-	0x13, 0x1a, 0x8e, 0x4c, 0x30, 0xf7, 0x40, 0x95, 0x0f, 0x23, 0xf7, 0x20, 0x61, 0xd1, 0x10, 0x2e,
-	0xff, 0xf3, 0x5d, 0x76, 0x33, 0x8f, 0xce, 0xac, 0xfd, 0x9d, 0xd9, 0x75, 0xf2, 0x24, 0xf3, 0x25,
-	0x58, 0xd9, 0xa7, 0x44, 0x0c, 0x12, 0x0a, 0x57, 0x64, 0x68, 0xa3, 0x20, 0xf4, 0x99, 0x72, 0xfc,
-	0x2f, 0x32, 0x4b, 0x79, 0xf8, 0xe0, 0xc3, 0x74, 0xd4, 0xca, 0x3f, 0xdc, 0xa7, 0xe9, 0xa8, 0x75,
-	0x33, 0x37, 0x5b, 0x47, 0x7f, 0xec, 0xe9, 0xc2, 0x6e, 0x34, 0xd7, 0xc1, 0xda, 0xc2, 0x23, 0x87,
-	0xf2, 0x78, 0x66, 0xef, 0x7e, 0x34, 0xc0, 0xd2, 0x0e, 0xf7, 0xcd, 0x77, 0xe0, 0xe2, 0xdc, 0x3a,
-	0xdd, 0xc0, 0x05, 0xfb, 0x8d, 0x17, 0x52, 0xea, 0xb7, 0xce, 0xe2, 0xca, 0xba, 0x9a, 0xb5, 0x6f,
-	0x8b, 0xe7, 0xdb, 0xba, 0x57, 0xaf, 0xbc, 0x9f, 0x8e, 0x5a, 0x86, 0x7d, 0xff, 0xeb, 0x18, 0x19,
-	0x27, 0x63, 0x64, 0xfc, 0x1a, 0x23, 0xe3, 0xf3, 0x04, 0x95, 0x4e, 0x26, 0xa8, 0xf4, 0x63, 0x82,
-	0x4a, 0x6f, 0x90, 0x22, 0xb8, 0xd7, 0xc7, 0x01, 0x9b, 0x3b, 0xa6, 0x1c, 0x63, 0x6f, 0x59, 0xfe,
-	0x13, 0xb7, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x3b, 0x57, 0xe6, 0xfa, 0xaf, 0x03, 0x00, 0x00,
+	// 659 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xdf, 0x4f, 0xd3, 0x40,
+	0x1c, 0xa7, 0x76, 0x03, 0x77, 0x9a, 0x10, 0x0e, 0x26, 0xa5, 0x91, 0x5a, 0x1b, 0x63, 0xc8, 0xe2,
+	0xae, 0x63, 0x22, 0xe2, 0x12, 0x13, 0x18, 0xd1, 0xa0, 0x09, 0xd1, 0x94, 0xe0, 0x83, 0x2f, 0xa6,
+	0x6b, 0x8f, 0xae, 0x61, 0xed, 0x2d, 0xbd, 0xdb, 0x64, 0x6f, 0xc6, 0x47, 0x7d, 0xd1, 0xbf, 0xc2,
+	0x27, 0x13, 0x4c, 0xf8, 0x23, 0x0c, 0x4f, 0x84, 0x27, 0xe3, 0x93, 0x81, 0x07, 0x12, 0xff, 0x0a,
+	0xc3, 0xb5, 0x5b, 0xc7, 0xd6, 0x09, 0xcc, 0xf8, 0xb2, 0xa4, 0xfb, 0xfc, 0xba, 0xeb, 0x7d, 0xbe,
+	0x57, 0x70, 0xd3, 0x22, 0xd4, 0x23, 0x54, 0xb7, 0x88, 0x4f, 0xb1, 0x4f, 0x1b, 0x54, 0x6f, 0xce,
+	0xeb, 0x6c, 0x07, 0xd5, 0x03, 0xc2, 0x08, 0x9c, 0x0c, 0x51, 0xd4, 0x41, 0x51, 0x73, 0x5e, 0x9e,
+	0x30, 0x3d, 0xd7, 0x27, 0x3a, 0xff, 0x0d, 0x79, 0xf2, 0x4c, 0xc8, 0x7b, 0xc3, 0x9f, 0xf4, 0x48,
+	0x14, 0x42, 0xd3, 0x51, 0x80, 0x47, 0x9d, 0x53, 0x6b, 0x8f, 0x3a, 0x11, 0xa0, 0x58, 0xc4, 0xc3,
+	0xac, 0xb2, 0xc5, 0x74, 0xd6, 0xaa, 0x63, 0x9e, 0x5b, 0x37, 0x03, 0xd3, 0x8b, 0x84, 0xda, 0xd7,
+	0x14, 0x18, 0x5f, 0xa7, 0xce, 0x66, 0xdd, 0x36, 0x19, 0x7e, 0xc9, 0x11, 0xb8, 0x08, 0x32, 0x66,
+	0x83, 0x55, 0x49, 0xe0, 0xb2, 0x96, 0x24, 0xa8, 0xc2, 0x5c, 0xa6, 0x2c, 0x1d, 0xee, 0xe5, 0xa7,
+	0xa2, 0xc4, 0x15, 0xdb, 0x0e, 0x30, 0xa5, 0x1b, 0x2c, 0x70, 0x7d, 0xc7, 0x88, 0xa9, 0x70, 0x01,
+	0xa4, 0x2b, 0x35, 0x62, 0x6d, 0x4b, 0x57, 0x54, 0x61, 0xee, 0x5a, 0x51, 0x41, 0xed, 0x6c, 0xc4,
+	0xb3, 0x51, 0x73, 0x1e, 0x95, 0x4f, 0xf1, 0x30, 0xc6, 0x08, 0xc9, 0xf0, 0x31, 0xb8, 0x8a, 0x9b,
+	0xae, 0x8d, 0x7d, 0x0b, 0x4b, 0x22, 0x17, 0xde, 0x4e, 0x10, 0x3e, 0x89, 0x28, 0x91, 0xb6, 0x23,
+	0x81, 0xcb, 0x20, 0xd3, 0x34, 0x6b, 0xae, 0x6d, 0x32, 0x12, 0x48, 0x29, 0xae, 0xd7, 0x12, 0xf4,
+	0xaf, 0xda, 0x9c, 0xc8, 0x20, 0x16, 0xc1, 0x35, 0x90, 0x32, 0x2b, 0x96, 0x2b, 0xa5, 0xb9, 0x78,
+	0x36, 0x41, 0xbc, 0x52, 0x5e, 0x7d, 0x16, 0xea, 0xca, 0xd9, 0x9f, 0x7b, 0xf9, 0xf1, 0xf0, 0x45,
+	0xe4, 0xa9, 0xbd, 0xad, 0x16, 0xd0, 0x83, 0x82, 0x24, 0x18, 0xdc, 0x01, 0x6e, 0x82, 0x0c, 0x6d,
+	0xf9, 0x56, 0x35, 0x20, 0x7e, 0x4b, 0x1a, 0x1d, 0xb8, 0x96, 0x8d, 0x36, 0x27, 0xf2, 0x9c, 0xec,
+	0xf7, 0x2c, 0x1a, 0xb1, 0x13, 0x7c, 0x01, 0xc6, 0xb6, 0xb0, 0xc9, 0x1a, 0x01, 0x96, 0xc6, 0xb8,
+	0xa9, 0x9a, 0x60, 0xfa, 0x34, 0x64, 0xfc, 0xcd, 0xb2, 0xed, 0x52, 0x7a, 0xf4, 0xfe, 0x64, 0x37,
+	0x17, 0x1f, 0xdc, 0x87, 0x93, 0xdd, 0xdc, 0xdd, 0x98, 0xac, 0xef, 0x74, 0xf5, 0xb4, 0xa7, 0x1b,
+	0xda, 0x0c, 0x98, 0xee, 0xf9, 0xcb, 0xc0, 0xb4, 0x7e, 0x4a, 0xd7, 0x7e, 0x8b, 0x1c, 0xdb, 0xb0,
+	0xaa, 0xd8, 0x6e, 0xd4, 0x22, 0x34, 0x64, 0x0e, 0x5d, 0xa9, 0x1b, 0x60, 0xb4, 0x8a, 0x5d, 0xa7,
+	0xca, 0x78, 0xa7, 0x44, 0x23, 0x7a, 0x8a, 0xab, 0x26, 0x0e, 0x5b, 0xb5, 0xd4, 0x3f, 0x56, 0x2d,
+	0x3d, 0x4c, 0xd5, 0x96, 0x87, 0x2a, 0x48, 0x77, 0x17, 0x4a, 0x97, 0xee, 0x42, 0x7c, 0xec, 0xcf,
+	0x0f, 0xf7, 0xf2, 0x13, 0x5d, 0x87, 0xab, 0x16, 0x50, 0x11, 0x15, 0xfa, 0xbb, 0xa0, 0x75, 0x75,
+	0x61, 0xc0, 0x81, 0x6a, 0x4b, 0xe0, 0xd6, 0x00, 0xa8, 0xdd, 0x87, 0x52, 0x36, 0x31, 0x4e, 0xfb,
+	0x26, 0x80, 0xec, 0x3a, 0x75, 0x56, 0x4d, 0xdf, 0xc2, 0xb5, 0xff, 0x59, 0x92, 0xd2, 0xda, 0x05,
+	0xf7, 0xab, 0x9e, 0xdd, 0x6f, 0xff, 0xca, 0xb4, 0x45, 0x30, 0x9b, 0x08, 0x9c, 0xb3, 0xd7, 0xe2,
+	0x17, 0x11, 0x88, 0xeb, 0xd4, 0x81, 0x6f, 0xc1, 0xf5, 0x33, 0x37, 0xec, 0x1d, 0x94, 0x70, 0xe5,
+	0xa3, 0x9e, 0xc1, 0x92, 0xef, 0x5d, 0x84, 0xd5, 0x19, 0xbf, 0xc9, 0xfd, 0xde, 0x91, 0x5f, 0x78,
+	0x08, 0x3f, 0x0b, 0x60, 0x2a, 0x71, 0x20, 0x07, 0x7a, 0x27, 0xb1, 0xe5, 0x85, 0xcb, 0xb0, 0x3b,
+	0x2b, 0xca, 0xee, 0x27, 0xbd, 0x14, 0xf8, 0x51, 0x00, 0x30, 0xe1, 0xf4, 0x73, 0x83, 0x32, 0xfa,
+	0xb9, 0x72, 0xf1, 0xe2, 0xdc, 0x73, 0x56, 0x23, 0xa7, 0xdf, 0x9d, 0xec, 0xe6, 0x84, 0xf2, 0xd2,
+	0xf7, 0x23, 0x45, 0x38, 0x38, 0x52, 0x84, 0x5f, 0x47, 0x8a, 0xf0, 0xe9, 0x58, 0x19, 0x39, 0x38,
+	0x56, 0x46, 0x7e, 0x1c, 0x2b, 0x23, 0xaf, 0x95, 0x30, 0x8a, 0xda, 0xdb, 0xc8, 0x25, 0x67, 0xee,
+	0x46, 0x3e, 0x6f, 0x95, 0x51, 0xfe, 0x21, 0xbd, 0xff, 0x67, 0x00, 0x11, 0x82, 0x5d, 0x38, 0xe4,
+	0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -226,6 +472,12 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/consensus module parameters.
 	// The authority is defined in the keeper.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ScheduleParamsUpdate defines a governance operation for scheduling an update of the x/consensus module
+	// parameters at a future block height. The authority is defined in the keeper.
+	ScheduleParamsUpdate(ctx context.Context, in *MsgScheduleParamsUpdate, opts ...grpc.CallOption) (*MsgScheduleParamsUpdateResponse, error)
+	// CancelParamsUpdate defines a governance operation for cancelling a scheduled update of the x/consensus
+	// module parameters. The authority is defined in the keeper.
+	CancelParamsUpdate(ctx context.Context, in *MsgCancelParamsUpdate, opts ...grpc.CallOption) (*MsgCancelParamsUpdateResponse, error)
 }
 
 type msgClient struct {
@@ -245,11 +497,35 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ScheduleParamsUpdate(ctx context.Context, in *MsgScheduleParamsUpdate, opts ...grpc.CallOption) (*MsgScheduleParamsUpdateResponse, error) {
+	out := new(MsgScheduleParamsUpdateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.consensus.v1.Msg/ScheduleParamsUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelParamsUpdate(ctx context.Context, in *MsgCancelParamsUpdate, opts ...grpc.CallOption) (*MsgCancelParamsUpdateResponse, error) {
+	out := new(MsgCancelParamsUpdateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.consensus.v1.Msg/CancelParamsUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/consensus module parameters.
 	// The authority is defined in the keeper.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ScheduleParamsUpdate defines a governance operation for scheduling an update of the x/consensus module
+	// parameters at a future block height. The authority is defined in the keeper.
+	ScheduleParamsUpdate(context.Context, *MsgScheduleParamsUpdate) (*MsgScheduleParamsUpdateResponse, error)
+	// CancelParamsUpdate defines a governance operation for cancelling a scheduled update of the x/consensus
+	// module parameters. The authority is defined in the keeper.
+	CancelParamsUpdate(context.Context, *MsgCancelParamsUpdate) (*MsgCancelParamsUpdateResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -259,6 +535,12 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) ScheduleParamsUpdate(ctx context.Context, req *MsgScheduleParamsUpdate) (*MsgScheduleParamsUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleParamsUpdate not implemented")
+}
+func (*UnimplementedMsgServer) CancelParamsUpdate(ctx context.Context, req *MsgCancelParamsUpdate) (*MsgCancelParamsUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelParamsUpdate not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -282,6 +564,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleParamsUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleParamsUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleParamsUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.consensus.v1.Msg/ScheduleParamsUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleParamsUpdate(ctx, req.(*MsgScheduleParamsUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelParamsUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelParamsUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelParamsUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.consensus.v1.Msg/CancelParamsUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelParamsUpdate(ctx, req.(*MsgCancelParamsUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.consensus.v1.Msg",
@@ -291,6 +609,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ScheduleParamsUpdate",
+			Handler:    _Msg_ScheduleParamsUpdate_Handler,
+		},
+		{
+			MethodName: "CancelParamsUpdate",
+			Handler:    _Msg_CancelParamsUpdate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/consensus/v1/tx.proto",
@@ -421,38 +747,214 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgScheduleParamsUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgScheduleParamsUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleParamsUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Block != nil {
-		l = m.Block.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.Feature != nil {
+		{
+			size, err := m.Feature.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.Evidence != nil {
-		l = m.Evidence.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.Synchrony != nil {
+		{
+			size, err := m.Synchrony.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
 	if m.Validator != nil {
-		l = m.Validator.Size()
-		n += 1 + l + sovTx(uint64(l))
+		{
+			size, err := m.Validator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Evidence != nil {
+		{
+			size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleParamsUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleParamsUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleParamsUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelParamsUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelParamsUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelParamsUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelParamsUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelParamsUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelParamsUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Evidence != nil {
+		l = m.Evidence.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Validator != nil {
+		l = m.Validator.Size()
+		n += 1 + l + sovTx(uint64(l))
 	}
 	if m.Abci != nil {
 		l = m.Abci.Size()
@@ -466,25 +968,443 @@ func (m *MsgUpdateParams) Size() (n int) {
 		l = m.Feature.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleParamsUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Evidence != nil {
+		l = m.Evidence.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Validator != nil {
+		l = m.Validator.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Synchrony != nil {
+		l = m.Synchrony.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Feature != nil {
+		l = m.Feature.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgScheduleParamsUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelParamsUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MsgCancelParamsUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &v1.BlockParams{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Evidence == nil {
+				m.Evidence = &v1.EvidenceParams{}
+			}
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Validator == nil {
+				m.Validator = &v1.ValidatorParams{}
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abci", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Abci == nil {
+				m.Abci = &v1.ABCIParams{}
+			}
+			if err := m.Abci.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synchrony", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Synchrony == nil {
+				m.Synchrony = &v1.SynchronyParams{}
+			}
+			if err := m.Synchrony.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feature", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Feature == nil {
+				m.Feature = &v1.FeatureParams{}
+			}
+			if err := m.Feature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleParamsUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -507,10 +1427,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleParamsUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleParamsUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -546,6 +1466,25 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
@@ -581,7 +1520,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
 			}
@@ -617,7 +1556,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
@@ -653,9 +1592,9 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Abci", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Synchrony", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -682,16 +1621,16 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Abci == nil {
-				m.Abci = &v1.ABCIParams{}
+			if m.Synchrony == nil {
+				m.Synchrony = &v1.SynchronyParams{}
 			}
-			if err := m.Abci.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Synchrony.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Synchrony", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Feature", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -718,18 +1657,118 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Synchrony == nil {
-				m.Synchrony = &v1.SynchronyParams{}
+			if m.Feature == nil {
+				m.Feature = &v1.FeatureParams{}
 			}
-			if err := m.Synchrony.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Feature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleParamsUpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleParamsUpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleParamsUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelParamsUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelParamsUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelParamsUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Feature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -739,28 +1778,43 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Feature == nil {
-				m.Feature = &v1.FeatureParams{}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			if err := m.Feature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -782,7 +1836,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelParamsUpdateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -805,10 +1859,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelParamsUpdateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelParamsUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
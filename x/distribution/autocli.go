@@ -85,6 +85,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query the amount of coins in the community pool",
 					Example:   fmt.Sprintf(`$ %s query distribution community-pool`, version.AppName),
 				},
+				{
+					RpcMethod: "AutoCompoundDelegators",
+					Use:       "auto-compound-delegators",
+					Short:     "Query the delegators opted in to the auto-compounding of their rewards",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -125,6 +130,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "amount", Varargs: true},
 					},
 				},
+				{
+					RpcMethod: "SetAutoCompound",
+					Use:       "set-auto-compound <enabled>",
+					Short:     "Opt in or out of the auto-compounding of the rewards of all delegations",
+					Long:      "Opt in or out of the auto-compounding of the rewards of all delegations, which are then periodically withdrawn and delegated back to the validators they were earned from. Only the rewards of delegators withdrawing to their own address are auto-compounded.",
+					Example:   fmt.Sprintf("%s tx distribution set-auto-compound true --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "enabled"},
+					},
+				},
 				{
 					RpcMethod:  "FundCommunityPool",
 					Deprecated: fmt.Sprintf("Use %s tx protocolpool fund-community-pool", version.AppName),
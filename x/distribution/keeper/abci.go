@@ -9,7 +9,8 @@ import (
 )
 
 // BeginBlocker sets the proposer for determining distribution during endblock
-// and distribute rewards for the previous block.
+// and distribute rewards for the previous block. It also auto-compounds the
+// rewards of a batch of delegators when an auto-compounding run is in progress.
 func (k Keeper) BeginBlocker(ctx context.Context) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyBeginBlocker)
 
@@ -36,5 +37,6 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 		}
 	}
 
-	return nil
+	// auto-compound the rewards of the next batch of opted-in delegators, if a run is in progress
+	return k.autoCompoundBatch(ctx)
 }
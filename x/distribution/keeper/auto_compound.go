@@ -0,0 +1,248 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/distribution/types"
+	epochstypes "cosmossdk.io/x/epochs/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EpochHooks wrapper struct for the x/epochs hooks of the distribution keeper
+type EpochHooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+// EpochHooks returns the x/epochs hooks of the distribution keeper, starting the
+// auto-compounding of the rewards at the start of each auto-compounding epoch.
+func (k Keeper) EpochHooks() EpochHooks {
+	return EpochHooks{k}
+}
+
+// BeforeEpochStart starts the auto-compounding of the rewards of the opted-in
+// delegators, which are then processed in batches in the following begin blockers.
+// A run not finished at the start of the next epoch is restarted.
+func (h EpochHooks) BeforeEpochStart(ctx context.Context, epochIdentifier string, _ int64) error {
+	params, err := h.k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.AutoCompoundEpochIdentifier == "" || params.AutoCompoundEpochIdentifier != epochIdentifier {
+		return nil
+	}
+
+	return h.k.startAutoCompound(ctx)
+}
+
+// AfterEpochEnd is a noop
+func (h EpochHooks) AfterEpochEnd(_ context.Context, _ string, _ int64) error {
+	return nil
+}
+
+// SetAutoCompound opts a delegator in or out of the auto-compounding of its rewards.
+func (k Keeper) SetAutoCompound(ctx context.Context, delAddr sdk.AccAddress, enabled bool) error {
+	var err error
+	if enabled {
+		err = k.AutoCompoundDelegators.Set(ctx, delAddr)
+	} else {
+		err = k.AutoCompoundDelegators.Remove(ctx, delAddr)
+	}
+	if err != nil {
+		return err
+	}
+
+	addr, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSetAutoCompound,
+		event.NewAttribute(types.AttributeKeyDelegator, addr),
+		event.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+	)
+}
+
+// startAutoCompound points the auto-compounding cursor to the first delegation of the
+// first opted-in delegator.
+func (k Keeper) startAutoCompound(ctx context.Context) error {
+	iter, err := k.AutoCompoundDelegators.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return k.AutoCompoundCursor.Remove(ctx)
+	}
+
+	first, err := iter.Key()
+	if err != nil {
+		return err
+	}
+
+	return k.AutoCompoundCursor.Set(ctx, collections.Join(first, sdk.ValAddress{}))
+}
+
+// autoCompoundBatch auto-compounds the rewards of the next AutoCompoundBatchSize
+// delegations of the opted-in delegators of the current run, if any, and moves the
+// cursor past them. An opted-in delegator without delegations counts as one delegation.
+func (k Keeper) autoCompoundBatch(ctx context.Context) error {
+	cursor, err := k.AutoCompoundCursor.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// auto-compounding was disabled during the run
+	if params.AutoCompoundEpochIdentifier == "" {
+		return k.AutoCompoundCursor.Remove(ctx)
+	}
+
+	// collect the batch first, as the store must not be written while iterated
+	type delegatorBatch struct {
+		delAddr     sdk.AccAddress
+		delegations []stakingtypes.Delegation
+	}
+	var (
+		batch   []delegatorBatch
+		next    collections.Pair[sdk.AccAddress, sdk.ValAddress]
+		hasNext bool
+	)
+	remaining := params.AutoCompoundBatchSize
+	rng := new(collections.Range[sdk.AccAddress]).StartInclusive(cursor.K1())
+	err = k.AutoCompoundDelegators.Walk(ctx, rng, func(delAddr sdk.AccAddress) (bool, error) {
+		if remaining == 0 {
+			next, hasNext = collections.Join(delAddr, sdk.ValAddress{}), true
+			return true, nil
+		}
+
+		var startVal sdk.ValAddress
+		if delAddr.Equals(cursor.K1()) {
+			startVal = cursor.K2()
+		}
+
+		// one more delegation is read to know where the next batch starts
+		delegations, err := k.stakingKeeper.GetDelegatorDelegationsFrom(ctx, delAddr, startVal, remaining+1)
+		if err != nil {
+			return true, err
+		}
+
+		if uint64(len(delegations)) > remaining {
+			valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(delegations[remaining].ValidatorAddress)
+			if err != nil {
+				return true, err
+			}
+			batch = append(batch, delegatorBatch{delAddr, delegations[:remaining]})
+			next, hasNext = collections.Join(delAddr, sdk.ValAddress(valAddr)), true
+			return true, nil
+		}
+
+		batch = append(batch, delegatorBatch{delAddr, delegations})
+		remaining -= max(uint64(len(delegations)), 1)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, b := range batch {
+		if err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
+			return k.autoCompoundDelegations(ctx, b.delAddr, b.delegations)
+		}); err != nil {
+			// purposely ignoring the error here not to fail the whole batch if a delegator fails
+			k.Logger.Error("failed to auto-compound rewards", "delegator", b.delAddr.String(), "error", err)
+		}
+	}
+
+	if !hasNext {
+		return k.AutoCompoundCursor.Remove(ctx)
+	}
+
+	return k.AutoCompoundCursor.Set(ctx, next)
+}
+
+// autoCompoundDelegations withdraws the rewards of the given delegations of a delegator
+// and delegates the rewards in the bond denom back to the validators they were earned
+// from. The rewards of delegators withdrawing to another address are not auto-compounded,
+// as the delegator does not receive them, which is reported by an event.
+func (k Keeper) autoCompoundDelegations(ctx context.Context, delAddr sdk.AccAddress, delegations []stakingtypes.Delegation) error {
+	if len(delegations) == 0 {
+		return nil
+	}
+
+	withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	if err != nil {
+		return err
+	}
+
+	if !withdrawAddr.Equals(delAddr) {
+		withdrawAddrStr, err := k.authKeeper.AddressCodec().BytesToString(withdrawAddr)
+		if err != nil {
+			return err
+		}
+
+		return k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeAutoCompoundSkipped,
+			event.NewAttribute(types.AttributeKeyDelegator, delegations[0].DelegatorAddress),
+			event.NewAttribute(types.AttributeKeyWithdrawAddress, withdrawAddrStr),
+		)
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, delegation := range delegations {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(delegation.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return err
+		}
+
+		amount := rewards.AmountOf(bondDenom)
+		if !amount.IsPositive() {
+			continue
+		}
+
+		validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+
+		if _, err := k.stakingKeeper.Delegate(ctx, delAddr, amount, stakingtypes.Unbonded, validator, true); err != nil {
+			return err
+		}
+
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeAutoCompound,
+			event.NewAttribute(types.AttributeKeyDelegator, delegation.DelegatorAddress),
+			event.NewAttribute(types.AttributeKeyValidator, delegation.ValidatorAddress),
+			event.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/distribution"
+	"cosmossdk.io/x/distribution/keeper"
+	distrtestutil "cosmossdk.io/x/distribution/testutil"
+	disttypes "cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestAutoCompound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, distribution.AppModule{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), coretesting.NewNopLogger())
+
+	authorityAddr, err := accountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress("gov"))
+	require.NoError(t, err)
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		env,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		testCometService,
+		"fee_collector",
+		authorityAddr,
+	)
+
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+	params := disttypes.DefaultParams()
+	params.AutoCompoundBatchSize = 2
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	// create validator with 50% commission
+	valAddr := sdk.ValAddress(valConsAddr0)
+	addr := sdk.AccAddress(valAddr)
+	operatorAddr, err := stakingKeeper.ValidatorAddressCodec().BytesToString(valConsPk0.Address())
+	require.NoError(t, err)
+	val, err := distrtestutil.CreateValidator(valConsPk0, operatorAddr, math.NewInt(100))
+	require.NoError(t, err)
+	val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+
+	addrStr, err := accountKeeper.AddressCodec().BytesToString(addr)
+	require.NoError(t, err)
+	del := stakingtypes.NewDelegation(addrStr, operatorAddr, val.DelegatorShares)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del, nil).AnyTimes()
+	require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr))
+
+	// next block
+	ctx = ctx.WithHeaderInfo(header.Info{Height: ctx.HeaderInfo().Height + 1})
+
+	// no fees are allocated by the begin blocker
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).AnyTimes()
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(sdk.NewCoins()).AnyTimes()
+
+	initial := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}))
+
+	// opt in the delegator and two other delegators, and opt out one of them
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+	others := simtestutil.CreateIncrementalAccounts(3)
+	for _, delAddr := range append([]sdk.AccAddress{addr}, others...) {
+		delAddrStr, err := accountKeeper.AddressCodec().BytesToString(delAddr)
+		require.NoError(t, err)
+		_, err = msgServer.SetAutoCompound(ctx, disttypes.NewMsgSetAutoCompound(delAddrStr, true))
+		require.NoError(t, err)
+	}
+	othersStr, err := accountKeeper.AddressCodec().BytesToString(others[2])
+	require.NoError(t, err)
+	_, err = msgServer.SetAutoCompound(ctx, disttypes.NewMsgSetAutoCompound(othersStr, false))
+	require.NoError(t, err)
+
+	queryServer := keeper.NewQuerier(distrKeeper)
+	res, err := queryServer.AutoCompoundDelegators(ctx, &disttypes.QueryAutoCompoundDelegatorsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Delegators, 3)
+	require.Contains(t, res.Delegators, addrStr)
+	require.NotContains(t, res.Delegators, othersStr)
+
+	// the first other delegator has two delegations, split over the batches when it doesn't
+	// come last, and withdraws its rewards to another address
+	others0Str, err := accountKeeper.AddressCodec().BytesToString(others[0])
+	require.NoError(t, err)
+	operatorAddr1, err := stakingKeeper.ValidatorAddressCodec().BytesToString(valConsPk1.Address())
+	require.NoError(t, err)
+	othersDels := []stakingtypes.Delegation{
+		stakingtypes.NewDelegation(others0Str, operatorAddr, math.LegacyOneDec()),
+		stakingtypes.NewDelegation(others0Str, operatorAddr1, math.LegacyOneDec()),
+	}
+	valBytes := func(d stakingtypes.Delegation) []byte {
+		bz, err := stakingKeeper.ValidatorAddressCodec().StringToBytes(d.ValidatorAddress)
+		require.NoError(t, err)
+		return bz
+	}
+	slices.SortFunc(othersDels, func(a, b stakingtypes.Delegation) int { return bytes.Compare(valBytes(a), valBytes(b)) })
+	require.NoError(t, distrKeeper.DelegatorsWithdrawAddress.Set(ctx, others[0], others[2]))
+
+	delegations := map[string][]stakingtypes.Delegation{addr.String(): {del}, others[0].String(): othersDels}
+	var retrieved int
+	stakingKeeper.EXPECT().GetDelegatorDelegationsFrom(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, delAddr sdk.AccAddress, startVal sdk.ValAddress, maxRetrieve uint64) ([]stakingtypes.Delegation, error) {
+			var res []stakingtypes.Delegation
+			for _, d := range delegations[delAddr.String()] {
+				if uint64(len(res)) == maxRetrieve {
+					break
+				}
+				if len(startVal) > 0 && bytes.Compare(valBytes(d), startVal) < 0 {
+					continue
+				}
+				res = append(res, d)
+			}
+			retrieved += len(res)
+			return res, nil
+		}).AnyTimes()
+
+	// the rewards are withdrawn and delegated back to the validator
+	expRewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2)))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, addr, expRewards).Return(nil)
+	stakingKeeper.EXPECT().GetValidator(gomock.Any(), valAddr).Return(val, nil)
+	stakingKeeper.EXPECT().Delegate(gomock.Any(), addr, initial.QuoRaw(2), stakingtypes.Unbonded, val, true).Return(math.LegacyOneDec(), nil)
+
+	// nothing happens before an auto-compounding epoch starts
+	require.NoError(t, distrKeeper.BeginBlocker(ctx))
+	hooks := distrKeeper.EpochHooks()
+	require.NoError(t, hooks.BeforeEpochStart(ctx, "week", 1))
+	has, err := distrKeeper.AutoCompoundCursor.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the delegations of the opted-in delegators are processed in batches of two
+	require.NoError(t, hooks.BeforeEpochStart(ctx, params.AutoCompoundEpochIdentifier, 1))
+	require.NoError(t, distrKeeper.BeginBlocker(ctx))
+	has, err = distrKeeper.AutoCompoundCursor.Has(ctx)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	require.NoError(t, distrKeeper.BeginBlocker(ctx))
+	has, err = distrKeeper.AutoCompoundCursor.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the delegations read are bounded by the batches, plus one to find the next batch start
+	require.LessOrEqual(t, retrieved, 2*(int(params.AutoCompoundBatchSize)+1))
+
+	var autoCompounded, skipped bool
+	for _, e := range ctx.EventManager().Events() {
+		switch e.Type {
+		case disttypes.EventTypeAutoCompound:
+			autoCompounded = true
+		case disttypes.EventTypeAutoCompoundSkipped:
+			skipped = true
+		}
+	}
+	require.True(t, autoCompounded)
+	require.True(t, skipped)
+}
@@ -113,6 +113,16 @@ func (k Keeper) InitGenesis(ctx context.Context, data types.GenesisState) error
 		}
 	}
 
+	for _, delegator := range data.AutoCompoundDelegators {
+		delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(delegator)
+		if err != nil {
+			return err
+		}
+		if err := k.AutoCompoundDelegators.Set(ctx, delegatorAddress); err != nil {
+			return err
+		}
+	}
+
 	moduleHoldings = moduleHoldings.Add(data.FeePool.DecimalPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
 
@@ -285,5 +295,21 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
-	return types.NewGenesisState(params, feePool, dwi, outstanding, acc, his, cur, dels, slashes), nil
+	autoCompoundDelegators := make([]string, 0)
+	err = k.AutoCompoundDelegators.Walk(ctx, nil, func(delAddr sdk.AccAddress) (stop bool, err error) {
+		addr, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+		if err != nil {
+			return true, err
+		}
+
+		autoCompoundDelegators = append(autoCompoundDelegators, addr)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genState := types.NewGenesisState(params, feePool, dwi, outstanding, acc, his, cur, dels, slashes)
+	genState.AutoCompoundDelegators = autoCompoundDelegators
+	return genState, nil
 }
@@ -369,6 +369,22 @@ func (k Querier) DelegatorWithdrawAddress(ctx context.Context, req *types.QueryD
 	return &types.QueryDelegatorWithdrawAddressResponse{WithdrawAddress: addr}, nil
 }
 
+// AutoCompoundDelegators queries the delegators opted in to auto-compounding
+func (k Querier) AutoCompoundDelegators(ctx context.Context, req *types.QueryAutoCompoundDelegatorsRequest) (*types.QueryAutoCompoundDelegatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	delegators, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.AutoCompoundDelegators, req.Pagination, func(delAddr sdk.AccAddress, _ collections.NoValue) (string, error) {
+		return k.authKeeper.AddressCodec().BytesToString(delAddr)
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAutoCompoundDelegatorsResponse{Delegators: delegators, Pagination: pageRes}, nil
+}
+
 // Deprecated: DO NOT USE
 // This method uses deprecated query request. Use CommunityPool from x/protocolpool module instead.
 // CommunityPool queries the community pool coins
@@ -52,6 +52,11 @@ type Keeper struct {
 	ValidatorHistoricalRewards collections.Map[collections.Pair[sdk.ValAddress, uint64], types.ValidatorHistoricalRewards]
 	// ValidatorSlashEvents key: valAddr+height+period | value: ValidatorSlashEvent
 	ValidatorSlashEvents collections.Map[collections.Triple[sdk.ValAddress, uint64, uint64], types.ValidatorSlashEvent]
+	// AutoCompoundDelegators key: delAddr | value: none used (index key for delegators opted in to auto-compounding)
+	AutoCompoundDelegators collections.KeySet[sdk.AccAddress]
+	// AutoCompoundCursor stores the next delegation, by delegator and validator, whose rewards are
+	// auto-compounded in the current epoch. An empty validator stands for the first delegation of the delegator.
+	AutoCompoundCursor collections.Item[collections.Pair[sdk.AccAddress, sdk.ValAddress]]
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 }
@@ -133,6 +138,18 @@ func NewKeeper(
 			collections.TripleKeyCodec(sdk.LengthPrefixedAddressKey(sdk.ValAddressKey), collections.Uint64Key, collections.Uint64Key), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 			codec.CollValue[types.ValidatorSlashEvent](cdc),
 		),
+		AutoCompoundDelegators: collections.NewKeySet(
+			sb,
+			types.AutoCompoundDelegatorsPrefix,
+			"auto_compound_delegators",
+			sdk.AccAddressKey,
+		),
+		AutoCompoundCursor: collections.NewItem(
+			sb,
+			types.AutoCompoundCursorKey,
+			"auto_compound_cursor",
+			collcodec.KeyToValueCodec(collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey)),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -209,6 +209,19 @@ func (k msgServer) DepositValidatorRewardsPool(ctx context.Context, msg *types.M
 	return &types.MsgDepositValidatorRewardsPoolResponse{}, nil
 }
 
+func (k msgServer) SetAutoCompound(ctx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if err := k.Keeper.SetAutoCompound(ctx, delegatorAddress, msg.Enabled); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
+
 func (k *Keeper) validateAuthority(authority string) error {
 	if _, err := k.authKeeper.AddressCodec().StringToBytes(authority); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
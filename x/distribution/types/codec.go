@@ -17,6 +17,7 @@ func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	legacy.RegisterAminoMsg(registrar, &MsgSetWithdrawAddress{}, "cosmos-sdk/MsgModifyWithdrawAddress")
 	legacy.RegisterAminoMsg(registrar, &MsgUpdateParams{}, "cosmos-sdk/distribution/MsgUpdateParams")
 	legacy.RegisterAminoMsg(registrar, &MsgDepositValidatorRewardsPool{}, "cosmos-sdk/distr/MsgDepositValRewards")
+	legacy.RegisterAminoMsg(registrar, &MsgSetAutoCompound{}, "cosmos-sdk/distr/MsgSetAutoCompound")
 
 	registrar.RegisterConcrete(Params{}, "cosmos-sdk/x/distribution/Params")
 }
@@ -29,6 +30,7 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgSetWithdrawAddress{},
 		&MsgUpdateParams{},
 		&MsgDepositValidatorRewardsPool{},
+		&MsgSetAutoCompound{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
@@ -2,14 +2,18 @@ package types
 
 // distribution module event types
 const (
-	EventTypeSetWithdrawAddress = "set_withdraw_address"
-	EventTypeRewards            = "rewards"
-	EventTypeCommission         = "commission"
-	EventTypeWithdrawRewards    = "withdraw_rewards"
-	EventTypeWithdrawCommission = "withdraw_commission"
-	EventTypeProposerReward     = "proposer_reward"
+	EventTypeSetWithdrawAddress  = "set_withdraw_address"
+	EventTypeRewards             = "rewards"
+	EventTypeCommission          = "commission"
+	EventTypeWithdrawRewards     = "withdraw_rewards"
+	EventTypeWithdrawCommission  = "withdraw_commission"
+	EventTypeProposerReward      = "proposer_reward"
+	EventTypeSetAutoCompound     = "set_auto_compound"
+	EventTypeAutoCompound        = "auto_compound"
+	EventTypeAutoCompoundSkipped = "auto_compound_skipped"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
 	AttributeKeyDelegator       = "delegator"
+	AttributeKeyEnabled         = "enabled"
 )
@@ -346,6 +346,8 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// auto_compound_delegators defines the delegators opted in to auto-compounding at genesis.
+	AutoCompoundDelegators []string `protobuf:"bytes,11,rep,name=auto_compound_delegators,json=autoCompoundDelegators,proto3" json:"auto_compound_delegators,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -397,65 +399,68 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 925 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0x8e, 0x93, 0x34, 0xcd, 0x4e, 0x8a, 0xd8, 0x9d, 0x6e, 0x17, 0xef, 0xb6, 0x75, 0x36, 0xa5,
-	0x87, 0x02, 0x5a, 0x87, 0x06, 0x04, 0x55, 0x11, 0xa0, 0x26, 0x6d, 0x81, 0x1e, 0xa0, 0x4a, 0x24,
-	0x10, 0x08, 0x29, 0x9a, 0xd8, 0x13, 0x67, 0xd4, 0xc4, 0x13, 0x79, 0x26, 0x0e, 0x20, 0x71, 0xe0,
-	0x54, 0xc4, 0x89, 0x13, 0xe2, 0x58, 0x71, 0xaa, 0x90, 0x90, 0x38, 0xf4, 0x47, 0x54, 0xe2, 0x52,
-	0x55, 0x1c, 0x38, 0x01, 0xca, 0x1e, 0x40, 0x1c, 0xf9, 0x05, 0xc8, 0x9e, 0xb1, 0x3d, 0x59, 0xbb,
-	0xd9, 0xec, 0xd2, 0x5c, 0x76, 0xe3, 0x99, 0xf7, 0xde, 0xf7, 0x7d, 0xef, 0x3d, 0xbf, 0x67, 0xf0,
-	0x82, 0x45, 0xd9, 0x88, 0xb2, 0xba, 0x4d, 0x18, 0xf7, 0x48, 0x6f, 0xc2, 0x09, 0x75, 0xeb, 0xfe,
-	0xe5, 0x1e, 0xe6, 0xe8, 0x72, 0xdd, 0xc1, 0x2e, 0x66, 0x84, 0x99, 0x63, 0x8f, 0x72, 0x0a, 0xcf,
-	0x0a, 0x53, 0x53, 0x35, 0x35, 0xa5, 0xe9, 0xce, 0xa6, 0x43, 0x1d, 0x1a, 0xda, 0xd5, 0x83, 0x5f,
-	0xc2, 0x65, 0xc7, 0x90, 0xd1, 0x7b, 0x88, 0xe1, 0x38, 0xaa, 0x45, 0x89, 0x2b, 0xef, 0xcd, 0x45,
-	0xe8, 0x73, 0x38, 0xc2, 0x7e, 0x5b, 0xd8, 0x77, 0x05, 0x90, 0xe4, 0x23, 0xae, 0x36, 0xd0, 0x88,
-	0xb8, 0xb4, 0x1e, 0xfe, 0x15, 0x47, 0x17, 0x7e, 0xd2, 0xc0, 0x99, 0xeb, 0x78, 0x88, 0x1d, 0xc4,
-	0xa9, 0xf7, 0x11, 0xe1, 0x03, 0xdb, 0x43, 0xd3, 0xf7, 0xdc, 0x3e, 0x85, 0x37, 0xc0, 0x86, 0x1d,
-	0x5d, 0x74, 0x91, 0x6d, 0x7b, 0x98, 0x31, 0x5d, 0xdb, 0xd5, 0x2e, 0xad, 0x35, 0xf5, 0xc7, 0x0f,
-	0xf6, 0x36, 0x65, 0xe4, 0x6b, 0xe2, 0xa6, 0xc3, 0x3d, 0xe2, 0x3a, 0xed, 0xf5, 0xd8, 0x45, 0x9e,
-	0xc3, 0x16, 0x58, 0x9f, 0xca, 0xb0, 0x71, 0x94, 0xfc, 0x21, 0x51, 0x9e, 0x8d, 0x3c, 0xe4, 0xf1,
-	0xd5, 0xf2, 0xd7, 0xf7, 0xaa, 0xb9, 0xbf, 0xef, 0x55, 0x73, 0x17, 0xee, 0xe6, 0x41, 0xed, 0x43,
-	0x34, 0x24, 0x76, 0x80, 0xf1, 0xc1, 0x84, 0x33, 0x8e, 0x5c, 0x3b, 0xf0, 0xc1, 0x53, 0xe4, 0xd9,
-	0xac, 0x8d, 0x2d, 0xea, 0xd9, 0xf0, 0x7d, 0xb0, 0xe1, 0x47, 0x46, 0x07, 0xb8, 0xd7, 0x1e, 0x3f,
-	0xd8, 0x3b, 0x2f, 0x51, 0xe3, 0x40, 0x07, 0x44, 0xf8, 0x07, 0xce, 0xe1, 0x5d, 0x0d, 0x9c, 0xa6,
-	0x09, 0x58, 0xd7, 0x13, 0x68, 0x7a, 0x7e, 0xb7, 0x70, 0xa9, 0xd2, 0x38, 0x27, 0x4b, 0x64, 0x06,
-	0x25, 0x8c, 0xaa, 0x6d, 0x5e, 0xc7, 0x56, 0x8b, 0x12, 0xb7, 0x79, 0xe5, 0xe1, 0xef, 0xd5, 0xdc,
-	0x8f, 0x7f, 0x54, 0x5f, 0x72, 0x08, 0x1f, 0x4c, 0x7a, 0xa6, 0x45, 0x47, 0xb2, 0x2a, 0xf2, 0xdf,
-	0x1e, 0xb3, 0xef, 0xd4, 0xf9, 0xe7, 0x63, 0xcc, 0x22, 0x1f, 0x76, 0xff, 0xaf, 0x9f, 0x5f, 0xd4,
-	0xda, 0x90, 0xa6, 0xf4, 0x29, 0x99, 0xf8, 0x47, 0x03, 0x17, 0x13, 0x01, 0x96, 0x35, 0x19, 0x4d,
-	0x86, 0x88, 0x63, 0xbb, 0x45, 0x47, 0x23, 0xc2, 0x18, 0xa1, 0xee, 0x8a, 0x92, 0x31, 0x00, 0x15,
-	0x94, 0xc0, 0x85, 0xc5, 0xac, 0x34, 0xde, 0x30, 0x17, 0x74, 0xbe, 0xb9, 0x98, 0x67, 0x73, 0x2d,
-	0x48, 0x91, 0xd0, 0xac, 0x86, 0x56, 0xc4, 0xfe, 0xab, 0x81, 0xdd, 0x38, 0xc8, 0xbb, 0x84, 0x71,
-	0xea, 0x11, 0x0b, 0x0d, 0x57, 0x5b, 0xf5, 0x2d, 0x50, 0x1a, 0x63, 0x8f, 0x50, 0xa1, 0xb1, 0xd8,
-	0x96, 0x4f, 0xf0, 0x53, 0x70, 0x32, 0x6a, 0x80, 0x42, 0x28, 0xfe, 0xf5, 0xe5, 0xc4, 0xa7, 0x78,
-	0xab, 0xc2, 0xa3, 0x90, 0x8a, 0xe8, 0x5f, 0x35, 0x70, 0x3e, 0x76, 0x6e, 0x4d, 0x3c, 0x0f, 0xbb,
-	0x7c, 0xb5, 0x8a, 0x3f, 0x4e, 0x94, 0x89, 0xb2, 0xbe, 0xba, 0x9c, 0xb2, 0x79, 0x72, 0x87, 0xc8,
-	0xfa, 0x21, 0x0f, 0xce, 0xc6, 0x23, 0xa7, 0xc3, 0x91, 0xc7, 0x89, 0xeb, 0x04, 0x23, 0x47, 0x8a,
-	0x7a, 0x4a, 0x83, 0x27, 0x33, 0x37, 0xf9, 0xe3, 0xe7, 0xa6, 0x07, 0x9e, 0x61, 0x92, 0x6c, 0x97,
-	0xb8, 0x7d, 0x2a, 0x6b, 0xdf, 0x58, 0x98, 0xa1, 0x4c, 0x9d, 0x6a, 0x7e, 0x4e, 0x31, 0xe5, 0x42,
-	0x49, 0xd2, 0x77, 0x79, 0xb0, 0x1d, 0x53, 0xeb, 0x0c, 0x11, 0x1b, 0xdc, 0xf0, 0xc3, 0x0c, 0xaf,
-	0xaa, 0xd3, 0x07, 0x98, 0x38, 0x03, 0x1e, 0x75, 0xba, 0x78, 0x52, 0xde, 0x80, 0xc2, 0xdc, 0x1b,
-	0x40, 0xc1, 0x99, 0x04, 0x9f, 0x05, 0xec, 0xba, 0x38, 0xa0, 0xa7, 0x17, 0xc3, 0x9c, 0xbc, 0xbc,
-	0x5c, 0xd7, 0x24, 0xb2, 0xd4, 0x8c, 0x9c, 0xf6, 0xd3, 0xf7, 0x4a, 0x62, 0x7e, 0x29, 0x83, 0x53,
-	0xef, 0x88, 0x9d, 0xdb, 0xe1, 0x88, 0x63, 0x78, 0x13, 0x94, 0xc6, 0xc8, 0x43, 0x23, 0x91, 0x80,
-	0x4a, 0xe3, 0xf9, 0x85, 0xe0, 0xb7, 0x43, 0x53, 0x15, 0x4f, 0x7a, 0xc3, 0x5b, 0xa0, 0xdc, 0xc7,
-	0xb8, 0x3b, 0xa6, 0x74, 0x28, 0x9b, 0xff, 0xe2, 0xc2, 0x48, 0x37, 0x31, 0xbe, 0x4d, 0xe9, 0x70,
-	0xae, 0xd9, 0xfb, 0xe2, 0x0c, 0x4e, 0x81, 0x9e, 0xb4, 0x70, 0xbc, 0xfe, 0x82, 0xae, 0x09, 0x46,
-	0x46, 0x61, 0xf9, 0xb6, 0x51, 0x37, 0xb2, 0x8a, 0xb4, 0x65, 0x67, 0x59, 0x30, 0xf8, 0x45, 0xf6,
-	0x9e, 0x3a, 0x11, 0x62, 0xbe, 0xb5, 0x5c, 0x59, 0x9e, 0xb4, 0x55, 0x55, 0xfc, 0x8c, 0xd5, 0x04,
-	0xbf, 0xd7, 0x40, 0x4d, 0xe9, 0xca, 0x64, 0x8e, 0x77, 0xad, 0x78, 0xd4, 0x33, 0xbd, 0x14, 0x52,
-	0xb9, 0xf6, 0x3f, 0xd6, 0x45, 0x9a, 0x4d, 0xd5, 0x5f, 0xe8, 0xc0, 0xe0, 0x37, 0x1a, 0x38, 0x97,
-	0x50, 0x1b, 0xc4, 0x73, 0x38, 0x4e, 0xd0, 0xc9, 0x90, 0xd5, 0x9b, 0xc7, 0x9c, 0xe3, 0x69, 0x46,
-	0x3b, 0xfe, 0x13, 0x8d, 0xe1, 0x57, 0x1a, 0xd8, 0x4e, 0xc8, 0x58, 0x62, 0x74, 0xc6, 0x4c, 0xca,
-	0x21, 0x93, 0xab, 0xc7, 0x99, 0xbb, 0x69, 0x1a, 0xcf, 0xf9, 0xd9, 0x96, 0xf0, 0x4b, 0xb5, 0x41,
-	0xe7, 0xc6, 0x1a, 0xd3, 0xd7, 0x42, 0x06, 0x57, 0x8e, 0x3e, 0xd7, 0xd2, 0xf8, 0x49, 0x9b, 0xaa,
-	0x76, 0x0c, 0x4e, 0xc1, 0x56, 0xe6, 0xfc, 0x60, 0x3a, 0x08, 0xc1, 0x5f, 0x3b, 0xea, 0x00, 0x49,
-	0x43, 0x6f, 0x66, 0x8c, 0x11, 0x65, 0x0b, 0xdd, 0x2a, 0x96, 0x8b, 0xeb, 0x27, 0x9a, 0x6f, 0xdf,
-	0x9f, 0x19, 0xda, 0xc3, 0x99, 0xa1, 0x3d, 0x9a, 0x19, 0xda, 0x9f, 0x33, 0x43, 0xfb, 0x76, 0xdf,
-	0xc8, 0x3d, 0xda, 0x37, 0x72, 0xbf, 0xed, 0x1b, 0xb9, 0x4f, 0x6a, 0x82, 0x05, 0xb3, 0xef, 0x98,
-	0x84, 0xd6, 0x3f, 0x9b, 0xff, 0x04, 0x0f, 0xbf, 0xd8, 0x7a, 0xa5, 0xf0, 0x33, 0xfa, 0x95, 0xff,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x9c, 0xf4, 0xd9, 0x24, 0x0c, 0x00, 0x00,
+	// 970 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xda, 0x69, 0x1a, 0x8f, 0x8b, 0x9a, 0x4c, 0x53, 0xb3, 0x49, 0xdb, 0xb5, 0x53, 0x7a,
+	0x08, 0x54, 0x59, 0xb7, 0x29, 0x3f, 0xaa, 0x22, 0x40, 0xb5, 0xdb, 0x02, 0x3d, 0x40, 0xe5, 0x48,
+	0x20, 0x10, 0xd2, 0x6a, 0xbc, 0x3b, 0x59, 0x8f, 0x6a, 0xef, 0x58, 0x3b, 0xb3, 0x6b, 0x40, 0xe2,
+	0xc0, 0xa9, 0x88, 0x13, 0x27, 0xc4, 0xb1, 0xe2, 0x54, 0x21, 0x21, 0x21, 0x94, 0x3f, 0xa2, 0xc7,
+	0xaa, 0xe2, 0x80, 0x38, 0x00, 0x4a, 0x0e, 0x20, 0x8e, 0xfc, 0x05, 0x68, 0x77, 0x66, 0x77, 0xc7,
+	0xd9, 0x8d, 0xeb, 0x86, 0xe6, 0x92, 0xd8, 0x33, 0xef, 0xbd, 0xef, 0xfb, 0xde, 0x7b, 0x7e, 0x6f,
+	0xc0, 0x8b, 0x36, 0x65, 0x43, 0xca, 0x5a, 0x0e, 0x61, 0xdc, 0x27, 0xbd, 0x80, 0x13, 0xea, 0xb5,
+	0xc2, 0xcb, 0x3d, 0xcc, 0xd1, 0xe5, 0x96, 0x8b, 0x3d, 0xcc, 0x08, 0x33, 0x47, 0x3e, 0xe5, 0x14,
+	0x9e, 0x11, 0xa6, 0xa6, 0x6a, 0x6a, 0x4a, 0xd3, 0xd5, 0x65, 0x97, 0xba, 0x34, 0xb6, 0x6b, 0x45,
+	0x9f, 0x84, 0xcb, 0xaa, 0x21, 0xa3, 0xf7, 0x10, 0xc3, 0x69, 0x54, 0x9b, 0x12, 0x4f, 0xde, 0x9b,
+	0xd3, 0xd0, 0x27, 0x70, 0x84, 0xfd, 0x8a, 0xb0, 0xb7, 0x04, 0x90, 0xe4, 0x23, 0xae, 0x96, 0xd0,
+	0x90, 0x78, 0xb4, 0x15, 0xff, 0x15, 0x47, 0xe7, 0x7f, 0xd4, 0xc0, 0xe9, 0x1b, 0x78, 0x80, 0x5d,
+	0xc4, 0xa9, 0xff, 0x21, 0xe1, 0x7d, 0xc7, 0x47, 0xe3, 0x77, 0xbd, 0x6d, 0x0a, 0x6f, 0x82, 0x25,
+	0x27, 0xb9, 0xb0, 0x90, 0xe3, 0xf8, 0x98, 0x31, 0x5d, 0x6b, 0x6a, 0xeb, 0xd5, 0xb6, 0xfe, 0x78,
+	0x67, 0x63, 0x59, 0x46, 0xbe, 0x2e, 0x6e, 0xb6, 0xb8, 0x4f, 0x3c, 0xb7, 0xbb, 0x98, 0xba, 0xc8,
+	0x73, 0xd8, 0x01, 0x8b, 0x63, 0x19, 0x36, 0x8d, 0x52, 0x7e, 0x42, 0x94, 0x93, 0x89, 0x87, 0x3c,
+	0xbe, 0xb6, 0xf0, 0xd5, 0xfd, 0x46, 0xe9, 0xef, 0xfb, 0x8d, 0xd2, 0xf9, 0x7b, 0x65, 0xb0, 0xf6,
+	0x01, 0x1a, 0x10, 0x27, 0xc2, 0x78, 0x3f, 0xe0, 0x8c, 0x23, 0xcf, 0x89, 0x7c, 0xf0, 0x18, 0xf9,
+	0x0e, 0xeb, 0x62, 0x9b, 0xfa, 0x0e, 0x7c, 0x0f, 0x2c, 0x85, 0x89, 0xd1, 0x3e, 0xee, 0x6b, 0x8f,
+	0x77, 0x36, 0xce, 0x49, 0xd4, 0x34, 0xd0, 0x3e, 0x11, 0xe1, 0xbe, 0x73, 0x78, 0x4f, 0x03, 0xa7,
+	0x68, 0x06, 0x66, 0xf9, 0x02, 0x4d, 0x2f, 0x37, 0x2b, 0xeb, 0xb5, 0xcd, 0xb3, 0xb2, 0x44, 0x66,
+	0x54, 0xc2, 0xa4, 0xda, 0xe6, 0x0d, 0x6c, 0x77, 0x28, 0xf1, 0xda, 0x57, 0x1f, 0xfe, 0xde, 0x28,
+	0xfd, 0xf0, 0x47, 0xe3, 0xa2, 0x4b, 0x78, 0x3f, 0xe8, 0x99, 0x36, 0x1d, 0xca, 0xaa, 0xc8, 0x7f,
+	0x1b, 0xcc, 0xb9, 0xdb, 0xe2, 0x9f, 0x8d, 0x30, 0x4b, 0x7c, 0xd8, 0x83, 0xbf, 0x7e, 0x7a, 0x49,
+	0xeb, 0x42, 0x9a, 0xd3, 0xa7, 0x64, 0xe2, 0x1f, 0x0d, 0x5c, 0xc8, 0x04, 0xd8, 0x76, 0x30, 0x0c,
+	0x06, 0x88, 0x63, 0xa7, 0x43, 0x87, 0x43, 0xc2, 0x18, 0xa1, 0xde, 0x11, 0x25, 0xa3, 0x0f, 0x6a,
+	0x28, 0x83, 0x8b, 0x8b, 0x59, 0xdb, 0x7c, 0xdd, 0x9c, 0xd2, 0xf9, 0xe6, 0x74, 0x9e, 0xed, 0x6a,
+	0x94, 0x22, 0xa1, 0x59, 0x0d, 0xad, 0x88, 0xfd, 0x57, 0x03, 0xcd, 0x34, 0xc8, 0x3b, 0x84, 0x71,
+	0xea, 0x13, 0x1b, 0x0d, 0x8e, 0xb6, 0xea, 0x75, 0x30, 0x3f, 0xc2, 0x3e, 0xa1, 0x42, 0xe3, 0x5c,
+	0x57, 0x7e, 0x83, 0x9f, 0x80, 0xe3, 0x49, 0x03, 0x54, 0x62, 0xf1, 0xaf, 0xcd, 0x26, 0x3e, 0xc7,
+	0x5b, 0x15, 0x9e, 0x84, 0x54, 0x44, 0xff, 0xa2, 0x81, 0x73, 0xa9, 0x73, 0x27, 0xf0, 0x7d, 0xec,
+	0xf1, 0xa3, 0x55, 0xfc, 0x51, 0xa6, 0x4c, 0x94, 0xf5, 0xe5, 0xd9, 0x94, 0x4d, 0x92, 0x7b, 0x82,
+	0xac, 0xef, 0xcb, 0xe0, 0x4c, 0x3a, 0x72, 0xb6, 0x38, 0xf2, 0x39, 0xf1, 0xdc, 0x68, 0xe4, 0x48,
+	0x51, 0xcf, 0x68, 0xf0, 0x14, 0xe6, 0xa6, 0x7c, 0xf8, 0xdc, 0xf4, 0xc0, 0x73, 0x4c, 0x92, 0xb5,
+	0x88, 0xb7, 0x4d, 0x65, 0xed, 0x37, 0xa7, 0x66, 0xa8, 0x50, 0xa7, 0x9a, 0x9f, 0x13, 0x4c, 0xb9,
+	0x50, 0x92, 0xf4, 0x6d, 0x19, 0xac, 0xa4, 0xd4, 0xb6, 0x06, 0x88, 0xf5, 0x6f, 0x86, 0x71, 0x86,
+	0x8f, 0xaa, 0xd3, 0xfb, 0x98, 0xb8, 0x7d, 0x9e, 0x74, 0xba, 0xf8, 0xa6, 0xfc, 0x02, 0x2a, 0x13,
+	0xbf, 0x00, 0x0a, 0x4e, 0x67, 0xf8, 0x2c, 0x62, 0x67, 0xe1, 0x88, 0x9e, 0x3e, 0x17, 0xe7, 0xe4,
+	0xd2, 0x6c, 0x5d, 0x93, 0xc9, 0x52, 0x33, 0x72, 0x2a, 0xcc, 0xdf, 0x2b, 0x89, 0xf9, 0xb9, 0x0a,
+	0x4e, 0xbc, 0x2d, 0x76, 0xee, 0x16, 0x47, 0x1c, 0xc3, 0x5b, 0x60, 0x7e, 0x84, 0x7c, 0x34, 0x14,
+	0x09, 0xa8, 0x6d, 0xbe, 0x30, 0x15, 0xfc, 0x4e, 0x6c, 0xaa, 0xe2, 0x49, 0x6f, 0x78, 0x1b, 0x2c,
+	0x6c, 0x63, 0x6c, 0x8d, 0x28, 0x1d, 0xc8, 0xe6, 0xbf, 0x30, 0x35, 0xd2, 0x2d, 0x8c, 0xef, 0x50,
+	0x3a, 0x98, 0x68, 0xf6, 0x6d, 0x71, 0x06, 0xc7, 0x40, 0xcf, 0x5a, 0x38, 0x5d, 0x7f, 0x51, 0xd7,
+	0x44, 0x23, 0xa3, 0x32, 0x7b, 0xdb, 0xa8, 0x1b, 0x59, 0x45, 0xaa, 0x3b, 0x45, 0x16, 0x0c, 0x7e,
+	0x5e, 0xbc, 0xa7, 0x8e, 0xc5, 0x98, 0x6f, 0xce, 0x56, 0x96, 0x83, 0xb6, 0xaa, 0x8a, 0x5f, 0xb0,
+	0x9a, 0xe0, 0x77, 0x1a, 0x58, 0x53, 0xba, 0x32, 0x9b, 0xe3, 0x96, 0x9d, 0x8e, 0x7a, 0xa6, 0xcf,
+	0xc7, 0x54, 0xae, 0xff, 0x8f, 0x75, 0x91, 0x67, 0xd3, 0x08, 0xa7, 0x3a, 0x30, 0xf8, 0xb5, 0x06,
+	0xce, 0x66, 0xd4, 0xfa, 0xe9, 0x1c, 0x4e, 0x13, 0x74, 0x3c, 0x66, 0xf5, 0xc6, 0x21, 0xe7, 0x78,
+	0x9e, 0xd1, 0x6a, 0x78, 0xa0, 0x31, 0xfc, 0x52, 0x03, 0x2b, 0x19, 0x19, 0x5b, 0x8c, 0xce, 0x94,
+	0xc9, 0x42, 0xcc, 0xe4, 0xda, 0x61, 0xe6, 0x6e, 0x9e, 0xc6, 0xf3, 0x61, 0xb1, 0x25, 0xfc, 0x42,
+	0x6d, 0xd0, 0x89, 0xb1, 0xc6, 0xf4, 0x6a, 0xcc, 0xe0, 0xea, 0xd3, 0xcf, 0xb5, 0x3c, 0x7e, 0xdd,
+	0x29, 0xb2, 0x63, 0x70, 0x0c, 0xea, 0x85, 0xf3, 0x83, 0xe9, 0x20, 0x06, 0x7f, 0xf5, 0x69, 0x07,
+	0x48, 0x1e, 0x7a, 0xb9, 0x60, 0x8c, 0x30, 0x88, 0x81, 0x8e, 0x02, 0x4e, 0xa3, 0x8e, 0x1c, 0xd1,
+	0xc0, 0x73, 0xac, 0x94, 0x20, 0xd3, 0x6b, 0xcd, 0xca, 0x7a, 0xb5, 0x7d, 0xf1, 0xa0, 0x15, 0xf3,
+	0xdb, 0xce, 0xc6, 0xc9, 0xec, 0xc1, 0xd6, 0xbc, 0x64, 0xbe, 0x72, 0xa5, 0x5b, 0x8f, 0x82, 0x75,
+	0x64, 0xac, 0x34, 0x27, 0xca, 0xb2, 0xbb, 0x3d, 0xb7, 0x30, 0xb7, 0x78, 0xac, 0xfd, 0xd6, 0x83,
+	0x5d, 0x43, 0x7b, 0xb8, 0x6b, 0x68, 0x8f, 0x76, 0x0d, 0xed, 0xcf, 0x5d, 0x43, 0xfb, 0x66, 0xcf,
+	0x28, 0x3d, 0xda, 0x33, 0x4a, 0xbf, 0xee, 0x19, 0xa5, 0x8f, 0xd7, 0x44, 0x58, 0xe6, 0xdc, 0x35,
+	0x09, 0x6d, 0x7d, 0x3a, 0xf9, 0xd2, 0x8f, 0x1f, 0x86, 0xbd, 0xf9, 0xf8, 0xb5, 0x7e, 0xe5, 0xbf,
+	0x01, 0x00, 0x9d, 0xf5, 0xda, 0xeb, 0x8b, 0x0c, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -781,6 +786,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoCompoundDelegators) > 0 {
+		for iNdEx := len(m.AutoCompoundDelegators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoCompoundDelegators[iNdEx])
+			copy(dAtA[i:], m.AutoCompoundDelegators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoCompoundDelegators[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1089,6 +1103,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoCompoundDelegators) > 0 {
+		for _, s := range m.AutoCompoundDelegators {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2325,6 +2345,38 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundDelegators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundDelegators = append(m.AutoCompoundDelegators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -53,16 +53,22 @@ const (
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height><period>: ValidatorSlashEvent
 //
 // - 0x09: Params
+//
+// - 0x0A<accAddr_Bytes>: AutoCompoundDelegators
+//
+// - 0x0B: AutoCompoundCursor
 var (
-	FeePoolKey                           = collections.NewPrefix(0) // key for global distribution state
-	ValidatorOutstandingRewardsPrefix    = collections.NewPrefix(2) // key for outstanding rewards
-	DelegatorWithdrawAddrPrefix          = collections.NewPrefix(3) // key for delegator withdraw address
-	DelegatorStartingInfoPrefix          = collections.NewPrefix(4) // key for delegator starting info
-	ValidatorHistoricalRewardsPrefix     = collections.NewPrefix(5) // key for historical validators rewards / stake
-	ValidatorCurrentRewardsPrefix        = collections.NewPrefix(6) // key for current validator rewards
-	ValidatorAccumulatedCommissionPrefix = collections.NewPrefix(7) // key for accumulated validator commission
-	ValidatorSlashEventPrefix            = collections.NewPrefix(8) // key for validator slash fraction
-	ParamsKey                            = collections.NewPrefix(9) // key for distribution module params
+	FeePoolKey                           = collections.NewPrefix(0)  // key for global distribution state
+	ValidatorOutstandingRewardsPrefix    = collections.NewPrefix(2)  // key for outstanding rewards
+	DelegatorWithdrawAddrPrefix          = collections.NewPrefix(3)  // key for delegator withdraw address
+	DelegatorStartingInfoPrefix          = collections.NewPrefix(4)  // key for delegator starting info
+	ValidatorHistoricalRewardsPrefix     = collections.NewPrefix(5)  // key for historical validators rewards / stake
+	ValidatorCurrentRewardsPrefix        = collections.NewPrefix(6)  // key for current validator rewards
+	ValidatorAccumulatedCommissionPrefix = collections.NewPrefix(7)  // key for accumulated validator commission
+	ValidatorSlashEventPrefix            = collections.NewPrefix(8)  // key for validator slash fraction
+	ParamsKey                            = collections.NewPrefix(9)  // key for distribution module params
+	AutoCompoundDelegatorsPrefix         = collections.NewPrefix(10) // key for delegators opted in to auto-compounding
+	AutoCompoundCursorKey                = collections.NewPrefix(11) // key for the next delegation auto-compounded in the current epoch
 )
 
 // Reserved prefixes
@@ -13,6 +13,7 @@ var (
 	_ sdk.Msg = (*MsgCommunityPoolSpend)(nil)
 	_ sdk.Msg = (*MsgFundCommunityPool)(nil)
 	_ sdk.Msg = (*MsgDepositValidatorRewardsPool)(nil)
+	_ sdk.Msg = (*MsgSetAutoCompound)(nil)
 )
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr string) *MsgSetWithdrawAddress {
@@ -53,3 +54,12 @@ func NewMsgDepositValidatorRewardsPool(depositor, valAddr string, amount sdk.Coi
 		ValidatorAddress: valAddr,
 	}
 }
+
+// NewMsgSetAutoCompound returns a new MsgSetAutoCompound opting a delegator in
+// or out of auto-compounding.
+func NewMsgSetAutoCompound(delAddr string, enabled bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		DelegatorAddress: delAddr,
+		Enabled:          enabled,
+	}
+}
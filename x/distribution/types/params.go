@@ -7,19 +7,41 @@ import (
 	"cosmossdk.io/math"
 )
 
+// Default auto-compounding parameter values
+const (
+	DefaultAutoCompoundEpochIdentifier        = "day"
+	DefaultAutoCompoundBatchSize       uint64 = 100
+)
+
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        math.LegacyNewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  math.LegacyZeroDec(),            // deprecated
-		BonusProposerReward: math.LegacyZeroDec(),            // deprecated
-		WithdrawAddrEnabled: true,
+		CommunityTax:                math.LegacyNewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:          math.LegacyZeroDec(),            // deprecated
+		BonusProposerReward:         math.LegacyZeroDec(),            // deprecated
+		WithdrawAddrEnabled:         true,
+		AutoCompoundEpochIdentifier: DefaultAutoCompoundEpochIdentifier,
+		AutoCompoundBatchSize:       DefaultAutoCompoundBatchSize,
 	}
 }
 
 // ValidateBasic performs basic validation on distribution parameters.
 func (p Params) ValidateBasic() error {
-	return validateCommunityTax(p.CommunityTax)
+	if err := validateCommunityTax(p.CommunityTax); err != nil {
+		return err
+	}
+
+	return validateAutoCompound(p.AutoCompoundEpochIdentifier, p.AutoCompoundBatchSize)
+}
+
+// validateAutoCompound validates the auto-compounding params. An empty epoch
+// identifier disables auto-compounding.
+func validateAutoCompound(epochIdentifier string, batchSize uint64) error {
+	if epochIdentifier != "" && batchSize == 0 {
+		return errors.New("auto compound batch size must be positive")
+	}
+
+	return nil
 }
 
 func validateCommunityTax(i interface{}) error {
@@ -960,6 +960,109 @@ func (m *QueryCommunityPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+// QueryAutoCompoundDelegatorsRequest is the request type for the
+// Query/AutoCompoundDelegators RPC method.
+type QueryAutoCompoundDelegatorsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAutoCompoundDelegatorsRequest) Reset()         { *m = QueryAutoCompoundDelegatorsRequest{} }
+func (m *QueryAutoCompoundDelegatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundDelegatorsRequest) ProtoMessage()    {}
+func (*QueryAutoCompoundDelegatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
+}
+func (m *QueryAutoCompoundDelegatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundDelegatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundDelegatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundDelegatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundDelegatorsRequest.Merge(m, src)
+}
+func (m *QueryAutoCompoundDelegatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundDelegatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundDelegatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundDelegatorsRequest proto.InternalMessageInfo
+
+func (m *QueryAutoCompoundDelegatorsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAutoCompoundDelegatorsResponse is the response type for the
+// Query/AutoCompoundDelegators RPC method.
+type QueryAutoCompoundDelegatorsResponse struct {
+	// delegators defines the delegators opted in to auto-compounding.
+	Delegators []string `protobuf:"bytes,1,rep,name=delegators,proto3" json:"delegators,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAutoCompoundDelegatorsResponse) Reset()         { *m = QueryAutoCompoundDelegatorsResponse{} }
+func (m *QueryAutoCompoundDelegatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundDelegatorsResponse) ProtoMessage()    {}
+func (*QueryAutoCompoundDelegatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
+}
+func (m *QueryAutoCompoundDelegatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundDelegatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundDelegatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundDelegatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundDelegatorsResponse.Merge(m, src)
+}
+func (m *QueryAutoCompoundDelegatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundDelegatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundDelegatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundDelegatorsResponse proto.InternalMessageInfo
+
+func (m *QueryAutoCompoundDelegatorsResponse) GetDelegators() []string {
+	if m != nil {
+		return m.Delegators
+	}
+	return nil
+}
+
+func (m *QueryAutoCompoundDelegatorsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.distribution.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.distribution.v1beta1.QueryParamsResponse")
@@ -981,6 +1084,8 @@ func init() {
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
+	proto.RegisterType((*QueryAutoCompoundDelegatorsRequest)(nil), "cosmos.distribution.v1beta1.QueryAutoCompoundDelegatorsRequest")
+	proto.RegisterType((*QueryAutoCompoundDelegatorsResponse)(nil), "cosmos.distribution.v1beta1.QueryAutoCompoundDelegatorsResponse")
 }
 
 func init() {
@@ -988,89 +1093,94 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1299 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6c, 0x1b, 0xc5,
-	0x17, 0xce, 0x38, 0x69, 0xfa, 0xcb, 0xeb, 0xaf, 0x24, 0x9e, 0x06, 0xe4, 0x6c, 0x53, 0x27, 0xdd,
-	0xd0, 0x26, 0x6a, 0x14, 0x6f, 0x9a, 0x8a, 0x52, 0x12, 0x2a, 0x88, 0x9d, 0x84, 0x56, 0x44, 0x69,
-	0xeb, 0x16, 0x22, 0x40, 0x95, 0xb5, 0xf6, 0x6e, 0x9c, 0xa5, 0xf6, 0x8e, 0xb3, 0xb3, 0x8e, 0x89,
-	0xaa, 0x5e, 0xca, 0xa5, 0xf4, 0x84, 0xe0, 0xc2, 0x0d, 0x8e, 0x88, 0x13, 0x87, 0xdc, 0xe1, 0x58,
-	0xc1, 0xa5, 0x0a, 0x12, 0x42, 0x1c, 0x00, 0x25, 0x48, 0x94, 0x03, 0x88, 0x1b, 0x57, 0xe4, 0x99,
-	0x59, 0x7b, 0xd7, 0x5e, 0xaf, 0xff, 0xc9, 0x97, 0xc4, 0x9a, 0x9d, 0xf7, 0x7d, 0xef, 0x7b, 0xef,
-	0xcd, 0xec, 0x67, 0xc3, 0x74, 0x86, 0xd0, 0x3c, 0xa1, 0x8a, 0x66, 0x50, 0xdb, 0x32, 0xd2, 0x45,
-	0xdb, 0x20, 0xa6, 0xb2, 0x7b, 0x31, 0xad, 0xdb, 0xea, 0x45, 0x65, 0xa7, 0xa8, 0x5b, 0x7b, 0xb1,
-	0x82, 0x45, 0x6c, 0x82, 0x4f, 0xf3, 0x8d, 0x31, 0xf7, 0xc6, 0x98, 0xd8, 0x28, 0x5d, 0x10, 0x28,
-	0x69, 0x95, 0xea, 0x3c, 0xaa, 0x82, 0x51, 0x50, 0xb3, 0x86, 0xa9, 0xb2, 0xdd, 0x0c, 0x48, 0x1a,
-	0xcd, 0x92, 0x2c, 0x61, 0x1f, 0x95, 0xf2, 0x27, 0xb1, 0x3a, 0x9e, 0x25, 0x24, 0x9b, 0xd3, 0x15,
-	0xb5, 0x60, 0x28, 0xaa, 0x69, 0x12, 0x9b, 0x85, 0x50, 0xf1, 0x34, 0xea, 0xc6, 0x77, 0x90, 0x33,
-	0xc4, 0x70, 0x30, 0x63, 0x41, 0x2a, 0x3c, 0x19, 0xf3, 0xfd, 0x63, 0x7c, 0x7f, 0x8a, 0xa7, 0x21,
-	0x94, 0xf1, 0x47, 0x61, 0x35, 0x6f, 0x98, 0x44, 0x61, 0x7f, 0xf9, 0x92, 0x3c, 0x0a, 0xf8, 0x56,
-	0x59, 0xd3, 0x4d, 0xd5, 0x52, 0xf3, 0x34, 0xa9, 0xef, 0x14, 0x75, 0x6a, 0xcb, 0x77, 0xe1, 0x94,
-	0x67, 0x95, 0x16, 0x88, 0x49, 0x75, 0xbc, 0x06, 0x83, 0x05, 0xb6, 0x12, 0x41, 0x93, 0x68, 0xe6,
-	0xc4, 0xc2, 0x54, 0x2c, 0xa0, 0x70, 0x31, 0x1e, 0x1c, 0x1f, 0x7a, 0xf2, 0xcb, 0x44, 0xdf, 0x97,
-	0x7f, 0x7c, 0x7d, 0x01, 0x25, 0x45, 0xb4, 0x5c, 0x82, 0x73, 0x0c, 0xfe, 0x6d, 0x35, 0x67, 0x68,
-	0xaa, 0x4d, 0xac, 0x15, 0x57, 0xfc, 0x75, 0x73, 0x8b, 0x88, 0x3c, 0xf0, 0x06, 0x84, 0x77, 0x9d,
-	0x3d, 0x29, 0x55, 0xd3, 0x2c, 0x9d, 0x72, 0xee, 0xa1, 0xf8, 0xd9, 0x83, 0xfd, 0xb9, 0x33, 0x82,
-	0xbe, 0x82, 0xb3, 0xcc, 0xb7, 0xdc, 0xb6, 0x2d, 0xc3, 0xcc, 0x26, 0x47, 0x76, 0x6b, 0xd6, 0xe5,
-	0xbf, 0x43, 0x70, 0xbe, 0x19, 0xb3, 0xd0, 0xba, 0x0e, 0x23, 0xa4, 0xa0, 0x5b, 0x9d, 0x31, 0x0f,
-	0x3b, 0xa1, 0x62, 0x19, 0x3f, 0x44, 0x10, 0xa6, 0x7a, 0x6e, 0x2b, 0x95, 0x26, 0xa6, 0x96, 0xb2,
-	0xf4, 0x92, 0x6a, 0x69, 0x34, 0x12, 0x9a, 0xec, 0x9f, 0x39, 0xb1, 0x30, 0xee, 0x54, 0xb1, 0x3c,
-	0x01, 0x95, 0xea, 0xad, 0xe8, 0x99, 0x04, 0x31, 0xcc, 0xf8, 0x95, 0x72, 0xf9, 0xbe, 0xfa, 0x75,
-	0x62, 0x36, 0x6b, 0xd8, 0xdb, 0xc5, 0x74, 0x2c, 0x43, 0xf2, 0xa2, 0xa9, 0xe2, 0xdf, 0x1c, 0xd5,
-	0xee, 0x29, 0xf6, 0x5e, 0x41, 0xa7, 0x4e, 0x0c, 0xe5, 0xd5, 0x1e, 0x2e, 0x13, 0xc6, 0x89, 0xa9,
-	0x25, 0x39, 0x1d, 0xde, 0x01, 0xc8, 0x90, 0x7c, 0xde, 0xa0, 0xd4, 0x20, 0x66, 0xa4, 0xbf, 0x05,
-	0xf2, 0x4b, 0x1d, 0x90, 0x27, 0x5d, 0x24, 0xf2, 0x1e, 0x4c, 0x7b, 0xeb, 0x7d, 0xa3, 0x68, 0x53,
-	0x5b, 0x35, 0xb5, 0x72, 0x95, 0x78, 0x5a, 0xbd, 0xea, 0xf5, 0x47, 0x08, 0x66, 0x9a, 0x73, 0x8b,
-	0x6e, 0xdf, 0x85, 0xe3, 0x4e, 0x53, 0xf8, 0x68, 0x5f, 0x09, 0x1c, 0xed, 0x00, 0x48, 0xf7, 0xbc,
-	0x3b, 0x98, 0xf2, 0x0e, 0x4c, 0x78, 0x53, 0x49, 0x54, 0x4a, 0xd4, 0x2b, 0xf9, 0x8f, 0x11, 0x4c,
-	0x36, 0xe6, 0x14, 0xb2, 0xb7, 0x3c, 0x13, 0xc1, 0x95, 0x2f, 0xb5, 0xa6, 0x7c, 0x39, 0x93, 0x29,
-	0xe6, 0x8b, 0x39, 0xd5, 0xd6, 0xb5, 0x2a, 0xb0, 0x5b, 0xbc, 0x7b, 0x0c, 0x1e, 0x87, 0x60, 0xdc,
-	0x9b, 0xcc, 0xed, 0x9c, 0x4a, 0xb7, 0xf5, 0x5e, 0x35, 0x1f, 0x4f, 0xc3, 0x30, 0xb5, 0x55, 0xcb,
-	0x36, 0xcc, 0x6c, 0x6a, 0x5b, 0x37, 0xb2, 0xdb, 0x76, 0x24, 0x34, 0x89, 0x66, 0x06, 0x92, 0xcf,
-	0x39, 0xcb, 0xd7, 0xd8, 0x2a, 0x9e, 0x82, 0x93, 0x3a, 0x6b, 0x9f, 0xb3, 0xad, 0x9f, 0x6d, 0xfb,
-	0x3f, 0x5f, 0x14, 0x9b, 0xd6, 0x00, 0xaa, 0x57, 0x7d, 0x64, 0x80, 0x95, 0xe9, 0xbc, 0xe7, 0xe0,
-	0xf0, 0xb7, 0x49, 0xf5, 0xe6, 0xcb, 0xea, 0x42, 0x59, 0xd2, 0x15, 0xb9, 0x38, 0xf0, 0xe8, 0x8b,
-	0x89, 0x3e, 0xf9, 0x1b, 0x04, 0x67, 0x1a, 0x14, 0x43, 0xb4, 0xe5, 0x2d, 0x38, 0x4e, 0xf9, 0x52,
-	0x04, 0xb1, 0x53, 0x3a, 0xdf, 0x5a, 0x4f, 0x18, 0xce, 0xea, 0xae, 0x6e, 0xda, 0x9e, 0x29, 0x14,
-	0x58, 0xf8, 0x0d, 0x8f, 0x8c, 0x10, 0x93, 0x31, 0xdd, 0x54, 0x06, 0xcf, 0xc9, 0xad, 0x43, 0xfe,
-	0xd6, 0x51, 0xb0, 0xa2, 0xe7, 0xf4, 0x2c, 0x5b, 0xab, 0x39, 0xcc, 0xab, 0x10, 0xd6, 0xf8, 0xb3,
-	0xba, 0x7e, 0x46, 0x0e, 0xf6, 0xe7, 0x46, 0x05, 0x69, 0x4d, 0x1b, 0x2b, 0x21, 0x4e, 0x1b, 0x7d,
-	0xc7, 0x22, 0xd4, 0xf1, 0x58, 0x2c, 0xfe, 0xaf, 0xdc, 0x80, 0x67, 0xe5, 0x26, 0x7c, 0x82, 0x20,
-	0xda, 0x48, 0x82, 0xe8, 0x42, 0xc1, 0x7d, 0x27, 0xf4, 0xf2, 0xa2, 0xae, 0x5c, 0x13, 0x45, 0x90,
-	0x6b, 0x72, 0xba, 0x43, 0x6c, 0x35, 0xd7, 0x93, 0xda, 0xba, 0x6a, 0xf1, 0x0f, 0x82, 0xa9, 0x40,
-	0x5e, 0x51, 0x90, 0xf7, 0x6a, 0x0b, 0x72, 0x39, 0x70, 0x2c, 0xab, 0x68, 0x2b, 0x0e, 0x37, 0x47,
-	0xf4, 0xbb, 0x22, 0x71, 0x0e, 0x8e, 0xd9, 0x65, 0xd2, 0x1e, 0xbf, 0x14, 0x39, 0x89, 0x6c, 0x89,
-	0x0b, 0xb9, 0x92, 0x59, 0x65, 0x84, 0x7a, 0x57, 0xe6, 0x75, 0x71, 0x21, 0xfb, 0x72, 0x8a, 0x12,
-	0x47, 0x01, 0x2a, 0x43, 0xcb, 0xab, 0x3c, 0x94, 0x74, 0xad, 0xb8, 0xd0, 0x4a, 0xf0, 0xa2, 0x17,
-	0x6d, 0xd3, 0xb0, 0xb7, 0x35, 0x4b, 0x2d, 0x09, 0xe2, 0x9e, 0xc9, 0xd8, 0x15, 0xe6, 0xad, 0x31,
-	0xb1, 0xd0, 0x92, 0x80, 0x91, 0x92, 0x78, 0xd4, 0x32, 0xf1, 0x70, 0xc9, 0x0b, 0xe6, 0xe2, 0x5d,
-	0x80, 0x31, 0xc6, 0x5b, 0x7e, 0xdb, 0x14, 0x4d, 0xc3, 0xde, 0xbb, 0x49, 0x48, 0x4e, 0xa8, 0x5c,
-	0x7c, 0xfe, 0x60, 0x7f, 0x6e, 0xb8, 0xda, 0xfa, 0xc9, 0xf9, 0xd8, 0x4b, 0xf3, 0x11, 0x24, 0x7f,
-	0x8e, 0x40, 0xf2, 0x0b, 0x12, 0x19, 0xbe, 0x0f, 0x03, 0x05, 0x42, 0x72, 0x3d, 0x3e, 0xde, 0x8c,
-	0xa3, 0x41, 0x86, 0x0b, 0x3f, 0x87, 0xe1, 0x18, 0xcb, 0x10, 0x7f, 0x86, 0x60, 0x90, 0x5b, 0x66,
-	0xac, 0x04, 0x9e, 0xab, 0x7a, 0xbf, 0x2e, 0xcd, 0xb7, 0x1e, 0xc0, 0xa5, 0xcb, 0xb3, 0x0f, 0x7f,
-	0xf8, 0xfd, 0xd3, 0xd0, 0x39, 0x3c, 0xa5, 0x04, 0x7d, 0xbd, 0xe0, 0x7e, 0x1d, 0xff, 0x89, 0x60,
-	0xac, 0xa1, 0x63, 0xc6, 0xf1, 0xe6, 0xe4, 0xcd, 0x8c, 0xbe, 0x94, 0xe8, 0x0a, 0x43, 0x68, 0x4a,
-	0x30, 0x4d, 0x57, 0xf1, 0x52, 0xa0, 0xa6, 0xea, 0x69, 0x52, 0xee, 0xd7, 0xbd, 0x5c, 0x1e, 0xe0,
-	0x0f, 0x43, 0x70, 0x3a, 0xc0, 0xde, 0xe1, 0x95, 0x36, 0x32, 0x6d, 0x68, 0x76, 0xa5, 0xd5, 0x2e,
-	0x51, 0x84, 0xe2, 0x4d, 0xa6, 0xf8, 0x16, 0xbe, 0xd1, 0x85, 0x62, 0x85, 0x54, 0xf1, 0x9d, 0x6f,
-	0x26, 0xf8, 0x10, 0xc1, 0x29, 0x1f, 0xe3, 0x88, 0x5f, 0x6d, 0x23, 0xef, 0x3a, 0x8f, 0x2b, 0x5d,
-	0xed, 0x30, 0x5a, 0xa8, 0xdd, 0x60, 0x6a, 0xaf, 0xe1, 0xb5, 0x6e, 0xd4, 0x56, 0x5d, 0x29, 0xfe,
-	0x11, 0xc1, 0x48, 0xad, 0x07, 0xc3, 0xaf, 0xb4, 0x91, 0xa3, 0xd7, 0xc4, 0x4a, 0x8b, 0x9d, 0x84,
-	0x0a, 0x6d, 0x6f, 0x32, 0x6d, 0xab, 0x38, 0xd1, 0x8d, 0x36, 0xc7, 0xe8, 0xfd, 0x85, 0x20, 0x5c,
-	0xe7, 0x6b, 0x70, 0x0b, 0xe9, 0x35, 0xf2, 0x73, 0xd2, 0x52, 0x47, 0xb1, 0x42, 0x5b, 0x8a, 0x69,
-	0x7b, 0x07, 0x6f, 0x06, 0x6a, 0xab, 0xbc, 0x72, 0xa8, 0x72, 0xbf, 0xee, 0x8d, 0xf5, 0x40, 0x11,
-	0x93, 0xe9, 0x7b, 0x66, 0x9f, 0x21, 0x78, 0xc1, 0xdf, 0xbb, 0xe0, 0xd7, 0xda, 0x49, 0xdc, 0xc7,
-	0x6d, 0x49, 0xaf, 0x77, 0x0e, 0xd0, 0x56, 0x6b, 0x5b, 0x93, 0xcf, 0x0e, 0xa6, 0x8f, 0x81, 0x68,
-	0xe5, 0x60, 0x36, 0xf6, 0x3a, 0xad, 0x1c, 0xcc, 0x00, 0xd7, 0xd2, 0xe2, 0xc1, 0x6c, 0xa2, 0xb0,
-	0x3a, 0xdb, 0xf8, 0x5f, 0x04, 0x91, 0x46, 0xf6, 0x02, 0x2f, 0xb7, 0x91, 0xab, 0xbf, 0x27, 0x92,
-	0xe2, 0xdd, 0x40, 0x08, 0xcd, 0x77, 0x98, 0xe6, 0x0d, 0xbc, 0xde, 0x8d, 0xe6, 0x5a, 0x7f, 0x84,
-	0xbf, 0x47, 0x70, 0xd2, 0xe3, 0x55, 0xf0, 0xe5, 0xe6, 0xb9, 0xfa, 0x39, 0x22, 0xe9, 0xe5, 0xb6,
-	0xe3, 0x84, 0xb0, 0xeb, 0xdf, 0xd5, 0x1b, 0x15, 0xa6, 0x75, 0x0e, 0xcf, 0x06, 0x6a, 0xcd, 0x38,
-	0x70, 0xa9, 0xb2, 0xe1, 0x79, 0x14, 0x42, 0xf1, 0xa5, 0x27, 0x87, 0x51, 0xf4, 0xf4, 0x30, 0x8a,
-	0x7e, 0x3b, 0x8c, 0xa2, 0x8f, 0x8f, 0xa2, 0x7d, 0x4f, 0x8f, 0xa2, 0x7d, 0x3f, 0x1d, 0x45, 0xfb,
-	0xde, 0x3d, 0xcb, 0x81, 0xa8, 0x76, 0x2f, 0x66, 0x10, 0xe5, 0x03, 0x2f, 0x20, 0x33, 0x51, 0xe9,
-	0x41, 0xf6, 0x03, 0xe5, 0xa5, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x54, 0xf1, 0x6b, 0xc6,
-	0x15, 0x00, 0x00,
+	// 1387 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4d, 0x6c, 0x1b, 0x45,
+	0x14, 0xce, 0xb8, 0x69, 0x4b, 0x5f, 0x29, 0x49, 0x26, 0xa5, 0x72, 0xb7, 0xad, 0xe3, 0x6e, 0x68,
+	0x13, 0xb5, 0x8a, 0x37, 0x4d, 0xd4, 0x1f, 0x12, 0x2a, 0x1a, 0x3b, 0x29, 0xad, 0xa8, 0xfa, 0xe3,
+	0x16, 0x22, 0x40, 0x95, 0xb5, 0xf6, 0x6e, 0x9c, 0xa5, 0xf6, 0x8e, 0xb3, 0x3f, 0x31, 0x51, 0xd5,
+	0x03, 0xe5, 0x52, 0x7a, 0x42, 0x70, 0xe1, 0x06, 0x47, 0xc4, 0x89, 0x43, 0xae, 0x08, 0x8e, 0x55,
+	0xb9, 0x54, 0x41, 0x42, 0x9c, 0x00, 0x25, 0x48, 0x94, 0x03, 0x88, 0x1b, 0x57, 0xb4, 0x33, 0xb3,
+	0xeb, 0x5d, 0x7b, 0xbd, 0xfe, 0xc3, 0x97, 0xd6, 0x9a, 0x7d, 0xef, 0xfb, 0xde, 0xf7, 0xde, 0x9b,
+	0x99, 0x37, 0x81, 0x89, 0x02, 0x31, 0xcb, 0xc4, 0x94, 0x14, 0xcd, 0xb4, 0x0c, 0x2d, 0x6f, 0x5b,
+	0x1a, 0xd1, 0xa5, 0xf5, 0x33, 0x79, 0xd5, 0x92, 0xcf, 0x48, 0x6b, 0xb6, 0x6a, 0x6c, 0xa4, 0x2a,
+	0x06, 0xb1, 0x08, 0x3e, 0xc2, 0x0c, 0x53, 0x7e, 0xc3, 0x14, 0x37, 0x14, 0x4e, 0x71, 0x94, 0xbc,
+	0x6c, 0xaa, 0xcc, 0xcb, 0xc3, 0xa8, 0xc8, 0x45, 0x4d, 0x97, 0xa9, 0x35, 0x05, 0x12, 0x0e, 0x16,
+	0x49, 0x91, 0xd0, 0x9f, 0x92, 0xf3, 0x8b, 0xaf, 0x1e, 0x2d, 0x12, 0x52, 0x2c, 0xa9, 0x92, 0x5c,
+	0xd1, 0x24, 0x59, 0xd7, 0x89, 0x45, 0x5d, 0x4c, 0xfe, 0x35, 0xe1, 0xc7, 0x77, 0x91, 0x0b, 0x44,
+	0x73, 0x31, 0x53, 0x51, 0x2a, 0x02, 0x11, 0x33, 0xfb, 0xc3, 0xcc, 0x3e, 0xc7, 0xc2, 0xe0, 0xca,
+	0xd8, 0xa7, 0x11, 0xb9, 0xac, 0xe9, 0x44, 0xa2, 0xff, 0xb2, 0x25, 0xf1, 0x20, 0xe0, 0x5b, 0x8e,
+	0xa6, 0x9b, 0xb2, 0x21, 0x97, 0xcd, 0xac, 0xba, 0x66, 0xab, 0xa6, 0x25, 0xde, 0x85, 0xd1, 0xc0,
+	0xaa, 0x59, 0x21, 0xba, 0xa9, 0xe2, 0xcb, 0xb0, 0xa7, 0x42, 0x57, 0xe2, 0x28, 0x89, 0x26, 0xf7,
+	0xcf, 0x8c, 0xa7, 0x22, 0x12, 0x97, 0x62, 0xce, 0xe9, 0x7d, 0x4f, 0x7e, 0x19, 0x1b, 0xf8, 0xea,
+	0x8f, 0x6f, 0x4e, 0xa1, 0x2c, 0xf7, 0x16, 0xab, 0x70, 0x82, 0xc2, 0xbf, 0x2d, 0x97, 0x34, 0x45,
+	0xb6, 0x88, 0xb1, 0xe8, 0xf3, 0xbf, 0xaa, 0xaf, 0x10, 0x1e, 0x07, 0xbe, 0x0e, 0x23, 0xeb, 0xae,
+	0x4d, 0x4e, 0x56, 0x14, 0x43, 0x35, 0x19, 0xf7, 0xbe, 0xf4, 0xf1, 0xad, 0xcd, 0xa9, 0x63, 0x9c,
+	0xde, 0xc3, 0x59, 0x60, 0x26, 0xb7, 0x2d, 0x43, 0xd3, 0x8b, 0xd9, 0xe1, 0xf5, 0xba, 0x75, 0xf1,
+	0xef, 0x18, 0x9c, 0x6c, 0xc5, 0xcc, 0xb5, 0x5e, 0x83, 0x61, 0x52, 0x51, 0x8d, 0xee, 0x98, 0x87,
+	0x5c, 0x57, 0xbe, 0x8c, 0x1f, 0x22, 0x18, 0x31, 0xd5, 0xd2, 0x4a, 0x2e, 0x4f, 0x74, 0x25, 0x67,
+	0xa8, 0x55, 0xd9, 0x50, 0xcc, 0x78, 0x2c, 0xb9, 0x6b, 0x72, 0xff, 0xcc, 0x51, 0x37, 0x8b, 0x4e,
+	0x07, 0x78, 0xd9, 0x5b, 0x54, 0x0b, 0x19, 0xa2, 0xe9, 0xe9, 0x0b, 0x4e, 0xfa, 0xbe, 0xfe, 0x75,
+	0xec, 0x74, 0x51, 0xb3, 0x56, 0xed, 0x7c, 0xaa, 0x40, 0xca, 0xbc, 0xa8, 0xfc, 0xbf, 0x29, 0x53,
+	0xb9, 0x27, 0x59, 0x1b, 0x15, 0xd5, 0x74, 0x7d, 0x4c, 0x96, 0xed, 0x21, 0x87, 0x30, 0x4d, 0x74,
+	0x25, 0xcb, 0xe8, 0xf0, 0x1a, 0x40, 0x81, 0x94, 0xcb, 0x9a, 0x69, 0x6a, 0x44, 0x8f, 0xef, 0x6a,
+	0x83, 0x7c, 0xb6, 0x0b, 0xf2, 0xac, 0x8f, 0x44, 0xdc, 0x80, 0x89, 0x60, 0xbe, 0x6f, 0xd8, 0x96,
+	0x69, 0xc9, 0xba, 0xe2, 0x64, 0x89, 0x85, 0xd5, 0xaf, 0x5a, 0x7f, 0x8c, 0x60, 0xb2, 0x35, 0x37,
+	0xaf, 0xf6, 0x5d, 0xd8, 0xeb, 0x16, 0x85, 0xb5, 0xf6, 0x85, 0xc8, 0xd6, 0x8e, 0x80, 0xf4, 0xf7,
+	0xbb, 0x8b, 0x29, 0xae, 0xc1, 0x58, 0x30, 0x94, 0x8c, 0x97, 0xa2, 0x7e, 0xc9, 0x7f, 0x8c, 0x20,
+	0xd9, 0x9c, 0x93, 0xcb, 0x5e, 0x09, 0x74, 0x04, 0x53, 0x3e, 0xdf, 0x9e, 0xf2, 0x85, 0x42, 0xc1,
+	0x2e, 0xdb, 0x25, 0xd9, 0x52, 0x95, 0x1a, 0xb0, 0x5f, 0xbc, 0xbf, 0x0d, 0x1e, 0xc7, 0xe0, 0x68,
+	0x30, 0x98, 0xdb, 0x25, 0xd9, 0x5c, 0x55, 0xfb, 0x55, 0x7c, 0x3c, 0x01, 0x43, 0xa6, 0x25, 0x1b,
+	0x96, 0xa6, 0x17, 0x73, 0xab, 0xaa, 0x56, 0x5c, 0xb5, 0xe2, 0xb1, 0x24, 0x9a, 0x1c, 0xcc, 0xbe,
+	0xe4, 0x2e, 0x5f, 0xa1, 0xab, 0x78, 0x1c, 0x0e, 0xa8, 0xba, 0xe2, 0x33, 0xdb, 0x45, 0xcd, 0x5e,
+	0x64, 0x8b, 0xdc, 0xe8, 0x32, 0x40, 0xed, 0xa8, 0x8f, 0x0f, 0xd2, 0x34, 0x9d, 0x0c, 0x6c, 0x1c,
+	0x76, 0x9b, 0xd4, 0x4e, 0xbe, 0xa2, 0xca, 0x95, 0x65, 0x7d, 0x9e, 0x73, 0x83, 0x8f, 0xbe, 0x1c,
+	0x1b, 0x10, 0xbf, 0x43, 0x70, 0xac, 0x49, 0x32, 0x78, 0x59, 0xde, 0x82, 0xbd, 0x26, 0x5b, 0x8a,
+	0x23, 0xba, 0x4b, 0xa7, 0xdb, 0xab, 0x09, 0xc5, 0x59, 0x5a, 0x57, 0x75, 0x2b, 0xd0, 0x85, 0x1c,
+	0x0b, 0xbf, 0x11, 0x90, 0x11, 0xa3, 0x32, 0x26, 0x5a, 0xca, 0x60, 0x31, 0xf9, 0x75, 0x88, 0xdf,
+	0xbb, 0x0a, 0x16, 0xd5, 0x92, 0x5a, 0xa4, 0x6b, 0x75, 0x9b, 0x79, 0x09, 0x46, 0x14, 0xf6, 0xad,
+	0xa1, 0x9e, 0xf1, 0xad, 0xcd, 0xa9, 0x83, 0x9c, 0xb4, 0xae, 0x8c, 0x9e, 0x8b, 0x5b, 0xc6, 0xd0,
+	0xb6, 0x88, 0x75, 0xdd, 0x16, 0x73, 0x2f, 0x38, 0x05, 0x78, 0xee, 0x14, 0xe1, 0x53, 0x04, 0x89,
+	0x66, 0x12, 0x78, 0x15, 0x2a, 0xfe, 0x33, 0xa1, 0x9f, 0x07, 0xb5, 0x77, 0x4c, 0xd8, 0x20, 0xd6,
+	0xc5, 0x74, 0x87, 0x58, 0x72, 0xa9, 0x2f, 0xb9, 0xf5, 0xe5, 0xe2, 0x1f, 0x04, 0xe3, 0x91, 0xbc,
+	0x3c, 0x21, 0xef, 0xd5, 0x27, 0xe4, 0x5c, 0x64, 0x5b, 0xd6, 0xd0, 0x16, 0x5d, 0x6e, 0x86, 0x18,
+	0x76, 0x44, 0xe2, 0x12, 0xec, 0xb6, 0x1c, 0xd2, 0x3e, 0x5f, 0x8a, 0x8c, 0x44, 0x34, 0xf8, 0x81,
+	0xec, 0x45, 0xe6, 0xb5, 0x50, 0xff, 0xd2, 0x7c, 0x0d, 0x92, 0xcd, 0x39, 0x79, 0x8a, 0x13, 0x00,
+	0x5e, 0xd3, 0xb2, 0x2c, 0xef, 0xcb, 0xfa, 0x56, 0x7c, 0x68, 0x55, 0x78, 0x25, 0x88, 0xb6, 0xac,
+	0x59, 0xab, 0x8a, 0x21, 0x57, 0x39, 0x71, 0xdf, 0x64, 0xac, 0xc3, 0x89, 0x16, 0xc4, 0x5c, 0x4b,
+	0x06, 0x86, 0xab, 0xfc, 0x53, 0xdb, 0xc4, 0x43, 0xd5, 0x20, 0x98, 0x8f, 0x77, 0x06, 0x0e, 0x53,
+	0x5e, 0xe7, 0xb6, 0xb1, 0x75, 0xcd, 0xda, 0xb8, 0x49, 0x48, 0x89, 0xab, 0x9c, 0x7b, 0x79, 0x6b,
+	0x73, 0x6a, 0xa8, 0x56, 0xfa, 0xe4, 0x74, 0xea, 0xec, 0x74, 0x1c, 0x89, 0x5f, 0x20, 0x10, 0xc2,
+	0x9c, 0x78, 0x84, 0xef, 0xc3, 0x60, 0x85, 0x90, 0x52, 0x9f, 0xb7, 0x37, 0xe5, 0x68, 0x16, 0xe1,
+	0x87, 0x88, 0xef, 0xf9, 0x05, 0xdb, 0x22, 0x19, 0x52, 0xae, 0x10, 0x5b, 0x57, 0xbc, 0xd4, 0x7a,
+	0x55, 0x0c, 0xde, 0x40, 0xa8, 0xeb, 0x1b, 0x68, 0xb4, 0x31, 0x8a, 0x59, 0xf1, 0x5b, 0x77, 0xff,
+	0x37, 0x8b, 0x81, 0xa7, 0xeb, 0x02, 0x80, 0xd7, 0x17, 0xbc, 0x39, 0x23, 0x4a, 0xe9, 0xb3, 0xfd,
+	0xdf, 0x6e, 0x9e, 0xd0, 0xf8, 0x67, 0x9e, 0x8e, 0xc2, 0x6e, 0x1a, 0x3f, 0xfe, 0x1c, 0xc1, 0x1e,
+	0xf6, 0xec, 0xc0, 0x52, 0xe4, 0xd9, 0xd4, 0xf8, 0xe6, 0x11, 0xa6, 0xdb, 0x77, 0x60, 0x81, 0x89,
+	0xa7, 0x1f, 0xfe, 0xf8, 0xfb, 0x67, 0xb1, 0x13, 0x78, 0x5c, 0x8a, 0x7a, 0xa2, 0xb1, 0x37, 0x0f,
+	0xfe, 0x13, 0xc1, 0xe1, 0xa6, 0xaf, 0x0e, 0x9c, 0x6e, 0x4d, 0xde, 0xea, 0xb1, 0x24, 0x64, 0x7a,
+	0xc2, 0xe0, 0x9a, 0x32, 0x54, 0xd3, 0x45, 0x3c, 0x1f, 0xa9, 0xa9, 0x76, 0x22, 0x49, 0xf7, 0x1b,
+	0x2e, 0xe8, 0x07, 0xf8, 0xa3, 0x18, 0x1c, 0x89, 0x18, 0x91, 0xf1, 0x62, 0x07, 0x91, 0x36, 0x7d,
+	0x30, 0x08, 0x4b, 0x3d, 0xa2, 0x70, 0xc5, 0xcb, 0x54, 0xf1, 0x2d, 0x7c, 0xa3, 0x07, 0xc5, 0x12,
+	0xa9, 0xe1, 0xbb, 0xaf, 0x3b, 0xbc, 0x8d, 0x60, 0x34, 0x64, 0xf8, 0xc6, 0xaf, 0x75, 0x10, 0x77,
+	0xc3, 0x3b, 0x41, 0xb8, 0xd8, 0xa5, 0x37, 0x57, 0x7b, 0x9d, 0xaa, 0xbd, 0x82, 0x2f, 0xf7, 0xa2,
+	0xb6, 0x36, 0xd9, 0xe3, 0x9f, 0x10, 0x0c, 0xd7, 0xcf, 0xb1, 0xf8, 0xd5, 0x0e, 0x62, 0x0c, 0x3e,
+	0x04, 0x84, 0xb9, 0x6e, 0x5c, 0xb9, 0xb6, 0x37, 0xa9, 0xb6, 0x25, 0x9c, 0xe9, 0x45, 0x9b, 0x3b,
+	0x2c, 0xff, 0x85, 0x60, 0xa4, 0x61, 0x36, 0xc4, 0x6d, 0x84, 0xd7, 0x6c, 0x26, 0x16, 0xe6, 0xbb,
+	0xf2, 0xe5, 0xda, 0x72, 0x54, 0xdb, 0x3b, 0x78, 0x39, 0x52, 0x5b, 0xed, 0xc8, 0x95, 0xee, 0x37,
+	0xdc, 0xfa, 0x0f, 0x24, 0xde, 0x99, 0xa1, 0x7b, 0xf6, 0x39, 0x82, 0x43, 0xe1, 0xf3, 0x1f, 0x7e,
+	0xbd, 0x93, 0xc0, 0x43, 0x26, 0x56, 0xe1, 0x52, 0xf7, 0x00, 0x1d, 0x95, 0xb6, 0x3d, 0xf9, 0x74,
+	0x63, 0x86, 0x0c, 0x61, 0xed, 0x6c, 0xcc, 0xe6, 0xf3, 0xa2, 0x70, 0xb1, 0x4b, 0xef, 0x8e, 0x36,
+	0x66, 0x0b, 0x85, 0xb5, 0xde, 0xc6, 0xff, 0x22, 0x88, 0x37, 0x1b, 0xd1, 0xf0, 0x42, 0x07, 0xb1,
+	0x86, 0xcf, 0x95, 0x42, 0xba, 0x17, 0x08, 0xae, 0xf9, 0x0e, 0xd5, 0x7c, 0x1d, 0x5f, 0xeb, 0x45,
+	0x73, 0xfd, 0x8c, 0x89, 0x7f, 0x40, 0x70, 0x20, 0x30, 0xef, 0xe1, 0x73, 0xad, 0x63, 0x0d, 0x9b,
+	0x2a, 0x85, 0xf3, 0x1d, 0xfb, 0x71, 0x61, 0x57, 0x9f, 0x36, 0x0e, 0x7b, 0x54, 0xeb, 0x14, 0x3e,
+	0x1d, 0xa9, 0xb5, 0xe0, 0xc2, 0xe5, 0x9c, 0xa1, 0xf1, 0x51, 0x0c, 0x39, 0xe7, 0xd0, 0xa1, 0xf0,
+	0xb9, 0xac, 0x9d, 0x7d, 0x19, 0x39, 0x55, 0x0a, 0x97, 0xba, 0x07, 0xe0, 0x42, 0x6f, 0x36, 0x0a,
+	0x9d, 0xa5, 0x42, 0xcf, 0xe3, 0xb3, 0x91, 0x42, 0x65, 0xdb, 0x22, 0xb9, 0x02, 0x07, 0xce, 0xd5,
+	0x4a, 0x9c, 0x9e, 0x7f, 0xb2, 0x9d, 0x40, 0xcf, 0xb6, 0x13, 0xe8, 0xb7, 0xed, 0x04, 0xfa, 0x64,
+	0x27, 0x31, 0xf0, 0x6c, 0x27, 0x31, 0xf0, 0xf3, 0x4e, 0x62, 0xe0, 0xdd, 0xe3, 0x0c, 0xcf, 0x54,
+	0xee, 0xa5, 0x34, 0x22, 0x7d, 0x10, 0xc4, 0xa5, 0x83, 0x77, 0x7e, 0x0f, 0xfd, 0xa3, 0xf6, 0xec,
+	0x7f, 0x03, 0x00, 0x75, 0xa7, 0xf6, 0xf3, 0xfa, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1108,6 +1218,9 @@ type QueryClient interface {
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// AutoCompoundDelegators queries the delegators opted in to the auto-compounding
+	// of their rewards.
+	AutoCompoundDelegators(ctx context.Context, in *QueryAutoCompoundDelegatorsRequest, opts ...grpc.CallOption) (*QueryAutoCompoundDelegatorsResponse, error)
 }
 
 type queryClient struct {
@@ -1209,6 +1322,15 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
+func (c *queryClient) AutoCompoundDelegators(ctx context.Context, in *QueryAutoCompoundDelegatorsRequest, opts ...grpc.CallOption) (*QueryAutoCompoundDelegatorsResponse, error) {
+	out := new(QueryAutoCompoundDelegatorsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/AutoCompoundDelegators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the distribution module.
@@ -1234,6 +1356,9 @@ type QueryServer interface {
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// AutoCompoundDelegators queries the delegators opted in to the auto-compounding
+	// of their rewards.
+	AutoCompoundDelegators(context.Context, *QueryAutoCompoundDelegatorsRequest) (*QueryAutoCompoundDelegatorsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1270,6 +1395,9 @@ func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, r
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (*UnimplementedQueryServer) AutoCompoundDelegators(ctx context.Context, req *QueryAutoCompoundDelegatorsRequest) (*QueryAutoCompoundDelegatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoCompoundDelegators not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1455,6 +1583,24 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AutoCompoundDelegators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoCompoundDelegatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoCompoundDelegators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/AutoCompoundDelegators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoCompoundDelegators(ctx, req.(*QueryAutoCompoundDelegatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Query",
@@ -1500,6 +1646,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "AutoCompoundDelegators",
+			Handler:    _Query_AutoCompoundDelegators_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
@@ -2213,6 +2363,85 @@ func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAutoCompoundDelegatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundDelegatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundDelegatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoCompoundDelegatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundDelegatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundDelegatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegators) > 0 {
+		for iNdEx := len(m.Delegators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Delegators[iNdEx])
+			copy(dAtA[i:], m.Delegators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2516,6 +2745,38 @@ func (m *QueryCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAutoCompoundDelegatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoCompoundDelegatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegators) > 0 {
+		for _, s := range m.Delegators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4353,6 +4614,210 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAutoCompoundDelegatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCompoundDelegatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCompoundDelegatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoCompoundDelegatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCompoundDelegatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCompoundDelegatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegators = append(m.Delegators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -652,6 +652,84 @@ func (m *MsgDepositValidatorRewardsPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDepositValidatorRewardsPoolResponse proto.InternalMessageInfo
 
+// MsgSetAutoCompound opts a delegator in or out of the auto-compounding of its
+// rewards, which are then periodically withdrawn and delegated back to the
+// validators they were earned from.
+type MsgSetAutoCompound struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	Enabled          bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetAutoCompound) Reset()         { *m = MsgSetAutoCompound{} }
+func (m *MsgSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompound) ProtoMessage()    {}
+func (*MsgSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{14}
+}
+func (m *MsgSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompound.Merge(m, src)
+}
+func (m *MsgSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompound proto.InternalMessageInfo
+
+// MsgSetAutoCompoundResponse defines the Msg/SetAutoCompound response type.
+type MsgSetAutoCompoundResponse struct {
+}
+
+func (m *MsgSetAutoCompoundResponse) Reset()         { *m = MsgSetAutoCompoundResponse{} }
+func (m *MsgSetAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgSetAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{15}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompoundResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddress")
 	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddressResponse")
@@ -667,6 +745,8 @@ func init() {
 	proto.RegisterType((*MsgCommunityPoolSpendResponse)(nil), "cosmos.distribution.v1beta1.MsgCommunityPoolSpendResponse")
 	proto.RegisterType((*MsgDepositValidatorRewardsPool)(nil), "cosmos.distribution.v1beta1.MsgDepositValidatorRewardsPool")
 	proto.RegisterType((*MsgDepositValidatorRewardsPoolResponse)(nil), "cosmos.distribution.v1beta1.MsgDepositValidatorRewardsPoolResponse")
+	proto.RegisterType((*MsgSetAutoCompound)(nil), "cosmos.distribution.v1beta1.MsgSetAutoCompound")
+	proto.RegisterType((*MsgSetAutoCompoundResponse)(nil), "cosmos.distribution.v1beta1.MsgSetAutoCompoundResponse")
 }
 
 func init() {
@@ -674,71 +754,75 @@ func init() {
 }
 
 var fileDescriptor_ed4f433d965e58ca = []byte{
-	// 1013 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x38, 0x22, 0x22, 0xd3, 0x4a, 0x69, 0xb6, 0x29, 0x71, 0x37, 0xed, 0x3a, 0xdd, 0x42,
-	0x88, 0x22, 0xb2, 0x9b, 0x84, 0xa6, 0x15, 0x46, 0xa8, 0x22, 0x29, 0x91, 0x38, 0x6c, 0xa9, 0x1c,
-	0x01, 0x82, 0x4b, 0xb4, 0xf6, 0x2e, 0x9b, 0x51, 0xb3, 0x3b, 0xab, 0x9d, 0x71, 0x5c, 0xdf, 0x10,
-	0x42, 0xa2, 0x42, 0x1c, 0x90, 0x38, 0xd1, 0x0b, 0x3d, 0x56, 0x9c, 0x2c, 0x61, 0x89, 0xfe, 0x01,
-	0x1c, 0xaa, 0x9e, 0x2a, 0x9f, 0x10, 0x07, 0xa8, 0x9c, 0x83, 0x91, 0xf8, 0x07, 0x38, 0x21, 0xb4,
-	0xbb, 0xb3, 0x6b, 0xef, 0x0f, 0xef, 0xda, 0x21, 0x02, 0x2e, 0xb6, 0x35, 0xf3, 0xbe, 0x37, 0xdf,
-	0x7c, 0xf3, 0xcd, 0x7b, 0x63, 0xf8, 0x72, 0x1d, 0x13, 0x13, 0x13, 0x59, 0x43, 0x84, 0x3a, 0xa8,
-	0xd6, 0xa0, 0x08, 0x5b, 0xf2, 0xd1, 0x46, 0x4d, 0xa7, 0xea, 0x86, 0x4c, 0xef, 0x49, 0xb6, 0x83,
-	0x29, 0xe6, 0x16, 0xfd, 0x28, 0x69, 0x38, 0x4a, 0x62, 0x51, 0xfc, 0xbc, 0x81, 0x0d, 0xec, 0xc5,
-	0xc9, 0xee, 0x2f, 0x1f, 0xc2, 0x0b, 0x2c, 0x71, 0x4d, 0x25, 0x7a, 0x98, 0xb0, 0x8e, 0x91, 0xc5,
-	0xe6, 0x2f, 0xfa, 0xf3, 0xfb, 0x3e, 0x90, 0xe5, 0xf7, 0xa7, 0x16, 0x18, 0xd4, 0x24, 0x86, 0x7c,
-	0xb4, 0xe1, 0x7e, 0xb1, 0x89, 0x39, 0xd5, 0x44, 0x16, 0x96, 0xbd, 0x4f, 0x36, 0x24, 0x65, 0xf1,
-	0x8f, 0xd0, 0xf5, 0xe2, 0xc5, 0x3f, 0x00, 0xbc, 0xa0, 0x10, 0x63, 0x4f, 0xa7, 0x1f, 0x22, 0x7a,
-	0xa0, 0x39, 0x6a, 0xf3, 0x6d, 0x4d, 0x73, 0x74, 0x42, 0xb8, 0x77, 0xe0, 0x9c, 0xa6, 0x1f, 0xea,
-	0x86, 0x4a, 0xb1, 0xb3, 0xaf, 0xfa, 0x83, 0x25, 0xb0, 0x04, 0x56, 0x66, 0xb6, 0x4b, 0xdd, 0xce,
-	0xda, 0x3c, 0xa3, 0xc8, 0xc2, 0xf7, 0xa8, 0x83, 0x2c, 0xa3, 0x7a, 0x2e, 0x84, 0x04, 0x69, 0x76,
-	0xe0, 0xb9, 0x26, 0xcb, 0x1c, 0x66, 0x29, 0xe6, 0x64, 0x99, 0x6d, 0x46, 0xb9, 0x54, 0x76, 0xef,
-	0x3f, 0x2c, 0x17, 0x7e, 0x7f, 0x58, 0x2e, 0x7c, 0xd6, 0x6f, 0xaf, 0x26, 0x69, 0x7d, 0xd9, 0x6f,
-	0xaf, 0x5e, 0xf5, 0x33, 0xad, 0x11, 0xed, 0xae, 0xac, 0x10, 0x43, 0xc1, 0x1a, 0xfa, 0xa4, 0x15,
-	0xdb, 0x93, 0x58, 0x86, 0x97, 0x53, 0x37, 0x5b, 0xd5, 0x89, 0x8d, 0x2d, 0xa2, 0x8b, 0x7f, 0x01,
-	0xc8, 0x2b, 0xc4, 0x08, 0xa6, 0x6f, 0x05, 0x2b, 0x55, 0xf5, 0xa6, 0xea, 0x68, 0xa7, 0xa5, 0xc9,
-	0x6d, 0x38, 0x77, 0xa4, 0x1e, 0x22, 0x2d, 0x92, 0xc6, 0x17, 0xe5, 0x4a, 0xb7, 0xb3, 0x76, 0x99,
-	0xa5, 0xf9, 0x20, 0x88, 0x89, 0xe5, 0x3b, 0x8a, 0x8d, 0x57, 0xde, 0xcd, 0x97, 0x67, 0x39, 0x2a,
-	0x4f, 0x6c, 0x83, 0x08, 0x5b, 0xfe, 0x0e, 0xc5, 0x1f, 0x00, 0x14, 0x47, 0x0b, 0x10, 0xe8, 0xc4,
-	0x7d, 0x05, 0xe0, 0xb4, 0x6a, 0xe2, 0x86, 0x45, 0x4b, 0x60, 0x69, 0x6a, 0xe5, 0xcc, 0xe6, 0x45,
-	0x66, 0x3c, 0xc9, 0xf5, 0x77, 0x70, 0x15, 0xa4, 0x1d, 0x8c, 0xac, 0xed, 0x8f, 0x9e, 0xfc, 0x5a,
-	0x2e, 0x7c, 0xff, 0x5b, 0x79, 0xc5, 0x40, 0xf4, 0xa0, 0x51, 0x93, 0xea, 0xd8, 0x64, 0xfe, 0x96,
-	0x87, 0x48, 0xd1, 0x96, 0xad, 0x13, 0x0f, 0x40, 0x7e, 0xe9, 0xac, 0xcd, 0x0e, 0x66, 0x96, 0xd6,
-	0xa5, 0x6b, 0x37, 0x1e, 0xf4, 0xdb, 0xab, 0x67, 0x5d, 0x2a, 0xf5, 0xd6, 0xbe, 0x7b, 0x69, 0xc8,
-	0xa3, 0x7e, 0x7b, 0x15, 0x54, 0x19, 0x07, 0xf1, 0x31, 0x80, 0xc2, 0x10, 0xeb, 0x50, 0xb8, 0x1d,
-	0x6c, 0x9a, 0x88, 0x10, 0x84, 0xad, 0x74, 0xcd, 0xc1, 0xc9, 0x35, 0x8f, 0x5a, 0x32, 0x91, 0x3a,
-	0xc5, 0x92, 0x43, 0xec, 0x06, 0xbc, 0xc4, 0x1f, 0x01, 0x5c, 0xce, 0xa6, 0xfe, 0x7f, 0x15, 0xfd,
-	0x41, 0x11, 0xce, 0x2b, 0xc4, 0xd8, 0x6d, 0x58, 0x9a, 0x4b, 0xb6, 0x61, 0x21, 0xda, 0xba, 0x83,
-	0xf1, 0x21, 0xd7, 0x1a, 0x9f, 0xe6, 0xee, 0xa4, 0x34, 0xb3, 0x39, 0x71, 0xd7, 0xe1, 0x8c, 0xa6,
-	0xdb, 0x98, 0x20, 0x8a, 0x9d, 0xdc, 0x32, 0x33, 0x08, 0xad, 0xbc, 0x17, 0x9c, 0x66, 0x37, 0x2e,
-	0xc3, 0xd6, 0xba, 0x7b, 0xc0, 0x83, 0x50, 0xf7, 0x60, 0xcb, 0xd1, 0x83, 0x4d, 0x28, 0x50, 0x02,
-	0xe2, 0x16, 0xbc, 0x94, 0x36, 0x13, 0x9c, 0x65, 0xe5, 0x42, 0xca, 0x42, 0x25, 0x20, 0x3e, 0x07,
-	0x70, 0x56, 0x21, 0xc6, 0xfb, 0xb6, 0xa6, 0x52, 0xfd, 0x8e, 0xea, 0xa8, 0x26, 0x71, 0xf7, 0xa4,
-	0x36, 0xe8, 0x01, 0x76, 0x10, 0x6d, 0xe5, 0x16, 0x9b, 0x41, 0x28, 0xb7, 0x0b, 0xa7, 0x6d, 0x2f,
-	0x83, 0x27, 0xc4, 0x99, 0xcd, 0xab, 0x52, 0x46, 0xd7, 0x92, 0xfc, 0xc5, 0xb6, 0x67, 0xdc, 0x03,
-	0x61, 0x9a, 0xfa, 0xe8, 0x8a, 0xd2, 0x4d, 0x5a, 0xc3, 0xd3, 0x24, 0x5c, 0xca, 0xd5, 0xe4, 0xd5,
-	0x21, 0x4d, 0x22, 0xcd, 0x27, 0xb6, 0x1d, 0x51, 0x82, 0x0b, 0xb1, 0xa1, 0x50, 0x94, 0xf3, 0x29,
-	0x2b, 0x89, 0x8f, 0x8b, 0x5e, 0x87, 0x8a, 0xc8, 0xb8, 0x67, 0xeb, 0x96, 0x76, 0x62, 0x61, 0x2e,
-	0xc1, 0x19, 0x47, 0xaf, 0x23, 0x1b, 0xe9, 0x16, 0xf5, 0x4d, 0x52, 0x1d, 0x0c, 0x0c, 0xb9, 0x77,
-	0xea, 0x5f, 0x76, 0x6f, 0xe5, 0xf6, 0x28, 0xf7, 0x45, 0x94, 0x5e, 0x8e, 0x2b, 0x2d, 0xa7, 0xca,
-	0x53, 0x02, 0xe2, 0x75, 0xaf, 0xdd, 0x25, 0xa7, 0xf2, 0x5c, 0xf8, 0x67, 0xd1, 0x2b, 0xa7, 0xb7,
-	0x7c, 0xcf, 0x87, 0x25, 0xc9, 0xef, 0x01, 0xc4, 0xbb, 0xe3, 0x91, 0x8b, 0x06, 0xc6, 0xbe, 0x68,
-	0xa7, 0xdd, 0xfa, 0xfe, 0xcb, 0xd3, 0xda, 0x9b, 0xa8, 0x66, 0xbc, 0x92, 0x76, 0x6a, 0x03, 0x85,
-	0x99, 0xb6, 0xe2, 0x5b, 0x5e, 0x37, 0xc8, 0x50, 0x3e, 0xe3, 0xb2, 0x6c, 0xad, 0x6f, 0xfe, 0xf4,
-	0x22, 0x9c, 0x52, 0x88, 0xc1, 0x7d, 0x0e, 0x20, 0x97, 0xf2, 0xa6, 0xdb, 0xcc, 0x2c, 0x01, 0xa9,
-	0x4f, 0x23, 0xbe, 0x32, 0x39, 0x26, 0xec, 0x58, 0xdf, 0x00, 0xb8, 0x30, 0xea, 0x2d, 0x75, 0x23,
-	0x2f, 0xef, 0x08, 0x20, 0x7f, 0xf3, 0x84, 0xc0, 0x90, 0xd5, 0x77, 0x00, 0x2e, 0x66, 0x3d, 0x15,
-	0xde, 0x1c, 0x77, 0x81, 0x14, 0x30, 0xbf, 0xf3, 0x0f, 0xc0, 0x21, 0xc3, 0x6f, 0x01, 0x9c, 0x4b,
-	0xf6, 0xd5, 0x8d, 0xbc, 0xd4, 0x09, 0x08, 0xff, 0xc6, 0xc4, 0x90, 0xf0, 0x29, 0xfc, 0xd2, 0xd3,
-	0xa4, 0xbf, 0xee, 0x17, 0x01, 0xf7, 0x05, 0x80, 0x67, 0x23, 0xfd, 0xe9, 0xb5, 0xbc, 0x35, 0x86,
-	0xa3, 0xf9, 0x6b, 0x93, 0x44, 0x87, 0x64, 0xce, 0x3f, 0x4d, 0x76, 0x06, 0x57, 0x25, 0x2e, 0xa5,
-	0x2d, 0xe4, 0x9a, 0x3c, 0x89, 0xc9, 0x37, 0xf9, 0xe8, 0x22, 0x9a, 0xc2, 0x6d, 0x6b, 0x9d, 0xeb,
-	0x00, 0xb8, 0x98, 0x55, 0x3f, 0x73, 0x3d, 0x96, 0x01, 0xce, 0xf7, 0xd8, 0x18, 0xf5, 0x23, 0x95,
-	0x36, 0xff, 0xc2, 0xa7, 0x6e, 0x89, 0xdb, 0xbe, 0xf9, 0xa8, 0x27, 0x80, 0x27, 0x3d, 0x01, 0x3c,
-	0xeb, 0x09, 0xe0, 0x79, 0x4f, 0x00, 0x5f, 0x1f, 0x0b, 0x85, 0x67, 0xc7, 0x42, 0xe1, 0xe7, 0x63,
-	0xa1, 0xf0, 0xf1, 0x15, 0x1f, 0x45, 0xb4, 0xbb, 0x12, 0xc2, 0xf2, 0xbd, 0x68, 0xbb, 0xf7, 0xea,
-	0x67, 0x6d, 0xda, 0xfb, 0x77, 0xf9, 0xfa, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xfd, 0xd3, 0xe1,
-	0xa5, 0x4f, 0x0f, 0x00, 0x00,
+	// 1078 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0xdf, 0xd9, 0x8a, 0xc0, 0x4e, 0x2b, 0xa5, 0x71, 0x53, 0xb2, 0x75, 0x1a, 0x6f, 0xea, 0x42,
+	0x88, 0x22, 0x62, 0x67, 0x93, 0xa6, 0x11, 0x46, 0xa8, 0x6a, 0xb6, 0x44, 0xe2, 0xb0, 0xa5, 0xda,
+	0x08, 0x50, 0xb9, 0x44, 0xde, 0xf5, 0xe0, 0x8c, 0x1a, 0x7b, 0x2c, 0xcf, 0x6c, 0xd2, 0xbd, 0x21,
+	0x84, 0x44, 0x85, 0x38, 0x54, 0xe2, 0x44, 0x2f, 0xf4, 0x58, 0x71, 0x8a, 0x44, 0x24, 0xfa, 0x27,
+	0x54, 0x3d, 0x45, 0xb9, 0x80, 0x38, 0x40, 0x95, 0x1c, 0x82, 0xc4, 0x3f, 0xc0, 0x09, 0x21, 0x7f,
+	0xee, 0xfa, 0x63, 0xed, 0xdd, 0x34, 0x02, 0x2e, 0xf9, 0x98, 0x79, 0xbf, 0x37, 0xbf, 0xf9, 0xbd,
+	0x37, 0xef, 0x3d, 0xc3, 0x37, 0x5a, 0x84, 0x1a, 0x84, 0xca, 0x1a, 0xa6, 0xcc, 0xc6, 0xcd, 0x36,
+	0xc3, 0xc4, 0x94, 0xb7, 0xab, 0x4d, 0xc4, 0xd4, 0xaa, 0xcc, 0xee, 0x4b, 0x96, 0x4d, 0x18, 0xe1,
+	0x26, 0x3d, 0x2b, 0xa9, 0xd7, 0x4a, 0xf2, 0xad, 0xf8, 0x71, 0x9d, 0xe8, 0xc4, 0xb5, 0x93, 0x9d,
+	0xbf, 0x3c, 0x08, 0x2f, 0xf8, 0x8e, 0x9b, 0x2a, 0x45, 0xa1, 0xc3, 0x16, 0xc1, 0xa6, 0xbf, 0x7f,
+	0xc9, 0xdb, 0xdf, 0xf0, 0x80, 0xbe, 0x7f, 0x6f, 0x6b, 0xc2, 0x87, 0x1a, 0x54, 0x97, 0xb7, 0xab,
+	0xce, 0x2f, 0x7f, 0x63, 0x4c, 0x35, 0xb0, 0x49, 0x64, 0xf7, 0xa7, 0xbf, 0x24, 0x65, 0xf1, 0x8f,
+	0xd0, 0x75, 0xed, 0xc5, 0x3f, 0x01, 0xbc, 0x58, 0xa7, 0xfa, 0x3a, 0x62, 0x9f, 0x60, 0xb6, 0xa9,
+	0xd9, 0xea, 0xce, 0x4d, 0x4d, 0xb3, 0x11, 0xa5, 0xdc, 0xfb, 0x70, 0x4c, 0x43, 0x5b, 0x48, 0x57,
+	0x19, 0xb1, 0x37, 0x54, 0x6f, 0xb1, 0x0c, 0xa6, 0xc1, 0x6c, 0x69, 0xb5, 0x7c, 0xb0, 0x37, 0x3f,
+	0xee, 0x53, 0xf4, 0xcd, 0xd7, 0x99, 0x8d, 0x4d, 0xbd, 0x71, 0x3e, 0x84, 0x04, 0x6e, 0x6a, 0xf0,
+	0xfc, 0x8e, 0xef, 0x39, 0xf4, 0x52, 0xcc, 0xf1, 0x32, 0xba, 0x13, 0xe5, 0xa2, 0xac, 0x3d, 0x78,
+	0x5c, 0x29, 0xfc, 0xf1, 0xb8, 0x52, 0xf8, 0xe2, 0x78, 0x77, 0x2e, 0x49, 0xeb, 0xeb, 0xe3, 0xdd,
+	0xb9, 0xab, 0x9e, 0xa7, 0x79, 0xaa, 0xdd, 0x93, 0xeb, 0x54, 0xaf, 0x13, 0x0d, 0x7f, 0xd6, 0x89,
+	0xdd, 0x49, 0xac, 0xc0, 0xa9, 0xd4, 0xcb, 0x36, 0x10, 0xb5, 0x88, 0x49, 0x91, 0xf8, 0x37, 0x80,
+	0x7c, 0x9d, 0xea, 0xc1, 0xf6, 0xad, 0xe0, 0xa4, 0x06, 0xda, 0x51, 0x6d, 0xed, 0xb4, 0x34, 0xb9,
+	0x0d, 0xc7, 0xb6, 0xd5, 0x2d, 0xac, 0x45, 0xdc, 0x78, 0xa2, 0x5c, 0x39, 0xd8, 0x9b, 0x9f, 0xf2,
+	0xdd, 0x7c, 0x1c, 0xd8, 0xc4, 0xfc, 0x6d, 0xc7, 0xd6, 0x95, 0x0f, 0xf2, 0xe5, 0x99, 0x89, 0xca,
+	0x13, 0xbb, 0x20, 0x26, 0xa6, 0x77, 0x43, 0xf1, 0x47, 0x00, 0xc5, 0xfe, 0x02, 0x04, 0x3a, 0x71,
+	0xdf, 0x00, 0x38, 0xa2, 0x1a, 0xa4, 0x6d, 0xb2, 0x32, 0x98, 0x3e, 0x33, 0x7b, 0x76, 0xf1, 0x92,
+	0x9f, 0x78, 0x92, 0x93, 0xdf, 0xc1, 0x53, 0x90, 0x6a, 0x04, 0x9b, 0xab, 0x77, 0x9f, 0xfd, 0x56,
+	0x29, 0xfc, 0xf0, 0x7b, 0x65, 0x56, 0xc7, 0x6c, 0xb3, 0xdd, 0x94, 0x5a, 0xc4, 0xf0, 0xf3, 0x5b,
+	0xee, 0x21, 0xc5, 0x3a, 0x16, 0xa2, 0x2e, 0x80, 0xfe, 0xba, 0x37, 0x3f, 0xda, 0xdd, 0x99, 0x5e,
+	0x90, 0xae, 0xad, 0x3c, 0x3a, 0xde, 0x9d, 0x3b, 0xe7, 0x50, 0x69, 0x75, 0x36, 0x9c, 0x47, 0x43,
+	0x9f, 0x1c, 0xef, 0xce, 0x81, 0x86, 0xcf, 0x41, 0x7c, 0x0a, 0xa0, 0xd0, 0xc3, 0x3a, 0x14, 0xae,
+	0x46, 0x0c, 0x03, 0x53, 0x8a, 0x89, 0x99, 0xae, 0x39, 0x38, 0xb9, 0xe6, 0xd1, 0x94, 0x4c, 0xb8,
+	0x4e, 0x49, 0xc9, 0x1e, 0x76, 0x5d, 0x5e, 0xe2, 0x4f, 0x00, 0xce, 0x64, 0x53, 0xff, 0xbf, 0x8a,
+	0xfe, 0xa8, 0x08, 0xc7, 0xeb, 0x54, 0x5f, 0x6b, 0x9b, 0x9a, 0x43, 0xb6, 0x6d, 0x62, 0xd6, 0xb9,
+	0x43, 0xc8, 0x16, 0xd7, 0x19, 0x9c, 0xe6, 0xda, 0xb0, 0x34, 0xb3, 0x39, 0x71, 0xd7, 0x61, 0x49,
+	0x43, 0x16, 0xa1, 0x98, 0x11, 0x3b, 0xb7, 0xcc, 0x74, 0x4d, 0x95, 0x0f, 0x83, 0x68, 0x1e, 0xc4,
+	0x65, 0x58, 0x5e, 0x70, 0x02, 0xdc, 0x35, 0x75, 0x02, 0x5b, 0x89, 0x06, 0x36, 0xa1, 0x40, 0x19,
+	0x88, 0xcb, 0xf0, 0x72, 0xda, 0x4e, 0x10, 0x4b, 0xe5, 0x62, 0xca, 0x41, 0x65, 0x20, 0xbe, 0x00,
+	0x70, 0xb4, 0x4e, 0xf5, 0x8f, 0x2c, 0x4d, 0x65, 0xe8, 0x8e, 0x6a, 0xab, 0x06, 0x75, 0xee, 0xa4,
+	0xb6, 0xd9, 0x26, 0xb1, 0x31, 0xeb, 0xe4, 0x16, 0x9b, 0xae, 0x29, 0xb7, 0x06, 0x47, 0x2c, 0xd7,
+	0x83, 0x2b, 0xc4, 0xd9, 0xc5, 0xab, 0x52, 0x46, 0xd7, 0x92, 0xbc, 0xc3, 0x56, 0x4b, 0x4e, 0x40,
+	0x7c, 0x4d, 0x3d, 0xb4, 0x52, 0x3f, 0x48, 0xa6, 0x86, 0xab, 0x49, 0x78, 0x94, 0xa3, 0xc9, 0x5b,
+	0x3d, 0x9a, 0x44, 0x9a, 0x4f, 0xec, 0x3a, 0xa2, 0x04, 0x27, 0x62, 0x4b, 0xa1, 0x28, 0x17, 0x52,
+	0x4e, 0x12, 0x9f, 0x16, 0xdd, 0x0e, 0x15, 0x91, 0x71, 0xdd, 0x42, 0xa6, 0x76, 0x62, 0x61, 0x2e,
+	0xc3, 0x92, 0x8d, 0x5a, 0xd8, 0xc2, 0xc8, 0x64, 0x5e, 0x92, 0x34, 0xba, 0x0b, 0x3d, 0xd9, 0x7b,
+	0xe6, 0x5f, 0xce, 0x5e, 0xe5, 0x76, 0xbf, 0xec, 0x8b, 0x28, 0x3d, 0x13, 0x57, 0x5a, 0x4e, 0x95,
+	0xa7, 0x0c, 0xc4, 0xeb, 0x70, 0x2a, 0x75, 0x2b, 0x2f, 0x0b, 0xff, 0x2a, 0xba, 0xe5, 0xf4, 0x96,
+	0x97, 0xf3, 0x61, 0x49, 0xf2, 0x7a, 0x00, 0x75, 0xdf, 0x78, 0xe4, 0xa1, 0x81, 0x81, 0x1f, 0xda,
+	0x69, 0xb7, 0xbe, 0xff, 0x32, 0x5a, 0xeb, 0x43, 0xd5, 0x8c, 0x37, 0xd3, 0xa2, 0xd6, 0x55, 0xd8,
+	0xd7, 0x56, 0x7c, 0x0f, 0xce, 0x44, 0xd6, 0x13, 0xca, 0x67, 0x3c, 0x96, 0xe5, 0x05, 0x71, 0x1f,
+	0x40, 0xce, 0x9b, 0x70, 0x6e, 0xb6, 0x19, 0xa9, 0x11, 0xc3, 0x22, 0x6d, 0xf3, 0xd4, 0xe6, 0x96,
+	0x32, 0x7c, 0x15, 0x99, 0x6a, 0x73, 0x0b, 0x69, 0x6e, 0xc8, 0x5e, 0x6b, 0x04, 0xff, 0x2a, 0x77,
+	0xfb, 0x6b, 0xb1, 0x34, 0xd8, 0xcc, 0x16, 0x6a, 0x12, 0xe3, 0x2e, 0x56, 0x21, 0x9f, 0x5c, 0xcd,
+	0x52, 0x61, 0x69, 0xf1, 0xe7, 0x12, 0x3c, 0x53, 0xa7, 0x3a, 0xf7, 0x25, 0x80, 0x5c, 0xca, 0x64,
+	0xbb, 0x98, 0x59, 0x08, 0x53, 0x07, 0x44, 0x5e, 0x19, 0x1e, 0x13, 0xf6, 0xed, 0x6f, 0x01, 0x9c,
+	0xe8, 0x37, 0x51, 0xae, 0xe4, 0xf9, 0xed, 0x03, 0xe4, 0x6f, 0x9c, 0x10, 0x18, 0xb2, 0xfa, 0x1e,
+	0xc0, 0xc9, 0xac, 0x81, 0xe9, 0xdd, 0x41, 0x0f, 0x48, 0x01, 0xf3, 0xb5, 0x97, 0x00, 0x87, 0x0c,
+	0xbf, 0x03, 0x70, 0x2c, 0x39, 0x5d, 0x54, 0xf3, 0x5c, 0x27, 0x20, 0xfc, 0x3b, 0x43, 0x43, 0xc2,
+	0x0f, 0x82, 0xd7, 0x9f, 0x27, 0x5f, 0xd9, 0x83, 0x22, 0xe0, 0xbe, 0x02, 0xf0, 0x5c, 0xa4, 0x4b,
+	0xbf, 0x9d, 0x77, 0x46, 0xaf, 0x35, 0x7f, 0x6d, 0x18, 0xeb, 0x90, 0xcc, 0x85, 0xe7, 0xc9, 0xfe,
+	0xe8, 0xa8, 0xc4, 0xa5, 0x34, 0xc7, 0xdc, 0x24, 0x4f, 0x62, 0x78, 0x65, 0x78, 0x4c, 0x06, 0xb7,
+	0xe5, 0x05, 0x6e, 0x0f, 0xc0, 0xc9, 0xac, 0x2e, 0x92, 0x9b, 0x63, 0x19, 0x60, 0xbe, 0xf6, 0x12,
+	0xe0, 0x6c, 0xda, 0x0f, 0x01, 0x1c, 0x8d, 0x97, 0x50, 0x79, 0x80, 0x02, 0xd0, 0x0b, 0xe0, 0x57,
+	0x86, 0x04, 0x64, 0x51, 0x5a, 0xe2, 0x5f, 0xf9, 0xdc, 0xe9, 0x3d, 0xab, 0x37, 0x9e, 0x1c, 0x0a,
+	0xe0, 0xd9, 0xa1, 0x00, 0xf6, 0x0f, 0x05, 0xf0, 0xe2, 0x50, 0x00, 0x0f, 0x8f, 0x84, 0xc2, 0xfe,
+	0x91, 0x50, 0xf8, 0xe5, 0x48, 0x28, 0x7c, 0x7a, 0xc5, 0x43, 0x51, 0xed, 0x9e, 0x84, 0x89, 0x7c,
+	0x3f, 0x3a, 0x87, 0xb9, 0x8d, 0xad, 0x39, 0xe2, 0x7e, 0xf6, 0x2f, 0xfd, 0x33, 0x00, 0xb4, 0xd4,
+	0x37, 0xd7, 0xe8, 0x10, 0x00, 0x00,
 }
 
 func (this *MsgSetWithdrawAddressResponse) Equal(that interface{}) bool {
@@ -966,6 +1050,27 @@ func (this *MsgDepositValidatorRewardsPoolResponse) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *MsgSetAutoCompoundResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetAutoCompoundResponse)
+	if !ok {
+		that2, ok := that.(MsgSetAutoCompoundResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -1006,6 +1111,9 @@ type MsgClient interface {
 	// DepositValidatorRewardsPool defines a method to provide additional rewards
 	// to delegators to a specific validator.
 	DepositValidatorRewardsPool(ctx context.Context, in *MsgDepositValidatorRewardsPool, opts ...grpc.CallOption) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// SetAutoCompound defines a method for a delegator to opt in or out of the
+	// auto-compounding of its rewards.
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
 }
 
 type msgClient struct {
@@ -1080,6 +1188,15 @@ func (c *msgClient) DepositValidatorRewardsPool(ctx context.Context, in *MsgDepo
 	return out, nil
 }
 
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/SetAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetWithdrawAddress defines a method to change the withdraw address
@@ -1109,6 +1226,9 @@ type MsgServer interface {
 	// DepositValidatorRewardsPool defines a method to provide additional rewards
 	// to delegators to a specific validator.
 	DepositValidatorRewardsPool(context.Context, *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// SetAutoCompound defines a method for a delegator to opt in or out of the
+	// auto-compounding of its rewards.
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1136,6 +1256,9 @@ func (*UnimplementedMsgServer) CommunityPoolSpend(ctx context.Context, req *MsgC
 func (*UnimplementedMsgServer) DepositValidatorRewardsPool(ctx context.Context, req *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositValidatorRewardsPool not implemented")
 }
+func (*UnimplementedMsgServer) SetAutoCompound(ctx context.Context, req *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1267,6 +1390,24 @@ func _Msg_DepositValidatorRewardsPool_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/SetAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Msg",
@@ -1300,6 +1441,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DepositValidatorRewardsPool",
 			Handler:    _Msg_DepositValidatorRewardsPool_Handler,
 		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/tx.proto",
@@ -1784,6 +1929,69 @@ func (m *MsgDepositValidatorRewardsPoolResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1997,6 +2205,31 @@ func (m *MsgDepositValidatorRewardsPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3258,6 +3491,158 @@ func (m *MsgDepositValidatorRewardsPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetAutoCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
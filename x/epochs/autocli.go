@@ -1,8 +1,12 @@
 package epochs
 
 import (
+	"fmt"
+
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	epochsv1beta1 "cosmossdk.io/api/cosmos/epochs/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/version"
 )
 
 // AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
@@ -21,6 +25,33 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "current-epoch",
 					Short:     "Query current epoch by specified identifier",
 				},
+				{
+					RpcMethod: "UpcomingEpochStarts",
+					Use:       "upcoming-epoch-starts",
+					Short:     "Query the start times of the next epochs, of all identifiers or of a specified identifier",
+					Example:   fmt.Sprintf(`%s query epochs upcoming-epoch-starts --identifier day --count 7`, version.AppName),
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: epochsv1beta1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "AddEpochIdentifier",
+					Use:            "add-epoch-identifier-proposal <identifier> <duration>",
+					Short:          "Submit a proposal to add an epoch identifier, starting at the proposal execution unless --start-time is set",
+					Example:        fmt.Sprintf(`%s tx epochs add-epoch-identifier-proposal month 720h`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "identifier"}, {ProtoField: "duration"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "RemoveEpochIdentifier",
+					Use:            "remove-epoch-identifier-proposal <identifier>",
+					Short:          "Submit a proposal to remove an epoch identifier",
+					Example:        fmt.Sprintf(`%s tx epochs remove-epoch-identifier-proposal month`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "identifier"}},
+					GovProposal:    true,
+				},
 			},
 		},
 	}
@@ -6,6 +6,7 @@ import (
 	"slices"
 
 	modulev1 "cosmossdk.io/api/cosmos/epochs/module/v1"
+	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
@@ -13,6 +14,7 @@ import (
 	"cosmossdk.io/x/epochs/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdkaddress "github.com/cosmos/cosmos-sdk/types/address"
 )
 
 var _ depinject.OnePerModuleType = AppModule{}
@@ -30,9 +32,10 @@ func init() {
 type ModuleInputs struct {
 	depinject.In
 
-	Config      *modulev1.Module
-	Cdc         codec.Codec
-	Environment appmodule.Environment
+	Config       *modulev1.Module
+	Cdc          codec.Codec
+	Environment  appmodule.Environment
+	AddressCodec address.Codec
 }
 
 type ModuleOutputs struct {
@@ -43,7 +46,23 @@ type ModuleOutputs struct {
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
-	k := keeper.NewKeeper(in.Environment, in.Cdc)
+	// default to governance authority if not provided
+	authority := sdkaddress.Module("gov")
+	if in.Config.Authority != "" {
+		bz, err := in.AddressCodec.StringToBytes(in.Config.Authority)
+		if err != nil {
+			authority = sdkaddress.Module(in.Config.Authority)
+		} else {
+			authority = bz
+		}
+	}
+
+	authorityAddr, err := in.AddressCodec.BytesToString(authority)
+	if err != nil {
+		panic(err)
+	}
+
+	k := keeper.NewKeeper(in.Environment, in.Cdc, authorityAddr)
 	m := NewAppModule(in.Cdc, k)
 	return ModuleOutputs{EpochKeeper: k, Module: m}
 }
@@ -55,16 +74,17 @@ func InvokeSetHooks(keeper *keeper.Keeper, hooks map[string]types.EpochHooksWrap
 
 	// Default ordering is lexical by module name.
 	// Explicit ordering can be added to the module config if required.
+	// Each module subscribes separately, a failing module hook not reverting the others.
 	modNames := slices.Sorted(maps.Keys(hooks))
-	var multiHooks types.MultiEpochHooks
+	var subscribers []types.EpochHooksSubscriber
 	for _, modName := range modNames {
 		hook, ok := hooks[modName]
 		if !ok {
 			return fmt.Errorf("can't find epoch hooks for module %s", modName)
 		}
-		multiHooks = append(multiHooks, hook)
+		subscribers = append(subscribers, types.EpochHooksSubscriber{Name: modName, EpochHooks: hook})
 	}
 
-	keeper.SetHooks(multiHooks)
+	keeper.SetSubscribers(subscribers...)
 	return nil
 }
@@ -46,12 +46,9 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 					return false, nil
 				}
 
-				if err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
-					return k.AfterEpochEnd(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
-				}); err != nil {
-					// purposely ignoring the error here not to halt the chain if the hook fails
-					k.Logger.Error(fmt.Sprintf("Error after epoch end with identifier %s epoch number %d", epochInfo.Identifier, epochInfo.CurrentEpoch))
-				}
+				// purposely ignoring the error here not to halt the chain if a hook fails, the
+				// failing subscribers being reverted and logged
+				_ = k.AfterEpochEnd(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
 
 				epochInfo.CurrentEpoch += 1
 				epochInfo.CurrentEpochStartTime = epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
@@ -71,12 +68,9 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 				k.Logger.Error(fmt.Sprintf("Error set epoch info with identifier %s epoch number %d", epochInfo.Identifier, epochInfo.CurrentEpoch))
 				return false, nil
 			}
-			if err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
-				return k.BeforeEpochStart(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
-			}); err != nil {
-				// purposely ignoring the error here not to halt the chain if the hook fails
-				k.Logger.Error(fmt.Sprintf("Error before epoch start with identifier %s epoch number %d", epochInfo.Identifier, epochInfo.CurrentEpoch))
-			}
+			// purposely ignoring the error here not to halt the chain if a hook fails, the
+			// failing subscribers being reverted and logged
+			_ = k.BeforeEpochStart(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
 			return false, nil
 		},
 	)
@@ -59,3 +59,17 @@ func (k Keeper) NumBlocksSinceEpochStart(ctx context.Context, identifier string)
 	}
 	return k.HeaderService.HeaderInfo(ctx).Height - epoch.CurrentEpochStartHeight, nil
 }
+
+// RemoveEpochInfo removes the epoch info of an identifier. The modules scheduling
+// operations with the identifier are not triggered anymore.
+func (k Keeper) RemoveEpochInfo(ctx context.Context, identifier string) error {
+	isExist, err := k.EpochInfo.Has(ctx, identifier)
+	if err != nil {
+		return err
+	}
+	if !isExist {
+		return fmt.Errorf("epoch with identifier %s not found", identifier)
+	}
+
+	return k.EpochInfo.Remove(ctx, identifier)
+}
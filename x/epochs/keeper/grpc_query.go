@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"errors"
+	"slices"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -12,6 +13,9 @@ import (
 
 var _ types.QueryServer = Querier{}
 
+// maxUpcomingEpochStarts is the maximum number of upcoming epoch starts per identifier.
+const maxUpcomingEpochStarts = 100
+
 // Querier defines a wrapper around the x/epochs keeper providing gRPC method
 // handlers.
 type Querier struct {
@@ -49,3 +53,44 @@ func (q Querier) CurrentEpoch(ctx context.Context, req *types.QueryCurrentEpochR
 		CurrentEpoch: info.CurrentEpoch,
 	}, nil
 }
+
+// UpcomingEpochStarts provides the start times of the next epochs, of all identifiers or
+// of specified identifier, ordered by start time.
+func (q Querier) UpcomingEpochStarts(ctx context.Context, req *types.QueryUpcomingEpochStartsRequest) (*types.QueryUpcomingEpochStartsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	count := req.Count
+	if count == 0 {
+		count = 1
+	}
+	if count > maxUpcomingEpochStarts {
+		return nil, status.Errorf(codes.InvalidArgument, "count must not exceed %d", maxUpcomingEpochStarts)
+	}
+
+	var epochs []types.EpochInfo
+	if req.Identifier != "" {
+		info, err := q.Keeper.EpochInfo.Get(ctx, req.Identifier)
+		if err != nil {
+			return nil, errors.New("not available identifier")
+		}
+		epochs = append(epochs, info)
+	} else {
+		var err error
+		if epochs, err = q.Keeper.AllEpochInfos(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	var starts []types.UpcomingEpochStart
+	for _, epoch := range epochs {
+		starts = append(starts, epoch.UpcomingStarts(count)...)
+	}
+
+	slices.SortStableFunc(starts, func(a, b types.UpcomingEpochStart) int {
+		return a.StartTime.Compare(b.StartTime)
+	})
+
+	return &types.QueryUpcomingEpochStartsResponse{EpochStarts: starts}, nil
+}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	"cosmossdk.io/x/epochs/types"
 )
 
@@ -20,3 +22,34 @@ func (s *KeeperTestSuite) TestQueryEpochInfos() {
 
 	s.Require().Equal(expectedEpochs, epochInfosResponse.Epochs)
 }
+
+func (s *KeeperTestSuite) TestQueryUpcomingEpochStarts() {
+	s.SetupTest()
+	queryClient := s.queryClient
+	startTime := s.Ctx.BlockTime()
+
+	// epochs which have not started yet start at their start time
+	res, err := queryClient.UpcomingEpochStarts(s.Ctx, &types.QueryUpcomingEpochStartsRequest{Identifier: "hour", Count: 3})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.UpcomingEpochStart{
+		{Identifier: "hour", EpochNumber: 1, StartTime: startTime},
+		{Identifier: "hour", EpochNumber: 2, StartTime: startTime.Add(time.Hour)},
+		{Identifier: "hour", EpochNumber: 3, StartTime: startTime.Add(2 * time.Hour)},
+	}, res.EpochStarts)
+
+	// started epochs start every duration after the current epoch start time
+	s.Require().NoError(s.EpochsKeeper.BeginBlocker(s.Ctx))
+	res, err = queryClient.UpcomingEpochStarts(s.Ctx, &types.QueryUpcomingEpochStartsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.UpcomingEpochStart{
+		{Identifier: "minute", EpochNumber: 2, StartTime: startTime.Add(time.Minute)},
+		{Identifier: "hour", EpochNumber: 2, StartTime: startTime.Add(time.Hour)},
+		{Identifier: "day", EpochNumber: 2, StartTime: startTime.Add(24 * time.Hour)},
+		{Identifier: "week", EpochNumber: 2, StartTime: startTime.Add(7 * 24 * time.Hour)},
+	}, res.EpochStarts)
+
+	_, err = queryClient.UpcomingEpochStarts(s.Ctx, &types.QueryUpcomingEpochStartsRequest{Identifier: "month"})
+	s.Require().Error(err)
+	_, err = queryClient.UpcomingEpochStarts(s.Ctx, &types.QueryUpcomingEpochStartsRequest{Count: 101})
+	s.Require().Error(err)
+}
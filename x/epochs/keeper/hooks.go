@@ -2,26 +2,50 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"cosmossdk.io/x/epochs/types"
 )
 
 // Hooks gets the hooks for governance Keeper
 func (k Keeper) Hooks() types.EpochHooks {
-	if k.hooks == nil {
-		// return a no-op implementation if no hooks are set
-		return types.MultiEpochHooks{}
+	hooks := make(types.MultiEpochHooks, 0, len(k.subscribers))
+	for _, subscriber := range k.subscribers {
+		hooks = append(hooks, subscriber.EpochHooks)
 	}
 
-	return k.hooks
+	return hooks
 }
 
 // AfterEpochEnd gets called at the end of the epoch, end of epoch is the timestamp of first block produced after epoch duration.
+// Each subscriber is called in its own branch: the state changes of a failing subscriber are reverted, without
+// reverting the other subscribers. The errors of the failing subscribers are returned.
 func (k Keeper) AfterEpochEnd(ctx context.Context, identifier string, epochNumber int64) error {
-	return k.Hooks().AfterEpochEnd(ctx, identifier, epochNumber)
+	return k.callSubscribers(ctx, "after epoch end", identifier, epochNumber, func(ctx context.Context, hooks types.EpochHooks) error {
+		return hooks.AfterEpochEnd(ctx, identifier, epochNumber)
+	})
 }
 
 // BeforeEpochStart new epoch is next block of epoch end block
+// Each subscriber is called in its own branch: the state changes of a failing subscriber are reverted, without
+// reverting the other subscribers. The errors of the failing subscribers are returned.
 func (k Keeper) BeforeEpochStart(ctx context.Context, identifier string, epochNumber int64) error {
-	return k.Hooks().BeforeEpochStart(ctx, identifier, epochNumber)
+	return k.callSubscribers(ctx, "before epoch start", identifier, epochNumber, func(ctx context.Context, hooks types.EpochHooks) error {
+		return hooks.BeforeEpochStart(ctx, identifier, epochNumber)
+	})
+}
+
+func (k Keeper) callSubscribers(ctx context.Context, hook, identifier string, epochNumber int64, call func(context.Context, types.EpochHooks) error) error {
+	var errs error
+	for _, subscriber := range k.subscribers {
+		if err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
+			return call(ctx, subscriber.EpochHooks)
+		}); err != nil {
+			k.Logger.Error(fmt.Sprintf("Error %s with identifier %s epoch number %d", hook, identifier, epochNumber), "subscriber", subscriber.Name, "error", err)
+			errs = errors.Join(errs, fmt.Errorf("%s: %w", subscriber.Name, err))
+		}
+	}
+
+	return errs
 }
@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	epochskeeper "cosmossdk.io/x/epochs/keeper"
+	"cosmossdk.io/x/epochs/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+// storeEpochHook records the epochs it is called for in the store, before failing if
+// shouldError is set.
+type storeEpochHook struct {
+	keeper      *epochskeeper.Keeper
+	name        string
+	shouldError bool
+}
+
+func (h storeEpochHook) AfterEpochEnd(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
+
+func (h storeEpochHook) BeforeEpochStart(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	if err := h.keeper.EpochInfo.Set(ctx, h.name, types.NewGenesisEpochInfo(h.name, time.Hour)); err != nil {
+		return err
+	}
+
+	if h.shouldError {
+		return errors.New("hook failed")
+	}
+
+	return nil
+}
+
+func (s *KeeperTestSuite) TestHooksSubscribersIsolation() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
+	k := epochskeeper.NewKeeper(s.environment, encCfg.Codec, authority)
+	k.SetSubscribers(
+		types.EpochHooksSubscriber{Name: "a", EpochHooks: storeEpochHook{keeper: k, name: "a"}},
+		types.EpochHooksSubscriber{Name: "b", EpochHooks: storeEpochHook{keeper: k, name: "b", shouldError: true}},
+		types.EpochHooksSubscriber{Name: "c", EpochHooks: storeEpochHook{keeper: k, name: "c"}},
+	)
+	s.Require().Panics(func() { k.SetHooks(types.NewMultiEpochHooks()) })
+
+	// the failing subscriber is reverted, without reverting the others
+	err := k.BeforeEpochStart(s.Ctx, "day", 1)
+	s.Require().ErrorContains(err, "b: hook failed")
+
+	for name, expected := range map[string]bool{"a": true, "b": false, "c": true} {
+		has, err := k.EpochInfo.Has(s.Ctx, name)
+		s.Require().NoError(err)
+		s.Require().Equal(expected, has, name)
+	}
+}
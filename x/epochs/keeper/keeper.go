@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/x/epochs/types"
@@ -11,19 +13,24 @@ import (
 type Keeper struct {
 	appmodule.Environment
 
-	cdc   codec.BinaryCodec
-	hooks types.EpochHooks
+	cdc         codec.BinaryCodec
+	subscribers []types.EpochHooksSubscriber
+
+	// the address capable of executing governance messages. Typically, this
+	// should be the x/gov module account.
+	authority string
 
 	Schema    collections.Schema
 	EpochInfo collections.Map[string, types.EpochInfo]
 }
 
 // NewKeeper returns a new keeper by codec and storeKey inputs.
-func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec) *Keeper {
+func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, authority string) *Keeper {
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 	k := Keeper{
 		Environment: env,
 		cdc:         cdc,
+		authority:   authority,
 		EpochInfo:   collections.NewMap(sb, types.KeyPrefixEpoch, "epoch_info", collections.StringKey, codec.CollValue[types.EpochInfo](cdc)),
 	}
 
@@ -35,13 +42,38 @@ func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec) *Keeper {
 	return &k
 }
 
-// Set the gamm hooks.
+// GetAuthority returns the x/epochs module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Set the gamm hooks. The hooks of a MultiEpochHooks are subscribed separately, a
+// failing hook not reverting the others.
 func (k *Keeper) SetHooks(eh types.EpochHooks) *Keeper {
-	if k.hooks != nil {
+	if k.subscribers != nil {
+		panic("cannot set epochs hooks twice")
+	}
+
+	k.subscribers = []types.EpochHooksSubscriber{}
+	if multiHooks, ok := eh.(types.MultiEpochHooks); ok {
+		for _, hooks := range multiHooks {
+			k.subscribers = append(k.subscribers, types.EpochHooksSubscriber{Name: fmt.Sprintf("%T", hooks), EpochHooks: hooks})
+		}
+	} else {
+		k.subscribers = append(k.subscribers, types.EpochHooksSubscriber{Name: fmt.Sprintf("%T", eh), EpochHooks: eh})
+	}
+
+	return k
+}
+
+// SetSubscribers sets the epoch hooks subscribers, called in order. A failing subscriber
+// doesn't revert the others.
+func (k *Keeper) SetSubscribers(subscribers ...types.EpochHooksSubscriber) *Keeper {
+	if k.subscribers != nil {
 		panic("cannot set epochs hooks twice")
 	}
 
-	k.hooks = eh
+	k.subscribers = append([]types.EpochHooksSubscriber{}, subscribers...)
 
 	return k
 }
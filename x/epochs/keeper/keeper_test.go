@@ -21,8 +21,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
+var authority = authtypes.NewModuleAddress("gov").String()
+
 type KeeperTestSuite struct {
 	suite.Suite
 	Ctx          sdk.Context
@@ -62,6 +65,7 @@ func Setup(t *testing.T) (sdk.Context, *epochskeeper.Keeper, appmodule.Environme
 	epochsKeeper := epochskeeper.NewKeeper(
 		environment,
 		encCfg.Codec,
+		authority,
 	)
 	epochsKeeper = epochsKeeper.SetHooks(types.NewMultiEpochHooks())
 	ctx.WithHeaderInfo(header.Info{Height: 1, Time: time.Now().UTC(), ChainID: "epochs"})
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/x/epochs/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/epochs MsgServer interface.
+func NewMsgServerImpl(k *Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// AddEpochIdentifier adds an epoch identifier.
+func (ms msgServer) AddEpochIdentifier(ctx context.Context, msg *types.MsgAddEpochIdentifier) (*types.MsgAddEpochIdentifierResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	epoch := types.NewGenesisEpochInfo(msg.Identifier, msg.Duration)
+	epoch.StartTime = msg.StartTime
+	if err := ms.AddEpochInfo(ctx, epoch); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddEpochIdentifierResponse{}, nil
+}
+
+// RemoveEpochIdentifier removes an epoch identifier.
+func (ms msgServer) RemoveEpochIdentifier(ctx context.Context, msg *types.MsgRemoveEpochIdentifier) (*types.MsgRemoveEpochIdentifierResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := ms.RemoveEpochInfo(ctx, msg.Identifier); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveEpochIdentifierResponse{}, nil
+}
@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"time"
+
+	epochskeeper "cosmossdk.io/x/epochs/keeper"
+	"cosmossdk.io/x/epochs/types"
+)
+
+func (s *KeeperTestSuite) TestMsgAddRemoveEpochIdentifier() {
+	msgServer := epochskeeper.NewMsgServerImpl(s.EpochsKeeper)
+
+	_, err := msgServer.AddEpochIdentifier(s.Ctx, &types.MsgAddEpochIdentifier{Authority: "invalid", Identifier: "month", Duration: 30 * 24 * time.Hour})
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	_, err = msgServer.AddEpochIdentifier(s.Ctx, &types.MsgAddEpochIdentifier{Authority: authority, Identifier: "month"})
+	s.Require().ErrorContains(err, "duration should NOT be 0")
+
+	_, err = msgServer.AddEpochIdentifier(s.Ctx, &types.MsgAddEpochIdentifier{Authority: authority, Identifier: "day", Duration: time.Hour})
+	s.Require().ErrorContains(err, "already exists")
+
+	_, err = msgServer.AddEpochIdentifier(s.Ctx, &types.MsgAddEpochIdentifier{Authority: authority, Identifier: "month", Duration: 30 * 24 * time.Hour})
+	s.Require().NoError(err)
+
+	epoch, err := s.EpochsKeeper.EpochInfo.Get(s.Ctx, "month")
+	s.Require().NoError(err)
+	s.Require().Equal(s.Ctx.HeaderInfo().Time, epoch.StartTime)
+	s.Require().Equal(30*24*time.Hour, epoch.Duration)
+	s.Require().False(epoch.EpochCountingStarted)
+
+	_, err = msgServer.RemoveEpochIdentifier(s.Ctx, &types.MsgRemoveEpochIdentifier{Authority: "invalid", Identifier: "month"})
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	_, err = msgServer.RemoveEpochIdentifier(s.Ctx, &types.MsgRemoveEpochIdentifier{Authority: authority, Identifier: "month"})
+	s.Require().NoError(err)
+
+	has, err := s.EpochsKeeper.EpochInfo.Has(s.Ctx, "month")
+	s.Require().NoError(err)
+	s.Require().False(has)
+
+	_, err = msgServer.RemoveEpochIdentifier(s.Ctx, &types.MsgRemoveEpochIdentifier{Authority: authority, Identifier: "month"})
+	s.Require().ErrorContains(err, "not found")
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	"cosmossdk.io/core/registry"
+	coretransaction "cosmossdk.io/core/transaction"
+
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec
+func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
+	legacy.RegisterAminoMsg(registrar, &MsgAddEpochIdentifier{}, "cosmos-sdk/MsgAddEpochIdentifier")
+	legacy.RegisterAminoMsg(registrar, &MsgRemoveEpochIdentifier{}, "cosmos-sdk/MsgRemoveEpochIdentifier")
+}
+
+// RegisterInterfaces registers the interfaces types with the interface registry.
+func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
+	registrar.RegisterImplementations(
+		(*coretransaction.Msg)(nil),
+		&MsgAddEpochIdentifier{},
+		&MsgRemoveEpochIdentifier{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
+}
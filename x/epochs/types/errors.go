@@ -0,0 +1,6 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// ErrInvalidSigner is returned when the signer of a governance message is not the authority.
+var ErrInvalidSigner = errors.Register(ModuleName, 2, "expected authority account as only signer for proposal message")
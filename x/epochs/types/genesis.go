@@ -67,3 +67,25 @@ func NewGenesisEpochInfo(identifier string, duration time.Duration) EpochInfo {
 		EpochCountingStarted:    false,
 	}
 }
+
+// UpcomingStarts returns the next count start times of the epoch. The first epoch starts
+// at the epoch start time, and the next ones every epoch duration.
+func (epoch EpochInfo) UpcomingStarts(count uint32) []UpcomingEpochStart {
+	epochNumber, startTime := int64(1), epoch.StartTime
+	if epoch.EpochCountingStarted {
+		epochNumber, startTime = epoch.CurrentEpoch+1, epoch.CurrentEpochStartTime.Add(epoch.Duration)
+	}
+
+	starts := make([]UpcomingEpochStart, 0, count)
+	for i := uint32(0); i < count; i++ {
+		starts = append(starts, UpcomingEpochStart{
+			Identifier:  epoch.Identifier,
+			EpochNumber: epochNumber,
+			StartTime:   startTime,
+		})
+		epochNumber++
+		startTime = startTime.Add(epoch.Duration)
+	}
+
+	return starts
+}
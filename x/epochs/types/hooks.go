@@ -39,6 +39,13 @@ func (h MultiEpochHooks) BeforeEpochStart(ctx context.Context, epochIdentifier s
 	return errs
 }
 
+// EpochHooksSubscriber is an EpochHooks subscribed to the epochs module, identified by
+// its name in logs, usually the name of its module.
+type EpochHooksSubscriber struct {
+	Name string
+	EpochHooks
+}
+
 // EpochHooksWrapper is a wrapper for modules to inject EpochHooks using depinject.
 type EpochHooksWrapper struct{ EpochHooks }
 
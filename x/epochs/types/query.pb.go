@@ -6,23 +6,28 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -198,42 +203,221 @@ func (m *QueryCurrentEpochResponse) GetCurrentEpoch() int64 {
 	return 0
 }
 
+// QueryUpcomingEpochStartsRequest is the request type for the Query/UpcomingEpochStarts RPC method.
+type QueryUpcomingEpochStartsRequest struct {
+	// identifier is the epoch identifier, all the epochs are queried if empty.
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// count is the number of upcoming epoch starts per identifier, 1 if zero.
+	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *QueryUpcomingEpochStartsRequest) Reset()         { *m = QueryUpcomingEpochStartsRequest{} }
+func (m *QueryUpcomingEpochStartsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpcomingEpochStartsRequest) ProtoMessage()    {}
+func (*QueryUpcomingEpochStartsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dacbc976c75f2414, []int{4}
+}
+func (m *QueryUpcomingEpochStartsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpcomingEpochStartsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpcomingEpochStartsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpcomingEpochStartsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpcomingEpochStartsRequest.Merge(m, src)
+}
+func (m *QueryUpcomingEpochStartsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpcomingEpochStartsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpcomingEpochStartsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpcomingEpochStartsRequest proto.InternalMessageInfo
+
+func (m *QueryUpcomingEpochStartsRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *QueryUpcomingEpochStartsRequest) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// UpcomingEpochStart is the start time of an upcoming epoch. The epoch starts at the
+// first block whose block time is after the start time.
+type UpcomingEpochStart struct {
+	Identifier  string    `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	EpochNumber int64     `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	StartTime   time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+}
+
+func (m *UpcomingEpochStart) Reset()         { *m = UpcomingEpochStart{} }
+func (m *UpcomingEpochStart) String() string { return proto.CompactTextString(m) }
+func (*UpcomingEpochStart) ProtoMessage()    {}
+func (*UpcomingEpochStart) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dacbc976c75f2414, []int{5}
+}
+func (m *UpcomingEpochStart) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpcomingEpochStart) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpcomingEpochStart.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpcomingEpochStart) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpcomingEpochStart.Merge(m, src)
+}
+func (m *UpcomingEpochStart) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpcomingEpochStart) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpcomingEpochStart.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpcomingEpochStart proto.InternalMessageInfo
+
+func (m *UpcomingEpochStart) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *UpcomingEpochStart) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *UpcomingEpochStart) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+// QueryUpcomingEpochStartsResponse is the response type for the Query/UpcomingEpochStarts RPC method.
+type QueryUpcomingEpochStartsResponse struct {
+	// epoch_starts are the upcoming epoch starts, ordered by start time.
+	EpochStarts []UpcomingEpochStart `protobuf:"bytes,1,rep,name=epoch_starts,json=epochStarts,proto3" json:"epoch_starts"`
+}
+
+func (m *QueryUpcomingEpochStartsResponse) Reset()         { *m = QueryUpcomingEpochStartsResponse{} }
+func (m *QueryUpcomingEpochStartsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpcomingEpochStartsResponse) ProtoMessage()    {}
+func (*QueryUpcomingEpochStartsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dacbc976c75f2414, []int{6}
+}
+func (m *QueryUpcomingEpochStartsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpcomingEpochStartsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpcomingEpochStartsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpcomingEpochStartsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpcomingEpochStartsResponse.Merge(m, src)
+}
+func (m *QueryUpcomingEpochStartsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpcomingEpochStartsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpcomingEpochStartsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpcomingEpochStartsResponse proto.InternalMessageInfo
+
+func (m *QueryUpcomingEpochStartsResponse) GetEpochStarts() []UpcomingEpochStart {
+	if m != nil {
+		return m.EpochStarts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryEpochsInfoRequest)(nil), "cosmos.epochs.v1beta1.QueryEpochsInfoRequest")
 	proto.RegisterType((*QueryEpochsInfoResponse)(nil), "cosmos.epochs.v1beta1.QueryEpochsInfoResponse")
 	proto.RegisterType((*QueryCurrentEpochRequest)(nil), "cosmos.epochs.v1beta1.QueryCurrentEpochRequest")
 	proto.RegisterType((*QueryCurrentEpochResponse)(nil), "cosmos.epochs.v1beta1.QueryCurrentEpochResponse")
+	proto.RegisterType((*QueryUpcomingEpochStartsRequest)(nil), "cosmos.epochs.v1beta1.QueryUpcomingEpochStartsRequest")
+	proto.RegisterType((*UpcomingEpochStart)(nil), "cosmos.epochs.v1beta1.UpcomingEpochStart")
+	proto.RegisterType((*QueryUpcomingEpochStartsResponse)(nil), "cosmos.epochs.v1beta1.QueryUpcomingEpochStartsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/epochs/v1beta1/query.proto", fileDescriptor_dacbc976c75f2414) }
 
 var fileDescriptor_dacbc976c75f2414 = []byte{
-	// 396 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbf, 0x4e, 0xe3, 0x30,
-	0x1c, 0xc7, 0xe3, 0xf6, 0xae, 0xd2, 0xf9, 0x7a, 0x8b, 0x75, 0x7f, 0x72, 0xb9, 0xbb, 0xb4, 0x97,
-	0x02, 0xaa, 0x10, 0xc4, 0xb4, 0x88, 0x85, 0x01, 0xa1, 0x22, 0x06, 0x46, 0xb2, 0xc1, 0x82, 0xd2,
-	0xd4, 0x0d, 0x16, 0x60, 0xa7, 0xb1, 0x8b, 0xe8, 0xca, 0x13, 0x20, 0x78, 0x00, 0xde, 0x84, 0xb9,
-	0x63, 0x25, 0x16, 0x26, 0x84, 0x5a, 0x1e, 0x04, 0xd5, 0x36, 0x55, 0x11, 0x29, 0xea, 0x96, 0xd8,
-	0x9f, 0xef, 0x9f, 0xdf, 0x2f, 0x81, 0xff, 0x23, 0x2e, 0xce, 0xb8, 0xc0, 0x24, 0xe1, 0xd1, 0xb1,
-	0xc0, 0xe7, 0xb5, 0x26, 0x91, 0x61, 0x0d, 0x77, 0xba, 0x24, 0xed, 0xf9, 0x49, 0xca, 0x25, 0x47,
-	0x3f, 0x34, 0xe2, 0x6b, 0xc4, 0x37, 0x88, 0xf3, 0x3d, 0xe6, 0x31, 0x57, 0x04, 0x1e, 0x3f, 0x69,
-	0xd8, 0xf9, 0x1b, 0x73, 0x1e, 0x9f, 0x12, 0x1c, 0x26, 0x14, 0x87, 0x8c, 0x71, 0x19, 0x4a, 0xca,
-	0x99, 0x30, 0xb7, 0xcb, 0x26, 0xad, 0x19, 0x0a, 0xa2, 0x33, 0x26, 0x89, 0x49, 0x18, 0x53, 0xa6,
-	0x60, 0xc3, 0x56, 0xb2, 0x9b, 0xc5, 0x84, 0x11, 0x41, 0x8d, 0xa1, 0x67, 0xc3, 0x9f, 0xfb, 0x63,
-	0x9b, 0x5d, 0x05, 0xed, 0xb1, 0x36, 0x0f, 0x48, 0xa7, 0x4b, 0x84, 0xf4, 0x0e, 0xe0, 0xaf, 0x77,
-	0x37, 0x22, 0xe1, 0x4c, 0x10, 0xb4, 0x05, 0x0b, 0xda, 0xd4, 0x06, 0xe5, 0x7c, 0xf5, 0x6b, 0xbd,
-	0xec, 0x67, 0x4e, 0xe8, 0x2b, 0xe9, 0x58, 0xd9, 0xf8, 0xd4, 0x7f, 0x2c, 0x59, 0x81, 0x51, 0x79,
-	0x9b, 0xd0, 0x56, 0xd6, 0x3b, 0xdd, 0x34, 0x25, 0x4c, 0x2a, 0xcc, 0xc4, 0x22, 0x17, 0x42, 0xda,
-	0x22, 0x4c, 0xd2, 0x36, 0x25, 0xa9, 0x0d, 0xca, 0xa0, 0xfa, 0x25, 0x98, 0x3a, 0xf1, 0xb6, 0xe1,
-	0xef, 0x0c, 0xad, 0x29, 0x56, 0x81, 0xdf, 0x22, 0x7d, 0x7e, 0xa4, 0xa2, 0x94, 0x3e, 0x1f, 0x14,
-	0xa3, 0x29, 0xb8, 0x7e, 0x97, 0x83, 0x9f, 0x95, 0x05, 0xba, 0x06, 0x10, 0x4e, 0x3a, 0x0a, 0xb4,
-	0x3a, 0x63, 0x8c, 0xec, 0x05, 0x39, 0xfe, 0xbc, 0xb8, 0x2e, 0xe7, 0x2d, 0x5e, 0xde, 0x3f, 0xdf,
-	0xe4, 0x4a, 0xe8, 0x1f, 0xce, 0xfe, 0x30, 0xfa, 0x15, 0xdd, 0x02, 0x58, 0x9c, 0x1e, 0x0e, 0xe1,
-	0x8f, 0x72, 0x32, 0x56, 0xe8, 0xac, 0xcd, 0x2f, 0x30, 0xd5, 0x56, 0x54, 0xb5, 0x25, 0xb4, 0x30,
-	0xa3, 0xda, 0x9b, 0xa5, 0x36, 0x36, 0xfa, 0x43, 0x17, 0x0c, 0x86, 0x2e, 0x78, 0x1a, 0xba, 0xe0,
-	0x6a, 0xe4, 0x5a, 0x83, 0x91, 0x6b, 0x3d, 0x8c, 0x5c, 0xeb, 0xf0, 0x8f, 0x96, 0x8b, 0xd6, 0x89,
-	0x4f, 0x39, 0xbe, 0x78, 0xb5, 0x91, 0xbd, 0x84, 0x88, 0x66, 0x41, 0xfd, 0x71, 0xeb, 0x2f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x49, 0x28, 0xbd, 0xf7, 0x32, 0x03, 0x00, 0x00,
+	// 605 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcf, 0x6e, 0xd3, 0x3e,
+	0x1c, 0xaf, 0xd7, 0x6d, 0xfa, 0xcd, 0xed, 0x7e, 0x07, 0x33, 0x20, 0x0b, 0x90, 0x66, 0x19, 0xa0,
+	0x82, 0x36, 0xa7, 0x2b, 0x02, 0xa4, 0x1d, 0x10, 0xea, 0x04, 0x12, 0x17, 0x24, 0x02, 0x1c, 0xe0,
+	0x52, 0xa5, 0x99, 0x1b, 0xa2, 0x51, 0x3b, 0x8b, 0x1d, 0xc4, 0xae, 0x1c, 0xb9, 0x30, 0xc1, 0x03,
+	0xf0, 0x0a, 0x1c, 0x78, 0x88, 0x89, 0x03, 0x9a, 0xe0, 0xc2, 0x09, 0x50, 0xcb, 0x83, 0xa0, 0xd8,
+	0x4e, 0x55, 0xd4, 0x64, 0x94, 0x5b, 0xf2, 0xf5, 0xe7, 0x9f, 0xed, 0x8f, 0xe1, 0x5a, 0xc0, 0xf8,
+	0x80, 0x71, 0x97, 0xc4, 0x2c, 0x78, 0xc6, 0xdd, 0x17, 0x5b, 0x3d, 0x22, 0xfc, 0x2d, 0x77, 0x3f,
+	0x25, 0xc9, 0x01, 0x8e, 0x13, 0x26, 0x18, 0x3a, 0xad, 0x20, 0x58, 0x41, 0xb0, 0x86, 0x98, 0x2b,
+	0x21, 0x0b, 0x99, 0x44, 0xb8, 0xd9, 0x97, 0x02, 0x9b, 0x8d, 0x90, 0xb1, 0xf0, 0x39, 0x71, 0xe5,
+	0x5f, 0x2f, 0xed, 0xbb, 0x22, 0x1a, 0x10, 0x2e, 0xfc, 0x41, 0xac, 0x01, 0xab, 0x4a, 0xad, 0xab,
+	0x98, 0x5a, 0x5a, 0x2d, 0x9d, 0xd7, 0x5c, 0x3f, 0x8e, 0x5c, 0x9f, 0x52, 0x26, 0x7c, 0x11, 0x31,
+	0x9a, 0xaf, 0x5e, 0xd5, 0x49, 0x7b, 0x3e, 0x27, 0x2a, 0xdf, 0x38, 0x6d, 0xec, 0x87, 0x11, 0x95,
+	0x60, 0x8d, 0x5d, 0x2f, 0xde, 0x55, 0x48, 0x28, 0xe1, 0x91, 0x16, 0x74, 0x0c, 0x78, 0xe6, 0x41,
+	0x26, 0x73, 0x47, 0x82, 0xee, 0xd1, 0x3e, 0xf3, 0xc8, 0x7e, 0x4a, 0xb8, 0x70, 0x9e, 0xc0, 0xb3,
+	0x53, 0x2b, 0x3c, 0x66, 0x94, 0x13, 0x74, 0x0b, 0x2e, 0x2a, 0x51, 0x03, 0xd8, 0xd5, 0x66, 0xad,
+	0x6d, 0xe3, 0xc2, 0xd3, 0xc1, 0x92, 0x9a, 0x31, 0x3b, 0xf3, 0x47, 0xdf, 0x1b, 0x15, 0x4f, 0xb3,
+	0x9c, 0x6d, 0x68, 0x48, 0xe9, 0x9d, 0x34, 0x49, 0x08, 0x15, 0x12, 0xa6, 0x6d, 0x91, 0x05, 0x61,
+	0xb4, 0x4b, 0xa8, 0x88, 0xfa, 0x11, 0x49, 0x0c, 0x60, 0x83, 0xe6, 0x92, 0x37, 0x31, 0x71, 0x6e,
+	0xc3, 0xd5, 0x02, 0xae, 0x0e, 0xb6, 0x0e, 0x97, 0x03, 0x35, 0xef, 0x4a, 0x2b, 0xc9, 0xaf, 0x7a,
+	0xf5, 0x60, 0x02, 0xec, 0xec, 0xc1, 0x86, 0x54, 0x78, 0x1c, 0x07, 0x6c, 0x10, 0xd1, 0x50, 0x4e,
+	0x1f, 0x0a, 0x3f, 0x11, 0x7c, 0xc6, 0x10, 0x68, 0x05, 0x2e, 0x04, 0x2c, 0xa5, 0xc2, 0x98, 0xb3,
+	0x41, 0x73, 0xd9, 0x53, 0x3f, 0xdb, 0xe8, 0xcb, 0xc7, 0xcd, 0xff, 0x5f, 0xea, 0xf3, 0xb6, 0x5b,
+	0xb8, 0x8d, 0x5b, 0xce, 0x07, 0x00, 0xd1, 0xb4, 0xd1, 0x5f, 0x0d, 0xd6, 0x60, 0x5d, 0xca, 0x74,
+	0x69, 0x3a, 0xe8, 0x91, 0x44, 0xfa, 0x54, 0xbd, 0x9a, 0x9c, 0xdd, 0x97, 0x23, 0xb4, 0x03, 0x21,
+	0xcf, 0xb4, 0xba, 0x59, 0xb9, 0x8c, 0xaa, 0x0d, 0x9a, 0xb5, 0xb6, 0x89, 0x55, 0x7b, 0x70, 0xde,
+	0x3c, 0xfc, 0x28, 0x6f, 0x5e, 0xe7, 0xbf, 0xec, 0x0a, 0x0e, 0x7f, 0x34, 0x80, 0xb7, 0x24, 0x79,
+	0xd9, 0x4a, 0x61, 0xe4, 0xd7, 0x00, 0xda, 0xe5, 0x07, 0xa4, 0x4f, 0xda, 0xcb, 0x03, 0x4a, 0xad,
+	0xbc, 0x08, 0x57, 0x4a, 0x8a, 0x30, 0xad, 0xa4, 0x1b, 0x51, 0x23, 0xe3, 0x09, 0x2f, 0x0a, 0xd3,
+	0x7e, 0x33, 0x0f, 0x17, 0x64, 0x18, 0xf4, 0x16, 0x40, 0x38, 0x2e, 0x14, 0x47, 0x9b, 0x25, 0x56,
+	0xc5, 0x6d, 0x36, 0xf1, 0xac, 0x70, 0xb5, 0x3f, 0xe7, 0xd2, 0xab, 0xaf, 0xbf, 0xde, 0xcd, 0x35,
+	0xd0, 0x05, 0xb7, 0xf8, 0x15, 0xa9, 0x5f, 0xf4, 0x1e, 0xc0, 0xfa, 0x64, 0x13, 0x91, 0x7b, 0x92,
+	0x4f, 0x41, 0xdf, 0xcd, 0xd6, 0xec, 0x04, 0x1d, 0x6d, 0x43, 0x46, 0xbb, 0x8c, 0x2e, 0x96, 0x44,
+	0xfb, 0xe3, 0x05, 0xa0, 0xcf, 0x00, 0x9e, 0x2a, 0xb8, 0x48, 0x74, 0xe3, 0x24, 0xdf, 0xf2, 0xa7,
+	0x61, 0xde, 0xfc, 0x67, 0x9e, 0x8e, 0x7d, 0xf7, 0xd3, 0xd4, 0xed, 0xca, 0x8d, 0x60, 0xb4, 0x51,
+	0xb2, 0x91, 0x54, 0xab, 0x75, 0x27, 0x9b, 0xd6, 0xb9, 0x7e, 0x34, 0xb4, 0xc0, 0xf1, 0xd0, 0x02,
+	0x3f, 0x87, 0x16, 0x38, 0x1c, 0x59, 0x95, 0xe3, 0x91, 0x55, 0xf9, 0x36, 0xb2, 0x2a, 0x4f, 0xcf,
+	0x29, 0x19, 0xbe, 0xbb, 0x87, 0x23, 0xe6, 0xe6, 0x56, 0xae, 0x38, 0x88, 0x09, 0xef, 0x2d, 0xca,
+	0x27, 0x71, 0xed, 0xf7, 0x00, 0xc8, 0x9f, 0x62, 0x36, 0xec, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -252,6 +436,9 @@ type QueryClient interface {
 	EpochInfos(ctx context.Context, in *QueryEpochsInfoRequest, opts ...grpc.CallOption) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
+	// UpcomingEpochStarts provide the start times of the next epochs, of all identifiers
+	// or of a specified identifier
+	UpcomingEpochStarts(ctx context.Context, in *QueryUpcomingEpochStartsRequest, opts ...grpc.CallOption) (*QueryUpcomingEpochStartsResponse, error)
 }
 
 type queryClient struct {
@@ -280,12 +467,24 @@ func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochReq
 	return out, nil
 }
 
+func (c *queryClient) UpcomingEpochStarts(ctx context.Context, in *QueryUpcomingEpochStartsRequest, opts ...grpc.CallOption) (*QueryUpcomingEpochStartsResponse, error) {
+	out := new(QueryUpcomingEpochStartsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.epochs.v1beta1.Query/UpcomingEpochStarts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// EpochInfos provide running epochInfos
 	EpochInfos(context.Context, *QueryEpochsInfoRequest) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
+	// UpcomingEpochStarts provide the start times of the next epochs, of all identifiers
+	// or of a specified identifier
+	UpcomingEpochStarts(context.Context, *QueryUpcomingEpochStartsRequest) (*QueryUpcomingEpochStartsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -298,6 +497,9 @@ func (*UnimplementedQueryServer) EpochInfos(ctx context.Context, req *QueryEpoch
 func (*UnimplementedQueryServer) CurrentEpoch(ctx context.Context, req *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
 }
+func (*UnimplementedQueryServer) UpcomingEpochStarts(ctx context.Context, req *QueryUpcomingEpochStartsRequest) (*QueryUpcomingEpochStartsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpcomingEpochStarts not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -339,6 +541,24 @@ func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UpcomingEpochStarts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpcomingEpochStartsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpcomingEpochStarts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.epochs.v1beta1.Query/UpcomingEpochStarts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpcomingEpochStarts(ctx, req.(*QueryUpcomingEpochStartsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.epochs.v1beta1.Query",
@@ -352,6 +572,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CurrentEpoch",
 			Handler:    _Query_CurrentEpoch_Handler,
 		},
+		{
+			MethodName: "UpcomingEpochStarts",
+			Handler:    _Query_UpcomingEpochStarts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/epochs/v1beta1/query.proto",
@@ -475,6 +699,121 @@ func (m *QueryCurrentEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryUpcomingEpochStartsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpcomingEpochStartsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpcomingEpochStartsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpcomingEpochStart) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpcomingEpochStart) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpcomingEpochStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintQuery(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpcomingEpochStartsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpcomingEpochStartsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpcomingEpochStartsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochStarts) > 0 {
+		for iNdEx := len(m.EpochStarts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochStarts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -535,6 +874,55 @@ func (m *QueryCurrentEpochResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryUpcomingEpochStartsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *UpcomingEpochStart) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryUpcomingEpochStartsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EpochStarts) > 0 {
+		for _, e := range m.EpochStarts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -826,6 +1214,325 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryUpcomingEpochStartsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpcomingEpochStartsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpcomingEpochStartsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpcomingEpochStart) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpcomingEpochStart: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpcomingEpochStart: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpcomingEpochStartsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpcomingEpochStartsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpcomingEpochStartsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStarts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochStarts = append(m.EpochStarts, UpcomingEpochStart{})
+			if err := m.EpochStarts[len(m.EpochStarts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
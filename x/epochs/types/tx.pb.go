@@ -0,0 +1,1100 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/epochs/v1beta1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgAddEpochIdentifier is the Msg/AddEpochIdentifier request type.
+type MsgAddEpochIdentifier struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// identifier is the unique identifier of the epoch.
+	Identifier string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// start_time is the time at which the epoch first ticks. If unset, it is the
+	// block time of the execution of the message.
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// duration is the time in between epoch ticks, it must be non-zero.
+	Duration time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration"`
+}
+
+func (m *MsgAddEpochIdentifier) Reset()         { *m = MsgAddEpochIdentifier{} }
+func (m *MsgAddEpochIdentifier) String() string { return proto.CompactTextString(m) }
+func (*MsgAddEpochIdentifier) ProtoMessage()    {}
+func (*MsgAddEpochIdentifier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9df40940234702e, []int{0}
+}
+func (m *MsgAddEpochIdentifier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddEpochIdentifier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddEpochIdentifier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddEpochIdentifier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddEpochIdentifier.Merge(m, src)
+}
+func (m *MsgAddEpochIdentifier) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddEpochIdentifier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddEpochIdentifier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddEpochIdentifier proto.InternalMessageInfo
+
+func (m *MsgAddEpochIdentifier) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgAddEpochIdentifier) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *MsgAddEpochIdentifier) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgAddEpochIdentifier) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+// MsgAddEpochIdentifierResponse defines the response structure for executing a
+// MsgAddEpochIdentifier message.
+type MsgAddEpochIdentifierResponse struct {
+}
+
+func (m *MsgAddEpochIdentifierResponse) Reset()         { *m = MsgAddEpochIdentifierResponse{} }
+func (m *MsgAddEpochIdentifierResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddEpochIdentifierResponse) ProtoMessage()    {}
+func (*MsgAddEpochIdentifierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9df40940234702e, []int{1}
+}
+func (m *MsgAddEpochIdentifierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddEpochIdentifierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddEpochIdentifierResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddEpochIdentifierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddEpochIdentifierResponse.Merge(m, src)
+}
+func (m *MsgAddEpochIdentifierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddEpochIdentifierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddEpochIdentifierResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddEpochIdentifierResponse proto.InternalMessageInfo
+
+// MsgRemoveEpochIdentifier is the Msg/RemoveEpochIdentifier request type.
+type MsgRemoveEpochIdentifier struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// identifier is the identifier of the epoch to remove.
+	Identifier string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+}
+
+func (m *MsgRemoveEpochIdentifier) Reset()         { *m = MsgRemoveEpochIdentifier{} }
+func (m *MsgRemoveEpochIdentifier) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveEpochIdentifier) ProtoMessage()    {}
+func (*MsgRemoveEpochIdentifier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9df40940234702e, []int{2}
+}
+func (m *MsgRemoveEpochIdentifier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveEpochIdentifier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveEpochIdentifier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveEpochIdentifier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveEpochIdentifier.Merge(m, src)
+}
+func (m *MsgRemoveEpochIdentifier) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveEpochIdentifier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveEpochIdentifier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveEpochIdentifier proto.InternalMessageInfo
+
+func (m *MsgRemoveEpochIdentifier) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveEpochIdentifier) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+// MsgRemoveEpochIdentifierResponse defines the response structure for executing a
+// MsgRemoveEpochIdentifier message.
+type MsgRemoveEpochIdentifierResponse struct {
+}
+
+func (m *MsgRemoveEpochIdentifierResponse) Reset()         { *m = MsgRemoveEpochIdentifierResponse{} }
+func (m *MsgRemoveEpochIdentifierResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveEpochIdentifierResponse) ProtoMessage()    {}
+func (*MsgRemoveEpochIdentifierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9df40940234702e, []int{3}
+}
+func (m *MsgRemoveEpochIdentifierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveEpochIdentifierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveEpochIdentifierResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveEpochIdentifierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveEpochIdentifierResponse.Merge(m, src)
+}
+func (m *MsgRemoveEpochIdentifierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveEpochIdentifierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveEpochIdentifierResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveEpochIdentifierResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgAddEpochIdentifier)(nil), "cosmos.epochs.v1beta1.MsgAddEpochIdentifier")
+	proto.RegisterType((*MsgAddEpochIdentifierResponse)(nil), "cosmos.epochs.v1beta1.MsgAddEpochIdentifierResponse")
+	proto.RegisterType((*MsgRemoveEpochIdentifier)(nil), "cosmos.epochs.v1beta1.MsgRemoveEpochIdentifier")
+	proto.RegisterType((*MsgRemoveEpochIdentifierResponse)(nil), "cosmos.epochs.v1beta1.MsgRemoveEpochIdentifierResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/epochs/v1beta1/tx.proto", fileDescriptor_a9df40940234702e) }
+
+var fileDescriptor_a9df40940234702e = []byte{
+	// 499 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0x31, 0x6f, 0xd3, 0x40,
+	0x18, 0xcd, 0xa5, 0x80, 0xc8, 0x21, 0x90, 0xb0, 0x1a, 0xe1, 0x1a, 0x71, 0xb1, 0xc2, 0x52, 0x45,
+	0xe4, 0xae, 0x49, 0xa1, 0x48, 0xd9, 0x1a, 0xb5, 0x52, 0x19, 0xb2, 0x18, 0x26, 0x96, 0xca, 0xa9,
+	0xaf, 0xd7, 0x53, 0xb1, 0xcf, 0xf2, 0x5d, 0xa2, 0x74, 0x03, 0xc6, 0x4e, 0x1d, 0xf9, 0x09, 0x8c,
+	0x19, 0x3a, 0x32, 0xa3, 0x8e, 0x55, 0x27, 0x26, 0x40, 0xc9, 0x90, 0xbf, 0x81, 0xec, 0x3b, 0xa7,
+	0x40, 0x5d, 0x44, 0x96, 0x2e, 0x89, 0x7d, 0xef, 0x7d, 0xef, 0xfb, 0xde, 0xbb, 0xcf, 0x10, 0xed,
+	0x09, 0x19, 0x0a, 0x49, 0x68, 0x2c, 0xf6, 0x0e, 0x24, 0x19, 0xb6, 0xfa, 0x54, 0xf9, 0x2d, 0xa2,
+	0x46, 0x38, 0x4e, 0x84, 0x12, 0x56, 0x55, 0xe3, 0x58, 0xe3, 0xd8, 0xe0, 0xce, 0x32, 0x13, 0x4c,
+	0x64, 0x0c, 0x92, 0x3e, 0x69, 0xb2, 0x83, 0x98, 0x10, 0xec, 0x1d, 0x25, 0xd9, 0x5b, 0x7f, 0xb0,
+	0x4f, 0x82, 0x41, 0xe2, 0x2b, 0x2e, 0x22, 0x83, 0xd7, 0xfe, 0xc6, 0x15, 0x0f, 0xa9, 0x54, 0x7e,
+	0x18, 0x1b, 0xc2, 0x8a, 0xee, 0xb6, 0xab, 0x95, 0x4d, 0x6b, 0x0d, 0x3d, 0x32, 0x83, 0x86, 0x92,
+	0x91, 0x61, 0x2b, 0xfd, 0x33, 0xc0, 0x43, 0x3f, 0xe4, 0x91, 0x20, 0xd9, 0xaf, 0x3e, 0xaa, 0x7f,
+	0x2d, 0xc3, 0x6a, 0x4f, 0xb2, 0xcd, 0x20, 0xd8, 0x4e, 0xc7, 0x7e, 0x15, 0xd0, 0x48, 0xf1, 0x7d,
+	0x4e, 0x13, 0x6b, 0x03, 0x56, 0xfc, 0x81, 0x3a, 0x10, 0x09, 0x57, 0x47, 0x36, 0x70, 0xc1, 0x6a,
+	0xa5, 0x6b, 0x5f, 0x9c, 0x36, 0x97, 0x4d, 0xab, 0xcd, 0x20, 0x48, 0xa8, 0x94, 0xaf, 0x55, 0xc2,
+	0x23, 0xe6, 0x5d, 0x52, 0x2d, 0x04, 0x21, 0x9f, 0xab, 0xd8, 0xe5, 0xb4, 0xd0, 0xfb, 0xed, 0xc4,
+	0xda, 0x81, 0x50, 0x2a, 0x3f, 0x51, 0xbb, 0xa9, 0x23, 0x7b, 0xc9, 0x05, 0xab, 0xf7, 0xda, 0x0e,
+	0xd6, 0x76, 0x71, 0x6e, 0x17, 0xbf, 0xc9, 0xed, 0x76, 0xef, 0x9f, 0x7d, 0xaf, 0x95, 0x4e, 0x7e,
+	0xd4, 0xc0, 0xe7, 0xd9, 0xb8, 0x01, 0xbc, 0x4a, 0x56, 0x9c, 0xc2, 0xd6, 0x16, 0xbc, 0x9b, 0xa7,
+	0x66, 0xdf, 0xca, 0x74, 0x56, 0xae, 0xe8, 0x6c, 0x19, 0x82, 0x96, 0xf9, 0x34, 0x97, 0x99, 0x57,
+	0x76, 0xb6, 0x2f, 0x4e, 0x9b, 0x0f, 0x46, 0xe6, 0x56, 0xdd, 0x35, 0xdc, 0xc6, 0x6b, 0x1f, 0x67,
+	0xe3, 0xc6, 0xa5, 0xa3, 0xe3, 0xd9, 0xb8, 0xe1, 0x6a, 0xd7, 0x4d, 0x19, 0x1c, 0x92, 0xc2, 0xb8,
+	0xea, 0xeb, 0xf0, 0x49, 0x21, 0xe0, 0x51, 0x19, 0x8b, 0x48, 0xd2, 0x8e, 0x75, 0xb5, 0x4f, 0xfd,
+	0x0b, 0x80, 0x76, 0x4f, 0x32, 0x8f, 0x86, 0x62, 0x48, 0x6f, 0xe8, 0x02, 0x3a, 0x3b, 0xff, 0x63,
+	0xf8, 0xe9, 0x9f, 0x86, 0x0b, 0x27, 0xac, 0x6f, 0x40, 0xf7, 0x3a, 0xec, 0x5f, 0xb6, 0xdb, 0xc7,
+	0x65, 0xb8, 0xd4, 0x93, 0xcc, 0x1a, 0x41, 0xab, 0x60, 0xf1, 0x9e, 0xe1, 0xc2, 0x0f, 0x09, 0x17,
+	0xc6, 0xeb, 0x3c, 0x5f, 0x84, 0x9d, 0x4f, 0x65, 0x7d, 0x00, 0xb0, 0x5a, 0x9c, 0x3a, 0xb9, 0x5e,
+	0xaf, 0xb0, 0xc0, 0x79, 0xb9, 0x60, 0x41, 0x3e, 0x83, 0x73, 0xfb, 0x7d, 0xba, 0x89, 0xdd, 0x17,
+	0x67, 0x13, 0x04, 0xce, 0x27, 0x08, 0xfc, 0x9c, 0x20, 0x70, 0x32, 0x45, 0xa5, 0xf3, 0x29, 0x2a,
+	0x7d, 0x9b, 0xa2, 0xd2, 0xdb, 0xc7, 0x5a, 0x58, 0x06, 0x87, 0x98, 0x0b, 0x92, 0x67, 0x48, 0xd4,
+	0x51, 0x4c, 0x65, 0xff, 0x4e, 0xb6, 0xe2, 0xeb, 0xbf, 0x06, 0x00, 0x01, 0x1a, 0xe7, 0x4d, 0x96,
+	0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// AddEpochIdentifier defines a governance operation for adding an epoch identifier.
+	// The authority is defined in the keeper.
+	AddEpochIdentifier(ctx context.Context, in *MsgAddEpochIdentifier, opts ...grpc.CallOption) (*MsgAddEpochIdentifierResponse, error)
+	// RemoveEpochIdentifier defines a governance operation for removing an epoch identifier.
+	// The authority is defined in the keeper.
+	RemoveEpochIdentifier(ctx context.Context, in *MsgRemoveEpochIdentifier, opts ...grpc.CallOption) (*MsgRemoveEpochIdentifierResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) AddEpochIdentifier(ctx context.Context, in *MsgAddEpochIdentifier, opts ...grpc.CallOption) (*MsgAddEpochIdentifierResponse, error) {
+	out := new(MsgAddEpochIdentifierResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.epochs.v1beta1.Msg/AddEpochIdentifier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveEpochIdentifier(ctx context.Context, in *MsgRemoveEpochIdentifier, opts ...grpc.CallOption) (*MsgRemoveEpochIdentifierResponse, error) {
+	out := new(MsgRemoveEpochIdentifierResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.epochs.v1beta1.Msg/RemoveEpochIdentifier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// AddEpochIdentifier defines a governance operation for adding an epoch identifier.
+	// The authority is defined in the keeper.
+	AddEpochIdentifier(context.Context, *MsgAddEpochIdentifier) (*MsgAddEpochIdentifierResponse, error)
+	// RemoveEpochIdentifier defines a governance operation for removing an epoch identifier.
+	// The authority is defined in the keeper.
+	RemoveEpochIdentifier(context.Context, *MsgRemoveEpochIdentifier) (*MsgRemoveEpochIdentifierResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) AddEpochIdentifier(ctx context.Context, req *MsgAddEpochIdentifier) (*MsgAddEpochIdentifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEpochIdentifier not implemented")
+}
+func (*UnimplementedMsgServer) RemoveEpochIdentifier(ctx context.Context, req *MsgRemoveEpochIdentifier) (*MsgRemoveEpochIdentifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEpochIdentifier not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_AddEpochIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddEpochIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddEpochIdentifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.epochs.v1beta1.Msg/AddEpochIdentifier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddEpochIdentifier(ctx, req.(*MsgAddEpochIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveEpochIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveEpochIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveEpochIdentifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.epochs.v1beta1.Msg/RemoveEpochIdentifier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveEpochIdentifier(ctx, req.(*MsgRemoveEpochIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.epochs.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddEpochIdentifier",
+			Handler:    _Msg_AddEpochIdentifier_Handler,
+		},
+		{
+			MethodName: "RemoveEpochIdentifier",
+			Handler:    _Msg_RemoveEpochIdentifier_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/epochs/v1beta1/tx.proto",
+}
+
+func (m *MsgAddEpochIdentifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddEpochIdentifier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddEpochIdentifier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddEpochIdentifierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddEpochIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddEpochIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveEpochIdentifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveEpochIdentifier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveEpochIdentifier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveEpochIdentifierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveEpochIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveEpochIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddEpochIdentifier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddEpochIdentifierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveEpochIdentifier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveEpochIdentifierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddEpochIdentifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddEpochIdentifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddEpochIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddEpochIdentifierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddEpochIdentifierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddEpochIdentifierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveEpochIdentifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveEpochIdentifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveEpochIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveEpochIdentifierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveEpochIdentifierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveEpochIdentifierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -125,12 +125,13 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 		return err
 	}
 
-	err = k.slashingKeeper.SlashWithInfractionReason(
+	err = k.slashingKeeper.SlashWithEvidence(
 		ctx,
 		consAddr,
 		slashFractionDoubleSign,
 		evidence.GetValidatorPower(), distributionHeight,
 		st.Infraction_INFRACTION_DOUBLE_SIGN,
+		evidence.Route(),
 	)
 	if err != nil {
 		return err
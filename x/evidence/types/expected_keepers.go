@@ -27,7 +27,7 @@ type SlashingKeeper interface {
 	HasValidatorSigningInfo(context.Context, sdk.ConsAddress) bool
 	Tombstone(context.Context, sdk.ConsAddress) error
 	Slash(context.Context, sdk.ConsAddress, math.LegacyDec, int64, int64) error
-	SlashWithInfractionReason(context.Context, sdk.ConsAddress, math.LegacyDec, int64, int64, st.Infraction) error
+	SlashWithEvidence(context.Context, sdk.ConsAddress, math.LegacyDec, int64, int64, st.Infraction, string) error
 	SlashFractionDoubleSign(context.Context) (math.LegacyDec, error)
 	Jail(context.Context, sdk.ConsAddress) error
 	JailUntil(context.Context, sdk.ConsAddress, time.Time) error
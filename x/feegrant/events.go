@@ -8,7 +8,12 @@ const (
 	EventTypeUpdateFeeGrant = "update_feegrant"
 	EventTypePruneFeeGrant  = "prune_feegrant"
 
+	EventTypeSetSponsorship    = "set_sponsorship"
+	EventTypeRevokeSponsorship = "revoke_sponsorship"
+	EventTypeUseSponsorship    = "use_sponsorship"
+
 	AttributeKeyGranter = "granter"
 	AttributeKeyGrantee = "grantee"
 	AttributeKeyPruner  = "pruner"
+	AttributeKeySponsor = "sponsor"
 )
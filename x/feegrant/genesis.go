@@ -1,6 +1,8 @@
 package feegrant
 
 import (
+	"fmt"
+
 	gogoprotoany "github.com/cosmos/gogoproto/types/any"
 )
 
@@ -25,6 +27,28 @@ func ValidateGenesis(data GenesisState) error {
 			return err
 		}
 	}
+
+	sponsors := make(map[string]bool)
+	for _, s := range data.Sponsorships {
+		if sponsors[s.Sponsor] {
+			return fmt.Errorf("duplicate sponsorship for sponsor %s", s.Sponsor)
+		}
+		sponsors[s.Sponsor] = true
+
+		if err := s.Allowance.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	for _, u := range data.SponsoredUsages {
+		if !sponsors[u.Sponsor] {
+			return fmt.Errorf("sponsored usage of unknown sponsor %s", u.Sponsor)
+		}
+		if !u.Spent.IsValid() {
+			return fmt.Errorf("invalid sponsored usage of sponsor %s for account %s: %s", u.Sponsor, u.Account, u.Spent)
+		}
+	}
+
 	return nil
 }
 
@@ -5,6 +5,7 @@ package feegrant
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -27,6 +28,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState contains a set of fee allowances, persisted from the store
 type GenesisState struct {
 	Allowances []Grant `protobuf:"bytes,1,rep,name=allowances,proto3" json:"allowances"`
+	// sponsorships are the sponsorships of the sponsors.
+	Sponsorships []Sponsorship `protobuf:"bytes,2,rep,name=sponsorships,proto3" json:"sponsorships"`
+	// sponsored_usages are the fees paid by the sponsors for accounts in the current day.
+	SponsoredUsages []SponsoredUsage `protobuf:"bytes,3,rep,name=sponsored_usages,json=sponsoredUsages,proto3" json:"sponsored_usages"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +74,20 @@ func (m *GenesisState) GetAllowances() []Grant {
 	return nil
 }
 
+func (m *GenesisState) GetSponsorships() []Sponsorship {
+	if m != nil {
+		return m.Sponsorships
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSponsoredUsages() []SponsoredUsage {
+	if m != nil {
+		return m.SponsoredUsages
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.feegrant.v1beta1.GenesisState")
 }
@@ -78,20 +97,26 @@ func init() {
 }
 
 var fileDescriptor_ac719d2d0954d1bf = []byte{
-	// 207 bytes of a gzipped FileDescriptorProto
+	// 298 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xce, 0x2f, 0xce,
 	0xcd, 0x2f, 0xd6, 0x4f, 0x4b, 0x4d, 0x4d, 0x2f, 0x4a, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a,
 	0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
 	0xc9, 0x17, 0x12, 0x87, 0x28, 0xd3, 0x83, 0x29, 0xd3, 0x83, 0x2a, 0x93, 0x12, 0x49, 0xcf, 0x4f,
 	0xcf, 0x07, 0xab, 0xd1, 0x07, 0xb1, 0x20, 0xca, 0xa5, 0xd4, 0x70, 0x99, 0x0a, 0xd7, 0x0f, 0x51,
-	0x27, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0x42, 0x4a, 0x91, 0x5c, 0x3c, 0xee,
-	0x10, 0xab, 0x83, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x3c, 0xb9, 0xb8, 0x12, 0x73, 0x72, 0xf2, 0xcb,
-	0x13, 0xf3, 0x92, 0x53, 0x8b, 0x25, 0x18, 0x15, 0x98, 0x35, 0xb8, 0x8d, 0xe4, 0xf4, 0x70, 0x38,
-	0x47, 0xcf, 0x1d, 0xc4, 0x73, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c,
-	0x41, 0x48, 0x9a, 0x9d, 0x0c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x0a,
-	0xea, 0xbd, 0xe2, 0x94, 0x6c, 0xbd, 0xcc, 0x7c, 0xfd, 0x0a, 0xb8, 0x33, 0x93, 0xd8, 0xc0, 0x8e,
-	0x32, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x32, 0xdb, 0x7e, 0x13, 0x27, 0x01, 0x00, 0x00,
+	0x27, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0xa1, 0x42, 0x92, 0x10, 0xad, 0xf1, 0x10,
+	0x33, 0xa1, 0xd6, 0x82, 0x39, 0x4a, 0x5b, 0x99, 0xb8, 0x78, 0xdc, 0x21, 0xce, 0x0a, 0x2e, 0x49,
+	0x2c, 0x49, 0x15, 0xf2, 0xe4, 0xe2, 0x4a, 0xcc, 0xc9, 0xc9, 0x2f, 0x4f, 0xcc, 0x4b, 0x4e, 0x2d,
+	0x96, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x36, 0x92, 0xd3, 0xc3, 0xe1, 0x54, 0x3d, 0x77, 0x10, 0xcf,
+	0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06, 0x21, 0x69, 0x16, 0x4a,
+	0xe7, 0xe2, 0x29, 0x2e, 0xc8, 0xcf, 0x2b, 0xce, 0x2f, 0x2a, 0xce, 0xc8, 0x2c, 0x28, 0x96, 0x60,
+	0x02, 0x1b, 0xa6, 0x82, 0xd3, 0xb0, 0x60, 0x84, 0x62, 0x27, 0x59, 0x90, 0x91, 0xb7, 0xb6, 0xe8,
+	0x0a, 0x54, 0xc0, 0xfd, 0xa7, 0x60, 0xa8, 0x67, 0xa0, 0x67, 0x00, 0xb1, 0x06, 0xc5, 0x60, 0xa1,
+	0x42, 0x2e, 0x01, 0x28, 0x3f, 0x35, 0x25, 0xbe, 0xb4, 0x38, 0x31, 0x3d, 0xb5, 0x58, 0x82, 0x19,
+	0x6c, 0x99, 0x3a, 0x21, 0xcb, 0x52, 0x53, 0x42, 0x41, 0xea, 0x09, 0xd9, 0xc7, 0x5f, 0x8c, 0xa2,
+	0xbc, 0xd8, 0xc9, 0xf0, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63,
+	0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xa0, 0xd1,
+	0x5a, 0x9c, 0x92, 0xad, 0x97, 0x99, 0xaf, 0x8f, 0x30, 0x2e, 0x89, 0x0d, 0x1c, 0xe2, 0xc6, 0x80,
+	0x01, 0x00, 0x1c, 0x11, 0x3d, 0x2b, 0x1f, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -114,6 +139,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SponsoredUsages) > 0 {
+		for iNdEx := len(m.SponsoredUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SponsoredUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Sponsorships) > 0 {
+		for iNdEx := len(m.Sponsorships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sponsorships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Allowances) > 0 {
 		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -154,6 +207,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Sponsorships) > 0 {
+		for _, e := range m.Sponsorships {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SponsoredUsages) > 0 {
+		for _, e := range m.SponsoredUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -226,6 +291,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsorships", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsorships = append(m.Sponsorships, Sponsorship{})
+			if err := m.Sponsorships[len(m.Sponsorships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SponsoredUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SponsoredUsages = append(m.SponsoredUsages, SponsoredUsage{})
+			if err := m.SponsoredUsages[len(m.SponsoredUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
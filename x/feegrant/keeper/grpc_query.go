@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"github.com/cosmos/gogoproto/proto"
 	"google.golang.org/grpc/codes"
@@ -116,3 +117,72 @@ func (q Keeper) AllowancesByGranter(c context.Context, req *feegrant.QueryAllowa
 
 	return &feegrant.QueryAllowancesByGranterResponse{Allowances: grants, Pagination: pageRes}, nil
 }
+
+// Sponsorship returns the sponsorship of a sponsor.
+func (q Keeper) Sponsorship(ctx context.Context, req *feegrant.QuerySponsorshipRequest) (*feegrant.QuerySponsorshipResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sponsor, err := q.authKeeper.AddressCodec().StringToBytes(req.Sponsor)
+	if err != nil {
+		return nil, err
+	}
+
+	sponsorship, err := q.Sponsors.Get(ctx, sponsor)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "sponsorship of %s not found", req.Sponsor)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QuerySponsorshipResponse{Sponsorship: &sponsorship}, nil
+}
+
+// Sponsorships returns all the sponsorships.
+func (q Keeper) Sponsorships(ctx context.Context, req *feegrant.QuerySponsorshipsRequest) (*feegrant.QuerySponsorshipsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sponsorships, pageRes, err := query.CollectionPaginate(ctx, q.Sponsors, req.Pagination,
+		func(_ sdk.AccAddress, sponsorship feegrant.Sponsorship) (*feegrant.Sponsorship, error) {
+			return &sponsorship, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QuerySponsorshipsResponse{Sponsorships: sponsorships, Pagination: pageRes}, nil
+}
+
+// SponsoredUsage returns the fees paid by a sponsor for an account in the current day.
+func (q Keeper) SponsoredUsage(ctx context.Context, req *feegrant.QuerySponsoredUsageRequest) (*feegrant.QuerySponsoredUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sponsor, err := q.authKeeper.AddressCodec().StringToBytes(req.Sponsor)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := q.authKeeper.AddressCodec().StringToBytes(req.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := q.SponsoredUsages.Get(ctx, collections.Join(sdk.AccAddress(sponsor), sdk.AccAddress(account)))
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// the fees paid in a previous day are not counted anymore
+	if err != nil || !q.HeaderService.HeaderInfo(ctx).Time.Before(usage.PeriodReset) {
+		usage = feegrant.SponsoredUsage{Sponsor: req.Sponsor, Account: req.Account}
+	}
+
+	return &feegrant.QuerySponsoredUsageResponse{Usage: &usage}, nil
+}
@@ -24,23 +24,31 @@ type Keeper struct {
 
 	cdc        codec.BinaryCodec
 	authKeeper feegrant.AccountKeeper
+	bankKeeper feegrant.BankKeeper
 	Schema     collections.Schema
 	// FeeAllowance key: grantee+granter | value: Grant
 	FeeAllowance collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], feegrant.Grant]
 	// FeeAllowanceQueue key: expiration time+grantee+granter | value: bool
 	FeeAllowanceQueue collections.Map[collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress], bool]
+	// Sponsors key: sponsor | value: Sponsorship
+	Sponsors collections.Map[sdk.AccAddress, feegrant.Sponsorship]
+	// SponsorsByMsg key: allowed message type url or contract address+sponsor
+	SponsorsByMsg collections.KeySet[collections.Pair[string, sdk.AccAddress]]
+	// SponsoredUsages key: sponsor+account | value: SponsoredUsage
+	SponsoredUsages collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], feegrant.SponsoredUsage]
 }
 
 var _ ante.FeegrantKeeper = &Keeper{}
 
 // NewKeeper creates a feegrant Keeper
-func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, ak feegrant.AccountKeeper) Keeper {
+func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, ak feegrant.AccountKeeper, bk feegrant.BankKeeper) Keeper {
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 
-	return Keeper{
+	k := Keeper{
 		Environment: env,
 		cdc:         cdc,
 		authKeeper:  ak,
+		bankKeeper:  bk,
 		FeeAllowance: collections.NewMap(
 			sb,
 			feegrant.FeeAllowanceKeyPrefix,
@@ -55,7 +63,35 @@ func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, ak feegrant.Acc
 			collections.TripleKeyCodec(sdk.TimeKey, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 			collections.BoolValue,
 		),
+		Sponsors: collections.NewMap(
+			sb,
+			feegrant.SponsorshipKeyPrefix,
+			"sponsorships",
+			sdk.AccAddressKey,
+			codec.CollValue[feegrant.Sponsorship](cdc),
+		),
+		SponsorsByMsg: collections.NewKeySet(
+			sb,
+			feegrant.SponsorshipByMsgKeyPrefix,
+			"sponsorships_by_msg",
+			collections.PairKeyCodec(collections.StringKey, sdk.AccAddressKey),
+		),
+		SponsoredUsages: collections.NewMap(
+			sb,
+			feegrant.SponsoredUsageKeyPrefix,
+			"sponsored_usages",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+			codec.CollValue[feegrant.SponsoredUsage](cdc),
+		),
 	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
 }
 
 // GrantAllowance creates a new grant
@@ -274,6 +310,33 @@ func (k Keeper) InitGenesis(ctx context.Context, data *feegrant.GenesisState) er
 			return err
 		}
 	}
+
+	for _, s := range data.Sponsorships {
+		sponsor, err := k.authKeeper.AddressCodec().StringToBytes(s.Sponsor)
+		if err != nil {
+			return err
+		}
+
+		if err := k.setSponsorship(ctx, sponsor, s); err != nil {
+			return err
+		}
+	}
+
+	for _, u := range data.SponsoredUsages {
+		sponsor, err := k.authKeeper.AddressCodec().StringToBytes(u.Sponsor)
+		if err != nil {
+			return err
+		}
+		account, err := k.authKeeper.AddressCodec().StringToBytes(u.Account)
+		if err != nil {
+			return err
+		}
+
+		if err := k.SponsoredUsages.Set(ctx, collections.Join(sdk.AccAddress(sponsor), sdk.AccAddress(account)), u); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -285,9 +348,29 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*feegrant.GenesisState, erro
 		grants = append(grants, grant)
 		return false
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	var sponsorships []feegrant.Sponsorship
+	err = k.Sponsors.Walk(ctx, nil, func(_ sdk.AccAddress, sponsorship feegrant.Sponsorship) (stop bool, err error) {
+		sponsorships = append(sponsorships, sponsorship)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var usages []feegrant.SponsoredUsage
+	err = k.SponsoredUsages.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], usage feegrant.SponsoredUsage) (stop bool, err error) {
+		usages = append(usages, usage)
+		return false, nil
+	})
 
 	return &feegrant.GenesisState{
-		Allowances: grants,
+		Allowances:      grants,
+		Sponsorships:    sponsorships,
+		SponsoredUsages: usages,
 	}, err
 }
 
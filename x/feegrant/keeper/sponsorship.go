@@ -0,0 +1,243 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"slices"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/header"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GrantSponsorship sets the sponsorship of the sponsor, replacing its previous one. The
+// fees already paid for the accounts in the current day are kept.
+// It can be used by modules to sponsor fees from their module account.
+func (k Keeper) GrantSponsorship(ctx context.Context, sponsor sdk.AccAddress, allowance feegrant.SponsorshipAllowance) error {
+	if err := allowance.ValidateBasic(); err != nil {
+		return err
+	}
+
+	for _, contract := range allowance.AllowedContracts {
+		if _, err := k.authKeeper.AddressCodec().StringToBytes(contract); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address %s: %s", contract, err)
+		}
+	}
+
+	if allowance.Expiration != nil && allowance.Expiration.Before(k.HeaderService.HeaderInfo(ctx).Time) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "expiration is before current block time")
+	}
+
+	sponsorStr, err := k.authKeeper.AddressCodec().BytesToString(sponsor)
+	if err != nil {
+		return err
+	}
+
+	previous, err := k.Sponsors.Get(ctx, sponsor)
+	if err == nil {
+		if err := k.unindexSponsorship(ctx, sponsor, previous.Allowance); err != nil {
+			return err
+		}
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	if err := k.setSponsorship(ctx, sponsor, feegrant.Sponsorship{Sponsor: sponsorStr, Allowance: allowance}); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		feegrant.EventTypeSetSponsorship,
+		event.NewAttribute(feegrant.AttributeKeySponsor, sponsorStr),
+	)
+}
+
+// RevokeSponsorship removes the sponsorship of the sponsor and the fees paid for the
+// accounts in the current day.
+func (k Keeper) RevokeSponsorship(ctx context.Context, sponsor sdk.AccAddress) error {
+	sponsorship, err := k.Sponsors.Get(ctx, sponsor)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrap(feegrant.ErrNoAllowance, "sponsorship not found")
+		}
+		return err
+	}
+
+	if err := k.removeSponsorship(ctx, sponsor, sponsorship.Allowance); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		feegrant.EventTypeRevokeSponsorship,
+		event.NewAttribute(feegrant.AttributeKeySponsor, sponsorship.Sponsor),
+	)
+}
+
+// UseSponsoredFees looks for a sponsor paying the fee of the msgs of the account, without
+// prior grant to the account, and returns it. At most MaxSponsorCandidates sponsors allowing
+// the first msg are considered, in address order from a pseudo random sponsor, and the first
+// one accepting the fee and with enough spendable coins is used. It returns nil if there is
+// no such sponsor.
+//
+// The sponsorships which are expired, used up, or whose sponsor cannot pay a fee they accept
+// are removed, so that they are not considered again by the following transactions.
+func (k Keeper) UseSponsoredFees(ctx context.Context, account sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) (sdk.AccAddress, error) {
+	if len(msgs) == 0 || fee.IsZero() {
+		return nil, nil
+	}
+
+	var candidates []sdk.AccAddress
+	collect := func(key collections.Pair[string, sdk.AccAddress]) (bool, error) {
+		sponsor := key.K2()
+		// a sponsor allowing both the msg and its contract is considered once
+		if sponsor.Equals(account) || slices.ContainsFunc(candidates, func(c sdk.AccAddress) bool { return c.Equals(sponsor) }) {
+			return false, nil
+		}
+
+		candidates = append(candidates, sponsor)
+		return len(candidates) >= feegrant.MaxSponsorCandidates, nil
+	}
+
+	// the walk starts at a pseudo random sponsor and wraps around, so that the sponsors cannot
+	// be considered before the others by choosing their address
+	start := sponsorWalkStart(k.HeaderService.HeaderInfo(ctx), account)
+	for _, key := range feegrant.SponsoredMsgKeys(msgs[0]) {
+		for _, rng := range []*collections.PairRange[string, sdk.AccAddress]{
+			collections.NewPrefixedPairRange[string, sdk.AccAddress](key).StartInclusive(start),
+			collections.NewPrefixedPairRange[string, sdk.AccAddress](key).EndExclusive(start),
+		} {
+			if err := k.SponsorsByMsg.Walk(ctx, rng, collect); err != nil {
+				return nil, err
+			}
+			if len(candidates) >= feegrant.MaxSponsorCandidates {
+				break
+			}
+		}
+		if len(candidates) >= feegrant.MaxSponsorCandidates {
+			break
+		}
+	}
+
+	now := k.HeaderService.HeaderInfo(ctx).Time
+	for _, sponsor := range candidates {
+		sponsorship, err := k.Sponsors.Get(ctx, sponsor)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				// already removed as expired
+				continue
+			}
+			return nil, err
+		}
+
+		usage, err := k.SponsoredUsages.Get(ctx, collections.Join(sponsor, account))
+		if err != nil {
+			if !errors.Is(err, collections.ErrNotFound) {
+				return nil, err
+			}
+
+			accountStr, err := k.authKeeper.AddressCodec().BytesToString(account)
+			if err != nil {
+				return nil, err
+			}
+			usage = feegrant.SponsoredUsage{Sponsor: sponsorship.Sponsor, Account: accountStr}
+		}
+
+		remove, err := sponsorship.Allowance.Accept(now, &usage, fee, msgs)
+		if err != nil {
+			if remove {
+				if err := k.removeSponsorship(ctx, sponsor, sponsorship.Allowance); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+
+		if !k.bankKeeper.SpendableCoins(ctx, sponsor).IsAllGTE(fee) {
+			// the sponsor does not fund its sponsorship
+			if err := k.removeSponsorship(ctx, sponsor, sponsorship.Allowance); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if remove {
+			// the sponsorship is used up
+			err = k.removeSponsorship(ctx, sponsor, sponsorship.Allowance)
+		} else {
+			err = k.setSponsorship(ctx, sponsor, sponsorship)
+			if err == nil {
+				err = k.SponsoredUsages.Set(ctx, collections.Join(sponsor, account), usage)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			feegrant.EventTypeUseSponsorship,
+			event.NewAttribute(feegrant.AttributeKeySponsor, usage.Sponsor),
+			event.NewAttribute(feegrant.AttributeKeyGrantee, usage.Account),
+		); err != nil {
+			return nil, err
+		}
+
+		return sponsor, nil
+	}
+
+	return nil, nil
+}
+
+// sponsorWalkStart returns the sponsor address the walk of the sponsors of a transaction starts
+// from, derived from the block and the account paying the fee.
+func sponsorWalkStart(info header.Info, account sdk.AccAddress) sdk.AccAddress {
+	h := sha256.New()
+	h.Write(info.Hash)
+	h.Write(sdk.Uint64ToBigEndian(uint64(info.Height)))
+	h.Write(account)
+	return sdk.AccAddress(h.Sum(nil)[:20])
+}
+
+// setSponsorship stores a sponsorship and indexes it by allowed message and contract.
+func (k Keeper) setSponsorship(ctx context.Context, sponsor sdk.AccAddress, sponsorship feegrant.Sponsorship) error {
+	if err := k.Sponsors.Set(ctx, sponsor, sponsorship); err != nil {
+		return err
+	}
+
+	for _, key := range sponsorship.Allowance.Keys() {
+		if err := k.SponsorsByMsg.Set(ctx, collections.Join(key, sponsor)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeSponsorship removes a sponsorship, its indexes and the fees paid by the sponsor
+// for the accounts in the current day.
+func (k Keeper) removeSponsorship(ctx context.Context, sponsor sdk.AccAddress, allowance feegrant.SponsorshipAllowance) error {
+	if err := k.Sponsors.Remove(ctx, sponsor); err != nil {
+		return err
+	}
+
+	if err := k.unindexSponsorship(ctx, sponsor, allowance); err != nil {
+		return err
+	}
+
+	return k.SponsoredUsages.Clear(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](sponsor))
+}
+
+func (k Keeper) unindexSponsorship(ctx context.Context, sponsor sdk.AccAddress, allowance feegrant.SponsorshipAllowance) error {
+	for _, key := range allowance.Keys() {
+		if err := k.SponsorsByMsg.Remove(ctx, collections.Join(key, sponsor)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
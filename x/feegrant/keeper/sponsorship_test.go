@@ -0,0 +1,184 @@
+package keeper_test
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/core/header"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestSponsorship() {
+	now := time.Now().UTC()
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Time: now})
+	sponsor, other := suite.addrs[0], suite.addrs[1]
+	user1, user2 := suite.addrs[2], suite.addrs[3]
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	send := &banktypes.MsgSend{FromAddress: suite.encodedAddrs[2]}
+	multiSend := &banktypes.MsgMultiSend{}
+
+	allowance := feegrant.SponsorshipAllowance{
+		AllowedMessages:     []string{sdk.MsgTypeURL(send)},
+		SpendLimit:          sdk.NewCoins(sdk.NewInt64Coin("atom", 25)),
+		UserDailySpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 15)),
+	}
+
+	// invalid sponsorships
+	_, err := suite.msgSrvr.GrantSponsorship(ctx, feegrant.NewMsgGrantSponsorship(suite.encodedAddrs[0], feegrant.SponsorshipAllowance{SpendLimit: fee, UserDailySpendLimit: fee}))
+	suite.Require().ErrorIs(err, feegrant.ErrNoMessages)
+	_, err = suite.msgSrvr.GrantSponsorship(ctx, feegrant.NewMsgGrantSponsorship(suite.encodedAddrs[0], feegrant.SponsorshipAllowance{AllowedContracts: []string{"invalid"}, SpendLimit: fee, UserDailySpendLimit: fee}))
+	suite.Require().ErrorContains(err, "invalid contract address")
+
+	_, err = suite.msgSrvr.GrantSponsorship(ctx, feegrant.NewMsgGrantSponsorship(suite.encodedAddrs[0], allowance))
+	suite.Require().NoError(err)
+
+	res, err := suite.feegrantKeeper.Sponsorship(ctx, &feegrant.QuerySponsorshipRequest{Sponsor: suite.encodedAddrs[0]})
+	suite.Require().NoError(err)
+	suite.Require().Equal(allowance, res.Sponsorship.Allowance)
+
+	// no sponsor of other msgs
+	found, err := suite.feegrantKeeper.UseSponsoredFees(ctx, user1, fee, []sdk.Msg{multiSend})
+	suite.Require().NoError(err)
+	suite.Require().Nil(found)
+	found, err = suite.feegrantKeeper.UseSponsoredFees(ctx, user1, fee, []sdk.Msg{send, multiSend})
+	suite.Require().NoError(err)
+	suite.Require().Nil(found)
+
+	// sponsors without spendable coins are skipped, and their sponsorship removed
+	suite.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), sponsor).Return(sdk.NewCoins()).Times(1)
+	found, err = suite.feegrantKeeper.UseSponsoredFees(ctx, user1, fee, []sdk.Msg{send})
+	suite.Require().NoError(err)
+	suite.Require().Nil(found)
+	_, err = suite.feegrantKeeper.Sponsorship(ctx, &feegrant.QuerySponsorshipRequest{Sponsor: suite.encodedAddrs[0]})
+	suite.Require().ErrorContains(err, "not found")
+	found, err = suite.feegrantKeeper.UseSponsoredFees(ctx, user1, fee, []sdk.Msg{send})
+	suite.Require().NoError(err)
+	suite.Require().Nil(found)
+
+	_, err = suite.msgSrvr.GrantSponsorship(ctx, feegrant.NewMsgGrantSponsorship(suite.encodedAddrs[0], allowance))
+	suite.Require().NoError(err)
+	suite.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), sponsor).Return(suite.atom).AnyTimes()
+	found, err = suite.feegrantKeeper.UseSponsoredFees(ctx, user1, fee, []sdk.Msg{send})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sponsor, found)
+
+	// the daily spend limit of an account is enforced
+	found, err = suite.feegrantKeeper.UseSponsoredFees(ctx, user1, fee, []sdk.Msg{send})
+	suite.Require().NoError(err)
+	suite.Require().Nil(found)
+
+	usage, err := suite.feegrantKeeper.SponsoredUsage(ctx, &feegrant.QuerySponsoredUsageRequest{Sponsor: suite.encodedAddrs[0], Account: suite.encodedAddrs[2]})
+	suite.Require().NoError(err)
+	suite.Require().Equal(fee, usage.Usage.Spent)
+	suite.Require().Equal(now.Add(feegrant.SponsorshipUserPeriod), usage.Usage.PeriodReset)
+
+	found, err = suite.feegrantKeeper.UseSponsoredFees(ctx, user2, fee, []sdk.Msg{send})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sponsor, found)
+
+	// the daily spend limit is reset the next day, the global spend limit is not
+	ctx = ctx.WithHeaderInfo(header.Info{Time: now.Add(feegrant.SponsorshipUserPeriod)})
+	usage, err = suite.feegrantKeeper.SponsoredUsage(ctx, &feegrant.QuerySponsoredUsageRequest{Sponsor: suite.encodedAddrs[0], Account: suite.encodedAddrs[2]})
+	suite.Require().NoError(err)
+	suite.Require().True(usage.Usage.Spent.IsZero())
+
+	found, err = suite.feegrantKeeper.UseSponsoredFees(ctx, user1, fee, []sdk.Msg{send})
+	suite.Require().NoError(err)
+	suite.Require().Nil(found)
+
+	// the sponsorship is removed once used up
+	found, err = suite.feegrantKeeper.UseSponsoredFees(ctx, user1, sdk.NewCoins(sdk.NewInt64Coin("atom", 5)), []sdk.Msg{send})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sponsor, found)
+	_, err = suite.feegrantKeeper.Sponsorship(ctx, &feegrant.QuerySponsorshipRequest{Sponsor: suite.encodedAddrs[0]})
+	suite.Require().ErrorContains(err, "not found")
+
+	// sponsors of contracts, the sponsor cannot sponsor itself
+	contract := suite.encodedAddrs[4]
+	_, err = suite.msgSrvr.GrantSponsorship(ctx, feegrant.NewMsgGrantSponsorship(suite.encodedAddrs[1], feegrant.SponsorshipAllowance{
+		AllowedContracts:    []string{contract},
+		SpendLimit:          suite.atom,
+		UserDailySpendLimit: suite.atom,
+	}))
+	suite.Require().NoError(err)
+
+	suite.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), other).Return(suite.atom).AnyTimes()
+	found, err = suite.feegrantKeeper.UseSponsoredFees(ctx, user1, fee, []sdk.Msg{&mockContractMsg{Contract: contract}})
+	suite.Require().NoError(err)
+	suite.Require().Equal(other, found)
+	found, err = suite.feegrantKeeper.UseSponsoredFees(ctx, other, fee, []sdk.Msg{&mockContractMsg{Contract: contract}})
+	suite.Require().NoError(err)
+	suite.Require().Nil(found)
+
+	sponsorships, err := suite.feegrantKeeper.Sponsorships(ctx, &feegrant.QuerySponsorshipsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(sponsorships.Sponsorships, 1)
+
+	// revoke
+	_, err = suite.msgSrvr.RevokeSponsorship(ctx, feegrant.NewMsgRevokeSponsorship(suite.encodedAddrs[1]))
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.RevokeSponsorship(ctx, feegrant.NewMsgRevokeSponsorship(suite.encodedAddrs[1]))
+	suite.Require().ErrorIs(err, feegrant.ErrNoAllowance)
+	found, err = suite.feegrantKeeper.UseSponsoredFees(ctx, user1, fee, []sdk.Msg{&mockContractMsg{Contract: contract}})
+	suite.Require().NoError(err)
+	suite.Require().Nil(found)
+}
+
+func (suite *KeeperTestSuite) TestSponsorshipCandidatesLimit() {
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Time: time.Now().UTC()})
+	send := &banktypes.MsgSend{FromAddress: suite.encodedAddrs[0]}
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+
+	// only one sponsor accepts the fee, the sponsors being spread over the address space
+	sponsors := make([]sdk.AccAddress, feegrant.MaxSponsorCandidates+1)
+	for i := range sponsors {
+		sponsors[i] = bytes.Repeat([]byte{byte(i * 255 / len(sponsors))}, 20)
+	}
+	for i, sponsor := range sponsors {
+		limit := sdk.NewCoins(sdk.NewInt64Coin("atom", 5))
+		if i == len(sponsors)-1 {
+			limit = sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+		}
+		suite.Require().NoError(suite.feegrantKeeper.GrantSponsorship(ctx, sponsor, feegrant.SponsorshipAllowance{
+			AllowedMessages:     []string{sdk.MsgTypeURL(send)},
+			SpendLimit:          sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)),
+			UserDailySpendLimit: limit,
+		}))
+	}
+
+	// at most MaxSponsorCandidates sponsors are considered, from a sponsor which depends on the
+	// block, so that the sponsors rejecting the fee cannot always be considered first
+	suite.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), sponsors[len(sponsors)-1]).Return(suite.atom).AnyTimes()
+	found, missed := 0, 0
+	for height := int64(1); height <= 50; height++ {
+		sponsor, err := suite.feegrantKeeper.UseSponsoredFees(ctx.WithHeaderInfo(header.Info{Height: height, Time: ctx.HeaderInfo().Time}), suite.addrs[0], fee, []sdk.Msg{send})
+		suite.Require().NoError(err)
+		if sponsor == nil {
+			missed++
+			continue
+		}
+		suite.Require().Equal(sponsors[len(sponsors)-1], sponsor)
+		found++
+	}
+	suite.Require().Positive(found)
+	suite.Require().Positive(missed)
+
+	// a sponsor rejecting the fee of an account is kept for the others
+	res, err := suite.feegrantKeeper.Sponsorships(ctx, &feegrant.QuerySponsorshipsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Sponsorships, len(sponsors))
+}
+
+// mockContractMsg is a message executing a contract.
+type mockContractMsg struct {
+	banktypes.MsgSend
+
+	Contract string
+}
+
+func (m *mockContractMsg) GetContract() string { return m.Contract }
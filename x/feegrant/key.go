@@ -20,4 +20,18 @@ var (
 	// FeeAllowanceQueueKeyPrefix is the set of the kvstore for fee allowance keys data
 	// - 0x01<allowance_prefix_queue_key_bytes>: <empty value>
 	FeeAllowanceQueueKeyPrefix = collections.NewPrefix(1)
+
+	// SponsorshipKeyPrefix is the set of the kvstore for sponsorship data
+	// - 0x02<sponsor_address_bytes>: sponsorship
+	SponsorshipKeyPrefix = collections.NewPrefix(2)
+
+	// SponsorshipByMsgKeyPrefix is the set of the kvstore indexing the sponsorships by
+	// allowed message type url or contract address
+	// - 0x03<msg_key><sponsor_address_bytes>: <empty value>
+	SponsorshipByMsgKeyPrefix = collections.NewPrefix(3)
+
+	// SponsoredUsageKeyPrefix is the set of the kvstore for the fees paid by the sponsors
+	// for accounts in the current day
+	// - 0x04<sponsor_address_bytes><account_address_bytes>: sponsored usage
+	SponsoredUsageKeyPrefix = collections.NewPrefix(4)
 )
@@ -51,7 +51,7 @@ func TestFeegrantPruning(t *testing.T) {
 
 	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), coretesting.NewNopLogger())
 
-	feegrantKeeper := keeper.NewKeeper(env, encCfg.Codec, accountKeeper)
+	feegrantKeeper := keeper.NewKeeper(env, encCfg.Codec, accountKeeper, feegranttestutil.NewMockBankKeeper(ctrl))
 
 	err := feegrantKeeper.GrantAllowance(
 		testCtx.Ctx,
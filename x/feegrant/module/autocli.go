@@ -44,6 +44,31 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "granter"},
 					},
 				},
+				{
+					RpcMethod: "Sponsorship",
+					Use:       "sponsorship <sponsor>",
+					Short:     "Query the sponsorship of a sponsor",
+					Example:   fmt.Sprintf(`$ %s query feegrant sponsorship [sponsor]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sponsor"},
+					},
+				},
+				{
+					RpcMethod: "Sponsorships",
+					Use:       "sponsorships",
+					Short:     "Query all sponsorships",
+					Example:   fmt.Sprintf(`$ %s query feegrant sponsorships`, version.AppName),
+				},
+				{
+					RpcMethod: "SponsoredUsage",
+					Use:       "sponsored-usage <sponsor> <account>",
+					Short:     "Query the fees paid by a sponsor for an account in the current day",
+					Example:   fmt.Sprintf(`$ %s query feegrant sponsored-usage [sponsor] [account]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sponsor"},
+						{ProtoField: "account"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -67,6 +92,27 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Long:      "Prune up to 75 expired allowances in order to reduce the size of the store when the number of expired allowances is large.",
 					Example:   fmt.Sprintf(`$ %s tx feegrant prune --from [mykey]`, version.AppName),
 				},
+				{
+					RpcMethod: "GrantSponsorship",
+					Use:       "grant-sponsorship <granter> <allowance>",
+					Short:     "Sponsor the fees of the transactions of allowed messages or contracts",
+					Long:      "Sponsor the fees of any account for the transactions of allowed messages or contracts, up to a global spend limit and a daily spend limit per account. It replaces the previous sponsorship of the granter. Note, the '--from' flag is ignored as it is implied from [granter]",
+					Example:   fmt.Sprintf(`$ %s tx feegrant grant-sponsorship [granter] '{"allowed_messages":["/cosmos.gov.v1.MsgVote"],"spend_limit":[{"denom":"stake","amount":"1000000"}],"user_daily_spend_limit":[{"denom":"stake","amount":"10000"}]}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+						{ProtoField: "allowance"},
+					},
+				},
+				{
+					RpcMethod: "RevokeSponsorship",
+					Use:       "revoke-sponsorship <granter>",
+					Short:     "Revoke a sponsorship",
+					Long:      "Revoke the sponsorship of a granter. Note, the '--from' flag is ignored as it is implied from [granter]",
+					Example:   fmt.Sprintf(`$ %s tx feegrant revoke-sponsorship [granter]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+					},
+				},
 			},
 			EnhanceCustomCommand: true,
 		},
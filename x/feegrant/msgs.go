@@ -13,6 +13,7 @@ import (
 
 var (
 	_, _ sdk.Msg                              = &MsgGrantAllowance{}, &MsgRevokeAllowance{}
+	_, _ sdk.Msg                              = &MsgGrantSponsorship{}, &MsgRevokeSponsorship{}
 	_    gogoprotoany.UnpackInterfacesMessage = &MsgGrantAllowance{}
 )
 
@@ -55,3 +56,13 @@ func (msg MsgGrantAllowance) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker)
 func NewMsgRevokeAllowance(granter, grantee string) MsgRevokeAllowance {
 	return MsgRevokeAllowance{Granter: granter, Grantee: grantee}
 }
+
+// NewMsgGrantSponsorship returns a message to set the sponsorship of the granter.
+func NewMsgGrantSponsorship(granter string, allowance SponsorshipAllowance) *MsgGrantSponsorship {
+	return &MsgGrantSponsorship{Granter: granter, Allowance: allowance}
+}
+
+// NewMsgRevokeSponsorship returns a message to revoke the sponsorship of the granter.
+func NewMsgRevokeSponsorship(granter string) *MsgRevokeSponsorship {
+	return &MsgRevokeSponsorship{Granter: granter}
+}
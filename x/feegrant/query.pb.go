@@ -349,6 +349,300 @@ func (m *QueryAllowancesByGranterResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QuerySponsorshipRequest is the request type for the Query/Sponsorship RPC method.
+type QuerySponsorshipRequest struct {
+	// sponsor is the address of the account paying the sponsored fees.
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+}
+
+func (m *QuerySponsorshipRequest) Reset()         { *m = QuerySponsorshipRequest{} }
+func (m *QuerySponsorshipRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorshipRequest) ProtoMessage()    {}
+func (*QuerySponsorshipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{6}
+}
+func (m *QuerySponsorshipRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorshipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorshipRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorshipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorshipRequest.Merge(m, src)
+}
+func (m *QuerySponsorshipRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorshipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorshipRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorshipRequest proto.InternalMessageInfo
+
+func (m *QuerySponsorshipRequest) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+// QuerySponsorshipResponse is the response type for the Query/Sponsorship RPC method.
+type QuerySponsorshipResponse struct {
+	// sponsorship is the sponsorship of the sponsor.
+	Sponsorship *Sponsorship `protobuf:"bytes,1,opt,name=sponsorship,proto3" json:"sponsorship,omitempty"`
+}
+
+func (m *QuerySponsorshipResponse) Reset()         { *m = QuerySponsorshipResponse{} }
+func (m *QuerySponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorshipResponse) ProtoMessage()    {}
+func (*QuerySponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{7}
+}
+func (m *QuerySponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorshipResponse.Merge(m, src)
+}
+func (m *QuerySponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorshipResponse proto.InternalMessageInfo
+
+func (m *QuerySponsorshipResponse) GetSponsorship() *Sponsorship {
+	if m != nil {
+		return m.Sponsorship
+	}
+	return nil
+}
+
+// QuerySponsorshipsRequest is the request type for the Query/Sponsorships RPC method.
+type QuerySponsorshipsRequest struct {
+	// pagination defines an pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySponsorshipsRequest) Reset()         { *m = QuerySponsorshipsRequest{} }
+func (m *QuerySponsorshipsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorshipsRequest) ProtoMessage()    {}
+func (*QuerySponsorshipsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{8}
+}
+func (m *QuerySponsorshipsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorshipsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorshipsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorshipsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorshipsRequest.Merge(m, src)
+}
+func (m *QuerySponsorshipsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorshipsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorshipsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorshipsRequest proto.InternalMessageInfo
+
+func (m *QuerySponsorshipsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySponsorshipsResponse is the response type for the Query/Sponsorships RPC method.
+type QuerySponsorshipsResponse struct {
+	// sponsorships are the sponsorships of the sponsors.
+	Sponsorships []*Sponsorship `protobuf:"bytes,1,rep,name=sponsorships,proto3" json:"sponsorships,omitempty"`
+	// pagination defines an pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySponsorshipsResponse) Reset()         { *m = QuerySponsorshipsResponse{} }
+func (m *QuerySponsorshipsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorshipsResponse) ProtoMessage()    {}
+func (*QuerySponsorshipsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{9}
+}
+func (m *QuerySponsorshipsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorshipsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorshipsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorshipsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorshipsResponse.Merge(m, src)
+}
+func (m *QuerySponsorshipsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorshipsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorshipsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorshipsResponse proto.InternalMessageInfo
+
+func (m *QuerySponsorshipsResponse) GetSponsorships() []*Sponsorship {
+	if m != nil {
+		return m.Sponsorships
+	}
+	return nil
+}
+
+func (m *QuerySponsorshipsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySponsoredUsageRequest is the request type for the Query/SponsoredUsage RPC method.
+type QuerySponsoredUsageRequest struct {
+	// sponsor is the address of the account paying the sponsored fees.
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// account is the address of the account whose fees are sponsored.
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *QuerySponsoredUsageRequest) Reset()         { *m = QuerySponsoredUsageRequest{} }
+func (m *QuerySponsoredUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsoredUsageRequest) ProtoMessage()    {}
+func (*QuerySponsoredUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{10}
+}
+func (m *QuerySponsoredUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsoredUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsoredUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsoredUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsoredUsageRequest.Merge(m, src)
+}
+func (m *QuerySponsoredUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsoredUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsoredUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsoredUsageRequest proto.InternalMessageInfo
+
+func (m *QuerySponsoredUsageRequest) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *QuerySponsoredUsageRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+// QuerySponsoredUsageResponse is the response type for the Query/SponsoredUsage RPC method.
+type QuerySponsoredUsageResponse struct {
+	// usage is the fees paid by the sponsor for the account in the current day.
+	Usage *SponsoredUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+}
+
+func (m *QuerySponsoredUsageResponse) Reset()         { *m = QuerySponsoredUsageResponse{} }
+func (m *QuerySponsoredUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsoredUsageResponse) ProtoMessage()    {}
+func (*QuerySponsoredUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{11}
+}
+func (m *QuerySponsoredUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsoredUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsoredUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsoredUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsoredUsageResponse.Merge(m, src)
+}
+func (m *QuerySponsoredUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsoredUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsoredUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsoredUsageResponse proto.InternalMessageInfo
+
+func (m *QuerySponsoredUsageResponse) GetUsage() *SponsoredUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAllowanceRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceRequest")
 	proto.RegisterType((*QueryAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceResponse")
@@ -356,6 +650,12 @@ func init() {
 	proto.RegisterType((*QueryAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesResponse")
 	proto.RegisterType((*QueryAllowancesByGranterRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesByGranterRequest")
 	proto.RegisterType((*QueryAllowancesByGranterResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesByGranterResponse")
+	proto.RegisterType((*QuerySponsorshipRequest)(nil), "cosmos.feegrant.v1beta1.QuerySponsorshipRequest")
+	proto.RegisterType((*QuerySponsorshipResponse)(nil), "cosmos.feegrant.v1beta1.QuerySponsorshipResponse")
+	proto.RegisterType((*QuerySponsorshipsRequest)(nil), "cosmos.feegrant.v1beta1.QuerySponsorshipsRequest")
+	proto.RegisterType((*QuerySponsorshipsResponse)(nil), "cosmos.feegrant.v1beta1.QuerySponsorshipsResponse")
+	proto.RegisterType((*QuerySponsoredUsageRequest)(nil), "cosmos.feegrant.v1beta1.QuerySponsoredUsageRequest")
+	proto.RegisterType((*QuerySponsoredUsageResponse)(nil), "cosmos.feegrant.v1beta1.QuerySponsoredUsageResponse")
 }
 
 func init() {
@@ -363,41 +663,57 @@ func init() {
 }
 
 var fileDescriptor_59efc303945de53f = []byte{
-	// 543 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x4f, 0x6b, 0x13, 0x4f,
-	0x18, 0xc7, 0x33, 0xfd, 0xfd, 0x54, 0xf2, 0xf4, 0x20, 0x4c, 0xd5, 0xc6, 0x45, 0xd6, 0xb0, 0x42,
-	0xfd, 0x47, 0x66, 0x9a, 0xa8, 0xa5, 0x8a, 0x14, 0x1a, 0xc4, 0x5c, 0x35, 0x82, 0x07, 0x2f, 0x32,
-	0xe9, 0x3e, 0x2e, 0x4b, 0xe3, 0x4e, 0xba, 0xb3, 0x51, 0x8b, 0x14, 0xc1, 0x57, 0x20, 0xe8, 0x2b,
-	0xf0, 0xe0, 0xc9, 0x53, 0xe9, 0x0b, 0xf0, 0x28, 0x9e, 0x42, 0xbd, 0x78, 0x94, 0xc4, 0x17, 0x22,
-	0x99, 0x9d, 0xdd, 0x8d, 0x49, 0x96, 0x2e, 0xea, 0xc1, 0x5b, 0x26, 0xfb, 0xfd, 0x3e, 0xfb, 0xf9,
-	0x3e, 0xcf, 0xcc, 0x2c, 0x5c, 0xd8, 0x92, 0xea, 0xa9, 0x54, 0xfc, 0x09, 0xa2, 0x17, 0x8a, 0x20,
-	0xe2, 0xcf, 0xea, 0x1d, 0x8c, 0x44, 0x9d, 0xef, 0xf4, 0x31, 0xdc, 0x65, 0xbd, 0x50, 0x46, 0x92,
-	0x2e, 0xc7, 0x22, 0x96, 0x88, 0x98, 0x11, 0x59, 0x2b, 0x79, 0xee, 0x54, 0xa9, 0x0b, 0x58, 0x57,
-	0x8c, 0xae, 0x23, 0x14, 0xc6, 0x95, 0x53, 0x65, 0x4f, 0x78, 0x7e, 0x20, 0x22, 0x5f, 0x06, 0x46,
-	0x7b, 0xce, 0x93, 0xd2, 0xeb, 0x22, 0x17, 0x3d, 0x9f, 0x8b, 0x20, 0x90, 0x91, 0x7e, 0xa8, 0xcc,
-	0xd3, 0xb3, 0x71, 0xa5, 0xc7, 0x7a, 0xc5, 0x0d, 0x97, 0x5e, 0x38, 0xaf, 0xe0, 0xf4, 0xfd, 0x71,
-	0xe9, 0xcd, 0x6e, 0x57, 0x3e, 0x17, 0xc1, 0x16, 0xb6, 0x71, 0xa7, 0x8f, 0x2a, 0xa2, 0x0d, 0x38,
-	0xa1, 0x61, 0x30, 0xac, 0x90, 0x2a, 0xb9, 0x54, 0x6e, 0x56, 0x0e, 0x0f, 0x6a, 0xa7, 0x8c, 0x77,
-	0xd3, 0x75, 0x43, 0x54, 0xea, 0x41, 0x14, 0xfa, 0x81, 0xd7, 0x4e, 0x84, 0x99, 0x07, 0x2b, 0x0b,
-	0xc5, 0x3c, 0xe8, 0x3c, 0x84, 0x33, 0xd3, 0x00, 0xaa, 0x27, 0x03, 0x85, 0xf4, 0x36, 0x94, 0x45,
-	0xf2, 0xa7, 0x66, 0x58, 0x6c, 0xd8, 0x2c, 0xa7, 0xa9, 0xac, 0x35, 0x5e, 0xb5, 0x33, 0x83, 0xf3,
-	0x8e, 0x4c, 0x17, 0x56, 0x33, 0xd1, 0xb0, 0x68, 0x34, 0xa4, 0x77, 0x01, 0xb2, 0xa6, 0xeb, 0x74,
-	0x8b, 0x8d, 0x95, 0x84, 0x66, 0x3c, 0x21, 0x16, 0xcf, 0x3e, 0xe1, 0xb9, 0x27, 0xbc, 0xa4, 0x95,
-	0xed, 0x09, 0xa7, 0xf3, 0x9e, 0xc0, 0xf2, 0x0c, 0x96, 0x09, 0xbc, 0x01, 0x90, 0xf2, 0xab, 0x0a,
-	0xa9, 0xfe, 0x57, 0x20, 0xf1, 0x84, 0x83, 0xb6, 0xe6, 0x30, 0x5e, 0x3c, 0x92, 0x31, 0x7e, 0xf9,
-	0x2f, 0x90, 0xfb, 0x04, 0xce, 0x4f, 0x41, 0x36, 0x77, 0x5b, 0xf1, 0x90, 0xff, 0x64, 0x7f, 0xfc,
-	0xa5, 0x26, 0xde, 0x5a, 0x3a, 0x3c, 0xa8, 0x9d, 0x8c, 0x6d, 0x35, 0xe5, 0x6e, 0x57, 0x57, 0xd9,
-	0xf5, 0x35, 0xe7, 0x13, 0x81, 0x6a, 0x3e, 0xf4, 0x3f, 0xd6, 0xe2, 0xb9, 0x11, 0x1a, 0xfb, 0xff,
-	0xc3, 0x31, 0x1d, 0x81, 0x7e, 0x24, 0x50, 0x4e, 0x73, 0x50, 0x96, 0x4b, 0x38, 0xf7, 0xec, 0x5a,
-	0xbc, 0xb0, 0x3e, 0x26, 0x73, 0x36, 0x5e, 0x7f, 0xfd, 0xf1, 0x76, 0x61, 0x9d, 0xae, 0xf1, 0xbc,
-	0xbb, 0x29, 0xed, 0x01, 0x7f, 0x69, 0xa6, 0xb9, 0x97, 0xfc, 0xc2, 0x3d, 0xfa, 0x81, 0x00, 0x64,
-	0x6d, 0xa7, 0x45, 0xdf, 0x9f, 0x9c, 0x48, 0x6b, 0xb5, 0xb8, 0xc1, 0x10, 0xdf, 0xd0, 0xc4, 0x9c,
-	0xd6, 0x8e, 0x26, 0x56, 0x13, 0xa0, 0x03, 0x02, 0x4b, 0x73, 0xf6, 0x07, 0x5d, 0x2f, 0x0a, 0x30,
-	0x7d, 0x0e, 0xac, 0x9b, 0xbf, 0xe1, 0x34, 0x19, 0xee, 0x7c, 0x99, 0xdd, 0x03, 0x3a, 0xd6, 0x55,
-	0x7a, 0x39, 0x37, 0x96, 0xaf, 0x54, 0x1f, 0xdd, 0x6c, 0x0a, 0xcd, 0xfa, 0xe7, 0xa1, 0x4d, 0x06,
-	0x43, 0x9b, 0x7c, 0x1f, 0xda, 0xe4, 0xcd, 0xc8, 0x2e, 0x0d, 0x46, 0x76, 0xe9, 0xdb, 0xc8, 0x2e,
-	0x3d, 0x32, 0x5f, 0x20, 0xe5, 0x6e, 0x33, 0x5f, 0xf2, 0x17, 0x69, 0xad, 0xce, 0x71, 0x7d, 0xf7,
-	0x5f, 0xfb, 0x19, 0x00, 0x00, 0xff, 0xff, 0x84, 0x03, 0x50, 0xd8, 0xc8, 0x06, 0x00, 0x00,
+	// 787 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0x66, 0x30, 0x68, 0xfa, 0x4a, 0xd4, 0x0c, 0x28, 0x65, 0x35, 0xb5, 0x59, 0x15, 0xfc, 0x91,
+	0xee, 0xb4, 0x05, 0x09, 0x12, 0xc5, 0x94, 0x18, 0x30, 0xf1, 0xa2, 0x25, 0x7a, 0xd0, 0x83, 0x59,
+	0xda, 0xb1, 0x6e, 0xc0, 0x9d, 0xb2, 0xb3, 0x55, 0x08, 0x69, 0x4c, 0x3c, 0x7a, 0x32, 0xd1, 0x93,
+	0x47, 0x0f, 0x9e, 0x3c, 0x19, 0x0e, 0xde, 0xf4, 0xe0, 0xc1, 0x70, 0x22, 0x78, 0xe1, 0x68, 0xc0,
+	0x3f, 0xc4, 0x74, 0x76, 0xf6, 0x47, 0xdb, 0xdd, 0x74, 0x8b, 0x1c, 0xbc, 0x31, 0xb3, 0xef, 0x7b,
+	0xef, 0xfb, 0xbe, 0x79, 0xf3, 0x86, 0xc2, 0xf9, 0x32, 0xe3, 0xcf, 0x19, 0x27, 0x4f, 0x29, 0xad,
+	0x5a, 0xba, 0x69, 0x93, 0x17, 0xf9, 0x25, 0x6a, 0xeb, 0x79, 0xb2, 0x5a, 0xa7, 0xd6, 0xba, 0x56,
+	0xb3, 0x98, 0xcd, 0xf0, 0x88, 0x13, 0xa4, 0xb9, 0x41, 0x9a, 0x0c, 0x52, 0xc6, 0xa2, 0xd0, 0x5e,
+	0xa4, 0x48, 0xa0, 0x5c, 0x91, 0x71, 0x4b, 0x3a, 0xa7, 0x4e, 0x66, 0x2f, 0xb2, 0xa6, 0x57, 0x0d,
+	0x53, 0xb7, 0x0d, 0x66, 0xca, 0xd8, 0xb3, 0x55, 0xc6, 0xaa, 0x2b, 0x94, 0xe8, 0x35, 0x83, 0xe8,
+	0xa6, 0xc9, 0x6c, 0xf1, 0x91, 0xcb, 0xaf, 0xa3, 0x4e, 0xa6, 0x27, 0x62, 0x45, 0x24, 0x2f, 0xb1,
+	0x50, 0x5f, 0xc1, 0xa9, 0xfb, 0xcd, 0xd4, 0xc5, 0x95, 0x15, 0xf6, 0x52, 0x37, 0xcb, 0xb4, 0x44,
+	0x57, 0xeb, 0x94, 0xdb, 0xb8, 0x00, 0xc7, 0x04, 0x19, 0x6a, 0xa5, 0x50, 0x06, 0x5d, 0x4a, 0xcc,
+	0xa5, 0x76, 0x36, 0xb3, 0xc3, 0x12, 0x5b, 0xac, 0x54, 0x2c, 0xca, 0xf9, 0xa2, 0x6d, 0x19, 0x66,
+	0xb5, 0xe4, 0x06, 0xfa, 0x18, 0x9a, 0xea, 0x8f, 0x87, 0xa1, 0xea, 0x43, 0x38, 0xdd, 0x4e, 0x80,
+	0xd7, 0x98, 0xc9, 0x29, 0xbe, 0x01, 0x09, 0xdd, 0xdd, 0x14, 0x1c, 0x92, 0x85, 0xb4, 0x16, 0x61,
+	0xaa, 0xb6, 0xd0, 0x5c, 0x95, 0x7c, 0x80, 0xfa, 0x1e, 0xb5, 0x27, 0xe6, 0x1d, 0xd2, 0x68, 0x5c,
+	0x69, 0x14, 0xcf, 0x03, 0xf8, 0xa6, 0x0b, 0x75, 0xc9, 0xc2, 0x98, 0xcb, 0xa6, 0x79, 0x42, 0x9a,
+	0x73, 0xf6, 0x2e, 0x9f, 0x7b, 0x7a, 0xd5, 0xb5, 0xb2, 0x14, 0x40, 0xaa, 0x1f, 0x11, 0x8c, 0x74,
+	0xd0, 0x92, 0x82, 0x67, 0x01, 0x3c, 0xfe, 0x3c, 0x85, 0x32, 0x47, 0x62, 0x28, 0x0e, 0x20, 0xf0,
+	0x42, 0x08, 0xc7, 0xf1, 0xae, 0x1c, 0x9d, 0xe2, 0x2d, 0x24, 0xbf, 0x20, 0x38, 0xd7, 0x46, 0x72,
+	0x6e, 0x7d, 0xc1, 0x39, 0xe4, 0x7f, 0xe9, 0x8f, 0x43, 0x32, 0x71, 0x66, 0x68, 0x67, 0x33, 0x7b,
+	0xc2, 0x81, 0x65, 0x79, 0x65, 0x39, 0x93, 0xd3, 0x26, 0xa7, 0xd4, 0xef, 0x08, 0x32, 0xd1, 0xa4,
+	0xff, 0x33, 0x8b, 0xc3, 0x25, 0x94, 0x65, 0x6f, 0x2c, 0x36, 0xe3, 0x99, 0xc5, 0x9f, 0x19, 0xb5,
+	0x80, 0xdd, 0xdc, 0xd9, 0xed, 0x6e, 0xb7, 0x0c, 0x9c, 0x19, 0xde, 0xd9, 0xcc, 0x9e, 0x5c, 0xf3,
+	0xc6, 0x4a, 0x26, 0xaf, 0xe5, 0xb4, 0x9c, 0xba, 0x06, 0xa9, 0xce, 0x22, 0xd2, 0x9e, 0x79, 0x48,
+	0x72, 0x7f, 0x5b, 0x5e, 0xba, 0x0b, 0x91, 0xfe, 0x04, 0x53, 0x04, 0x81, 0xf1, 0x2b, 0x7b, 0x77,
+	0xb2, 0xb5, 0x35, 0xd0, 0x81, 0x5b, 0x23, 0xbc, 0xf2, 0x0f, 0x04, 0xa3, 0x21, 0xa5, 0xa5, 0xea,
+	0x3b, 0x30, 0x18, 0x20, 0xef, 0xb6, 0x45, 0x3c, 0xd9, 0x2d, 0xc8, 0xc3, 0x6b, 0x8f, 0x70, 0x19,
+	0x1f, 0x10, 0x28, 0x41, 0x19, 0xb4, 0xf2, 0x80, 0xfb, 0x3e, 0x1c, 0xa4, 0x47, 0x9a, 0x18, 0xbd,
+	0x5c, 0x66, 0x75, 0xd3, 0xee, 0x3e, 0xb2, 0x65, 0x60, 0x04, 0x39, 0x0b, 0xce, 0x84, 0x72, 0x93,
+	0x26, 0xdf, 0x84, 0x81, 0x7a, 0x73, 0x23, 0x85, 0x5a, 0x5d, 0x89, 0x72, 0xd7, 0xc5, 0x3b, 0xa8,
+	0xf0, 0x9a, 0x85, 0x37, 0x09, 0x18, 0x10, 0x45, 0xf1, 0x67, 0x04, 0x09, 0xef, 0xe2, 0x63, 0x2d,
+	0x32, 0x7b, 0xe8, 0x63, 0xa7, 0x90, 0xd8, 0xf1, 0x8e, 0x1a, 0x75, 0xf6, 0xf5, 0xaf, 0x3f, 0xef,
+	0xfa, 0xa7, 0xf1, 0x14, 0x89, 0x7a, 0xcc, 0xbd, 0xa1, 0x41, 0x36, 0xe4, 0xf8, 0x6b, 0xb8, 0x7f,
+	0xd1, 0x06, 0xfe, 0x84, 0x00, 0x8a, 0xfe, 0x58, 0x89, 0x5b, 0xdf, 0xbd, 0x2e, 0x4a, 0x2e, 0x3e,
+	0x40, 0x32, 0xbe, 0x26, 0x18, 0x13, 0x9c, 0xed, 0xce, 0x98, 0x07, 0x88, 0x6e, 0x23, 0x18, 0x0a,
+	0x19, 0xa8, 0x78, 0x3a, 0x2e, 0x81, 0xf6, 0x87, 0x43, 0xb9, 0x7e, 0x00, 0xa4, 0xd4, 0x70, 0x7b,
+	0xab, 0x73, 0x68, 0x0a, 0x59, 0x57, 0xf1, 0xe5, 0x48, 0x59, 0x06, 0xe7, 0x75, 0x5a, 0xf1, 0x4f,
+	0x01, 0x7f, 0x43, 0x90, 0x0c, 0x5c, 0x61, 0xdc, 0xc5, 0xcb, 0xce, 0x61, 0xac, 0xe4, 0x7b, 0x40,
+	0x48, 0xea, 0x77, 0xb7, 0x42, 0xfa, 0x57, 0x70, 0xcf, 0x63, 0x12, 0xc9, 0x3d, 0x38, 0x5c, 0xc8,
+	0x86, 0x5c, 0x35, 0xf0, 0x57, 0x04, 0x83, 0x81, 0x22, 0x1c, 0xc7, 0x27, 0xe4, 0x75, 0x50, 0xa1,
+	0x17, 0x88, 0x14, 0x51, 0x8c, 0x14, 0x31, 0x8e, 0x2f, 0xc6, 0x12, 0x81, 0x77, 0x11, 0x1c, 0x6f,
+	0xbd, 0xe1, 0x78, 0x22, 0x16, 0x93, 0xd6, 0x59, 0xa7, 0x4c, 0xf6, 0x06, 0x92, 0x02, 0x1e, 0x47,
+	0x0a, 0x28, 0xe2, 0x5b, 0x3d, 0x9e, 0x02, 0x11, 0x83, 0x89, 0x6c, 0xc8, 0xa9, 0xd8, 0x98, 0xcb,
+	0xff, 0xdc, 0x4b, 0xa3, 0xed, 0xbd, 0x34, 0xfa, 0xbd, 0x97, 0x46, 0x6f, 0xf7, 0xd3, 0x7d, 0xdb,
+	0xfb, 0xe9, 0xbe, 0xdd, 0xfd, 0x74, 0xdf, 0x23, 0xf9, 0x53, 0x80, 0x57, 0x96, 0x35, 0x83, 0x11,
+	0xbf, 0xfe, 0xd2, 0x51, 0xf1, 0x4f, 0xf8, 0xc4, 0xdf, 0x01, 0x00, 0x55, 0xcc, 0x1c, 0xe6, 0x51,
+	0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -418,6 +734,12 @@ type QueryClient interface {
 	Allowances(ctx context.Context, in *QueryAllowancesRequest, opts ...grpc.CallOption) (*QueryAllowancesResponse, error)
 	// AllowancesByGranter returns all the grants given by an address
 	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+	// Sponsorship returns the sponsorship of a sponsor.
+	Sponsorship(ctx context.Context, in *QuerySponsorshipRequest, opts ...grpc.CallOption) (*QuerySponsorshipResponse, error)
+	// Sponsorships returns all the sponsorships.
+	Sponsorships(ctx context.Context, in *QuerySponsorshipsRequest, opts ...grpc.CallOption) (*QuerySponsorshipsResponse, error)
+	// SponsoredUsage returns the fees paid by a sponsor for an account in the current day.
+	SponsoredUsage(ctx context.Context, in *QuerySponsoredUsageRequest, opts ...grpc.CallOption) (*QuerySponsoredUsageResponse, error)
 }
 
 type queryClient struct {
@@ -455,6 +777,33 @@ func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowanc
 	return out, nil
 }
 
+func (c *queryClient) Sponsorship(ctx context.Context, in *QuerySponsorshipRequest, opts ...grpc.CallOption) (*QuerySponsorshipResponse, error) {
+	out := new(QuerySponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/Sponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Sponsorships(ctx context.Context, in *QuerySponsorshipsRequest, opts ...grpc.CallOption) (*QuerySponsorshipsResponse, error) {
+	out := new(QuerySponsorshipsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/Sponsorships", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SponsoredUsage(ctx context.Context, in *QuerySponsoredUsageRequest, opts ...grpc.CallOption) (*QuerySponsoredUsageResponse, error) {
+	out := new(QuerySponsoredUsageResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/SponsoredUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Allowance returns granted allowance to the grantee by the granter.
@@ -463,6 +812,12 @@ type QueryServer interface {
 	Allowances(context.Context, *QueryAllowancesRequest) (*QueryAllowancesResponse, error)
 	// AllowancesByGranter returns all the grants given by an address
 	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+	// Sponsorship returns the sponsorship of a sponsor.
+	Sponsorship(context.Context, *QuerySponsorshipRequest) (*QuerySponsorshipResponse, error)
+	// Sponsorships returns all the sponsorships.
+	Sponsorships(context.Context, *QuerySponsorshipsRequest) (*QuerySponsorshipsResponse, error)
+	// SponsoredUsage returns the fees paid by a sponsor for an account in the current day.
+	SponsoredUsage(context.Context, *QuerySponsoredUsageRequest) (*QuerySponsoredUsageResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -478,6 +833,15 @@ func (*UnimplementedQueryServer) Allowances(ctx context.Context, req *QueryAllow
 func (*UnimplementedQueryServer) AllowancesByGranter(ctx context.Context, req *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
 }
+func (*UnimplementedQueryServer) Sponsorship(ctx context.Context, req *QuerySponsorshipRequest) (*QuerySponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sponsorship not implemented")
+}
+func (*UnimplementedQueryServer) Sponsorships(ctx context.Context, req *QuerySponsorshipsRequest) (*QuerySponsorshipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sponsorships not implemented")
+}
+func (*UnimplementedQueryServer) SponsoredUsage(ctx context.Context, req *QuerySponsoredUsageRequest) (*QuerySponsoredUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SponsoredUsage not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -537,6 +901,60 @@ func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Sponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Sponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/Sponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Sponsorship(ctx, req.(*QuerySponsorshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Sponsorships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorshipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Sponsorships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/Sponsorships",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Sponsorships(ctx, req.(*QuerySponsorshipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SponsoredUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsoredUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SponsoredUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/SponsoredUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SponsoredUsage(ctx, req.(*QuerySponsoredUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.feegrant.v1beta1.Query",
@@ -554,6 +972,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllowancesByGranter",
 			Handler:    _Query_AllowancesByGranter_Handler,
 		},
+		{
+			MethodName: "Sponsorship",
+			Handler:    _Query_Sponsorship_Handler,
+		},
+		{
+			MethodName: "Sponsorships",
+			Handler:    _Query_Sponsorships_Handler,
+		},
+		{
+			MethodName: "SponsoredUsage",
+			Handler:    _Query_SponsoredUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/query.proto",
@@ -813,21 +1243,242 @@ func (m *QueryAllowancesByGranterResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySponsorshipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAllowanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QuerySponsorshipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorshipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sponsorship != nil {
+		{
+			size, err := m.Sponsorship.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorshipsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorshipsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorshipsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorshipsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorshipsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorshipsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sponsorships) > 0 {
+		for iNdEx := len(m.Sponsorships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sponsorships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsoredUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsoredUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsoredUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsoredUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsoredUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsoredUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Usage != nil {
+		{
+			size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = len(m.Granter)
@@ -883,56 +1534,700 @@ func (m *QueryAllowancesResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryAllowancesByGranterRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowancesByGranterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowancesByGranterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorshipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sponsorship != nil {
+		l = m.Sponsorship.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorshipsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorshipsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sponsorships) > 0 {
+		for _, e := range m.Sponsorships {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsoredUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsoredUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Usage != nil {
+		l = m.Usage.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &Grant{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, &Grant{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryAllowancesByGranterResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Allowances) > 0 {
-		for _, e := range m.Allowances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -955,17 +2250,17 @@ func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowancesByGranterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowancesByGranterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -975,29 +2270,31 @@ func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
+			m.Allowances = append(m.Allowances, &Grant{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1007,23 +2304,27 @@ func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1046,7 +2347,7 @@ func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySponsorshipRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1069,17 +2370,17 @@ func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySponsorshipRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySponsorshipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1089,27 +2390,23 @@ func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Allowance == nil {
-				m.Allowance = &Grant{}
-			}
-			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1132,7 +2429,7 @@ func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySponsorshipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1155,17 +2452,17 @@ func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySponsorshipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsorship", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1175,25 +2472,79 @@ func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			if m.Sponsorship == nil {
+				m.Sponsorship = &Sponsorship{}
+			}
+			if err := m.Sponsorship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsorshipsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorshipsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorshipsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1250,7 +2601,7 @@ func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySponsorshipsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1273,15 +2624,15 @@ func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySponsorshipsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySponsorshipsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsorships", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1308,8 +2659,8 @@ func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowances = append(m.Allowances, &Grant{})
-			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sponsorships = append(m.Sponsorships, &Sponsorship{})
+			if err := m.Sponsorships[len(m.Sponsorships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1370,7 +2721,7 @@ func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySponsoredUsageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1393,15 +2744,15 @@ func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySponsoredUsageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySponsoredUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1429,13 +2780,13 @@ func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1445,27 +2796,23 @@ func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1488,7 +2835,7 @@ func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySponsoredUsageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1511,49 +2858,15 @@ func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySponsoredUsageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySponsoredUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Allowances = append(m.Allowances, &Grant{})
-			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1580,10 +2893,10 @@ func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Usage == nil {
+				m.Usage = &SponsoredUsage{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,130 @@
+package feegrant
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// SponsorshipUserPeriod is the period of the per account spend limit of the sponsorships.
+	SponsorshipUserPeriod = 24 * time.Hour
+
+	// MaxSponsorCandidates is the maximum number of sponsors considered to pay the fee of a
+	// transaction, bounding the state reads of the transactions without fee granter.
+	MaxSponsorCandidates = 10
+)
+
+// contractMsg is implemented by the messages executing a contract, such as the
+// CosmWasm MsgExecuteContract.
+type contractMsg interface {
+	GetContract() string
+}
+
+// SponsoredMsgKeys returns the keys under which the sponsorships of a message are
+// indexed: its type url and, for a contract message, its contract address.
+func SponsoredMsgKeys(msg sdk.Msg) []string {
+	keys := []string{sdk.MsgTypeURL(msg)}
+	if m, ok := msg.(contractMsg); ok && m.GetContract() != "" {
+		keys = append(keys, m.GetContract())
+	}
+
+	return keys
+}
+
+// Keys returns the keys under which the sponsorship is indexed: its allowed messages
+// and contracts.
+func (a SponsorshipAllowance) Keys() []string {
+	keys := make([]string, 0, len(a.AllowedMessages)+len(a.AllowedContracts))
+	keys = append(keys, a.AllowedMessages...)
+	return append(keys, a.AllowedContracts...)
+}
+
+// Allows returns whether the fees of the message are sponsored, the message type or
+// the contract it executes being allowed.
+func (a SponsorshipAllowance) Allows(msg sdk.Msg) bool {
+	for _, key := range SponsoredMsgKeys(msg) {
+		for _, allowed := range a.Keys() {
+			if key == allowed {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Accept checks whether the sponsorship pays the fee of the msgs of an account, given
+// the fees already paid for the account in the current period. On acceptance, the
+// spend limit and the usage are updated. If remove is true, the sponsorship is used up
+// or expired and must be deleted.
+func (a *SponsorshipAllowance) Accept(now time.Time, usage *SponsoredUsage, fee sdk.Coins, msgs []sdk.Msg) (remove bool, err error) {
+	if a.Expiration != nil && a.Expiration.Before(now) {
+		return true, errorsmod.Wrap(ErrFeeLimitExpired, "sponsorship")
+	}
+
+	for _, msg := range msgs {
+		if !a.Allows(msg) {
+			return false, errorsmod.Wrapf(ErrMessageNotAllowed, "message does not exist in sponsorship: %s", sdk.MsgTypeURL(msg))
+		}
+	}
+
+	left, invalid := a.SpendLimit.SafeSub(fee...)
+	if invalid {
+		return false, errorsmod.Wrap(ErrFeeLimitExceeded, "sponsorship")
+	}
+
+	spent := usage.Spent
+	periodReset := usage.PeriodReset
+	if !now.Before(periodReset) {
+		spent = sdk.NewCoins()
+		periodReset = now.Add(SponsorshipUserPeriod)
+	}
+
+	spent = spent.Add(fee...)
+	if !a.UserDailySpendLimit.IsAllGTE(spent) {
+		return false, errorsmod.Wrap(ErrFeeLimitExceeded, "sponsorship user daily spend limit")
+	}
+
+	a.SpendLimit = left
+	usage.Spent = spent
+	usage.PeriodReset = periodReset
+
+	return left.IsZero(), nil
+}
+
+// ValidateBasic performs basic sanity checks of the sponsorship, the contract
+// addresses being validated by the keeper.
+func (a SponsorshipAllowance) ValidateBasic() error {
+	if len(a.AllowedMessages) == 0 && len(a.AllowedContracts) == 0 {
+		return errorsmod.Wrap(ErrNoMessages, "sponsorship must allow messages or contracts")
+	}
+
+	seen := make(map[string]bool)
+	for _, key := range a.Keys() {
+		if key == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "sponsorship allowed message or contract cannot be empty")
+		}
+		if seen[key] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate sponsorship allowed message or contract: %s", key)
+		}
+		seen[key] = true
+	}
+
+	if !a.SpendLimit.IsValid() || a.SpendLimit.Empty() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "sponsorship spend limit must be positive: %s", a.SpendLimit)
+	}
+
+	if !a.UserDailySpendLimit.IsValid() || a.UserDailySpendLimit.Empty() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "sponsorship user daily spend limit must be positive: %s", a.UserDailySpendLimit)
+	}
+
+	if a.Expiration != nil && a.Expiration.Unix() < 0 {
+		return errorsmod.Wrap(ErrInvalidDuration, "expiration time cannot be negative")
+	}
+
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	any "github.com/cosmos/gogoproto/types/any"
@@ -308,6 +309,181 @@ func (m *MsgPruneAllowancesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPruneAllowancesResponse proto.InternalMessageInfo
 
+// MsgGrantSponsorship sets the sponsorship of the granter's account.
+type MsgGrantSponsorship struct {
+	// granter is the address of the account sponsoring the fees.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// allowance is the sponsorship allowance of the granter.
+	Allowance SponsorshipAllowance `protobuf:"bytes,2,opt,name=allowance,proto3" json:"allowance"`
+}
+
+func (m *MsgGrantSponsorship) Reset()         { *m = MsgGrantSponsorship{} }
+func (m *MsgGrantSponsorship) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantSponsorship) ProtoMessage()    {}
+func (*MsgGrantSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{6}
+}
+func (m *MsgGrantSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantSponsorship.Merge(m, src)
+}
+func (m *MsgGrantSponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantSponsorship proto.InternalMessageInfo
+
+func (m *MsgGrantSponsorship) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *MsgGrantSponsorship) GetAllowance() SponsorshipAllowance {
+	if m != nil {
+		return m.Allowance
+	}
+	return SponsorshipAllowance{}
+}
+
+// MsgGrantSponsorshipResponse defines the Msg/GrantSponsorship response type.
+type MsgGrantSponsorshipResponse struct {
+}
+
+func (m *MsgGrantSponsorshipResponse) Reset()         { *m = MsgGrantSponsorshipResponse{} }
+func (m *MsgGrantSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantSponsorshipResponse) ProtoMessage()    {}
+func (*MsgGrantSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{7}
+}
+func (m *MsgGrantSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantSponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantSponsorshipResponse.Merge(m, src)
+}
+func (m *MsgGrantSponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantSponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantSponsorshipResponse proto.InternalMessageInfo
+
+// MsgRevokeSponsorship removes the sponsorship of the granter's account.
+type MsgRevokeSponsorship struct {
+	// granter is the address of the account sponsoring the fees.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+}
+
+func (m *MsgRevokeSponsorship) Reset()         { *m = MsgRevokeSponsorship{} }
+func (m *MsgRevokeSponsorship) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeSponsorship) ProtoMessage()    {}
+func (*MsgRevokeSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{8}
+}
+func (m *MsgRevokeSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeSponsorship.Merge(m, src)
+}
+func (m *MsgRevokeSponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeSponsorship proto.InternalMessageInfo
+
+func (m *MsgRevokeSponsorship) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+// MsgRevokeSponsorshipResponse defines the Msg/RevokeSponsorship response type.
+type MsgRevokeSponsorshipResponse struct {
+}
+
+func (m *MsgRevokeSponsorshipResponse) Reset()         { *m = MsgRevokeSponsorshipResponse{} }
+func (m *MsgRevokeSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeSponsorshipResponse) ProtoMessage()    {}
+func (*MsgRevokeSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{9}
+}
+func (m *MsgRevokeSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeSponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeSponsorshipResponse.Merge(m, src)
+}
+func (m *MsgRevokeSponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeSponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeSponsorshipResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgGrantAllowance)(nil), "cosmos.feegrant.v1beta1.MsgGrantAllowance")
 	proto.RegisterType((*MsgGrantAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgGrantAllowanceResponse")
@@ -315,43 +491,57 @@ func init() {
 	proto.RegisterType((*MsgRevokeAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgRevokeAllowanceResponse")
 	proto.RegisterType((*MsgPruneAllowances)(nil), "cosmos.feegrant.v1beta1.MsgPruneAllowances")
 	proto.RegisterType((*MsgPruneAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.MsgPruneAllowancesResponse")
+	proto.RegisterType((*MsgGrantSponsorship)(nil), "cosmos.feegrant.v1beta1.MsgGrantSponsorship")
+	proto.RegisterType((*MsgGrantSponsorshipResponse)(nil), "cosmos.feegrant.v1beta1.MsgGrantSponsorshipResponse")
+	proto.RegisterType((*MsgRevokeSponsorship)(nil), "cosmos.feegrant.v1beta1.MsgRevokeSponsorship")
+	proto.RegisterType((*MsgRevokeSponsorshipResponse)(nil), "cosmos.feegrant.v1beta1.MsgRevokeSponsorshipResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/feegrant/v1beta1/tx.proto", fileDescriptor_dd44ad7946dad783) }
 
 var fileDescriptor_dd44ad7946dad783 = []byte{
-	// 483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4b, 0x4d, 0x4d, 0x2f, 0x4a, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x07,
-	0x2b, 0x4b, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xe8, 0x91, 0x92, 0x84, 0xe8, 0x89, 0x07,
-	0xf3, 0xf4, 0xa1, 0x06, 0x40, 0xa4, 0xa0, 0xc6, 0xe9, 0xe7, 0x16, 0xa7, 0xeb, 0x97, 0x19, 0x82,
-	0x28, 0xa8, 0x84, 0x60, 0x62, 0x6e, 0x66, 0x5e, 0xbe, 0x3e, 0x98, 0x84, 0x08, 0x29, 0x75, 0x32,
-	0x71, 0x09, 0xfa, 0x16, 0xa7, 0xbb, 0x83, 0xac, 0x75, 0xcc, 0xc9, 0xc9, 0x2f, 0x4f, 0xcc, 0x4b,
-	0x4e, 0x15, 0x32, 0xe2, 0x62, 0x07, 0x3b, 0x24, 0xb5, 0x48, 0x82, 0x51, 0x81, 0x51, 0x83, 0xd3,
-	0x49, 0xe2, 0xd2, 0x16, 0x5d, 0x11, 0xa8, 0x25, 0x8e, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0xc5, 0xc1,
-	0x25, 0x45, 0x99, 0x79, 0xe9, 0x41, 0x30, 0x85, 0x08, 0x3d, 0xa9, 0x12, 0x4c, 0xc4, 0xe9, 0x49,
-	0x15, 0x8a, 0xe5, 0xe2, 0x4c, 0x84, 0x59, 0x2a, 0xc1, 0xac, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa2,
-	0x07, 0xf1, 0xb3, 0x1e, 0xcc, 0xcf, 0x7a, 0x8e, 0x79, 0x95, 0x4e, 0x9a, 0xa7, 0xb6, 0xe8, 0xaa,
-	0xe2, 0x08, 0x25, 0x3d, 0xb7, 0xd4, 0x54, 0xb8, 0xd3, 0x3d, 0x83, 0x10, 0x26, 0x5a, 0xe9, 0x36,
-	0x3d, 0xdf, 0xa0, 0x05, 0x73, 0x60, 0xd7, 0xf3, 0x0d, 0x5a, 0x32, 0x10, 0x23, 0x74, 0x8b, 0x53,
-	0xb2, 0xf5, 0x31, 0x7c, 0xad, 0x24, 0xcd, 0x25, 0x89, 0x21, 0x18, 0x94, 0x5a, 0x5c, 0x90, 0x9f,
-	0x57, 0x9c, 0xaa, 0xb4, 0x86, 0x91, 0x4b, 0xc8, 0xb7, 0x38, 0x3d, 0x28, 0xb5, 0x2c, 0x3f, 0x3b,
-	0x95, 0xee, 0x21, 0x65, 0xa5, 0x87, 0xee, 0x15, 0x59, 0x54, 0xaf, 0xa0, 0xb9, 0x4b, 0x49, 0x86,
-	0x4b, 0x0a, 0x53, 0x14, 0xee, 0x99, 0x34, 0xb0, 0x5f, 0x02, 0x8a, 0x4a, 0xf3, 0x10, 0x92, 0xc5,
-	0x42, 0x06, 0x5c, 0x6c, 0x05, 0x20, 0x21, 0xc2, 0x5e, 0x81, 0xaa, 0xb3, 0x92, 0xbb, 0xb4, 0x45,
-	0x97, 0x1f, 0xe1, 0x10, 0x05, 0x03, 0x3d, 0x53, 0x03, 0x90, 0x43, 0xa1, 0xf2, 0x4a, 0x86, 0x60,
-	0x57, 0xa0, 0xd9, 0x03, 0x73, 0x85, 0x95, 0x30, 0x16, 0xdd, 0x46, 0xff, 0x98, 0xb8, 0x98, 0x7d,
-	0x8b, 0xd3, 0x85, 0x0a, 0xb8, 0xf8, 0xd0, 0x12, 0xa5, 0x96, 0x1e, 0xae, 0x04, 0x80, 0x11, 0x6b,
-	0x52, 0x46, 0xc4, 0xab, 0x85, 0x39, 0x47, 0xa8, 0x98, 0x8b, 0x1f, 0x3d, 0x76, 0xb5, 0xf1, 0x19,
-	0x83, 0xa6, 0x58, 0xca, 0x98, 0x04, 0xc5, 0x70, 0x4b, 0x3b, 0x18, 0xb9, 0xf8, 0xd1, 0xe3, 0x01,
-	0xaf, 0xad, 0x68, 0x8a, 0xf1, 0xdb, 0x8a, 0x23, 0xe4, 0x95, 0x84, 0x4f, 0x61, 0x86, 0xbc, 0x14,
-	0x6b, 0xc3, 0xf3, 0x0d, 0x5a, 0x8c, 0x4e, 0x86, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7,
-	0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c,
-	0xc7, 0x10, 0x05, 0x2d, 0x57, 0x8a, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x2b, 0xe0, 0x05, 0x5a,
-	0x12, 0x1b, 0x38, 0xaf, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x32, 0x6b, 0x8d, 0xea,
-	0x04, 0x00, 0x00,
+	// 644 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0x4d, 0x8f, 0xd2, 0x5c,
+	0x14, 0xc7, 0xb9, 0x4c, 0x66, 0x9e, 0x70, 0x9f, 0x44, 0x86, 0xd2, 0x44, 0xa6, 0x83, 0x1d, 0xd2,
+	0x44, 0x33, 0xa2, 0xbd, 0xa5, 0x30, 0xb3, 0x61, 0x07, 0x1a, 0x8d, 0x0b, 0x12, 0xc3, 0x24, 0x2e,
+	0x4c, 0x8c, 0x29, 0xc3, 0xa5, 0x12, 0xa0, 0x97, 0xf4, 0x32, 0x38, 0xb3, 0x33, 0xae, 0xd4, 0x98,
+	0x38, 0xdf, 0xc0, 0xad, 0x0b, 0x17, 0x2c, 0xf8, 0x10, 0x13, 0x56, 0x13, 0x56, 0xae, 0x8c, 0x81,
+	0x44, 0xbe, 0x86, 0xe9, 0x2b, 0xb5, 0x2d, 0x6f, 0x9a, 0xb8, 0xe1, 0xe5, 0xdc, 0xdf, 0x39, 0xe7,
+	0x7f, 0xce, 0x3d, 0xa7, 0x85, 0x99, 0x53, 0x42, 0x3b, 0x84, 0x4a, 0x0d, 0x8c, 0x55, 0x5d, 0xd1,
+	0x7a, 0x52, 0x5f, 0xae, 0xe1, 0x9e, 0x22, 0x4b, 0xbd, 0x73, 0xd4, 0xd5, 0x49, 0x8f, 0x30, 0x37,
+	0x2d, 0x02, 0x39, 0x04, 0xb2, 0x09, 0x8e, 0x55, 0x89, 0x4a, 0x4c, 0x46, 0x32, 0x7e, 0x59, 0x38,
+	0xb7, 0xa7, 0x12, 0xa2, 0xb6, 0xb1, 0x64, 0xfe, 0xab, 0x9d, 0x35, 0x24, 0x45, 0xbb, 0x70, 0x8e,
+	0xac, 0x48, 0x2f, 0x2d, 0x1f, 0x3b, 0xac, 0x75, 0x64, 0x27, 0x91, 0x3a, 0x54, 0x95, 0xfa, 0xb2,
+	0xf1, 0x65, 0x1f, 0x24, 0x94, 0x4e, 0x53, 0x23, 0x92, 0xf9, 0x69, 0x9b, 0xee, 0x2c, 0x92, 0xec,
+	0x2a, 0x34, 0x39, 0xe1, 0x7d, 0x14, 0x26, 0x2a, 0x54, 0x7d, 0x6c, 0x98, 0x4a, 0xed, 0x36, 0x79,
+	0xad, 0x68, 0xa7, 0x98, 0xc9, 0xc3, 0xff, 0x4c, 0x08, 0xeb, 0x29, 0x90, 0x01, 0x87, 0xb1, 0x72,
+	0x6a, 0x3c, 0x14, 0x59, 0x5b, 0x4c, 0xa9, 0x5e, 0xd7, 0x31, 0xa5, 0x27, 0x3d, 0xbd, 0xa9, 0xa9,
+	0x55, 0x07, 0x9c, 0xfb, 0xe0, 0x54, 0x74, 0x3d, 0x1f, 0xcc, 0xbc, 0x80, 0x31, 0xc5, 0x49, 0x9a,
+	0xda, 0xca, 0x80, 0xc3, 0xff, 0xf3, 0x2c, 0xb2, 0x7a, 0x83, 0x9c, 0xde, 0xa0, 0x92, 0x76, 0x51,
+	0xbe, 0x3b, 0x1a, 0x8a, 0xb7, 0x17, 0xf4, 0x18, 0x3d, 0xc2, 0xd8, 0x95, 0xfe, 0xa4, 0x3a, 0x8f,
+	0x58, 0x14, 0xdf, 0xce, 0x06, 0x59, 0x47, 0xe0, 0x87, 0xd9, 0x20, 0x9b, 0xb6, 0x42, 0x88, 0xb4,
+	0xde, 0x92, 0x02, 0x55, 0x0b, 0xfb, 0x70, 0x2f, 0x60, 0xac, 0x62, 0xda, 0x25, 0x1a, 0xc5, 0xc2,
+	0x57, 0x00, 0x99, 0x0a, 0x55, 0xab, 0xb8, 0x4f, 0x5a, 0xf8, 0x9f, 0x77, 0xaa, 0x88, 0xfc, 0xa5,
+	0xdc, 0xfa, 0xbd, 0x14, 0x9f, 0x2e, 0x21, 0x0d, 0xb9, 0xa0, 0xd5, 0x2d, 0xa6, 0x61, 0xd6, 0xf2,
+	0x54, 0x3f, 0xd3, 0xe6, 0x87, 0x94, 0xc9, 0xc1, 0x9d, 0xae, 0x61, 0x5a, 0x5d, 0x8a, 0xcd, 0x15,
+	0xf9, 0xf1, 0x50, 0x8c, 0xcf, 0x85, 0x64, 0x72, 0xe8, 0x38, 0x67, 0x08, 0xb5, 0xcf, 0x05, 0x19,
+	0x72, 0xc1, 0x3c, 0x8e, 0x8a, 0x62, 0x32, 0xc4, 0x5b, 0xf8, 0x09, 0x60, 0xd2, 0xb9, 0x85, 0x13,
+	0x83, 0x23, 0x3a, 0x7d, 0xd5, 0xec, 0xfe, 0x51, 0xa3, 0x9f, 0x79, 0xc7, 0x2b, 0x6a, 0x8e, 0x97,
+	0x88, 0x16, 0x4d, 0x91, 0x27, 0x99, 0xab, 0xb5, 0x1c, 0xbb, 0xfa, 0x7e, 0x10, 0xf9, 0x32, 0x1b,
+	0x64, 0x81, 0x77, 0xae, 0x1e, 0x8c, 0x87, 0xe2, 0xee, 0xb9, 0xbb, 0x4a, 0x19, 0x19, 0xe5, 0x50,
+	0xce, 0x7f, 0x41, 0x7c, 0xc8, 0xac, 0x79, 0x72, 0x08, 0x05, 0xb8, 0x1f, 0x62, 0x76, 0x9b, 0xc3,
+	0x86, 0xe5, 0x10, 0x2e, 0x01, 0x64, 0xdd, 0x7b, 0xfd, 0xcb, 0xf6, 0x14, 0x1f, 0xae, 0x53, 0xc6,
+	0x41, 0xd8, 0x9c, 0x79, 0xeb, 0x38, 0x82, 0xe9, 0x30, 0xfb, 0xf2, 0x42, 0xf2, 0x9f, 0xb7, 0xe1,
+	0x56, 0x85, 0xaa, 0x4c, 0x17, 0xde, 0xf0, 0x3d, 0x7b, 0xb2, 0x0b, 0x6f, 0x28, 0xb0, 0x9c, 0x5c,
+	0x7e, 0x7d, 0xd6, 0xd1, 0xc3, 0x50, 0x18, 0xf7, 0x2f, 0xf1, 0xbd, 0x65, 0x61, 0x7c, 0x30, 0x57,
+	0xd8, 0x00, 0x76, 0x93, 0xbe, 0x03, 0x30, 0xee, 0x5f, 0xb7, 0xa5, 0x59, 0x7d, 0x30, 0x57, 0xd8,
+	0x00, 0x76, 0xd7, 0x3c, 0x39, 0x0a, 0x2e, 0x18, 0xf3, 0x11, 0xc0, 0xdd, 0xc0, 0x76, 0xdd, 0x5f,
+	0xd9, 0x48, 0x0f, 0xcd, 0x1d, 0x6d, 0x42, 0xbb, 0x6a, 0xd8, 0x51, 0xc8, 0x20, 0x30, 0x9f, 0x00,
+	0x4c, 0x04, 0xc7, 0x59, 0x5c, 0xdd, 0x64, 0xaf, 0xa0, 0xe3, 0x8d, 0xf0, 0xe5, 0x8a, 0xb8, 0xed,
+	0x37, 0xc6, 0xbe, 0x97, 0xe5, 0xab, 0x09, 0x0f, 0xae, 0x27, 0x3c, 0xf8, 0x31, 0xe1, 0xc1, 0xe5,
+	0x94, 0x8f, 0x5c, 0x4f, 0xf9, 0xc8, 0xb7, 0x29, 0x1f, 0x79, 0x6e, 0xbf, 0x87, 0x69, 0xbd, 0x85,
+	0x9a, 0x44, 0x9a, 0xfb, 0xd7, 0x76, 0xcc, 0x77, 0x56, 0xe1, 0xd7, 0x00, 0x0a, 0x1a, 0x28, 0xeb,
+	0x30, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -374,6 +564,11 @@ type MsgClient interface {
 	RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance, opts ...grpc.CallOption) (*MsgRevokeAllowanceResponse, error)
 	// PruneAllowances prunes expired fee allowances, currently up to 75 at a time.
 	PruneAllowances(ctx context.Context, in *MsgPruneAllowances, opts ...grpc.CallOption) (*MsgPruneAllowancesResponse, error)
+	// GrantSponsorship sets the sponsorship of the granter's account, paying the fees of
+	// any account for the transactions of the allowed messages or contracts.
+	GrantSponsorship(ctx context.Context, in *MsgGrantSponsorship, opts ...grpc.CallOption) (*MsgGrantSponsorshipResponse, error)
+	// RevokeSponsorship revokes the sponsorship of the granter's account.
+	RevokeSponsorship(ctx context.Context, in *MsgRevokeSponsorship, opts ...grpc.CallOption) (*MsgRevokeSponsorshipResponse, error)
 }
 
 type msgClient struct {
@@ -411,6 +606,24 @@ func (c *msgClient) PruneAllowances(ctx context.Context, in *MsgPruneAllowances,
 	return out, nil
 }
 
+func (c *msgClient) GrantSponsorship(ctx context.Context, in *MsgGrantSponsorship, opts ...grpc.CallOption) (*MsgGrantSponsorshipResponse, error) {
+	out := new(MsgGrantSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/GrantSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeSponsorship(ctx context.Context, in *MsgRevokeSponsorship, opts ...grpc.CallOption) (*MsgRevokeSponsorshipResponse, error) {
+	out := new(MsgRevokeSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/RevokeSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// GrantAllowance grants fee allowance to the grantee on the granter's
@@ -421,6 +634,11 @@ type MsgServer interface {
 	RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error)
 	// PruneAllowances prunes expired fee allowances, currently up to 75 at a time.
 	PruneAllowances(context.Context, *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error)
+	// GrantSponsorship sets the sponsorship of the granter's account, paying the fees of
+	// any account for the transactions of the allowed messages or contracts.
+	GrantSponsorship(context.Context, *MsgGrantSponsorship) (*MsgGrantSponsorshipResponse, error)
+	// RevokeSponsorship revokes the sponsorship of the granter's account.
+	RevokeSponsorship(context.Context, *MsgRevokeSponsorship) (*MsgRevokeSponsorshipResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -436,6 +654,12 @@ func (*UnimplementedMsgServer) RevokeAllowance(ctx context.Context, req *MsgRevo
 func (*UnimplementedMsgServer) PruneAllowances(ctx context.Context, req *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneAllowances not implemented")
 }
+func (*UnimplementedMsgServer) GrantSponsorship(ctx context.Context, req *MsgGrantSponsorship) (*MsgGrantSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantSponsorship not implemented")
+}
+func (*UnimplementedMsgServer) RevokeSponsorship(ctx context.Context, req *MsgRevokeSponsorship) (*MsgRevokeSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSponsorship not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -495,6 +719,42 @@ func _Msg_PruneAllowances_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_GrantSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/GrantSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantSponsorship(ctx, req.(*MsgGrantSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/RevokeSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeSponsorship(ctx, req.(*MsgRevokeSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.feegrant.v1beta1.Msg",
@@ -512,6 +772,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PruneAllowances",
 			Handler:    _Msg_PruneAllowances_Handler,
 		},
+		{
+			MethodName: "GrantSponsorship",
+			Handler:    _Msg_GrantSponsorship_Handler,
+		},
+		{
+			MethodName: "RevokeSponsorship",
+			Handler:    _Msg_RevokeSponsorship_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/tx.proto",
@@ -702,6 +970,122 @@ func (m *MsgPruneAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgGrantSponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantSponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeSponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeSponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -791,8 +1175,54 @@ func (m *MsgPruneAllowancesResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgGrantSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Allowance.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgGrantSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -1293,6 +1723,303 @@ func (m *MsgPruneAllowancesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgGrantSponsorship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantSponsorship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantSponsorshipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantSponsorshipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeSponsorship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeSponsorship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeSponsorshipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeSponsorshipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
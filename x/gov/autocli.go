@@ -94,6 +94,47 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "constitution",
 					Short:     "Query the current chain constitution",
 				},
+				{
+					RpcMethod: "ConvictionLock",
+					Use:       "conviction-lock <voter>",
+					Short:     "Query the conviction lock of a voter",
+					Example:   fmt.Sprintf("%s query gov conviction-lock cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "voter"},
+					},
+				},
+				{
+					RpcMethod: "ConvictionLocks",
+					Use:       "conviction-locks",
+					Short:     "Query all the conviction locks",
+				},
+				{
+					RpcMethod: "Governor",
+					Use:       "governor <governor-address>",
+					Short:     "Query a governor and the voting power delegated to it",
+					Example:   fmt.Sprintf("%s query gov governor cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "governor_address"},
+					},
+				},
+				{
+					RpcMethod: "Governors",
+					Use:       "governors",
+					Short:     "Query all the governors and the voting power delegated to them",
+				},
+				{
+					RpcMethod: "GovernanceDelegation",
+					Use:       "governance-delegation <delegator-address>",
+					Short:     "Query the governor a token holder delegated its governance voting power to",
+					Example:   fmt.Sprintf("%s query gov governance-delegation cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
+				{
+					RpcMethod: "SimulateProposal",
+					Skip:      true, // skipped because a manual command reads the messages from a proposal file
+				},
 			},
 			EnhanceCustomCommand: true, // We still have manual commands in gov that we want to keep
 		},
@@ -154,6 +195,50 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod: "LockConviction",
+					Use:       "lock-conviction <amount> <conviction>",
+					Short:     "Lock delegated tokens for a conviction from 1 to 6, multiplying their voting power",
+					Long:      "Lock delegated tokens for the conviction lock period multiplied by the conviction, from 1 to 6. The voting power of the locked tokens is multiplied by the conviction and they cannot be undelegated nor redelegated until the lock expires.",
+					Example:   fmt.Sprintf("$ %s tx gov lock-conviction 1000000 3 --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "amount"},
+						{ProtoField: "conviction"},
+					},
+				},
+				{
+					RpcMethod: "CreateGovernor",
+					Use:       "create-governor <moniker>",
+					Short:     "Register as a governor, to which token holders can delegate their governance voting power",
+					Example:   fmt.Sprintf(`$ %s tx gov create-governor "My Governor" --details "..." --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "moniker"},
+					},
+				},
+				{
+					RpcMethod: "EditGovernor",
+					Use:       "edit-governor <moniker>",
+					Short:     "Edit the description of a governor",
+					Example:   fmt.Sprintf(`$ %s tx gov edit-governor "My Governor" --details "..." --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "moniker"},
+					},
+				},
+				{
+					RpcMethod: "DelegateGovernor",
+					Use:       "delegate-governor <governor-address>",
+					Short:     "Delegate governance voting power to a governor, who votes on behalf of the delegator unless it votes itself",
+					Example:   fmt.Sprintf("$ %s tx gov delegate-governor cosmos1... --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "governor_address"},
+					},
+				},
+				{
+					RpcMethod: "UndelegateGovernor",
+					Use:       "undelegate-governor",
+					Short:     "Remove the delegation of governance voting power to a governor",
+					Example:   fmt.Sprintf("$ %s tx gov undelegate-governor --from mykey", version.AppName),
+				},
 			},
 			EnhanceCustomCommand: true, // We still have manual commands in gov that we want to keep
 		},
@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// NewQueryCmd returns the manual query commands for this module.
+// The other query commands are generated by autocli.
+func NewQueryCmd() *cobra.Command {
+	govQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the governance module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	govQueryCmd.AddCommand(
+		NewCmdSimulateProposal(),
+	)
+
+	return govQueryCmd
+}
+
+// NewCmdSimulateProposal implements the command simulating the execution of the messages of a proposal.
+func NewCmdSimulateProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-proposal <path/to/proposal.json>",
+		Short: "Simulate the execution of the messages of a proposal against the current state",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Simulate the execution of the messages of a proposal against the current state,
+as if the proposal had passed, without persisting any state change.
+The proposal file has the same format as the one of submit-proposal, only its messages are used.
+The message responses, the emitted events, the gas used and the execution error, if any, are returned.
+
+Example:
+$ %s query gov simulate-proposal path/to/proposal.json
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, msgs, _, err := parseSubmitProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			req, err := v1.NewQuerySimulateProposalRequest(msgs)
+			if err != nil {
+				return err
+			}
+
+			res, err := v1.NewQueryClient(clientCtx).SimulateProposal(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
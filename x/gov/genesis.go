@@ -73,6 +73,29 @@ func InitGenesis(ctx context.Context, ak types.AccountKeeper, bk types.BankKeepe
 		}
 	}
 
+	for _, lock := range data.ConvictionLocks {
+		if err := k.SetConvictionLock(ctx, *lock); err != nil {
+			return err
+		}
+	}
+
+	for _, governor := range data.Governors {
+		addr, err := ak.AddressCodec().StringToBytes(governor.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.Governors.Set(ctx, addr, *governor); err != nil {
+			return err
+		}
+	}
+
+	for _, delegation := range data.GovernanceDelegations {
+		if err := k.SetGovernanceDelegation(ctx, *delegation); err != nil {
+			return err
+		}
+	}
+
 	// if account has zero balance it probably means it's not set, so we set it
 	balance := bk.GetAllBalances(ctx, moduleAcc.GetAddress())
 	if balance.IsZero() {
@@ -131,12 +154,42 @@ func ExportGenesis(ctx context.Context, k *keeper.Keeper) (*v1.GenesisState, err
 		return nil, err
 	}
 
+	var convictionLocks []*v1.ConvictionLock
+	err = k.ConvictionLocks.Walk(ctx, nil, func(_ sdk.AccAddress, value v1.ConvictionLock) (stop bool, err error) {
+		convictionLocks = append(convictionLocks, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var governors []*v1.Governor
+	err = k.Governors.Walk(ctx, nil, func(_ sdk.AccAddress, value v1.Governor) (stop bool, err error) {
+		governors = append(governors, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var governanceDelegations []*v1.GovernanceDelegation
+	err = k.GovernanceDelegations.Walk(ctx, nil, func(_ sdk.AccAddress, value v1.GovernanceDelegation) (stop bool, err error) {
+		governanceDelegations = append(governanceDelegations, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &v1.GenesisState{
-		StartingProposalId: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		Params:             &params,
-		Constitution:       constitution,
+		StartingProposalId:    startingProposalID,
+		Deposits:              proposalsDeposits,
+		Votes:                 proposalsVotes,
+		Proposals:             proposals,
+		Params:                &params,
+		Constitution:          constitution,
+		ConvictionLocks:       convictionLocks,
+		Governors:             governors,
+		GovernanceDelegations: governanceDelegations,
 	}, nil
 }
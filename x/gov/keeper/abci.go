@@ -277,7 +277,9 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 			k.Logger.Error("failed to emit event", "error", err)
 		}
 	}
-	return nil
+
+	// delete the conviction locks which have expired
+	return k.pruneConvictionLocks(ctx)
 }
 
 // executes route(msg) and recovers from panic.
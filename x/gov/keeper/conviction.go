@@ -0,0 +1,307 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LockConviction locks an amount of the delegated tokens of a voter for the conviction lock period
+// multiplied by the conviction, and returns the time at which the lock expires.
+// An active lock can only be replaced by a lock of the same or a larger amount and conviction,
+// expiring at the same time or later.
+func (k Keeper) LockConviction(ctx context.Context, voter sdk.AccAddress, amount math.Int, conviction uint32) (time.Time, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if !params.ConvictionVotingEnabled() {
+		return time.Time{}, types.ErrConvictionDisabled
+	}
+
+	if conviction == 0 || conviction > v1.MaxConviction {
+		return time.Time{}, types.ErrInvalidConvictionLock.Wrapf("conviction must be between 1 and %d: %d", v1.MaxConviction, conviction)
+	}
+
+	if amount.IsNil() || !amount.IsPositive() {
+		return time.Time{}, types.ErrInvalidConvictionLock.Wrapf("amount must be positive: %s", amount)
+	}
+
+	delegated, err := k.delegatedTokens(ctx, voter, nil)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if amount.GT(delegated) {
+		return time.Time{}, types.ErrInvalidConvictionLock.Wrapf("amount %s is greater than the delegated tokens %s", amount, delegated)
+	}
+
+	unlockTime := k.HeaderService.HeaderInfo(ctx).Time.Add(*params.ConvictionLockPeriod * time.Duration(conviction))
+
+	existing, found, err := k.activeConvictionLock(ctx, voter)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if found {
+		if amount.LT(existing.Amount) || conviction < existing.Conviction || unlockTime.Before(existing.UnlockTime) {
+			return time.Time{}, types.ErrInvalidConvictionLock.Wrap("an active lock cannot be reduced or shortened")
+		}
+
+		if err := k.ConvictionLockQueue.Remove(ctx, collections.Join(existing.UnlockTime, voter)); err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	voterStr, err := k.authKeeper.AddressCodec().BytesToString(voter)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := k.SetConvictionLock(ctx, v1.ConvictionLock{
+		Voter:      voterStr,
+		Amount:     amount,
+		Conviction: conviction,
+		UnlockTime: unlockTime,
+	}); err != nil {
+		return time.Time{}, err
+	}
+
+	return unlockTime, nil
+}
+
+// SetConvictionLock sets a conviction lock and queues it for pruning once it expires.
+func (k Keeper) SetConvictionLock(ctx context.Context, lock v1.ConvictionLock) error {
+	voter, err := k.authKeeper.AddressCodec().StringToBytes(lock.Voter)
+	if err != nil {
+		return err
+	}
+
+	if err := k.ConvictionLocks.Set(ctx, voter, lock); err != nil {
+		return err
+	}
+
+	return k.ConvictionLockQueue.Set(ctx, collections.Join(lock.UnlockTime, sdk.AccAddress(voter)))
+}
+
+// activeConvictionLock returns the conviction lock of a voter, and whether it has one that
+// has not expired yet.
+func (k Keeper) activeConvictionLock(ctx context.Context, voter sdk.AccAddress) (v1.ConvictionLock, bool, error) {
+	lock, err := k.ConvictionLocks.Get(ctx, voter)
+	if errors.Is(err, collections.ErrNotFound) {
+		return v1.ConvictionLock{}, false, nil
+	} else if err != nil {
+		return v1.ConvictionLock{}, false, err
+	}
+
+	if !lock.UnlockTime.After(k.HeaderService.HeaderInfo(ctx).Time) {
+		return v1.ConvictionLock{}, false, nil
+	}
+
+	return lock, true, nil
+}
+
+// pruneConvictionLocks deletes the conviction locks which have expired.
+func (k Keeper) pruneConvictionLocks(ctx context.Context) error {
+	rng := collections.NewPrefixUntilPairRange[time.Time, sdk.AccAddress](k.HeaderService.HeaderInfo(ctx).Time)
+	iter, err := k.ConvictionLockQueue.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+
+	expired, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		if err := k.ConvictionLocks.Remove(ctx, key.K2()); err != nil {
+			return err
+		}
+
+		if err := k.ConvictionLockQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// convictionBonus returns the voting power a voter gets on top of its voting power from its
+// active conviction lock. Only the locked tokens still backing the voting power are multiplied.
+func (k Keeper) convictionBonus(ctx context.Context, voter sdk.AccAddress, votingPower math.LegacyDec) (math.LegacyDec, error) {
+	lock, found, err := k.activeConvictionLock(ctx, voter)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if !found || lock.Conviction <= 1 {
+		return math.LegacyZeroDec(), nil
+	}
+
+	locked := math.LegacyMinDec(votingPower, lock.Amount.ToLegacyDec())
+	return locked.MulInt64(int64(lock.Conviction - 1)), nil
+}
+
+// delegatedTokens returns the tokens delegated by a delegator, excluding its delegation to
+// a given validator if any.
+func (k Keeper) delegatedTokens(ctx context.Context, delAddr sdk.AccAddress, excluded sdk.ValAddress) (math.Int, error) {
+	total := math.LegacyZeroDec()
+	var iterErr error
+	err := k.sk.IterateDelegations(ctx, delAddr, func(_ int64, delegation sdk.DelegationI) (stop bool) {
+		valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(delegation.GetValidatorAddr())
+		if err != nil {
+			iterErr = err
+			return true
+		}
+
+		if excluded != nil && excluded.Equals(sdk.ValAddress(valAddr)) {
+			return false
+		}
+
+		validator, err := k.sk.Validator(ctx, valAddr)
+		if err != nil {
+			iterErr = err
+			return true
+		}
+
+		total = total.Add(validator.TokensFromShares(delegation.GetShares()))
+		return false
+	})
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	if iterErr != nil {
+		return math.Int{}, iterErr
+	}
+
+	return total.TruncateInt(), nil
+}
+
+// checkConvictionLock returns an error if the tokens delegated by a voter, excluding its delegation
+// to a given validator if any, do not cover the amount of its active conviction lock. If the tokens
+// delegated before the change are known, only a change decreasing them is rejected, so that a voter
+// whose delegations were slashed below its lock can still add to them.
+func (k Keeper) checkConvictionLock(ctx context.Context, voter sdk.AccAddress, excluded sdk.ValAddress, before math.Int) error {
+	lock, found, err := k.activeConvictionLock(ctx, voter)
+	if err != nil || !found {
+		return err
+	}
+
+	delegated, err := k.delegatedTokens(ctx, voter, excluded)
+	if err != nil {
+		return err
+	}
+
+	if !before.IsNil() && delegated.GTE(before) {
+		return nil
+	}
+
+	if delegated.LT(lock.Amount) {
+		return types.ErrConvictionLocked.Wrapf("%s tokens are locked until %s", lock.Amount, lock.UnlockTime)
+	}
+
+	return nil
+}
+
+var _ types.StakingHooks = StakingHooks{}
+
+// StakingHooks wrapper struct for the staking hooks of the governance keeper
+type StakingHooks struct {
+	k Keeper
+}
+
+// StakingHooks returns the staking hooks of the governance keeper, preventing voters from
+// undelegating or redelegating the tokens locked by an active conviction lock.
+func (k Keeper) StakingHooks() StakingHooks {
+	return StakingHooks{k}
+}
+
+// BeforeDelegationSharesModified records the tokens delegated by a voter with an active conviction
+// lock, for AfterDelegationModified to know whether the change decreases them.
+func (h StakingHooks) BeforeDelegationSharesModified(ctx context.Context, delAddr sdk.AccAddress, _ sdk.ValAddress) error {
+	_, found, err := h.k.activeConvictionLock(ctx, delAddr)
+	if err != nil || !found {
+		return err
+	}
+
+	delegated, err := h.k.delegatedTokens(ctx, delAddr, nil)
+	if err != nil {
+		return err
+	}
+
+	return h.k.ConvictionDelegated.Set(ctx, delAddr, delegated)
+}
+
+// AfterDelegationModified fails if a change decreasing the tokens delegated by a voter leaves them
+// below its conviction lock. A created delegation only adds to them.
+func (h StakingHooks) AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddress, _ sdk.ValAddress) error {
+	before, err := h.k.ConvictionDelegated.Get(ctx, delAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err := h.k.ConvictionDelegated.Remove(ctx, delAddr); err != nil {
+		return err
+	}
+
+	return h.k.checkConvictionLock(ctx, delAddr, nil, before)
+}
+
+// BeforeDelegationRemoved fails if the tokens left delegated by a voter once the delegation is removed
+// do not cover its conviction lock.
+func (h StakingHooks) BeforeDelegationRemoved(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	if err := h.k.ConvictionDelegated.Remove(ctx, delAddr); err != nil {
+		return err
+	}
+
+	return h.k.checkConvictionLock(ctx, delAddr, valAddr, math.Int{})
+}
+
+func (StakingHooks) AfterValidatorCreated(_ context.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (StakingHooks) BeforeValidatorModified(_ context.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (StakingHooks) AfterValidatorRemoved(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (StakingHooks) AfterValidatorBonded(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (StakingHooks) AfterValidatorBeginUnbonding(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (StakingHooks) BeforeDelegationCreated(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (StakingHooks) BeforeValidatorSlashed(_ context.Context, _ sdk.ValAddress, _ math.LegacyDec) error {
+	return nil
+}
+
+func (StakingHooks) AfterUnbondingInitiated(_ context.Context, _ uint64) error {
+	return nil
+}
+
+func (StakingHooks) AfterConsensusPubKeyUpdate(_ context.Context, _, _ cryptotypes.PubKey, _ sdk.Coin) error {
+	return nil
+}
@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/gov/keeper"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestConvictionVoting(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	msgServer := keeper.NewMsgServerImpl(govKeeper)
+	queryServer := keeper.NewQueryServer(govKeeper)
+
+	addrs := simtestutil.CreateRandomAccounts(2)
+	voter, valAddr := addrs[0], sdk.ValAddress(addrs[1])
+	voterStr, err := mocks.acctKeeper.AddressCodec().BytesToString(voter)
+	require.NoError(t, err)
+	valCodec := address.NewBech32Codec("cosmosvaloper")
+	valStr, err := valCodec.BytesToString(valAddr)
+	require.NoError(t, err)
+
+	// the voter delegates 1000 tokens to a bonded validator
+	validator := stakingtypes.Validator{
+		OperatorAddress: valStr,
+		Status:          stakingtypes.Bonded,
+		Tokens:          sdkmath.NewInt(1000000),
+		DelegatorShares: sdkmath.LegacyNewDec(1000000),
+	}
+	delegation := stakingtypes.NewDelegation(voterStr, valStr, sdkmath.LegacyNewDec(1000))
+	mocks.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(valCodec).AnyTimes()
+	mocks.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(validator, nil).AnyTimes()
+	mocks.stakingKeeper.EXPECT().IterateDelegations(gomock.Any(), voter, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ sdk.AccAddress, fn func(index int64, d sdk.DelegationI) bool) error {
+			fn(0, delegation)
+			return nil
+		}).AnyTimes()
+	mocks.stakingKeeper.EXPECT().IterateBondedValidatorsByPower(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(index int64, validator sdk.ValidatorI) bool) error {
+			fn(0, validator)
+			return nil
+		}).AnyTimes()
+	totalBonded := sdkmath.NewInt(1000000)
+	mocks.stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).DoAndReturn(func(context.Context) (sdkmath.Int, error) {
+		return totalBonded, nil
+	}).AnyTimes()
+
+	// conviction voting is disabled by default
+	_, err = msgServer.LockConviction(ctx, v1.NewMsgLockConviction(voterStr, sdkmath.NewInt(600), 3))
+	require.ErrorIs(t, err, types.ErrConvictionDisabled)
+
+	params, err := govKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	lockPeriod, votingPeriod := 24*time.Hour, 100*time.Hour
+	params.ConvictionLockPeriod = &lockPeriod
+	params.VotingPeriod = &votingPeriod
+	require.NoError(t, govKeeper.Params.Set(ctx, params))
+
+	// the lock must cover at most the delegated tokens, with a conviction from 1 to 6
+	_, err = msgServer.LockConviction(ctx, v1.NewMsgLockConviction(voterStr, sdkmath.NewInt(2000), 3))
+	require.ErrorIs(t, err, types.ErrInvalidConvictionLock)
+	_, err = msgServer.LockConviction(ctx, v1.NewMsgLockConviction(voterStr, sdkmath.NewInt(600), 7))
+	require.ErrorIs(t, err, types.ErrInvalidConvictionLock)
+
+	res, err := msgServer.LockConviction(ctx, v1.NewMsgLockConviction(voterStr, sdkmath.NewInt(600), 3))
+	require.NoError(t, err)
+	require.Equal(t, ctx.HeaderInfo().Time.Add(3*lockPeriod), res.UnlockTime)
+
+	// an active lock cannot be shortened
+	_, err = msgServer.LockConviction(ctx, v1.NewMsgLockConviction(voterStr, sdkmath.NewInt(600), 2))
+	require.ErrorIs(t, err, types.ErrInvalidConvictionLock)
+
+	lockRes, err := queryServer.ConvictionLock(ctx, &v1.QueryConvictionLockRequest{Voter: voterStr})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(600), lockRes.Lock.Amount)
+	require.Equal(t, uint32(3), lockRes.Lock.Conviction)
+
+	locksRes, err := queryServer.ConvictionLocks(ctx, &v1.QueryConvictionLocksRequest{})
+	require.NoError(t, err)
+	require.Len(t, locksRes.Locks, 1)
+
+	// the locked tokens cannot be undelegated
+	hooks := govKeeper.StakingHooks()
+	require.NoError(t, hooks.AfterDelegationModified(ctx, voter, valAddr))
+	require.ErrorIs(t, hooks.BeforeDelegationRemoved(ctx, voter, valAddr), types.ErrConvictionLocked)
+
+	// once slashed below the lock, the delegations can still be topped up but not decreased
+	delegation.Shares = sdkmath.LegacyNewDec(500)
+	require.NoError(t, hooks.BeforeDelegationSharesModified(ctx, voter, valAddr))
+	delegation.Shares = sdkmath.LegacyNewDec(550)
+	require.NoError(t, hooks.AfterDelegationModified(ctx, voter, valAddr))
+	require.NoError(t, hooks.BeforeDelegationSharesModified(ctx, voter, valAddr))
+	delegation.Shares = sdkmath.LegacyNewDec(450)
+	require.ErrorIs(t, hooks.AfterDelegationModified(ctx, voter, valAddr), types.ErrConvictionLocked)
+	delegation.Shares = sdkmath.LegacyNewDec(1000)
+
+	// the voting power of the locked tokens is multiplied by the conviction
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", voter, v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+	require.NoError(t, govKeeper.ActivateVotingPeriod(ctx, proposal))
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, voter, v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	// but the quorum is computed on the voting power without the conviction: 1000 of 5000 bonded
+	// tokens do not reach it, even though the results hold 2200 tokens
+	totalBonded = sdkmath.NewInt(5000)
+	passes, _, tally, err := govKeeper.Tally(ctx, proposal)
+	require.NoError(t, err)
+	require.Equal(t, "2200", tally.YesCount)
+	require.False(t, passes)
+
+	// the votes are removed once tallied
+	totalBonded = sdkmath.NewInt(2500)
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, voter, v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	passes, _, _, err = govKeeper.Tally(ctx, proposal)
+	require.NoError(t, err)
+	require.True(t, passes)
+
+	// the lock is deleted once expired
+	ctx = ctx.WithHeaderInfo(header.Info{Time: res.UnlockTime})
+	require.NoError(t, govKeeper.EndBlocker(ctx))
+	has, err := govKeeper.ConvictionLocks.Has(ctx, voter)
+	require.NoError(t, err)
+	require.False(t, has)
+	require.NoError(t, hooks.BeforeDelegationRemoved(ctx, voter, valAddr))
+}
@@ -0,0 +1,213 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreateGovernor registers an account as a governor.
+func (k Keeper) CreateGovernor(ctx context.Context, governor v1.Governor) error {
+	addr, err := k.authKeeper.AddressCodec().StringToBytes(governor.Address)
+	if err != nil {
+		return err
+	}
+
+	if err := k.validateGovernorDescription(governor); err != nil {
+		return err
+	}
+
+	exists, err := k.Governors.Has(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return types.ErrGovernorExists.Wrapf("governor %s", governor.Address)
+	}
+
+	return k.Governors.Set(ctx, addr, governor)
+}
+
+// EditGovernor edits the description of a governor.
+func (k Keeper) EditGovernor(ctx context.Context, governor v1.Governor) error {
+	addr, err := k.authKeeper.AddressCodec().StringToBytes(governor.Address)
+	if err != nil {
+		return err
+	}
+
+	if err := k.validateGovernorDescription(governor); err != nil {
+		return err
+	}
+
+	exists, err := k.Governors.Has(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return types.ErrGovernorNotFound.Wrapf("governor %s", governor.Address)
+	}
+
+	return k.Governors.Set(ctx, addr, governor)
+}
+
+// validateGovernorDescription checks the moniker and the details of a governor.
+func (k Keeper) validateGovernorDescription(governor v1.Governor) error {
+	if governor.Moniker == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("governor moniker cannot be empty")
+	}
+
+	if err := k.assertMetadataLength(governor.Moniker); err != nil {
+		return err
+	}
+
+	return k.assertMetadataLength(governor.Details)
+}
+
+// DelegateGovernor delegates the governance voting power of a token holder to a governor,
+// replacing any previous governance delegation of the token holder.
+func (k Keeper) DelegateGovernor(ctx context.Context, delegator, governor sdk.AccAddress) error {
+	if delegator.Equals(governor) {
+		return types.ErrInvalidGovDelegation.Wrap("cannot delegate to oneself")
+	}
+
+	exists, err := k.Governors.Has(ctx, governor)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return types.ErrGovernorNotFound.Wrapf("governor %s", governor)
+	}
+
+	if err := k.removeGovernanceDelegation(ctx, delegator); err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	delegatorStr, err := k.authKeeper.AddressCodec().BytesToString(delegator)
+	if err != nil {
+		return err
+	}
+
+	governorStr, err := k.authKeeper.AddressCodec().BytesToString(governor)
+	if err != nil {
+		return err
+	}
+
+	return k.SetGovernanceDelegation(ctx, v1.GovernanceDelegation{
+		DelegatorAddress: delegatorStr,
+		GovernorAddress:  governorStr,
+	})
+}
+
+// UndelegateGovernor removes the governance delegation of a token holder.
+func (k Keeper) UndelegateGovernor(ctx context.Context, delegator sdk.AccAddress) error {
+	err := k.removeGovernanceDelegation(ctx, delegator)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ErrInvalidGovDelegation.Wrapf("no governance delegation for %s", delegator)
+	}
+
+	return err
+}
+
+// SetGovernanceDelegation sets a governance delegation and indexes it by governor.
+func (k Keeper) SetGovernanceDelegation(ctx context.Context, delegation v1.GovernanceDelegation) error {
+	delegator, err := k.authKeeper.AddressCodec().StringToBytes(delegation.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+
+	governor, err := k.authKeeper.AddressCodec().StringToBytes(delegation.GovernorAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := k.GovernanceDelegations.Set(ctx, delegator, delegation); err != nil {
+		return err
+	}
+
+	return k.GovernorDelegators.Set(ctx, collections.Join(sdk.AccAddress(governor), sdk.AccAddress(delegator)))
+}
+
+// removeGovernanceDelegation removes the governance delegation of a token holder, returning
+// collections.ErrNotFound if it has none.
+func (k Keeper) removeGovernanceDelegation(ctx context.Context, delegator sdk.AccAddress) error {
+	delegation, err := k.GovernanceDelegations.Get(ctx, delegator)
+	if err != nil {
+		return err
+	}
+
+	governor, err := k.authKeeper.AddressCodec().StringToBytes(delegation.GovernorAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := k.GovernorDelegators.Remove(ctx, collections.Join(sdk.AccAddress(governor), delegator)); err != nil {
+		return err
+	}
+
+	return k.GovernanceDelegations.Remove(ctx, delegator)
+}
+
+// governorDelegators returns the token holders who delegated their governance voting power
+// to a governor.
+func (k Keeper) governorDelegators(ctx context.Context, governor sdk.AccAddress) ([]sdk.AccAddress, error) {
+	var delegators []sdk.AccAddress
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](governor)
+	err := k.GovernorDelegators.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress]) (bool, error) {
+		delegators = append(delegators, key.K2())
+		return false, nil
+	})
+
+	return delegators, err
+}
+
+// GovernorDelegatedPower returns the voting power delegated to a governor, which is the sum of
+// the tokens its delegators delegated to bonded validators.
+func (k Keeper) GovernorDelegatedPower(ctx context.Context, governor sdk.AccAddress) (math.LegacyDec, error) {
+	delegators, err := k.governorDelegators(ctx, governor)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	power := math.LegacyZeroDec()
+	for _, delegator := range delegators {
+		var iterErr error
+		err := k.sk.IterateDelegations(ctx, delegator, func(_ int64, delegation sdk.DelegationI) (stop bool) {
+			valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(delegation.GetValidatorAddr())
+			if err != nil {
+				iterErr = err
+				return true
+			}
+
+			validator, err := k.sk.Validator(ctx, valAddr)
+			if err != nil {
+				iterErr = err
+				return true
+			}
+
+			if validator.IsBonded() {
+				power = power.Add(validator.TokensFromShares(delegation.GetShares()))
+			}
+
+			return false
+		})
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+
+		if iterErr != nil {
+			return math.LegacyDec{}, iterErr
+		}
+	}
+
+	return power, nil
+}
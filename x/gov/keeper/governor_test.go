@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/gov/keeper"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGovernors(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	msgServer := keeper.NewMsgServerImpl(govKeeper)
+	queryServer := keeper.NewQueryServer(govKeeper)
+
+	addrs := simtestutil.CreateRandomAccounts(4)
+	governor, del1, del2, valAddr := addrs[0], addrs[1], addrs[2], sdk.ValAddress(addrs[3])
+	addrCodec := mocks.acctKeeper.AddressCodec()
+	governorStr, err := addrCodec.BytesToString(governor)
+	require.NoError(t, err)
+	del1Str, err := addrCodec.BytesToString(del1)
+	require.NoError(t, err)
+	del2Str, err := addrCodec.BytesToString(del2)
+	require.NoError(t, err)
+	valCodec := address.NewBech32Codec("cosmosvaloper")
+	valStr, err := valCodec.BytesToString(valAddr)
+	require.NoError(t, err)
+
+	// the two token holders delegate 1000 and 500 tokens to a bonded validator
+	validator := stakingtypes.Validator{
+		OperatorAddress: valStr,
+		Status:          stakingtypes.Bonded,
+		Tokens:          sdkmath.NewInt(1000000),
+		DelegatorShares: sdkmath.LegacyNewDec(1000000),
+	}
+	delegations := map[string][]stakingtypes.Delegation{
+		del1Str: {stakingtypes.NewDelegation(del1Str, valStr, sdkmath.LegacyNewDec(1000))},
+		del2Str: {stakingtypes.NewDelegation(del2Str, valStr, sdkmath.LegacyNewDec(500))},
+	}
+	mocks.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(valCodec).AnyTimes()
+	mocks.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(validator, nil).AnyTimes()
+	mocks.stakingKeeper.EXPECT().IterateDelegations(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, delegator sdk.AccAddress, fn func(index int64, d sdk.DelegationI) bool) error {
+			delegatorStr, err := addrCodec.BytesToString(delegator)
+			require.NoError(t, err)
+			for i, d := range delegations[delegatorStr] {
+				fn(int64(i), d)
+			}
+			return nil
+		}).AnyTimes()
+	mocks.stakingKeeper.EXPECT().IterateBondedValidatorsByPower(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(index int64, validator sdk.ValidatorI) bool) error {
+			fn(0, validator)
+			return nil
+		}).AnyTimes()
+	mocks.stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(sdkmath.NewInt(1000000), nil).AnyTimes()
+
+	// the governance voting power can only be delegated to a governor
+	_, err = msgServer.DelegateGovernor(ctx, v1.NewMsgDelegateGovernor(del1Str, governorStr))
+	require.ErrorIs(t, err, types.ErrGovernorNotFound)
+
+	_, err = msgServer.CreateGovernor(ctx, v1.NewMsgCreateGovernor(governorStr, "governor", ""))
+	require.NoError(t, err)
+	_, err = msgServer.CreateGovernor(ctx, v1.NewMsgCreateGovernor(governorStr, "governor", ""))
+	require.ErrorIs(t, err, types.ErrGovernorExists)
+	_, err = msgServer.EditGovernor(ctx, v1.NewMsgEditGovernor(governorStr, "governor", "details"))
+	require.NoError(t, err)
+
+	_, err = msgServer.DelegateGovernor(ctx, v1.NewMsgDelegateGovernor(governorStr, governorStr))
+	require.ErrorIs(t, err, types.ErrInvalidGovDelegation)
+	for _, delegator := range []string{del1Str, del2Str} {
+		_, err = msgServer.DelegateGovernor(ctx, v1.NewMsgDelegateGovernor(delegator, governorStr))
+		require.NoError(t, err)
+	}
+
+	res, err := queryServer.Governors(ctx, &v1.QueryGovernorsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Governors, 1)
+	require.Equal(t, "details", res.Governors[0].Governor.Details)
+	require.Equal(t, sdkmath.LegacyNewDec(1500).String(), res.Governors[0].DelegatedPower)
+
+	delegationRes, err := queryServer.GovernanceDelegation(ctx, &v1.QueryGovernanceDelegationRequest{DelegatorAddress: del1Str})
+	require.NoError(t, err)
+	require.Equal(t, governorStr, delegationRes.Delegation.GovernorAddress)
+
+	// the governor vote is inherited over the validator vote, unless the token holder votes itself
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", governor, v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+	require.NoError(t, govKeeper.ActivateVotingPeriod(ctx, proposal))
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, sdk.AccAddress(valAddr), v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, governor, v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, del2, v1.NewNonSplitVoteOption(v1.OptionAbstain), ""))
+
+	_, _, tally, err := govKeeper.Tally(ctx, proposal)
+	require.NoError(t, err)
+	require.Equal(t, "1000", tally.YesCount)
+	require.Equal(t, "500", tally.AbstainCount)
+	require.Equal(t, "998500", tally.NoCount)
+
+	// the governance delegation is removed
+	_, err = msgServer.UndelegateGovernor(ctx, v1.NewMsgUndelegateGovernor(del2Str))
+	require.NoError(t, err)
+	_, err = msgServer.UndelegateGovernor(ctx, v1.NewMsgUndelegateGovernor(del2Str))
+	require.ErrorIs(t, err, types.ErrInvalidGovDelegation)
+
+	governorRes, err := queryServer.Governor(ctx, &v1.QueryGovernorRequest{GovernorAddress: governorStr})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(1000).String(), governorRes.Governor.DelegatedPower)
+}
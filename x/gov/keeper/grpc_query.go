@@ -334,6 +334,128 @@ func (q queryServer) TallyResult(ctx context.Context, req *v1.QueryTallyResultRe
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// ConvictionLock queries the conviction lock of a voter.
+func (q queryServer) ConvictionLock(ctx context.Context, req *v1.QueryConvictionLockRequest) (*v1.QueryConvictionLockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Voter == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty voter address")
+	}
+
+	voter, err := q.k.authKeeper.AddressCodec().StringToBytes(req.Voter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	lock, err := q.k.ConvictionLocks.Get(ctx, voter)
+	if err == nil {
+		return &v1.QueryConvictionLockResponse{Lock: &lock}, nil
+	}
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "conviction lock not found for voter: %s", req.Voter)
+	}
+	return nil, status.Error(codes.Internal, err.Error())
+}
+
+// ConvictionLocks queries all the conviction locks.
+func (q queryServer) ConvictionLocks(ctx context.Context, req *v1.QueryConvictionLocksRequest) (*v1.QueryConvictionLocksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	locks, pageRes, err := query.CollectionPaginate(ctx, q.k.ConvictionLocks, req.Pagination, func(_ sdk.AccAddress, lock v1.ConvictionLock) (*v1.ConvictionLock, error) {
+		return &lock, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryConvictionLocksResponse{Locks: locks, Pagination: pageRes}, nil
+}
+
+// Governor queries a governor and the voting power delegated to it.
+func (q queryServer) Governor(ctx context.Context, req *v1.QueryGovernorRequest) (*v1.QueryGovernorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.GovernorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty governor address")
+	}
+
+	governor, err := q.k.authKeeper.AddressCodec().StringToBytes(req.GovernorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	g, err := q.k.Governors.Get(ctx, governor)
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "governor not found: %s", req.GovernorAddress)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	info, err := q.governorInfo(ctx, governor, g)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernorResponse{Governor: info}, nil
+}
+
+// Governors queries all the governors and the voting power delegated to them.
+func (q queryServer) Governors(ctx context.Context, req *v1.QueryGovernorsRequest) (*v1.QueryGovernorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	governors, pageRes, err := query.CollectionPaginate(ctx, q.k.Governors, req.Pagination, func(governor sdk.AccAddress, g v1.Governor) (*v1.GovernorInfo, error) {
+		return q.governorInfo(ctx, governor, g)
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernorsResponse{Governors: governors, Pagination: pageRes}, nil
+}
+
+// governorInfo returns a governor along with the voting power delegated to it.
+func (q queryServer) governorInfo(ctx context.Context, governor sdk.AccAddress, g v1.Governor) (*v1.GovernorInfo, error) {
+	power, err := q.k.GovernorDelegatedPower(ctx, governor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GovernorInfo{Governor: &g, DelegatedPower: power.String()}, nil
+}
+
+// GovernanceDelegation queries the governance delegation of a token holder.
+func (q queryServer) GovernanceDelegation(ctx context.Context, req *v1.QueryGovernanceDelegationRequest) (*v1.QueryGovernanceDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	delegator, err := q.k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	delegation, err := q.k.GovernanceDelegations.Get(ctx, delegator)
+	if err == nil {
+		return &v1.QueryGovernanceDelegationResponse{Delegation: &delegation}, nil
+	}
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "governance delegation not found for delegator: %s", req.DelegatorAddress)
+	}
+	return nil, status.Error(codes.Internal, err.Error())
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct{ qs v1.QueryServer }
@@ -509,3 +631,26 @@ func (q legacyQueryServer) TallyResult(ctx context.Context, req *v1beta1.QueryTa
 
 	return &v1beta1.QueryTallyResultResponse{Tally: tally}, nil
 }
+
+// SimulateProposal executes the messages of a proposal against the current state without persisting them.
+func (q queryServer) SimulateProposal(ctx context.Context, req *v1.QuerySimulateProposalRequest) (*v1.QuerySimulateProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Messages) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty proposal messages")
+	}
+
+	msgs, err := req.GetMsgs()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := q.k.SimulateProposal(ctx, msgs)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return res, nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/gov/types"
 	v1 "cosmossdk.io/x/gov/types/v1"
 	"cosmossdk.io/x/gov/types/v1beta1"
@@ -65,6 +66,18 @@ type Keeper struct {
 	ActiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
 	// InactiveProposalsQueue key: depositEndTime+proposalID | value: proposalID
 	InactiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
+	// ConvictionLocks key: voterAddr | value: ConvictionLock
+	ConvictionLocks collections.Map[sdk.AccAddress, v1.ConvictionLock]
+	// ConvictionLockQueue key: unlockTime+voterAddr
+	ConvictionLockQueue collections.KeySet[collections.Pair[time.Time, sdk.AccAddress]]
+	// ConvictionDelegated key: voterAddr | value: delegated tokens before the delegation being modified
+	ConvictionDelegated collections.Map[sdk.AccAddress, math.Int]
+	// Governors key: governorAddr | value: Governor
+	Governors collections.Map[sdk.AccAddress, v1.Governor]
+	// GovernanceDelegations key: delegatorAddr | value: GovernanceDelegation
+	GovernanceDelegations collections.Map[sdk.AccAddress, v1.GovernanceDelegation]
+	// GovernorDelegators key: governorAddr+delegatorAddr
+	GovernorDelegators collections.KeySet[collections.Pair[sdk.AccAddress, sdk.AccAddress]]
 }
 
 // GetAuthority returns the x/gov module's authority.
@@ -131,6 +144,12 @@ func NewKeeper(
 		ProposalVoteOptions:    collections.NewMap(sb, types.ProposalVoteOptionsKeyPrefix, "proposal_vote_options", collections.Uint64Key, codec.CollValue[v1.ProposalVoteOptions](cdc)),
 		ActiveProposalsQueue:   collections.NewMap(sb, types.ActiveProposalQueuePrefix, "active_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),     // sdk.TimeKey is needed to retain state compatibility
 		InactiveProposalsQueue: collections.NewMap(sb, types.InactiveProposalQueuePrefix, "inactive_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value), // sdk.TimeKey is needed to retain state compatibility
+		ConvictionLocks:        collections.NewMap(sb, types.ConvictionLocksKeyPrefix, "conviction_locks", sdk.AccAddressKey, codec.CollValue[v1.ConvictionLock](cdc)),
+		ConvictionLockQueue:    collections.NewKeySet(sb, types.ConvictionLockQueuePrefix, "conviction_lock_queue", collections.PairKeyCodec(sdk.TimeKey, sdk.AccAddressKey)),
+		ConvictionDelegated:    collections.NewMap(sb, types.ConvictionDelegatedKeyPrefix, "conviction_delegated", sdk.AccAddressKey, sdk.IntValue),
+		Governors:              collections.NewMap(sb, types.GovernorsKeyPrefix, "governors", sdk.AccAddressKey, codec.CollValue[v1.Governor](cdc)),
+		GovernanceDelegations:  collections.NewMap(sb, types.GovDelegationsKeyPrefix, "governance_delegations", sdk.AccAddressKey, codec.CollValue[v1.GovernanceDelegation](cdc)),
+		GovernorDelegators:     collections.NewKeySet(sb, types.GovernorDelegatorsKeyPrefix, "governor_delegators", collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey)),
 	}
 	schema, err := sb.Build()
 	if err != nil {
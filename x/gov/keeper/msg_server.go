@@ -412,6 +412,119 @@ type legacyMsgServer struct {
 	server  v1.MsgServer
 }
 
+// LockConviction implements the MsgServer.LockConviction method.
+func (k msgServer) LockConviction(ctx context.Context, msg *v1.MsgLockConviction) (*v1.MsgLockConvictionResponse, error) {
+	voter, err := k.authKeeper.AddressCodec().StringToBytes(msg.Voter)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
+	}
+
+	unlockTime, err := k.Keeper.LockConviction(ctx, voter, msg.Amount, msg.Conviction)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		govtypes.EventTypeLockConviction,
+		event.NewAttribute(govtypes.AttributeKeyVoter, msg.Voter),
+		event.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		event.NewAttribute(govtypes.AttributeKeyConviction, fmt.Sprint(msg.Conviction)),
+		event.NewAttribute(govtypes.AttributeKeyUnlockTime, unlockTime.String()),
+	); err != nil {
+		return nil, errors.Wrapf(err, "failed to emit event: %s", govtypes.EventTypeLockConviction)
+	}
+
+	return &v1.MsgLockConvictionResponse{UnlockTime: unlockTime}, nil
+}
+
+// CreateGovernor implements the MsgServer.CreateGovernor method.
+func (k msgServer) CreateGovernor(ctx context.Context, msg *v1.MsgCreateGovernor) (*v1.MsgCreateGovernorResponse, error) {
+	if _, err := k.authKeeper.AddressCodec().StringToBytes(msg.Address); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+
+	if err := k.Keeper.CreateGovernor(ctx, v1.Governor{Address: msg.Address, Moniker: msg.Moniker, Details: msg.Details}); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		govtypes.EventTypeCreateGovernor,
+		event.NewAttribute(govtypes.AttributeKeyGovernor, msg.Address),
+	); err != nil {
+		return nil, errors.Wrapf(err, "failed to emit event: %s", govtypes.EventTypeCreateGovernor)
+	}
+
+	return &v1.MsgCreateGovernorResponse{}, nil
+}
+
+// EditGovernor implements the MsgServer.EditGovernor method.
+func (k msgServer) EditGovernor(ctx context.Context, msg *v1.MsgEditGovernor) (*v1.MsgEditGovernorResponse, error) {
+	if _, err := k.authKeeper.AddressCodec().StringToBytes(msg.Address); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+
+	if err := k.Keeper.EditGovernor(ctx, v1.Governor{Address: msg.Address, Moniker: msg.Moniker, Details: msg.Details}); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		govtypes.EventTypeEditGovernor,
+		event.NewAttribute(govtypes.AttributeKeyGovernor, msg.Address),
+	); err != nil {
+		return nil, errors.Wrapf(err, "failed to emit event: %s", govtypes.EventTypeEditGovernor)
+	}
+
+	return &v1.MsgEditGovernorResponse{}, nil
+}
+
+// DelegateGovernor implements the MsgServer.DelegateGovernor method.
+func (k msgServer) DelegateGovernor(ctx context.Context, msg *v1.MsgDelegateGovernor) (*v1.MsgDelegateGovernorResponse, error) {
+	delegator, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	governor, err := k.authKeeper.AddressCodec().StringToBytes(msg.GovernorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+
+	if err := k.Keeper.DelegateGovernor(ctx, delegator, governor); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		govtypes.EventTypeDelegateGovernor,
+		event.NewAttribute(govtypes.AttributeKeyDelegator, msg.DelegatorAddress),
+		event.NewAttribute(govtypes.AttributeKeyGovernor, msg.GovernorAddress),
+	); err != nil {
+		return nil, errors.Wrapf(err, "failed to emit event: %s", govtypes.EventTypeDelegateGovernor)
+	}
+
+	return &v1.MsgDelegateGovernorResponse{}, nil
+}
+
+// UndelegateGovernor implements the MsgServer.UndelegateGovernor method.
+func (k msgServer) UndelegateGovernor(ctx context.Context, msg *v1.MsgUndelegateGovernor) (*v1.MsgUndelegateGovernorResponse, error) {
+	delegator, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if err := k.Keeper.UndelegateGovernor(ctx, delegator); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		govtypes.EventTypeUndelegateGovernor,
+		event.NewAttribute(govtypes.AttributeKeyDelegator, msg.DelegatorAddress),
+	); err != nil {
+		return nil, errors.Wrapf(err, "failed to emit event: %s", govtypes.EventTypeUndelegateGovernor)
+	}
+
+	return &v1.MsgUndelegateGovernorResponse{}, nil
+}
+
 // NewLegacyMsgServerImpl returns an implementation of the v1beta1 legacy MsgServer interface. It wraps around
 // the current MsgServer
 func NewLegacyMsgServerImpl(govAcct string, v1Server v1.MsgServer) v1beta1.MsgServer {
@@ -83,33 +83,9 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 			}
 		}
 
-		// perform a basic validation of the message
-		if m, ok := msg.(sdk.HasValidateBasic); ok {
-			if err := m.ValidateBasic(); err != nil {
-				return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalMsg, err.Error())
-			}
-		}
-
-		signers, _, err := k.cdc.GetMsgSigners(msg)
-		if err != nil {
+		if err := k.validateProposalMsg(ctx, msg); err != nil {
 			return v1.Proposal{}, err
 		}
-		if len(signers) != 1 {
-			return v1.Proposal{}, types.ErrInvalidSigner
-		}
-
-		// assert that the governance module account is the only signer of the messages
-		if !bytes.Equal(signers[0], k.GetGovernanceAccount(ctx).GetAddress()) {
-			addr, err := k.authKeeper.AddressCodec().BytesToString(signers[0])
-			if err != nil {
-				return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidSigner, err.Error())
-			}
-			return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidSigner, addr)
-		}
-
-		if err := k.MsgRouterService.CanInvoke(ctx, sdk.MsgTypeURL(msg)); err != nil {
-			return v1.Proposal{}, errorsmod.Wrap(types.ErrUnroutableProposalMsg, err.Error())
-		}
 
 		// Only if it's a MsgExecLegacyContent we try to execute the
 		// proposal in a cached context.
@@ -183,6 +159,39 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 	return proposal, nil
 }
 
+// validateProposalMsg performs a basic validation of a proposal message and checks that it
+// is routable and has the governance module account as only signer.
+func (k Keeper) validateProposalMsg(ctx context.Context, msg sdk.Msg) error {
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(types.ErrInvalidProposalMsg, err.Error())
+		}
+	}
+
+	signers, _, err := k.cdc.GetMsgSigners(msg)
+	if err != nil {
+		return err
+	}
+	if len(signers) != 1 {
+		return types.ErrInvalidSigner
+	}
+
+	// assert that the governance module account is the only signer of the messages
+	if !bytes.Equal(signers[0], k.GetGovernanceAccount(ctx).GetAddress()) {
+		addr, err := k.authKeeper.AddressCodec().BytesToString(signers[0])
+		if err != nil {
+			return errorsmod.Wrap(types.ErrInvalidSigner, err.Error())
+		}
+		return errorsmod.Wrap(types.ErrInvalidSigner, addr)
+	}
+
+	if err := k.MsgRouterService.CanInvoke(ctx, sdk.MsgTypeURL(msg)); err != nil {
+		return errorsmod.Wrap(types.ErrUnroutableProposalMsg, err.Error())
+	}
+
+	return nil
+}
+
 // CancelProposal will cancel proposal before the voting period ends
 func (k Keeper) CancelProposal(ctx context.Context, proposalID uint64, proposer string) error {
 	proposal, err := k.Proposals.Get(ctx, proposalID)
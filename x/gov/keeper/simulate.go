@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+
+	"cosmossdk.io/core/event"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// errSimulationDone is returned by a successful simulation in order to discard its state changes.
+var errSimulationDone = errors.New("proposal simulation done")
+
+// SimulateProposal executes the messages of a proposal against the current state, the way they
+// would be executed once the proposal passes, and discards the resulting state changes.
+// The messages are checked the same way as on submission. An execution failure does not make
+// the simulation fail, but is reported in the response error along with the failing message.
+func (k Keeper) SimulateProposal(ctx context.Context, messages []sdk.Msg) (*v1.QuerySimulateProposalResponse, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, msg := range messages {
+		if err := k.validateProposalMsg(ctx, msg); err != nil {
+			return nil, err
+		}
+	}
+
+	res := &v1.QuerySimulateProposalResponse{}
+	gasUsed, err := k.BranchService.ExecuteWithGasLimit(ctx, params.ProposalExecutionGas, func(ctx context.Context) error {
+		execErr := k.executeSimulatedMessages(ctx, messages, res)
+
+		// the events are read from the branched context before they are discarded along with its state
+		events, err := k.emittedEvents(ctx)
+		if err != nil {
+			return err
+		}
+		res.Events = events
+
+		if execErr != nil {
+			return execErr
+		}
+
+		return errSimulationDone
+	})
+	res.GasUsed = gasUsed
+	if err != nil && !errors.Is(err, errSimulationDone) {
+		res.MsgResponses = nil
+		res.Error = err.Error()
+	}
+
+	return res, nil
+}
+
+// executeSimulatedMessages executes the messages of a simulated proposal, appending their
+// responses to res.
+func (k Keeper) executeSimulatedMessages(ctx context.Context, messages []sdk.Msg, res *v1.QuerySimulateProposalResponse) error {
+	for idx, msg := range messages {
+		msgRes, err := safeExecuteHandler(ctx, msg, k.MsgRouterService)
+		if err != nil {
+			return fmt.Errorf("msg %d (%s) failed on execution: %w", idx, sdk.MsgTypeURL(msg), err)
+		}
+
+		if msgRes == nil {
+			continue
+		}
+
+		anyRes, err := codectypes.NewAnyWithValue(msgRes)
+		if err != nil {
+			return err
+		}
+
+		res.MsgResponses = append(res.MsgResponses, anyRes)
+	}
+
+	return nil
+}
+
+// emittedEventsReader is implemented by the event managers able to return the events
+// emitted in their context, such as the runtime and server/v2 ones.
+type emittedEventsReader interface {
+	EmittedEvents() []event.Event
+}
+
+// emittedEvents returns the events emitted so far in ctx through the event service.
+// No events are returned when the event manager of the app does not expose them.
+func (k Keeper) emittedEvents(ctx context.Context) ([]abci.Event, error) {
+	reader, ok := k.EventService.EventManager(ctx).(emittedEventsReader)
+	if !ok {
+		return nil, nil
+	}
+
+	emitted := reader.EmittedEvents()
+	events := make([]abci.Event, 0, len(emitted))
+	for _, ev := range emitted {
+		attrs, err := ev.Attributes()
+		if err != nil {
+			return nil, err
+		}
+
+		abciAttrs := make([]abci.EventAttribute, 0, len(attrs))
+		for _, attr := range attrs {
+			abciAttrs = append(abciAttrs, abci.EventAttribute{Key: attr.Key, Value: attr.Value})
+		}
+		events = append(events, abci.Event{Type: ev.Type, Attributes: abciAttrs})
+	}
+
+	return events, nil
+}
@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/gov/keeper"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSimulateProposal(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	queryServer := keeper.NewQueryServer(govKeeper)
+
+	params, err := govKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	// the messages are executed, but the state changes are discarded
+	newParams := params
+	newParams.Quorum = "0.5"
+	req, err := v1.NewQuerySimulateProposalRequest([]sdk.Msg{&v1.MsgUpdateParams{Authority: govAcctStr, Params: newParams}})
+	require.NoError(t, err)
+	res, err := queryServer.SimulateProposal(ctx, req)
+	require.NoError(t, err)
+	require.Empty(t, res.Error)
+	require.Len(t, res.MsgResponses, 1)
+	require.Equal(t, sdk.MsgTypeURL(&v1.MsgUpdateParamsResponse{}), res.MsgResponses[0].TypeUrl)
+	require.NotZero(t, res.GasUsed)
+
+	storedParams, err := govKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params.Quorum, storedParams.Quorum)
+
+	// an execution failure is reported in the response, along with the failing message
+	invalidParams := params
+	invalidParams.Quorum = "2"
+	req, err = v1.NewQuerySimulateProposalRequest([]sdk.Msg{
+		&v1.MsgUpdateParams{Authority: govAcctStr, Params: newParams},
+		&v1.MsgUpdateParams{Authority: govAcctStr, Params: invalidParams},
+	})
+	require.NoError(t, err)
+	res, err = queryServer.SimulateProposal(ctx, req)
+	require.NoError(t, err)
+	require.Contains(t, res.Error, "msg 1 (/cosmos.gov.v1.MsgUpdateParams) failed on execution")
+	require.Empty(t, res.MsgResponses)
+
+	// the messages must be signed by the gov module account only
+	addrStr, err := mocks.acctKeeper.AddressCodec().BytesToString(addr)
+	require.NoError(t, err)
+	req, err = v1.NewQuerySimulateProposalRequest([]sdk.Msg{
+		v1.NewMsgLockConviction(addrStr, sdkmath.NewInt(1), 1),
+	})
+	require.NoError(t, err)
+	_, err = queryServer.SimulateProposal(ctx, req)
+	require.ErrorContains(t, err, types.ErrInvalidSigner.Error())
+
+	_, err = queryServer.SimulateProposal(ctx, &v1.QuerySimulateProposalRequest{})
+	require.ErrorContains(t, err, "empty proposal messages")
+}
@@ -18,19 +18,25 @@ func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDe
 		return false, false, v1.TallyResult{}, err
 	}
 
-	if k.config.CalculateVoteResultsAndVotingPowerFn == nil {
-		k.config.CalculateVoteResultsAndVotingPowerFn = defaultCalculateVoteResultsAndVotingPower
-	}
-
-	totalVoterPower, results, err := k.config.CalculateVoteResultsAndVotingPowerFn(ctx, k, proposal.Id, validators)
+	params, err := k.Params.Get(ctx)
 	if err != nil {
 		return false, false, v1.TallyResult{}, err
 	}
 
-	params, err := k.Params.Get(ctx)
+	// a voting power calculator set by the app developer takes precedence over conviction voting
+	calculateVoteResultsAndVotingPowerFn := k.config.CalculateVoteResultsAndVotingPowerFn
+	if calculateVoteResultsAndVotingPowerFn == nil {
+		calculateVoteResultsAndVotingPowerFn = defaultCalculateVoteResultsAndVotingPower
+		if params.ConvictionVotingEnabled() {
+			calculateVoteResultsAndVotingPowerFn = ConvictionCalculateVoteResultsAndVotingPower
+		}
+	}
+
+	totalVoterPower, results, err := calculateVoteResultsAndVotingPowerFn(ctx, k, proposal.Id, validators)
 	if err != nil {
 		return false, false, v1.TallyResult{}, err
 	}
+
 	tallyResults = v1.NewTallyResultFromMap(results)
 
 	// If there is no staked coins, the proposal fails
@@ -97,26 +103,30 @@ func (k Keeper) tallyStandard(ctx context.Context, proposal v1.Proposal, totalVo
 		return false, params.BurnVoteQuorum, tallyResults, nil
 	}
 
+	// the thresholds are computed on the results, which can exceed the voting power of the
+	// voters with conviction voting
+	votedPower := resultsPower(results)
+
 	// If no one votes (everyone abstains), proposal fails
-	if totalVoterPower.Equal(results[v1.OptionAbstain]) {
+	if votedPower.Equal(results[v1.OptionAbstain]) {
 		return false, false, tallyResults, nil
 	}
 
 	// If yes quorum enabled and less than yes_quorum of voters vote Yes, proposal fails
 	yesQuorum, _ := math.LegacyNewDecFromStr(yesQuorumStr)
-	if yesQuorum.GT(math.LegacyZeroDec()) && results[v1.OptionYes].Quo(totalVoterPower).LT(yesQuorum) {
+	if yesQuorum.GT(math.LegacyZeroDec()) && results[v1.OptionYes].Quo(votedPower).LT(yesQuorum) {
 		return false, false, tallyResults, nil
 	}
 
 	// If more than 1/3 of voters veto, proposal fails
 	vetoThreshold, _ := math.LegacyNewDecFromStr(vetoThresholdStr)
-	if results[v1.OptionNoWithVeto].Quo(totalVoterPower).GT(vetoThreshold) {
+	if results[v1.OptionNoWithVeto].Quo(votedPower).GT(vetoThreshold) {
 		return false, params.BurnVoteVeto, tallyResults, nil
 	}
 
 	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
 	threshold, _ := math.LegacyNewDecFromStr(thresholdStr)
-	if results[v1.OptionYes].Quo(totalVoterPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
+	if results[v1.OptionYes].Quo(votedPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
 		return true, false, tallyResults, nil
 	}
 
@@ -141,26 +151,30 @@ func (k Keeper) tallyExpedited(totalVoterPower math.LegacyDec, totalBonded math.
 		return false, params.BurnVoteQuorum, tallyResults, nil
 	}
 
+	// the thresholds are computed on the results, which can exceed the voting power of the
+	// voters with conviction voting
+	votedPower := resultsPower(results)
+
 	// If no one votes (everyone abstains), proposal fails
-	if totalVoterPower.Equal(results[v1.OptionAbstain]) {
+	if votedPower.Equal(results[v1.OptionAbstain]) {
 		return false, false, tallyResults, nil
 	}
 
 	// If yes quorum enabled and less than yes_quorum of voters vote Yes, proposal fails
 	yesQuorum, _ := math.LegacyNewDecFromStr(params.YesQuorum)
-	if yesQuorum.GT(math.LegacyZeroDec()) && results[v1.OptionYes].Quo(totalVoterPower).LT(yesQuorum) {
+	if yesQuorum.GT(math.LegacyZeroDec()) && results[v1.OptionYes].Quo(votedPower).LT(yesQuorum) {
 		return false, false, tallyResults, nil
 	}
 
 	// If more than 1/3 of voters veto, proposal fails
 	vetoThreshold, _ := math.LegacyNewDecFromStr(params.VetoThreshold)
-	if results[v1.OptionNoWithVeto].Quo(totalVoterPower).GT(vetoThreshold) {
+	if results[v1.OptionNoWithVeto].Quo(votedPower).GT(vetoThreshold) {
 		return false, params.BurnVoteVeto, tallyResults, nil
 	}
 
 	// If more than 2/3 of non-abstaining voters vote Yes, proposal passes
 	threshold, _ := math.LegacyNewDecFromStr(params.GetExpeditedThreshold())
-	if results[v1.OptionYes].Quo(totalVoterPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
+	if results[v1.OptionYes].Quo(votedPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
 		return true, false, tallyResults, nil
 	}
 
@@ -208,6 +222,16 @@ func (k Keeper) tallyMultipleChoice(totalVoterPower math.LegacyDec, totalBonded
 	return true, false, tallyResults, nil
 }
 
+// resultsPower returns the voting power of the results of all the options.
+func resultsPower(results map[v1.VoteOption]math.LegacyDec) math.LegacyDec {
+	total := math.LegacyZeroDec()
+	for _, power := range results {
+		total = total.Add(power)
+	}
+
+	return total
+}
+
 // getCurrentValidators fetches all the bonded validators, insert them into currValidators
 func (k Keeper) getCurrentValidators(ctx context.Context) (map[string]v1.ValidatorGovInfo, error) {
 	currValidators := make(map[string]v1.ValidatorGovInfo)
@@ -232,13 +256,38 @@ func (k Keeper) getCurrentValidators(ctx context.Context) (map[string]v1.Validat
 	return currValidators, nil
 }
 
-// calculateVoteResultsAndVotingPower iterate over all votes, tally up the voting power of each validator
+// defaultCalculateVoteResultsAndVotingPower iterate over all votes, tally up the voting power of each validator
 // and returns the votes results from voters
 func defaultCalculateVoteResultsAndVotingPower(
 	ctx context.Context,
 	k Keeper,
 	proposalID uint64,
 	validators map[string]v1.ValidatorGovInfo,
+) (math.LegacyDec, map[v1.VoteOption]math.LegacyDec, error) {
+	return calculateVoteResultsAndVotingPower(ctx, k, proposalID, validators, false)
+}
+
+// ConvictionCalculateVoteResultsAndVotingPower tallies the votes like the default calculator, but
+// multiplies the voting power of the delegated tokens locked by a voter by the conviction of its
+// active lock. The extra voting power only counts in the results, not in the total voting power
+// the quorum is computed on. It is used by Tally when the conviction lock period param is set.
+func ConvictionCalculateVoteResultsAndVotingPower(
+	ctx context.Context,
+	k Keeper,
+	proposalID uint64,
+	validators map[string]v1.ValidatorGovInfo,
+) (math.LegacyDec, map[v1.VoteOption]math.LegacyDec, error) {
+	return calculateVoteResultsAndVotingPower(ctx, k, proposalID, validators, true)
+}
+
+// calculateVoteResultsAndVotingPower iterate over all votes, tally up the voting power of each validator
+// and returns the votes results from voters, applying the conviction locks of the voters if requested.
+func calculateVoteResultsAndVotingPower(
+	ctx context.Context,
+	k Keeper,
+	proposalID uint64,
+	validators map[string]v1.ValidatorGovInfo,
+	conviction bool,
 ) (math.LegacyDec, map[v1.VoteOption]math.LegacyDec, error) {
 	totalVP := math.LegacyZeroDec()
 	results := createEmptyResults()
@@ -246,6 +295,8 @@ func defaultCalculateVoteResultsAndVotingPower(
 	// iterate over all votes, tally up the voting power of each validator
 	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
 	votesToRemove := []collections.Pair[uint64, sdk.AccAddress]{}
+	voted := make(map[string]struct{})
+	var governorVotes []v1.Vote
 	if err := k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
 		// if validator, just record it in the map
 		voter, err := k.authKeeper.AddressCodec().StringToBytes(vote.Voter)
@@ -264,39 +315,66 @@ func defaultCalculateVoteResultsAndVotingPower(
 		}
 
 		// iterate over all delegations from voter, deduct from any delegated-to validators
-		err = k.sk.IterateDelegations(ctx, voter, func(index int64, delegation sdk.DelegationI) (stop bool) {
-			valAddrStr := delegation.GetValidatorAddr()
-
-			if val, ok := validators[valAddrStr]; ok {
-				// There is no need to handle the special case that validator address equal to voter address.
-				// Because voter's voting power will tally again even if there will be deduction of voter's voting power from validator.
-				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
-				validators[valAddrStr] = val
-
-				// delegation shares * bonded / total shares
-				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+		voterPower, err := tallyDelegations(ctx, k, voter, vote.Options, validators, results)
+		if err != nil {
+			return false, err
+		}
+		totalVP = totalVP.Add(voterPower)
 
-				for _, option := range vote.Options {
-					weight, _ := math.LegacyNewDecFromStr(option.Weight)
-					subPower := votingPower.Mul(weight)
-					results[option.Option] = results[option.Option].Add(subPower)
-				}
+		if conviction {
+			bonus, err := k.convictionBonus(ctx, voter, voterPower)
+			if err != nil {
+				return false, err
+			}
 
-				totalVP = totalVP.Add(votingPower)
+			// the bonus only counts in the results, the quorum is reached on the voting power
+			for _, option := range vote.Options {
+				weight, _ := math.LegacyNewDecFromStr(option.Weight)
+				results[option.Option] = results[option.Option].Add(bonus.Mul(weight))
 			}
+		}
 
-			return false
-		})
+		isGovernor, err := k.Governors.Has(ctx, voter)
 		if err != nil {
 			return false, err
 		}
+		if isGovernor {
+			governorVotes = append(governorVotes, vote)
+		}
 
+		voted[string(voter)] = struct{}{}
 		votesToRemove = append(votesToRemove, key)
 		return false, nil
 	}); err != nil {
 		return math.LegacyDec{}, nil, err
 	}
 
+	// the token holders who delegated their governance voting power to a governor and did not vote
+	// inherit the vote of their governor, over the vote of the validators they delegated to
+	for _, vote := range governorVotes {
+		governor, err := k.authKeeper.AddressCodec().StringToBytes(vote.Voter)
+		if err != nil {
+			return math.LegacyDec{}, nil, err
+		}
+
+		delegators, err := k.governorDelegators(ctx, governor)
+		if err != nil {
+			return math.LegacyDec{}, nil, err
+		}
+
+		for _, delegator := range delegators {
+			if _, ok := voted[string(delegator)]; ok {
+				continue
+			}
+
+			votingPower, err := tallyDelegations(ctx, k, delegator, vote.Options, validators, results)
+			if err != nil {
+				return math.LegacyDec{}, nil, err
+			}
+			totalVP = totalVP.Add(votingPower)
+		}
+	}
+
 	// remove all votes from store
 	for _, key := range votesToRemove {
 		if err := k.Votes.Remove(ctx, key); err != nil {
@@ -324,6 +402,45 @@ func defaultCalculateVoteResultsAndVotingPower(
 	return totalVP, results, nil
 }
 
+// tallyDelegations tallies the voting power of the delegations of a voter to bonded validators for the
+// given vote options, deducting the delegated shares from the validators, and returns the voting power
+// of the voter.
+func tallyDelegations(
+	ctx context.Context,
+	k Keeper,
+	voter sdk.AccAddress,
+	options []*v1.WeightedVoteOption,
+	validators map[string]v1.ValidatorGovInfo,
+	results map[v1.VoteOption]math.LegacyDec,
+) (math.LegacyDec, error) {
+	voterPower := math.LegacyZeroDec()
+	err := k.sk.IterateDelegations(ctx, voter, func(index int64, delegation sdk.DelegationI) (stop bool) {
+		valAddrStr := delegation.GetValidatorAddr()
+
+		if val, ok := validators[valAddrStr]; ok {
+			// There is no need to handle the special case that validator address equal to voter address.
+			// Because voter's voting power will tally again even if there will be deduction of voter's voting power from validator.
+			val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+			validators[valAddrStr] = val
+
+			// delegation shares * bonded / total shares
+			votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+
+			for _, option := range options {
+				weight, _ := math.LegacyNewDecFromStr(option.Weight)
+				subPower := votingPower.Mul(weight)
+				results[option.Option] = results[option.Option].Add(subPower)
+			}
+
+			voterPower = voterPower.Add(votingPower)
+		}
+
+		return false
+	})
+
+	return voterPower, err
+}
+
 func createEmptyResults() map[v1.VoteOption]math.LegacyDec {
 	results := make(map[v1.VoteOption]math.LegacyDec)
 	results[v1.OptionYes] = math.LegacyZeroDec()
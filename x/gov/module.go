@@ -101,6 +101,11 @@ func (am AppModule) GetTxCmd() *cobra.Command {
 	return cli.NewTxCmd(legacyProposalCLIHandlers)
 }
 
+// GetQueryCmd returns the root query command for the gov module.
+func (am AppModule) GetQueryCmd() *cobra.Command {
+	return cli.NewQueryCmd()
+}
+
 func getProposalCLIHandlers(handlers []govclient.ProposalHandler) []*cobra.Command {
 	proposalCLIHandlers := make([]*cobra.Command, 0, len(handlers))
 	for _, proposalHandler := range handlers {
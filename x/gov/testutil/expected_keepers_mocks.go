@@ -398,6 +398,21 @@ func (mr *MockStakingKeeperMockRecorder) TotalBondedTokens(arg0 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TotalBondedTokens", reflect.TypeOf((*MockStakingKeeper)(nil).TotalBondedTokens), arg0)
 }
 
+// Validator mocks base method.
+func (m *MockStakingKeeper) Validator(arg0 context.Context, arg1 types.ValAddress) (types.ValidatorI, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validator", arg0, arg1)
+	ret0, _ := ret[0].(types.ValidatorI)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Validator indicates an expected call of Validator.
+func (mr *MockStakingKeeperMockRecorder) Validator(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validator", reflect.TypeOf((*MockStakingKeeper)(nil).Validator), arg0, arg1)
+}
+
 // ValidatorAddressCodec mocks base method.
 func (m *MockStakingKeeper) ValidatorAddressCodec() address.Codec {
 	m.ctrl.T.Helper()
@@ -28,4 +28,10 @@ var (
 	ErrTitleTooLong            = errors.Register(ModuleName, 24, "title too long")
 	ErrTooLateToCancel         = errors.Register(ModuleName, 25, "too late to cancel proposal")
 	ErrTooManyVoteOptions      = errors.Register(ModuleName, 26, "too many weighted vote options")
+	ErrInvalidConvictionLock   = errors.Register(ModuleName, 27, "invalid conviction lock")
+	ErrConvictionLocked        = errors.Register(ModuleName, 28, "delegated tokens locked by conviction lock")
+	ErrConvictionDisabled      = errors.Register(ModuleName, 29, "conviction voting is disabled")
+	ErrGovernorExists          = errors.Register(ModuleName, 30, "governor already exists")
+	ErrGovernorNotFound        = errors.Register(ModuleName, 31, "governor not found")
+	ErrInvalidGovDelegation    = errors.Register(ModuleName, 32, "invalid governance delegation")
 )
@@ -26,6 +26,12 @@ var (
 	ConstitutionKey              = collections.NewPrefix(49) // ConstitutionKey stores a chain's constitution.
 	ProposalVoteOptionsKeyPrefix = collections.NewPrefix(50) // ProposalVoteOptionsKeyPrefix stores the vote options of proposals.
 	MessageBasedParamsKey        = collections.NewPrefix(51) // MessageBasedParamsKey stores the message based gov params.
+	ConvictionLocksKeyPrefix     = collections.NewPrefix(52) // ConvictionLocksKeyPrefix stores the conviction locks of voters.
+	ConvictionLockQueuePrefix    = collections.NewPrefix(53) // ConvictionLockQueuePrefix stores the conviction locks by unlock time.
+	GovernorsKeyPrefix           = collections.NewPrefix(54) // GovernorsKeyPrefix stores the governors.
+	GovDelegationsKeyPrefix      = collections.NewPrefix(55) // GovDelegationsKeyPrefix stores the governance delegations by delegator.
+	GovernorDelegatorsKeyPrefix  = collections.NewPrefix(56) // GovernorDelegatorsKeyPrefix stores the delegators of governors.
+	ConvictionDelegatedKeyPrefix = collections.NewPrefix(57) // ConvictionDelegatedKeyPrefix stores the tokens delegated by locked voters while their delegations are modified.
 )
 
 // Reserved kvstore keys
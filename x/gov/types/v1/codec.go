@@ -20,6 +20,11 @@ func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	legacy.RegisterAminoMsg(registrar, &MsgUpdateParams{}, "cosmos-sdk/x/gov/v1/MsgUpdateParams")
 	legacy.RegisterAminoMsg(registrar, &MsgUpdateMessageParams{}, "x/gov/v1/MsgUpdateMessageParams")
 	legacy.RegisterAminoMsg(registrar, &MsgSudoExec{}, "cosmos-sdk/x/gov/v1/MsgSudoExec")
+	legacy.RegisterAminoMsg(registrar, &MsgLockConviction{}, "cosmos-sdk/v1/MsgLockConviction")
+	legacy.RegisterAminoMsg(registrar, &MsgCreateGovernor{}, "cosmos-sdk/v1/MsgCreateGovernor")
+	legacy.RegisterAminoMsg(registrar, &MsgEditGovernor{}, "cosmos-sdk/v1/MsgEditGovernor")
+	legacy.RegisterAminoMsg(registrar, &MsgDelegateGovernor{}, "cosmos-sdk/v1/MsgDelegateGovernor")
+	legacy.RegisterAminoMsg(registrar, &MsgUndelegateGovernor{}, "cosmos-sdk/v1/MsgUndelegateGovernor")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -34,6 +39,11 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgUpdateParams{},
 		&MsgUpdateMessageParams{},
 		&MsgSudoExec{},
+		&MsgLockConviction{},
+		&MsgCreateGovernor{},
+		&MsgEditGovernor{},
+		&MsgDelegateGovernor{},
+		&MsgUndelegateGovernor{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
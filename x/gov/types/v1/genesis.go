@@ -98,6 +98,71 @@ func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 		return nil
 	})
 
+	// weed out duplicate and invalid conviction locks
+	errGroup.Go(func() error {
+		voters := make(map[string]struct{})
+		for _, l := range data.ConvictionLocks {
+			if _, err := ac.StringToBytes(l.Voter); err != nil {
+				return fmt.Errorf("invalid conviction lock voter %s: %w", l.Voter, err)
+			}
+
+			if _, ok := voters[l.Voter]; ok {
+				return fmt.Errorf("duplicate conviction lock: %v", l)
+			}
+
+			if l.Amount.IsNil() || !l.Amount.IsPositive() {
+				return fmt.Errorf("conviction lock %v has non-positive amount", l)
+			}
+
+			if l.Conviction == 0 || l.Conviction > MaxConviction {
+				return fmt.Errorf("conviction lock %v has invalid conviction: %d", l, l.Conviction)
+			}
+
+			voters[l.Voter] = struct{}{}
+		}
+
+		return nil
+	})
+
+	// weed out duplicate governors and delegations to non-existent governors
+	errGroup.Go(func() error {
+		governors := make(map[string]struct{})
+		for _, g := range data.Governors {
+			if _, err := ac.StringToBytes(g.Address); err != nil {
+				return fmt.Errorf("invalid governor address %s: %w", g.Address, err)
+			}
+
+			if _, ok := governors[g.Address]; ok {
+				return fmt.Errorf("duplicate governor: %v", g)
+			}
+
+			governors[g.Address] = struct{}{}
+		}
+
+		delegators := make(map[string]struct{})
+		for _, d := range data.GovernanceDelegations {
+			if _, err := ac.StringToBytes(d.DelegatorAddress); err != nil {
+				return fmt.Errorf("invalid governance delegator address %s: %w", d.DelegatorAddress, err)
+			}
+
+			if _, ok := governors[d.GovernorAddress]; !ok {
+				return fmt.Errorf("governance delegation %v has non-existent governor: %s", d, d.GovernorAddress)
+			}
+
+			if d.DelegatorAddress == d.GovernorAddress {
+				return fmt.Errorf("governance delegation %v is to the delegator itself", d)
+			}
+
+			if _, ok := delegators[d.DelegatorAddress]; ok {
+				return fmt.Errorf("duplicate governance delegation: %v", d)
+			}
+
+			delegators[d.DelegatorAddress] = struct{}{}
+		}
+
+		return nil
+	})
+
 	// verify params
 	errGroup.Go(func() error {
 		return data.Params.ValidateBasic(ac)
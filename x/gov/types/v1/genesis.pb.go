@@ -49,6 +49,12 @@ type GenesisState struct {
 	// There are no amendments, to go outside of scope, just fork.
 	// constitution is an immutable string in genesis for a chain builder to lay out their vision, ideas and ideals.
 	Constitution string `protobuf:"bytes,9,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// conviction_locks defines all the conviction locks present at genesis.
+	ConvictionLocks []*ConvictionLock `protobuf:"bytes,10,rep,name=conviction_locks,json=convictionLocks,proto3" json:"conviction_locks,omitempty"`
+	// governors defines all the governors present at genesis.
+	Governors []*Governor `protobuf:"bytes,11,rep,name=governors,proto3" json:"governors,omitempty"`
+	// governance_delegations defines all the governance delegations present at genesis.
+	GovernanceDelegations []*GovernanceDelegation `protobuf:"bytes,12,rep,name=governance_delegations,json=governanceDelegations,proto3" json:"governance_delegations,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -150,6 +156,27 @@ func (m *GenesisState) GetConstitution() string {
 	return ""
 }
 
+func (m *GenesisState) GetConvictionLocks() []*ConvictionLock {
+	if m != nil {
+		return m.ConvictionLocks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGovernors() []*Governor {
+	if m != nil {
+		return m.Governors
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGovernanceDelegations() []*GovernanceDelegation {
+	if m != nil {
+		return m.GovernanceDelegations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1.GenesisState")
 }
@@ -157,32 +184,39 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/genesis.proto", fileDescriptor_ef7cfd15e3ded621) }
 
 var fileDescriptor_ef7cfd15e3ded621 = []byte{
-	// 400 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xbd, 0x6e, 0xe2, 0x40,
-	0x14, 0x85, 0x19, 0xfe, 0x16, 0x06, 0xb3, 0x2b, 0x0d, 0xbb, 0x8b, 0x17, 0x56, 0x96, 0xb5, 0x95,
-	0xb7, 0xc0, 0x06, 0x12, 0x44, 0x1d, 0x84, 0x84, 0xd2, 0x21, 0x27, 0x4a, 0x91, 0x06, 0x39, 0xd8,
-	0xb2, 0x2c, 0xc0, 0x63, 0x71, 0x27, 0xa3, 0xf0, 0x16, 0x79, 0x89, 0xbc, 0x41, 0x1e, 0x22, 0x25,
-	0x65, 0x94, 0x2a, 0x82, 0x17, 0x89, 0x98, 0xb1, 0x03, 0x38, 0x94, 0x73, 0xcf, 0x77, 0xce, 0x1c,
-	0x5d, 0x5d, 0xdc, 0x9c, 0x52, 0x58, 0x50, 0xb0, 0x7c, 0xca, 0x2d, 0xde, 0xb1, 0x7c, 0x2f, 0xf4,
-	0x20, 0x00, 0x33, 0x5a, 0x52, 0x46, 0x49, 0x55, 0x8a, 0xa6, 0x4f, 0xb9, 0xc9, 0x3b, 0x8d, 0x7a,
-	0x8a, 0xa5, 0x5c, 0x72, 0x8d, 0x3f, 0x52, 0x98, 0x88, 0x97, 0x15, 0x9b, 0xc4, 0xe3, 0xdf, 0x53,
-	0x1e, 0x2b, 0x23, 0x19, 0x7a, 0xc5, 0x1c, 0xe6, 0x91, 0x36, 0xfe, 0x09, 0xcc, 0x59, 0xb2, 0x20,
-	0xf4, 0x77, 0x7c, 0x44, 0xc1, 0x99, 0x4f, 0x02, 0x57, 0x45, 0x3a, 0x32, 0xf2, 0x36, 0x49, 0xb4,
-	0x71, 0x2c, 0x5d, 0xba, 0xa4, 0x8b, 0x4b, 0xae, 0x17, 0x51, 0x08, 0x18, 0xa8, 0x59, 0x3d, 0x67,
-	0x54, 0xba, 0xbf, 0xcd, 0xa3, 0x62, 0xe6, 0x50, 0xca, 0xf6, 0x27, 0x47, 0xfe, 0xe3, 0x02, 0xa7,
-	0xcc, 0x03, 0x35, 0x27, 0x0c, 0xb5, 0x94, 0xe1, 0x86, 0x32, 0xcf, 0x96, 0x04, 0xe9, 0xe1, 0x72,
-	0xd2, 0x03, 0xd4, 0xbc, 0xc0, 0xeb, 0x29, 0x3c, 0x29, 0x63, 0xef, 0x49, 0x32, 0xc2, 0xdf, 0xe3,
-	0xdf, 0x26, 0x91, 0xb3, 0x74, 0x16, 0xa0, 0x16, 0x74, 0x64, 0x54, 0xba, 0x7f, 0x4f, 0x77, 0x1b,
-	0x0b, 0x66, 0x90, 0x55, 0x91, 0x5d, 0x75, 0x0f, 0x47, 0x64, 0x88, 0xab, 0x9c, 0xca, 0x75, 0xc8,
-	0x9c, 0xa2, 0xc8, 0x69, 0x7e, 0xad, 0xbc, 0x5b, 0xcb, 0x3e, 0x46, 0xe1, 0x07, 0x13, 0x72, 0x81,
-	0x15, 0xe6, 0xcc, 0xe7, 0xab, 0x24, 0xe4, 0x9b, 0x08, 0x69, 0xa4, 0x42, 0xae, 0x77, 0xc8, 0x41,
-	0x46, 0x85, 0xed, 0x07, 0x64, 0x80, 0x8b, 0xb1, 0xb9, 0x24, 0xcc, 0xbf, 0xd2, 0x5b, 0x90, 0xbe,
-	0xda, 0xdb, 0x73, 0xeb, 0x87, 0x54, 0x5a, 0xe0, 0xce, 0xf4, 0xb6, 0x79, 0xde, 0xb7, 0x63, 0x27,
-	0xe9, 0x63, 0x65, 0x4a, 0x43, 0x60, 0x01, 0xbb, 0x67, 0x01, 0x0d, 0xd5, 0xb2, 0x8e, 0x8c, 0xf2,
-	0x09, 0x4b, 0xaf, 0x6d, 0x1f, 0x81, 0x83, 0xde, 0xcb, 0x46, 0x43, 0xeb, 0x8d, 0x86, 0xde, 0x37,
-	0x1a, 0x7a, 0xdc, 0x6a, 0x99, 0xf5, 0x56, 0xcb, 0xbc, 0x6e, 0xb5, 0xcc, 0x6d, 0x7c, 0xa1, 0xe0,
-	0xce, 0xcc, 0x80, 0x5a, 0x0f, 0xe2, 0xfa, 0xd8, 0x2a, 0xf2, 0xc0, 0xe2, 0x9d, 0xbb, 0xa2, 0xb8,
-	0xb2, 0xb3, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x79, 0xe0, 0xfa, 0xb1, 0xc7, 0x02, 0x00, 0x00,
+	// 497 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0xcd, 0x6e, 0xd3, 0x4e,
+	0x14, 0xc5, 0xe3, 0x7e, 0xe4, 0xdf, 0x4c, 0x92, 0x7f, 0xd0, 0x94, 0xb6, 0x43, 0x0a, 0x96, 0x05,
+	0x9b, 0xb0, 0x88, 0x9d, 0x04, 0xa2, 0xae, 0x09, 0x11, 0x11, 0x12, 0x8b, 0xca, 0x20, 0x24, 0xd8,
+	0x44, 0xc6, 0x1e, 0x59, 0x56, 0x52, 0x5f, 0xcb, 0x77, 0x18, 0xd1, 0xb7, 0xe0, 0x61, 0xca, 0x3b,
+	0xb0, 0xec, 0x12, 0x75, 0x85, 0x92, 0x17, 0x41, 0x9e, 0x71, 0xbe, 0x1c, 0x2f, 0xef, 0x9c, 0xdf,
+	0x39, 0x73, 0x74, 0xed, 0x21, 0x97, 0x3e, 0xe0, 0x0d, 0xa0, 0x13, 0x82, 0x74, 0x64, 0xdf, 0x09,
+	0x79, 0xcc, 0x31, 0x42, 0x3b, 0x49, 0x41, 0x00, 0x6d, 0x6a, 0xd1, 0x0e, 0x41, 0xda, 0xb2, 0xdf,
+	0xbe, 0x28, 0xb0, 0x20, 0x35, 0xd7, 0x7e, 0xa2, 0x85, 0xa9, 0x9a, 0x9c, 0xdc, 0xa4, 0x86, 0xe7,
+	0xbf, 0xaa, 0xa4, 0x31, 0xd1, 0xa1, 0x1f, 0x85, 0x27, 0x38, 0xed, 0x91, 0xc7, 0x28, 0xbc, 0x54,
+	0x44, 0x71, 0x98, 0xf1, 0x09, 0xa0, 0x37, 0x9f, 0x46, 0x01, 0x33, 0x2c, 0xa3, 0x73, 0xe4, 0xd2,
+	0x95, 0x76, 0x9d, 0x4b, 0xef, 0x03, 0x3a, 0x20, 0x27, 0x01, 0x4f, 0x00, 0x23, 0x81, 0xec, 0xc0,
+	0x3a, 0xec, 0xd4, 0x07, 0xe7, 0xf6, 0x4e, 0x31, 0x7b, 0xac, 0x65, 0x77, 0xcd, 0xd1, 0x97, 0xe4,
+	0x58, 0x82, 0xe0, 0xc8, 0x0e, 0x95, 0xe1, 0xb4, 0x60, 0xf8, 0x0c, 0x82, 0xbb, 0x9a, 0xa0, 0x43,
+	0x52, 0x5b, 0xf5, 0x40, 0x76, 0xa4, 0xf0, 0x8b, 0x02, 0xbe, 0x2a, 0xe3, 0x6e, 0x48, 0x3a, 0x21,
+	0xff, 0xe7, 0xb7, 0x4d, 0x13, 0x2f, 0xf5, 0x6e, 0x90, 0x1d, 0x5b, 0x46, 0xa7, 0x3e, 0x78, 0x5a,
+	0xde, 0xed, 0x5a, 0x31, 0xa3, 0x03, 0x66, 0xb8, 0xcd, 0x60, 0xfb, 0x88, 0x8e, 0x49, 0x53, 0x82,
+	0x5e, 0x87, 0xce, 0xa9, 0xaa, 0x9c, 0xcb, 0xfd, 0xca, 0xd9, 0x5a, 0x36, 0x31, 0x0d, 0xb9, 0x75,
+	0x42, 0xdf, 0x90, 0x86, 0xf0, 0xe6, 0xf3, 0xdb, 0x55, 0xc8, 0x7f, 0x2a, 0xa4, 0x5d, 0x08, 0xf9,
+	0x94, 0x21, 0x5b, 0x19, 0x75, 0xb1, 0x39, 0xa0, 0x23, 0x52, 0xcd, 0xcd, 0x27, 0xca, 0x7c, 0x56,
+	0xdc, 0x82, 0xf6, 0x9d, 0x3e, 0xdc, 0x75, 0x5b, 0x5a, 0xe9, 0x62, 0x30, 0xb3, 0x7a, 0xf6, 0xeb,
+	0x2b, 0x37, 0x77, 0xd2, 0x2b, 0xd2, 0xf0, 0x21, 0x46, 0x11, 0x89, 0xef, 0x22, 0x82, 0x98, 0xd5,
+	0x2c, 0xa3, 0x53, 0x2b, 0xb1, 0x0c, 0x7b, 0xee, 0x0e, 0x48, 0xbf, 0x90, 0x47, 0x3e, 0xc4, 0x32,
+	0xf2, 0xb3, 0x69, 0x3a, 0x07, 0x7f, 0x86, 0x8c, 0xa8, 0x8f, 0xf1, 0xac, 0x50, 0xe3, 0xed, 0x1a,
+	0xfb, 0x00, 0xfe, 0x6c, 0xd4, 0x7a, 0xb8, 0xeb, 0xd6, 0x7f, 0x64, 0x3f, 0xa3, 0xd5, 0xb7, 0x7b,
+	0x76, 0xcf, 0x6d, 0xf9, 0x3b, 0x00, 0xd2, 0x77, 0xa4, 0x16, 0x82, 0xe4, 0x69, 0x0c, 0x29, 0xb2,
+	0x7a, 0xe9, 0x07, 0x9e, 0xe4, 0xfa, 0x7e, 0xda, 0xc6, 0x4a, 0x67, 0xe4, 0x5c, 0x0f, 0x5e, 0xec,
+	0xf3, 0x69, 0xc0, 0xe7, 0x3c, 0xf4, 0xb2, 0x5b, 0x90, 0x35, 0x54, 0xe8, 0x8b, 0xd2, 0xd0, 0x0c,
+	0x1e, 0xaf, 0xd9, 0xfd, 0x0b, 0xce, 0xc2, 0x12, 0x0c, 0x47, 0xc3, 0xdf, 0x0b, 0xd3, 0xb8, 0x5f,
+	0x98, 0xc6, 0xdf, 0x85, 0x69, 0xfc, 0x5c, 0x9a, 0x95, 0xfb, 0xa5, 0x59, 0xf9, 0xb3, 0x34, 0x2b,
+	0x5f, 0xf3, 0x17, 0x8b, 0xc1, 0xcc, 0x8e, 0xc0, 0x51, 0x51, 0x8e, 0xb8, 0x4d, 0x38, 0x3a, 0xb2,
+	0xff, 0xad, 0xaa, 0x5e, 0xdd, 0xab, 0x7f, 0x03, 0x00, 0xfb, 0xa9, 0x6d, 0x41, 0xd7, 0x03, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -205,6 +239,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GovernanceDelegations) > 0 {
+		for iNdEx := len(m.GovernanceDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GovernanceDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Governors) > 0 {
+		for iNdEx := len(m.Governors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Governors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ConvictionLocks) > 0 {
+		for iNdEx := len(m.ConvictionLocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConvictionLocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Constitution) > 0 {
 		i -= len(m.Constitution)
 		copy(dAtA[i:], m.Constitution)
@@ -368,6 +444,24 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.ConvictionLocks) > 0 {
+		for _, e := range m.ConvictionLocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Governors) > 0 {
+		for _, e := range m.Governors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GovernanceDelegations) > 0 {
+		for _, e := range m.GovernanceDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -703,6 +797,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.Constitution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvictionLocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConvictionLocks = append(m.ConvictionLocks, &ConvictionLock{})
+			if err := m.ConvictionLocks[len(m.ConvictionLocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Governors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Governors = append(m.Governors, &Governor{})
+			if err := m.Governors[len(m.Governors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernanceDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernanceDelegations = append(m.GovernanceDelegations, &GovernanceDelegation{})
+			if err := m.GovernanceDelegations[len(m.GovernanceDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -4,6 +4,7 @@
 package v1
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -956,15 +957,15 @@ type Params struct {
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
 	ProposalCancelRatio string `protobuf:"bytes,8,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty"`
@@ -975,7 +976,7 @@ type Params struct {
 	ExpeditedVotingPeriod *time.Duration `protobuf:"bytes,10,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
 	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.67.
 	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
+	//  Minimum expedited deposit for a proposal to enter voting period.
 	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
@@ -1005,6 +1006,10 @@ type Params struct {
 	// considered valid for an expedited proposal.
 	ExpeditedQuorum      string `protobuf:"bytes,21,opt,name=expedited_quorum,json=expeditedQuorum,proto3" json:"expedited_quorum,omitempty"`
 	ProposalExecutionGas uint64 `protobuf:"varint,22,opt,name=proposal_execution_gas,json=proposalExecutionGas,proto3" json:"proposal_execution_gas,omitempty"`
+	// conviction_lock_period is the period a conviction lock of conviction 1 locks the delegated
+	// tokens of a voter for. A conviction lock of conviction N locks them for N periods.
+	// If empty, conviction voting is disabled.
+	ConvictionLockPeriod *time.Duration `protobuf:"bytes,23,opt,name=conviction_lock_period,json=convictionLockPeriod,proto3,stdduration" json:"conviction_lock_period,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1194,6 +1199,13 @@ func (m *Params) GetProposalExecutionGas() uint64 {
 	return 0
 }
 
+func (m *Params) GetConvictionLockPeriod() *time.Duration {
+	if m != nil {
+		return m.ConvictionLockPeriod
+	}
+	return nil
+}
+
 // MessageBasedParams defines the parameters of specific messages in a proposal.
 // It is used to define the parameters of a proposal that is based on a specific message.
 // Once a message has message based params, it only supports a standard proposal type.
@@ -1279,6 +1291,194 @@ func (m *MessageBasedParams) GetVetoThreshold() string {
 	return ""
 }
 
+// ConvictionLock defines a lock of the delegated tokens of a voter, multiplying the voting
+// power of the locked tokens by the conviction of the lock until it expires.
+type ConvictionLock struct {
+	// voter is the address of the voter locking its delegated tokens.
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	// amount is the amount of delegated tokens locked.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// conviction is the voting power multiplier of the locked tokens, from 1 to 6.
+	Conviction uint32 `protobuf:"varint,3,opt,name=conviction,proto3" json:"conviction,omitempty"`
+	// unlock_time is the time at which the lock expires.
+	UnlockTime time.Time `protobuf:"bytes,4,opt,name=unlock_time,json=unlockTime,proto3,stdtime" json:"unlock_time"`
+}
+
+func (m *ConvictionLock) Reset()         { *m = ConvictionLock{} }
+func (m *ConvictionLock) String() string { return proto.CompactTextString(m) }
+func (*ConvictionLock) ProtoMessage()    {}
+func (*ConvictionLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{11}
+}
+func (m *ConvictionLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConvictionLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConvictionLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConvictionLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvictionLock.Merge(m, src)
+}
+func (m *ConvictionLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConvictionLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvictionLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConvictionLock proto.InternalMessageInfo
+
+func (m *ConvictionLock) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *ConvictionLock) GetConviction() uint32 {
+	if m != nil {
+		return m.Conviction
+	}
+	return 0
+}
+
+func (m *ConvictionLock) GetUnlockTime() time.Time {
+	if m != nil {
+		return m.UnlockTime
+	}
+	return time.Time{}
+}
+
+// Governor defines an account registered as a governor, to which token holders can delegate
+// their governance voting power.
+type Governor struct {
+	// address is the address of the governor.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// moniker defines a human-readable name for the governor.
+	Moniker string `protobuf:"bytes,2,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	// details define other optional details about the governor.
+	Details string `protobuf:"bytes,3,opt,name=details,proto3" json:"details,omitempty"`
+}
+
+func (m *Governor) Reset()         { *m = Governor{} }
+func (m *Governor) String() string { return proto.CompactTextString(m) }
+func (*Governor) ProtoMessage()    {}
+func (*Governor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{12}
+}
+func (m *Governor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Governor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Governor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Governor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Governor.Merge(m, src)
+}
+func (m *Governor) XXX_Size() int {
+	return m.Size()
+}
+func (m *Governor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Governor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Governor proto.InternalMessageInfo
+
+func (m *Governor) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Governor) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
+	}
+	return ""
+}
+
+func (m *Governor) GetDetails() string {
+	if m != nil {
+		return m.Details
+	}
+	return ""
+}
+
+// GovernanceDelegation defines the delegation of the governance voting power of a
+// token holder to a governor.
+type GovernanceDelegation struct {
+	// delegator_address is the address of the token holder delegating its voting power.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// governor_address is the address of the governor the voting power is delegated to.
+	GovernorAddress string `protobuf:"bytes,2,opt,name=governor_address,json=governorAddress,proto3" json:"governor_address,omitempty"`
+}
+
+func (m *GovernanceDelegation) Reset()         { *m = GovernanceDelegation{} }
+func (m *GovernanceDelegation) String() string { return proto.CompactTextString(m) }
+func (*GovernanceDelegation) ProtoMessage()    {}
+func (*GovernanceDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{13}
+}
+func (m *GovernanceDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernanceDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovernanceDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovernanceDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceDelegation.Merge(m, src)
+}
+func (m *GovernanceDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernanceDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceDelegation proto.InternalMessageInfo
+
+func (m *GovernanceDelegation) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *GovernanceDelegation) GetGovernorAddress() string {
+	if m != nil {
+		return m.GovernorAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.ProposalType", ProposalType_name, ProposalType_value)
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
@@ -1294,138 +1494,154 @@ func init() {
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
 	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
 	proto.RegisterType((*MessageBasedParams)(nil), "cosmos.gov.v1.MessageBasedParams")
+	proto.RegisterType((*ConvictionLock)(nil), "cosmos.gov.v1.ConvictionLock")
+	proto.RegisterType((*Governor)(nil), "cosmos.gov.v1.Governor")
+	proto.RegisterType((*GovernanceDelegation)(nil), "cosmos.gov.v1.GovernanceDelegation")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 2006 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x6f, 0xdb, 0xc8,
-	0x19, 0x0e, 0x25, 0xf9, 0x43, 0xaf, 0x25, 0x99, 0x1e, 0xdb, 0x31, 0x63, 0xaf, 0x3f, 0x62, 0x14,
-	0x0b, 0x37, 0xbb, 0x96, 0xec, 0x6c, 0xdd, 0x6e, 0xd3, 0xcd, 0x41, 0xb2, 0x98, 0x84, 0x41, 0x6c,
-	0xa9, 0x14, 0xe3, 0x24, 0x2d, 0x0a, 0x82, 0x36, 0x27, 0x32, 0x77, 0x45, 0x8e, 0x4a, 0x8e, 0x6c,
-	0xab, 0xbf, 0x62, 0x8f, 0x3d, 0x15, 0xbd, 0x75, 0x8f, 0x3d, 0x04, 0xbd, 0xf7, 0xd4, 0x45, 0x0f,
-	0xc5, 0x22, 0xa7, 0x62, 0x81, 0xa6, 0x45, 0x72, 0x28, 0xb0, 0x3f, 0xa1, 0xe8, 0xa1, 0x98, 0xe1,
-	0x50, 0xa4, 0x3e, 0x1c, 0xcb, 0x8b, 0x5e, 0x12, 0x79, 0xe6, 0x79, 0x9e, 0x79, 0xe7, 0xfd, 0x9a,
-	0x57, 0x82, 0xa5, 0x13, 0x12, 0xb8, 0x24, 0x28, 0x35, 0xc9, 0x59, 0xe9, 0x6c, 0x97, 0xfd, 0x57,
-	0x6c, 0xfb, 0x84, 0x12, 0x94, 0x0f, 0x37, 0x8a, 0x6c, 0xe5, 0x6c, 0x77, 0x79, 0x4d, 0xe0, 0x8e,
-	0xad, 0x00, 0x97, 0xce, 0x76, 0x8f, 0x31, 0xb5, 0x76, 0x4b, 0x27, 0xc4, 0xf1, 0x42, 0xf8, 0xf2,
-	0x42, 0x93, 0x34, 0x09, 0xff, 0x58, 0x62, 0x9f, 0xc4, 0xea, 0x7a, 0x93, 0x90, 0x66, 0x0b, 0x97,
-	0xf8, 0x5f, 0xc7, 0x9d, 0x97, 0x25, 0xea, 0xb8, 0x38, 0xa0, 0x96, 0xdb, 0x16, 0x80, 0x5b, 0x83,
-	0x00, 0xcb, 0xeb, 0x8a, 0xad, 0xb5, 0xc1, 0x2d, 0xbb, 0xe3, 0x5b, 0xd4, 0x21, 0xd1, 0x89, 0xb7,
-	0x42, 0x8b, 0xcc, 0xf0, 0x50, 0x61, 0x6d, 0xb8, 0x35, 0x67, 0xb9, 0x8e, 0x47, 0x4a, 0xfc, 0xdf,
-	0x70, 0x69, 0x93, 0x00, 0x7a, 0x86, 0x9d, 0xe6, 0x29, 0xc5, 0xf6, 0x11, 0xa1, 0xb8, 0xd6, 0x66,
-	0x4a, 0x68, 0x17, 0x26, 0x09, 0xff, 0xa4, 0x48, 0x1b, 0xd2, 0x56, 0xe1, 0xee, 0xad, 0x62, 0xdf,
-	0xad, 0x8b, 0x31, 0x54, 0x17, 0x40, 0xf4, 0x21, 0x4c, 0x9e, 0x73, 0x21, 0x25, 0xb5, 0x21, 0x6d,
-	0x65, 0x2b, 0x85, 0xd7, 0xaf, 0xb6, 0x41, 0xb0, 0xaa, 0xf8, 0x44, 0x17, 0xbb, 0x9b, 0xbf, 0x97,
-	0x60, 0xaa, 0x8a, 0xdb, 0x24, 0x70, 0x28, 0x5a, 0x87, 0x99, 0xb6, 0x4f, 0xda, 0x24, 0xb0, 0x5a,
-	0xa6, 0x63, 0xf3, 0xb3, 0x32, 0x3a, 0x44, 0x4b, 0x9a, 0x8d, 0x7e, 0x0c, 0x59, 0x3b, 0xc4, 0x12,
-	0x5f, 0xe8, 0x2a, 0xaf, 0x5f, 0x6d, 0x2f, 0x08, 0xdd, 0xb2, 0x6d, 0xfb, 0x38, 0x08, 0x1a, 0xd4,
-	0x77, 0xbc, 0xa6, 0x1e, 0x43, 0xd1, 0x67, 0x30, 0x69, 0xb9, 0xa4, 0xe3, 0x51, 0x25, 0xbd, 0x91,
-	0xde, 0x9a, 0x89, 0xed, 0x67, 0x61, 0x2a, 0x8a, 0x30, 0x15, 0xf7, 0x89, 0xe3, 0x55, 0xb2, 0x5f,
-	0xbf, 0x59, 0xbf, 0xf1, 0xd5, 0xbf, 0xff, 0x78, 0x47, 0xd2, 0x05, 0x67, 0xf3, 0xcf, 0x53, 0x30,
-	0x5d, 0x17, 0x46, 0xa0, 0x02, 0xa4, 0x7a, 0xa6, 0xa5, 0x1c, 0x1b, 0xed, 0xc0, 0xb4, 0x8b, 0x83,
-	0xc0, 0x6a, 0xe2, 0x40, 0x49, 0x71, 0xf1, 0x85, 0x62, 0x18, 0x91, 0x62, 0x14, 0x91, 0x62, 0xd9,
-	0xeb, 0xea, 0x3d, 0x14, 0xda, 0x83, 0xc9, 0x80, 0x5a, 0xb4, 0x13, 0x28, 0x69, 0xee, 0xcc, 0xd5,
-	0x01, 0x67, 0x46, 0x47, 0x35, 0x38, 0x48, 0x17, 0x60, 0xf4, 0x08, 0xd0, 0x4b, 0xc7, 0xb3, 0x5a,
-	0x26, 0xb5, 0x5a, 0xad, 0xae, 0xe9, 0xe3, 0xa0, 0xd3, 0xa2, 0x4a, 0x66, 0x43, 0xda, 0x9a, 0xb9,
-	0xbb, 0x3c, 0x20, 0x61, 0x30, 0x88, 0xce, 0x11, 0xba, 0xcc, 0x59, 0x89, 0x15, 0x54, 0x86, 0x99,
-	0xa0, 0x73, 0xec, 0x3a, 0xd4, 0x64, 0x69, 0xa6, 0x4c, 0x08, 0x89, 0x41, 0xab, 0x8d, 0x28, 0x07,
-	0x2b, 0x99, 0x2f, 0xff, 0xb9, 0x2e, 0xe9, 0x10, 0x92, 0xd8, 0x32, 0x7a, 0x0c, 0xb2, 0xf0, 0xae,
-	0x89, 0x3d, 0x3b, 0xd4, 0x99, 0x1c, 0x53, 0xa7, 0x20, 0x98, 0xaa, 0x67, 0x73, 0x2d, 0x0d, 0xf2,
-	0x94, 0x50, 0xab, 0x65, 0x8a, 0x75, 0x65, 0xea, 0x1a, 0x31, 0xca, 0x71, 0x6a, 0x94, 0x40, 0x4f,
-	0x60, 0xee, 0x8c, 0x50, 0xc7, 0x6b, 0x9a, 0x01, 0xb5, 0x7c, 0x71, 0xbf, 0xe9, 0x31, 0xed, 0x9a,
-	0x0d, 0xa9, 0x0d, 0xc6, 0xe4, 0x86, 0x3d, 0x02, 0xb1, 0x14, 0xdf, 0x31, 0x3b, 0xa6, 0x56, 0x3e,
-	0x24, 0x46, 0x57, 0x5c, 0x66, 0x49, 0x42, 0x2d, 0xdb, 0xa2, 0x96, 0x02, 0x2c, 0x6d, 0xf5, 0xde,
-	0xdf, 0xe8, 0x87, 0x30, 0x41, 0x1d, 0xda, 0xc2, 0xca, 0x0c, 0xcf, 0xe7, 0xf9, 0x6f, 0x5f, 0x6d,
-	0xcf, 0x86, 0x37, 0xdf, 0x0e, 0xec, 0x2f, 0x36, 0x76, 0x8a, 0x3f, 0xfa, 0x89, 0x1e, 0x22, 0xd0,
-	0x36, 0x4c, 0x05, 0x1d, 0xd7, 0xb5, 0xfc, 0xae, 0x92, 0xbb, 0x1c, 0x1c, 0x61, 0xd0, 0x43, 0x98,
-	0x0e, 0x6b, 0x07, 0xfb, 0x4a, 0x9e, 0xe3, 0x3f, 0xba, 0xac, 0x58, 0x46, 0xe9, 0xf4, 0xc8, 0xe8,
-	0x13, 0xc8, 0xe2, 0x8b, 0x36, 0xb6, 0x1d, 0x8a, 0x6d, 0xa5, 0xb0, 0x21, 0x6d, 0x4d, 0x57, 0x16,
-	0x87, 0x18, 0x7b, 0x3b, 0x8a, 0xa4, 0xc7, 0x38, 0xf4, 0x29, 0xe4, 0x5f, 0x5a, 0x4e, 0x0b, 0xdb,
-	0xa6, 0x8f, 0xad, 0x80, 0x78, 0xca, 0xec, 0x25, 0x26, 0xef, 0xed, 0xe8, 0xb9, 0x10, 0xa9, 0x73,
-	0x20, 0xd2, 0x21, 0xdf, 0x6b, 0x03, 0xb4, 0xdb, 0xc6, 0x8a, 0xcc, 0xeb, 0x64, 0xe5, 0x92, 0x3a,
-	0x31, 0xba, 0x6d, 0x5c, 0x91, 0xbf, 0x7d, 0xb5, 0x9d, 0xbb, 0x60, 0x7d, 0x79, 0xe3, 0x6c, 0xa7,
-	0x78, 0xb7, 0xb8, 0xa3, 0xe7, 0xda, 0x89, 0xfd, 0xcd, 0xbf, 0x4a, 0x30, 0x1f, 0x11, 0xe2, 0x6e,
-	0x15, 0xa0, 0x55, 0x80, 0xb0, 0x61, 0x99, 0xc4, 0xc3, 0xbc, 0xac, 0xb3, 0x7a, 0x36, 0x5c, 0xa9,
-	0x79, 0x38, 0xb1, 0x4d, 0xcf, 0x49, 0xd8, 0x71, 0xa2, 0x6d, 0xe3, 0x9c, 0xa0, 0xdb, 0x90, 0x8b,
-	0xb6, 0x4f, 0x7d, 0x8c, 0x79, 0x41, 0x67, 0xf5, 0x19, 0x01, 0x60, 0x4b, 0xac, 0xa7, 0x09, 0xc8,
-	0x4b, 0xd2, 0xf1, 0x79, 0xbd, 0x66, 0x75, 0x21, 0xfa, 0x80, 0x74, 0xfc, 0x04, 0x20, 0x68, 0x5b,
-	0x2e, 0xaf, 0xc6, 0x1e, 0xa0, 0xd1, 0xb6, 0xdc, 0x7b, 0xf2, 0xeb, 0x81, 0xab, 0x6d, 0xfe, 0x37,
-	0x0d, 0x33, 0xc9, 0x82, 0xde, 0x86, 0x6c, 0x17, 0x07, 0xe6, 0x09, 0xef, 0x70, 0xfc, 0x0e, 0x15,
-	0x39, 0xd1, 0x6e, 0x35, 0xb6, 0xaa, 0x4f, 0x77, 0x71, 0xb0, 0xcf, 0x10, 0x68, 0x0f, 0xf2, 0xd6,
-	0x71, 0x40, 0x2d, 0xc7, 0x13, 0x94, 0xd4, 0x25, 0x94, 0x9c, 0x80, 0x85, 0xb4, 0x8f, 0x60, 0xda,
-	0x23, 0x82, 0x91, 0xbe, 0x84, 0x31, 0xe5, 0x91, 0x10, 0x7c, 0x1f, 0x90, 0x47, 0xcc, 0x73, 0x87,
-	0x9e, 0x9a, 0x67, 0x98, 0x46, 0xb4, 0xcc, 0x25, 0xb4, 0x59, 0x8f, 0x3c, 0x73, 0xe8, 0xe9, 0x11,
-	0xa6, 0x82, 0xfe, 0x29, 0xc8, 0x71, 0x58, 0x04, 0x79, 0x62, 0xe8, 0x1d, 0xd1, 0x3c, 0xaa, 0x17,
-	0x7a, 0xc1, 0x1a, 0x64, 0xd2, 0xf3, 0xe8, 0xd8, 0xc9, 0xf7, 0x31, 0x8d, 0x73, 0x71, 0xe6, 0x67,
-	0x80, 0x92, 0xc1, 0x14, 0xdc, 0xa9, 0x91, 0x5c, 0x39, 0x11, 0xe2, 0x90, 0x7d, 0x0f, 0xe6, 0x12,
-	0x71, 0x16, 0xe4, 0xe9, 0x91, 0xe4, 0xd9, 0x38, 0xfa, 0x21, 0x77, 0x1b, 0x80, 0xc5, 0x5e, 0x90,
-	0xb2, 0x23, 0x49, 0x59, 0x86, 0xe0, 0xf0, 0xcd, 0x3f, 0x49, 0x90, 0x61, 0x39, 0x7c, 0xf5, 0x7b,
-	0x59, 0x84, 0x89, 0x33, 0x42, 0xf1, 0xd5, 0x6f, 0x65, 0x08, 0x43, 0x3f, 0x83, 0xa9, 0xd0, 0xb6,
-	0x40, 0xc9, 0xf0, 0x26, 0x7c, 0x7b, 0xa0, 0xe6, 0x86, 0x67, 0x03, 0x3d, 0x62, 0xf4, 0x35, 0xb9,
-	0x89, 0xfe, 0x26, 0xf7, 0x38, 0x33, 0x9d, 0x96, 0x33, 0x9b, 0xff, 0x90, 0x20, 0x2f, 0x5a, 0x75,
-	0xdd, 0xf2, 0x2d, 0x37, 0x40, 0x2f, 0x60, 0xc6, 0x75, 0xbc, 0x5e, 0xe7, 0x97, 0xae, 0xea, 0xfc,
-	0xab, 0xac, 0xf3, 0x7f, 0xf7, 0x66, 0x7d, 0x31, 0xc1, 0xfa, 0x98, 0xb8, 0x0e, 0xc5, 0x6e, 0x9b,
-	0x76, 0x75, 0x70, 0x1d, 0x2f, 0x7a, 0x0b, 0x5c, 0x40, 0xae, 0x75, 0x11, 0x81, 0xcc, 0x36, 0xf6,
-	0x1d, 0x62, 0x73, 0x47, 0xb0, 0x13, 0x06, 0x1b, 0x78, 0x55, 0x0c, 0x4d, 0x95, 0x1f, 0x7c, 0xf7,
-	0x66, 0xfd, 0x83, 0x61, 0x62, 0x7c, 0xc8, 0x6f, 0x59, 0x7f, 0x97, 0x5d, 0xeb, 0x22, 0xba, 0x09,
-	0xdf, 0xbf, 0x97, 0x52, 0xa4, 0xcd, 0xe7, 0x90, 0x3b, 0xe2, 0x7d, 0x5f, 0xdc, 0xae, 0x0a, 0xe2,
-	0x1d, 0x88, 0x4e, 0x97, 0xae, 0x3a, 0x3d, 0xc3, 0xd5, 0x73, 0x21, 0x2b, 0xa1, 0xfc, 0x3b, 0x49,
-	0x54, 0xbc, 0x50, 0xfe, 0x10, 0x26, 0x7f, 0xdd, 0x21, 0x7e, 0xc7, 0x15, 0xe5, 0x3e, 0x34, 0x5d,
-	0x85, 0xbb, 0xe8, 0x63, 0xc8, 0xb2, 0x64, 0x0e, 0x4e, 0x49, 0xcb, 0xbe, 0x64, 0x10, 0x8b, 0x01,
-	0x68, 0x0f, 0x0a, 0xbc, 0x58, 0x63, 0x4a, 0x7a, 0x24, 0x25, 0xcf, 0x50, 0x46, 0x04, 0xe2, 0x06,
-	0xfe, 0x25, 0x0f, 0x93, 0xc2, 0x36, 0xf5, 0x9a, 0x31, 0x4d, 0xbc, 0xe6, 0xc9, 0xf8, 0x1d, 0x7c,
-	0xbf, 0xf8, 0x65, 0x46, 0xc7, 0x67, 0x38, 0x16, 0xe9, 0xef, 0x11, 0x8b, 0x84, 0xdf, 0x33, 0xe3,
-	0xfb, 0x7d, 0xe2, 0xfa, 0x7e, 0x9f, 0x1c, 0xc3, 0xef, 0x48, 0x83, 0x5b, 0xcc, 0xd1, 0x8e, 0xe7,
-	0x50, 0x27, 0x1e, 0x9f, 0x4c, 0x6e, 0xfe, 0x88, 0xbe, 0xc5, 0x14, 0x6e, 0xba, 0x8e, 0xa7, 0x85,
-	0x78, 0xe1, 0x1e, 0x9d, 0xa1, 0xd1, 0x53, 0x58, 0xec, 0x75, 0x92, 0x13, 0xcb, 0x3b, 0xc1, 0x2d,
-	0x21, 0x13, 0x76, 0xb0, 0xdb, 0xfd, 0x32, 0xa3, 0x9e, 0xf0, 0xf9, 0x88, 0xbf, 0xcf, 0xe9, 0xa1,
-	0xec, 0xaf, 0x60, 0x61, 0x50, 0xd6, 0xc6, 0x41, 0xd4, 0xe2, 0xc6, 0x9f, 0x46, 0xf6, 0x76, 0x74,
-	0xd4, 0xaf, 0x5f, 0xc5, 0x01, 0x45, 0x9f, 0xc3, 0x52, 0x6f, 0xde, 0x30, 0xfb, 0xa3, 0x0b, 0x57,
-	0x45, 0x77, 0x89, 0x45, 0x77, 0xd4, 0x41, 0x8b, 0x3d, 0xc9, 0xa3, 0x64, 0xe4, 0x75, 0x98, 0x8f,
-	0xcf, 0x8a, 0x03, 0x35, 0x33, 0xae, 0x7f, 0x50, 0x8f, 0x1d, 0x07, 0xf0, 0x39, 0xc4, 0x87, 0x99,
-	0xc9, 0x9a, 0xc9, 0x5d, 0xa3, 0x66, 0x62, 0xb3, 0x0e, 0xe2, 0xe2, 0xb9, 0x0f, 0xf2, 0x71, 0xc7,
-	0xf7, 0x98, 0x53, 0xb0, 0x29, 0x32, 0x36, 0xcf, 0x07, 0xb7, 0x91, 0x23, 0x63, 0x81, 0x81, 0x59,
-	0x4f, 0xff, 0x79, 0x98, 0xbe, 0x47, 0xb0, 0xca, 0xe9, 0xbd, 0xe0, 0xf5, 0xaa, 0xd0, 0xc7, 0x4c,
-	0x52, 0x0c, 0x81, 0x23, 0xb5, 0x96, 0x19, 0x33, 0x1a, 0xb5, 0xa2, 0x1a, 0x0c, 0x69, 0xe8, 0xa7,
-	0x50, 0x88, 0xcd, 0x62, 0xc9, 0xcc, 0x87, 0xc2, 0x4b, 0x84, 0x72, 0x91, 0x51, 0x6c, 0x2c, 0x40,
-	0x07, 0x30, 0x97, 0xf0, 0x90, 0xc8, 0x4e, 0x79, 0x5c, 0xef, 0xcf, 0xc6, 0x8d, 0x25, 0xcc, 0xcc,
-	0x5f, 0xc2, 0xf2, 0x60, 0x66, 0xb2, 0x6e, 0x23, 0xb2, 0x67, 0x8e, 0xeb, 0xae, 0x0d, 0xe9, 0xf6,
-	0x4f, 0x98, 0x4b, 0xfd, 0x29, 0x79, 0x60, 0x5d, 0x88, 0x5c, 0x69, 0xc3, 0x3a, 0x7b, 0x14, 0x5d,
-	0x27, 0xa0, 0xce, 0x89, 0x69, 0x75, 0xe8, 0x29, 0xf1, 0x9d, 0xdf, 0x60, 0xdb, 0xb4, 0xc2, 0x2c,
-	0xc7, 0x81, 0x82, 0x36, 0xd2, 0x5b, 0xd9, 0xca, 0xd6, 0x7b, 0x2a, 0xa0, 0xff, 0xac, 0xd5, 0x58,
-	0xb0, 0xdc, 0xd3, 0x2b, 0x47, 0x72, 0xe8, 0x18, 0x12, 0x00, 0xd3, 0xc7, 0x9f, 0xe3, 0x93, 0xfe,
-	0x3c, 0x9d, 0x1f, 0xeb, 0x46, 0x2b, 0xb1, 0x88, 0x2e, 0x34, 0xe2, 0x6c, 0xbd, 0x0f, 0xc0, 0xa6,
-	0x4c, 0x91, 0x4d, 0x0b, 0x63, 0x09, 0xb2, 0xb9, 0x54, 0xe4, 0x94, 0x06, 0x72, 0x9c, 0xec, 0x42,
-	0x64, 0xf1, 0x0a, 0x91, 0xdd, 0xe2, 0x4e, 0x71, 0x47, 0x9f, 0xed, 0xf1, 0x84, 0xd4, 0x03, 0xb8,
-	0xd9, 0x0b, 0x1e, 0xbe, 0xc0, 0x27, 0x1d, 0x3e, 0x77, 0x35, 0xad, 0x40, 0xb9, 0xc9, 0x46, 0xa0,
-	0x11, 0x5f, 0x06, 0x7a, 0x6d, 0x48, 0x8d, 0xe0, 0x0f, 0xad, 0xe0, 0xde, 0xfc, 0xeb, 0xe1, 0xb4,
-	0xdb, 0xfc, 0x2a, 0x05, 0xe8, 0x20, 0xfc, 0xae, 0x5e, 0xb1, 0x02, 0x6c, 0xff, 0x3f, 0xdf, 0xf2,
-	0xc4, 0xfb, 0x91, 0x7a, 0xef, 0xfb, 0xb1, 0x3d, 0xc2, 0xd7, 0x43, 0x0f, 0x48, 0xec, 0xdb, 0xbe,
-	0xe7, 0x26, 0x7d, 0xfd, 0xe7, 0x26, 0x33, 0xce, 0x33, 0x3f, 0xf4, 0x3d, 0xe4, 0xce, 0x1f, 0x24,
-	0xc8, 0x25, 0xbf, 0x85, 0xa1, 0x55, 0xb8, 0x55, 0xd7, 0x6b, 0xf5, 0x5a, 0xa3, 0xfc, 0xc4, 0x34,
-	0x5e, 0xd4, 0x55, 0xf3, 0xe9, 0x61, 0xa3, 0xae, 0xee, 0x6b, 0x0f, 0x34, 0xb5, 0x2a, 0xdf, 0x40,
-	0xcb, 0x70, 0xb3, 0x7f, 0xbb, 0x61, 0x94, 0x0f, 0xab, 0x65, 0xbd, 0x2a, 0x4b, 0xe8, 0x36, 0xac,
-	0xf6, 0xef, 0x1d, 0x3c, 0x7d, 0x62, 0x68, 0xf5, 0x27, 0xaa, 0xb9, 0xff, 0xa8, 0xa6, 0xed, 0xab,
-	0x72, 0x0a, 0x7d, 0x00, 0x4a, 0x3f, 0xa4, 0x56, 0x37, 0xb4, 0x03, 0xad, 0x61, 0x68, 0xfb, 0x72,
-	0x1a, 0xad, 0xc0, 0x52, 0xff, 0xae, 0xfa, 0xbc, 0xae, 0x56, 0x35, 0x43, 0xad, 0xca, 0x99, 0x3b,
-	0xff, 0x91, 0x00, 0x12, 0xbf, 0x67, 0xad, 0xc0, 0xd2, 0x51, 0xcd, 0x08, 0x05, 0x6a, 0x87, 0x03,
-	0x56, 0xce, 0xc3, 0x6c, 0x72, 0xf3, 0x85, 0xda, 0x90, 0xa5, 0xc1, 0xc5, 0xda, 0xa1, 0x2a, 0x4b,
-	0x68, 0x09, 0xe6, 0x93, 0x8b, 0xe5, 0x4a, 0xc3, 0x28, 0x6b, 0x87, 0x72, 0x6a, 0x10, 0x6d, 0x3c,
-	0xab, 0xc9, 0x29, 0x84, 0xa0, 0x90, 0x5c, 0x3c, 0xac, 0xc9, 0x69, 0xb4, 0x08, 0x73, 0x7d, 0xc0,
-	0x47, 0xba, 0xaa, 0xca, 0x69, 0x76, 0xd3, 0x7e, 0xa8, 0xf9, 0x4c, 0x33, 0x1e, 0x99, 0x47, 0xaa,
-	0x51, 0x93, 0x33, 0x68, 0x01, 0xe4, 0xe4, 0xee, 0x83, 0xda, 0x53, 0x7d, 0x78, 0xb5, 0x51, 0x2f,
-	0x1f, 0xc8, 0x13, 0xcb, 0x29, 0x59, 0xba, 0xf3, 0x37, 0x09, 0x0a, 0xfd, 0x3f, 0x2a, 0xa1, 0x75,
-	0x58, 0xe9, 0x39, 0xab, 0x61, 0x94, 0x8d, 0xa7, 0x8d, 0x01, 0x27, 0x6c, 0xc2, 0xda, 0x20, 0xa0,
-	0xaa, 0xd6, 0x6b, 0x0d, 0xcd, 0x30, 0xeb, 0xaa, 0xae, 0xd5, 0x06, 0x43, 0x26, 0x30, 0x47, 0x35,
-	0x43, 0x3b, 0x7c, 0x18, 0x41, 0x52, 0x7d, 0x11, 0x17, 0x90, 0x7a, 0xb9, 0xd1, 0x50, 0xab, 0xe1,
-	0x25, 0x07, 0xf7, 0x74, 0xf5, 0xb1, 0xba, 0xcf, 0x23, 0x36, 0x8a, 0xf9, 0xa0, 0xac, 0x3d, 0x51,
-	0xab, 0xf2, 0x44, 0x65, 0xef, 0xeb, 0xb7, 0x6b, 0xd2, 0x37, 0x6f, 0xd7, 0xa4, 0x7f, 0xbd, 0x5d,
-	0x93, 0xbe, 0x7c, 0xb7, 0x76, 0xe3, 0x9b, 0x77, 0x6b, 0x37, 0xfe, 0xfe, 0x6e, 0xed, 0xc6, 0x2f,
-	0x56, 0xc2, 0xf4, 0x0d, 0xec, 0x2f, 0x8a, 0x0e, 0x29, 0xf1, 0x64, 0x2d, 0xd1, 0x6e, 0x1b, 0x07,
-	0xa5, 0xb3, 0xdd, 0xe3, 0x49, 0x5e, 0xa3, 0x9f, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x10, 0x4b,
-	0xae, 0xb9, 0xcc, 0x15, 0x00, 0x00,
+	// 2216 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xcf, 0x6f, 0xdb, 0xc8,
+	0xf5, 0x0f, 0x25, 0xf9, 0x87, 0x9e, 0x25, 0x99, 0x1e, 0xdb, 0x31, 0x63, 0xaf, 0x65, 0x47, 0xf8,
+	0x62, 0xe1, 0x6f, 0x76, 0x2d, 0xdb, 0xd9, 0xba, 0xdd, 0xa6, 0xbb, 0x07, 0xc9, 0x62, 0x62, 0x05,
+	0xb6, 0xa5, 0x52, 0x8a, 0x93, 0xb4, 0x28, 0x08, 0x5a, 0x9c, 0xc8, 0xdc, 0x88, 0x1c, 0x95, 0xa4,
+	0xfc, 0xa3, 0xd7, 0x1e, 0x7a, 0xdd, 0x63, 0x4f, 0x45, 0x6f, 0xdd, 0x4b, 0x81, 0x02, 0x0d, 0x7a,
+	0xef, 0x6d, 0xd1, 0x43, 0xb1, 0xc8, 0xa9, 0x08, 0xd0, 0xb4, 0x48, 0x0e, 0x05, 0xf6, 0x4f, 0x28,
+	0x7a, 0x28, 0xe6, 0x07, 0x45, 0x52, 0x92, 0x63, 0x65, 0xd1, 0x4b, 0x22, 0xcd, 0x7c, 0x3e, 0x9f,
+	0x79, 0xf3, 0xde, 0x9b, 0x37, 0x4f, 0x63, 0x58, 0x6a, 0x11, 0xcf, 0x26, 0xde, 0x56, 0x9b, 0x9c,
+	0x6d, 0x9d, 0xed, 0xd0, 0xff, 0x8a, 0x5d, 0x97, 0xf8, 0x04, 0x65, 0xf9, 0x44, 0x91, 0x8e, 0x9c,
+	0xed, 0x2c, 0xe7, 0x05, 0xee, 0xc4, 0xf0, 0xf0, 0xd6, 0xd9, 0xce, 0x09, 0xf6, 0x8d, 0x9d, 0xad,
+	0x16, 0xb1, 0x1c, 0x0e, 0x5f, 0x5e, 0x68, 0x93, 0x36, 0x61, 0x1f, 0xb7, 0xe8, 0x27, 0x31, 0xba,
+	0xd6, 0x26, 0xa4, 0xdd, 0xc1, 0x5b, 0xec, 0xdb, 0x49, 0xef, 0xd9, 0x96, 0x6f, 0xd9, 0xd8, 0xf3,
+	0x0d, 0xbb, 0x2b, 0x00, 0xb7, 0x06, 0x01, 0x86, 0x73, 0x29, 0xa6, 0xf2, 0x83, 0x53, 0x66, 0xcf,
+	0x35, 0x7c, 0x8b, 0x04, 0x2b, 0xde, 0xe2, 0x16, 0xe9, 0x7c, 0x51, 0x61, 0x2d, 0x9f, 0x9a, 0x33,
+	0x6c, 0xcb, 0x21, 0x5b, 0xec, 0x5f, 0x3e, 0x54, 0x20, 0x80, 0x1e, 0x63, 0xab, 0x7d, 0xea, 0x63,
+	0xf3, 0x98, 0xf8, 0xb8, 0xd6, 0xa5, 0x4a, 0x68, 0x07, 0x26, 0x09, 0xfb, 0xa4, 0x48, 0xeb, 0xd2,
+	0x46, 0xee, 0xee, 0xad, 0x62, 0x6c, 0xd7, 0xc5, 0x10, 0xaa, 0x09, 0x20, 0xfa, 0x10, 0x26, 0xcf,
+	0x99, 0x90, 0x92, 0x58, 0x97, 0x36, 0xd2, 0xe5, 0xdc, 0xcb, 0x17, 0x9b, 0x20, 0x58, 0x15, 0xdc,
+	0xd2, 0xc4, 0x6c, 0xe1, 0xb7, 0x12, 0x4c, 0x55, 0x70, 0x97, 0x78, 0x96, 0x8f, 0xd6, 0x60, 0xa6,
+	0xeb, 0x92, 0x2e, 0xf1, 0x8c, 0x8e, 0x6e, 0x99, 0x6c, 0xad, 0x94, 0x06, 0xc1, 0x50, 0xd5, 0x44,
+	0xdf, 0x87, 0xb4, 0xc9, 0xb1, 0xc4, 0x15, 0xba, 0xca, 0xcb, 0x17, 0x9b, 0x0b, 0x42, 0xb7, 0x64,
+	0x9a, 0x2e, 0xf6, 0xbc, 0x86, 0xef, 0x5a, 0x4e, 0x5b, 0x0b, 0xa1, 0xe8, 0x33, 0x98, 0x34, 0x6c,
+	0xd2, 0x73, 0x7c, 0x25, 0xb9, 0x9e, 0xdc, 0x98, 0x09, 0xed, 0xa7, 0x61, 0x2a, 0x8a, 0x30, 0x15,
+	0xf7, 0x88, 0xe5, 0x94, 0xd3, 0x5f, 0xbf, 0x5e, 0xbb, 0xf1, 0xd5, 0xbf, 0xfe, 0x70, 0x47, 0xd2,
+	0x04, 0xa7, 0xf0, 0xe7, 0x29, 0x98, 0xae, 0x0b, 0x23, 0x50, 0x0e, 0x12, 0x7d, 0xd3, 0x12, 0x96,
+	0x89, 0xb6, 0x61, 0xda, 0xc6, 0x9e, 0x67, 0xb4, 0xb1, 0xa7, 0x24, 0x98, 0xf8, 0x42, 0x91, 0x47,
+	0xa4, 0x18, 0x44, 0xa4, 0x58, 0x72, 0x2e, 0xb5, 0x3e, 0x0a, 0xed, 0xc2, 0xa4, 0xe7, 0x1b, 0x7e,
+	0xcf, 0x53, 0x92, 0xcc, 0x99, 0xab, 0x03, 0xce, 0x0c, 0x96, 0x6a, 0x30, 0x90, 0x26, 0xc0, 0x68,
+	0x1f, 0xd0, 0x33, 0xcb, 0x31, 0x3a, 0xba, 0x6f, 0x74, 0x3a, 0x97, 0xba, 0x8b, 0xbd, 0x5e, 0xc7,
+	0x57, 0x52, 0xeb, 0xd2, 0xc6, 0xcc, 0xdd, 0xe5, 0x01, 0x89, 0x26, 0x85, 0x68, 0x0c, 0xa1, 0xc9,
+	0x8c, 0x15, 0x19, 0x41, 0x25, 0x98, 0xf1, 0x7a, 0x27, 0xb6, 0xe5, 0xeb, 0x34, 0xcd, 0x94, 0x09,
+	0x21, 0x31, 0x68, 0x75, 0x33, 0xc8, 0xc1, 0x72, 0xea, 0xcb, 0x7f, 0xac, 0x49, 0x1a, 0x70, 0x12,
+	0x1d, 0x46, 0x0f, 0x41, 0x16, 0xde, 0xd5, 0xb1, 0x63, 0x72, 0x9d, 0xc9, 0x31, 0x75, 0x72, 0x82,
+	0xa9, 0x3a, 0x26, 0xd3, 0xaa, 0x42, 0xd6, 0x27, 0xbe, 0xd1, 0xd1, 0xc5, 0xb8, 0x32, 0xf5, 0x1e,
+	0x31, 0xca, 0x30, 0x6a, 0x90, 0x40, 0x07, 0x30, 0x77, 0x46, 0x7c, 0xcb, 0x69, 0xeb, 0x9e, 0x6f,
+	0xb8, 0x62, 0x7f, 0xd3, 0x63, 0xda, 0x35, 0xcb, 0xa9, 0x0d, 0xca, 0x64, 0x86, 0xed, 0x83, 0x18,
+	0x0a, 0xf7, 0x98, 0x1e, 0x53, 0x2b, 0xcb, 0x89, 0xc1, 0x16, 0x97, 0x69, 0x92, 0xf8, 0x86, 0x69,
+	0xf8, 0x86, 0x02, 0x34, 0x6d, 0xb5, 0xfe, 0x77, 0xf4, 0xff, 0x30, 0xe1, 0x5b, 0x7e, 0x07, 0x2b,
+	0x33, 0x2c, 0x9f, 0xe7, 0x5f, 0xbd, 0xd8, 0x9c, 0xe5, 0x3b, 0xdf, 0xf4, 0xcc, 0xe7, 0xeb, 0xdb,
+	0xc5, 0xef, 0xfd, 0x40, 0xe3, 0x08, 0xb4, 0x09, 0x53, 0x5e, 0xcf, 0xb6, 0x0d, 0xf7, 0x52, 0xc9,
+	0x5c, 0x0d, 0x0e, 0x30, 0xe8, 0x01, 0x4c, 0xf3, 0xb3, 0x83, 0x5d, 0x25, 0xcb, 0xf0, 0x1f, 0x5d,
+	0x75, 0x58, 0x46, 0xe9, 0xf4, 0xc9, 0xe8, 0x13, 0x48, 0xe3, 0x8b, 0x2e, 0x36, 0x2d, 0x1f, 0x9b,
+	0x4a, 0x6e, 0x5d, 0xda, 0x98, 0x2e, 0x2f, 0x0e, 0x31, 0x76, 0xb7, 0x15, 0x49, 0x0b, 0x71, 0xe8,
+	0x53, 0xc8, 0x3e, 0x33, 0xac, 0x0e, 0x36, 0x75, 0x17, 0x1b, 0x1e, 0x71, 0x94, 0xd9, 0x2b, 0x4c,
+	0xde, 0xdd, 0xd6, 0x32, 0x1c, 0xa9, 0x31, 0x20, 0xd2, 0x20, 0xdb, 0x2f, 0x03, 0xfe, 0x65, 0x17,
+	0x2b, 0x32, 0x3b, 0x27, 0x2b, 0x57, 0x9c, 0x93, 0xe6, 0x65, 0x17, 0x97, 0xe5, 0x57, 0x2f, 0x36,
+	0x33, 0x17, 0xb4, 0x2e, 0xaf, 0x9f, 0x6d, 0x17, 0xef, 0x16, 0xb7, 0xb5, 0x4c, 0x37, 0x32, 0x5f,
+	0xf8, 0x8b, 0x04, 0xf3, 0x01, 0x21, 0xac, 0x56, 0x1e, 0x5a, 0x05, 0xe0, 0x05, 0x4b, 0x27, 0x0e,
+	0x66, 0xc7, 0x3a, 0xad, 0xa5, 0xf9, 0x48, 0xcd, 0xc1, 0x91, 0x69, 0xff, 0x9c, 0x28, 0x89, 0xe8,
+	0x74, 0xf3, 0x9c, 0xa0, 0xdb, 0x90, 0x09, 0xa6, 0x4f, 0x5d, 0x8c, 0xd9, 0x81, 0x4e, 0x6b, 0x33,
+	0x02, 0x40, 0x87, 0x68, 0x4d, 0x13, 0x90, 0x67, 0xa4, 0xe7, 0xb2, 0xf3, 0x9a, 0xd6, 0x84, 0xe8,
+	0x7d, 0xd2, 0x73, 0x23, 0x00, 0xaf, 0x6b, 0xd8, 0xca, 0x44, 0x14, 0xd0, 0xe8, 0x1a, 0xf6, 0x3d,
+	0xf9, 0xe5, 0xc0, 0xd6, 0x0a, 0xff, 0x49, 0xc2, 0x4c, 0xf4, 0x40, 0x6f, 0x42, 0xfa, 0x12, 0x7b,
+	0x7a, 0x8b, 0x55, 0x38, 0xb6, 0x87, 0xb2, 0x1c, 0x29, 0xb7, 0x55, 0x3a, 0xaa, 0x4d, 0x5f, 0x62,
+	0x6f, 0x8f, 0x22, 0xd0, 0x2e, 0x64, 0x8d, 0x13, 0xcf, 0x37, 0x2c, 0x47, 0x50, 0x12, 0x57, 0x50,
+	0x32, 0x02, 0xc6, 0x69, 0x1f, 0xc1, 0xb4, 0x43, 0x04, 0x23, 0x79, 0x05, 0x63, 0xca, 0x21, 0x1c,
+	0xfc, 0x39, 0x20, 0x87, 0xe8, 0xe7, 0x96, 0x7f, 0xaa, 0x9f, 0x61, 0x3f, 0xa0, 0xa5, 0xae, 0xa0,
+	0xcd, 0x3a, 0xe4, 0xb1, 0xe5, 0x9f, 0x1e, 0x63, 0x5f, 0xd0, 0x3f, 0x05, 0x39, 0x0c, 0x8b, 0x20,
+	0x4f, 0x0c, 0xdd, 0x23, 0x55, 0xc7, 0xd7, 0x72, 0xfd, 0x60, 0x0d, 0x32, 0xfd, 0xf3, 0x60, 0xd9,
+	0xc9, 0x77, 0x31, 0x9b, 0xe7, 0x62, 0xcd, 0xcf, 0x00, 0x45, 0x83, 0x29, 0xb8, 0x53, 0x23, 0xb9,
+	0x72, 0x24, 0xc4, 0x9c, 0x7d, 0x0f, 0xe6, 0x22, 0x71, 0x16, 0xe4, 0xe9, 0x91, 0xe4, 0xd9, 0x30,
+	0xfa, 0x9c, 0xbb, 0x09, 0x40, 0x63, 0x2f, 0x48, 0xe9, 0x91, 0xa4, 0x34, 0x45, 0x30, 0x78, 0xe1,
+	0x4f, 0x12, 0xa4, 0x68, 0x0e, 0x5f, 0x7f, 0x5f, 0x16, 0x61, 0xe2, 0x8c, 0xf8, 0xf8, 0xfa, 0xbb,
+	0x92, 0xc3, 0xd0, 0x8f, 0x60, 0x8a, 0xdb, 0xe6, 0x29, 0x29, 0x56, 0x84, 0x6f, 0x0f, 0x9c, 0xb9,
+	0xe1, 0xde, 0x40, 0x0b, 0x18, 0xb1, 0x22, 0x37, 0x11, 0x2f, 0x72, 0x0f, 0x53, 0xd3, 0x49, 0x39,
+	0x55, 0xf8, 0xbb, 0x04, 0x59, 0x51, 0xaa, 0xeb, 0x86, 0x6b, 0xd8, 0x1e, 0x7a, 0x0a, 0x33, 0xb6,
+	0xe5, 0xf4, 0x2b, 0xbf, 0x74, 0x5d, 0xe5, 0x5f, 0xa5, 0x95, 0xff, 0xdb, 0xd7, 0x6b, 0x8b, 0x11,
+	0xd6, 0xc7, 0xc4, 0xb6, 0x7c, 0x6c, 0x77, 0xfd, 0x4b, 0x0d, 0x6c, 0xcb, 0x09, 0xee, 0x02, 0x1b,
+	0x90, 0x6d, 0x5c, 0x04, 0x20, 0xbd, 0x8b, 0x5d, 0x8b, 0x98, 0xcc, 0x11, 0x74, 0x85, 0xc1, 0x02,
+	0x5e, 0x11, 0x4d, 0x53, 0xf9, 0xff, 0xbe, 0x7d, 0xbd, 0xf6, 0xc1, 0x30, 0x31, 0x5c, 0xe4, 0xd7,
+	0xb4, 0xbe, 0xcb, 0xb6, 0x71, 0x11, 0xec, 0x84, 0xcd, 0xdf, 0x4b, 0x28, 0x52, 0xe1, 0x09, 0x64,
+	0x8e, 0x59, 0xdd, 0x17, 0xbb, 0xab, 0x80, 0xb8, 0x07, 0x82, 0xd5, 0xa5, 0xeb, 0x56, 0x4f, 0x31,
+	0xf5, 0x0c, 0x67, 0x45, 0x94, 0x7f, 0x23, 0x89, 0x13, 0x2f, 0x94, 0x3f, 0x84, 0xc9, 0x9f, 0xf7,
+	0x88, 0xdb, 0xb3, 0x15, 0x69, 0x28, 0x5b, 0x58, 0x77, 0xc5, 0x67, 0xd1, 0xc7, 0x90, 0xa6, 0xc9,
+	0xec, 0x9d, 0x92, 0x8e, 0x79, 0x45, 0x23, 0x16, 0x02, 0xd0, 0x2e, 0xe4, 0xd8, 0x61, 0x0d, 0x29,
+	0xc9, 0x91, 0x94, 0x2c, 0x45, 0x35, 0x03, 0x10, 0x33, 0xf0, 0xf7, 0x39, 0x98, 0x14, 0xb6, 0xa9,
+	0xef, 0x19, 0xd3, 0xc8, 0x6d, 0x1e, 0x8d, 0xdf, 0xe1, 0x77, 0x8b, 0x5f, 0x6a, 0x74, 0x7c, 0x86,
+	0x63, 0x91, 0xfc, 0x0e, 0xb1, 0x88, 0xf8, 0x3d, 0x35, 0xbe, 0xdf, 0x27, 0xde, 0xdf, 0xef, 0x93,
+	0x63, 0xf8, 0x1d, 0x55, 0xe1, 0x16, 0x75, 0xb4, 0xe5, 0x58, 0xbe, 0x15, 0xb6, 0x4f, 0x3a, 0x33,
+	0x5f, 0x99, 0x1a, 0xa9, 0x70, 0xd3, 0xb6, 0x9c, 0x2a, 0xc7, 0x0b, 0xf7, 0x68, 0x14, 0x8d, 0x1e,
+	0xc1, 0x62, 0xbf, 0x92, 0xb4, 0x0c, 0xa7, 0x85, 0x3b, 0x42, 0x86, 0x57, 0xb0, 0xdb, 0x71, 0x99,
+	0x51, 0x57, 0xf8, 0x7c, 0xc0, 0xdf, 0x63, 0x74, 0x2e, 0xfb, 0x33, 0x58, 0x18, 0x94, 0x35, 0xb1,
+	0x17, 0x94, 0xb8, 0xf1, 0xbb, 0x91, 0xdd, 0x6d, 0x0d, 0xc5, 0xf5, 0x2b, 0xd8, 0xf3, 0xd1, 0x17,
+	0xb0, 0xd4, 0xef, 0x37, 0xf4, 0x78, 0x74, 0xe1, 0xba, 0xe8, 0x2e, 0xd1, 0xe8, 0x8e, 0x5a, 0x68,
+	0xb1, 0x2f, 0x79, 0x1c, 0x8d, 0xbc, 0x06, 0xf3, 0xe1, 0x5a, 0x61, 0xa0, 0x66, 0xc6, 0xf5, 0x0f,
+	0xea, 0xb3, 0xc3, 0x00, 0x3e, 0x81, 0x70, 0x31, 0x3d, 0x7a, 0x66, 0x32, 0xef, 0x71, 0x66, 0x42,
+	0xb3, 0x0e, 0xc3, 0xc3, 0xf3, 0x39, 0xc8, 0x27, 0x3d, 0xd7, 0xa1, 0x4e, 0xc1, 0xba, 0xc8, 0xd8,
+	0x2c, 0x6b, 0xdc, 0x46, 0xb6, 0x8c, 0x39, 0x0a, 0xa6, 0x35, 0xfd, 0xc7, 0x3c, 0x7d, 0x8f, 0x61,
+	0x95, 0xd1, 0xfb, 0xc1, 0xeb, 0x9f, 0x42, 0x17, 0x53, 0x49, 0x25, 0x77, 0xb5, 0xd6, 0x32, 0x65,
+	0x06, 0xad, 0x56, 0x70, 0x06, 0x39, 0x0d, 0xfd, 0x10, 0x72, 0xa1, 0x59, 0x34, 0x99, 0x95, 0xd9,
+	0xab, 0x85, 0x32, 0x81, 0x51, 0xb4, 0x2d, 0x40, 0x87, 0x30, 0x17, 0xf1, 0x90, 0xc8, 0x4e, 0x79,
+	0x5c, 0xef, 0xcf, 0x86, 0x85, 0x85, 0x67, 0xe6, 0x4f, 0x61, 0x79, 0x30, 0x33, 0x69, 0xb5, 0x11,
+	0xd9, 0x33, 0xc7, 0x74, 0xf3, 0x43, 0xba, 0xf1, 0x0e, 0x73, 0x29, 0x9e, 0x92, 0x87, 0xc6, 0x85,
+	0xc8, 0x95, 0x2e, 0xac, 0xd1, 0x4b, 0xd1, 0xb6, 0x3c, 0xdf, 0x6a, 0xe9, 0x46, 0xcf, 0x3f, 0x25,
+	0xae, 0xf5, 0x0b, 0x6c, 0xea, 0x06, 0xcf, 0x72, 0xec, 0x29, 0x68, 0x3d, 0xb9, 0x91, 0x2e, 0x6f,
+	0xbc, 0xe3, 0x04, 0xc4, 0xd7, 0x5a, 0x0d, 0x05, 0x4b, 0x7d, 0xbd, 0x52, 0x20, 0x87, 0x4e, 0x20,
+	0x02, 0xd0, 0x5d, 0xfc, 0x05, 0x6e, 0xc5, 0xf3, 0x74, 0x7e, 0xac, 0x1d, 0xad, 0x84, 0x22, 0x9a,
+	0xd0, 0x08, 0xb3, 0xf5, 0x73, 0x00, 0xda, 0x65, 0x8a, 0x6c, 0x5a, 0x18, 0x4b, 0x90, 0xf6, 0xa5,
+	0x22, 0xa7, 0xaa, 0x20, 0x87, 0xc9, 0x2e, 0x44, 0x16, 0xaf, 0x11, 0xd9, 0x29, 0x6e, 0x17, 0xb7,
+	0xb5, 0xd9, 0x3e, 0x4f, 0x48, 0xdd, 0x87, 0x9b, 0xfd, 0xe0, 0xe1, 0x0b, 0xdc, 0xea, 0xb1, 0xbe,
+	0xab, 0x6d, 0x78, 0xca, 0x4d, 0xda, 0x02, 0x8d, 0xf8, 0x31, 0xd0, 0x2f, 0x43, 0x6a, 0x00, 0x7f,
+	0x60, 0x78, 0xc8, 0x84, 0x9b, 0x2d, 0xe2, 0x9c, 0x59, 0x2d, 0xc6, 0xef, 0x90, 0xd6, 0xf3, 0x20,
+	0x01, 0x96, 0xae, 0x2b, 0x1f, 0xf3, 0xa2, 0x7c, 0xcc, 0xf0, 0x65, 0xb8, 0xa1, 0x0b, 0xa1, 0xda,
+	0x01, 0x69, 0x3d, 0x17, 0xf7, 0xf7, 0xfc, 0xcb, 0xe1, 0xe4, 0x2e, 0x7c, 0x95, 0x00, 0x74, 0xc8,
+	0x5f, 0x04, 0xca, 0x86, 0x87, 0xcd, 0xff, 0x65, 0xc7, 0x10, 0xb9, 0xa5, 0x12, 0xef, 0xbc, 0xa5,
+	0x36, 0x47, 0x44, 0x74, 0xe8, 0x9a, 0x0a, 0x23, 0x18, 0xbb, 0xd4, 0x92, 0xef, 0x7f, 0xa9, 0xa5,
+	0xc6, 0x69, 0x26, 0x86, 0x7f, 0xed, 0xfc, 0x2a, 0x01, 0xb9, 0xbd, 0x98, 0x63, 0xc3, 0xbe, 0x56,
+	0x1a, 0xaf, 0xaf, 0xdd, 0xef, 0xbf, 0xff, 0x70, 0x87, 0x6c, 0xd3, 0xf2, 0xf9, 0xea, 0xf5, 0xda,
+	0x22, 0x27, 0x79, 0xe6, 0xf3, 0xa2, 0x45, 0xb6, 0x6c, 0xc3, 0x3f, 0xa5, 0xbd, 0x76, 0xbc, 0xf3,
+	0x8e, 0xbd, 0x05, 0xa1, 0x3c, 0x40, 0x18, 0x64, 0xe6, 0x84, 0xac, 0x16, 0x19, 0x41, 0x0f, 0x61,
+	0xa6, 0xe7, 0xb0, 0x4c, 0x62, 0xef, 0x05, 0xa9, 0x6b, 0xdf, 0x0b, 0xb2, 0xd4, 0x14, 0xfa, 0x66,
+	0xc0, 0xd7, 0x01, 0xce, 0xa6, 0xf3, 0xf7, 0x66, 0x5f, 0xc6, 0xf3, 0xab, 0xf0, 0x4b, 0x09, 0xa6,
+	0x1f, 0x90, 0x33, 0xec, 0x3a, 0xc4, 0x45, 0x77, 0x61, 0x4a, 0x94, 0x93, 0x6b, 0xbd, 0x10, 0x00,
+	0x91, 0x02, 0x53, 0x36, 0x71, 0xac, 0xe7, 0xc1, 0x2f, 0x02, 0x2d, 0xf8, 0x4a, 0x67, 0x4c, 0xec,
+	0x1b, 0x56, 0xc7, 0x13, 0x3f, 0x62, 0x83, 0xaf, 0xc3, 0x56, 0xfc, 0x51, 0x82, 0x05, 0x6e, 0x05,
+	0x2d, 0x7b, 0x15, 0xdc, 0xc1, 0x6d, 0x96, 0x8a, 0x48, 0x85, 0x39, 0x93, 0x7f, 0x23, 0xae, 0x3e,
+	0xae, 0x6d, 0x72, 0x9f, 0x22, 0xc6, 0xd1, 0x1e, 0xc8, 0x6d, 0xb1, 0xc9, 0xbe, 0xca, 0x75, 0xbf,
+	0x5f, 0x66, 0x03, 0x86, 0x18, 0x1e, 0xb2, 0xfa, 0xce, 0xef, 0x24, 0xc8, 0x44, 0x5f, 0x0c, 0xd0,
+	0x2a, 0xdc, 0xaa, 0x6b, 0xb5, 0x7a, 0xad, 0x51, 0x3a, 0xd0, 0x9b, 0x4f, 0xeb, 0xaa, 0xfe, 0xe8,
+	0xa8, 0x51, 0x57, 0xf7, 0xaa, 0xf7, 0xab, 0x6a, 0x45, 0xbe, 0x81, 0x96, 0xe1, 0x66, 0x7c, 0xba,
+	0xd1, 0x2c, 0x1d, 0x55, 0x4a, 0x5a, 0x45, 0x96, 0xd0, 0x6d, 0x58, 0x8d, 0xcf, 0x1d, 0x3e, 0x3a,
+	0x68, 0x56, 0xeb, 0x07, 0xaa, 0xbe, 0xb7, 0x5f, 0xab, 0xee, 0xa9, 0x72, 0x02, 0x7d, 0x00, 0x4a,
+	0x1c, 0x52, 0xab, 0x37, 0xab, 0x87, 0xd5, 0x46, 0xb3, 0xba, 0x27, 0x27, 0xd1, 0x0a, 0x2c, 0xc5,
+	0x67, 0xd5, 0x27, 0x75, 0xb5, 0x52, 0x6d, 0xaa, 0x15, 0x39, 0x75, 0xe7, 0xdf, 0x12, 0x40, 0xe4,
+	0xed, 0x75, 0x05, 0x96, 0x8e, 0x6b, 0x4d, 0x2e, 0x50, 0x3b, 0x1a, 0xb0, 0x72, 0x1e, 0x66, 0xa3,
+	0x93, 0x4f, 0xd5, 0x86, 0x2c, 0x0d, 0x0e, 0xd6, 0x8e, 0x54, 0x59, 0x42, 0x4b, 0x30, 0x1f, 0x1d,
+	0x2c, 0x95, 0x1b, 0xcd, 0x52, 0xf5, 0x48, 0x4e, 0x0c, 0xa2, 0x9b, 0x8f, 0x6b, 0x72, 0x02, 0x21,
+	0xc8, 0x45, 0x07, 0x8f, 0x6a, 0x72, 0x12, 0x2d, 0xc2, 0x5c, 0x0c, 0xb8, 0xaf, 0xa9, 0xaa, 0x9c,
+	0xa4, 0x3b, 0x8d, 0x43, 0xf5, 0xc7, 0xd5, 0xe6, 0xbe, 0x7e, 0xac, 0x36, 0x6b, 0x72, 0x0a, 0x2d,
+	0x80, 0x1c, 0x9d, 0xbd, 0x5f, 0x7b, 0xa4, 0x0d, 0x8f, 0x36, 0xea, 0xa5, 0x43, 0x79, 0x62, 0x39,
+	0x21, 0x4b, 0x77, 0xfe, 0x2a, 0x41, 0x2e, 0xfe, 0x00, 0x8a, 0xd6, 0x60, 0xa5, 0xef, 0xac, 0x46,
+	0xb3, 0xd4, 0x7c, 0xd4, 0x18, 0x70, 0x42, 0x01, 0xf2, 0x83, 0x80, 0x8a, 0x5a, 0xaf, 0x35, 0xaa,
+	0x4d, 0xbd, 0xae, 0x6a, 0xd5, 0xda, 0x60, 0xc8, 0x04, 0xe6, 0xb8, 0xd6, 0xac, 0x1e, 0x3d, 0x08,
+	0x20, 0x89, 0x58, 0xc4, 0x05, 0xa4, 0x5e, 0x6a, 0x34, 0xd4, 0x0a, 0xdf, 0xe4, 0xe0, 0x9c, 0xa6,
+	0x3e, 0x54, 0xf7, 0x58, 0xc4, 0x46, 0x31, 0xef, 0x97, 0xaa, 0x07, 0x6a, 0x45, 0x9e, 0x28, 0xef,
+	0x7e, 0xfd, 0x26, 0x2f, 0x7d, 0xf3, 0x26, 0x2f, 0xfd, 0xf3, 0x4d, 0x5e, 0xfa, 0xf2, 0x6d, 0xfe,
+	0xc6, 0x37, 0x6f, 0xf3, 0x37, 0xfe, 0xf6, 0x36, 0x7f, 0xe3, 0x27, 0x2b, 0xb1, 0xe2, 0xc3, 0x72,
+	0x75, 0x8b, 0x3e, 0x77, 0x79, 0xf4, 0xef, 0x06, 0x93, 0xac, 0x54, 0x7c, 0xf2, 0xdf, 0x01, 0x00,
+	0x79, 0xed, 0x72, 0xbe, 0x78, 0x18, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -2021,6 +2237,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ConvictionLockPeriod != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ConvictionLockPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ConvictionLockPeriod):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
 	if m.ProposalExecutionGas != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.ProposalExecutionGas))
 		i--
@@ -2136,12 +2364,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 	}
 	if m.ExpeditedVotingPeriod != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
-		if err8 != nil {
-			return 0, err8
+		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -2188,22 +2416,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if m.VotingPeriod != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err9 != nil {
-			return 0, err9
+		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.MaxDepositPeriod != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err10 != nil {
-			return 0, err10
+		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err11 != nil {
+			return 0, err11
 		}
-		i -= n10
-		i = encodeVarintGov(dAtA, i, uint64(n10))
+		i -= n11
+		i = encodeVarintGov(dAtA, i, uint64(n11))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2275,12 +2503,146 @@ func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if m.VotingPeriod != nil {
-		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err11 != nil {
-			return 0, err11
+		n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err12 != nil {
+			return 0, err12
 		}
-		i -= n11
-		i = encodeVarintGov(dAtA, i, uint64(n11))
+		i -= n12
+		i = encodeVarintGov(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConvictionLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConvictionLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConvictionLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintGov(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x22
+	if m.Conviction != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Conviction))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Governor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Governor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Governor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Details) > 0 {
+		i -= len(m.Details)
+		copy(dAtA[i:], m.Details)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Details)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GovernanceDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernanceDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernanceDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GovernorAddress) > 0 {
+		i -= len(m.GovernorAddress)
+		copy(dAtA[i:], m.GovernorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.GovernorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.DelegatorAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2658,6 +3020,10 @@ func (m *Params) Size() (n int) {
 	if m.ProposalExecutionGas != 0 {
 		n += 2 + sovGov(uint64(m.ProposalExecutionGas))
 	}
+	if m.ConvictionLockPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ConvictionLockPeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -2690,13 +3056,71 @@ func (m *MessageBasedParams) Size() (n int) {
 	return n
 }
 
-func sovGov(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGov(x uint64) (n int) {
-	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *ConvictionLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGov(uint64(l))
+	if m.Conviction != 0 {
+		n += 1 + sovGov(uint64(m.Conviction))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockTime)
+	n += 1 + l + sovGov(uint64(l))
+	return n
 }
-func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+
+func (m *Governor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *GovernanceDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.GovernorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5234,6 +5658,42 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvictionLockPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConvictionLockPeriod == nil {
+				m.ConvictionLockPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ConvictionLockPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -5469,6 +5929,434 @@ func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ConvictionLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConvictionLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConvictionLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conviction", wireType)
+			}
+			m.Conviction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Conviction |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Governor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Governor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Governor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernanceDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernanceDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernanceDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,6 +6,7 @@ import (
 
 	gogoprotoany "github.com/cosmos/gogoproto/types/any"
 
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/gov/types/v1beta1"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -14,8 +15,9 @@ import (
 )
 
 var (
-	_, _, _, _, _, _, _, _ sdk.Msg                              = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}, &MsgSubmitMultipleChoiceProposal{}
-	_, _                   gogoprotoany.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
+	_, _, _, _, _, _, _, _, _ sdk.Msg                              = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}, &MsgSubmitMultipleChoiceProposal{}, &MsgLockConviction{}
+	_, _, _, _                sdk.Msg                              = &MsgCreateGovernor{}, &MsgEditGovernor{}, &MsgDelegateGovernor{}, &MsgUndelegateGovernor{}
+	_, _                      gogoprotoany.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
@@ -135,6 +137,46 @@ func NewMsgCancelProposal(proposalID uint64, proposer string) *MsgCancelProposal
 	}
 }
 
+// NewMsgLockConviction creates a new MsgLockConviction instance.
+func NewMsgLockConviction(voter string, amount sdkmath.Int, conviction uint32) *MsgLockConviction {
+	return &MsgLockConviction{
+		Voter:      voter,
+		Amount:     amount,
+		Conviction: conviction,
+	}
+}
+
+// NewMsgCreateGovernor creates a new MsgCreateGovernor instance.
+func NewMsgCreateGovernor(address, moniker, details string) *MsgCreateGovernor {
+	return &MsgCreateGovernor{
+		Address: address,
+		Moniker: moniker,
+		Details: details,
+	}
+}
+
+// NewMsgEditGovernor creates a new MsgEditGovernor instance.
+func NewMsgEditGovernor(address, moniker, details string) *MsgEditGovernor {
+	return &MsgEditGovernor{
+		Address: address,
+		Moniker: moniker,
+		Details: details,
+	}
+}
+
+// NewMsgDelegateGovernor creates a new MsgDelegateGovernor instance.
+func NewMsgDelegateGovernor(delegator, governor string) *MsgDelegateGovernor {
+	return &MsgDelegateGovernor{
+		DelegatorAddress: delegator,
+		GovernorAddress:  governor,
+	}
+}
+
+// NewMsgUndelegateGovernor creates a new MsgUndelegateGovernor instance.
+func NewMsgUndelegateGovernor(delegator string) *MsgUndelegateGovernor {
+	return &MsgUndelegateGovernor{DelegatorAddress: delegator}
+}
+
 // GetSudoedMsg returns the cache values from the MsgSudoExec.Msg if present.
 func (msg *MsgSudoExec) GetSudoedMsg() (sdk.Msg, error) {
 	if msg.Msg == nil {
@@ -16,6 +16,9 @@ const (
 	DefaultPeriod                         time.Duration = time.Hour * 24 * 2 // 2 days
 	DefaultExpeditedPeriod                time.Duration = time.Hour * 24 * 1 // 1 day
 	DefaultMinExpeditedDepositTokensRatio               = 5
+
+	// MaxConviction is the maximum voting power multiplier of a conviction lock
+	MaxConviction uint32 = 6
 )
 
 // Default governance params
@@ -275,9 +278,19 @@ func (p Params) ValidateBasic(addressCodec address.Codec) error {
 		return fmt.Errorf("proposal execution gas must be positive: %d", p.ProposalExecutionGas)
 	}
 
+	if p.ConvictionLockPeriod != nil && *p.ConvictionLockPeriod < 0 {
+		return fmt.Errorf("conviction lock period must not be negative: %s", p.ConvictionLockPeriod)
+	}
+
 	return nil
 }
 
+// ConvictionVotingEnabled returns whether voters can lock their delegated tokens
+// to multiply their voting power.
+func (p Params) ConvictionVotingEnabled() bool {
+	return p.ConvictionLockPeriod != nil && *p.ConvictionLockPeriod > 0
+}
+
 // ValidateBasic performs basic validation on governance parameters.
 func (p MessageBasedParams) ValidateBasic() error {
 	if p.VotingPeriod == nil {
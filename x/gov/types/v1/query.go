@@ -0,0 +1,30 @@
+package v1
+
+import (
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var _ gogoprotoany.UnpackInterfacesMessage = &QuerySimulateProposalRequest{}
+
+// NewQuerySimulateProposalRequest creates a new QuerySimulateProposalRequest.
+func NewQuerySimulateProposalRequest(messages []sdk.Msg) (*QuerySimulateProposalRequest, error) {
+	anys, err := sdktx.SetMsgs(messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QuerySimulateProposalRequest{Messages: anys}, nil
+}
+
+// GetMsgs unpacks r.Messages Any's into sdk.Msg's
+func (r *QuerySimulateProposalRequest) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(r.Messages, "sdk.MsgProposal")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r QuerySimulateProposalRequest) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, r.Messages)
+}
@@ -141,6 +141,51 @@ func (mr *MockBankKeeperMockRecorder) Burn(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Burn", reflect.TypeOf((*MockBankKeeper)(nil).Burn), arg0, arg1)
 }
 
+// BurnDenom mocks base method.
+func (m *MockBankKeeper) BurnDenom(arg0 context.Context, arg1 *types.MsgBurnDenom) (*types.MsgBurnDenomResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BurnDenom", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgBurnDenomResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BurnDenom indicates an expected call of BurnDenom.
+func (mr *MockBankKeeperMockRecorder) BurnDenom(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnDenom", reflect.TypeOf((*MockBankKeeper)(nil).BurnDenom), arg0, arg1)
+}
+
+// ChangeDenomAdmin mocks base method.
+func (m *MockBankKeeper) ChangeDenomAdmin(arg0 context.Context, arg1 *types.MsgChangeDenomAdmin) (*types.MsgChangeDenomAdminResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeDenomAdmin", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgChangeDenomAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeDenomAdmin indicates an expected call of ChangeDenomAdmin.
+func (mr *MockBankKeeperMockRecorder) ChangeDenomAdmin(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeDenomAdmin", reflect.TypeOf((*MockBankKeeper)(nil).ChangeDenomAdmin), arg0, arg1)
+}
+
+// CreateDenom mocks base method.
+func (m *MockBankKeeper) CreateDenom(arg0 context.Context, arg1 *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDenom", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgCreateDenomResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDenom indicates an expected call of CreateDenom.
+func (mr *MockBankKeeperMockRecorder) CreateDenom(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDenom", reflect.TypeOf((*MockBankKeeper)(nil).CreateDenom), arg0, arg1)
+}
+
 // GetAllBalances mocks base method.
 func (m *MockBankKeeper) GetAllBalances(ctx context.Context, addr types0.AccAddress) types0.Coins {
 	m.ctrl.T.Helper()
@@ -169,6 +214,21 @@ func (mr *MockBankKeeperMockRecorder) MintCoins(ctx, moduleName, amt interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MintCoins", reflect.TypeOf((*MockBankKeeper)(nil).MintCoins), ctx, moduleName, amt)
 }
 
+// MintDenom mocks base method.
+func (m *MockBankKeeper) MintDenom(arg0 context.Context, arg1 *types.MsgMintDenom) (*types.MsgMintDenomResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MintDenom", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgMintDenomResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MintDenom indicates an expected call of MintDenom.
+func (mr *MockBankKeeperMockRecorder) MintDenom(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MintDenom", reflect.TypeOf((*MockBankKeeper)(nil).MintDenom), arg0, arg1)
+}
+
 // MultiSend mocks base method.
 func (m *MockBankKeeper) MultiSend(arg0 context.Context, arg1 *types.MsgMultiSend) (*types.MsgMultiSendResponse, error) {
 	m.ctrl.T.Helper()
@@ -213,6 +273,36 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderMo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
 }
 
+// SetDenomMetadata mocks base method.
+func (m *MockBankKeeper) SetDenomMetadata(arg0 context.Context, arg1 *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDenomMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgSetDenomMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDenomMetadata indicates an expected call of SetDenomMetadata.
+func (mr *MockBankKeeperMockRecorder) SetDenomMetadata(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomMetadata", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomMetadata), arg0, arg1)
+}
+
+// SetDenomSendHook mocks base method.
+func (m *MockBankKeeper) SetDenomSendHook(arg0 context.Context, arg1 *types.MsgSetDenomSendHook) (*types.MsgSetDenomSendHookResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDenomSendHook", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgSetDenomSendHookResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDenomSendHook indicates an expected call of SetDenomSendHook.
+func (mr *MockBankKeeperMockRecorder) SetDenomSendHook(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomSendHook", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomSendHook), arg0, arg1)
+}
+
 // SetSendEnabled mocks base method.
 func (m *MockBankKeeper) SetSendEnabled(arg0 context.Context, arg1 *types.MsgSetSendEnabled) (*types.MsgSetSendEnabledResponse, error) {
 	m.ctrl.T.Helper()
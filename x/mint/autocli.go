@@ -29,6 +29,13 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "annual-provisions",
 					Short:     "Query the current minting annual provisions value",
 				},
+				{
+					RpcMethod:      "SupplyProjection",
+					Use:            "supply-projection <years>",
+					Short:          "Query the projected total supply at the end of each of the next years",
+					Example:        fmt.Sprintf(`%s query mint supply-projection 10`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "years"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"cosmossdk.io/x/mint/types"
 )
 
@@ -45,3 +48,39 @@ func (q queryServer) AnnualProvisions(ctx context.Context, _ *types.QueryAnnualP
 
 	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
 }
+
+// SupplyProjection returns the projected total supply at the end of each of the next years.
+func (q queryServer) SupplyProjection(ctx context.Context, req *types.QuerySupplyProjectionRequest) (*types.QuerySupplyProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Years == 0 || req.Years > types.MaxProjectionYears {
+		return nil, status.Errorf(codes.InvalidArgument, "years must be between 1 and %d", types.MaxProjectionYears)
+	}
+
+	minter, err := q.k.Minter.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	totalSupply, err := q.k.StakingTokenSupply(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bondedRatio, err := q.k.BondedRatio(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	height := uint64(q.k.HeaderService.HeaderInfo(ctx).Height)
+	projections := types.ProjectSupply(minter, params, height, totalSupply, bondedRatio, req.Years)
+
+	return &types.QuerySupplyProjectionResponse{Projections: projections}, nil
+}
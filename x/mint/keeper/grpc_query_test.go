@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/mint"
 	"cosmossdk.io/x/mint/keeper"
@@ -26,9 +27,10 @@ import (
 type MintTestSuite struct {
 	suite.Suite
 
-	ctx         sdk.Context
-	queryClient types.QueryClient
-	mintKeeper  keeper.Keeper
+	ctx           sdk.Context
+	queryClient   types.QueryClient
+	mintKeeper    keeper.Keeper
+	stakingKeeper *minttestutil.MockStakingKeeper
 }
 
 func (suite *MintTestSuite) SetupTest() {
@@ -55,6 +57,7 @@ func (suite *MintTestSuite) SetupTest() {
 		authtypes.FeeCollectorName,
 		govModuleNameStr,
 	)
+	suite.stakingKeeper = stakingKeeper
 
 	err := suite.mintKeeper.Params.Set(suite.ctx, types.DefaultParams())
 	suite.Require().NoError(err)
@@ -83,6 +86,32 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	suite.Require().Equal(annualProvisions.AnnualProvisions, minter.AnnualProvisions)
 }
 
+func (suite *MintTestSuite) TestGRPCSupplyProjection() {
+	suite.stakingKeeper.EXPECT().StakingTokenSupply(gomock.Any()).Return(math.NewInt(1000), nil).AnyTimes()
+	suite.stakingKeeper.EXPECT().BondedRatio(gomock.Any()).Return(math.LegacyNewDecWithPrec(67, 2), nil).AnyTimes()
+
+	params := types.DefaultParams()
+	params.MintStrategy = types.MintStrategyFixedYearly
+	params.AnnualProvisions = math.LegacyNewDec(100)
+	params.MaxSupply = math.NewInt(1250)
+	suite.Require().NoError(suite.mintKeeper.Params.Set(suite.ctx, params))
+
+	_, err := suite.queryClient.SupplyProjection(gocontext.Background(), &types.QuerySupplyProjectionRequest{})
+	suite.Require().ErrorContains(err, "years must be between 1 and")
+
+	_, err = suite.queryClient.SupplyProjection(gocontext.Background(), &types.QuerySupplyProjectionRequest{Years: types.MaxProjectionYears + 1})
+	suite.Require().ErrorContains(err, "years must be between 1 and")
+
+	res, err := suite.queryClient.SupplyProjection(gocontext.Background(), &types.QuerySupplyProjectionRequest{Years: 4})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SupplyProjection{
+		{Year: 1, TotalSupply: math.NewInt(1100), Minted: math.NewInt(100)},
+		{Year: 2, TotalSupply: math.NewInt(1200), Minted: math.NewInt(100)},
+		{Year: 3, TotalSupply: math.NewInt(1250), Minted: math.NewInt(50)},
+		{Year: 4, TotalSupply: math.NewInt(1250), Minted: math.ZeroInt()},
+	}, res.Projections)
+}
+
 func TestMintTestSuite(t *testing.T) {
 	suite.Run(t, new(MintTestSuite))
 }
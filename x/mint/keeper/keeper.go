@@ -124,8 +124,18 @@ func (k Keeper) DefaultMintFn(ic types.InflationCalculationFn) types.MintFn {
 			return err
 		}
 
-		minter.Inflation = ic(ctx, *minter, params, bondedRatio)
-		minter.AnnualProvisions = minter.NextAnnualProvisions(params, stakingTokenSupply)
+		switch params.MintStrategy {
+		case types.MintStrategyBondedRatio:
+			minter.Inflation = ic(ctx, *minter, params, bondedRatio)
+			minter.AnnualProvisions = minter.NextAnnualProvisions(params, stakingTokenSupply)
+		default:
+			height := uint64(env.HeaderService.HeaderInfo(ctx).Height)
+			minter.AnnualProvisions = params.StrategyAnnualProvisions(minter.StrategyStartHeight, height, stakingTokenSupply)
+			minter.Inflation = math.LegacyZeroDec()
+			if stakingTokenSupply.IsPositive() {
+				minter.Inflation = minter.AnnualProvisions.QuoInt(stakingTokenSupply)
+			}
+		}
 
 		mintedCoin := minter.BlockProvision(params)
 		mintedCoins := sdk.NewCoins(mintedCoin)
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/header"
 	coretesting "cosmossdk.io/core/testing"
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
@@ -138,6 +139,70 @@ func (s *KeeperTestSuite) TestDefaultMintFn() {
 	s.NoError(err)
 }
 
+func (s *KeeperTestSuite) TestDefaultMintFnStrategies() {
+	totalSupply := math.NewInt(100000000000)
+	s.stakingKeeper.EXPECT().StakingTokenSupply(gomock.Any()).Return(totalSupply, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().BondedRatio(gomock.Any()).Return(math.LegacyNewDecWithPrec(15, 2), nil).AnyTimes()
+
+	testCases := []struct {
+		name      string
+		malleate  func(p *types.Params)
+		height    int64
+		expMinted int64
+	}{
+		{
+			name: "halving",
+			malleate: func(p *types.Params) {
+				p.MintStrategy = types.MintStrategyHalving
+				p.AnnualProvisions = math.LegacyNewDec(1_000_000)
+				p.HalvingBlocks = 10
+			},
+			height:    25,
+			expMinted: 250,
+		},
+		{
+			name: "fixed yearly",
+			malleate: func(p *types.Params) {
+				p.MintStrategy = types.MintStrategyFixedYearly
+				p.AnnualProvisions = math.LegacyNewDec(1_000_000)
+			},
+			height:    25,
+			expMinted: 1000,
+		},
+		{
+			name: "exponential decay",
+			malleate: func(p *types.Params) {
+				p.MintStrategy = types.MintStrategyExponentialDecay
+				p.DecayRate = math.LegacyNewDecWithPrec(5, 1)
+				p.MaxSupply = totalSupply.AddRaw(2_000_000)
+			},
+			height:    25,
+			expMinted: 1000,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			params := types.DefaultParams()
+			params.BlocksPerYear = 1000
+			tc.malleate(&params)
+			s.Require().NoError(params.Validate())
+			s.Require().NoError(s.mintKeeper.Params.Set(s.ctx, params))
+
+			minted := sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(tc.expMinted)))
+			s.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, minted).Return(nil)
+			s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, authtypes.FeeCollectorName, minted).Return(nil)
+
+			ctx := s.ctx.WithHeaderInfo(header.Info{Height: tc.height})
+			minter := types.DefaultInitialMinter()
+			err := s.mintKeeper.DefaultMintFn(types.DefaultInflationCalculationFn)(ctx, s.mintKeeper.Environment, &minter, "block", 0)
+			s.Require().NoError(err)
+			s.Require().Equal(math.LegacyNewDec(tc.expMinted*1000), minter.AnnualProvisions)
+			s.Require().Equal(minter.AnnualProvisions.QuoInt(totalSupply), minter.Inflation)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestBeginBlocker() {
 	s.stakingKeeper.EXPECT().StakingTokenSupply(s.ctx).Return(math.NewIntFromUint64(100000000000), nil).AnyTimes()
 	bondedRatio := math.LegacyNewDecWithPrec(15, 2)
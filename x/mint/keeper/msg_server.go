@@ -31,6 +31,27 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams
 		return nil, err
 	}
 
+	params, err := ms.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the halvings of the halving strategy are counted from the height at which it is set or
+	// its params change, so that new params don't apply the halvings already elapsed
+	halvingChanged := msg.Params.MintStrategy == types.MintStrategyHalving &&
+		(!params.AnnualProvisions.Equal(msg.Params.AnnualProvisions) || params.HalvingBlocks != msg.Params.HalvingBlocks)
+	if params.MintStrategy != msg.Params.MintStrategy || halvingChanged {
+		minter, err := ms.Minter.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		minter.StrategyStartHeight = uint64(ms.HeaderService.HeaderInfo(ctx).Height)
+		if err := ms.Minter.Set(ctx, minter); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := ms.Params.Set(ctx, msg.Params); err != nil {
 		return nil, err
 	}
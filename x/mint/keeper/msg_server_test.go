@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"cosmossdk.io/core/header"
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/mint/types"
 
@@ -71,3 +72,41 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUpdateParamsStrategyStartHeight() {
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 100})
+	params := types.DefaultParams()
+	params.MintStrategy = types.MintStrategyHalving
+	params.AnnualProvisions = sdkmath.LegacyNewDec(1000)
+	params.HalvingBlocks = 10
+
+	// the start height is set when the strategy changes
+	_, err := s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: s.mintKeeper.GetAuthority(), Params: params})
+	s.Require().NoError(err)
+	minter, err := s.mintKeeper.Minter.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(100), minter.StrategyStartHeight)
+
+	// reset when the halving params change
+	params.AnnualProvisions = sdkmath.LegacyNewDec(2000)
+	_, err = s.msgServer.UpdateParams(ctx.WithHeaderInfo(header.Info{Height: 120}), &types.MsgUpdateParams{Authority: s.mintKeeper.GetAuthority(), Params: params})
+	s.Require().NoError(err)
+	minter, err = s.mintKeeper.Minter.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(120), minter.StrategyStartHeight)
+
+	params.HalvingBlocks = 20
+	_, err = s.msgServer.UpdateParams(ctx.WithHeaderInfo(header.Info{Height: 130}), &types.MsgUpdateParams{Authority: s.mintKeeper.GetAuthority(), Params: params})
+	s.Require().NoError(err)
+	minter, err = s.mintKeeper.Minter.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(130), minter.StrategyStartHeight)
+
+	// and kept when other params change
+	params.BlocksPerYear++
+	_, err = s.msgServer.UpdateParams(ctx.WithHeaderInfo(header.Info{Height: 140}), &types.MsgUpdateParams{Authority: s.mintKeeper.GetAuthority(), Params: params})
+	s.Require().NoError(err)
+	minter, err = s.mintKeeper.Minter.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(130), minter.StrategyStartHeight)
+}
@@ -27,6 +27,46 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MintStrategy defines the strategy used by the default mint function to compute
+// the provisions of each block.
+type MintStrategy int32
+
+const (
+	// MINT_STRATEGY_BONDED_RATIO_UNSPECIFIED defines an inflation rate adjusting each
+	// block toward the goal bonded ratio, between a minimum and a maximum inflation.
+	MintStrategyBondedRatio MintStrategy = 0
+	// MINT_STRATEGY_HALVING defines annual provisions halved every halving_blocks
+	// blocks since genesis.
+	MintStrategyHalving MintStrategy = 1
+	// MINT_STRATEGY_FIXED_YEARLY defines fixed annual provisions.
+	MintStrategyFixedYearly MintStrategy = 2
+	// MINT_STRATEGY_EXPONENTIAL_DECAY defines annual provisions of a fixed share,
+	// the decay_rate, of the supply remaining to be minted up to max_supply.
+	MintStrategyExponentialDecay MintStrategy = 3
+)
+
+var MintStrategy_name = map[int32]string{
+	0: "MINT_STRATEGY_BONDED_RATIO_UNSPECIFIED",
+	1: "MINT_STRATEGY_HALVING",
+	2: "MINT_STRATEGY_FIXED_YEARLY",
+	3: "MINT_STRATEGY_EXPONENTIAL_DECAY",
+}
+
+var MintStrategy_value = map[string]int32{
+	"MINT_STRATEGY_BONDED_RATIO_UNSPECIFIED": 0,
+	"MINT_STRATEGY_HALVING":                  1,
+	"MINT_STRATEGY_FIXED_YEARLY":             2,
+	"MINT_STRATEGY_EXPONENTIAL_DECAY":        3,
+}
+
+func (x MintStrategy) String() string {
+	return proto.EnumName(MintStrategy_name, int32(x))
+}
+
+func (MintStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{0}
+}
+
 // Minter represents the minting state.
 type Minter struct {
 	// current annual inflation rate
@@ -36,6 +76,9 @@ type Minter struct {
 	// data is any custom data that the user might want to put in the minter, to
 	// be used in the minting process.
 	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// strategy_start_height is the height at which the current mint strategy was set, or
+	// the params of the halving strategy last changed, from which its halvings are counted.
+	StrategyStartHeight uint64 `protobuf:"varint,4,opt,name=strategy_start_height,json=strategyStartHeight,proto3" json:"strategy_start_height,omitempty"`
 }
 
 func (m *Minter) Reset()         { *m = Minter{} }
@@ -78,6 +121,13 @@ func (m *Minter) GetData() []byte {
 	return nil
 }
 
+func (m *Minter) GetStrategyStartHeight() uint64 {
+	if m != nil {
+		return m.StrategyStartHeight
+	}
+	return 0
+}
+
 // Params defines the parameters for the x/mint module.
 type Params struct {
 	// type of coin to mint
@@ -94,6 +144,16 @@ type Params struct {
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
 	// maximum supply for the token
 	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
+	// mint_strategy is the strategy used to compute the provisions of each block.
+	MintStrategy MintStrategy `protobuf:"varint,8,opt,name=mint_strategy,json=mintStrategy,proto3,enum=cosmos.mint.v1beta1.MintStrategy" json:"mint_strategy,omitempty"`
+	// annual provisions of the fixed yearly strategy, and annual provisions before
+	// the first halving of the halving strategy
+	AnnualProvisions cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"annual_provisions"`
+	// number of blocks between two halvings of the halving strategy
+	HalvingBlocks uint64 `protobuf:"varint,10,opt,name=halving_blocks,json=halvingBlocks,proto3" json:"halving_blocks,omitempty"`
+	// share of the supply remaining to be minted up to the max supply, minted each
+	// year by the exponential decay strategy
+	DecayRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=decay_rate,json=decayRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"decay_rate"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -143,46 +203,135 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetMintStrategy() MintStrategy {
+	if m != nil {
+		return m.MintStrategy
+	}
+	return MintStrategyBondedRatio
+}
+
+func (m *Params) GetHalvingBlocks() uint64 {
+	if m != nil {
+		return m.HalvingBlocks
+	}
+	return 0
+}
+
+// SupplyProjection is the projected supply at the end of a year.
+type SupplyProjection struct {
+	// year is the number of years from now, starting at 1.
+	Year uint32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	// total_supply is the projected total supply at the end of the year.
+	TotalSupply cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_supply,json=totalSupply,proto3,customtype=cosmossdk.io/math.Int" json:"total_supply"`
+	// minted is the projected amount minted during the year.
+	Minted cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=minted,proto3,customtype=cosmossdk.io/math.Int" json:"minted"`
+}
+
+func (m *SupplyProjection) Reset()         { *m = SupplyProjection{} }
+func (m *SupplyProjection) String() string { return proto.CompactTextString(m) }
+func (*SupplyProjection) ProtoMessage()    {}
+func (*SupplyProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{2}
+}
+func (m *SupplyProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyProjection.Merge(m, src)
+}
+func (m *SupplyProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyProjection proto.InternalMessageInfo
+
+func (m *SupplyProjection) GetYear() uint32 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.mint.v1beta1.MintStrategy", MintStrategy_name, MintStrategy_value)
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
+	proto.RegisterType((*SupplyProjection)(nil), "cosmos.mint.v1beta1.SupplyProjection")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 490 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x73, 0x10, 0x82, 0x72, 0xb4, 0x82, 0x5e, 0xa9, 0xe4, 0x16, 0xd5, 0x8d, 0x3a, 0xa0,
-	0xa8, 0xa8, 0xb1, 0xa2, 0x4a, 0x0c, 0x8c, 0x21, 0x4b, 0x11, 0x15, 0x91, 0x19, 0x10, 0x20, 0x61,
-	0xbd, 0xb6, 0xaf, 0xee, 0x11, 0xdf, 0x9d, 0xe5, 0xbb, 0x54, 0xf6, 0x57, 0x60, 0xe2, 0x63, 0x30,
-	0x76, 0x60, 0xe1, 0x1b, 0x74, 0x41, 0xaa, 0x98, 0x10, 0x43, 0x85, 0x92, 0xa1, 0x5f, 0x03, 0xf9,
-	0xce, 0xa4, 0xfc, 0x99, 0x08, 0x5d, 0xac, 0xbb, 0xf7, 0x79, 0xdf, 0xdf, 0xf3, 0xd8, 0xf2, 0x8b,
-	0xdd, 0x48, 0x2a, 0x2e, 0x95, 0xc7, 0x99, 0xd0, 0xde, 0x71, 0x3f, 0xa4, 0x1a, 0xfa, 0xe6, 0xd2,
-	0xcb, 0x72, 0xa9, 0x25, 0x59, 0xb5, 0x7a, 0xcf, 0x94, 0x6a, 0x7d, 0xe3, 0x6e, 0x22, 0x13, 0x69,
-	0x74, 0xaf, 0x3a, 0xd9, 0xd6, 0x8d, 0x75, 0xdb, 0x1a, 0x58, 0xa1, 0x9e, 0xb3, 0xd2, 0x0a, 0x70,
-	0x26, 0xa4, 0x67, 0x9e, 0x3f, 0xbb, 0x13, 0x29, 0x93, 0x94, 0x7a, 0xe6, 0x16, 0x4e, 0x0e, 0x3d,
-	0x10, 0xa5, 0x95, 0xb6, 0x3f, 0x23, 0xdc, 0x3a, 0x60, 0x42, 0xd3, 0x9c, 0x3c, 0xc3, 0x6d, 0x26,
-	0x0e, 0x53, 0xd0, 0x4c, 0x0a, 0x07, 0x75, 0x50, 0xb7, 0x3d, 0xe8, 0x9f, 0x9e, 0x6f, 0x35, 0xbe,
-	0x9d, 0x6f, 0xdd, 0xb3, 0x0e, 0x2a, 0x1e, 0xf7, 0x98, 0xf4, 0x38, 0xe8, 0xa3, 0xde, 0x53, 0x9a,
-	0x40, 0x54, 0x0e, 0x69, 0xf4, 0xe5, 0xe3, 0x2e, 0xae, 0x03, 0x0c, 0x69, 0xe4, 0x5f, 0x32, 0xc8,
-	0x1b, 0xbc, 0x02, 0x42, 0x4c, 0x20, 0xad, 0x62, 0x1e, 0x33, 0xc5, 0xa4, 0x50, 0xce, 0xb5, 0x45,
-	0xc1, 0x77, 0x2c, 0x6b, 0x34, 0x47, 0x11, 0x82, 0x9b, 0x31, 0x68, 0x70, 0xae, 0x77, 0x50, 0x77,
-	0xc9, 0x37, 0xe7, 0xed, 0x4f, 0x4d, 0xdc, 0x1a, 0x41, 0x0e, 0x5c, 0x91, 0x4d, 0x8c, 0xab, 0x2f,
-	0x19, 0xc4, 0x54, 0x48, 0x6e, 0x5f, 0xc8, 0x6f, 0x57, 0x95, 0x61, 0x55, 0x20, 0x6f, 0xf1, 0xda,
-	0x3c, 0x6a, 0x90, 0x83, 0xa6, 0x41, 0x74, 0x04, 0x22, 0xa1, 0x75, 0xc2, 0x87, 0xff, 0x9c, 0xf0,
-	0xc3, 0xc5, 0xc9, 0x0e, 0xf2, 0x57, 0xe7, 0x50, 0x1f, 0x34, 0x7d, 0x6c, 0x90, 0xe4, 0x35, 0x5e,
-	0xbe, 0xf4, 0xe2, 0x50, 0x98, 0xc8, 0x8b, 0x7b, 0x2c, 0xcd, 0x61, 0x07, 0x50, 0xfc, 0x01, 0x67,
-	0xc2, 0x69, 0x5e, 0x15, 0x9c, 0x09, 0xf2, 0x02, 0xdf, 0x4a, 0x24, 0xa4, 0x41, 0x28, 0x45, 0x4c,
-	0x63, 0xe7, 0xc6, 0x7f, 0xa1, 0x71, 0x85, 0x1a, 0x18, 0x12, 0xb9, 0x8f, 0x6f, 0x87, 0xa9, 0x8c,
-	0xc6, 0x2a, 0xc8, 0x68, 0x1e, 0x94, 0x14, 0x72, 0xa7, 0xd5, 0x41, 0xdd, 0xa6, 0xbf, 0x6c, 0xcb,
-	0x23, 0x9a, 0xbf, 0xa4, 0x90, 0x93, 0x27, 0x18, 0x73, 0x28, 0x02, 0x35, 0xc9, 0xb2, 0xb4, 0x74,
-	0x6e, 0x1a, 0xff, 0x07, 0xb5, 0xff, 0xda, 0xdf, 0xfe, 0xfb, 0x42, 0xff, 0xe2, 0xbc, 0x2f, 0xb4,
-	0xdf, 0xe6, 0x50, 0x3c, 0x37, 0xd3, 0x8f, 0x36, 0xdf, 0x5d, 0x9c, 0xec, 0x38, 0x56, 0xdb, 0x55,
-	0xf1, 0xd8, 0x2b, 0xec, 0x2e, 0xda, 0x1f, 0x66, 0xb0, 0x77, 0x3a, 0x75, 0xd1, 0xd9, 0xd4, 0x45,
-	0xdf, 0xa7, 0x2e, 0x7a, 0x3f, 0x73, 0x1b, 0x67, 0x33, 0xb7, 0xf1, 0x75, 0xe6, 0x36, 0x5e, 0xad,
-	0xff, 0x66, 0x54, 0x4f, 0xe9, 0x32, 0xa3, 0x2a, 0x6c, 0x99, 0x3d, 0xda, 0xfb, 0x11, 0x00, 0x00,
-	0xff, 0xff, 0x53, 0x44, 0x4d, 0x1a, 0xdd, 0x03, 0x00, 0x00,
+	// 857 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x41, 0x4f, 0x1b, 0x47,
+	0x14, 0xf6, 0x82, 0xeb, 0xd4, 0x83, 0x9d, 0x3a, 0x43, 0x51, 0x36, 0x4e, 0x63, 0xb6, 0x1c, 0x22,
+	0x44, 0x85, 0x17, 0x88, 0xd4, 0x4a, 0xe9, 0xc9, 0x66, 0x17, 0xd8, 0x0a, 0x8c, 0xb5, 0x76, 0x5b,
+	0xdc, 0x4a, 0x1d, 0x8d, 0xbd, 0x93, 0xf5, 0x86, 0xdd, 0x19, 0x6b, 0x77, 0x40, 0xf6, 0x3f, 0xa8,
+	0x38, 0x55, 0xaa, 0x7a, 0xe4, 0xd4, 0x4b, 0x8e, 0x39, 0xe4, 0x47, 0xe4, 0x18, 0xe5, 0xd2, 0x2a,
+	0x87, 0xa8, 0x82, 0x43, 0xfe, 0x46, 0x35, 0x33, 0x0b, 0xb1, 0x81, 0x1c, 0x48, 0xb8, 0x58, 0x33,
+	0xf3, 0xbe, 0xf7, 0xbd, 0x37, 0x6f, 0xbe, 0xcf, 0x0b, 0x2a, 0x3d, 0x96, 0x44, 0x2c, 0x31, 0xa3,
+	0x80, 0x72, 0xf3, 0x70, 0xb5, 0x4b, 0x38, 0x5e, 0x95, 0x9b, 0xea, 0x20, 0x66, 0x9c, 0xc1, 0x59,
+	0x15, 0xaf, 0xca, 0xa3, 0x34, 0x5e, 0xfe, 0xd2, 0x67, 0x3e, 0x93, 0x71, 0x53, 0xac, 0x14, 0xb4,
+	0x7c, 0x4f, 0x41, 0x91, 0x0a, 0xa4, 0x79, 0x2a, 0x74, 0x07, 0x47, 0x01, 0x65, 0xa6, 0xfc, 0x3d,
+	0x43, 0xfb, 0x8c, 0xf9, 0x21, 0x31, 0xe5, 0xae, 0x7b, 0xf0, 0xc4, 0xc4, 0x74, 0xa4, 0x42, 0x0b,
+	0x7f, 0x4e, 0x81, 0xdc, 0x4e, 0x40, 0x39, 0x89, 0xe1, 0x2e, 0xc8, 0x07, 0xf4, 0x49, 0x88, 0x79,
+	0xc0, 0xa8, 0xae, 0x19, 0xda, 0x62, 0xbe, 0xbe, 0xfa, 0xf2, 0xed, 0x7c, 0xe6, 0xcd, 0xdb, 0xf9,
+	0xfb, 0xaa, 0x42, 0xe2, 0xed, 0x57, 0x03, 0x66, 0x46, 0x98, 0xf7, 0xab, 0xdb, 0xc4, 0xc7, 0xbd,
+	0x91, 0x45, 0x7a, 0xaf, 0x5f, 0x2c, 0x83, 0xb4, 0x01, 0x8b, 0xf4, 0xdc, 0xf7, 0x1c, 0xf0, 0x37,
+	0x70, 0x07, 0x53, 0x7a, 0x80, 0x43, 0xd1, 0xe6, 0x61, 0x90, 0x04, 0x8c, 0x26, 0xfa, 0xd4, 0xc7,
+	0x12, 0x97, 0x14, 0x57, 0xf3, 0x9c, 0x0a, 0x42, 0x90, 0xf5, 0x30, 0xc7, 0xfa, 0xb4, 0xa1, 0x2d,
+	0x16, 0x5c, 0xb9, 0x86, 0x16, 0x98, 0x4b, 0x78, 0x8c, 0x39, 0xf1, 0x47, 0x28, 0xe1, 0x38, 0xe6,
+	0xa8, 0x4f, 0x02, 0xbf, 0xcf, 0xf5, 0xac, 0xa1, 0x2d, 0x66, 0xeb, 0xa5, 0x37, 0x2f, 0x96, 0x0b,
+	0x43, 0x39, 0x74, 0x63, 0xa5, 0xba, 0x56, 0x5d, 0x71, 0x67, 0xcf, 0xe0, 0x2d, 0x81, 0xde, 0x92,
+	0xe0, 0x85, 0x67, 0xb7, 0x40, 0xae, 0x89, 0x63, 0x1c, 0x25, 0xf0, 0x01, 0x00, 0x02, 0x8d, 0x3c,
+	0x42, 0x59, 0xa4, 0xc6, 0xe2, 0xe6, 0xc5, 0x89, 0x25, 0x0e, 0xe0, 0x53, 0x30, 0x77, 0x7e, 0x61,
+	0x24, 0x88, 0x50, 0xaf, 0x8f, 0xa9, 0x4f, 0xd2, 0x7b, 0x7e, 0x7b, 0xed, 0x7b, 0x3e, 0x7b, 0xf7,
+	0x7c, 0x49, 0x73, 0x67, 0xcf, 0x49, 0x5d, 0xcc, 0xc9, 0xba, 0xa4, 0x84, 0xbf, 0x82, 0xe2, 0xfb,
+	0x5a, 0x11, 0x1e, 0xea, 0xd3, 0x9f, 0x54, 0xa3, 0x70, 0x4e, 0xb6, 0x83, 0x87, 0x17, 0xc8, 0x03,
+	0xaa, 0x67, 0x6f, 0x8a, 0x3c, 0xa0, 0xf0, 0x67, 0x30, 0xe3, 0x33, 0x1c, 0xa2, 0x2e, 0xa3, 0x1e,
+	0xf1, 0xf4, 0xcf, 0x3e, 0x89, 0x1a, 0x08, 0xaa, 0xba, 0x64, 0x82, 0x0f, 0xc1, 0x17, 0xdd, 0x90,
+	0xf5, 0xf6, 0x13, 0x34, 0x20, 0x31, 0x1a, 0x11, 0x1c, 0xeb, 0x39, 0xf1, 0xd0, 0x6e, 0x51, 0x1d,
+	0x37, 0x49, 0xdc, 0x21, 0x38, 0x86, 0x3f, 0x00, 0x10, 0xe1, 0x21, 0x4a, 0x0e, 0x06, 0x83, 0x70,
+	0xa4, 0xdf, 0x92, 0xf5, 0xbf, 0x49, 0xeb, 0xcf, 0x5d, 0xae, 0xef, 0x50, 0x3e, 0x56, 0xd9, 0xa1,
+	0xdc, 0xcd, 0x47, 0x78, 0xd8, 0x92, 0xd9, 0x70, 0x0f, 0x14, 0xa5, 0x22, 0xce, 0x84, 0xa3, 0x7f,
+	0x6e, 0x68, 0x8b, 0xb7, 0xd7, 0xbe, 0xae, 0x5e, 0x61, 0xdf, 0xaa, 0xf0, 0x56, 0x2b, 0x05, 0x5e,
+	0xa1, 0xbe, 0x42, 0x34, 0x16, 0x87, 0xc9, 0x55, 0x86, 0xc9, 0xcb, 0x66, 0x37, 0xae, 0x3d, 0xac,
+	0x8b, 0xb5, 0xd4, 0xf0, 0x2e, 0xbb, 0xe8, 0x3b, 0x70, 0xbb, 0x8f, 0xc3, 0xc3, 0x80, 0xfa, 0x48,
+	0xcd, 0x4c, 0x07, 0x1f, 0xb0, 0x4a, 0x31, 0xc5, 0xd5, 0x25, 0x0c, 0x12, 0x00, 0x3c, 0xd2, 0xc3,
+	0x23, 0x29, 0x7b, 0x7d, 0xe6, 0x46, 0xdb, 0xcc, 0x4b, 0x66, 0xa1, 0xfd, 0xc7, 0x0f, 0x8e, 0xde,
+	0x3d, 0x5f, 0xd2, 0x55, 0xc2, 0x72, 0xe2, 0xed, 0x9b, 0x0a, 0x6d, 0x2a, 0x7f, 0x2e, 0xfc, 0xa3,
+	0x81, 0x92, 0x7a, 0x98, 0x66, 0xcc, 0x9e, 0x92, 0x9e, 0xfc, 0xe7, 0x81, 0x20, 0x2b, 0xb5, 0x20,
+	0xec, 0x5a, 0x74, 0xe5, 0x1a, 0xb6, 0x40, 0x81, 0x33, 0x8e, 0xc3, 0x33, 0x11, 0x28, 0x83, 0xae,
+	0x5c, 0x43, 0x04, 0xaa, 0xb5, 0x19, 0xc9, 0x92, 0x6a, 0x61, 0x0b, 0xe4, 0x44, 0x33, 0xc4, 0xd3,
+	0xa7, 0x3f, 0x92, 0x2e, 0xcd, 0x7f, 0x5c, 0x7a, 0x7d, 0x61, 0x10, 0x4b, 0x7f, 0x4d, 0x81, 0xc2,
+	0xb8, 0x7c, 0xe0, 0x26, 0x78, 0xb8, 0xe3, 0x34, 0xda, 0xa8, 0xd5, 0x76, 0x6b, 0x6d, 0x7b, 0xb3,
+	0x83, 0xea, 0xbb, 0x0d, 0xcb, 0xb6, 0x90, 0x5b, 0x6b, 0x3b, 0xbb, 0xe8, 0xc7, 0x46, 0xab, 0x69,
+	0xaf, 0x3b, 0x1b, 0x8e, 0x6d, 0x95, 0x32, 0xe5, 0xfb, 0x47, 0xc7, 0xc6, 0xdd, 0x09, 0xf1, 0x49,
+	0xc3, 0xb8, 0xc2, 0x92, 0x70, 0x0d, 0xcc, 0x4d, 0x12, 0x6d, 0xd5, 0xb6, 0x7f, 0x72, 0x1a, 0x9b,
+	0x25, 0xad, 0x7c, 0xf7, 0xe8, 0xd8, 0x98, 0x1d, 0xcf, 0xdb, 0x52, 0x6f, 0x0e, 0xbf, 0x07, 0xe5,
+	0xc9, 0x9c, 0x0d, 0x67, 0xcf, 0xb6, 0x50, 0xc7, 0xae, 0xb9, 0xdb, 0x9d, 0xd2, 0xd4, 0xe5, 0x82,
+	0x1b, 0xc1, 0x90, 0x78, 0xc2, 0x7c, 0xe1, 0x08, 0xda, 0x60, 0x7e, 0x32, 0xd9, 0xde, 0x6b, 0xee,
+	0x36, 0xec, 0x46, 0xdb, 0xa9, 0x6d, 0x23, 0xcb, 0x5e, 0xaf, 0x75, 0x4a, 0xd3, 0x65, 0xe3, 0xe8,
+	0xd8, 0xf8, 0x6a, 0x9c, 0xc1, 0x1e, 0x0e, 0x18, 0x25, 0x94, 0x07, 0x38, 0xb4, 0x84, 0x1c, 0xca,
+	0xd9, 0xdf, 0xff, 0xae, 0x64, 0xea, 0x8f, 0x5e, 0x9e, 0x54, 0xb4, 0x57, 0x27, 0x15, 0xed, 0xbf,
+	0x93, 0x8a, 0xf6, 0xc7, 0x69, 0x25, 0xf3, 0xea, 0xb4, 0x92, 0xf9, 0xf7, 0xb4, 0x92, 0xf9, 0xe5,
+	0xde, 0xc4, 0xd4, 0x53, 0x9d, 0xf0, 0xd1, 0x80, 0x24, 0xdd, 0x9c, 0xfc, 0xdc, 0x3d, 0xfa, 0x7f,
+	0x00, 0x68, 0xfb, 0xd6, 0x7c, 0x84, 0x07, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -205,6 +354,11 @@ func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StrategyStartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StrategyStartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Data) > 0 {
 		i -= len(m.Data)
 		copy(dAtA[i:], m.Data)
@@ -255,6 +409,36 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.DecayRate.Size()
+		i -= size
+		if _, err := m.DecayRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.HalvingBlocks != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.HalvingBlocks))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.MintStrategy != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.MintStrategy))
+		i--
+		dAtA[i] = 0x40
+	}
 	{
 		size := m.MaxSupply.Size()
 		i -= size
@@ -320,6 +504,54 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SupplyProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Year != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Year))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -345,6 +577,9 @@ func (m *Minter) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMint(uint64(l))
 	}
+	if m.StrategyStartHeight != 0 {
+		n += 1 + sovMint(uint64(m.StrategyStartHeight))
+	}
 	return n
 }
 
@@ -371,6 +606,32 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MaxSupply.Size()
 	n += 1 + l + sovMint(uint64(l))
+	if m.MintStrategy != 0 {
+		n += 1 + sovMint(uint64(m.MintStrategy))
+	}
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.HalvingBlocks != 0 {
+		n += 1 + sovMint(uint64(m.HalvingBlocks))
+	}
+	l = m.DecayRate.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *SupplyProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Year != 0 {
+		n += 1 + sovMint(uint64(m.Year))
+	}
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Minted.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -511,6 +772,25 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 				m.Data = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategyStartHeight", wireType)
+			}
+			m.StrategyStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StrategyStartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -782,6 +1062,249 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintStrategy", wireType)
+			}
+			m.MintStrategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintStrategy |= MintStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingBlocks", wireType)
+			}
+			m.HalvingBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalvingBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyProjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Year", wireType)
+			}
+			m.Year = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Year |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -10,7 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// NewParams returns Params instance with the given values.
+// NewParams returns Params instance with the given values, using the bonded ratio mint strategy.
 func NewParams(mintDenom string, inflationRateChange, inflationMax, inflationMin, goalBonded math.LegacyDec, blocksPerYear uint64, maxSupply math.Int) Params {
 	return Params{
 		MintDenom:           mintDenom,
@@ -20,6 +20,9 @@ func NewParams(mintDenom string, inflationRateChange, inflationMax, inflationMin
 		GoalBonded:          goalBonded,
 		BlocksPerYear:       blocksPerYear,
 		MaxSupply:           maxSupply,
+		MintStrategy:        MintStrategyBondedRatio,
+		AnnualProvisions:    math.LegacyZeroDec(),
+		DecayRate:           math.LegacyZeroDec(),
 	}
 }
 
@@ -33,6 +36,10 @@ func DefaultParams() Params {
 		GoalBonded:          math.LegacyNewDecWithPrec(67, 2),
 		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5-second block times
 		MaxSupply:           math.ZeroInt(),             // assuming zero is infinite
+		MintStrategy:        MintStrategyBondedRatio,
+		AnnualProvisions:    math.LegacyZeroDec(),
+		HalvingBlocks:       0,
+		DecayRate:           math.LegacyZeroDec(),
 	}
 }
 
@@ -66,7 +73,49 @@ func (p Params) Validate() error {
 		)
 	}
 
-	return nil
+	return p.validateMintStrategy()
+}
+
+// validateMintStrategy checks that the params required by the mint strategy are set.
+func (p Params) validateMintStrategy() error {
+	if !p.AnnualProvisions.IsNil() && p.AnnualProvisions.IsNegative() {
+		return fmt.Errorf("annual provisions cannot be negative: %s", p.AnnualProvisions)
+	}
+	if !p.DecayRate.IsNil() && (p.DecayRate.IsNegative() || p.DecayRate.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("decay rate must be between 0 and 1: %s", p.DecayRate)
+	}
+
+	switch p.MintStrategy {
+	case MintStrategyBondedRatio:
+		return nil
+
+	case MintStrategyHalving:
+		if p.AnnualProvisions.IsNil() || !p.AnnualProvisions.IsPositive() {
+			return errors.New("annual provisions must be positive for the halving mint strategy")
+		}
+		if p.HalvingBlocks == 0 {
+			return errors.New("halving blocks must be positive for the halving mint strategy")
+		}
+		return nil
+
+	case MintStrategyFixedYearly:
+		if p.AnnualProvisions.IsNil() || !p.AnnualProvisions.IsPositive() {
+			return errors.New("annual provisions must be positive for the fixed yearly mint strategy")
+		}
+		return nil
+
+	case MintStrategyExponentialDecay:
+		if p.DecayRate.IsNil() || !p.DecayRate.IsPositive() {
+			return errors.New("decay rate must be positive for the exponential decay mint strategy")
+		}
+		if !p.MaxSupply.IsPositive() {
+			return errors.New("max supply must be set for the exponential decay mint strategy")
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unknown mint strategy: %d", p.MintStrategy)
+	}
 }
 
 func validateMintDenom(v string) error {
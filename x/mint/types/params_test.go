@@ -111,6 +111,111 @@ func TestValidate(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestValidateMintStrategy(t *testing.T) {
+	tests := []struct {
+		name     string
+		malleate func(p *Params)
+		expErr   string
+	}{
+		{
+			name:     "bonded ratio",
+			malleate: func(p *Params) {},
+		},
+		{
+			name:     "old state without strategy params",
+			malleate: func(p *Params) { p.AnnualProvisions, p.DecayRate = math.LegacyDec{}, math.LegacyDec{} },
+		},
+		{
+			name:     "unknown strategy",
+			malleate: func(p *Params) { p.MintStrategy = 5 },
+			expErr:   "unknown mint strategy",
+		},
+		{
+			name:     "negative annual provisions",
+			malleate: func(p *Params) { p.AnnualProvisions = math.LegacyNewDec(-1) },
+			expErr:   "annual provisions cannot be negative",
+		},
+		{
+			name:     "decay rate greater than one",
+			malleate: func(p *Params) { p.DecayRate = math.LegacyNewDec(2) },
+			expErr:   "decay rate must be between 0 and 1",
+		},
+		{
+			name: "halving",
+			malleate: func(p *Params) {
+				p.MintStrategy = MintStrategyHalving
+				p.AnnualProvisions = math.LegacyNewDec(1000)
+				p.HalvingBlocks = 100
+			},
+		},
+		{
+			name: "halving without annual provisions",
+			malleate: func(p *Params) {
+				p.MintStrategy = MintStrategyHalving
+				p.HalvingBlocks = 100
+			},
+			expErr: "annual provisions must be positive",
+		},
+		{
+			name: "halving without halving blocks",
+			malleate: func(p *Params) {
+				p.MintStrategy = MintStrategyHalving
+				p.AnnualProvisions = math.LegacyNewDec(1000)
+			},
+			expErr: "halving blocks must be positive",
+		},
+		{
+			name: "fixed yearly",
+			malleate: func(p *Params) {
+				p.MintStrategy = MintStrategyFixedYearly
+				p.AnnualProvisions = math.LegacyNewDec(1000)
+			},
+		},
+		{
+			name:     "fixed yearly without annual provisions",
+			malleate: func(p *Params) { p.MintStrategy = MintStrategyFixedYearly },
+			expErr:   "annual provisions must be positive",
+		},
+		{
+			name: "exponential decay",
+			malleate: func(p *Params) {
+				p.MintStrategy = MintStrategyExponentialDecay
+				p.DecayRate = math.LegacyNewDecWithPrec(1, 1)
+				p.MaxSupply = math.NewInt(1000)
+			},
+		},
+		{
+			name: "exponential decay without decay rate",
+			malleate: func(p *Params) {
+				p.MintStrategy = MintStrategyExponentialDecay
+				p.MaxSupply = math.NewInt(1000)
+			},
+			expErr: "decay rate must be positive",
+		},
+		{
+			name: "exponential decay without max supply",
+			malleate: func(p *Params) {
+				p.MintStrategy = MintStrategyExponentialDecay
+				p.DecayRate = math.LegacyNewDecWithPrec(1, 1)
+			},
+			expErr: "max supply must be set",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			tc.malleate(&params)
+			err := params.Validate()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func Test_validateInflationFields(t *testing.T) {
 	fns := []func(dec math.LegacyDec) error{
 		validateInflationRateChange,
@@ -270,6 +270,100 @@ func (m *QueryAnnualProvisionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAnnualProvisionsResponse proto.InternalMessageInfo
 
+// QuerySupplyProjectionRequest is the request type for the
+// Query/SupplyProjection RPC method.
+type QuerySupplyProjectionRequest struct {
+	// years is the number of years to project, at most 100.
+	Years uint32 `protobuf:"varint,1,opt,name=years,proto3" json:"years,omitempty"`
+}
+
+func (m *QuerySupplyProjectionRequest) Reset()         { *m = QuerySupplyProjectionRequest{} }
+func (m *QuerySupplyProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyProjectionRequest) ProtoMessage()    {}
+func (*QuerySupplyProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{6}
+}
+func (m *QuerySupplyProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyProjectionRequest.Merge(m, src)
+}
+func (m *QuerySupplyProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyProjectionRequest proto.InternalMessageInfo
+
+func (m *QuerySupplyProjectionRequest) GetYears() uint32 {
+	if m != nil {
+		return m.Years
+	}
+	return 0
+}
+
+// QuerySupplyProjectionResponse is the response type for the
+// Query/SupplyProjection RPC method.
+type QuerySupplyProjectionResponse struct {
+	// projections are the projected supplies at the end of each year.
+	Projections []SupplyProjection `protobuf:"bytes,1,rep,name=projections,proto3" json:"projections"`
+}
+
+func (m *QuerySupplyProjectionResponse) Reset()         { *m = QuerySupplyProjectionResponse{} }
+func (m *QuerySupplyProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyProjectionResponse) ProtoMessage()    {}
+func (*QuerySupplyProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{7}
+}
+func (m *QuerySupplyProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyProjectionResponse.Merge(m, src)
+}
+func (m *QuerySupplyProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyProjectionResponse proto.InternalMessageInfo
+
+func (m *QuerySupplyProjectionResponse) GetProjections() []SupplyProjection {
+	if m != nil {
+		return m.Projections
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.mint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.mint.v1beta1.QueryParamsResponse")
@@ -277,42 +371,52 @@ func init() {
 	proto.RegisterType((*QueryInflationResponse)(nil), "cosmos.mint.v1beta1.QueryInflationResponse")
 	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsRequest")
 	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QuerySupplyProjectionRequest)(nil), "cosmos.mint.v1beta1.QuerySupplyProjectionRequest")
+	proto.RegisterType((*QuerySupplyProjectionResponse)(nil), "cosmos.mint.v1beta1.QuerySupplyProjectionResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/query.proto", fileDescriptor_d0a1e393be338aea) }
 
 var fileDescriptor_d0a1e393be338aea = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4d, 0x8b, 0xd3, 0x40,
-	0x18, 0xc7, 0x33, 0x8a, 0x85, 0x8e, 0x1e, 0x76, 0x67, 0xd7, 0x97, 0x4d, 0x77, 0xa7, 0x4b, 0x84,
-	0xb5, 0xac, 0x38, 0x43, 0xbb, 0xe0, 0x51, 0xb0, 0xec, 0x45, 0xf0, 0xb0, 0x2e, 0x7a, 0xf1, 0x22,
-	0xb3, 0x71, 0x8c, 0xc1, 0x66, 0x26, 0x9b, 0x99, 0x16, 0x7b, 0x13, 0xf1, 0xe8, 0x41, 0xf0, 0x4b,
-	0xe8, 0xcd, 0x83, 0x5f, 0x41, 0xe8, 0xb1, 0xe8, 0x45, 0x3c, 0x14, 0x69, 0x05, 0xbf, 0x86, 0x64,
-	0x66, 0x5a, 0x69, 0x9a, 0xa0, 0xe2, 0x25, 0x24, 0xcf, 0xdb, 0xff, 0x37, 0xcf, 0x7f, 0x02, 0x9b,
-	0xa1, 0x54, 0x89, 0x54, 0x34, 0x89, 0x85, 0xa6, 0x83, 0xf6, 0x09, 0xd7, 0xac, 0x4d, 0x4f, 0xfb,
-	0x3c, 0x1b, 0x92, 0x34, 0x93, 0x5a, 0xa2, 0x0d, 0x5b, 0x40, 0xf2, 0x02, 0xe2, 0x0a, 0xfc, 0xcd,
-	0x48, 0x46, 0xd2, 0xe4, 0x69, 0xfe, 0x66, 0x4b, 0xfd, 0xed, 0x48, 0xca, 0xa8, 0xc7, 0x29, 0x4b,
-	0x63, 0xca, 0x84, 0x90, 0x9a, 0xe9, 0x58, 0x0a, 0xe5, 0xb2, 0xb8, 0x4c, 0xc9, 0x4c, 0xb5, 0xf9,
-	0x75, 0x96, 0xc4, 0x42, 0x52, 0xf3, 0x74, 0xa1, 0x2d, 0xdb, 0xf2, 0xc8, 0x2a, 0x39, 0x10, 0xf3,
-	0x11, 0x6c, 0x42, 0x74, 0x2f, 0xa7, 0x3c, 0x62, 0x19, 0x4b, 0xd4, 0x31, 0x3f, 0xed, 0x73, 0xa5,
-	0x83, 0x07, 0x70, 0x63, 0x29, 0xaa, 0x52, 0x29, 0x14, 0x47, 0xb7, 0x60, 0x2d, 0x35, 0x91, 0x2b,
-	0x60, 0x17, 0xb4, 0xce, 0x77, 0x1a, 0xa4, 0xe4, 0x50, 0xc4, 0x36, 0x75, 0xeb, 0xa3, 0x49, 0xd3,
-	0x7b, 0xf7, 0xf3, 0xc3, 0x3e, 0x38, 0x76, 0x5d, 0xc1, 0x65, 0x78, 0xd1, 0x8c, 0xbd, 0x23, 0x9e,
-	0xf4, 0xcc, 0x99, 0xe6, 0x7a, 0x02, 0x5e, 0x2a, 0x26, 0x9c, 0xe4, 0x7d, 0x58, 0x8f, 0xe7, 0x41,
-	0xa3, 0x7a, 0xa1, 0x7b, 0x33, 0x1f, 0xfc, 0x6d, 0xd2, 0x6c, 0x58, 0x71, 0xf5, 0xf8, 0x19, 0x89,
-	0x25, 0x4d, 0x98, 0x7e, 0x4a, 0xee, 0xf2, 0x88, 0x85, 0xc3, 0x43, 0x1e, 0x7e, 0xfe, 0x78, 0x03,
-	0x3a, 0xb6, 0x43, 0x1e, 0x5a, 0x8a, 0xdf, 0x83, 0x02, 0x0c, 0xb7, 0x8d, 0xde, 0x6d, 0x21, 0xfa,
-	0xac, 0x77, 0x94, 0xc9, 0x41, 0xac, 0xf2, 0x15, 0xcf, 0x79, 0x5e, 0x01, 0xb8, 0x53, 0x51, 0xe0,
-	0xb8, 0x42, 0xb8, 0xce, 0x4c, 0x2e, 0x5f, 0xaa, 0x4b, 0xfe, 0x27, 0xdf, 0x1a, 0x2b, 0x88, 0x75,
-	0x3e, 0x9d, 0x85, 0xe7, 0x0c, 0x06, 0x7a, 0x01, 0x60, 0xcd, 0xee, 0x15, 0x5d, 0x2b, 0x5d, 0xfa,
-	0xaa, 0x89, 0x7e, 0xeb, 0xcf, 0x85, 0xf6, 0x30, 0xc1, 0xd5, 0x97, 0x5f, 0x7e, 0xbc, 0x3d, 0xb3,
-	0x83, 0x1a, 0xb4, 0xec, 0x6e, 0x59, 0xf3, 0xd0, 0x6b, 0x00, 0xeb, 0x0b, 0x7f, 0xd0, 0x7e, 0xf5,
-	0xf0, 0xa2, 0xbb, 0xfe, 0xf5, 0xbf, 0xaa, 0x75, 0x2c, 0x7b, 0x86, 0x65, 0x17, 0xe1, 0x52, 0x96,
-	0x85, 0x85, 0xe8, 0x3d, 0x80, 0x6b, 0x45, 0x77, 0x50, 0xbb, 0x5a, 0xa9, 0xc2, 0x6a, 0xbf, 0xf3,
-	0x2f, 0x2d, 0x8e, 0x91, 0x18, 0xc6, 0x16, 0xda, 0x2b, 0x65, 0x5c, 0xb9, 0x17, 0xdd, 0x83, 0xd1,
-	0x14, 0x83, 0xf1, 0x14, 0x83, 0xef, 0x53, 0x0c, 0xde, 0xcc, 0xb0, 0x37, 0x9e, 0x61, 0xef, 0xeb,
-	0x0c, 0x7b, 0x0f, 0xb7, 0x96, 0xee, 0xc8, 0x73, 0x3b, 0x48, 0x0f, 0x53, 0xae, 0x4e, 0x6a, 0xe6,
-	0x07, 0x3d, 0xf8, 0x15, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x7d, 0x50, 0x82, 0x5a, 0x04, 0x00, 0x00,
+	// 596 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x4f, 0x6b, 0x13, 0x41,
+	0x18, 0xc6, 0x33, 0x4a, 0x02, 0x99, 0x54, 0x48, 0xa7, 0xf1, 0x4f, 0x37, 0xed, 0x26, 0xac, 0x58,
+	0x43, 0xa5, 0x33, 0xc9, 0x16, 0x3c, 0x78, 0x10, 0x0c, 0xa5, 0x20, 0x78, 0x88, 0x51, 0x2f, 0x5e,
+	0xc2, 0x74, 0x1d, 0xe3, 0x6a, 0xb2, 0xb3, 0xdd, 0xd9, 0x14, 0x83, 0x08, 0x22, 0x7a, 0x10, 0x3c,
+	0x08, 0x7e, 0x09, 0xbd, 0x79, 0xe8, 0x17, 0xf0, 0x56, 0x3c, 0x95, 0x7a, 0x11, 0x0f, 0x45, 0x12,
+	0xc1, 0xaf, 0x21, 0x3b, 0x33, 0x49, 0xd3, 0xcd, 0x46, 0x2d, 0x5e, 0x4a, 0x77, 0xde, 0xf7, 0x7d,
+	0x9e, 0xdf, 0xbb, 0xfb, 0x4c, 0x60, 0xc9, 0xe1, 0xa2, 0xcb, 0x05, 0xe9, 0xba, 0x5e, 0x48, 0x76,
+	0x6a, 0x5b, 0x2c, 0xa4, 0x35, 0xb2, 0xdd, 0x63, 0x41, 0x1f, 0xfb, 0x01, 0x0f, 0x39, 0x5a, 0x50,
+	0x0d, 0x38, 0x6a, 0xc0, 0xba, 0xc1, 0x28, 0xb4, 0x79, 0x9b, 0xcb, 0x3a, 0x89, 0xfe, 0x53, 0xad,
+	0xc6, 0x52, 0x9b, 0xf3, 0x76, 0x87, 0x11, 0xea, 0xbb, 0x84, 0x7a, 0x1e, 0x0f, 0x69, 0xe8, 0x72,
+	0x4f, 0xe8, 0xaa, 0x99, 0xe4, 0x24, 0x55, 0x55, 0x7d, 0x9e, 0x76, 0x5d, 0x8f, 0x13, 0xf9, 0x57,
+	0x1f, 0x2d, 0xaa, 0x91, 0x96, 0x72, 0xd2, 0x20, 0xf2, 0xc1, 0x2a, 0x40, 0x74, 0x3b, 0xa2, 0x6c,
+	0xd0, 0x80, 0x76, 0x45, 0x93, 0x6d, 0xf7, 0x98, 0x08, 0xad, 0x7b, 0x70, 0xe1, 0xd8, 0xa9, 0xf0,
+	0xb9, 0x27, 0x18, 0xba, 0x0e, 0x33, 0xbe, 0x3c, 0xb9, 0x00, 0xca, 0xa0, 0x92, 0xb3, 0x8b, 0x38,
+	0x61, 0x29, 0xac, 0x86, 0xea, 0xd9, 0xbd, 0xc3, 0x52, 0xea, 0xc3, 0xaf, 0x4f, 0xab, 0xa0, 0xa9,
+	0xa7, 0xac, 0xf3, 0xf0, 0xac, 0x94, 0xbd, 0xe9, 0x3d, 0xec, 0xc8, 0x9d, 0x46, 0x7e, 0x1e, 0x3c,
+	0x17, 0x2f, 0x68, 0xcb, 0xbb, 0x30, 0xeb, 0x8e, 0x0e, 0xa5, 0xeb, 0x5c, 0xfd, 0x6a, 0x24, 0xfc,
+	0xfd, 0xb0, 0x54, 0x54, 0xe6, 0xe2, 0xc1, 0x13, 0xec, 0x72, 0xd2, 0xa5, 0xe1, 0x23, 0x7c, 0x8b,
+	0xb5, 0xa9, 0xd3, 0xdf, 0x60, 0xce, 0xc1, 0xee, 0x1a, 0xd4, 0x6c, 0x1b, 0xcc, 0x51, 0x14, 0x47,
+	0x42, 0x96, 0x09, 0x97, 0xa4, 0xdf, 0x0d, 0xcf, 0xeb, 0xd1, 0x4e, 0x23, 0xe0, 0x3b, 0xae, 0x88,
+	0x5e, 0xf1, 0x88, 0xe7, 0x15, 0x80, 0xcb, 0x33, 0x1a, 0x34, 0x97, 0x03, 0xe7, 0xa9, 0xac, 0xb5,
+	0xfc, 0x71, 0xf1, 0x3f, 0xf9, 0xf2, 0x34, 0x66, 0x66, 0x6d, 0x6a, 0xcc, 0x3b, 0x3d, 0xdf, 0xef,
+	0xf4, 0x1b, 0x01, 0x7f, 0xcc, 0x9c, 0x89, 0xd7, 0x86, 0x0a, 0x30, 0xdd, 0x67, 0x34, 0x50, 0xc6,
+	0x67, 0x9a, 0xea, 0xe1, 0x5a, 0xfe, 0x60, 0x77, 0x6d, 0xee, 0xa9, 0x8c, 0x44, 0xb9, 0x8a, 0x6d,
+	0x5c, 0xb5, 0x5e, 0x8f, 0xd6, 0x99, 0x16, 0xd2, 0xeb, 0x34, 0x61, 0xce, 0x1f, 0x9f, 0x46, 0x7a,
+	0xa7, 0x2b, 0x39, 0xfb, 0x52, 0xe2, 0xe7, 0x8d, 0x6b, 0x4c, 0x7e, 0xe8, 0x49, 0x91, 0x69, 0x0e,
+	0xfb, 0x4d, 0x1a, 0xa6, 0x25, 0x07, 0x7a, 0x01, 0x60, 0x46, 0xe5, 0x04, 0x5d, 0x4e, 0x74, 0x99,
+	0x0e, 0xa5, 0x51, 0xf9, 0x7b, 0xa3, 0xda, 0xc6, 0xba, 0xf8, 0xf2, 0xeb, 0xcf, 0xf7, 0xa7, 0x96,
+	0x51, 0x91, 0x24, 0xdd, 0x15, 0x15, 0x46, 0xf4, 0x16, 0xc0, 0xec, 0x38, 0x6f, 0x68, 0x75, 0xb6,
+	0x78, 0x3c, 0xad, 0xc6, 0x95, 0x7f, 0xea, 0xd5, 0x2c, 0x2b, 0x92, 0xa5, 0x8c, 0xcc, 0x44, 0x96,
+	0x71, 0x24, 0xd1, 0x47, 0x00, 0xf3, 0xf1, 0xb4, 0xa1, 0xda, 0x6c, 0xa7, 0x19, 0xd1, 0x35, 0xec,
+	0x93, 0x8c, 0x68, 0x46, 0x2c, 0x19, 0x2b, 0x68, 0x25, 0x91, 0x71, 0x2a, 0xe7, 0xe8, 0x33, 0x80,
+	0xf9, 0x78, 0x0c, 0xfe, 0xc4, 0x3a, 0x23, 0xbf, 0x86, 0x7d, 0x92, 0x11, 0xcd, 0xba, 0xf9, 0x25,
+	0x96, 0x2a, 0xc9, 0x5e, 0x45, 0x38, 0x91, 0x5d, 0x48, 0x99, 0xd6, 0x51, 0x2c, 0xc9, 0x33, 0x79,
+	0x49, 0x9e, 0xd7, 0xd7, 0xf7, 0x06, 0x26, 0xd8, 0x1f, 0x98, 0xe0, 0xc7, 0xc0, 0x04, 0xef, 0x86,
+	0x66, 0x6a, 0x7f, 0x68, 0xa6, 0xbe, 0x0d, 0xcd, 0xd4, 0xfd, 0xc5, 0x63, 0xf7, 0x56, 0x59, 0x91,
+	0xb0, 0xef, 0x33, 0xb1, 0x95, 0x91, 0x3f, 0x9a, 0xeb, 0xbf, 0x07, 0x00, 0x46, 0x4e, 0x52, 0x48,
+	0xee, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -333,6 +437,9 @@ type QueryClient interface {
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// SupplyProjection projects the total supply at the end of each of the next years,
+	// from the current supply, minter and params of the default mint function.
+	SupplyProjection(ctx context.Context, in *QuerySupplyProjectionRequest, opts ...grpc.CallOption) (*QuerySupplyProjectionResponse, error)
 }
 
 type queryClient struct {
@@ -370,6 +477,15 @@ func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvi
 	return out, nil
 }
 
+func (c *queryClient) SupplyProjection(ctx context.Context, in *QuerySupplyProjectionRequest, opts ...grpc.CallOption) (*QuerySupplyProjectionResponse, error) {
+	out := new(QuerySupplyProjectionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/SupplyProjection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
@@ -378,6 +494,9 @@ type QueryServer interface {
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// SupplyProjection projects the total supply at the end of each of the next years,
+	// from the current supply, minter and params of the default mint function.
+	SupplyProjection(context.Context, *QuerySupplyProjectionRequest) (*QuerySupplyProjectionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -393,6 +512,9 @@ func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflat
 func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
 }
+func (*UnimplementedQueryServer) SupplyProjection(ctx context.Context, req *QuerySupplyProjectionRequest) (*QuerySupplyProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyProjection not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -452,6 +574,24 @@ func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SupplyProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/SupplyProjection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyProjection(ctx, req.(*QuerySupplyProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.mint.v1beta1.Query",
@@ -469,6 +609,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AnnualProvisions",
 			Handler:    _Query_AnnualProvisions_Handler,
 		},
+		{
+			MethodName: "SupplyProjection",
+			Handler:    _Query_SupplyProjection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/query.proto",
@@ -642,6 +786,71 @@ func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySupplyProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Years != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Years))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for iNdEx := len(m.Projections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -713,6 +922,33 @@ func (m *QueryAnnualProvisionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySupplyProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Years != 0 {
+		n += 1 + sovQuery(uint64(m.Years))
+	}
+	return n
+}
+
+func (m *QuerySupplyProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for _, e := range m.Projections {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1118,6 +1354,159 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySupplyProjectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyProjectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Years", wireType)
+			}
+			m.Years = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Years |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyProjectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyProjectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projections = append(m.Projections, SupplyProjection{})
+			if err := m.Projections[len(m.Projections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,154 @@
+package types
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+)
+
+const (
+	// MaxProjectionYears is the maximum number of years of a supply projection.
+	MaxProjectionYears = 100
+
+	// maxHalvings is the number of halvings from which no more tokens are minted.
+	maxHalvings = 128
+)
+
+// StrategyAnnualProvisions returns the annual provisions of the mint strategy of the params, at the
+// given block height and total supply, the strategy being set at startHeight. The provisions of the
+// bonded ratio strategy depend on the inflation of the minter instead, so zero is returned for it.
+func (p Params) StrategyAnnualProvisions(startHeight, height uint64, totalSupply math.Int) math.LegacyDec {
+	switch p.MintStrategy {
+	case MintStrategyHalving:
+		return halvedAnnualProvisions(p.AnnualProvisions, p.halvings(startHeight, height))
+
+	case MintStrategyFixedYearly:
+		return p.AnnualProvisions
+
+	case MintStrategyExponentialDecay:
+		remaining := p.MaxSupply.Sub(totalSupply)
+		if !remaining.IsPositive() {
+			return math.LegacyZeroDec()
+		}
+		return p.DecayRate.MulInt(remaining)
+
+	default:
+		return math.LegacyZeroDec()
+	}
+}
+
+// halvings returns the number of halvings of the halving strategy at the given height, the halvings
+// being counted from the height at which the strategy was set.
+func (p Params) halvings(startHeight, height uint64) uint64 {
+	if height < startHeight {
+		return 0
+	}
+
+	return (height - startHeight) / p.HalvingBlocks
+}
+
+// halvedAnnualProvisions returns the annual provisions halved the given number of times.
+func halvedAnnualProvisions(annualProvisions math.LegacyDec, halvings uint64) math.LegacyDec {
+	if halvings >= maxHalvings {
+		return math.LegacyZeroDec()
+	}
+
+	divisor := math.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), uint(halvings)))
+	return annualProvisions.QuoInt(divisor)
+}
+
+// ProjectSupply projects the total supply at the end of each of the next years, as minted by the
+// default mint function from the given minter, params, block height and total supply. The halvings
+// are counted from the strategy start height of the minter. The bonded ratio strategy is approximated
+// assuming the bonded ratio doesn't change.
+func ProjectSupply(minter Minter, params Params, height uint64, totalSupply math.Int, bondedRatio math.LegacyDec, years uint32) []SupplyProjection {
+	projections := make([]SupplyProjection, 0, years)
+	inflation := minter.Inflation
+	blocksPerYear := params.BlocksPerYear
+
+	for year := uint32(1); year <= years; year++ {
+		var minted math.Int
+		switch params.MintStrategy {
+		case MintStrategyBondedRatio:
+			// the inflation changes linearly during the year, so the average of the inflation at the
+			// start and at the end of the year is minted, the supply growth during the year being ignored
+			endInflation := nextYearInflation(inflation, params, bondedRatio)
+			minted = inflation.Add(endInflation).QuoInt64(2).MulInt(totalSupply).TruncateInt()
+			inflation = endInflation
+
+		case MintStrategyHalving:
+			minted = projectHalvingYear(params, minter.StrategyStartHeight, height)
+
+		case MintStrategyFixedYearly:
+			minted = params.AnnualProvisions.TruncateInt()
+
+		case MintStrategyExponentialDecay:
+			// the remaining supply decays by decay_rate / blocks_per_year each block
+			remaining := params.MaxSupply.Sub(totalSupply)
+			if remaining.IsPositive() {
+				blockDecay := math.LegacyOneDec().Sub(params.DecayRate.QuoInt64(int64(blocksPerYear)))
+				minted = remaining.Sub(blockDecay.Power(blocksPerYear).MulInt(remaining).Ceil().TruncateInt())
+			}
+		}
+
+		if minted.IsNil() || minted.IsNegative() {
+			minted = math.ZeroInt()
+		}
+
+		// the supply is capped to the max supply
+		if params.MaxSupply.IsPositive() && totalSupply.Add(minted).GT(params.MaxSupply) {
+			minted = math.MaxInt(params.MaxSupply.Sub(totalSupply), math.ZeroInt())
+		}
+
+		totalSupply = totalSupply.Add(minted)
+		height += blocksPerYear
+		projections = append(projections, SupplyProjection{
+			Year:        year,
+			TotalSupply: totalSupply,
+			Minted:      minted,
+		})
+	}
+
+	return projections
+}
+
+// nextYearInflation returns the inflation of the bonded ratio strategy after a year, the inflation
+// changing by (1 - bondedRatio/GoalBonded) * InflationRateChange per year, between the min and max inflation.
+func nextYearInflation(inflation math.LegacyDec, params Params, bondedRatio math.LegacyDec) math.LegacyDec {
+	inflationRateChange := math.LegacyOneDec().
+		Sub(bondedRatio.Quo(params.GoalBonded)).
+		Mul(params.InflationRateChange)
+
+	inflation = inflation.Add(inflationRateChange)
+	if inflation.GT(params.InflationMax) {
+		inflation = params.InflationMax
+	}
+	if inflation.LT(params.InflationMin) {
+		inflation = params.InflationMin
+	}
+
+	return inflation
+}
+
+// projectHalvingYear returns the amount minted by the halving strategy set at startHeight during
+// the year starting at the given height, summing the block provisions of each halving period of the year.
+func projectHalvingYear(params Params, startHeight, height uint64) math.Int {
+	minted := math.ZeroInt()
+	end := height + params.BlocksPerYear
+
+	for height < end {
+		halvings := params.halvings(startHeight, height)
+		if halvings >= maxHalvings {
+			break
+		}
+
+		periodEnd := min(startHeight+(halvings+1)*params.HalvingBlocks, end)
+		blockProvision := halvedAnnualProvisions(params.AnnualProvisions, halvings).
+			QuoInt(math.NewIntFromUint64(params.BlocksPerYear)).
+			TruncateInt()
+		minted = minted.Add(blockProvision.Mul(math.NewIntFromUint64(periodEnd - height)))
+		height = periodEnd
+	}
+
+	return minted
+}
@@ -0,0 +1,196 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+)
+
+func TestStrategyAnnualProvisions(t *testing.T) {
+	tests := []struct {
+		name        string
+		malleate    func(p *Params)
+		startHeight uint64
+		height      uint64
+		totalSupply math.Int
+		exp         math.LegacyDec
+	}{
+		{
+			name:        "bonded ratio",
+			malleate:    func(p *Params) {},
+			totalSupply: math.NewInt(1000),
+			exp:         math.LegacyZeroDec(),
+		},
+		{
+			name: "halving before first halving",
+			malleate: func(p *Params) {
+				p.MintStrategy = MintStrategyHalving
+				p.AnnualProvisions = math.LegacyNewDec(1000)
+				p.HalvingBlocks = 10
+			},
+			height:      9,
+			totalSupply: math.NewInt(1000),
+			exp:         math.LegacyNewDec(1000),
+		},
+		{
+			name: "halving after two halvings",
+			malleate: func(p *Params) {
+				p.MintStrategy = MintStrategyHalving
+				p.AnnualProvisions = math.LegacyNewDec(1000)
+				p.HalvingBlocks = 10
+			},
+			height:      25,
+			totalSupply: math.NewInt(1000),
+			exp:         math.LegacyNewDec(250),
+		},
+		{
+			name: "halving counted from the start height",
+			malleate: func(p *Params) {
+				p.MintStrategy = MintStrategyHalving
+				p.AnnualProvisions = math.LegacyNewDec(1000)
+				p.HalvingBlocks = 10
+			},
+			startHeight: 100,
+			height:      115,
+			totalSupply: math.NewInt(1000),
+			exp:         math.LegacyNewDec(500),
+		},
+		{
+			name: "halving after max halvings",
+			malleate: func(p *Params) {
+				p.MintStrategy = MintStrategyHalving
+				p.AnnualProvisions = math.LegacyNewDec(1000)
+				p.HalvingBlocks = 1
+			},
+			height:      maxHalvings,
+			totalSupply: math.NewInt(1000),
+			exp:         math.LegacyZeroDec(),
+		},
+		{
+			name: "fixed yearly",
+			malleate: func(p *Params) {
+				p.MintStrategy = MintStrategyFixedYearly
+				p.AnnualProvisions = math.LegacyNewDec(1000)
+			},
+			height:      100,
+			totalSupply: math.NewInt(1000),
+			exp:         math.LegacyNewDec(1000),
+		},
+		{
+			name: "exponential decay",
+			malleate: func(p *Params) {
+				p.MintStrategy = MintStrategyExponentialDecay
+				p.DecayRate = math.LegacyNewDecWithPrec(1, 1)
+				p.MaxSupply = math.NewInt(5000)
+			},
+			totalSupply: math.NewInt(1000),
+			exp:         math.LegacyNewDec(400),
+		},
+		{
+			name: "exponential decay with max supply reached",
+			malleate: func(p *Params) {
+				p.MintStrategy = MintStrategyExponentialDecay
+				p.DecayRate = math.LegacyNewDecWithPrec(1, 1)
+				p.MaxSupply = math.NewInt(1000)
+			},
+			totalSupply: math.NewInt(1000),
+			exp:         math.LegacyZeroDec(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			tc.malleate(&params)
+			require.NoError(t, params.Validate())
+			require.Equal(t, tc.exp, params.StrategyAnnualProvisions(tc.startHeight, tc.height, tc.totalSupply))
+		})
+	}
+}
+
+func TestProjectSupply(t *testing.T) {
+	tests := []struct {
+		name        string
+		malleate    func(p *Params)
+		startHeight uint64
+		height      uint64
+		totalSupply math.Int
+		expMinted   []int64
+	}{
+		{
+			name:        "bonded ratio",
+			malleate:    func(p *Params) {},
+			totalSupply: math.NewInt(1000),
+			expMinted:   []int64{50, 52, 55},
+		},
+		{
+			name: "halving",
+			malleate: func(p *Params) {
+				p.MintStrategy = MintStrategyHalving
+				p.AnnualProvisions = math.LegacyNewDec(1_000_000)
+				p.BlocksPerYear = 1000
+				p.HalvingBlocks = 500
+			},
+			totalSupply: math.ZeroInt(),
+			expMinted:   []int64{750_000, 187_500, 46_500},
+		},
+		{
+			name: "halving counted from the start height",
+			malleate: func(p *Params) {
+				p.MintStrategy = MintStrategyHalving
+				p.AnnualProvisions = math.LegacyNewDec(1_000_000)
+				p.BlocksPerYear = 1000
+				p.HalvingBlocks = 500
+			},
+			startHeight: 10_000,
+			height:      10_250,
+			totalSupply: math.ZeroInt(),
+			expMinted:   []int64{562_500, 140_500, 34_750},
+		},
+		{
+			name: "fixed yearly capped to the max supply",
+			malleate: func(p *Params) {
+				p.MintStrategy = MintStrategyFixedYearly
+				p.AnnualProvisions = math.LegacyNewDec(1000)
+				p.MaxSupply = math.NewInt(2500)
+			},
+			totalSupply: math.ZeroInt(),
+			expMinted:   []int64{1000, 1000, 500},
+		},
+		{
+			name: "exponential decay",
+			malleate: func(p *Params) {
+				p.MintStrategy = MintStrategyExponentialDecay
+				p.DecayRate = math.LegacyOneDec()
+				p.BlocksPerYear = 2
+				p.MaxSupply = math.NewInt(1000)
+			},
+			totalSupply: math.ZeroInt(),
+			expMinted:   []int64{750, 187, 47},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			tc.malleate(&params)
+			require.NoError(t, params.Validate())
+
+			// the bonded ratio is the goal, so the inflation of the bonded ratio strategy doesn't change
+			minter := NewMinter(params.InflationMax, math.LegacyZeroDec())
+			minter.StrategyStartHeight = tc.startHeight
+			projections := ProjectSupply(minter, params, tc.height, tc.totalSupply, params.GoalBonded, uint32(len(tc.expMinted)))
+			require.Len(t, projections, len(tc.expMinted))
+
+			totalSupply := tc.totalSupply
+			for i, projection := range projections {
+				totalSupply = totalSupply.AddRaw(tc.expMinted[i])
+				require.Equal(t, uint32(i+1), projection.Year)
+				require.Equal(t, math.NewInt(tc.expMinted[i]), projection.Minted, "year %d", i+1)
+				require.Equal(t, totalSupply, projection.TotalSupply, "year %d", i+1)
+			}
+		})
+	}
+}
@@ -11,6 +11,15 @@ import (
 func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	registrar.RegisterImplementations((*coretransaction.Msg)(nil),
 		&MsgSend{},
+		&MsgCreateClass{},
+		&MsgMint{},
+		&MsgBurn{},
+		&MsgUpdateNFT{},
+		&MsgFreezeClass{},
+		&MsgApprove{},
+		&MsgRevoke{},
+		&MsgApproveOperator{},
+		&MsgRevokeOperator{},
 	)
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
@@ -6,10 +6,14 @@ import (
 
 // x/nft module sentinel errors
 var (
-	ErrClassExists    = errors.Register(ModuleName, 3, "nft class already exists")
-	ErrClassNotExists = errors.Register(ModuleName, 4, "nft class does not exist")
-	ErrNFTExists      = errors.Register(ModuleName, 5, "nft already exists")
-	ErrNFTNotExists   = errors.Register(ModuleName, 6, "nft does not exist")
-	ErrEmptyClassID   = errors.Register(ModuleName, 7, "empty class id")
-	ErrEmptyNFTID     = errors.Register(ModuleName, 8, "empty nft id")
+	ErrClassExists       = errors.Register(ModuleName, 3, "nft class already exists")
+	ErrClassNotExists    = errors.Register(ModuleName, 4, "nft class does not exist")
+	ErrNFTExists         = errors.Register(ModuleName, 5, "nft already exists")
+	ErrNFTNotExists      = errors.Register(ModuleName, 6, "nft does not exist")
+	ErrEmptyClassID      = errors.Register(ModuleName, 7, "empty class id")
+	ErrEmptyNFTID        = errors.Register(ModuleName, 8, "empty nft id")
+	ErrClassFrozen       = errors.Register(ModuleName, 9, "nft class is frozen")
+	ErrMaxSupply         = errors.Register(ModuleName, 10, "nft class max supply reached")
+	ErrNoClassConfig     = errors.Register(ModuleName, 11, "nft class was not created with Msg/CreateClass")
+	ErrApprovalNotExists = errors.Register(ModuleName, 12, "nft approval does not exist")
 )
@@ -11,6 +11,14 @@ func ValidateGenesis(data GenesisState, ac address.Codec) error {
 			return ErrEmptyClassID
 		}
 	}
+	for _, config := range data.ClassConfigs {
+		if len(config.ClassId) == 0 {
+			return ErrEmptyClassID
+		}
+		if _, err := ac.StringToBytes(config.Creator); err != nil {
+			return err
+		}
+	}
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
 			if len(nft.Id) == 0 {
@@ -21,6 +29,25 @@ func ValidateGenesis(data GenesisState, ac address.Codec) error {
 			}
 		}
 	}
+	for _, approval := range data.Approvals {
+		if len(approval.ClassId) == 0 {
+			return ErrEmptyClassID
+		}
+		if len(approval.Id) == 0 {
+			return ErrEmptyNFTID
+		}
+		if _, err := ac.StringToBytes(approval.Spender); err != nil {
+			return err
+		}
+	}
+	for _, approval := range data.OperatorApprovals {
+		if _, err := ac.StringToBytes(approval.Owner); err != nil {
+			return err
+		}
+		if _, err := ac.StringToBytes(approval.Operator); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
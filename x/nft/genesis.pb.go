@@ -28,6 +28,12 @@ type GenesisState struct {
 	Classes []*Class `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
 	// entry defines all nft owned by a person.
 	Entries []*Entry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	// class_configs defines the configurations of the classes created with Msg/CreateClass.
+	ClassConfigs []*ClassConfig `protobuf:"bytes,3,rep,name=class_configs,json=classConfigs,proto3" json:"class_configs,omitempty"`
+	// approvals defines the approvals of spenders to transfer nfts.
+	Approvals []*Approval `protobuf:"bytes,4,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// operator_approvals defines the approvals of operators to transfer all the nfts of owners.
+	OperatorApprovals []*OperatorApproval `protobuf:"bytes,5,rep,name=operator_approvals,json=operatorApprovals,proto3" json:"operator_approvals,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +83,27 @@ func (m *GenesisState) GetEntries() []*Entry {
 	return nil
 }
 
+func (m *GenesisState) GetClassConfigs() []*ClassConfig {
+	if m != nil {
+		return m.ClassConfigs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetApprovals() []*Approval {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOperatorApprovals() []*OperatorApproval {
+	if m != nil {
+		return m.OperatorApprovals
+	}
+	return nil
+}
+
 // Entry Defines all nft owned by a person
 type Entry struct {
 	// owner is the owner address of the following nft
@@ -140,22 +167,27 @@ func init() {
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/genesis.proto", fileDescriptor_0095f7548e354a72) }
 
 var fileDescriptor_0095f7548e354a72 = []byte{
-	// 228 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0xcf, 0x4b, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f,
-	0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0xa8,
-	0xd0, 0xcb, 0x4b, 0x2b, 0xd1, 0x83, 0xaa, 0x90, 0x92, 0xc1, 0xa2, 0x0b, 0x24, 0x0f, 0xd6, 0xa1,
-	0x54, 0xc1, 0xc5, 0xe3, 0x0e, 0x31, 0x22, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x98, 0x8b, 0x3d,
-	0x39, 0x27, 0xb1, 0xb8, 0x38, 0xb5, 0x58, 0x82, 0x51, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x52, 0x0f,
-	0xd3, 0x4c, 0x3d, 0x67, 0x90, 0x92, 0x20, 0x98, 0x4a, 0x90, 0xa6, 0xd4, 0xbc, 0x92, 0xa2, 0xcc,
-	0xd4, 0x62, 0x09, 0x26, 0xdc, 0x9a, 0x5c, 0xf3, 0x4a, 0x8a, 0x2a, 0x83, 0x60, 0x2a, 0x95, 0xbc,
-	0xb8, 0x58, 0xc1, 0x22, 0x42, 0x22, 0x5c, 0xac, 0xf9, 0xe5, 0x79, 0xa9, 0x45, 0x12, 0x8c, 0x0a,
-	0x8c, 0x1a, 0x9c, 0x41, 0x10, 0x8e, 0x90, 0x36, 0x17, 0x4b, 0x5e, 0x5a, 0x09, 0xcc, 0x40, 0x71,
-	0x6c, 0x06, 0xfa, 0xb9, 0x85, 0x04, 0x81, 0x15, 0x39, 0xe9, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
-	0xe3, 0xb1, 0x1c, 0x43, 0x14, 0x34, 0x44, 0x8a, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x2b, 0x40,
-	0x3e, 0x4f, 0x62, 0x03, 0x7b, 0xdd, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xbb, 0xe8, 0x93, 0x50,
-	0x50, 0x01, 0x00, 0x00,
+	// 312 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcd, 0x4a, 0x33, 0x31,
+	0x14, 0x86, 0x9b, 0xfe, 0x7c, 0x1f, 0x8d, 0x75, 0x61, 0x10, 0x1c, 0xa5, 0xc4, 0x52, 0x5c, 0x14,
+	0x94, 0x0c, 0xb5, 0x3b, 0x77, 0x5a, 0x7f, 0xc0, 0x85, 0x42, 0xea, 0xca, 0x4d, 0x49, 0xc7, 0x4c,
+	0x19, 0xac, 0xc9, 0x90, 0x13, 0xaa, 0xde, 0x85, 0x97, 0xe5, 0xce, 0x2e, 0x5d, 0x4a, 0x7b, 0x23,
+	0x32, 0x99, 0x19, 0x07, 0x74, 0xba, 0x3c, 0xc9, 0xf3, 0x3e, 0x87, 0xe4, 0xc5, 0x9d, 0x40, 0xc3,
+	0x93, 0x06, 0x5f, 0x85, 0xd6, 0x9f, 0xf7, 0x27, 0xd2, 0x8a, 0xbe, 0x3f, 0x95, 0x4a, 0x42, 0x04,
+	0x2c, 0x36, 0xda, 0x6a, 0x42, 0x52, 0x82, 0xa9, 0xd0, 0xb2, 0x8c, 0xd8, 0x6b, 0x97, 0xa4, 0x92,
+	0x7b, 0x97, 0xe8, 0x7e, 0x54, 0x71, 0xeb, 0x2a, 0x75, 0x8c, 0xac, 0xb0, 0x92, 0x0c, 0xf0, 0xff,
+	0x60, 0x26, 0x00, 0x24, 0x78, 0xa8, 0x53, 0xeb, 0x6d, 0x1c, 0xef, 0xb2, 0xbf, 0x52, 0x36, 0x4c,
+	0x10, 0x9e, 0x93, 0x49, 0x48, 0x2a, 0x6b, 0x22, 0x09, 0x5e, 0x75, 0x7d, 0xe8, 0x42, 0x59, 0xf3,
+	0xca, 0x73, 0x92, 0x9c, 0xe3, 0x4d, 0x97, 0x1f, 0x07, 0x5a, 0x85, 0xd1, 0x14, 0xbc, 0x9a, 0x8b,
+	0xee, 0xaf, 0xdd, 0x37, 0x74, 0x1c, 0x6f, 0x05, 0xc5, 0x00, 0xe4, 0x04, 0x37, 0x45, 0x1c, 0x1b,
+	0x3d, 0x17, 0x33, 0xf0, 0xea, 0xce, 0xd0, 0x2e, 0x33, 0x9c, 0x66, 0x10, 0x2f, 0x70, 0x32, 0xc2,
+	0x44, 0xc7, 0xd2, 0x08, 0xab, 0xcd, 0xb8, 0x90, 0x34, 0x9c, 0xe4, 0xa0, 0x4c, 0x72, 0x9b, 0xd1,
+	0x3f, 0xb2, 0x2d, 0xfd, 0xeb, 0x04, 0xba, 0xd7, 0xb8, 0xe1, 0x1e, 0x4a, 0xb6, 0x71, 0x43, 0x3f,
+	0x2b, 0x69, 0x3c, 0xd4, 0x41, 0xbd, 0x26, 0x4f, 0x07, 0x72, 0x88, 0xeb, 0x2a, 0xb4, 0xf9, 0x3f,
+	0xed, 0x94, 0x6d, 0xb9, 0xb9, 0xbc, 0xe3, 0x0e, 0x3a, 0x3b, 0x7a, 0x5f, 0x52, 0xb4, 0x58, 0x52,
+	0xf4, 0xb5, 0xa4, 0xe8, 0x6d, 0x45, 0x2b, 0x8b, 0x15, 0xad, 0x7c, 0xae, 0x68, 0xe5, 0x3e, 0x6b,
+	0x1a, 0x1e, 0x1e, 0x59, 0xa4, 0xfd, 0x97, 0xa4, 0xd1, 0xc9, 0x3f, 0x57, 0xe9, 0xe0, 0x7b, 0x00,
+	0x79, 0x6b, 0xc3, 0xdb, 0x28, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -178,6 +210,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OperatorApprovals) > 0 {
+		for iNdEx := len(m.OperatorApprovals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorApprovals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Approvals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ClassConfigs) > 0 {
+		for iNdEx := len(m.ClassConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClassConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Entries) > 0 {
 		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -282,6 +356,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ClassConfigs) > 0 {
+		for _, e := range m.ClassConfigs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Approvals) > 0 {
+		for _, e := range m.Approvals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OperatorApprovals) > 0 {
+		for _, e := range m.OperatorApprovals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -407,6 +499,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassConfigs = append(m.ClassConfigs, &ClassConfig{})
+			if err := m.ClassConfigs[len(m.ClassConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, &Approval{})
+			if err := m.Approvals[len(m.Approvals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorApprovals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorApprovals = append(m.OperatorApprovals, &OperatorApproval{})
+			if err := m.OperatorApprovals[len(m.OperatorApprovals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
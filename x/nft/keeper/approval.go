@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+	"cosmossdk.io/x/nft/internal/conv"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Approve defines a method for approving a spender to transfer an exist nft.
+// The approval replaces the previous one and is cleared when the nft is transferred or burnt.
+// Note: When the upper module uses this method, it needs to authenticate nft
+func (k Keeper) Approve(ctx context.Context, classID, nftID string, spender sdk.AccAddress) error {
+	if !k.HasNFT(ctx, classID, nftID) {
+		return errors.Wrap(nft.ErrNFTNotExists, nftID)
+	}
+
+	store := k.KVStoreService.OpenKVStore(ctx)
+	return store.Set(approvalStoreKey(classID, nftID), spender.Bytes())
+}
+
+// Revoke defines a method for revoking the approval of a nft
+func (k Keeper) Revoke(ctx context.Context, classID, nftID string) error {
+	if k.GetApproved(ctx, classID, nftID) == nil {
+		return errors.Wrapf(nft.ErrApprovalNotExists, "nft %s", nftID)
+	}
+
+	return k.deleteApproval(ctx, classID, nftID)
+}
+
+// GetApproved returns the spender approved to transfer the specified nft, nil if none
+func (k Keeper) GetApproved(ctx context.Context, classID, nftID string) sdk.AccAddress {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	bz, err := store.Get(approvalStoreKey(classID, nftID))
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return nil
+	}
+	return sdk.AccAddress(bz)
+}
+
+// GetApprovals returns all the nft approvals
+func (k Keeper) GetApprovals(ctx context.Context) ([]*nft.Approval, error) {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), ApprovalKey)
+	defer iterator.Close()
+
+	var approvals []*nft.Approval
+	for ; iterator.Valid(); iterator.Next() {
+		ids := bytes.SplitN(iterator.Key()[len(ApprovalKey):], Delimiter, 2)
+		if len(ids) != 2 {
+			panic("invalid approvalStoreKey")
+		}
+
+		spender, err := k.ac.BytesToString(iterator.Value())
+		if err != nil {
+			return nil, err
+		}
+
+		approvals = append(approvals, &nft.Approval{
+			ClassId: conv.UnsafeBytesToStr(ids[0]),
+			Id:      string(ids[1]),
+			Spender: spender,
+		})
+	}
+	return approvals, nil
+}
+
+func (k Keeper) deleteApproval(ctx context.Context, classID, nftID string) error {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	return store.Delete(approvalStoreKey(classID, nftID))
+}
+
+// SetOperatorApproval defines a method for approving an operator to transfer all the nfts of an owner
+func (k Keeper) SetOperatorApproval(ctx context.Context, approval nft.OperatorApproval) error {
+	owner, err := k.ac.StringToBytes(approval.Owner)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", approval.Owner)
+	}
+
+	operator, err := k.ac.StringToBytes(approval.Operator)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid operator address (%s)", approval.Operator)
+	}
+
+	if bytes.Equal(owner, operator) {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "owner cannot approve itself as operator")
+	}
+
+	bz, err := k.cdc.Marshal(&approval)
+	if err != nil {
+		return errors.Wrap(err, "Marshal nft.OperatorApproval failed")
+	}
+	store := k.KVStoreService.OpenKVStore(ctx)
+	return store.Set(operatorApprovalStoreKey(owner, operator), bz)
+}
+
+// RevokeOperator defines a method for revoking the approval of an operator
+func (k Keeper) RevokeOperator(ctx context.Context, owner, operator sdk.AccAddress) error {
+	if _, has := k.GetOperatorApproval(ctx, owner, operator); !has {
+		return errors.Wrapf(nft.ErrApprovalNotExists, "operator %s", operator)
+	}
+
+	store := k.KVStoreService.OpenKVStore(ctx)
+	return store.Delete(operatorApprovalStoreKey(owner, operator))
+}
+
+// GetOperatorApproval returns the approval of an operator by an owner, expired or not
+func (k Keeper) GetOperatorApproval(ctx context.Context, owner, operator sdk.AccAddress) (nft.OperatorApproval, bool) {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	var approval nft.OperatorApproval
+
+	bz, err := store.Get(operatorApprovalStoreKey(owner, operator))
+	if err != nil {
+		return approval, false
+	}
+
+	if len(bz) == 0 {
+		return approval, false
+	}
+	k.cdc.MustUnmarshal(bz, &approval)
+	return approval, true
+}
+
+// IsApprovedOperator determines whether an operator is approved, and not expired, to transfer
+// all the nfts of an owner
+func (k Keeper) IsApprovedOperator(ctx context.Context, owner, operator sdk.AccAddress) bool {
+	approval, has := k.GetOperatorApproval(ctx, owner, operator)
+	if !has {
+		return false
+	}
+
+	return approval.Expiration == nil || k.HeaderService.HeaderInfo(ctx).Time.Before(*approval.Expiration)
+}
+
+// GetOperatorApprovals returns all the operator approvals
+func (k Keeper) GetOperatorApprovals(ctx context.Context) (approvals []*nft.OperatorApproval) {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), OperatorApprovalKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var approval nft.OperatorApproval
+		k.cdc.MustUnmarshal(iterator.Value(), &approval)
+		approvals = append(approvals, &approval)
+	}
+	return
+}
+
+func (k Keeper) prefixStoreOperatorApprovals(ctx context.Context, owner sdk.AccAddress) prefix.Store {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	return prefix.NewStore(runtime.KVStoreAdapter(store), prefixOperatorApprovalStoreKey(owner))
+}
+
+// IsApprovedOrOwner determines whether a spender is the owner of a nft, the spender approved
+// for it, or an approved operator of its owner
+func (k Keeper) IsApprovedOrOwner(ctx context.Context, classID, nftID string, spender sdk.AccAddress) bool {
+	owner := k.GetOwner(ctx, classID, nftID)
+	if len(owner) == 0 {
+		return false
+	}
+
+	return bytes.Equal(owner, spender) ||
+		bytes.Equal(k.GetApproved(ctx, classID, nftID), spender) ||
+		k.IsApprovedOperator(ctx, owner, spender)
+}
+
+// TransferFrom defines a method for a spender to send a nft on behalf of its owner.
+// The spender must be the owner of the nft, the spender approved for it or an approved operator of its owner.
+func (k Keeper) TransferFrom(ctx context.Context,
+	classID string,
+	nftID string,
+	spender sdk.AccAddress,
+	receiver sdk.AccAddress,
+) error {
+	if !k.IsApprovedOrOwner(ctx, classID, nftID, spender) {
+		spenderStr, err := k.ac.BytesToString(spender)
+		if err != nil {
+			return err
+		}
+		return errors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s nor approved to transfer it", spenderStr, nftID)
+	}
+
+	return k.Transfer(ctx, classID, nftID, receiver)
+}
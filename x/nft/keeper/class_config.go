@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxRoyaltyBasisPoints is the maximum royalty rate of a class, in basis points.
+const MaxRoyaltyBasisPoints = 10000
+
+// CreateClass defines a method for creating a new nft class along with its configuration
+func (k Keeper) CreateClass(ctx context.Context, class nft.Class, config nft.ClassConfig) error {
+	if len(class.Id) == 0 {
+		return nft.ErrEmptyClassID
+	}
+
+	config.ClassId = class.Id
+	if err := k.validateClassConfig(config); err != nil {
+		return err
+	}
+
+	if err := k.SaveClass(ctx, class); err != nil {
+		return err
+	}
+
+	return k.SetClassConfig(ctx, config)
+}
+
+// validateClassConfig checks the addresses and the royalty rate of a class configuration
+func (k Keeper) validateClassConfig(config nft.ClassConfig) error {
+	if _, err := k.ac.StringToBytes(config.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid creator address (%s)", config.Creator)
+	}
+
+	for _, addr := range append(slices.Clone(config.Minters), config.Updaters...) {
+		if _, err := k.ac.StringToBytes(addr); err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid address (%s)", addr)
+		}
+	}
+
+	if config.RoyaltyReceiver != "" {
+		if _, err := k.ac.StringToBytes(config.RoyaltyReceiver); err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid royalty receiver address (%s)", config.RoyaltyReceiver)
+		}
+	}
+
+	if config.RoyaltyBasisPoints > MaxRoyaltyBasisPoints {
+		return sdkerrors.ErrInvalidRequest.Wrapf("royalty basis points must be at most %d", MaxRoyaltyBasisPoints)
+	}
+
+	return nil
+}
+
+// SetClassConfig defines a method for setting the configuration of an exist nft class
+func (k Keeper) SetClassConfig(ctx context.Context, config nft.ClassConfig) error {
+	if !k.HasClass(ctx, config.ClassId) {
+		return errors.Wrap(nft.ErrClassNotExists, config.ClassId)
+	}
+	bz, err := k.cdc.Marshal(&config)
+	if err != nil {
+		return errors.Wrap(err, "Marshal nft.ClassConfig failed")
+	}
+	store := k.KVStoreService.OpenKVStore(ctx)
+	return store.Set(classConfigStoreKey(config.ClassId), bz)
+}
+
+// GetClassConfig defines a method for returning the configuration of the specified class
+func (k Keeper) GetClassConfig(ctx context.Context, classID string) (nft.ClassConfig, bool) {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	var config nft.ClassConfig
+
+	bz, err := store.Get(classConfigStoreKey(classID))
+	if err != nil {
+		return config, false
+	}
+
+	if len(bz) == 0 {
+		return config, false
+	}
+	k.cdc.MustUnmarshal(bz, &config)
+	return config, true
+}
+
+// GetClassConfigs defines a method for returning the configurations of all classes
+func (k Keeper) GetClassConfigs(ctx context.Context) (configs []*nft.ClassConfig) {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), ClassConfigKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var config nft.ClassConfig
+		k.cdc.MustUnmarshal(iterator.Value(), &config)
+		configs = append(configs, &config)
+	}
+	return
+}
+
+// canMint determines whether the specified address is allowed to mint nfts of a class
+func canMint(config nft.ClassConfig, addr string) bool {
+	return config.Creator == addr || slices.Contains(config.Minters, addr)
+}
+
+// canUpdate determines whether the specified address is allowed to update nfts of a class
+func canUpdate(config nft.ClassConfig, addr string) bool {
+	return config.Creator == addr || slices.Contains(config.Updaters, addr)
+}
@@ -15,6 +15,11 @@ func (k Keeper) InitGenesis(ctx context.Context, data *nft.GenesisState) error {
 			return err
 		}
 	}
+	for _, config := range data.ClassConfigs {
+		if err := k.SetClassConfig(ctx, *config); err != nil {
+			return err
+		}
+	}
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
 			owner, err := k.ac.StringToBytes(entry.Owner)
@@ -27,6 +32,21 @@ func (k Keeper) InitGenesis(ctx context.Context, data *nft.GenesisState) error {
 			}
 		}
 	}
+	for _, approval := range data.Approvals {
+		spender, err := k.ac.StringToBytes(approval.Spender)
+		if err != nil {
+			return err
+		}
+
+		if err := k.Approve(ctx, approval.ClassId, approval.Id, spender); err != nil {
+			return err
+		}
+	}
+	for _, approval := range data.OperatorApprovals {
+		if err := k.SetOperatorApproval(ctx, *approval); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -63,8 +83,16 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*nft.GenesisState, error) {
 			Nfts:  nftMap[owner],
 		})
 	}
+	approvals, err := k.GetApprovals(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &nft.GenesisState{
-		Classes: classes,
-		Entries: entries,
+		Classes:           classes,
+		Entries:           entries,
+		ClassConfigs:      k.GetClassConfigs(ctx),
+		Approvals:         approvals,
+		OperatorApprovals: k.GetOperatorApprovals(ctx),
 	}, nil
 }
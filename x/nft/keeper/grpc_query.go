@@ -259,3 +259,79 @@ func (k Keeper) Classes(ctx context.Context, r *nft.QueryClassesRequest) (*nft.Q
 		Pagination: pageRes,
 	}, nil
 }
+
+// ClassConfig return the configuration of an NFT class created with Msg/CreateClass
+func (k Keeper) ClassConfig(ctx context.Context, r *nft.QueryClassConfigRequest) (*nft.QueryClassConfigResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	config, has := k.GetClassConfig(ctx, r.ClassId)
+	if !has {
+		return nil, nft.ErrNoClassConfig.Wrapf("not found class config: %s", r.ClassId)
+	}
+	return &nft.QueryClassConfigResponse{Config: &config}, nil
+}
+
+// Approval return the spender approved to transfer an NFT
+func (k Keeper) Approval(ctx context.Context, r *nft.QueryApprovalRequest) (*nft.QueryApprovalResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(r.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	spender := k.GetApproved(ctx, r.ClassId, r.Id)
+	if spender == nil {
+		return nil, nft.ErrApprovalNotExists.Wrapf("not found approval: %s/%s", r.ClassId, r.Id)
+	}
+
+	spenderStr, err := k.ac.BytesToString(spender)
+	if err != nil {
+		return nil, err
+	}
+	return &nft.QueryApprovalResponse{Approval: &nft.Approval{
+		ClassId: r.ClassId,
+		Id:      r.Id,
+		Spender: spenderStr,
+	}}, nil
+}
+
+// OperatorApprovals return the operators approved to transfer all the NFTs of an owner, including the expired ones
+func (k Keeper) OperatorApprovals(ctx context.Context, r *nft.QueryOperatorApprovalsRequest) (*nft.QueryOperatorApprovalsResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	owner, err := k.ac.StringToBytes(r.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var approvals []*nft.OperatorApproval
+	pageRes, err := query.Paginate(k.prefixStoreOperatorApprovals(ctx, owner), r.Pagination, func(_, value []byte) error {
+		var approval nft.OperatorApproval
+		if err := k.cdc.Unmarshal(value, &approval); err != nil {
+			return err
+		}
+		approvals = append(approvals, &approval)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &nft.QueryOperatorApprovalsResponse{
+		Approvals:  approvals,
+		Pagination: pageRes,
+	}, nil
+}
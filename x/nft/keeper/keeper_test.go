@@ -45,6 +45,7 @@ type TestSuite struct {
 	encodedAddrs  []string
 	queryClient   nft.QueryClient
 	nftKeeper     keeper.Keeper
+	msgServer     nft.MsgServer
 	accountKeeper *nfttestutil.MockAccountKeeper
 
 	encCfg moduletestutil.TestEncodingConfig
@@ -80,6 +81,7 @@ func (s *TestSuite) SetupTest() {
 	nft.RegisterQueryServer(queryHelper, nftKeeper)
 
 	s.nftKeeper = nftKeeper
+	s.msgServer = keeper.NewMsgServerImpl(nftKeeper)
 	s.queryClient = nft.NewQueryClient(queryHelper)
 	s.ctx = ctx
 }
@@ -16,6 +16,9 @@ var (
 	NFTOfClassByOwnerKey = []byte{0x03}
 	OwnerKey             = []byte{0x04}
 	ClassTotalSupply     = []byte{0x05}
+	ClassConfigKey       = []byte{0x06}
+	ApprovalKey          = []byte{0x07}
+	OperatorApprovalKey  = []byte{0x08}
 
 	Delimiter   = []byte{0x00}
 	Placeholder = []byte{0x01}
@@ -32,6 +35,14 @@ func classStoreKey(classID string) []byte {
 	return key
 }
 
+// classConfigStoreKey returns the byte representation of the nft class configuration key
+func classConfigStoreKey(classID string) []byte {
+	key := make([]byte, len(ClassConfigKey)+len(classID))
+	copy(key, ClassConfigKey)
+	copy(key[len(ClassConfigKey):], classID)
+	return key
+}
+
 // nftStoreKey returns the byte representation of the nft
 func nftStoreKey(classID string) []byte {
 	key := make([]byte, len(NFTKey)+len(classID)+len(Delimiter))
@@ -105,3 +116,43 @@ func ownerStoreKey(classID, nftID string) []byte {
 	copy(key[len(OwnerKey)+len(classIDBz)+len(Delimiter):], nftIDBz)
 	return key
 }
+
+// approvalStoreKey returns the byte representation of the nft approval
+// Items are stored with the following key: values
+// 0x07<classID><Delimiter(1 Byte)><nftID>
+func approvalStoreKey(classID, nftID string) []byte {
+	classIDBz := conv.UnsafeStrToBytes(classID)
+	nftIDBz := conv.UnsafeStrToBytes(nftID)
+
+	key := make([]byte, len(ApprovalKey)+len(classIDBz)+len(Delimiter)+len(nftIDBz))
+	copy(key, ApprovalKey)
+	copy(key[len(ApprovalKey):], classIDBz)
+	copy(key[len(ApprovalKey)+len(classIDBz):], Delimiter)
+	copy(key[len(ApprovalKey)+len(classIDBz)+len(Delimiter):], nftIDBz)
+	return key
+}
+
+// operatorApprovalStoreKey returns the byte representation of the operator approval
+// Items are stored with the following key: values
+// 0x08<owner(length prefixed)><operator(length prefixed)>
+func operatorApprovalStoreKey(owner, operator sdk.AccAddress) []byte {
+	prefix := prefixOperatorApprovalStoreKey(owner)
+	operator = address.MustLengthPrefix(operator)
+
+	key := make([]byte, len(prefix)+len(operator))
+	copy(key, prefix)
+	copy(key[len(prefix):], operator)
+	return key
+}
+
+// prefixOperatorApprovalStoreKey returns the prefix of the result of the method operatorApprovalStoreKey
+// Items are stored with the following key: values
+// 0x08<owner(length prefixed)>
+func prefixOperatorApprovalStoreKey(owner sdk.AccAddress) []byte {
+	owner = address.MustLengthPrefix(owner)
+
+	key := make([]byte, len(OperatorApprovalKey)+len(owner))
+	copy(key, OperatorApprovalKey)
+	copy(key[len(OperatorApprovalKey):], owner)
+	return key
+}
@@ -7,13 +7,26 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/nft"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-var _ nft.MsgServer = Keeper{}
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the nft MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) nft.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ nft.MsgServer = msgServer{}
 
 // Send implements Send method of the types.MsgServer.
-func (k Keeper) Send(ctx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
+func (k msgServer) Send(ctx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
 	if len(msg.ClassId) == 0 {
 		return nil, nft.ErrEmptyClassID
 	}
@@ -32,19 +45,20 @@ func (k Keeper) Send(ctx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespons
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid receiver address (%s)", msg.Receiver)
 	}
 
-	owner := k.GetOwner(ctx, msg.ClassId, msg.Id)
-	if !bytes.Equal(owner, sender) {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", msg.Sender, msg.Id)
+	// the sender is either the owner of the nft, or an account approved to transfer it
+	owner, err := k.ac.BytesToString(k.GetOwner(ctx, msg.ClassId, msg.Id))
+	if err != nil {
+		return nil, err
 	}
 
-	if err := k.Transfer(ctx, msg.ClassId, msg.Id, receiver); err != nil {
+	if err := k.TransferFrom(ctx, msg.ClassId, msg.Id, sender, receiver); err != nil {
 		return nil, err
 	}
 
 	if err = k.EventService.EventManager(ctx).Emit(&nft.EventSend{
 		ClassId:  msg.ClassId,
 		Id:       msg.Id,
-		Sender:   msg.Sender,
+		Sender:   owner,
 		Receiver: msg.Receiver,
 	}); err != nil {
 		return nil, err
@@ -52,3 +66,325 @@ func (k Keeper) Send(ctx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespons
 
 	return &nft.MsgSendResponse{}, nil
 }
+
+// CreateClass implements CreateClass method of the types.MsgServer.
+func (k msgServer) CreateClass(ctx context.Context, msg *nft.MsgCreateClass) (*nft.MsgCreateClassResponse, error) {
+	if msg.Class == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "class cannot be nil")
+	}
+
+	if err := k.Keeper.CreateClass(ctx, *msg.Class, nft.ClassConfig{
+		Creator:            msg.Creator,
+		Minters:            msg.Minters,
+		Updaters:           msg.Updaters,
+		MaxSupply:          msg.MaxSupply,
+		RoyaltyReceiver:    msg.RoyaltyReceiver,
+		RoyaltyBasisPoints: msg.RoyaltyBasisPoints,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&nft.EventCreateClass{
+		ClassId: msg.Class.Id,
+		Creator: msg.Creator,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgCreateClassResponse{}, nil
+}
+
+// Mint implements Mint method of the types.MsgServer.
+func (k msgServer) Mint(ctx context.Context, msg *nft.MsgMint) (*nft.MsgMintResponse, error) {
+	if msg.Nft == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "nft cannot be nil")
+	}
+
+	if len(msg.Nft.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	receiver, err := k.ac.StringToBytes(msg.Receiver)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid receiver address (%s)", msg.Receiver)
+	}
+
+	config, err := k.mutableClassConfig(ctx, msg.Nft.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canMint(config, msg.Minter) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to mint nfts of class %s", msg.Minter, config.ClassId)
+	}
+
+	// burnt nfts still count towards the max supply. The live supply covers the nfts
+	// minted directly through the keeper, which do not increment the counter.
+	minted := max(config.Minted, k.GetTotalSupply(ctx, config.ClassId))
+	if config.MaxSupply > 0 && minted >= config.MaxSupply {
+		return nil, errorsmod.Wrapf(nft.ErrMaxSupply, "class %s has a max supply of %d", config.ClassId, config.MaxSupply)
+	}
+
+	if err := k.Keeper.Mint(ctx, *msg.Nft, receiver); err != nil {
+		return nil, err
+	}
+
+	config.Minted = minted + 1
+	if err := k.SetClassConfig(ctx, config); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgMintResponse{}, nil
+}
+
+// Burn implements Burn method of the types.MsgServer.
+func (k msgServer) Burn(ctx context.Context, msg *nft.MsgBurn) (*nft.MsgBurnResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	owner, err := k.ac.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", msg.Owner)
+	}
+
+	// only the nfts of the classes created with Msg/CreateClass can be burnt by their owner,
+	// the other classes are managed by the modules which created them.
+	if _, has := k.GetClassConfig(ctx, msg.ClassId); !has {
+		return nil, errorsmod.Wrap(nft.ErrNoClassConfig, msg.ClassId)
+	}
+
+	if !bytes.Equal(k.GetOwner(ctx, msg.ClassId, msg.Id), owner) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", msg.Owner, msg.Id)
+	}
+
+	if err := k.Keeper.Burn(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgBurnResponse{}, nil
+}
+
+// UpdateNFT implements UpdateNFT method of the types.MsgServer.
+func (k msgServer) UpdateNFT(ctx context.Context, msg *nft.MsgUpdateNFT) (*nft.MsgUpdateNFTResponse, error) {
+	if msg.Nft == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "nft cannot be nil")
+	}
+
+	if len(msg.Nft.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	config, err := k.mutableClassConfig(ctx, msg.Nft.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canUpdate(config, msg.Updater) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to update nfts of class %s", msg.Updater, config.ClassId)
+	}
+
+	if err := k.Update(ctx, *msg.Nft); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&nft.EventUpdateNFT{
+		ClassId: msg.Nft.ClassId,
+		Id:      msg.Nft.Id,
+		Updater: msg.Updater,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgUpdateNFTResponse{}, nil
+}
+
+// FreezeClass implements FreezeClass method of the types.MsgServer.
+func (k msgServer) FreezeClass(ctx context.Context, msg *nft.MsgFreezeClass) (*nft.MsgFreezeClassResponse, error) {
+	config, err := k.mutableClassConfig(ctx, msg.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Creator != msg.Creator {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the creator of class %s", msg.Creator, msg.ClassId)
+	}
+
+	config.Frozen = true
+	if err := k.SetClassConfig(ctx, config); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&nft.EventFreezeClass{
+		ClassId: msg.ClassId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgFreezeClassResponse{}, nil
+}
+
+// Approve implements Approve method of the types.MsgServer.
+func (k msgServer) Approve(ctx context.Context, msg *nft.MsgApprove) (*nft.MsgApproveResponse, error) {
+	owner, err := k.nftOwnerOrOperator(ctx, msg.ClassId, msg.Id, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	spender, err := k.ac.StringToBytes(msg.Spender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid spender address (%s)", msg.Spender)
+	}
+
+	if bytes.Equal(owner, spender) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cannot approve the owner of the nft")
+	}
+
+	if err := k.Keeper.Approve(ctx, msg.ClassId, msg.Id, spender); err != nil {
+		return nil, err
+	}
+
+	ownerStr, err := k.ac.BytesToString(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&nft.EventApprove{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Owner:   ownerStr,
+		Spender: msg.Spender,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgApproveResponse{}, nil
+}
+
+// Revoke implements Revoke method of the types.MsgServer.
+func (k msgServer) Revoke(ctx context.Context, msg *nft.MsgRevoke) (*nft.MsgRevokeResponse, error) {
+	owner, err := k.nftOwnerOrOperator(ctx, msg.ClassId, msg.Id, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.Revoke(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+
+	ownerStr, err := k.ac.BytesToString(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&nft.EventRevoke{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Owner:   ownerStr,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgRevokeResponse{}, nil
+}
+
+// ApproveOperator implements ApproveOperator method of the types.MsgServer.
+func (k msgServer) ApproveOperator(ctx context.Context, msg *nft.MsgApproveOperator) (*nft.MsgApproveOperatorResponse, error) {
+	if msg.Expiration != nil && !k.HeaderService.HeaderInfo(ctx).Time.Before(*msg.Expiration) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "expiration must be in the future")
+	}
+
+	if err := k.SetOperatorApproval(ctx, nft.OperatorApproval{
+		Owner:      msg.Owner,
+		Operator:   msg.Operator,
+		Expiration: msg.Expiration,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&nft.EventApproveOperator{
+		Owner:    msg.Owner,
+		Operator: msg.Operator,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgApproveOperatorResponse{}, nil
+}
+
+// RevokeOperator implements RevokeOperator method of the types.MsgServer.
+func (k msgServer) RevokeOperator(ctx context.Context, msg *nft.MsgRevokeOperator) (*nft.MsgRevokeOperatorResponse, error) {
+	owner, err := k.ac.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", msg.Owner)
+	}
+
+	operator, err := k.ac.StringToBytes(msg.Operator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid operator address (%s)", msg.Operator)
+	}
+
+	if err := k.Keeper.RevokeOperator(ctx, owner, operator); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&nft.EventRevokeOperator{
+		Owner:    msg.Owner,
+		Operator: msg.Operator,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgRevokeOperatorResponse{}, nil
+}
+
+// nftOwnerOrOperator returns the owner of a nft, failing if the sender is neither its owner
+// nor an approved operator of its owner.
+func (k msgServer) nftOwnerOrOperator(ctx context.Context, classID, nftID, sender string) (sdk.AccAddress, error) {
+	if len(classID) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(nftID) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	senderAddr, err := k.ac.StringToBytes(sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", sender)
+	}
+
+	owner := k.GetOwner(ctx, classID, nftID)
+	if len(owner) == 0 {
+		return nil, errorsmod.Wrap(nft.ErrNFTNotExists, nftID)
+	}
+
+	if !bytes.Equal(owner, senderAddr) && !k.IsApprovedOperator(ctx, owner, senderAddr) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s nor an approved operator", sender, nftID)
+	}
+
+	return owner, nil
+}
+
+// mutableClassConfig returns the configuration of a class created with Msg/CreateClass,
+// failing if the class is frozen.
+func (k msgServer) mutableClassConfig(ctx context.Context, classID string) (nft.ClassConfig, error) {
+	if len(classID) == 0 {
+		return nft.ClassConfig{}, nft.ErrEmptyClassID
+	}
+
+	config, has := k.GetClassConfig(ctx, classID)
+	if !has {
+		return nft.ClassConfig{}, errorsmod.Wrap(nft.ErrNoClassConfig, classID)
+	}
+
+	if config.Frozen {
+		return nft.ClassConfig{}, errorsmod.Wrap(nft.ErrClassFrozen, classID)
+	}
+
+	return config, nil
+}
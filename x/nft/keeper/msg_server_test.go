@@ -2,8 +2,13 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/x/nft"
+	"cosmossdk.io/x/nft/keeper"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var (
@@ -121,7 +126,7 @@ func (s *TestSuite) TestSend() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			_, err := s.nftKeeper.Send(s.ctx, tc.req)
+			_, err := s.msgServer.Send(s.ctx, tc.req)
 			if tc.expErr {
 				s.Require().Error(err)
 				s.Require().Contains(err.Error(), tc.errMsg)
@@ -131,3 +136,178 @@ func (s *TestSuite) TestSend() {
 		})
 	}
 }
+
+func (s *TestSuite) TestUserMessages() {
+	createMsg := &nft.MsgCreateClass{
+		Creator:            s.encodedAddrs[0],
+		Class:              &ExpClass,
+		Minters:            []string{s.encodedAddrs[1]},
+		MaxSupply:          1,
+		RoyaltyReceiver:    s.encodedAddrs[0],
+		RoyaltyBasisPoints: keeper.MaxRoyaltyBasisPoints + 1,
+	}
+	_, err := s.msgServer.CreateClass(s.ctx, createMsg)
+	s.Require().ErrorContains(err, "royalty basis points must be at most")
+
+	createMsg.RoyaltyBasisPoints = 250
+	_, err = s.msgServer.CreateClass(s.ctx, createMsg)
+	s.Require().NoError(err)
+	_, err = s.msgServer.CreateClass(s.ctx, createMsg)
+	s.Require().ErrorIs(err, nft.ErrClassExists)
+
+	res, err := s.queryClient.ClassConfig(s.ctx, &nft.QueryClassConfigRequest{ClassId: testClassID})
+	s.Require().NoError(err)
+	s.Require().Equal(s.encodedAddrs[0], res.Config.Creator)
+	s.Require().Equal(uint32(250), res.Config.RoyaltyBasisPoints)
+
+	// only the creator and the minters can mint, up to the max supply
+	mintMsg := &nft.MsgMint{Minter: s.encodedAddrs[2], Nft: &ExpNFT, Receiver: s.encodedAddrs[2]}
+	_, err = s.msgServer.Mint(s.ctx, mintMsg)
+	s.Require().ErrorContains(err, "is not allowed to mint nfts")
+
+	mintMsg.Minter = s.encodedAddrs[1]
+	_, err = s.msgServer.Mint(s.ctx, mintMsg)
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[2], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	_, err = s.msgServer.Mint(s.ctx, &nft.MsgMint{
+		Minter:   s.encodedAddrs[0],
+		Nft:      &nft.NFT{ClassId: testClassID, Id: "kitty2"},
+		Receiver: s.encodedAddrs[0],
+	})
+	s.Require().ErrorIs(err, nft.ErrMaxSupply)
+
+	// only the creator and the updaters can update
+	updated := ExpNFT
+	updated.Uri = "new kitty uri"
+	_, err = s.msgServer.UpdateNFT(s.ctx, &nft.MsgUpdateNFT{Updater: s.encodedAddrs[1], Nft: &updated})
+	s.Require().ErrorContains(err, "is not allowed to update nfts")
+	_, err = s.msgServer.UpdateNFT(s.ctx, &nft.MsgUpdateNFT{Updater: s.encodedAddrs[0], Nft: &updated})
+	s.Require().NoError(err)
+	actual, has := s.nftKeeper.GetNFT(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().Equal(updated, actual)
+
+	// a frozen class cannot be minted nor updated anymore
+	_, err = s.msgServer.FreezeClass(s.ctx, &nft.MsgFreezeClass{Creator: s.encodedAddrs[1], ClassId: testClassID})
+	s.Require().ErrorContains(err, "is not the creator of class")
+	_, err = s.msgServer.FreezeClass(s.ctx, &nft.MsgFreezeClass{Creator: s.encodedAddrs[0], ClassId: testClassID})
+	s.Require().NoError(err)
+	_, err = s.msgServer.UpdateNFT(s.ctx, &nft.MsgUpdateNFT{Updater: s.encodedAddrs[0], Nft: &updated})
+	s.Require().ErrorIs(err, nft.ErrClassFrozen)
+
+	genesis, err := s.nftKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(genesis.ClassConfigs, 1)
+	s.Require().True(genesis.ClassConfigs[0].Frozen)
+
+	// only the owner can burn
+	_, err = s.msgServer.Burn(s.ctx, &nft.MsgBurn{Owner: s.encodedAddrs[0], ClassId: testClassID, Id: testID})
+	s.Require().ErrorContains(err, "is not the owner of nft")
+	_, err = s.msgServer.Burn(s.ctx, &nft.MsgBurn{Owner: s.encodedAddrs[2], ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+	s.Require().False(s.nftKeeper.HasNFT(s.ctx, testClassID, testID))
+
+	// the classes not created with Msg/CreateClass cannot be used with the user messages
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "other"}))
+	_, err = s.msgServer.Mint(s.ctx, &nft.MsgMint{
+		Minter:   s.encodedAddrs[0],
+		Nft:      &nft.NFT{ClassId: "other", Id: testID},
+		Receiver: s.encodedAddrs[0],
+	})
+	s.Require().ErrorIs(err, nft.ErrNoClassConfig)
+}
+
+func (s *TestSuite) TestMintMaxSupplyAfterBurn() {
+	_, err := s.msgServer.CreateClass(s.ctx, &nft.MsgCreateClass{
+		Creator:   s.encodedAddrs[0],
+		Class:     &ExpClass,
+		MaxSupply: 1,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.Mint(s.ctx, &nft.MsgMint{Minter: s.encodedAddrs[0], Nft: &ExpNFT, Receiver: s.encodedAddrs[0]})
+	s.Require().NoError(err)
+	_, err = s.msgServer.Burn(s.ctx, &nft.MsgBurn{Owner: s.encodedAddrs[0], ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+	s.Require().Zero(s.nftKeeper.GetTotalSupply(s.ctx, testClassID))
+
+	// the burnt nft still counts towards the max supply
+	_, err = s.msgServer.Mint(s.ctx, &nft.MsgMint{
+		Minter:   s.encodedAddrs[0],
+		Nft:      &nft.NFT{ClassId: testClassID, Id: "kitty2"},
+		Receiver: s.encodedAddrs[0],
+	})
+	s.Require().ErrorIs(err, nft.ErrMaxSupply)
+
+	// and the counter is kept across genesis
+	genesis, err := s.nftKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(genesis.ClassConfigs, 1)
+	s.Require().Equal(uint64(1), genesis.ClassConfigs[0].Minted)
+}
+
+func (s *TestSuite) TestApprovals() {
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, ExpClass))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, ExpNFT, s.addrs[0]))
+
+	// only the owner or an approved operator can approve a spender
+	approveMsg := &nft.MsgApprove{Sender: s.encodedAddrs[1], ClassId: testClassID, Id: testID, Spender: s.encodedAddrs[2]}
+	_, err := s.msgServer.Approve(s.ctx, approveMsg)
+	s.Require().ErrorContains(err, "nor an approved operator")
+
+	approveMsg.Sender = s.encodedAddrs[0]
+	_, err = s.msgServer.Approve(s.ctx, approveMsg)
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.Approval(s.ctx, &nft.QueryApprovalRequest{ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+	s.Require().Equal(s.encodedAddrs[2], res.Approval.Spender)
+
+	// the approved spender can transfer the nft, which clears the approval
+	_, err = s.msgServer.Send(s.ctx, &nft.MsgSend{ClassId: testClassID, Id: testID, Sender: s.encodedAddrs[2], Receiver: s.encodedAddrs[1]})
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[1], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+	s.Require().Nil(s.nftKeeper.GetApproved(s.ctx, testClassID, testID))
+	_, err = s.msgServer.Send(s.ctx, &nft.MsgSend{ClassId: testClassID, Id: testID, Sender: s.encodedAddrs[2], Receiver: s.encodedAddrs[2]})
+	s.Require().ErrorContains(err, "nor approved to transfer it")
+
+	_, err = s.msgServer.Revoke(s.ctx, &nft.MsgRevoke{Sender: s.encodedAddrs[1], ClassId: testClassID, Id: testID})
+	s.Require().ErrorIs(err, nft.ErrApprovalNotExists)
+
+	// an operator can transfer and approve all the nfts of the owner until its approval expires
+	expiration := s.ctx.HeaderInfo().Time.Add(time.Hour)
+	_, err = s.msgServer.ApproveOperator(s.ctx, &nft.MsgApproveOperator{Owner: s.encodedAddrs[1], Operator: s.encodedAddrs[1]})
+	s.Require().ErrorContains(err, "cannot approve itself")
+	_, err = s.msgServer.ApproveOperator(s.ctx, &nft.MsgApproveOperator{Owner: s.encodedAddrs[1], Operator: s.encodedAddrs[2], Expiration: &expiration})
+	s.Require().NoError(err)
+
+	opRes, err := s.queryClient.OperatorApprovals(s.ctx, &nft.QueryOperatorApprovalsRequest{Owner: s.encodedAddrs[1]})
+	s.Require().NoError(err)
+	s.Require().Len(opRes.Approvals, 1)
+	s.Require().Equal(s.encodedAddrs[2], opRes.Approvals[0].Operator)
+
+	approveMsg = &nft.MsgApprove{Sender: s.encodedAddrs[2], ClassId: testClassID, Id: testID, Spender: s.encodedAddrs[0]}
+	_, err = s.msgServer.Approve(s.ctx, approveMsg)
+	s.Require().NoError(err)
+	_, err = s.msgServer.Revoke(s.ctx, &nft.MsgRevoke{Sender: s.encodedAddrs[2], ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+
+	expiredCtx := s.ctx.WithHeaderInfo(header.Info{Time: expiration})
+	_, err = s.msgServer.Send(expiredCtx, &nft.MsgSend{ClassId: testClassID, Id: testID, Sender: s.encodedAddrs[2], Receiver: s.encodedAddrs[2]})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = s.msgServer.Send(s.ctx, &nft.MsgSend{ClassId: testClassID, Id: testID, Sender: s.encodedAddrs[2], Receiver: s.encodedAddrs[2]})
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[2], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	genesis, err := s.nftKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(genesis.OperatorApprovals, 1)
+
+	_, err = s.msgServer.RevokeOperator(s.ctx, &nft.MsgRevokeOperator{Owner: s.encodedAddrs[1], Operator: s.encodedAddrs[2]})
+	s.Require().NoError(err)
+	s.Require().False(s.nftKeeper.IsApprovedOperator(s.ctx, s.addrs[1], s.addrs[2]))
+	_, err = s.msgServer.RevokeOperator(s.ctx, &nft.MsgRevokeOperator{Owner: s.encodedAddrs[1], Operator: s.encodedAddrs[2]})
+	s.Require().ErrorIs(err, nft.ErrApprovalNotExists)
+}
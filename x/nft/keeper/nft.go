@@ -72,6 +72,9 @@ func (k Keeper) burnWithNoCheck(ctx context.Context, classID, nftID string) erro
 
 	k.deleteOwner(ctx, classID, nftID, owner)
 	k.decrTotalSupply(ctx, classID)
+	if err := k.deleteApproval(ctx, classID, nftID); err != nil {
+		return err
+	}
 
 	ownerStr, err := k.ac.BytesToString(owner.Bytes())
 	if err != nil {
@@ -139,7 +142,7 @@ func (k Keeper) transferWithNoCheck(ctx context.Context,
 	owner := k.GetOwner(ctx, classID, nftID)
 	k.deleteOwner(ctx, classID, nftID, owner)
 	k.setOwner(ctx, classID, nftID, receiver)
-	return nil
+	return k.deleteApproval(ctx, classID, nftID)
 }
 
 // GetNFT returns the nft information of the specified classID and nftID
@@ -73,6 +73,34 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "class_id"},
 					},
 				},
+				{
+					RpcMethod: "ClassConfig",
+					Use:       "class-config <class-id>",
+					Short:     "Query the configuration of an NFT class created with create-class",
+					Example:   fmt.Sprintf(`%s query %s class-config <class-id>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+					},
+				},
+				{
+					RpcMethod: "Approval",
+					Use:       "approval <class-id> <nft-id>",
+					Short:     "Query the spender approved to transfer an NFT",
+					Example:   fmt.Sprintf(`%s query %s approval <class-id> <nft-id>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "OperatorApprovals",
+					Use:       "operator-approvals <owner>",
+					Short:     "Query the operators approved to transfer all the NFTs of an owner",
+					Example:   fmt.Sprintf(`%s query %s operator-approvals <owner>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "owner"},
+					},
+				},
 				{
 					RpcMethod: "Classes",
 					Use:       "classes",
@@ -95,6 +123,87 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					// Sender is the signer of the transaction and is automatically added as from flag by AutoCLI.
 				},
+				{
+					RpcMethod: "CreateClass",
+					Use:       "create-class <class> --from <creator>",
+					Short:     "Create a new NFT class, with optional minters, updaters, max supply and royalties",
+					Example:   fmt.Sprintf(`%s tx %s create-class '{"id":"kitty","name":"Crypto Kitty","uri":"class uri"}' --minters <minter> --max-supply 100 --from <creator>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class"},
+					},
+				},
+				{
+					RpcMethod: "Mint",
+					Use:       "mint <nft> <receiver> --from <minter>",
+					Short:     "Mint a new NFT of a class created with create-class",
+					Example:   fmt.Sprintf(`%s tx %s mint '{"class_id":"kitty","id":"kitty1","uri":"kitty uri"}' <receiver> --from <minter>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "nft"},
+						{ProtoField: "receiver"},
+					},
+				},
+				{
+					RpcMethod: "Burn",
+					Use:       "burn <class-id> <nft-id> --from <owner>",
+					Short:     "Burn an owned NFT of a class created with create-class",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "UpdateNFT",
+					Use:       "update-nft <nft> --from <updater>",
+					Short:     "Update the metadata of an NFT of a class created with create-class",
+					Example:   fmt.Sprintf(`%s tx %s update-nft '{"class_id":"kitty","id":"kitty1","uri":"new kitty uri"}' --from <updater>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "nft"},
+					},
+				},
+				{
+					RpcMethod: "FreezeClass",
+					Use:       "freeze-class <class-id> --from <creator>",
+					Short:     "Freeze an NFT class, no NFT of the class can be minted or updated anymore",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+					},
+				},
+				{
+					RpcMethod: "Approve",
+					Use:       "approve <class-id> <nft-id> <spender> --from <sender>",
+					Short:     "Approve a spender to transfer an NFT, as its owner or an approved operator of its owner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+						{ProtoField: "id"},
+						{ProtoField: "spender"},
+					},
+				},
+				{
+					RpcMethod: "Revoke",
+					Use:       "revoke <class-id> <nft-id> --from <sender>",
+					Short:     "Revoke the approval of an NFT",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "ApproveOperator",
+					Use:       "approve-operator <operator> --from <owner>",
+					Short:     "Approve an operator to transfer all the NFTs of the owner, with an optional expiration",
+					Example:   fmt.Sprintf(`%s tx %s approve-operator <operator> --expiration 2030-01-01T00:00:00Z --from <owner>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "operator"},
+					},
+				},
+				{
+					RpcMethod: "RevokeOperator",
+					Use:       "revoke-operator <operator> --from <owner>",
+					Short:     "Revoke the approval of an operator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "operator"},
+					},
+				},
 			},
 		},
 	}
@@ -66,7 +66,7 @@ func (AppModule) Name() string {
 // RegisterServices registers a gRPC query service to respond to the
 // module-specific gRPC queries.
 func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
-	nft.RegisterMsgServer(registrar, am.keeper)
+	nft.RegisterMsgServer(registrar, keeper.NewMsgServerImpl(am.keeper))
 	nft.RegisterQueryServer(registrar, am.keeper)
 	return nil
 }
@@ -133,4 +133,11 @@ func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
 
 func (am AppModule) WeightedOperationsX(weights simsx.WeightSource, reg simsx.Registry) {
 	reg.Add(weights.Get("msg_send", 100), simulation.MsgSendFactory(am.keeper))
+	reg.Add(weights.Get("msg_create_class", 20), simulation.MsgCreateClassFactory())
+	reg.Add(weights.Get("msg_mint", 60), simulation.MsgMintFactory(am.keeper))
+	reg.Add(weights.Get("msg_burn", 10), simulation.MsgBurnFactory(am.keeper))
+	reg.Add(weights.Get("msg_update_nft", 20), simulation.MsgUpdateNFTFactory(am.keeper))
+	reg.Add(weights.Get("msg_freeze_class", 5), simulation.MsgFreezeClassFactory(am.keeper))
+	reg.Add(weights.Get("msg_approve", 20), simulation.MsgApproveFactory(am.keeper))
+	reg.Add(weights.Get("msg_approve_operator", 10), simulation.MsgApproveOperatorFactory())
 }
@@ -5,17 +5,23 @@ package nft
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	any "github.com/cosmos/gogoproto/types/any"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -205,35 +211,305 @@ func (m *NFT) GetData() *any.Any {
 	return nil
 }
 
+// ClassConfig defines the configuration of a class created with Msg/CreateClass.
+type ClassConfig struct {
+	// class_id associated with the configuration
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// creator is the address of the account which created the class. It is always allowed to mint and update nfts
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// minters are the addresses of the accounts allowed to mint nfts of the class, besides the creator
+	Minters []string `protobuf:"bytes,3,rep,name=minters,proto3" json:"minters,omitempty"`
+	// updaters are the addresses of the accounts allowed to update nfts of the class, besides the creator
+	Updaters []string `protobuf:"bytes,4,rep,name=updaters,proto3" json:"updaters,omitempty"`
+	// max_supply is the maximum number of nfts of the class in existence. Zero means no limit
+	MaxSupply uint64 `protobuf:"varint,5,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	// royalty_receiver is the address of the account receiving the royalties of the class. Optional
+	RoyaltyReceiver string `protobuf:"bytes,6,opt,name=royalty_receiver,json=royaltyReceiver,proto3" json:"royalty_receiver,omitempty"`
+	// royalty_basis_points is the royalty rate of the class in basis points, from 0 to 10000.
+	// It is only metadata for marketplaces, x/nft does not collect royalties
+	RoyaltyBasisPoints uint32 `protobuf:"varint,7,opt,name=royalty_basis_points,json=royaltyBasisPoints,proto3" json:"royalty_basis_points,omitempty"`
+	// frozen defines whether the class is frozen. No nft of a frozen class can be minted or updated anymore
+	Frozen bool `protobuf:"varint,8,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	// minted is the number of nfts of the class minted so far, including the burnt ones. It is checked against max_supply
+	Minted uint64 `protobuf:"varint,9,opt,name=minted,proto3" json:"minted,omitempty"`
+}
+
+func (m *ClassConfig) Reset()         { *m = ClassConfig{} }
+func (m *ClassConfig) String() string { return proto.CompactTextString(m) }
+func (*ClassConfig) ProtoMessage()    {}
+func (*ClassConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{2}
+}
+func (m *ClassConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClassConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassConfig.Merge(m, src)
+}
+func (m *ClassConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassConfig proto.InternalMessageInfo
+
+func (m *ClassConfig) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *ClassConfig) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *ClassConfig) GetMinters() []string {
+	if m != nil {
+		return m.Minters
+	}
+	return nil
+}
+
+func (m *ClassConfig) GetUpdaters() []string {
+	if m != nil {
+		return m.Updaters
+	}
+	return nil
+}
+
+func (m *ClassConfig) GetMaxSupply() uint64 {
+	if m != nil {
+		return m.MaxSupply
+	}
+	return 0
+}
+
+func (m *ClassConfig) GetRoyaltyReceiver() string {
+	if m != nil {
+		return m.RoyaltyReceiver
+	}
+	return ""
+}
+
+func (m *ClassConfig) GetRoyaltyBasisPoints() uint32 {
+	if m != nil {
+		return m.RoyaltyBasisPoints
+	}
+	return 0
+}
+
+func (m *ClassConfig) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+func (m *ClassConfig) GetMinted() uint64 {
+	if m != nil {
+		return m.Minted
+	}
+	return 0
+}
+
+// Approval defines the approval of a spender to transfer a nft on behalf of its owner.
+// It is cleared when the nft is transferred.
+type Approval struct {
+	// class_id associated with the nft
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// id is a unique identifier of the nft
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// spender is the address of the account approved to transfer the nft
+	Spender string `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`
+}
+
+func (m *Approval) Reset()         { *m = Approval{} }
+func (m *Approval) String() string { return proto.CompactTextString(m) }
+func (*Approval) ProtoMessage()    {}
+func (*Approval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{3}
+}
+func (m *Approval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Approval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Approval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Approval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Approval.Merge(m, src)
+}
+func (m *Approval) XXX_Size() int {
+	return m.Size()
+}
+func (m *Approval) XXX_DiscardUnknown() {
+	xxx_messageInfo_Approval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Approval proto.InternalMessageInfo
+
+func (m *Approval) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *Approval) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Approval) GetSpender() string {
+	if m != nil {
+		return m.Spender
+	}
+	return ""
+}
+
+// OperatorApproval defines the approval of an operator to transfer all the nfts of an owner.
+type OperatorApproval struct {
+	// owner is the address of the owner of the nfts
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// operator is the address of the account approved to transfer the nfts of the owner
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// expiration is the time after which the approval is no longer valid. Optional
+	Expiration *time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *OperatorApproval) Reset()         { *m = OperatorApproval{} }
+func (m *OperatorApproval) String() string { return proto.CompactTextString(m) }
+func (*OperatorApproval) ProtoMessage()    {}
+func (*OperatorApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{4}
+}
+func (m *OperatorApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorApproval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorApproval.Merge(m, src)
+}
+func (m *OperatorApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorApproval proto.InternalMessageInfo
+
+func (m *OperatorApproval) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *OperatorApproval) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *OperatorApproval) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Class)(nil), "cosmos.nft.v1beta1.Class")
 	proto.RegisterType((*NFT)(nil), "cosmos.nft.v1beta1.NFT")
+	proto.RegisterType((*ClassConfig)(nil), "cosmos.nft.v1beta1.ClassConfig")
+	proto.RegisterType((*Approval)(nil), "cosmos.nft.v1beta1.Approval")
+	proto.RegisterType((*OperatorApproval)(nil), "cosmos.nft.v1beta1.OperatorApproval")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/nft.proto", fileDescriptor_eb8ebf8e8053172c) }
 
 var fileDescriptor_eb8ebf8e8053172c = []byte{
-	// 308 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
-	0x14, 0x85, 0x3b, 0x49, 0x9a, 0xfc, 0xff, 0x14, 0x44, 0x06, 0x91, 0xa9, 0xc8, 0x10, 0xba, 0xca,
-	0x42, 0x26, 0x54, 0x9f, 0x40, 0x05, 0xd1, 0x8d, 0x8b, 0xe2, 0xca, 0x4d, 0x99, 0x64, 0xd2, 0x66,
-	0x30, 0xc9, 0x94, 0x4c, 0x22, 0xe6, 0x09, 0xdc, 0xfa, 0x40, 0x3e, 0x80, 0xcb, 0x2e, 0x5d, 0x4a,
-	0xf2, 0x22, 0x32, 0x93, 0x58, 0x5c, 0x14, 0xdc, 0xdd, 0x7b, 0xce, 0xe1, 0xf2, 0x1d, 0x2e, 0x3c,
-	0x8d, 0xa5, 0xca, 0xa5, 0x0a, 0x8b, 0x55, 0x15, 0x3e, 0xcf, 0xa3, 0xa4, 0x62, 0x73, 0x3d, 0xd3,
-	0x4d, 0x29, 0x2b, 0x89, 0x50, 0xef, 0x52, 0xad, 0x0c, 0xee, 0xc9, 0x74, 0x2d, 0xe5, 0x3a, 0x4b,
-	0x42, 0x93, 0x88, 0xea, 0x55, 0xc8, 0x8a, 0xa6, 0x8f, 0xcf, 0xde, 0x01, 0x1c, 0x5f, 0x67, 0x4c,
-	0x29, 0x74, 0x00, 0x2d, 0xc1, 0x31, 0xf0, 0x41, 0xf0, 0x7f, 0x61, 0x09, 0x8e, 0x10, 0x74, 0x0a,
-	0x96, 0x27, 0xd8, 0x32, 0x8a, 0x99, 0xd1, 0x31, 0x74, 0x55, 0x93, 0x47, 0x32, 0xc3, 0xb6, 0x51,
-	0x87, 0x0d, 0xf9, 0x70, 0xc2, 0x13, 0x15, 0x97, 0x62, 0x53, 0x09, 0x59, 0x60, 0xc7, 0x98, 0xbf,
-	0x25, 0x74, 0x08, 0xed, 0xba, 0x14, 0x78, 0x6c, 0x1c, 0x3d, 0xa2, 0x29, 0xfc, 0x57, 0x97, 0x62,
-	0x99, 0x32, 0x95, 0x62, 0xd7, 0xc8, 0x5e, 0x5d, 0x8a, 0x5b, 0xa6, 0x52, 0x14, 0x40, 0x87, 0xb3,
-	0x8a, 0x61, 0xcf, 0x07, 0xc1, 0xe4, 0xfc, 0x88, 0xf6, 0xf8, 0xf4, 0x07, 0x9f, 0x5e, 0x16, 0xcd,
-	0xc2, 0x24, 0x66, 0xaf, 0x00, 0xda, 0xf7, 0x37, 0x0f, 0xfa, 0x58, 0xac, 0x5b, 0x2c, 0x77, 0x15,
-	0x3c, 0xb3, 0xdf, 0xf1, 0xa1, 0x97, 0xb5, 0xeb, 0x35, 0x90, 0xd8, 0xfb, 0x49, 0x9c, 0xfd, 0x24,
-	0xf0, 0x2f, 0x92, 0xab, 0xb3, 0x8f, 0x96, 0x80, 0x6d, 0x4b, 0xc0, 0x57, 0x4b, 0xc0, 0x5b, 0x47,
-	0x46, 0xdb, 0x8e, 0x8c, 0x3e, 0x3b, 0x32, 0x7a, 0x1c, 0x3e, 0xa2, 0xf8, 0x13, 0x15, 0x32, 0x7c,
-	0xd1, 0xbf, 0x8a, 0x5c, 0x73, 0xe1, 0xe2, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xf2, 0xf7, 0xce, 0x23,
-	0xcc, 0x01, 0x00, 0x00,
+	// 611 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0x13, 0x31,
+	0x10, 0xed, 0x66, 0xb7, 0x4d, 0x3a, 0x15, 0xa5, 0xb2, 0x22, 0xe4, 0x56, 0x90, 0x46, 0x3d, 0xe5,
+	0x40, 0x37, 0x6d, 0xe0, 0xc4, 0x89, 0xa6, 0x12, 0x82, 0x0b, 0xa0, 0x6d, 0x4f, 0x5c, 0x22, 0x27,
+	0xeb, 0xa4, 0x16, 0x59, 0xdb, 0xb2, 0xbd, 0x21, 0xcb, 0x0f, 0x70, 0xed, 0x07, 0xf0, 0x19, 0x3d,
+	0xf2, 0x01, 0x88, 0x53, 0xd5, 0x13, 0x37, 0x50, 0xfb, 0x23, 0xc8, 0x5e, 0x27, 0xaa, 0xda, 0x2a,
+	0x11, 0xb7, 0x99, 0x37, 0x6f, 0x66, 0xdf, 0xcb, 0x4c, 0x0c, 0x4f, 0x07, 0x42, 0x67, 0x42, 0xb7,
+	0xf9, 0xd0, 0xb4, 0x27, 0x87, 0x7d, 0x6a, 0xc8, 0xa1, 0x8d, 0x63, 0xa9, 0x84, 0x11, 0x08, 0x95,
+	0xd5, 0xd8, 0x22, 0xbe, 0xba, 0xb3, 0x3d, 0x12, 0x62, 0x34, 0xa6, 0x6d, 0xc7, 0xe8, 0xe7, 0xc3,
+	0x36, 0xe1, 0x45, 0x49, 0xdf, 0xd9, 0x2e, 0xe9, 0x3d, 0x97, 0xb5, 0x7d, 0x6f, 0x59, 0xaa, 0x8f,
+	0xc4, 0x48, 0x94, 0xb8, 0x8d, 0x3c, 0xba, 0x7b, 0x77, 0x96, 0x61, 0x19, 0xd5, 0x86, 0x64, 0xb2,
+	0x24, 0xec, 0xfd, 0x08, 0x60, 0xf5, 0x78, 0x4c, 0xb4, 0x46, 0x9b, 0x50, 0x61, 0x29, 0x0e, 0x9a,
+	0x41, 0x6b, 0x3d, 0xa9, 0xb0, 0x14, 0x21, 0x88, 0x38, 0xc9, 0x28, 0xae, 0x38, 0xc4, 0xc5, 0xe8,
+	0x09, 0xac, 0xe9, 0x22, 0xeb, 0x8b, 0x31, 0x0e, 0x1d, 0xea, 0x33, 0xd4, 0x84, 0x8d, 0x94, 0xea,
+	0x81, 0x62, 0xd2, 0x30, 0xc1, 0x71, 0xe4, 0x8a, 0xb7, 0x21, 0xb4, 0x05, 0x61, 0xae, 0x18, 0x5e,
+	0x75, 0x15, 0x1b, 0xa2, 0x6d, 0xa8, 0xe5, 0x8a, 0xf5, 0xce, 0x88, 0x3e, 0xc3, 0x6b, 0x0e, 0xae,
+	0xe6, 0x8a, 0xbd, 0x25, 0xfa, 0x0c, 0xb5, 0x20, 0x4a, 0x89, 0x21, 0xb8, 0xda, 0x0c, 0x5a, 0x1b,
+	0x9d, 0x7a, 0x5c, 0x9a, 0x88, 0x67, 0x26, 0xe2, 0x23, 0x5e, 0x24, 0x8e, 0xb1, 0xf7, 0x2d, 0x80,
+	0xf0, 0xfd, 0x9b, 0x53, 0x3b, 0x6c, 0x60, 0x5d, 0xf4, 0xe6, 0x16, 0xaa, 0x2e, 0x7f, 0x97, 0x7a,
+	0x5f, 0x95, 0xb9, 0x2f, 0xaf, 0x24, 0x7c, 0x58, 0x49, 0xf4, 0xb0, 0x12, 0x58, 0xaa, 0xe4, 0x7b,
+	0x08, 0x1b, 0xee, 0x87, 0x3c, 0x16, 0x7c, 0xc8, 0x46, 0x8b, 0x14, 0x75, 0xa0, 0x3a, 0x50, 0x94,
+	0x18, 0xa1, 0x4a, 0x59, 0x5d, 0x7c, 0x75, 0xb1, 0x5f, 0xf7, 0xdb, 0x3c, 0x4a, 0x53, 0x45, 0xb5,
+	0x3e, 0x31, 0x8a, 0xf1, 0x51, 0x32, 0x23, 0xda, 0x9e, 0x8c, 0x71, 0x43, 0x95, 0xc6, 0x61, 0x33,
+	0x5c, 0xdc, 0xe3, 0x89, 0xe8, 0x25, 0xd4, 0x72, 0x99, 0x12, 0xd7, 0x14, 0x2d, 0x69, 0x9a, 0x33,
+	0xd1, 0x33, 0x80, 0x8c, 0x4c, 0x7b, 0x3a, 0x97, 0x72, 0x5c, 0xb8, 0x85, 0x45, 0xc9, 0x7a, 0x46,
+	0xa6, 0x27, 0x0e, 0x40, 0xc7, 0xb0, 0xa5, 0x44, 0x41, 0xc6, 0xa6, 0xe8, 0x29, 0x3a, 0xa0, 0x6c,
+	0x42, 0x55, 0xb9, 0xbe, 0x05, 0xc3, 0x1f, 0xfb, 0x8e, 0xc4, 0x37, 0xa0, 0x03, 0xa8, 0xcf, 0x86,
+	0xf4, 0x89, 0x66, 0xba, 0x27, 0x05, 0xe3, 0x46, 0xbb, 0x85, 0x3f, 0x4a, 0x90, 0xaf, 0x75, 0x6d,
+	0xe9, 0xa3, 0xab, 0xd8, 0xcb, 0x1b, 0x2a, 0xf1, 0x95, 0x72, 0x5c, 0x6b, 0x06, 0xad, 0x5a, 0xe2,
+	0x33, 0x8b, 0x3b, 0xbb, 0x29, 0x5e, 0x77, 0x4a, 0x7d, 0xf6, 0x6a, 0xf3, 0xea, 0x62, 0x1f, 0xf8,
+	0xd0, 0x34, 0x27, 0x07, 0x71, 0x27, 0x3e, 0xd8, 0x2b, 0xa0, 0x76, 0x24, 0xa5, 0x12, 0x13, 0x32,
+	0xfe, 0x9f, 0x63, 0xe9, 0x40, 0x55, 0x4b, 0xca, 0x53, 0xaa, 0x70, 0xb8, 0xc4, 0xe4, 0x8c, 0x78,
+	0xef, 0xd3, 0xbf, 0x02, 0xd8, 0xfa, 0x20, 0xa9, 0xb2, 0x7b, 0x9c, 0x6b, 0x88, 0x61, 0x55, 0x7c,
+	0xe1, 0x54, 0xe1, 0x60, 0xc9, 0xd8, 0x92, 0x66, 0x77, 0x29, 0xfc, 0x8c, 0xa5, 0x47, 0x33, 0x67,
+	0xa2, 0xd7, 0x00, 0x74, 0x2a, 0x99, 0x22, 0xee, 0x6f, 0x19, 0xba, 0x23, 0xde, 0xb9, 0x77, 0xc4,
+	0xa7, 0xb3, 0x37, 0xa1, 0x1b, 0x9d, 0xff, 0xd9, 0x0d, 0x92, 0x5b, 0x3d, 0x77, 0xcd, 0x74, 0x9f,
+	0xff, 0xbc, 0x6e, 0x04, 0x97, 0xd7, 0x8d, 0xe0, 0xef, 0x75, 0x23, 0x38, 0xbf, 0x69, 0xac, 0x5c,
+	0xde, 0x34, 0x56, 0x7e, 0xdf, 0x34, 0x56, 0x3e, 0xf9, 0xa7, 0x4c, 0xa7, 0x9f, 0x63, 0x26, 0xda,
+	0x53, 0xfb, 0xc8, 0xf5, 0xd7, 0xdc, 0x37, 0x5e, 0xfc, 0x1b, 0x00, 0x1c, 0xd0, 0xc4, 0x85, 0x05,
+	0x05, 0x00, 0x00,
 }
 
 func (m *Class) Marshal() (dAtA []byte, err error) {
@@ -376,6 +652,184 @@ func (m *NFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClassConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Minted != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.Minted))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RoyaltyBasisPoints != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.RoyaltyBasisPoints))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RoyaltyReceiver) > 0 {
+		i -= len(m.RoyaltyReceiver)
+		copy(dAtA[i:], m.RoyaltyReceiver)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.RoyaltyReceiver)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaxSupply != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.MaxSupply))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Updaters) > 0 {
+		for iNdEx := len(m.Updaters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Updaters[iNdEx])
+			copy(dAtA[i:], m.Updaters[iNdEx])
+			i = encodeVarintNft(dAtA, i, uint64(len(m.Updaters[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Minters[iNdEx])
+			copy(dAtA[i:], m.Minters[iNdEx])
+			i = encodeVarintNft(dAtA, i, uint64(len(m.Minters[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Approval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Approval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Approval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatorApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintNft(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintNft(dAtA []byte, offset int, v uint64) int {
 	offset -= sovNft(v)
 	base := offset
@@ -421,45 +875,624 @@ func (m *Class) Size() (n int) {
 		l = m.Data.Size()
 		n += 1 + l + sovNft(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *NFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.UriHash)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovNft(uint64(l))
+	}
+	return n
+}
+
+func (m *ClassConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if len(m.Minters) > 0 {
+		for _, s := range m.Minters {
+			l = len(s)
+			n += 1 + l + sovNft(uint64(l))
+		}
+	}
+	if len(m.Updaters) > 0 {
+		for _, s := range m.Updaters {
+			l = len(s)
+			n += 1 + l + sovNft(uint64(l))
+		}
+	}
+	if m.MaxSupply != 0 {
+		n += 1 + sovNft(uint64(m.MaxSupply))
+	}
+	l = len(m.RoyaltyReceiver)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.RoyaltyBasisPoints != 0 {
+		n += 1 + sovNft(uint64(m.RoyaltyBasisPoints))
+	}
+	if m.Frozen {
+		n += 2
+	}
+	if m.Minted != 0 {
+		n += 1 + sovNft(uint64(m.Minted))
+	}
+	return n
+}
+
+func (m *Approval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	return n
+}
+
+func (m *OperatorApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovNft(uint64(l))
+	}
+	return n
+}
+
+func sovNft(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNft(x uint64) (n int) {
+	return sovNft(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Class) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Class: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Class: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UriHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UriHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &any.Any{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UriHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UriHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &any.Any{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *NFT) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovNft(uint64(l))
-	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovNft(uint64(l))
-	}
-	l = len(m.Uri)
-	if l > 0 {
-		n += 1 + l + sovNft(uint64(l))
-	}
-	l = len(m.UriHash)
-	if l > 0 {
-		n += 1 + l + sovNft(uint64(l))
-	}
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovNft(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovNft(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozNft(x uint64) (n int) {
-	return sovNft(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Class) Unmarshal(dAtA []byte) error {
+func (m *ClassConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -482,15 +1515,15 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Class: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClassConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Class: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClassConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -518,11 +1551,11 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -550,11 +1583,11 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -582,11 +1615,11 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Minters = append(m.Minters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Updaters", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -614,13 +1647,13 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Updaters = append(m.Updaters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
 			}
-			var stringLen uint64
+			m.MaxSupply = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNft
@@ -630,27 +1663,14 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxSupply |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNft
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNft
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Uri = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UriHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyReceiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -678,13 +1698,13 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UriHash = string(dAtA[iNdEx:postIndex])
+			m.RoyaltyReceiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyBasisPoints", wireType)
 			}
-			var msglen int
+			m.RoyaltyBasisPoints = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNft
@@ -694,28 +1714,50 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RoyaltyBasisPoints |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthNft
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthNft
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Data == nil {
-				m.Data = &any.Any{}
+			m.Frozen = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
 			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Minted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Minted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNft(dAtA[iNdEx:])
@@ -737,7 +1779,7 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NFT) Unmarshal(dAtA []byte) error {
+func (m *Approval) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -760,10 +1802,10 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: Approval: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Approval: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -832,7 +1874,7 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -860,11 +1902,61 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uri = string(dAtA[iNdEx:postIndex])
+			m.Spender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorApproval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorApproval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorApproval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UriHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -892,11 +1984,43 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UriHash = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -923,10 +2047,10 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = &any.Any{}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
 			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1224,6 +1224,309 @@ func (m *QueryClassesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryClassConfigRequest is the request type for the Query/ClassConfig RPC method
+type QueryClassConfigRequest struct {
+	// class_id associated with the nft
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+}
+
+func (m *QueryClassConfigRequest) Reset()         { *m = QueryClassConfigRequest{} }
+func (m *QueryClassConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClassConfigRequest) ProtoMessage()    {}
+func (*QueryClassConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{24}
+}
+func (m *QueryClassConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClassConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClassConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClassConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClassConfigRequest.Merge(m, src)
+}
+func (m *QueryClassConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClassConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClassConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClassConfigRequest proto.InternalMessageInfo
+
+func (m *QueryClassConfigRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+// QueryClassConfigResponse is the response type for the Query/ClassConfig RPC method
+type QueryClassConfigResponse struct {
+	// config defines the configuration of the class.
+	Config *ClassConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (m *QueryClassConfigResponse) Reset()         { *m = QueryClassConfigResponse{} }
+func (m *QueryClassConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClassConfigResponse) ProtoMessage()    {}
+func (*QueryClassConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{25}
+}
+func (m *QueryClassConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClassConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClassConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClassConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClassConfigResponse.Merge(m, src)
+}
+func (m *QueryClassConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClassConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClassConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClassConfigResponse proto.InternalMessageInfo
+
+func (m *QueryClassConfigResponse) GetConfig() *ClassConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+// QueryApprovalRequest is the request type for the Query/Approval RPC method
+type QueryApprovalRequest struct {
+	// class_id associated with the nft
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// id is a unique identifier of the NFT
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryApprovalRequest) Reset()         { *m = QueryApprovalRequest{} }
+func (m *QueryApprovalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryApprovalRequest) ProtoMessage()    {}
+func (*QueryApprovalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{26}
+}
+func (m *QueryApprovalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryApprovalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryApprovalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryApprovalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryApprovalRequest.Merge(m, src)
+}
+func (m *QueryApprovalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryApprovalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryApprovalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryApprovalRequest proto.InternalMessageInfo
+
+func (m *QueryApprovalRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *QueryApprovalRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// QueryApprovalResponse is the response type for the Query/Approval RPC method
+type QueryApprovalResponse struct {
+	// approval defines the approval of the nft.
+	Approval *Approval `protobuf:"bytes,1,opt,name=approval,proto3" json:"approval,omitempty"`
+}
+
+func (m *QueryApprovalResponse) Reset()         { *m = QueryApprovalResponse{} }
+func (m *QueryApprovalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryApprovalResponse) ProtoMessage()    {}
+func (*QueryApprovalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{27}
+}
+func (m *QueryApprovalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryApprovalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryApprovalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryApprovalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryApprovalResponse.Merge(m, src)
+}
+func (m *QueryApprovalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryApprovalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryApprovalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryApprovalResponse proto.InternalMessageInfo
+
+func (m *QueryApprovalResponse) GetApproval() *Approval {
+	if m != nil {
+		return m.Approval
+	}
+	return nil
+}
+
+// QueryOperatorApprovalsRequest is the request type for the Query/OperatorApprovals RPC method
+type QueryOperatorApprovalsRequest struct {
+	// owner is the owner address of the nfts
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOperatorApprovalsRequest) Reset()         { *m = QueryOperatorApprovalsRequest{} }
+func (m *QueryOperatorApprovalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorApprovalsRequest) ProtoMessage()    {}
+func (*QueryOperatorApprovalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{28}
+}
+func (m *QueryOperatorApprovalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorApprovalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorApprovalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOperatorApprovalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorApprovalsRequest.Merge(m, src)
+}
+func (m *QueryOperatorApprovalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorApprovalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorApprovalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOperatorApprovalsRequest proto.InternalMessageInfo
+
+func (m *QueryOperatorApprovalsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryOperatorApprovalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryOperatorApprovalsResponse is the response type for the Query/OperatorApprovals RPC method
+type QueryOperatorApprovalsResponse struct {
+	// approvals defines the operator approvals of the owner.
+	Approvals []*OperatorApproval `protobuf:"bytes,1,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOperatorApprovalsResponse) Reset()         { *m = QueryOperatorApprovalsResponse{} }
+func (m *QueryOperatorApprovalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorApprovalsResponse) ProtoMessage()    {}
+func (*QueryOperatorApprovalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{29}
+}
+func (m *QueryOperatorApprovalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorApprovalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorApprovalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOperatorApprovalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorApprovalsResponse.Merge(m, src)
+}
+func (m *QueryOperatorApprovalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorApprovalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorApprovalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOperatorApprovalsResponse proto.InternalMessageInfo
+
+func (m *QueryOperatorApprovalsResponse) GetApprovals() []*OperatorApproval {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *QueryOperatorApprovalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.nft.v1beta1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceByQueryStringRequest)(nil), "cosmos.nft.v1beta1.QueryBalanceByQueryStringRequest")
@@ -1249,74 +1552,95 @@ func init() {
 	proto.RegisterType((*QueryClassByQueryStringResponse)(nil), "cosmos.nft.v1beta1.QueryClassByQueryStringResponse")
 	proto.RegisterType((*QueryClassesRequest)(nil), "cosmos.nft.v1beta1.QueryClassesRequest")
 	proto.RegisterType((*QueryClassesResponse)(nil), "cosmos.nft.v1beta1.QueryClassesResponse")
+	proto.RegisterType((*QueryClassConfigRequest)(nil), "cosmos.nft.v1beta1.QueryClassConfigRequest")
+	proto.RegisterType((*QueryClassConfigResponse)(nil), "cosmos.nft.v1beta1.QueryClassConfigResponse")
+	proto.RegisterType((*QueryApprovalRequest)(nil), "cosmos.nft.v1beta1.QueryApprovalRequest")
+	proto.RegisterType((*QueryApprovalResponse)(nil), "cosmos.nft.v1beta1.QueryApprovalResponse")
+	proto.RegisterType((*QueryOperatorApprovalsRequest)(nil), "cosmos.nft.v1beta1.QueryOperatorApprovalsRequest")
+	proto.RegisterType((*QueryOperatorApprovalsResponse)(nil), "cosmos.nft.v1beta1.QueryOperatorApprovalsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/query.proto", fileDescriptor_0d24e0db697b0f9d) }
 
 var fileDescriptor_0d24e0db697b0f9d = []byte{
-	// 987 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x41, 0x6f, 0xdc, 0x54,
-	0x10, 0xce, 0xdb, 0x64, 0x13, 0x98, 0x4a, 0xa1, 0x4c, 0x23, 0xba, 0xeb, 0xa6, 0x66, 0x71, 0x9b,
-	0x64, 0x9b, 0x12, 0x3b, 0x9b, 0x14, 0x0e, 0x08, 0x38, 0x04, 0xb1, 0x15, 0x14, 0x05, 0xd8, 0xe6,
-	0x42, 0x11, 0xaa, 0xbc, 0x59, 0xef, 0xca, 0x22, 0xb5, 0xb7, 0xb1, 0xb7, 0x10, 0x45, 0x3d, 0xd0,
-	0x03, 0xa2, 0xe2, 0x82, 0x44, 0x7f, 0x03, 0x47, 0x0e, 0x08, 0xc1, 0x5f, 0x40, 0x9c, 0x2a, 0xb8,
-	0x70, 0x44, 0x09, 0x3f, 0x04, 0xf9, 0xbd, 0xf1, 0xc6, 0x8e, 0x9f, 0x9f, 0xeb, 0x6d, 0x8e, 0x6f,
-	0xdf, 0xcc, 0x7c, 0xdf, 0xcc, 0x9b, 0x99, 0xcf, 0x0b, 0xfa, 0xae, 0x1f, 0xdc, 0xf3, 0x03, 0xcb,
-	0xeb, 0x87, 0xd6, 0x83, 0x56, 0xd7, 0x09, 0xed, 0x96, 0x75, 0x7f, 0xe4, 0xec, 0x1f, 0x98, 0xc3,
-	0x7d, 0x3f, 0xf4, 0x11, 0xc5, 0xbd, 0xe9, 0xf5, 0x43, 0x93, 0xee, 0xb5, 0x55, 0xf2, 0xe9, 0xda,
-	0x81, 0x23, 0x8c, 0xc7, 0xae, 0x43, 0x7b, 0xe0, 0x7a, 0x76, 0xe8, 0xfa, 0x9e, 0xf0, 0xd7, 0x16,
-	0x07, 0xbe, 0x3f, 0xd8, 0x73, 0x2c, 0x7b, 0xe8, 0x5a, 0xb6, 0xe7, 0xf9, 0x21, 0xbf, 0x0c, 0xe2,
-	0x5b, 0x09, 0x7a, 0x84, 0x24, 0x6e, 0xeb, 0xe2, 0xf6, 0x2e, 0x3f, 0x59, 0x44, 0x84, 0x1f, 0x8c,
-	0x36, 0x5c, 0xf8, 0x34, 0x02, 0xde, 0xb2, 0xf7, 0x6c, 0x6f, 0xd7, 0xe9, 0x38, 0xf7, 0x47, 0x4e,
-	0x10, 0x62, 0x1d, 0x5e, 0xd8, 0xdd, 0xb3, 0x83, 0xe0, 0xae, 0xdb, 0xab, 0xb1, 0x06, 0x6b, 0xbe,
-	0xd8, 0x99, 0xe3, 0xe7, 0x0f, 0x7a, 0xb8, 0x00, 0x55, 0xff, 0x2b, 0xcf, 0xd9, 0xaf, 0x55, 0xf8,
-	0xef, 0xe2, 0x60, 0xec, 0x42, 0x23, 0x19, 0x67, 0xeb, 0x80, 0x9f, 0x6e, 0x87, 0xfb, 0xae, 0x37,
-	0x98, 0x34, 0xe8, 0x5b, 0xf3, 0x7f, 0xfd, 0xba, 0x06, 0x5e, 0x3f, 0x6c, 0x3c, 0x58, 0x37, 0x5b,
-	0x66, 0xcb, 0x30, 0x61, 0x21, 0x4d, 0x36, 0x18, 0xfa, 0x5e, 0xe0, 0xe0, 0x2b, 0x30, 0x6b, 0xdf,
-	0xf3, 0x47, 0x5e, 0xc8, 0xc3, 0xce, 0x74, 0xe8, 0x64, 0xdc, 0x82, 0xd7, 0x14, 0xa4, 0xd4, 0xce,
-	0x19, 0xf0, 0x77, 0xe1, 0x65, 0xee, 0xfe, 0x71, 0x44, 0xed, 0x19, 0x52, 0x9a, 0x87, 0x8a, 0xdb,
-	0xa3, 0x7c, 0x2a, 0x6e, 0xcf, 0xf8, 0x1c, 0xf4, 0x13, 0xff, 0xb2, 0xf5, 0x39, 0x15, 0x2c, 0x43,
-	0x6e, 0x15, 0x30, 0x49, 0x8e, 0x52, 0x1b, 0x57, 0x95, 0x25, 0x9f, 0xea, 0x26, 0xbc, 0x9a, 0x4b,
-	0x44, 0xe5, 0x98, 0x01, 0xb5, 0x08, 0xf4, 0xf6, 0x68, 0x38, 0xdc, 0x3b, 0x28, 0xce, 0xc2, 0xf8,
-	0x88, 0x90, 0x85, 0x43, 0xc9, 0x1a, 0x64, 0xe0, 0xd7, 0xa8, 0x75, 0x63, 0xf8, 0x82, 0x66, 0xf8,
-	0x90, 0x3a, 0x54, 0x0a, 0x5e, 0xb2, 0x17, 0xbe, 0x67, 0x70, 0x9e, 0xfb, 0x6f, 0xb7, 0x77, 0x82,
-	0x49, 0xdb, 0x1b, 0xdb, 0x00, 0x27, 0x63, 0x5e, 0x9b, 0x6e, 0xb0, 0xe6, 0xb9, 0x8d, 0x65, 0x93,
-	0xc6, 0x33, 0xda, 0x09, 0xa6, 0x58, 0x20, 0x34, 0xd0, 0xe6, 0x27, 0xf6, 0x20, 0x1e, 0xd0, 0x4e,
-	0xc2, 0xd3, 0x78, 0xcc, 0xa8, 0x35, 0x05, 0x1b, 0xca, 0xe5, 0x3a, 0xcc, 0x78, 0xfd, 0x30, 0xa8,
-	0xb1, 0xc6, 0x74, 0xf3, 0xdc, 0xc6, 0x45, 0x33, 0xbb, 0x7f, 0xcc, 0xed, 0xf6, 0x4e, 0x87, 0x1b,
-	0xe1, 0xcd, 0x14, 0x95, 0x0a, 0xa7, 0xb2, 0x52, 0x48, 0x45, 0x20, 0xa5, 0xb8, 0xbc, 0x0d, 0x2f,
-	0xc5, 0x54, 0x26, 0x98, 0x91, 0xcf, 0x60, 0x31, 0xf6, 0x3e, 0xeb, 0x09, 0x79, 0xe7, 0xe4, 0xc5,
-	0xc6, 0x25, 0xba, 0x06, 0xd3, 0x5e, 0x5f, 0xbc, 0xb5, 0xa2, 0x42, 0x91, 0x8d, 0x71, 0x07, 0x2e,
-	0xe7, 0x30, 0x2b, 0x1d, 0x4b, 0xb2, 0xd6, 0xc4, 0xf3, 0xbd, 0x17, 0xa5, 0xf2, 0x0c, 0x63, 0x74,
-	0x8b, 0x36, 0x09, 0xb7, 0x7f, 0xde, 0x29, 0x7a, 0x9f, 0x86, 0x98, 0xc0, 0x29, 0x1b, 0x0b, 0xaa,
-	0xdc, 0x81, 0xf2, 0xa9, 0xcb, 0xf2, 0x11, 0x1e, 0xc2, 0xce, 0xe8, 0xd2, 0x68, 0xcb, 0x38, 0x4d,
-	0x18, 0x33, 0x43, 0xf5, 0x0b, 0x1a, 0x78, 0x6e, 0xe4, 0x8c, 0x2b, 0x95, 0x1e, 0x23, 0x36, 0xf1,
-	0x18, 0x3d, 0x61, 0x24, 0x2f, 0xe3, 0xf8, 0x44, 0x7c, 0x13, 0x44, 0xf5, 0x9c, 0x78, 0x98, 0x14,
-	0xd4, 0x63, 0xcb, 0x33, 0x9b, 0xa8, 0x8d, 0xdf, 0xe7, 0xa1, 0xca, 0x69, 0xe1, 0x13, 0x06, 0x73,
-	0x24, 0x65, 0xb8, 0x22, 0xa3, 0x20, 0x51, 0x72, 0xad, 0x59, 0x6c, 0x28, 0x40, 0x8d, 0x37, 0x1f,
-	0xfd, 0xfd, 0xdf, 0x8f, 0x95, 0x75, 0x34, 0x2d, 0xc9, 0xc7, 0x44, 0x57, 0x18, 0x5b, 0x87, 0x7c,
-	0x75, 0x3d, 0xb4, 0x0e, 0xe3, 0xfe, 0x7a, 0x88, 0xbf, 0x31, 0x58, 0x90, 0x29, 0x2c, 0xde, 0x28,
-	0x82, 0x96, 0xf5, 0xae, 0xf6, 0x46, 0x49, 0x2f, 0x62, 0xdf, 0xfa, 0x33, 0xd5, 0x2c, 0x3c, 0x97,
-	0xcb, 0x78, 0x49, 0x91, 0x0b, 0x3e, 0x66, 0x50, 0xe5, 0x22, 0x88, 0x4b, 0xb9, 0x98, 0x49, 0xb5,
-	0xd7, 0x96, 0x8b, 0xcc, 0x62, 0x2e, 0x1c, 0xfd, 0x3a, 0x5e, 0x93, 0xa1, 0xf3, 0x02, 0x26, 0xea,
-	0x67, 0x1d, 0x46, 0x45, 0xfc, 0x99, 0x01, 0x66, 0x05, 0x19, 0x37, 0xd4, 0x88, 0xd2, 0x02, 0x6e,
-	0x96, 0xf2, 0x21, 0xca, 0x96, 0xa4, 0x7c, 0x97, 0xb0, 0x9e, 0x9b, 0x00, 0x7e, 0xc7, 0x60, 0x56,
-	0x48, 0x29, 0xe6, 0x97, 0x25, 0xf5, 0x65, 0xa0, 0xad, 0x14, 0xda, 0x11, 0x99, 0x35, 0x0e, 0xbf,
-	0x82, 0x4b, 0x32, 0xf8, 0x80, 0xdb, 0x26, 0x1b, 0xf0, 0x17, 0x06, 0x17, 0x24, 0xaa, 0x8e, 0x9b,
-	0x05, 0x78, 0xd2, 0xea, 0xdd, 0x28, 0xe7, 0x44, 0x8c, 0xd7, 0x25, 0xe5, 0x5b, 0x44, 0x2d, 0x9f,
-	0x3f, 0x8e, 0x60, 0x26, 0x92, 0x6b, 0xbc, 0x9a, 0x8b, 0x97, 0xf8, 0xb6, 0xd0, 0x96, 0x0a, 0xac,
-	0x88, 0x46, 0x83, 0x03, 0x6b, 0x58, 0xb3, 0xe4, 0xff, 0x07, 0x02, 0x7c, 0xc4, 0x60, 0x7a, 0xbb,
-	0xbd, 0x83, 0x57, 0x54, 0x01, 0x63, 0xd4, 0xab, 0x6a, 0xa3, 0x38, 0x77, 0x0e, 0xba, 0x8a, 0xcd,
-	0x3c, 0xd0, 0x4c, 0xb3, 0xff, 0xc4, 0xe0, 0xfc, 0x69, 0x21, 0xc5, 0x75, 0x15, 0x98, 0xf4, 0xa9,
-	0x5a, 0x25, 0x3c, 0xe2, 0xce, 0x92, 0xbc, 0x53, 0x1d, 0x2f, 0xe6, 0x30, 0xc7, 0x6f, 0x19, 0x54,
-	0xf9, 0x5e, 0x57, 0x6c, 0x88, 0xa4, 0x6a, 0x2b, 0x36, 0x44, 0x4a, 0x5f, 0x0d, 0x93, 0x23, 0x37,
-	0x71, 0x59, 0x86, 0x4c, 0x12, 0x92, 0x6c, 0xf1, 0x68, 0x3d, 0x64, 0xa5, 0x55, 0xb1, 0x1e, 0x72,
-	0xbf, 0x0d, 0x14, 0xeb, 0x21, 0x5f, 0xbb, 0xcb, 0xac, 0x07, 0x4e, 0x1a, 0xbf, 0x61, 0x30, 0x47,
-	0x3a, 0xaa, 0xd0, 0xaa, 0xb4, 0x92, 0x2b, 0xb4, 0xea, 0x94, 0x24, 0x1b, 0x57, 0x54, 0xfb, 0x9d,
-	0xea, 0xb7, 0xf5, 0xfa, 0x1f, 0x47, 0x3a, 0x7b, 0x7a, 0xa4, 0xb3, 0x7f, 0x8f, 0x74, 0xf6, 0xc3,
-	0xb1, 0x3e, 0xf5, 0xf4, 0x58, 0x9f, 0xfa, 0xe7, 0x58, 0x9f, 0xba, 0x43, 0x7f, 0xc6, 0x83, 0xde,
-	0x97, 0xa6, 0xeb, 0x5b, 0x5f, 0x47, 0xde, 0xdd, 0x59, 0xfe, 0x87, 0x78, 0xf3, 0xff, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x2e, 0x12, 0xdc, 0xfc, 0xc9, 0x0f, 0x00, 0x00,
+	// 1228 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x6f, 0x1b, 0x55,
+	0x10, 0xef, 0x73, 0x9a, 0x8f, 0x4e, 0xa4, 0xd2, 0x4c, 0x02, 0x71, 0xb6, 0xa9, 0x63, 0xb6, 0xf9,
+	0x70, 0xbe, 0x76, 0x63, 0xa7, 0x7c, 0x08, 0x01, 0x52, 0x9d, 0x92, 0x0a, 0x8a, 0x52, 0xea, 0xe6,
+	0x42, 0x11, 0x8a, 0x36, 0xf6, 0x3a, 0xb2, 0x48, 0x77, 0x5d, 0xef, 0x26, 0x10, 0x45, 0x3d, 0xd0,
+	0x03, 0xa2, 0xe2, 0x82, 0x44, 0xff, 0x04, 0x04, 0x17, 0xc4, 0x01, 0x55, 0xdc, 0x90, 0x38, 0xa2,
+	0x9e, 0xa2, 0x72, 0xe1, 0x88, 0x12, 0xfe, 0x10, 0xb4, 0x6f, 0x67, 0xed, 0x5d, 0xef, 0xdb, 0xdd,
+	0xac, 0x93, 0xe3, 0xf3, 0x9b, 0x99, 0xdf, 0x6f, 0xe6, 0xcd, 0xbc, 0xf7, 0x5b, 0x43, 0xae, 0x6a,
+	0x5a, 0x0f, 0x4d, 0x4b, 0x35, 0xea, 0xb6, 0xba, 0x5f, 0xdc, 0xd6, 0x6d, 0xad, 0xa8, 0x3e, 0xda,
+	0xd3, 0x5b, 0x07, 0x4a, 0xb3, 0x65, 0xda, 0x26, 0xa2, 0xbb, 0xaf, 0x18, 0x75, 0x5b, 0xa1, 0x7d,
+	0x69, 0x81, 0x7c, 0xb6, 0x35, 0x4b, 0x77, 0x8d, 0xdb, 0xae, 0x4d, 0x6d, 0xa7, 0x61, 0x68, 0x76,
+	0xc3, 0x34, 0x5c, 0x7f, 0x69, 0x72, 0xc7, 0x34, 0x77, 0x76, 0x75, 0x55, 0x6b, 0x36, 0x54, 0xcd,
+	0x30, 0x4c, 0x9b, 0x6f, 0x5a, 0xde, 0xae, 0x00, 0xdd, 0x41, 0x72, 0x77, 0x27, 0xdc, 0xdd, 0x2d,
+	0xbe, 0x52, 0xdd, 0x85, 0xbb, 0x25, 0xaf, 0xc3, 0xe8, 0x3d, 0x07, 0xb8, 0xac, 0xed, 0x6a, 0x46,
+	0x55, 0xaf, 0xe8, 0x8f, 0xf6, 0x74, 0xcb, 0xc6, 0x09, 0x18, 0xaa, 0xee, 0x6a, 0x96, 0xb5, 0xd5,
+	0xa8, 0x65, 0x59, 0x9e, 0x15, 0x2e, 0x55, 0x06, 0xf9, 0xfa, 0xc3, 0x1a, 0x8e, 0x41, 0xbf, 0xf9,
+	0xa5, 0xa1, 0xb7, 0xb2, 0x19, 0xfe, 0xbb, 0xbb, 0x90, 0xab, 0x90, 0xf7, 0xc7, 0x29, 0x1f, 0xf0,
+	0xd5, 0x7d, 0xbb, 0xd5, 0x30, 0x76, 0x7a, 0x0d, 0xfa, 0xce, 0xe5, 0x97, 0xcf, 0x97, 0xc1, 0xa8,
+	0xdb, 0xf9, 0xfd, 0x15, 0xa5, 0xa8, 0x14, 0x65, 0x05, 0xc6, 0x82, 0x64, 0xad, 0xa6, 0x69, 0x58,
+	0x3a, 0xbe, 0x06, 0x03, 0xda, 0x43, 0x73, 0xcf, 0xb0, 0x79, 0xd8, 0x8b, 0x15, 0x5a, 0xc9, 0x77,
+	0xe0, 0xf5, 0x18, 0x52, 0xf1, 0xce, 0x21, 0xf0, 0xf7, 0x61, 0x84, 0xbb, 0xdf, 0x75, 0xa8, 0x9d,
+	0x22, 0xa5, 0xcb, 0x90, 0x69, 0xd4, 0x28, 0x9f, 0x4c, 0xa3, 0x26, 0x7f, 0x06, 0xb9, 0x8e, 0x7f,
+	0xda, 0xfa, 0x74, 0x05, 0x0b, 0x91, 0x5b, 0x00, 0xf4, 0x93, 0xa3, 0xd4, 0xda, 0x55, 0x65, 0xfe,
+	0xa3, 0xba, 0x0d, 0x53, 0x91, 0x44, 0xe2, 0x1c, 0x43, 0xa0, 0x2a, 0x81, 0xde, 0xdf, 0x6b, 0x36,
+	0x77, 0x0f, 0x92, 0xb3, 0x90, 0x3f, 0x86, 0x29, 0x9f, 0x43, 0xca, 0x1a, 0x84, 0xe0, 0x97, 0x61,
+	0xd4, 0x17, 0x2d, 0xb1, 0x19, 0x3e, 0x82, 0xbc, 0xcf, 0xfc, 0x6c, 0xbd, 0xf0, 0x1d, 0x83, 0x2b,
+	0xdc, 0x7f, 0x63, 0x7d, 0xd3, 0xea, 0xb5, 0xbd, 0x71, 0x1d, 0xa0, 0x33, 0xe6, 0xd9, 0xbe, 0x3c,
+	0x2b, 0x0c, 0x97, 0x66, 0x15, 0x1a, 0x4f, 0xe7, 0x4e, 0x50, 0xdc, 0x0b, 0x84, 0x06, 0x5a, 0xf9,
+	0x44, 0xdb, 0xf1, 0x06, 0xb4, 0xe2, 0xf3, 0x94, 0x9f, 0x32, 0x18, 0xf1, 0xb1, 0xa1, 0x5c, 0x16,
+	0xe1, 0xa2, 0x51, 0xb7, 0xad, 0x2c, 0xcb, 0xf7, 0x15, 0x86, 0x4b, 0xe3, 0x4a, 0xf8, 0xfe, 0x51,
+	0x36, 0xd6, 0x37, 0x2b, 0xdc, 0x08, 0x6f, 0x07, 0xa8, 0x64, 0x38, 0x95, 0xb9, 0x44, 0x2a, 0x2e,
+	0x52, 0x80, 0xcb, 0xbb, 0xf0, 0x8a, 0x47, 0xa5, 0x87, 0x19, 0xf9, 0x14, 0x26, 0x3d, 0xef, 0xf3,
+	0x9e, 0x90, 0xf7, 0x3a, 0x27, 0xd6, 0x2e, 0xd1, 0x3c, 0xf4, 0x19, 0x75, 0xf7, 0xac, 0x63, 0x2a,
+	0xe4, 0xd8, 0xc8, 0x0f, 0xe0, 0x5a, 0x04, 0xb3, 0xd4, 0xb1, 0x04, 0xd7, 0x9a, 0x7b, 0x7c, 0x6b,
+	0x4e, 0x2a, 0xa7, 0x18, 0xa3, 0x3b, 0x90, 0xeb, 0xd8, 0x9f, 0x75, 0x8a, 0x3e, 0x00, 0xec, 0x04,
+	0x6b, 0x67, 0xa3, 0x42, 0x3f, 0x77, 0xa0, 0x7c, 0x26, 0x44, 0xf9, 0xb8, 0x1e, 0xae, 0x9d, 0xbc,
+	0x4d, 0xa3, 0x2d, 0xe2, 0xd4, 0x63, 0xcc, 0x10, 0xd5, 0xcf, 0x69, 0xe0, 0xb9, 0x91, 0xde, 0xae,
+	0x54, 0x70, 0x8c, 0x58, 0xcf, 0x63, 0xf4, 0x8c, 0xc1, 0x58, 0x30, 0x3e, 0x11, 0x5f, 0x05, 0xb7,
+	0x7a, 0xba, 0x37, 0x4c, 0x31, 0xd4, 0x3d, 0xcb, 0xf3, 0x9b, 0xa8, 0x5b, 0x30, 0xde, 0x61, 0xb5,
+	0x66, 0x1a, 0xf5, 0x46, 0xda, 0x63, 0x2e, 0x29, 0x2b, 0x72, 0x15, 0xb2, 0xe1, 0x28, 0x94, 0xdf,
+	0x5b, 0x30, 0x50, 0xe5, 0xbf, 0x50, 0xf1, 0xa6, 0x22, 0xd3, 0x23, 0x47, 0x32, 0x0f, 0x81, 0xdc,
+	0xa3, 0x02, 0xde, 0x6c, 0x36, 0x5b, 0xe6, 0xbe, 0xb6, 0x7b, 0xc6, 0xb1, 0x75, 0x42, 0x6a, 0xf0,
+	0x6a, 0x57, 0x48, 0x22, 0xfd, 0x36, 0x0c, 0x69, 0xf4, 0x1b, 0xd1, 0x9e, 0x14, 0xd1, 0x6e, 0xfb,
+	0xb5, 0xad, 0x43, 0x10, 0x3f, 0x33, 0x9a, 0xed, 0xbb, 0x4d, 0xbd, 0xa5, 0xd9, 0x66, 0xcb, 0xf3,
+	0x69, 0x77, 0x98, 0x12, 0x78, 0x0e, 0xcb, 0xd9, 0x97, 0xcf, 0x97, 0xc7, 0x08, 0xeb, 0x66, 0xad,
+	0xd6, 0xd2, 0x2d, 0x8b, 0x5a, 0x5d, 0x78, 0xb1, 0x67, 0x7a, 0xed, 0xc8, 0x10, 0xd3, 0x3f, 0x18,
+	0xe4, 0xa2, 0x98, 0x52, 0x59, 0xca, 0x70, 0xc9, 0x4b, 0xd4, 0xeb, 0xd6, 0x69, 0x51, 0x5d, 0xba,
+	0x23, 0x54, 0x3a, 0x6e, 0xe7, 0xd6, 0xba, 0xdd, 0xfc, 0x4b, 0x47, 0x08, 0xfd, 0x9c, 0x3f, 0x3e,
+	0x63, 0x30, 0x48, 0xaa, 0x0c, 0xe7, 0x44, 0xfc, 0x04, 0xa2, 0x54, 0x2a, 0x24, 0x1b, 0xba, 0x24,
+	0xe4, 0x37, 0x9f, 0xfc, 0xfd, 0xdf, 0x0f, 0x99, 0x15, 0x54, 0x54, 0x81, 0x2e, 0xde, 0x76, 0x8d,
+	0xd5, 0x43, 0x7e, 0x58, 0x8f, 0xd5, 0x43, 0xaf, 0x37, 0x1f, 0xe3, 0xef, 0x0c, 0xc6, 0x44, 0x62,
+	0x11, 0x6f, 0x24, 0x41, 0x8b, 0xae, 0x61, 0xe9, 0x8d, 0x94, 0x5e, 0xc4, 0xbe, 0xf8, 0x22, 0x70,
+	0xef, 0xf1, 0x5c, 0xae, 0xe1, 0xd5, 0x98, 0x5c, 0xf0, 0x29, 0x83, 0x7e, 0xae, 0xe7, 0x70, 0x26,
+	0x12, 0xd3, 0x2f, 0x5c, 0xa5, 0xd9, 0x24, 0x33, 0x8f, 0x0b, 0x47, 0x5f, 0xc4, 0x79, 0x11, 0x3a,
+	0x2f, 0xa0, 0xaf, 0x7e, 0xea, 0xa1, 0x53, 0xc4, 0x5f, 0x19, 0x60, 0x58, 0x5b, 0x62, 0x29, 0x1e,
+	0x51, 0x58, 0xc0, 0xd5, 0x54, 0x3e, 0x44, 0x59, 0x15, 0x94, 0xef, 0x2a, 0x4e, 0x44, 0x26, 0x80,
+	0xdf, 0x32, 0x18, 0x70, 0x55, 0x21, 0x46, 0x97, 0x25, 0x20, 0x72, 0xa5, 0xb9, 0x44, 0x3b, 0x22,
+	0xb3, 0xcc, 0xe1, 0xe7, 0x70, 0x46, 0x04, 0x6f, 0x71, 0x5b, 0x7f, 0x03, 0xfe, 0xc6, 0x60, 0x54,
+	0x20, 0x50, 0x71, 0x35, 0x01, 0x4f, 0x58, 0xbd, 0x1b, 0xe9, 0x9c, 0x88, 0xf1, 0x8a, 0xa0, 0x7c,
+	0x93, 0x28, 0x45, 0xf3, 0xc7, 0x3d, 0xb8, 0xe8, 0x28, 0x4f, 0x9c, 0x8e, 0xc4, 0xf3, 0xc9, 0x64,
+	0x69, 0x26, 0xc1, 0x8a, 0x68, 0xe4, 0x39, 0xb0, 0x84, 0x59, 0x55, 0xfc, 0x69, 0x6b, 0xe1, 0x13,
+	0x06, 0x7d, 0x1b, 0xeb, 0x9b, 0x78, 0x3d, 0x2e, 0xa0, 0x87, 0x3a, 0x1d, 0x6f, 0xe4, 0xe5, 0xce,
+	0x41, 0x17, 0xb0, 0x10, 0x05, 0x1a, 0x6a, 0xf6, 0x9f, 0x18, 0x5c, 0xe9, 0xd6, 0x84, 0xb8, 0x12,
+	0x07, 0x26, 0x3c, 0xaa, 0x62, 0x0a, 0x0f, 0xaf, 0xb3, 0x04, 0xe7, 0x34, 0x81, 0xe3, 0x11, 0xcc,
+	0xf1, 0x1b, 0x06, 0xfd, 0xfc, 0x0d, 0x8f, 0xb9, 0x21, 0xfc, 0x02, 0x54, 0x9a, 0x4d, 0x32, 0x23,
+	0x1e, 0x0a, 0x47, 0x2e, 0xe0, 0xac, 0x08, 0x99, 0xd4, 0x90, 0xbf, 0xc5, 0x9d, 0xeb, 0x21, 0xac,
+	0x12, 0x63, 0xae, 0x87, 0x48, 0x99, 0x2b, 0xad, 0xa6, 0xf2, 0x49, 0x7f, 0x3d, 0x70, 0xd2, 0xf8,
+	0x0b, 0x83, 0x61, 0x9f, 0xfa, 0xc1, 0xc5, 0x78, 0xd4, 0x80, 0x44, 0x93, 0x96, 0x4e, 0x67, 0x4c,
+	0xdc, 0xca, 0x2f, 0x02, 0x0f, 0x26, 0xe7, 0xa6, 0xe0, 0x52, 0x24, 0xb7, 0x2d, 0x57, 0x88, 0x05,
+	0xea, 0xfb, 0x23, 0x83, 0x21, 0xef, 0x55, 0xc7, 0xe8, 0x27, 0xb3, 0x4b, 0xa3, 0x49, 0xf3, 0xa7,
+	0xb0, 0x24, 0x96, 0x6b, 0x02, 0x96, 0x2a, 0x2e, 0x8b, 0x58, 0xb6, 0x45, 0x45, 0x68, 0x70, 0xfe,
+	0x64, 0x30, 0x12, 0x92, 0x31, 0x18, 0x3d, 0x07, 0x51, 0xe2, 0x4c, 0x2a, 0xa5, 0x71, 0xa1, 0x0c,
+	0x6e, 0x09, 0x32, 0x88, 0x50, 0x0b, 0x26, 0x05, 0xd9, 0xf2, 0xa5, 0xe2, 0x0a, 0x07, 0xfc, 0x9a,
+	0xc1, 0x20, 0x7d, 0x2b, 0xc4, 0x88, 0x98, 0xe0, 0xd7, 0x8a, 0x54, 0x48, 0x36, 0x24, 0x92, 0xd7,
+	0xe3, 0x1e, 0x7e, 0x1a, 0xac, 0xf2, 0xd2, 0x5f, 0xc7, 0x39, 0x76, 0x74, 0x9c, 0x63, 0xff, 0x1e,
+	0xe7, 0xd8, 0xf7, 0x27, 0xb9, 0x0b, 0x47, 0x27, 0xb9, 0x0b, 0xff, 0x9c, 0xe4, 0x2e, 0x3c, 0xa0,
+	0x3f, 0x1c, 0xad, 0xda, 0x17, 0x4a, 0xc3, 0x54, 0xbf, 0x72, 0xbc, 0xb7, 0x07, 0xf8, 0x9f, 0x7e,
+	0xab, 0xff, 0x0f, 0x00, 0xbd, 0x7c, 0x06, 0xc4, 0xad, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1354,6 +1678,12 @@ type QueryClient interface {
 	Class(ctx context.Context, in *QueryClassRequest, opts ...grpc.CallOption) (*QueryClassResponse, error)
 	// Class queries an NFT class based on its id
 	ClassByQueryString(ctx context.Context, in *QueryClassByQueryStringRequest, opts ...grpc.CallOption) (*QueryClassByQueryStringResponse, error)
+	// ClassConfig queries the configuration of an NFT class created with Msg/CreateClass
+	ClassConfig(ctx context.Context, in *QueryClassConfigRequest, opts ...grpc.CallOption) (*QueryClassConfigResponse, error)
+	// Approval queries the spender approved to transfer an NFT
+	Approval(ctx context.Context, in *QueryApprovalRequest, opts ...grpc.CallOption) (*QueryApprovalResponse, error)
+	// OperatorApprovals queries the operators approved to transfer all the NFTs of an owner
+	OperatorApprovals(ctx context.Context, in *QueryOperatorApprovalsRequest, opts ...grpc.CallOption) (*QueryOperatorApprovalsResponse, error)
 	// Classes queries all NFT classes
 	Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error)
 }
@@ -1465,6 +1795,33 @@ func (c *queryClient) ClassByQueryString(ctx context.Context, in *QueryClassByQu
 	return out, nil
 }
 
+func (c *queryClient) ClassConfig(ctx context.Context, in *QueryClassConfigRequest, opts ...grpc.CallOption) (*QueryClassConfigResponse, error) {
+	out := new(QueryClassConfigResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Query/ClassConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Approval(ctx context.Context, in *QueryApprovalRequest, opts ...grpc.CallOption) (*QueryApprovalResponse, error) {
+	out := new(QueryApprovalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Query/Approval", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OperatorApprovals(ctx context.Context, in *QueryOperatorApprovalsRequest, opts ...grpc.CallOption) (*QueryOperatorApprovalsResponse, error) {
+	out := new(QueryOperatorApprovalsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Query/OperatorApprovals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error) {
 	out := new(QueryClassesResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Query/Classes", in, out, opts...)
@@ -1499,6 +1856,12 @@ type QueryServer interface {
 	Class(context.Context, *QueryClassRequest) (*QueryClassResponse, error)
 	// Class queries an NFT class based on its id
 	ClassByQueryString(context.Context, *QueryClassByQueryStringRequest) (*QueryClassByQueryStringResponse, error)
+	// ClassConfig queries the configuration of an NFT class created with Msg/CreateClass
+	ClassConfig(context.Context, *QueryClassConfigRequest) (*QueryClassConfigResponse, error)
+	// Approval queries the spender approved to transfer an NFT
+	Approval(context.Context, *QueryApprovalRequest) (*QueryApprovalResponse, error)
+	// OperatorApprovals queries the operators approved to transfer all the NFTs of an owner
+	OperatorApprovals(context.Context, *QueryOperatorApprovalsRequest) (*QueryOperatorApprovalsResponse, error)
 	// Classes queries all NFT classes
 	Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error)
 }
@@ -1540,6 +1903,15 @@ func (*UnimplementedQueryServer) Class(ctx context.Context, req *QueryClassReque
 func (*UnimplementedQueryServer) ClassByQueryString(ctx context.Context, req *QueryClassByQueryStringRequest) (*QueryClassByQueryStringResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClassByQueryString not implemented")
 }
+func (*UnimplementedQueryServer) ClassConfig(ctx context.Context, req *QueryClassConfigRequest) (*QueryClassConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassConfig not implemented")
+}
+func (*UnimplementedQueryServer) Approval(ctx context.Context, req *QueryApprovalRequest) (*QueryApprovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Approval not implemented")
+}
+func (*UnimplementedQueryServer) OperatorApprovals(ctx context.Context, req *QueryOperatorApprovalsRequest) (*QueryOperatorApprovalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorApprovals not implemented")
+}
 func (*UnimplementedQueryServer) Classes(ctx context.Context, req *QueryClassesRequest) (*QueryClassesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Classes not implemented")
 }
@@ -1746,6 +2118,60 @@ func _Query_ClassByQueryString_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClassConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Query/ClassConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassConfig(ctx, req.(*QueryClassConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Approval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Approval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Query/Approval",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Approval(ctx, req.(*QueryApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OperatorApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorApprovalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OperatorApprovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Query/OperatorApprovals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperatorApprovals(ctx, req.(*QueryOperatorApprovalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Classes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryClassesRequest)
 	if err := dec(in); err != nil {
@@ -1813,6 +2239,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClassByQueryString",
 			Handler:    _Query_ClassByQueryString_Handler,
 		},
+		{
+			MethodName: "ClassConfig",
+			Handler:    _Query_ClassConfig_Handler,
+		},
+		{
+			MethodName: "Approval",
+			Handler:    _Query_Approval_Handler,
+		},
+		{
+			MethodName: "OperatorApprovals",
+			Handler:    _Query_OperatorApprovals_Handler,
+		},
 		{
 			MethodName: "Classes",
 			Handler:    _Query_Classes_Handler,
@@ -2658,9 +3096,237 @@ func (m *QueryClassesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
+func (m *QueryClassConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClassConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClassConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClassConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClassConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClassConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryApprovalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryApprovalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryApprovalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryApprovalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryApprovalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryApprovalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Approval != nil {
+		{
+			size, err := m.Approval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorApprovalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorApprovalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorApprovalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorApprovalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorApprovalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorApprovalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Approvals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
@@ -3021,39 +3687,131 @@ func (m *QueryClassesResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryClassConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryClassConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+
+func (m *QueryApprovalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryApprovalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Approval != nil {
+		l = m.Approval.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorApprovalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorApprovalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Approvals) > 0 {
+		for _, e := range m.Approvals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4420,9 +5178,541 @@ func (m *QueryNFTRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNFTByQueryStringRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNFTByQueryStringRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNFTByQueryStringRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNFTResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNFTResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nft", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Nft == nil {
+				m.Nft = &NFT{}
+			}
+			if err := m.Nft.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNFTByQueryStringResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNFTByQueryStringResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNFTByQueryStringResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nft", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Nft == nil {
+				m.Nft = &NFT{}
+			}
+			if err := m.Nft.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClassRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClassByQueryStringRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassByQueryStringRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassByQueryStringRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClassResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4432,23 +5722,27 @@ func (m *QueryNFTRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if m.Class == nil {
+				m.Class = &Class{}
+			}
+			if err := m.Class.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4471,7 +5765,7 @@ func (m *QueryNFTRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNFTByQueryStringRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClassByQueryStringResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4494,17 +5788,17 @@ func (m *QueryNFTByQueryStringRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNFTByQueryStringRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClassByQueryStringResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNFTByQueryStringRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClassByQueryStringResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4514,55 +5808,27 @@ func (m *QueryNFTByQueryStringRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if m.Class == nil {
+				m.Class = &Class{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Class.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4585,7 +5851,7 @@ func (m *QueryNFTByQueryStringRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNFTResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClassesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4608,15 +5874,15 @@ func (m *QueryNFTResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNFTResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClassesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClassesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nft", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4643,10 +5909,10 @@ func (m *QueryNFTResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Nft == nil {
-				m.Nft = &NFT{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Nft.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4671,7 +5937,7 @@ func (m *QueryNFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNFTByQueryStringResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClassesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4694,15 +5960,15 @@ func (m *QueryNFTByQueryStringResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNFTByQueryStringResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClassesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNFTByQueryStringResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClassesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nft", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Classes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4729,10 +5995,44 @@ func (m *QueryNFTByQueryStringResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Nft == nil {
-				m.Nft = &NFT{}
+			m.Classes = append(m.Classes, &Class{})
+			if err := m.Classes[len(m.Classes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Nft.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4757,7 +6057,7 @@ func (m *QueryNFTByQueryStringResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClassConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4780,10 +6080,10 @@ func (m *QueryClassRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClassConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClassConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4839,7 +6139,7 @@ func (m *QueryClassRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassByQueryStringRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClassConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4862,17 +6162,17 @@ func (m *QueryClassByQueryStringRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassByQueryStringRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClassConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassByQueryStringRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClassConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4882,23 +6182,27 @@ func (m *QueryClassByQueryStringRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassId = string(dAtA[iNdEx:postIndex])
+			if m.Config == nil {
+				m.Config = &ClassConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4921,7 +6225,7 @@ func (m *QueryClassByQueryStringRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryApprovalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4944,17 +6248,17 @@ func (m *QueryClassResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryApprovalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryApprovalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4964,27 +6268,55 @@ func (m *QueryClassResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Class == nil {
-				m.Class = &Class{}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			if err := m.Class.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5007,7 +6339,7 @@ func (m *QueryClassResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassByQueryStringResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryApprovalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5030,15 +6362,15 @@ func (m *QueryClassByQueryStringResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassByQueryStringResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryApprovalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassByQueryStringResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryApprovalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Approval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5065,10 +6397,10 @@ func (m *QueryClassByQueryStringResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Class == nil {
-				m.Class = &Class{}
+			if m.Approval == nil {
+				m.Approval = &Approval{}
 			}
-			if err := m.Class.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Approval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5093,7 +6425,7 @@ func (m *QueryClassByQueryStringResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOperatorApprovalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5116,13 +6448,45 @@ func (m *QueryClassesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperatorApprovalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperatorApprovalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -5179,7 +6543,7 @@ func (m *QueryClassesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOperatorApprovalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5202,15 +6566,15 @@ func (m *QueryClassesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperatorApprovalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperatorApprovalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Classes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5237,8 +6601,8 @@ func (m *QueryClassesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Classes = append(m.Classes, &Class{})
-			if err := m.Classes[len(m.Classes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Approvals = append(m.Approvals, &OperatorApproval{})
+			if err := m.Approvals[len(m.Approvals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -39,6 +39,21 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			supplyA = sdk.BigEndianToUint64(kvA.Value)
 			supplyB = sdk.BigEndianToUint64(kvB.Value)
 			return fmt.Sprintf("%v\n%v", supplyA, supplyB)
+		case bytes.Equal(kvA.Key[:1], keeper.ClassConfigKey):
+			var configA, configB nft.ClassConfig
+			cdc.MustUnmarshal(kvA.Value, &configA)
+			cdc.MustUnmarshal(kvB.Value, &configB)
+			return fmt.Sprintf("%v\n%v", configA, configB)
+		case bytes.Equal(kvA.Key[:1], keeper.ApprovalKey):
+			var spenderA, spenderB sdk.AccAddress
+			spenderA = sdk.AccAddress(kvA.Value)
+			spenderB = sdk.AccAddress(kvB.Value)
+			return fmt.Sprintf("%v\n%v", spenderA, spenderB)
+		case bytes.Equal(kvA.Key[:1], keeper.OperatorApprovalKey):
+			var approvalA, approvalB nft.OperatorApproval
+			cdc.MustUnmarshal(kvA.Value, &approvalA)
+			cdc.MustUnmarshal(kvB.Value, &approvalB)
+			return fmt.Sprintf("%v\n%v", approvalA, approvalB)
 		default:
 			panic(fmt.Sprintf("invalid nft key %X", kvA.Key))
 		}
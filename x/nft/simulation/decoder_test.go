@@ -21,6 +21,8 @@ import (
 var (
 	ownerPk1   = ed25519.GenPrivKey().PubKey()
 	ownerAddr1 = sdk.AccAddress(ownerPk1.Address())
+	ownerPk2   = ed25519.GenPrivKey().PubKey()
+	ownerAddr2 = sdk.AccAddress(ownerPk2.Address())
 )
 
 func TestDecodeStore(t *testing.T) {
@@ -37,6 +39,21 @@ func TestDecodeStore(t *testing.T) {
 	classBz, err := encCfg.Codec.Marshal(&class)
 	require.NoError(t, err)
 
+	config := nft.ClassConfig{
+		ClassId:   "ClassID",
+		Creator:   ownerAddr1.String(),
+		MaxSupply: 10,
+	}
+	configBz, err := encCfg.Codec.Marshal(&config)
+	require.NoError(t, err)
+
+	operatorApproval := nft.OperatorApproval{
+		Owner:    ownerAddr1.String(),
+		Operator: ownerAddr2.String(),
+	}
+	operatorApprovalBz, err := encCfg.Codec.Marshal(&operatorApproval)
+	require.NoError(t, err)
+
 	nft := nft.NFT{
 		ClassId: "ClassID",
 		Id:      "NFTID",
@@ -57,6 +74,9 @@ func TestDecodeStore(t *testing.T) {
 			{Key: keeper.NFTOfClassByOwnerKey, Value: nftOfClassByOwnerValue},
 			{Key: keeper.OwnerKey, Value: ownerAddr1},
 			{Key: keeper.ClassTotalSupply, Value: totalSupplyBz},
+			{Key: keeper.ClassConfigKey, Value: configBz},
+			{Key: keeper.ApprovalKey, Value: ownerAddr2},
+			{Key: keeper.OperatorApprovalKey, Value: operatorApprovalBz},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -71,6 +91,9 @@ func TestDecodeStore(t *testing.T) {
 		{"NFTOfClassByOwnerKey", false, fmt.Sprintf("%v\n%v", nftOfClassByOwnerValue, nftOfClassByOwnerValue)},
 		{"OwnerKey", false, fmt.Sprintf("%v\n%v", ownerAddr1, ownerAddr1)},
 		{"ClassTotalSupply", false, fmt.Sprintf("%v\n%v", totalSupply, totalSupply)},
+		{"ClassConfig", false, fmt.Sprintf("%v\n%v", config, config)},
+		{"Approval", false, fmt.Sprintf("%v\n%v", ownerAddr2, ownerAddr2)},
+		{"OperatorApproval", false, fmt.Sprintf("%v\n%v", operatorApproval, operatorApproval)},
 		{"other", true, ""},
 	}
 
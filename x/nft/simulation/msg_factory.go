@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"context"
+	"errors"
 
 	"cosmossdk.io/x/nft"
 	"cosmossdk.io/x/nft/keeper"
@@ -31,6 +32,189 @@ func MsgSendFactory(k keeper.Keeper) simsx.SimMsgFactoryFn[*nft.MsgSend] {
 	}
 }
 
+func MsgCreateClassFactory() simsx.SimMsgFactoryFn[*nft.MsgCreateClass] {
+	return func(ctx context.Context, testData *simsx.ChainDataSource, reporter simsx.SimulationReporter) ([]simsx.SimAccount, *nft.MsgCreateClass) {
+		r := testData.Rand()
+		creator := testData.AnyAccount(reporter, simsx.WithSpendableBalance())
+		minter := testData.AnyAccount(reporter, simsx.ExcludeAccounts(creator))
+
+		msg := &nft.MsgCreateClass{
+			Creator: creator.AddressBech32,
+			Class: &nft.Class{
+				Id:     r.StringN(10),
+				Name:   r.StringN(10),
+				Symbol: r.StringN(10),
+				Uri:    r.StringN(10),
+			},
+			Minters:            []string{minter.AddressBech32},
+			Updaters:           []string{minter.AddressBech32},
+			MaxSupply:          r.Uint64InRange(0, 100),
+			RoyaltyReceiver:    creator.AddressBech32,
+			RoyaltyBasisPoints: r.Uint32InRange(0, keeper.MaxRoyaltyBasisPoints),
+		}
+
+		return []simsx.SimAccount{creator}, msg
+	}
+}
+
+func MsgMintFactory(k keeper.Keeper) simsx.SimMsgFactoryFn[*nft.MsgMint] {
+	return func(ctx context.Context, testData *simsx.ChainDataSource, reporter simsx.SimulationReporter) ([]simsx.SimAccount, *nft.MsgMint) {
+		r := testData.Rand()
+		config, err := randMutableClassConfig(ctx, r, k)
+		if err != nil {
+			reporter.Skip(err.Error())
+			return nil, nil
+		}
+
+		if config.MaxSupply > 0 && k.GetTotalSupply(ctx, config.ClassId) >= config.MaxSupply {
+			reporter.Skip("class max supply reached")
+			return nil, nil
+		}
+
+		minter := testData.GetAccount(reporter, config.Creator)
+		receiver := testData.AnyAccount(reporter)
+		msg := &nft.MsgMint{
+			Minter: minter.AddressBech32,
+			Nft: &nft.NFT{
+				ClassId: config.ClassId,
+				Id:      r.StringN(10),
+				Uri:     r.StringN(10),
+			},
+			Receiver: receiver.AddressBech32,
+		}
+
+		return []simsx.SimAccount{minter}, msg
+	}
+}
+
+func MsgBurnFactory(k keeper.Keeper) simsx.SimMsgFactoryFn[*nft.MsgBurn] {
+	return func(ctx context.Context, testData *simsx.ChainDataSource, reporter simsx.SimulationReporter) ([]simsx.SimAccount, *nft.MsgBurn) {
+		r := testData.Rand()
+		n, err := randConfiguredNFT(ctx, r, k)
+		if err != nil {
+			reporter.Skip(err.Error())
+			return nil, nil
+		}
+
+		owner := testData.GetAccountbyAccAddr(reporter, k.GetOwner(ctx, n.ClassId, n.Id))
+		msg := &nft.MsgBurn{
+			Owner:   owner.AddressBech32,
+			ClassId: n.ClassId,
+			Id:      n.Id,
+		}
+
+		return []simsx.SimAccount{owner}, msg
+	}
+}
+
+func MsgUpdateNFTFactory(k keeper.Keeper) simsx.SimMsgFactoryFn[*nft.MsgUpdateNFT] {
+	return func(ctx context.Context, testData *simsx.ChainDataSource, reporter simsx.SimulationReporter) ([]simsx.SimAccount, *nft.MsgUpdateNFT) {
+		r := testData.Rand()
+		n, err := randConfiguredNFT(ctx, r, k)
+		if err != nil {
+			reporter.Skip(err.Error())
+			return nil, nil
+		}
+
+		config, _ := k.GetClassConfig(ctx, n.ClassId)
+		if config.Frozen {
+			reporter.Skip("class is frozen")
+			return nil, nil
+		}
+
+		updater := testData.GetAccount(reporter, config.Creator)
+		n.Uri = r.StringN(10)
+		msg := &nft.MsgUpdateNFT{
+			Updater: updater.AddressBech32,
+			Nft:     &n,
+		}
+
+		return []simsx.SimAccount{updater}, msg
+	}
+}
+
+func MsgFreezeClassFactory(k keeper.Keeper) simsx.SimMsgFactoryFn[*nft.MsgFreezeClass] {
+	return func(ctx context.Context, testData *simsx.ChainDataSource, reporter simsx.SimulationReporter) ([]simsx.SimAccount, *nft.MsgFreezeClass) {
+		config, err := randMutableClassConfig(ctx, testData.Rand(), k)
+		if err != nil {
+			reporter.Skip(err.Error())
+			return nil, nil
+		}
+
+		creator := testData.GetAccount(reporter, config.Creator)
+		msg := &nft.MsgFreezeClass{
+			Creator: creator.AddressBech32,
+			ClassId: config.ClassId,
+		}
+
+		return []simsx.SimAccount{creator}, msg
+	}
+}
+
+func MsgApproveFactory(k keeper.Keeper) simsx.SimMsgFactoryFn[*nft.MsgApprove] {
+	return func(ctx context.Context, testData *simsx.ChainDataSource, reporter simsx.SimulationReporter) ([]simsx.SimAccount, *nft.MsgApprove) {
+		owner := testData.AnyAccount(reporter, simsx.WithSpendableBalance())
+		spender := testData.AnyAccount(reporter, simsx.ExcludeAccounts(owner))
+
+		n, err := randNFT(ctx, testData.Rand(), k, owner.Address)
+		if err != nil {
+			reporter.Skip(err.Error())
+			return nil, nil
+		}
+		msg := &nft.MsgApprove{
+			Sender:  owner.AddressBech32,
+			ClassId: n.ClassId,
+			Id:      n.Id,
+			Spender: spender.AddressBech32,
+		}
+
+		return []simsx.SimAccount{owner}, msg
+	}
+}
+
+func MsgApproveOperatorFactory() simsx.SimMsgFactoryFn[*nft.MsgApproveOperator] {
+	return func(ctx context.Context, testData *simsx.ChainDataSource, reporter simsx.SimulationReporter) ([]simsx.SimAccount, *nft.MsgApproveOperator) {
+		owner := testData.AnyAccount(reporter, simsx.WithSpendableBalance())
+		operator := testData.AnyAccount(reporter, simsx.ExcludeAccounts(owner))
+
+		msg := &nft.MsgApproveOperator{
+			Owner:    owner.AddressBech32,
+			Operator: operator.AddressBech32,
+		}
+
+		return []simsx.SimAccount{owner}, msg
+	}
+}
+
+// randMutableClassConfig picks a random configuration of a class created with Msg/CreateClass which is not frozen.
+func randMutableClassConfig(ctx context.Context, r *simsx.XRand, k keeper.Keeper) (nft.ClassConfig, error) {
+	var configs []*nft.ClassConfig
+	for _, config := range k.GetClassConfigs(ctx) {
+		if !config.Frozen {
+			configs = append(configs, config)
+		}
+	}
+
+	if len(configs) == 0 {
+		return nft.ClassConfig{}, errors.New("no mutable class config")
+	}
+	return *configs[r.Intn(len(configs))], nil
+}
+
+// randConfiguredNFT picks a random NFT of a class created with Msg/CreateClass.
+func randConfiguredNFT(ctx context.Context, r *simsx.XRand, k keeper.Keeper) (nft.NFT, error) {
+	configs := k.GetClassConfigs(ctx)
+	if len(configs) == 0 {
+		return nft.NFT{}, errors.New("no class config")
+	}
+
+	ns := k.GetNFTsOfClass(ctx, configs[r.Intn(len(configs))].ClassId)
+	if len(ns) == 0 {
+		return nft.NFT{}, errors.New("no nft of class")
+	}
+	return ns[r.Intn(len(ns))], nil
+}
+
 // randNFT picks a random NFT from a class belonging to the specified owner(minter).
 func randNFT(ctx context.Context, r *simsx.XRand, k keeper.Keeper, minter sdk.AccAddress) (nft.NFT, error) {
 	c, err := randClass(ctx, r, k)
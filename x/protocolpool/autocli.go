@@ -28,6 +28,27 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf(`$ %s query protocolpool unclaimed-budget cosmos1...`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
 				},
+				{
+					RpcMethod:      "Stream",
+					Use:            "stream <stream-id>",
+					Short:          "Query a stream and the amount claimable by its recipient",
+					Example:        fmt.Sprintf(`$ %s query protocolpool stream 1`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "stream_id"}},
+				},
+				{
+					RpcMethod:      "StreamsByPayer",
+					Use:            "streams-by-payer <payer>",
+					Short:          "Query the streams paid by an account",
+					Example:        fmt.Sprintf(`$ %s query protocolpool streams-by-payer cosmos1...`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "payer"}},
+				},
+				{
+					RpcMethod:      "StreamsByRecipient",
+					Use:            "streams-by-recipient <recipient>",
+					Short:          "Query the streams received by an account",
+					Example:        fmt.Sprintf(`$ %s query protocolpool streams-by-recipient cosmos1...`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -89,6 +110,32 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod: "CreateStream",
+					Use:       "create-stream <recipient> <rate> <period> <end-time>",
+					Short:     "Create a stream paying the rate every period to a recipient until the end time, escrowed from the payer's balance",
+					Example:   fmt.Sprintf(`$ %s tx protocolpool create-stream cosmos1... 100uatom 24h 2025-10-31T12:00:00Z --cliff-time 2025-04-30T12:00:00Z --cancellable-by STREAM_CANCELLABLE_BY_PAYER --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "recipient"},
+						{ProtoField: "rate"},
+						{ProtoField: "period"},
+						{ProtoField: "end_time"},
+					},
+				},
+				{
+					RpcMethod:      "ClaimStream",
+					Use:            "claim-stream <stream-id>",
+					Short:          "Claim the amount accrued by a stream",
+					Example:        fmt.Sprintf(`$ %s tx protocolpool claim-stream 1 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "stream_id"}},
+				},
+				{
+					RpcMethod:      "CancelStream",
+					Use:            "cancel-stream <stream-id>",
+					Short:          "Cancel a stream, paying the accrued amount to the recipient and refunding the rest to the payer",
+					Example:        fmt.Sprintf(`$ %s tx protocolpool cancel-stream 1 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "stream_id"}},
+				},
 			},
 		},
 	}
@@ -69,6 +69,16 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error
 		return errors.New("total to be distributed is greater than the last balance")
 	}
 
+	for _, stream := range data.Streams {
+		if err := k.setStream(ctx, stream); err != nil {
+			return fmt.Errorf("failed to set stream %d: %w", stream.Id, err)
+		}
+	}
+
+	if err := k.NextStreamID.Set(ctx, data.NextStreamId); err != nil {
+		return fmt.Errorf("failed to set next stream id: %w", err)
+	}
+
 	return nil
 }
 
@@ -129,5 +139,18 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	err = k.Streams.Walk(ctx, nil, func(_ uint64, stream types.Stream) (stop bool, err error) {
+		genState.Streams = append(genState.Streams, stream)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genState.NextStreamId, err = k.NextStreamID.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return genState, nil
 }
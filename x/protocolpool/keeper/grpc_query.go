@@ -12,6 +12,7 @@ import (
 	"cosmossdk.io/x/protocolpool/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var _ types.QueryServer = Querier{}
@@ -73,3 +74,75 @@ func (k Querier) UnclaimedBudget(ctx context.Context, req *types.QueryUnclaimedB
 		TranchesLeft:    budget.TranchesLeft,
 	}, nil
 }
+
+// Stream queries a stream by its identifier, with its claimable amount
+func (k Querier) Stream(ctx context.Context, req *types.QueryStreamRequest) (*types.QueryStreamResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	stream, err := k.Keeper.Streams.Get(ctx, req.StreamId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no stream found for id: %d", req.StreamId)
+		}
+		return nil, err
+	}
+
+	// the claimable amount is computed on a copy of the stream, which is not saved
+	accrued := stream
+	claimable, err := k.Keeper.accruedStreamFunds(ctx, &accrued)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryStreamResponse{Stream: stream, Claimable: claimable}, nil
+}
+
+// StreamsByPayer queries the streams paid by the given account
+func (k Querier) StreamsByPayer(ctx context.Context, req *types.QueryStreamsByPayerRequest) (*types.QueryStreamsByPayerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	payer, err := k.Keeper.authKeeper.AddressCodec().StringToBytes(req.Payer)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid payer address: %s", err.Error())
+	}
+
+	streams, pageRes, err := k.paginateStreams(ctx, k.Keeper.PayerStreams, payer, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryStreamsByPayerResponse{Streams: streams, Pagination: pageRes}, nil
+}
+
+// StreamsByRecipient queries the streams received by the given account
+func (k Querier) StreamsByRecipient(ctx context.Context, req *types.QueryStreamsByRecipientRequest) (*types.QueryStreamsByRecipientResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	recipient, err := k.Keeper.authKeeper.AddressCodec().StringToBytes(req.Recipient)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipient address: %s", err.Error())
+	}
+
+	streams, pageRes, err := k.paginateStreams(ctx, k.Keeper.RecipientStreams, recipient, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryStreamsByRecipientResponse{Streams: streams, Pagination: pageRes}, nil
+}
+
+func (k Querier) paginateStreams(ctx context.Context, index collections.KeySet[collections.Pair[sdk.AccAddress, uint64]], address sdk.AccAddress, pagination *query.PageRequest) ([]types.Stream, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx,
+		index,
+		pagination,
+		func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (types.Stream, error) {
+			return k.Keeper.Streams.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](address),
+	)
+}
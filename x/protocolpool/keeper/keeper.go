@@ -37,12 +37,20 @@ type Keeper struct {
 	RecipientFundDistribution collections.Map[sdk.AccAddress, math.Int]
 	Distributions             collections.Map[time.Time, math.Int] // key: time.Time | value: amount
 	LastBalance               collections.Item[math.Int]
+	Streams                   collections.Map[uint64, types.Stream]
+	// PayerStreams key: PayerAddr | StreamID
+	PayerStreams collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	// RecipientStreams key: RecipientAddr | StreamID
+	RecipientStreams collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	NextStreamID     collections.Sequence
 }
 
 const (
 	errModuleAccountNotSet = "%s module account has not been set"
 )
 
+var errBudgetPeriodNotPassed = errors.New("budget period has not passed yet")
+
 func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, authority string,
 ) Keeper {
 	// ensure pool module account is set
@@ -53,6 +61,10 @@ func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, ak types.Accoun
 	if addr := ak.GetModuleAddress(types.StreamAccount); addr == nil {
 		panic(fmt.Sprintf(errModuleAccountNotSet, types.StreamAccount))
 	}
+	// ensure stream escrow account is set
+	if addr := ak.GetModuleAddress(types.StreamEscrowAccount); addr == nil {
+		panic(fmt.Sprintf(errModuleAccountNotSet, types.StreamEscrowAccount))
+	}
 	// ensure protocol pool distribution account is set
 	if addr := ak.GetModuleAddress(types.ProtocolPoolDistrAccount); addr == nil {
 		panic(fmt.Sprintf(errModuleAccountNotSet, types.ProtocolPoolDistrAccount))
@@ -72,6 +84,10 @@ func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, ak types.Accoun
 		RecipientFundDistribution: collections.NewMap(sb, types.RecipientFundDistributionKey, "recipient_fund_distribution", sdk.AccAddressKey, sdk.IntValue),
 		Distributions:             collections.NewMap(sb, types.DistributionsKey, "distributions", sdk.TimeKey, sdk.IntValue),
 		LastBalance:               collections.NewItem(sb, types.LastBalanceKey, "last_balance", sdk.IntValue),
+		Streams:                   collections.NewMap(sb, types.StreamsKey, "streams", collections.Uint64Key, codec.CollValue[types.Stream](cdc)),
+		PayerStreams:              collections.NewKeySet(sb, types.PayerStreamsKey, "payer_streams", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+		RecipientStreams:          collections.NewKeySet(sb, types.RecipientStreamsKey, "recipient_streams", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+		NextStreamID:              collections.NewSequence(sb, types.NextStreamIDKey, "next_stream_id"),
 	}
 
 	schema, err := sb.Build()
@@ -402,12 +418,30 @@ func (k Keeper) getClaimableFunds(ctx context.Context, recipientAddr string) (am
 }
 
 func (k Keeper) calculateClaimableFunds(ctx context.Context, recipient sdk.AccAddress, budget types.Budget, currentTime time.Time) (amount sdk.Coin, err error) {
+	amount, err = releaseBudgetTranches(&budget, currentTime)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	k.Logger.Debug(fmt.Sprintf("Processing budget for recipient: %s. Amount: %s", budget.RecipientAddress, amount.Amount.String()))
+
+	// Save the updated budget in the state
+	if err := k.BudgetProposal.Set(ctx, recipient, budget); err != nil {
+		return sdk.Coin{}, fmt.Errorf("error while updating the budget for recipient %s", budget.RecipientAddress)
+	}
+
+	return amount, nil
+}
+
+// releaseBudgetTranches releases the tranches of the budget whose period has passed since
+// its last claim, and returns the released amount. The budget is updated but not saved.
+func releaseBudgetTranches(budget *types.Budget, currentTime time.Time) (amount sdk.Coin, err error) {
 	// Calculate the time elapsed since the last claim time
 	timeElapsed := currentTime.Sub(*budget.LastClaimedAt)
 
 	// Check the time elapsed has passed period length
 	if timeElapsed < *budget.Period {
-		return sdk.Coin{}, errors.New("budget period has not passed yet")
+		return sdk.Coin{}, errBudgetPeriodNotPassed
 	}
 
 	// Calculate how many periods have passed
@@ -436,13 +470,6 @@ func (k Keeper) calculateClaimableFunds(ctx context.Context, recipient sdk.AccAd
 	nextClaimFrom := budget.LastClaimedAt.Add(*budget.Period * time.Duration(periodsPassed))
 	budget.LastClaimedAt = &nextClaimFrom
 
-	k.Logger.Debug(fmt.Sprintf("Processing budget for recipient: %s. Amount: %s", budget.RecipientAddress, coinsToDistribute.String()))
-
-	// Save the updated budget in the state
-	if err := k.BudgetProposal.Set(ctx, recipient, budget); err != nil {
-		return sdk.Coin{}, fmt.Errorf("error while updating the budget for recipient %s", budget.RecipientAddress)
-	}
-
 	return amount, nil
 }
 
@@ -27,9 +27,10 @@ import (
 )
 
 var (
-	poolAcc      = authtypes.NewEmptyModuleAccount(types.ModuleName)
-	streamAcc    = authtypes.NewEmptyModuleAccount(types.StreamAccount)
-	poolDistrAcc = authtypes.NewEmptyModuleAccount(types.ProtocolPoolDistrAccount)
+	poolAcc         = authtypes.NewEmptyModuleAccount(types.ModuleName)
+	streamAcc       = authtypes.NewEmptyModuleAccount(types.StreamAccount)
+	streamEscrowAcc = authtypes.NewEmptyModuleAccount(types.StreamEscrowAccount)
+	poolDistrAcc    = authtypes.NewEmptyModuleAccount(types.ProtocolPoolDistrAccount)
 )
 
 type KeeperTestSuite struct {
@@ -61,6 +62,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	accountKeeper.EXPECT().GetModuleAddress(types.ProtocolPoolDistrAccount).Return(poolDistrAcc.GetAddress())
 	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
 	accountKeeper.EXPECT().GetModuleAddress(types.StreamAccount).Return(streamAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAddress(types.StreamEscrowAccount).Return(streamEscrowAcc.GetAddress())
 	s.authKeeper = accountKeeper
 
 	bankKeeper := pooltestutil.NewMockBankKeeper(ctrl)
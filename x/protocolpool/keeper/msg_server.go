@@ -225,6 +225,48 @@ func (k MsgServer) CancelContinuousFund(ctx context.Context, msg *types.MsgCance
 	}, nil
 }
 
+func (k MsgServer) CreateStream(ctx context.Context, msg *types.MsgCreateStream) (*types.MsgCreateStreamResponse, error) {
+	payer, err := k.authKeeper.AddressCodec().StringToBytes(msg.Payer)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid payer address: %s", err)
+	}
+
+	streamID, escrowed, err := k.Keeper.CreateStream(ctx, payer, *msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateStreamResponse{StreamId: streamID, Escrowed: escrowed}, nil
+}
+
+func (k MsgServer) ClaimStream(ctx context.Context, msg *types.MsgClaimStream) (*types.MsgClaimStreamResponse, error) {
+	recipient, err := k.authKeeper.AddressCodec().StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	amount, err := k.Keeper.ClaimStream(ctx, recipient, msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimStreamResponse{Amount: amount}, nil
+}
+
+func (k MsgServer) CancelStream(ctx context.Context, msg *types.MsgCancelStream) (*types.MsgCancelStreamResponse, error) {
+	sender, err := k.authKeeper.AddressCodec().StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	recipientAmount, refundedAmount, err := k.Keeper.CancelStream(ctx, sender, msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelStreamResponse{RecipientAmount: recipientAmount, RefundedAmount: refundedAmount}, nil
+}
+
 func (k *Keeper) validateAuthority(authority string) error {
 	if _, err := k.authKeeper.AddressCodec().StringToBytes(authority); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
@@ -0,0 +1,300 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/protocolpool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreateStream creates a stream paid by the payer, escrowing the full stream amount from
+// its balance into the stream escrow account. It returns the identifier of the stream and the
+// escrowed amount.
+func (k Keeper) CreateStream(ctx context.Context, payer sdk.AccAddress, msg types.MsgCreateStream) (uint64, sdk.Coin, error) {
+	recipient, err := k.authKeeper.AddressCodec().StringToBytes(msg.Recipient)
+	if err != nil {
+		return 0, sdk.Coin{}, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if payer.Equals(sdk.AccAddress(recipient)) {
+		return 0, sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "payer and recipient cannot be the same")
+	}
+
+	stream, escrow, err := k.validateStream(ctx, msg)
+	if err != nil {
+		return 0, sdk.Coin{}, err
+	}
+
+	stream.Id, err = k.NextStreamID.Next(ctx)
+	if err != nil {
+		return 0, sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.StreamEscrowAccount, sdk.NewCoins(escrow)); err != nil {
+		return 0, sdk.Coin{}, err
+	}
+
+	if err := k.setStream(ctx, *stream); err != nil {
+		return 0, sdk.Coin{}, err
+	}
+
+	return stream.Id, escrow, nil
+}
+
+// ClaimStream pays the amount accrued by the stream to its recipient. The stream is
+// removed once fully paid.
+func (k Keeper) ClaimStream(ctx context.Context, recipient sdk.AccAddress, streamID uint64) (sdk.Coin, error) {
+	stream, err := k.getStream(ctx, streamID)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	recipientAddr, err := k.authKeeper.AddressCodec().BytesToString(recipient)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if stream.Schedule.RecipientAddress != recipientAddr {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the recipient of stream %d", recipientAddr, streamID)
+	}
+
+	currentTime := k.HeaderService.HeaderInfo(ctx).Time
+	if stream.CliffTime != nil && currentTime.Before(*stream.CliffTime) {
+		return sdk.Coin{}, errors.New("stream cliff has not passed yet")
+	}
+
+	amount, err := releaseBudgetTranches(&stream.Schedule, currentTime)
+	if err != nil {
+		if errors.Is(err, errBudgetPeriodNotPassed) {
+			return sdk.Coin{}, errors.New("stream period has not passed yet")
+		}
+		return sdk.Coin{}, err
+	}
+
+	if err := k.distributeFromStreamEscrow(ctx, sdk.NewCoins(amount), recipient); err != nil {
+		return sdk.Coin{}, fmt.Errorf("error while distributing stream funds: %w", err)
+	}
+
+	if stream.Schedule.TranchesLeft == 0 {
+		return amount, k.removeStream(ctx, stream)
+	}
+
+	return amount, k.Streams.Set(ctx, stream.Id, stream)
+}
+
+// CancelStream cancels the stream on behalf of the sender, which must be allowed to
+// cancel it. The amount accrued by the stream is paid to the recipient, and the
+// remaining escrow is refunded to the payer.
+func (k Keeper) CancelStream(ctx context.Context, sender sdk.AccAddress, streamID uint64) (recipientAmount, refundedAmount sdk.Coin, err error) {
+	stream, err := k.getStream(ctx, streamID)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	senderAddr, err := k.authKeeper.AddressCodec().BytesToString(sender)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	isPayer, isRecipient := senderAddr == stream.Payer, senderAddr == stream.Schedule.RecipientAddress
+	switch stream.CancellableBy {
+	case types.StreamCancellableBy_STREAM_CANCELLABLE_BY_PAYER:
+		isRecipient = false
+	case types.StreamCancellableBy_STREAM_CANCELLABLE_BY_RECIPIENT:
+		isPayer = false
+	case types.StreamCancellableBy_STREAM_CANCELLABLE_BY_BOTH:
+	default:
+		isPayer, isRecipient = false, false
+	}
+	if !isPayer && !isRecipient {
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s cannot cancel stream %d", senderAddr, streamID)
+	}
+
+	recipientAmount, err = k.accruedStreamFunds(ctx, &stream)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	remaining := stream.Schedule.BudgetPerTranche.Amount.Mul(math.NewIntFromUint64(stream.Schedule.TranchesLeft))
+	refundedAmount = sdk.NewCoin(stream.Schedule.BudgetPerTranche.Denom, remaining)
+
+	recipient, err := k.authKeeper.AddressCodec().StringToBytes(stream.Schedule.RecipientAddress)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	payer, err := k.authKeeper.AddressCodec().StringToBytes(stream.Payer)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	if recipientAmount.IsPositive() {
+		if err := k.distributeFromStreamEscrow(ctx, sdk.NewCoins(recipientAmount), recipient); err != nil {
+			return sdk.Coin{}, sdk.Coin{}, fmt.Errorf("error while distributing stream funds: %w", err)
+		}
+	}
+
+	if refundedAmount.IsPositive() {
+		if err := k.distributeFromStreamEscrow(ctx, sdk.NewCoins(refundedAmount), payer); err != nil {
+			return sdk.Coin{}, sdk.Coin{}, fmt.Errorf("error while refunding stream funds: %w", err)
+		}
+	}
+
+	return recipientAmount, refundedAmount, k.removeStream(ctx, stream)
+}
+
+// accruedStreamFunds releases the amount the recipient of the stream can claim at the
+// current block time, zero before the cliff or if no period has passed since the last
+// claim. The stream is updated but not saved.
+func (k Keeper) accruedStreamFunds(ctx context.Context, stream *types.Stream) (sdk.Coin, error) {
+	zeroCoin := sdk.NewCoin(stream.Schedule.BudgetPerTranche.Denom, math.ZeroInt())
+
+	currentTime := k.HeaderService.HeaderInfo(ctx).Time
+	if stream.CliffTime != nil && currentTime.Before(*stream.CliffTime) {
+		return zeroCoin, nil
+	}
+
+	amount, err := releaseBudgetTranches(&stream.Schedule, currentTime)
+	if err != nil {
+		if errors.Is(err, errBudgetPeriodNotPassed) {
+			return zeroCoin, nil
+		}
+		return sdk.Coin{}, err
+	}
+
+	return amount, nil
+}
+
+// validateStream validates the fields of the CreateStream message, and returns the stream
+// to be created and the amount to escrow.
+func (k Keeper) validateStream(ctx context.Context, msg types.MsgCreateStream) (*types.Stream, sdk.Coin, error) {
+	if err := msg.Rate.Validate(); err != nil {
+		return nil, sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Rate.String())
+	}
+	if msg.Rate.IsZero() {
+		return nil, sdk.Coin{}, errors.New("invalid stream: rate cannot be zero")
+	}
+
+	if msg.Period == nil || *msg.Period <= 0 {
+		return nil, sdk.Coin{}, errors.New("invalid stream: period length should be greater than zero")
+	}
+
+	currentTime := k.HeaderService.HeaderInfo(ctx).Time
+	startTime := currentTime
+	if msg.StartTime != nil && !msg.StartTime.IsZero() {
+		startTime = *msg.StartTime
+	}
+	if startTime.Before(currentTime) {
+		return nil, sdk.Coin{}, errors.New("invalid stream: start time cannot be less than the current block time")
+	}
+
+	if msg.EndTime == nil || !msg.EndTime.After(startTime) {
+		return nil, sdk.Coin{}, errors.New("invalid stream: end time must be after the start time")
+	}
+
+	duration := msg.EndTime.Sub(startTime)
+	if duration%*msg.Period != 0 {
+		return nil, sdk.Coin{}, errors.New("invalid stream: duration must be a multiple of the period")
+	}
+
+	if msg.CliffTime != nil && (msg.CliffTime.Before(startTime) || msg.CliffTime.After(*msg.EndTime)) {
+		return nil, sdk.Coin{}, errors.New("invalid stream: cliff time must be between the start and end times")
+	}
+
+	if _, ok := types.StreamCancellableBy_name[int32(msg.CancellableBy)]; !ok {
+		return nil, sdk.Coin{}, fmt.Errorf("invalid stream: unknown cancellable by %d", msg.CancellableBy)
+	}
+
+	tranches := uint64(duration / *msg.Period)
+	escrow := sdk.NewCoin(msg.Rate.Denom, msg.Rate.Amount.Mul(math.NewIntFromUint64(tranches)))
+	zeroCoin := sdk.NewCoin(msg.Rate.Denom, math.ZeroInt())
+	rate := msg.Rate
+
+	return &types.Stream{
+		Payer: msg.Payer,
+		Schedule: types.Budget{
+			RecipientAddress: msg.Recipient,
+			ClaimedAmount:    &zeroCoin,
+			LastClaimedAt:    &startTime,
+			TranchesLeft:     tranches,
+			BudgetPerTranche: &rate,
+			Period:           msg.Period,
+		},
+		StartTime:     startTime,
+		EndTime:       *msg.EndTime,
+		CliffTime:     msg.CliffTime,
+		CancellableBy: msg.CancellableBy,
+	}, escrow, nil
+}
+
+func (k Keeper) getStream(ctx context.Context, streamID uint64) (types.Stream, error) {
+	stream, err := k.Streams.Get(ctx, streamID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Stream{}, errorsmod.Wrapf(types.ErrStreamNotFound, "stream %d", streamID)
+		}
+		return types.Stream{}, err
+	}
+
+	return stream, nil
+}
+
+// setStream stores a stream and indexes it by payer and recipient.
+func (k Keeper) setStream(ctx context.Context, stream types.Stream) error {
+	payer, recipient, err := k.streamAddresses(stream)
+	if err != nil {
+		return err
+	}
+
+	if err := k.Streams.Set(ctx, stream.Id, stream); err != nil {
+		return err
+	}
+
+	if err := k.PayerStreams.Set(ctx, collections.Join(payer, stream.Id)); err != nil {
+		return err
+	}
+
+	return k.RecipientStreams.Set(ctx, collections.Join(recipient, stream.Id))
+}
+
+// removeStream removes a stream and its indexes.
+func (k Keeper) removeStream(ctx context.Context, stream types.Stream) error {
+	payer, recipient, err := k.streamAddresses(stream)
+	if err != nil {
+		return err
+	}
+
+	if err := k.Streams.Remove(ctx, stream.Id); err != nil {
+		return err
+	}
+
+	if err := k.PayerStreams.Remove(ctx, collections.Join(payer, stream.Id)); err != nil {
+		return err
+	}
+
+	return k.RecipientStreams.Remove(ctx, collections.Join(recipient, stream.Id))
+}
+
+func (k Keeper) streamAddresses(stream types.Stream) (payer, recipient sdk.AccAddress, err error) {
+	payer, err = k.authKeeper.AddressCodec().StringToBytes(stream.Payer)
+	if err != nil {
+		return nil, nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid payer address: %s", err)
+	}
+
+	recipient, err = k.authKeeper.AddressCodec().StringToBytes(stream.Schedule.RecipientAddress)
+	if err != nil {
+		return nil, nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	return payer, recipient, nil
+}
+
+// distributeFromStreamEscrow pays escrowed stream funds to a receiver address.
+func (k Keeper) distributeFromStreamEscrow(ctx context.Context, amount sdk.Coins, receiveAddr []byte) error {
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.StreamEscrowAccount, receiveAddr, amount)
+}
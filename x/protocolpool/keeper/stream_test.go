@@ -0,0 +1,227 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/protocolpool/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var payerAddr = sdk.AccAddress([]byte("payer_______________"))
+
+func (suite *KeeperTestSuite) TestCreateStream() {
+	now := suite.environment.HeaderService.HeaderInfo(suite.ctx).Time
+	period := time.Hour
+	endTime := now.Add(10 * time.Hour)
+	payerStrAddr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(payerAddr)
+	suite.Require().NoError(err)
+	recipientStrAddr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(recipientAddr)
+	suite.Require().NoError(err)
+
+	validMsg := func() *types.MsgCreateStream {
+		return &types.MsgCreateStream{
+			Payer:     payerStrAddr,
+			Recipient: recipientStrAddr,
+			Rate:      fooCoin,
+			Period:    &period,
+			EndTime:   &endTime,
+		}
+	}
+
+	testCases := map[string]struct {
+		malleate  func(msg *types.MsgCreateStream)
+		expErrMsg string
+	}{
+		"invalid recipient": {
+			malleate:  func(msg *types.MsgCreateStream) { msg.Recipient = "invalid" },
+			expErrMsg: "invalid recipient address",
+		},
+		"payer is the recipient": {
+			malleate:  func(msg *types.MsgCreateStream) { msg.Recipient = payerStrAddr },
+			expErrMsg: "payer and recipient cannot be the same",
+		},
+		"zero rate": {
+			malleate:  func(msg *types.MsgCreateStream) { msg.Rate = sdk.NewInt64Coin("foo", 0) },
+			expErrMsg: "rate cannot be zero",
+		},
+		"no period": {
+			malleate:  func(msg *types.MsgCreateStream) { msg.Period = nil },
+			expErrMsg: "period length should be greater than zero",
+		},
+		"start time in the past": {
+			malleate: func(msg *types.MsgCreateStream) {
+				startTime := now.Add(-time.Hour)
+				msg.StartTime = &startTime
+			},
+			expErrMsg: "start time cannot be less than the current block time",
+		},
+		"end time before start time": {
+			malleate:  func(msg *types.MsgCreateStream) { msg.EndTime = &now },
+			expErrMsg: "end time must be after the start time",
+		},
+		"duration not a multiple of the period": {
+			malleate: func(msg *types.MsgCreateStream) {
+				endTime := now.Add(90 * time.Minute)
+				msg.EndTime = &endTime
+			},
+			expErrMsg: "duration must be a multiple of the period",
+		},
+		"cliff after end time": {
+			malleate: func(msg *types.MsgCreateStream) {
+				cliffTime := endTime.Add(time.Hour)
+				msg.CliffTime = &cliffTime
+			},
+			expErrMsg: "cliff time must be between the start and end times",
+		},
+		"unknown cancellable by": {
+			malleate:  func(msg *types.MsgCreateStream) { msg.CancellableBy = 4 },
+			expErrMsg: "unknown cancellable by",
+		},
+	}
+
+	for name, tc := range testCases {
+		suite.Run(name, func() {
+			msg := validMsg()
+			tc.malleate(msg)
+			_, err := suite.msgServer.CreateStream(suite.ctx, msg)
+			suite.Require().ErrorContains(err, tc.expErrMsg)
+		})
+	}
+
+	escrow := sdk.NewInt64Coin("foo", 1000)
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(suite.ctx, payerAddr, types.StreamEscrowAccount, sdk.NewCoins(escrow)).Return(nil).Times(2)
+	for i := uint64(0); i < 2; i++ {
+		res, err := suite.msgServer.CreateStream(suite.ctx, validMsg())
+		suite.Require().NoError(err)
+		suite.Require().Equal(i, res.StreamId)
+		suite.Require().Equal(escrow, res.Escrowed)
+	}
+
+	stream, err := suite.poolKeeper.Streams.Get(suite.ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(payerStrAddr, stream.Payer)
+	suite.Require().Equal(recipientStrAddr, stream.Schedule.RecipientAddress)
+	suite.Require().Equal(uint64(10), stream.Schedule.TranchesLeft)
+	suite.Require().Equal(now, stream.StartTime)
+
+	byPayer, err := suite.queryServer.StreamsByPayer(suite.ctx, &types.QueryStreamsByPayerRequest{Payer: payerStrAddr})
+	suite.Require().NoError(err)
+	suite.Require().Len(byPayer.Streams, 2)
+	byRecipient, err := suite.queryServer.StreamsByRecipient(suite.ctx, &types.QueryStreamsByRecipientRequest{Recipient: recipientStrAddr})
+	suite.Require().NoError(err)
+	suite.Require().Len(byRecipient.Streams, 2)
+	byRecipient, err = suite.queryServer.StreamsByRecipient(suite.ctx, &types.QueryStreamsByRecipientRequest{Recipient: payerStrAddr})
+	suite.Require().NoError(err)
+	suite.Require().Empty(byRecipient.Streams)
+}
+
+func (suite *KeeperTestSuite) TestClaimStream() {
+	now := suite.environment.HeaderService.HeaderInfo(suite.ctx).Time
+	period := time.Hour
+	endTime := now.Add(10 * time.Hour)
+	cliffTime := now.Add(3 * time.Hour)
+	payerStrAddr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(payerAddr)
+	suite.Require().NoError(err)
+	recipientStrAddr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(recipientAddr)
+	suite.Require().NoError(err)
+
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(suite.ctx, payerAddr, types.StreamEscrowAccount, sdk.NewCoins(sdk.NewInt64Coin("foo", 1000))).Return(nil)
+	res, err := suite.msgServer.CreateStream(suite.ctx, &types.MsgCreateStream{
+		Payer:     payerStrAddr,
+		Recipient: recipientStrAddr,
+		Rate:      fooCoin,
+		Period:    &period,
+		EndTime:   &endTime,
+		CliffTime: &cliffTime,
+	})
+	suite.Require().NoError(err)
+
+	// only the recipient can claim
+	_, err = suite.msgServer.ClaimStream(suite.ctx, &types.MsgClaimStream{Recipient: payerStrAddr, StreamId: res.StreamId})
+	suite.Require().ErrorContains(err, "is not the recipient of stream")
+	_, err = suite.msgServer.ClaimStream(suite.ctx, &types.MsgClaimStream{Recipient: recipientStrAddr, StreamId: 42})
+	suite.Require().ErrorIs(err, types.ErrStreamNotFound)
+
+	// nothing can be claimed before the cliff
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Time: now.Add(2 * time.Hour)})
+	_, err = suite.msgServer.ClaimStream(ctx, &types.MsgClaimStream{Recipient: recipientStrAddr, StreamId: res.StreamId})
+	suite.Require().ErrorContains(err, "stream cliff has not passed yet")
+	query, err := suite.queryServer.Stream(ctx, &types.QueryStreamRequest{StreamId: res.StreamId})
+	suite.Require().NoError(err)
+	suite.Require().True(query.Claimable.IsZero())
+
+	// the amount accrued at the cliff is claimable at once
+	ctx = suite.ctx.WithHeaderInfo(header.Info{Time: now.Add(3*time.Hour + time.Minute)})
+	query, err = suite.queryServer.Stream(ctx, &types.QueryStreamRequest{StreamId: res.StreamId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin("foo", 300), query.Claimable)
+
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.StreamEscrowAccount, recipientAddr, sdk.NewCoins(sdk.NewInt64Coin("foo", 300))).Return(nil)
+	claim, err := suite.msgServer.ClaimStream(ctx, &types.MsgClaimStream{Recipient: recipientStrAddr, StreamId: res.StreamId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin("foo", 300), claim.Amount)
+
+	_, err = suite.msgServer.ClaimStream(ctx, &types.MsgClaimStream{Recipient: recipientStrAddr, StreamId: res.StreamId})
+	suite.Require().ErrorContains(err, "stream period has not passed yet")
+
+	// the stream is removed once fully paid
+	ctx = suite.ctx.WithHeaderInfo(header.Info{Time: endTime.Add(time.Hour)})
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.StreamEscrowAccount, recipientAddr, sdk.NewCoins(sdk.NewInt64Coin("foo", 700))).Return(nil)
+	claim, err = suite.msgServer.ClaimStream(ctx, &types.MsgClaimStream{Recipient: recipientStrAddr, StreamId: res.StreamId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin("foo", 700), claim.Amount)
+
+	has, err := suite.poolKeeper.Streams.Has(ctx, res.StreamId)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+	byPayer, err := suite.queryServer.StreamsByPayer(ctx, &types.QueryStreamsByPayerRequest{Payer: payerStrAddr})
+	suite.Require().NoError(err)
+	suite.Require().Empty(byPayer.Streams)
+}
+
+func (suite *KeeperTestSuite) TestCancelStream() {
+	now := suite.environment.HeaderService.HeaderInfo(suite.ctx).Time
+	period := time.Hour
+	endTime := now.Add(10 * time.Hour)
+	payerStrAddr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(payerAddr)
+	suite.Require().NoError(err)
+	recipientStrAddr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(recipientAddr)
+	suite.Require().NoError(err)
+
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(suite.ctx, payerAddr, types.StreamEscrowAccount, sdk.NewCoins(sdk.NewInt64Coin("foo", 1000))).Return(nil).Times(2)
+	createStream := func(cancellableBy types.StreamCancellableBy) uint64 {
+		res, err := suite.msgServer.CreateStream(suite.ctx, &types.MsgCreateStream{
+			Payer:         payerStrAddr,
+			Recipient:     recipientStrAddr,
+			Rate:          fooCoin,
+			Period:        &period,
+			EndTime:       &endTime,
+			CancellableBy: cancellableBy,
+		})
+		suite.Require().NoError(err)
+		return res.StreamId
+	}
+
+	notCancellable := createStream(types.StreamCancellableBy_STREAM_CANCELLABLE_BY_NONE)
+	_, err = suite.msgServer.CancelStream(suite.ctx, &types.MsgCancelStream{Sender: payerStrAddr, StreamId: notCancellable})
+	suite.Require().ErrorContains(err, "cannot cancel stream")
+
+	byPayer := createStream(types.StreamCancellableBy_STREAM_CANCELLABLE_BY_PAYER)
+	_, err = suite.msgServer.CancelStream(suite.ctx, &types.MsgCancelStream{Sender: recipientStrAddr, StreamId: byPayer})
+	suite.Require().ErrorContains(err, "cannot cancel stream")
+
+	// the accrued amount is paid to the recipient and the remaining escrow refunded
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Time: now.Add(4*time.Hour + time.Minute)})
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.StreamEscrowAccount, recipientAddr, sdk.NewCoins(sdk.NewInt64Coin("foo", 400))).Return(nil)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.StreamEscrowAccount, payerAddr, sdk.NewCoins(sdk.NewInt64Coin("foo", 600))).Return(nil)
+	res, err := suite.msgServer.CancelStream(ctx, &types.MsgCancelStream{Sender: payerStrAddr, StreamId: byPayer})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin("foo", 400), res.RecipientAmount)
+	suite.Require().Equal(sdk.NewInt64Coin("foo", 600), res.RefundedAmount)
+
+	_, err = suite.queryServer.Stream(ctx, &types.QueryStreamRequest{StreamId: byPayer})
+	suite.Require().ErrorContains(err, "no stream found")
+}
@@ -17,6 +17,9 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgCreateContinuousFund{},
 		&MsgCancelContinuousFund{},
 		&MsgWithdrawContinuousFund{},
+		&MsgCreateStream{},
+		&MsgClaimStream{},
+		&MsgCancelStream{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
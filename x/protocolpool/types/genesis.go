@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -37,9 +38,45 @@ func ValidateGenesis(gs *GenesisState) error {
 			return err
 		}
 	}
+
+	seenStreams := make(map[uint64]bool)
+	for _, stream := range gs.Streams {
+		if seenStreams[stream.Id] {
+			return fmt.Errorf("duplicate stream id %d", stream.Id)
+		}
+		seenStreams[stream.Id] = true
+
+		if stream.Id >= gs.NextStreamId {
+			return fmt.Errorf("stream id %d must be lower than the next stream id %d", stream.Id, gs.NextStreamId)
+		}
+
+		if err := validateStream(stream); err != nil {
+			return fmt.Errorf("invalid stream %d: %w", stream.Id, err)
+		}
+	}
 	return nil
 }
 
+func validateStream(stream Stream) error {
+	if stream.Payer == "" {
+		return errors.New("payer cannot be empty")
+	}
+
+	if stream.Schedule.LastClaimedAt == nil {
+		return errors.New("last claimed at cannot be empty")
+	}
+
+	if !stream.EndTime.After(stream.StartTime) {
+		return errors.New("end time must be after the start time")
+	}
+
+	if _, ok := StreamCancellableBy_name[int32(stream.CancellableBy)]; !ok {
+		return fmt.Errorf("unknown cancellable by %d", stream.CancellableBy)
+	}
+
+	return validateBudget(stream.Schedule)
+}
+
 func validateBudget(bp Budget) error {
 	if bp.RecipientAddress == "" {
 		return errors.New("recipient cannot be empty")
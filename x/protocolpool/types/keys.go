@@ -9,6 +9,10 @@ const (
 	// StreamAccount is the name constant used for stream account
 	StreamAccount = "stream_acc"
 
+	// StreamEscrowAccount is the account escrowing the streams between accounts, kept apart
+	// from the continuous funds of the stream account.
+	StreamEscrowAccount = "stream_escrow"
+
 	// ProtocolPoolDistrAccount is an intermediary account that holds the funds to be distributed to the protocolpool accounts.
 	ProtocolPoolDistrAccount = "protocolpool_distr"
 
@@ -31,4 +35,8 @@ var (
 	RecipientFundDistributionKey = collections.NewPrefix(5)
 	DistributionsKey             = collections.NewPrefix(6)
 	LastBalanceKey               = collections.NewPrefix(7)
+	StreamsKey                   = collections.NewPrefix(8)
+	PayerStreamsKey              = collections.NewPrefix(9)
+	RecipientStreamsKey          = collections.NewPrefix(10)
+	NextStreamIDKey              = collections.NewPrefix(11)
 )
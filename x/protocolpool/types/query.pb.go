@@ -9,6 +9,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -253,11 +254,338 @@ func (m *QueryUnclaimedBudgetResponse) GetTranchesLeft() uint64 {
 	return 0
 }
 
+// QueryStreamRequest is the request type for the Query/Stream RPC method.
+type QueryStreamRequest struct {
+	// stream_id is the identifier of the stream.
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *QueryStreamRequest) Reset()         { *m = QueryStreamRequest{} }
+func (m *QueryStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamRequest) ProtoMessage()    {}
+func (*QueryStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51500a0a77d57843, []int{4}
+}
+func (m *QueryStreamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamRequest.Merge(m, src)
+}
+func (m *QueryStreamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamRequest proto.InternalMessageInfo
+
+func (m *QueryStreamRequest) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+// QueryStreamResponse is the response type for the Query/Stream RPC method.
+type QueryStreamResponse struct {
+	// stream is the stream.
+	Stream Stream `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream"`
+	// claimable is the amount the recipient can claim at the current block time.
+	Claimable types.Coin `protobuf:"bytes,2,opt,name=claimable,proto3" json:"claimable"`
+}
+
+func (m *QueryStreamResponse) Reset()         { *m = QueryStreamResponse{} }
+func (m *QueryStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamResponse) ProtoMessage()    {}
+func (*QueryStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51500a0a77d57843, []int{5}
+}
+func (m *QueryStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamResponse.Merge(m, src)
+}
+func (m *QueryStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamResponse proto.InternalMessageInfo
+
+func (m *QueryStreamResponse) GetStream() Stream {
+	if m != nil {
+		return m.Stream
+	}
+	return Stream{}
+}
+
+func (m *QueryStreamResponse) GetClaimable() types.Coin {
+	if m != nil {
+		return m.Claimable
+	}
+	return types.Coin{}
+}
+
+// QueryStreamsByPayerRequest is the request type for the Query/StreamsByPayer RPC method.
+type QueryStreamsByPayerRequest struct {
+	// payer is the address of the account paying the streams.
+	Payer string `protobuf:"bytes,1,opt,name=payer,proto3" json:"payer,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStreamsByPayerRequest) Reset()         { *m = QueryStreamsByPayerRequest{} }
+func (m *QueryStreamsByPayerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamsByPayerRequest) ProtoMessage()    {}
+func (*QueryStreamsByPayerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51500a0a77d57843, []int{6}
+}
+func (m *QueryStreamsByPayerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamsByPayerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamsByPayerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamsByPayerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamsByPayerRequest.Merge(m, src)
+}
+func (m *QueryStreamsByPayerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamsByPayerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamsByPayerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamsByPayerRequest proto.InternalMessageInfo
+
+func (m *QueryStreamsByPayerRequest) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *QueryStreamsByPayerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryStreamsByPayerResponse is the response type for the Query/StreamsByPayer RPC method.
+type QueryStreamsByPayerResponse struct {
+	// streams are the streams paid by the account.
+	Streams []Stream `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStreamsByPayerResponse) Reset()         { *m = QueryStreamsByPayerResponse{} }
+func (m *QueryStreamsByPayerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamsByPayerResponse) ProtoMessage()    {}
+func (*QueryStreamsByPayerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51500a0a77d57843, []int{7}
+}
+func (m *QueryStreamsByPayerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamsByPayerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamsByPayerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamsByPayerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamsByPayerResponse.Merge(m, src)
+}
+func (m *QueryStreamsByPayerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamsByPayerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamsByPayerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamsByPayerResponse proto.InternalMessageInfo
+
+func (m *QueryStreamsByPayerResponse) GetStreams() []Stream {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+func (m *QueryStreamsByPayerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryStreamsByRecipientRequest is the request type for the Query/StreamsByRecipient RPC method.
+type QueryStreamsByRecipientRequest struct {
+	// recipient is the address of the account receiving the streams.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStreamsByRecipientRequest) Reset()         { *m = QueryStreamsByRecipientRequest{} }
+func (m *QueryStreamsByRecipientRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamsByRecipientRequest) ProtoMessage()    {}
+func (*QueryStreamsByRecipientRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51500a0a77d57843, []int{8}
+}
+func (m *QueryStreamsByRecipientRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamsByRecipientRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamsByRecipientRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamsByRecipientRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamsByRecipientRequest.Merge(m, src)
+}
+func (m *QueryStreamsByRecipientRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamsByRecipientRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamsByRecipientRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamsByRecipientRequest proto.InternalMessageInfo
+
+func (m *QueryStreamsByRecipientRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *QueryStreamsByRecipientRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryStreamsByRecipientResponse is the response type for the Query/StreamsByRecipient RPC method.
+type QueryStreamsByRecipientResponse struct {
+	// streams are the streams received by the account.
+	Streams []Stream `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStreamsByRecipientResponse) Reset()         { *m = QueryStreamsByRecipientResponse{} }
+func (m *QueryStreamsByRecipientResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamsByRecipientResponse) ProtoMessage()    {}
+func (*QueryStreamsByRecipientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51500a0a77d57843, []int{9}
+}
+func (m *QueryStreamsByRecipientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamsByRecipientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamsByRecipientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamsByRecipientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamsByRecipientResponse.Merge(m, src)
+}
+func (m *QueryStreamsByRecipientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamsByRecipientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamsByRecipientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamsByRecipientResponse proto.InternalMessageInfo
+
+func (m *QueryStreamsByRecipientResponse) GetStreams() []Stream {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+func (m *QueryStreamsByRecipientResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.protocolpool.v1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.protocolpool.v1.QueryCommunityPoolResponse")
 	proto.RegisterType((*QueryUnclaimedBudgetRequest)(nil), "cosmos.protocolpool.v1.QueryUnclaimedBudgetRequest")
 	proto.RegisterType((*QueryUnclaimedBudgetResponse)(nil), "cosmos.protocolpool.v1.QueryUnclaimedBudgetResponse")
+	proto.RegisterType((*QueryStreamRequest)(nil), "cosmos.protocolpool.v1.QueryStreamRequest")
+	proto.RegisterType((*QueryStreamResponse)(nil), "cosmos.protocolpool.v1.QueryStreamResponse")
+	proto.RegisterType((*QueryStreamsByPayerRequest)(nil), "cosmos.protocolpool.v1.QueryStreamsByPayerRequest")
+	proto.RegisterType((*QueryStreamsByPayerResponse)(nil), "cosmos.protocolpool.v1.QueryStreamsByPayerResponse")
+	proto.RegisterType((*QueryStreamsByRecipientRequest)(nil), "cosmos.protocolpool.v1.QueryStreamsByRecipientRequest")
+	proto.RegisterType((*QueryStreamsByRecipientResponse)(nil), "cosmos.protocolpool.v1.QueryStreamsByRecipientResponse")
 }
 
 func init() {
@@ -265,46 +593,65 @@ func init() {
 }
 
 var fileDescriptor_51500a0a77d57843 = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0xd3, 0xb4, 0x9f, 0xbe, 0x29, 0xa1, 0x68, 0x54, 0xa1, 0xd4, 0xad, 0x9c, 0x12, 0x24,
-	0x14, 0x09, 0x75, 0x46, 0x49, 0x2b, 0x21, 0xc1, 0x86, 0xa6, 0x15, 0x62, 0xc1, 0x82, 0x1a, 0xd8,
-	0xb0, 0xb1, 0xfc, 0x33, 0x71, 0xad, 0xda, 0x73, 0x5d, 0xcf, 0xb8, 0x6a, 0x85, 0xd8, 0xc0, 0x0b,
-	0x54, 0x62, 0xc3, 0x13, 0xb0, 0x60, 0xcd, 0x82, 0x47, 0xe8, 0x0a, 0x55, 0xb0, 0x61, 0x45, 0x51,
-	0xcb, 0x8e, 0x97, 0x40, 0x1e, 0x8f, 0xa1, 0x8d, 0x12, 0x50, 0x57, 0x49, 0xee, 0xb9, 0xe7, 0xdc,
-	0x73, 0x72, 0xef, 0xa0, 0x8e, 0x0f, 0x22, 0x01, 0x41, 0xd3, 0x0c, 0x24, 0xf8, 0x10, 0xa7, 0x00,
-	0x31, 0xdd, 0xeb, 0xd1, 0xdd, 0x9c, 0x65, 0x07, 0x44, 0x55, 0xf1, 0xf5, 0xb2, 0x87, 0x9c, 0xef,
-	0x21, 0x7b, 0x3d, 0x73, 0x3e, 0x84, 0x10, 0x54, 0x91, 0x16, 0xdf, 0x4a, 0xdc, 0x5c, 0x0a, 0x01,
-	0xc2, 0x98, 0x51, 0x37, 0x8d, 0xa8, 0xcb, 0x39, 0x48, 0x57, 0x46, 0xc0, 0x35, 0xdb, 0xb4, 0xf4,
-	0x3c, 0xcf, 0x15, 0x8c, 0xee, 0xf5, 0x3c, 0x26, 0xdd, 0x1e, 0xf5, 0x21, 0xe2, 0x1a, 0x5f, 0x28,
-	0x71, 0xa7, 0x94, 0x3d, 0x3f, 0xd8, 0x6c, 0x6b, 0x61, 0xf5, 0xcb, 0xcb, 0x87, 0x54, 0x46, 0x09,
-	0x13, 0xd2, 0x4d, 0xd2, 0x4a, 0x7b, 0xb4, 0x21, 0xc8, 0x33, 0x35, 0xbc, 0xc4, 0x3b, 0x8b, 0x68,
-	0x61, 0xab, 0x88, 0xb5, 0x01, 0x49, 0x92, 0xf3, 0x48, 0x1e, 0x3c, 0x06, 0x88, 0x6d, 0xb6, 0x9b,
-	0x33, 0x21, 0x3b, 0xaf, 0x0d, 0x64, 0x8e, 0x43, 0x45, 0x0a, 0x5c, 0x30, 0xcc, 0x50, 0xa3, 0x88,
-	0xdd, 0x32, 0x96, 0xa7, 0xba, 0xb3, 0xfd, 0x25, 0xa2, 0x9d, 0x15, 0x31, 0x88, 0x8e, 0x41, 0x36,
-	0x99, 0xbf, 0x01, 0x11, 0x1f, 0xac, 0x1e, 0x7d, 0x6b, 0xd7, 0xde, 0x9f, 0xb4, 0x6f, 0x87, 0x91,
-	0xdc, 0xce, 0x3d, 0xe2, 0x43, 0xa2, 0x93, 0xe8, 0x8f, 0x15, 0x11, 0xec, 0x50, 0x79, 0x90, 0x32,
-	0x51, 0x71, 0x84, 0xad, 0xe4, 0x3b, 0x5b, 0x68, 0x51, 0x99, 0x78, 0xc6, 0xfd, 0xd8, 0x8d, 0x12,
-	0x16, 0x0c, 0xf2, 0x20, 0x64, 0x52, 0x9b, 0xc4, 0x7d, 0xf4, 0x9f, 0x1b, 0x04, 0x19, 0x13, 0xa2,
-	0x65, 0x2c, 0x1b, 0xdd, 0xff, 0x07, 0xad, 0xcf, 0x1f, 0x56, 0xe6, 0xb5, 0x97, 0xf5, 0x12, 0x79,
-	0x22, 0xb3, 0x88, 0x87, 0x76, 0xd5, 0xd8, 0xf9, 0x54, 0x47, 0x4b, 0xe3, 0x35, 0x75, 0xb4, 0xfb,
-	0xe8, 0xaa, 0x06, 0x1c, 0x37, 0x81, 0x9c, 0x4b, 0xa5, 0x3d, 0xdb, 0x5f, 0x18, 0x1b, 0xb2, 0x70,
-	0x6b, 0x37, 0x35, 0x61, 0x5d, 0xf5, 0xe3, 0x4d, 0x74, 0x2d, 0xe7, 0x23, 0x1a, 0xf5, 0x7f, 0x69,
-	0xcc, 0xfd, 0xa6, 0x68, 0x95, 0x87, 0x68, 0x8e, 0xb3, 0x7d, 0xe9, 0xa8, 0xaa, 0x33, 0xcc, 0x20,
-	0x69, 0x4d, 0x29, 0x11, 0x93, 0x94, 0x8b, 0x25, 0xd5, 0x62, 0xc9, 0xd3, 0x6a, 0xf3, 0x83, 0xc6,
-	0xe1, 0x49, 0xdb, 0xb0, 0x9b, 0x05, 0x71, 0xa3, 0xe0, 0x3d, 0xc8, 0x20, 0xc1, 0x77, 0xd0, 0x4c,
-	0xca, 0xb2, 0x08, 0x82, 0x56, 0x43, 0xbb, 0x18, 0x15, 0xd8, 0xd4, 0x97, 0x31, 0x68, 0xbc, 0x2d,
-	0xf8, 0xba, 0x1d, 0xdf, 0x44, 0x4d, 0x99, 0xb9, 0xdc, 0xdf, 0x66, 0xc2, 0x89, 0xd9, 0x50, 0xb6,
-	0xa6, 0x97, 0x8d, 0x6e, 0xc3, 0xbe, 0x52, 0x15, 0x1f, 0xb1, 0xa1, 0xec, 0xff, 0xac, 0xa3, 0x69,
-	0xf5, 0x87, 0xe2, 0x77, 0x06, 0x6a, 0x5e, 0x38, 0x17, 0xdc, 0x23, 0xe3, 0xdf, 0x0a, 0x99, 0x78,
-	0x78, 0x66, 0xff, 0x32, 0x94, 0x72, 0x65, 0x1d, 0xf2, 0xea, 0xcb, 0x8f, 0x37, 0xf5, 0x2e, 0xbe,
-	0x45, 0x27, 0x3c, 0x5f, 0xbf, 0xa2, 0x39, 0x45, 0x05, 0x7f, 0x34, 0xd0, 0xdc, 0xc8, 0xfa, 0xf1,
-	0xea, 0x5f, 0xe7, 0x8e, 0x3f, 0x40, 0x73, 0xed, 0x72, 0x24, 0x6d, 0xf7, 0xae, 0xb2, 0xbb, 0x86,
-	0xfb, 0x93, 0xec, 0xfe, 0xb9, 0x1e, 0x4f, 0x31, 0xe9, 0x0b, 0x7d, 0xbd, 0x2f, 0x07, 0xf7, 0x8e,
-	0x4e, 0x2d, 0xe3, 0xf8, 0xd4, 0x32, 0xbe, 0x9f, 0x5a, 0xc6, 0xe1, 0x99, 0x55, 0x3b, 0x3e, 0xb3,
-	0x6a, 0x5f, 0xcf, 0xac, 0xda, 0xf3, 0x1b, 0xa5, 0x98, 0x08, 0x76, 0x48, 0x04, 0x74, 0xff, 0xa2,
-	0xa8, 0x7a, 0x5d, 0xde, 0x8c, 0xaa, 0xad, 0xfe, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x1b, 0x33, 0x02,
-	0x59, 0xe6, 0x04, 0x00, 0x00,
+	// 926 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x33, 0xa9, 0xe3, 0x92, 0x29, 0x69, 0xd0, 0x50, 0x21, 0x67, 0x13, 0xad, 0xc3, 0x22,
+	0x95, 0xa8, 0x51, 0x76, 0x65, 0xbb, 0x6d, 0x24, 0xa0, 0x88, 0x6e, 0xa2, 0x02, 0x12, 0x87, 0x74,
+	0x0b, 0x17, 0x2e, 0xd6, 0x7a, 0x77, 0xb2, 0x5d, 0xd5, 0x3b, 0xb3, 0xdd, 0x99, 0x8d, 0x6a, 0x45,
+	0xb9, 0xc0, 0x17, 0xa8, 0x00, 0x21, 0x8e, 0x9c, 0x7a, 0xe8, 0x89, 0x03, 0x07, 0x3e, 0x00, 0x87,
+	0x9e, 0x50, 0x05, 0x17, 0x4e, 0x14, 0x25, 0x7c, 0x0e, 0x84, 0x76, 0xe6, 0xad, 0xe3, 0x35, 0x76,
+	0x9d, 0x48, 0x1c, 0x38, 0xd9, 0x7e, 0xf3, 0xfe, 0xff, 0xf7, 0x9b, 0xb7, 0x33, 0x6f, 0x8d, 0xad,
+	0x80, 0x8b, 0x84, 0x0b, 0x27, 0xcd, 0xb8, 0xe4, 0x01, 0xef, 0xa7, 0x9c, 0xf7, 0x9d, 0x83, 0x96,
+	0xf3, 0x30, 0xa7, 0xd9, 0xc0, 0x56, 0x51, 0xf2, 0x86, 0xce, 0xb1, 0x47, 0x73, 0xec, 0x83, 0x96,
+	0x71, 0x25, 0xe2, 0x11, 0x57, 0x41, 0xa7, 0xf8, 0xa6, 0xd7, 0x8d, 0xb5, 0x88, 0xf3, 0xa8, 0x4f,
+	0x1d, 0x3f, 0x8d, 0x1d, 0x9f, 0x31, 0x2e, 0x7d, 0x19, 0x73, 0x06, 0x6a, 0xc3, 0x84, 0x7a, 0x3d,
+	0x5f, 0x50, 0xe7, 0xa0, 0xd5, 0xa3, 0xd2, 0x6f, 0x39, 0x01, 0x8f, 0x19, 0xac, 0xaf, 0xe8, 0xf5,
+	0xae, 0xb6, 0x1d, 0x2d, 0x6c, 0x34, 0xc1, 0x58, 0xfd, 0xea, 0xe5, 0xfb, 0x8e, 0x8c, 0x13, 0x2a,
+	0xa4, 0x9f, 0xa4, 0xa5, 0xf7, 0x78, 0x42, 0x98, 0x67, 0xaa, 0x38, 0xac, 0x5f, 0x1b, 0xad, 0xad,
+	0x36, 0x38, 0x24, 0x48, 0xfd, 0x28, 0x66, 0xa3, 0xb9, 0xd3, 0xfa, 0x22, 0x07, 0x29, 0x05, 0x20,
+	0x6b, 0x15, 0xaf, 0xdc, 0x2d, 0x5c, 0x76, 0x78, 0x92, 0xe4, 0x2c, 0x96, 0x83, 0x3d, 0xce, 0xfb,
+	0x1e, 0x7d, 0x98, 0x53, 0x21, 0xad, 0x2f, 0x11, 0x36, 0x26, 0xad, 0x8a, 0x94, 0x33, 0x41, 0x09,
+	0xc5, 0xb5, 0xc2, 0xb2, 0x81, 0xd6, 0x2f, 0x6c, 0x5c, 0x6a, 0xaf, 0xd9, 0xb0, 0xd3, 0x02, 0xcd,
+	0x06, 0x28, 0x7b, 0x97, 0x06, 0x3b, 0x3c, 0x66, 0x6e, 0xe7, 0xd9, 0x1f, 0xcd, 0xb9, 0xa7, 0x2f,
+	0x9a, 0x9b, 0x51, 0x2c, 0xef, 0xe7, 0x3d, 0x3b, 0xe0, 0x09, 0x74, 0x06, 0x3e, 0xb6, 0x44, 0xf8,
+	0x00, 0xc8, 0x40, 0x23, 0x3c, 0x65, 0x6f, 0xdd, 0xc5, 0xab, 0x0a, 0xe2, 0x33, 0x16, 0xf4, 0xfd,
+	0x38, 0xa1, 0xa1, 0x9b, 0x87, 0x11, 0x95, 0x00, 0x49, 0xda, 0xf8, 0xa2, 0x1f, 0x86, 0x19, 0x15,
+	0xa2, 0x81, 0xd6, 0xd1, 0xc6, 0xa2, 0xdb, 0xf8, 0xf5, 0xc7, 0xad, 0x2b, 0xc0, 0x72, 0x5b, 0xaf,
+	0xdc, 0x93, 0x59, 0xcc, 0x22, 0xaf, 0x4c, 0xb4, 0x7e, 0x99, 0xc7, 0x6b, 0x93, 0x3d, 0x61, 0x6b,
+	0x1f, 0xe0, 0xcb, 0xb0, 0xd0, 0xf5, 0x13, 0x9e, 0x33, 0xa9, 0xbc, 0x2f, 0xb5, 0x57, 0x26, 0x6e,
+	0xb2, 0xa0, 0xf5, 0x96, 0x40, 0x70, 0x5b, 0xe5, 0x93, 0x5d, 0xfc, 0x5a, 0xce, 0xc6, 0x3c, 0xe6,
+	0x67, 0x79, 0x2c, 0xe7, 0xac, 0xea, 0xf2, 0x11, 0x5e, 0x66, 0xf4, 0x91, 0xec, 0xaa, 0x68, 0x77,
+	0x3f, 0xe3, 0x49, 0xe3, 0x82, 0x32, 0x31, 0x6c, 0x7d, 0x50, 0xec, 0xf2, 0xa0, 0xd8, 0x9f, 0x96,
+	0x27, 0xc9, 0xad, 0x3d, 0x7e, 0xd1, 0x44, 0xde, 0x52, 0x21, 0xdc, 0x29, 0x74, 0x77, 0x32, 0x9e,
+	0x90, 0x6d, 0x5c, 0x4f, 0x69, 0x16, 0xf3, 0xb0, 0x51, 0x03, 0x8a, 0x71, 0x83, 0x5d, 0x38, 0x69,
+	0x6e, 0xed, 0xbb, 0x42, 0x0f, 0xe9, 0xe4, 0x2d, 0xbc, 0x24, 0x33, 0x9f, 0x05, 0xf7, 0xa9, 0xe8,
+	0xf6, 0xe9, 0xbe, 0x6c, 0x2c, 0xac, 0xa3, 0x8d, 0x9a, 0xf7, 0x6a, 0x19, 0xfc, 0x84, 0xee, 0x4b,
+	0xab, 0x85, 0x89, 0xea, 0xe7, 0x3d, 0x99, 0x51, 0x3f, 0x29, 0x1f, 0xcd, 0x2a, 0x5e, 0x14, 0x2a,
+	0xd0, 0x8d, 0x43, 0xd5, 0xc0, 0x9a, 0xf7, 0x8a, 0x0e, 0x7c, 0x1c, 0x5a, 0x5f, 0x21, 0xfc, 0x7a,
+	0x45, 0x03, 0xad, 0x7f, 0x0f, 0xd7, 0x75, 0x0e, 0xb4, 0xdc, 0xb4, 0x27, 0x5f, 0x5d, 0x5b, 0xeb,
+	0xdc, 0x5a, 0x71, 0xb2, 0x3c, 0xd0, 0x90, 0x5b, 0x78, 0x51, 0xf5, 0xca, 0xef, 0xf5, 0xe9, 0xcc,
+	0x7e, 0x83, 0xf6, 0x54, 0x61, 0x7d, 0x53, 0x9e, 0x78, 0x6d, 0x2e, 0xdc, 0xc1, 0x9e, 0x3f, 0xa0,
+	0x59, 0xb9, 0x21, 0x1b, 0x2f, 0xa4, 0xc5, 0xef, 0x99, 0x27, 0x4d, 0xa7, 0x91, 0x3b, 0x18, 0x9f,
+	0xde, 0x4a, 0xc0, 0xb9, 0x5a, 0xc1, 0xd1, 0x33, 0xaa, 0x84, 0xda, 0xf3, 0x23, 0x0a, 0xb5, 0xbc,
+	0x11, 0xa5, 0xf5, 0x04, 0xe1, 0xd5, 0x89, 0x58, 0xd0, 0xb3, 0xf7, 0xf1, 0x45, 0xbd, 0x7f, 0x01,
+	0x97, 0xf1, 0x6c, 0x4d, 0x2b, 0x45, 0xe4, 0xc3, 0x09, 0x9c, 0x6f, 0xcf, 0xe4, 0xd4, 0xc5, 0x2b,
+	0xa0, 0xdf, 0x23, 0x6c, 0x56, 0x41, 0x3d, 0x1a, 0xc4, 0x69, 0x4c, 0xd9, 0xf0, 0xbe, 0xde, 0xc4,
+	0x8b, 0x59, 0x19, 0x9b, 0xd9, 0xc7, 0xd3, 0xd4, 0xff, 0xac, 0x97, 0x4f, 0x11, 0x6e, 0x4e, 0x45,
+	0xfc, 0x9f, 0xf5, 0xb3, 0xfd, 0x77, 0x1d, 0x2f, 0x28, 0x58, 0xf2, 0x04, 0xe1, 0xa5, 0xca, 0x18,
+	0x26, 0xad, 0x69, 0x4c, 0x53, 0x07, 0xba, 0xd1, 0x3e, 0x8f, 0x44, 0xe3, 0x58, 0xf6, 0x17, 0xbf,
+	0xfd, 0xf5, 0xf5, 0xfc, 0x06, 0xb9, 0xea, 0x4c, 0x79, 0x9d, 0x04, 0xa5, 0xac, 0x5b, 0x44, 0xc8,
+	0x4f, 0x08, 0x2f, 0x8f, 0x8d, 0x55, 0xd2, 0x79, 0x69, 0xdd, 0xc9, 0x83, 0xdd, 0xb8, 0x7e, 0x3e,
+	0x11, 0xe0, 0xbe, 0xa3, 0x70, 0xaf, 0x93, 0xf6, 0x34, 0xdc, 0xd3, 0xa9, 0xdc, 0x53, 0x4a, 0xe7,
+	0x10, 0xde, 0x0a, 0x47, 0xe4, 0x5b, 0x84, 0xeb, 0xfa, 0x81, 0x92, 0x6b, 0x2f, 0x2d, 0x5e, 0x19,
+	0x73, 0xc6, 0xe6, 0x99, 0x72, 0x81, 0xaf, 0xa3, 0xf8, 0xb6, 0xc8, 0xe6, 0x34, 0x3e, 0x38, 0x43,
+	0xce, 0xe1, 0x70, 0x74, 0x1e, 0x91, 0x1f, 0x10, 0xbe, 0x5c, 0xbd, 0xfa, 0xa4, 0x7d, 0x86, 0xa2,
+	0x63, 0xe3, 0xcb, 0xe8, 0x9c, 0x4b, 0x03, 0xc0, 0x37, 0x14, 0xb0, 0x43, 0xb6, 0x66, 0x01, 0xab,
+	0x91, 0xe7, 0x1c, 0xaa, 0x8f, 0x23, 0xf2, 0x33, 0xc2, 0xe4, 0xdf, 0x37, 0x8c, 0xdc, 0x3c, 0x1b,
+	0xc2, 0xf8, 0xd4, 0x30, 0xb6, 0xcf, 0xad, 0x03, 0xfc, 0x5b, 0x0a, 0x7f, 0x9b, 0xdc, 0x98, 0x85,
+	0x3f, 0x9c, 0x34, 0xce, 0xe1, 0xf0, 0xeb, 0x91, 0xfb, 0xee, 0xb3, 0x63, 0x13, 0x3d, 0x3f, 0x36,
+	0xd1, 0x9f, 0xc7, 0x26, 0x7a, 0x7c, 0x62, 0xce, 0x3d, 0x3f, 0x31, 0xe7, 0x7e, 0x3f, 0x31, 0xe7,
+	0x3e, 0x7f, 0x53, 0xfb, 0x89, 0xf0, 0x81, 0x1d, 0x73, 0xe7, 0x51, 0xd5, 0x57, 0xfd, 0x91, 0xe9,
+	0xd5, 0x55, 0xac, 0xf3, 0xcf, 0x00, 0x51, 0xe2, 0xc1, 0x9f, 0xa1, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -323,6 +670,12 @@ type QueryClient interface {
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
 	// UnclaimedBudget queries the remaining budget left to be claimed and it gives overall budget allocation view.
 	UnclaimedBudget(ctx context.Context, in *QueryUnclaimedBudgetRequest, opts ...grpc.CallOption) (*QueryUnclaimedBudgetResponse, error)
+	// Stream queries a stream by its identifier, with its claimable amount.
+	Stream(ctx context.Context, in *QueryStreamRequest, opts ...grpc.CallOption) (*QueryStreamResponse, error)
+	// StreamsByPayer queries the streams paid by an account.
+	StreamsByPayer(ctx context.Context, in *QueryStreamsByPayerRequest, opts ...grpc.CallOption) (*QueryStreamsByPayerResponse, error)
+	// StreamsByRecipient queries the streams received by an account.
+	StreamsByRecipient(ctx context.Context, in *QueryStreamsByRecipientRequest, opts ...grpc.CallOption) (*QueryStreamsByRecipientResponse, error)
 }
 
 type queryClient struct {
@@ -351,12 +704,45 @@ func (c *queryClient) UnclaimedBudget(ctx context.Context, in *QueryUnclaimedBud
 	return out, nil
 }
 
+func (c *queryClient) Stream(ctx context.Context, in *QueryStreamRequest, opts ...grpc.CallOption) (*QueryStreamResponse, error) {
+	out := new(QueryStreamResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Query/Stream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StreamsByPayer(ctx context.Context, in *QueryStreamsByPayerRequest, opts ...grpc.CallOption) (*QueryStreamsByPayerResponse, error) {
+	out := new(QueryStreamsByPayerResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Query/StreamsByPayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StreamsByRecipient(ctx context.Context, in *QueryStreamsByRecipientRequest, opts ...grpc.CallOption) (*QueryStreamsByRecipientResponse, error) {
+	out := new(QueryStreamsByRecipientResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Query/StreamsByRecipient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CommunityPool queries the community pool coins.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
 	// UnclaimedBudget queries the remaining budget left to be claimed and it gives overall budget allocation view.
 	UnclaimedBudget(context.Context, *QueryUnclaimedBudgetRequest) (*QueryUnclaimedBudgetResponse, error)
+	// Stream queries a stream by its identifier, with its claimable amount.
+	Stream(context.Context, *QueryStreamRequest) (*QueryStreamResponse, error)
+	// StreamsByPayer queries the streams paid by an account.
+	StreamsByPayer(context.Context, *QueryStreamsByPayerRequest) (*QueryStreamsByPayerResponse, error)
+	// StreamsByRecipient queries the streams received by an account.
+	StreamsByRecipient(context.Context, *QueryStreamsByRecipientRequest) (*QueryStreamsByRecipientResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -369,6 +755,15 @@ func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCo
 func (*UnimplementedQueryServer) UnclaimedBudget(ctx context.Context, req *QueryUnclaimedBudgetRequest) (*QueryUnclaimedBudgetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnclaimedBudget not implemented")
 }
+func (*UnimplementedQueryServer) Stream(ctx context.Context, req *QueryStreamRequest) (*QueryStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stream not implemented")
+}
+func (*UnimplementedQueryServer) StreamsByPayer(ctx context.Context, req *QueryStreamsByPayerRequest) (*QueryStreamsByPayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamsByPayer not implemented")
+}
+func (*UnimplementedQueryServer) StreamsByRecipient(ctx context.Context, req *QueryStreamsByRecipientRequest) (*QueryStreamsByRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamsByRecipient not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -410,6 +805,60 @@ func _Query_UnclaimedBudget_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Stream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Stream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Query/Stream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Stream(ctx, req.(*QueryStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StreamsByPayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamsByPayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamsByPayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Query/StreamsByPayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamsByPayer(ctx, req.(*QueryStreamsByPayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StreamsByRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamsByRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamsByRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Query/StreamsByRecipient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamsByRecipient(ctx, req.(*QueryStreamsByRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.protocolpool.v1.Query",
@@ -423,6 +872,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnclaimedBudget",
 			Handler:    _Query_UnclaimedBudget_Handler,
 		},
+		{
+			MethodName: "Stream",
+			Handler:    _Query_Stream_Handler,
+		},
+		{
+			MethodName: "StreamsByPayer",
+			Handler:    _Query_StreamsByPayer_Handler,
+		},
+		{
+			MethodName: "StreamsByRecipient",
+			Handler:    _Query_StreamsByRecipient_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/protocolpool/v1/query.proto",
@@ -590,17 +1051,270 @@ func (m *QueryUnclaimedBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
+func (m *QueryStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Claimable.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Stream.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStreamsByPayerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamsByPayerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamsByPayerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStreamsByPayerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamsByPayerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamsByPayerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStreamsByRecipientRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamsByRecipientRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamsByRecipientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStreamsByRecipientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamsByRecipientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamsByRecipientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
 func (m *QueryCommunityPoolRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -666,13 +1380,608 @@ func (m *QueryUnclaimedBudgetResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovQuery(uint64(m.StreamId))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stream.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Claimable.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStreamsByPayerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStreamsByPayerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStreamsByRecipientRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStreamsByRecipientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommunityPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommunityPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommunityPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = append(m.Pool, types.DecCoin{})
+			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnclaimedBudgetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnclaimedBudgetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnclaimedBudgetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnclaimedBudgetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnclaimedBudgetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnclaimedBudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClaimedAmount == nil {
+				m.ClaimedAmount = &types.Coin{}
+			}
+			if err := m.ClaimedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnclaimedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UnclaimedAmount == nil {
+				m.UnclaimedAmount = &types.Coin{}
+			}
+			if err := m.UnclaimedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextClaimFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextClaimFrom == nil {
+				m.NextClaimFrom = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NextClaimFrom, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Period == nil {
+				m.Period = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TranchesLeft", wireType)
+			}
+			m.TranchesLeft = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TranchesLeft |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStreamRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStreamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -695,12 +2004,78 @@ func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCommunityPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCommunityPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claimable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -722,7 +2097,7 @@ func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStreamsByPayerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -745,15 +2120,47 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCommunityPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStreamsByPayerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStreamsByPayerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -780,8 +2187,10 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pool = append(m.Pool, types.DecCoin{})
-			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -806,7 +2215,7 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnclaimedBudgetRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStreamsByPayerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -829,17 +2238,17 @@ func (m *QueryUnclaimedBudgetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnclaimedBudgetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStreamsByPayerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnclaimedBudgetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStreamsByPayerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -849,23 +2258,61 @@ func (m *QueryUnclaimedBudgetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Streams = append(m.Streams, Stream{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -888,7 +2335,7 @@ func (m *QueryUnclaimedBudgetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnclaimedBudgetResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStreamsByRecipientRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -911,17 +2358,17 @@ func (m *QueryUnclaimedBudgetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnclaimedBudgetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStreamsByRecipientRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnclaimedBudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStreamsByRecipientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -931,31 +2378,27 @@ func (m *QueryUnclaimedBudgetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClaimedAmount == nil {
-				m.ClaimedAmount = &types.Coin{}
-			}
-			if err := m.ClaimedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnclaimedAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -982,16 +2425,66 @@ func (m *QueryUnclaimedBudgetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UnclaimedAmount == nil {
-				m.UnclaimedAmount = &types.Coin{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.UnclaimedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStreamsByRecipientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStreamsByRecipientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStreamsByRecipientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextClaimFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1018,16 +2511,14 @@ func (m *QueryUnclaimedBudgetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NextClaimFrom == nil {
-				m.NextClaimFrom = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NextClaimFrom, dAtA[iNdEx:postIndex]); err != nil {
+			m.Streams = append(m.Streams, Stream{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1054,32 +2545,13 @@ func (m *QueryUnclaimedBudgetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Period == nil {
-				m.Period = new(time.Duration)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.Period, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TranchesLeft", wireType)
-			}
-			m.TranchesLeft = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TranchesLeft |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
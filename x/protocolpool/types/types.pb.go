@@ -31,6 +31,42 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// StreamCancellableBy defines the accounts which can cancel a stream.
+type StreamCancellableBy int32
+
+const (
+	// STREAM_CANCELLABLE_BY_NONE defines a stream which cannot be cancelled.
+	StreamCancellableBy_STREAM_CANCELLABLE_BY_NONE StreamCancellableBy = 0
+	// STREAM_CANCELLABLE_BY_PAYER defines a stream which can be cancelled by the payer.
+	StreamCancellableBy_STREAM_CANCELLABLE_BY_PAYER StreamCancellableBy = 1
+	// STREAM_CANCELLABLE_BY_RECIPIENT defines a stream which can be cancelled by the recipient.
+	StreamCancellableBy_STREAM_CANCELLABLE_BY_RECIPIENT StreamCancellableBy = 2
+	// STREAM_CANCELLABLE_BY_BOTH defines a stream which can be cancelled by the payer or the recipient.
+	StreamCancellableBy_STREAM_CANCELLABLE_BY_BOTH StreamCancellableBy = 3
+)
+
+var StreamCancellableBy_name = map[int32]string{
+	0: "STREAM_CANCELLABLE_BY_NONE",
+	1: "STREAM_CANCELLABLE_BY_PAYER",
+	2: "STREAM_CANCELLABLE_BY_RECIPIENT",
+	3: "STREAM_CANCELLABLE_BY_BOTH",
+}
+
+var StreamCancellableBy_value = map[string]int32{
+	"STREAM_CANCELLABLE_BY_NONE":      0,
+	"STREAM_CANCELLABLE_BY_PAYER":     1,
+	"STREAM_CANCELLABLE_BY_RECIPIENT": 2,
+	"STREAM_CANCELLABLE_BY_BOTH":      3,
+}
+
+func (x StreamCancellableBy) String() string {
+	return proto.EnumName(StreamCancellableBy_name, int32(x))
+}
+
+func (StreamCancellableBy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{0}
+}
+
 // Budget defines the fields of a budget proposal.
 type Budget struct {
 	// recipient_address is the address of the recipient who can claim the budget.
@@ -182,9 +218,114 @@ func (m *ContinuousFund) GetExpiry() *time.Time {
 	return nil
 }
 
+// Stream defines a streaming payment from a payer to a recipient, escrowed from the
+// payer's balance at creation.
+type Stream struct {
+	// id is the unique identifier of the stream.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// payer is the address of the account paying the stream.
+	Payer string `protobuf:"bytes,2,opt,name=payer,proto3" json:"payer,omitempty"`
+	// schedule is the release schedule of the stream: the recipient, the rate paid per
+	// period and the amounts claimed so far. It is processed like a budget.
+	Schedule Budget `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule"`
+	// start_time is the time when the stream starts accruing.
+	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is the time when the stream is fully paid.
+	EndTime time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// cliff_time is the time before which nothing can be claimed. The amount accrued at
+	// the cliff time becomes claimable at once. Optional.
+	CliffTime *time.Time `protobuf:"bytes,6,opt,name=cliff_time,json=cliffTime,proto3,stdtime" json:"cliff_time,omitempty"`
+	// cancellable_by defines the accounts which can cancel the stream.
+	CancellableBy StreamCancellableBy `protobuf:"varint,7,opt,name=cancellable_by,json=cancellableBy,proto3,enum=cosmos.protocolpool.v1.StreamCancellableBy" json:"cancellable_by,omitempty"`
+}
+
+func (m *Stream) Reset()         { *m = Stream{} }
+func (m *Stream) String() string { return proto.CompactTextString(m) }
+func (*Stream) ProtoMessage()    {}
+func (*Stream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{2}
+}
+func (m *Stream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Stream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Stream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Stream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stream.Merge(m, src)
+}
+func (m *Stream) XXX_Size() int {
+	return m.Size()
+}
+func (m *Stream) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Stream proto.InternalMessageInfo
+
+func (m *Stream) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Stream) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *Stream) GetSchedule() Budget {
+	if m != nil {
+		return m.Schedule
+	}
+	return Budget{}
+}
+
+func (m *Stream) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *Stream) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *Stream) GetCliffTime() *time.Time {
+	if m != nil {
+		return m.CliffTime
+	}
+	return nil
+}
+
+func (m *Stream) GetCancellableBy() StreamCancellableBy {
+	if m != nil {
+		return m.CancellableBy
+	}
+	return StreamCancellableBy_STREAM_CANCELLABLE_BY_NONE
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.protocolpool.v1.StreamCancellableBy", StreamCancellableBy_name, StreamCancellableBy_value)
 	proto.RegisterType((*Budget)(nil), "cosmos.protocolpool.v1.Budget")
 	proto.RegisterType((*ContinuousFund)(nil), "cosmos.protocolpool.v1.ContinuousFund")
+	proto.RegisterType((*Stream)(nil), "cosmos.protocolpool.v1.Stream")
 }
 
 func init() {
@@ -192,39 +333,53 @@ func init() {
 }
 
 var fileDescriptor_c1b7d0ea246d7f44 = []byte{
-	// 502 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0x8e, 0x69, 0x88, 0x94, 0x83, 0x94, 0x72, 0xaa, 0x90, 0x1b, 0x24, 0x27, 0x84, 0x25, 0x4b,
-	0xcf, 0x0a, 0x48, 0x80, 0xc4, 0x42, 0x9d, 0xf0, 0x31, 0x74, 0x80, 0xd0, 0x89, 0xc5, 0x3a, 0xdb,
-	0x6f, 0xdc, 0x13, 0xf6, 0x9d, 0x75, 0x77, 0x8e, 0x9a, 0x95, 0x5f, 0xd0, 0x91, 0x1f, 0xd2, 0x1f,
-	0xd1, 0xb1, 0xaa, 0x84, 0x84, 0x18, 0x0a, 0x4a, 0xfe, 0x08, 0x8a, 0xef, 0x12, 0xd2, 0x2e, 0x65,
-	0xb3, 0xde, 0xe7, 0xc3, 0xcf, 0xf3, 0x9e, 0x5e, 0xd4, 0x8b, 0x85, 0xca, 0x85, 0xf2, 0x0b, 0x29,
-	0xb4, 0x88, 0x45, 0x56, 0x08, 0x91, 0xf9, 0xd3, 0x81, 0xaf, 0x67, 0x05, 0x28, 0x52, 0x4d, 0xf1,
-	0x23, 0xc3, 0x21, 0x9b, 0x1c, 0x32, 0x1d, 0xb4, 0x77, 0x53, 0x91, 0x8a, 0x6a, 0xe8, 0x2f, 0xbf,
-	0x0c, 0xde, 0xde, 0x33, 0xec, 0xd0, 0x00, 0x9b, 0xd2, 0xb6, 0x67, 0x7f, 0x16, 0x51, 0x05, 0xfe,
-	0x74, 0x10, 0x81, 0xa6, 0x03, 0x3f, 0x16, 0x8c, 0x5b, 0xbc, 0x93, 0x0a, 0x91, 0x66, 0x60, 0xc2,
-	0x44, 0xe5, 0xc4, 0xd7, 0x2c, 0x07, 0xa5, 0x69, 0x5e, 0xac, 0x0c, 0x6e, 0x12, 0x92, 0x52, 0x52,
-	0xcd, 0x84, 0x35, 0xe8, 0x7d, 0xdb, 0x42, 0x8d, 0xa0, 0x4c, 0x52, 0xd0, 0xf8, 0x2d, 0x7a, 0x28,
-	0x21, 0x66, 0x05, 0x03, 0xae, 0x43, 0x9a, 0x24, 0x12, 0x94, 0x72, 0x9d, 0xae, 0xd3, 0x6f, 0x06,
-	0xee, 0xe5, 0xd9, 0xfe, 0xae, 0x0d, 0x76, 0x60, 0x90, 0xcf, 0x5a, 0x32, 0x9e, 0x8e, 0x77, 0xd6,
-	0x12, 0x3b, 0xc7, 0x6f, 0xd0, 0x76, 0x9c, 0x51, 0x96, 0x43, 0x12, 0xd2, 0x5c, 0x94, 0x5c, 0xbb,
-	0x77, 0xba, 0x4e, 0xff, 0xde, 0xb3, 0x3d, 0x62, 0x0d, 0x96, 0x5d, 0x88, 0xed, 0x42, 0x86, 0x82,
-	0xf1, 0x71, 0xcb, 0x0a, 0x0e, 0x2a, 0x3e, 0xfe, 0x80, 0x1e, 0x64, 0x54, 0xe9, 0x70, 0x6d, 0xa3,
-	0xdd, 0xad, 0xca, 0xa2, 0x4d, 0x4c, 0x1b, 0xb2, 0x6a, 0x43, 0x8e, 0x56, 0x75, 0x83, 0xfa, 0xe9,
-	0xef, 0x8e, 0x33, 0x6e, 0x2d, 0x85, 0x43, 0xeb, 0xa6, 0xf1, 0x53, 0xd4, 0xd2, 0x92, 0xf2, 0xf8,
-	0x18, 0x54, 0x98, 0xc1, 0x44, 0xbb, 0xf5, 0xae, 0xd3, 0xaf, 0x8f, 0xef, 0xaf, 0x86, 0x87, 0x30,
-	0xd1, 0xf8, 0x3d, 0xc2, 0x51, 0xb5, 0x81, 0xb0, 0x00, 0x19, 0x5a, 0xc8, 0xbd, 0x7b, 0x5b, 0xe8,
-	0x1d, 0x23, 0xfa, 0x08, 0xf2, 0xc8, 0x48, 0xf0, 0x4b, 0xd4, 0x28, 0x40, 0x32, 0x91, 0xb8, 0x0d,
-	0x2b, 0xbe, 0x19, 0x77, 0x64, 0x97, 0x1f, 0xd4, 0xbf, 0x2f, 0xd3, 0x5a, 0x7a, 0xef, 0x87, 0x83,
-	0xb6, 0x87, 0x82, 0x6b, 0xc6, 0x4b, 0x51, 0xaa, 0x77, 0x25, 0x4f, 0xf0, 0x0b, 0xd4, 0x5c, 0x6f,
-	0xf6, 0xd6, 0x47, 0xf8, 0x47, 0xc5, 0x9f, 0x10, 0x2a, 0x40, 0xc6, 0xc0, 0x35, 0x4d, 0xa1, 0xda,
-	0x7c, 0x33, 0x18, 0x9c, 0x5f, 0x75, 0x6a, 0xbf, 0xae, 0x3a, 0x8f, 0x8d, 0x58, 0x25, 0x5f, 0x09,
-	0x13, 0x7e, 0x4e, 0xf5, 0x31, 0x39, 0x84, 0x94, 0xc6, 0xb3, 0x11, 0xc4, 0x97, 0x67, 0xfb, 0xc8,
-	0x7a, 0x8f, 0x20, 0x1e, 0x6f, 0x98, 0xe0, 0x57, 0xa8, 0x01, 0x27, 0x05, 0x93, 0xb3, 0xff, 0x7e,
-	0x05, 0xcb, 0x0f, 0x5e, 0x9f, 0xcf, 0x3d, 0xe7, 0x62, 0xee, 0x39, 0x7f, 0xe6, 0x9e, 0x73, 0xba,
-	0xf0, 0x6a, 0x17, 0x0b, 0xaf, 0xf6, 0x73, 0xe1, 0xd5, 0xbe, 0x3c, 0xb9, 0x16, 0xe5, 0xe4, 0xfa,
-	0x31, 0x55, 0x97, 0x14, 0x35, 0xaa, 0xd9, 0xf3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x86, 0x82,
-	0x01, 0x87, 0x70, 0x03, 0x00, 0x00,
+	// 723 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x4e, 0xe3, 0x46,
+	0x18, 0x8f, 0x93, 0x10, 0xc8, 0xb4, 0x49, 0xd3, 0x29, 0xaa, 0x4c, 0x90, 0x9c, 0x34, 0x5c, 0xa2,
+	0x56, 0xd8, 0x0a, 0x95, 0xda, 0x4a, 0x3d, 0x94, 0xd8, 0xb8, 0x05, 0x29, 0x0d, 0xd4, 0xe4, 0x42,
+	0x2f, 0xd6, 0xc4, 0xfe, 0x62, 0xac, 0x75, 0x3c, 0x96, 0x3d, 0x46, 0xe4, 0xba, 0x4f, 0xc0, 0x71,
+	0xa5, 0x3d, 0xef, 0x1b, 0xf0, 0x10, 0x1c, 0x11, 0xd2, 0x4a, 0xab, 0x3d, 0xb0, 0x2b, 0x78, 0x91,
+	0x95, 0xed, 0x49, 0x08, 0x28, 0x28, 0xec, 0xcd, 0xfe, 0x7e, 0x7f, 0xe6, 0xfb, 0x7d, 0xf3, 0x07,
+	0xb5, 0x2c, 0x1a, 0x8d, 0x69, 0xa4, 0x04, 0x21, 0x65, 0xd4, 0xa2, 0x5e, 0x40, 0xa9, 0xa7, 0x9c,
+	0x75, 0x14, 0x36, 0x09, 0x20, 0x92, 0xd3, 0x2a, 0xfe, 0x31, 0xe3, 0xc8, 0xf3, 0x1c, 0xf9, 0xac,
+	0x53, 0x5f, 0x77, 0xa8, 0x43, 0xd3, 0xa2, 0x92, 0x7c, 0x65, 0x78, 0x7d, 0x23, 0x63, 0x9b, 0x19,
+	0x30, 0x2f, 0xad, 0x4b, 0x7c, 0xb1, 0x21, 0x89, 0x40, 0x39, 0xeb, 0x0c, 0x81, 0x91, 0x8e, 0x62,
+	0x51, 0xd7, 0xe7, 0x78, 0xc3, 0xa1, 0xd4, 0xf1, 0x20, 0x6b, 0x66, 0x18, 0x8f, 0x14, 0xe6, 0x8e,
+	0x21, 0x62, 0x64, 0x1c, 0x4c, 0x0d, 0x9e, 0x12, 0xec, 0x38, 0x24, 0xcc, 0xa5, 0xdc, 0xa0, 0xf5,
+	0xba, 0x80, 0x4a, 0x6a, 0x6c, 0x3b, 0xc0, 0xb0, 0x8e, 0xbe, 0x0f, 0xc1, 0x72, 0x03, 0x17, 0x7c,
+	0x66, 0x12, 0xdb, 0x0e, 0x21, 0x8a, 0x44, 0xa1, 0x29, 0xb4, 0xcb, 0xaa, 0x78, 0x73, 0xb9, 0xbd,
+	0xce, 0x1b, 0xeb, 0x66, 0xc8, 0x31, 0x0b, 0x5d, 0xdf, 0x31, 0x6a, 0x33, 0x09, 0xaf, 0xe3, 0x5d,
+	0x54, 0xb5, 0x3c, 0xe2, 0x8e, 0xc1, 0x36, 0xc9, 0x98, 0xc6, 0x3e, 0x13, 0xf3, 0x4d, 0xa1, 0xfd,
+	0xcd, 0xce, 0x86, 0xcc, 0x0d, 0x92, 0x2c, 0x32, 0xcf, 0x22, 0x6b, 0xd4, 0xf5, 0x8d, 0x0a, 0x17,
+	0x74, 0x53, 0x3e, 0xde, 0x47, 0xdf, 0x79, 0x24, 0x62, 0xe6, 0xcc, 0x86, 0x89, 0x85, 0xd4, 0xa2,
+	0x2e, 0x67, 0x69, 0xe4, 0x69, 0x1a, 0x79, 0x30, 0x8d, 0xab, 0x16, 0x2f, 0x3e, 0x35, 0x04, 0xa3,
+	0x92, 0x08, 0x35, 0xee, 0xc6, 0xf0, 0x16, 0xaa, 0xb0, 0x90, 0xf8, 0xd6, 0x29, 0x44, 0xa6, 0x07,
+	0x23, 0x26, 0x16, 0x9b, 0x42, 0xbb, 0x68, 0x7c, 0x3b, 0x2d, 0xf6, 0x60, 0xc4, 0xf0, 0x3f, 0x08,
+	0x0f, 0xd3, 0x09, 0x98, 0x01, 0x84, 0x26, 0x87, 0xc4, 0x95, 0x65, 0x4d, 0xd7, 0x32, 0xd1, 0x11,
+	0x84, 0x83, 0x4c, 0x82, 0x7f, 0x47, 0xa5, 0x00, 0x42, 0x97, 0xda, 0x62, 0x89, 0x8b, 0x9f, 0xb6,
+	0xbb, 0xc7, 0x87, 0xaf, 0x16, 0xdf, 0x24, 0xdd, 0x72, 0x7a, 0xeb, 0xbd, 0x80, 0xaa, 0x1a, 0xf5,
+	0x99, 0xeb, 0xc7, 0x34, 0x8e, 0xfe, 0x8e, 0x7d, 0x1b, 0xff, 0x86, 0xca, 0xb3, 0xc9, 0x2e, 0xdd,
+	0x84, 0x07, 0x2a, 0xfe, 0x0f, 0xa1, 0x00, 0x42, 0x0b, 0x7c, 0x46, 0x1c, 0x48, 0x27, 0x5f, 0x56,
+	0x3b, 0x57, 0xb7, 0x8d, 0xdc, 0xc7, 0xdb, 0xc6, 0x66, 0x26, 0x8e, 0xec, 0x57, 0xb2, 0x4b, 0x95,
+	0x31, 0x61, 0xa7, 0x72, 0x0f, 0x1c, 0x62, 0x4d, 0xf6, 0xc0, 0xba, 0xb9, 0xdc, 0x46, 0xdc, 0x7b,
+	0x0f, 0x2c, 0x63, 0xce, 0x04, 0xff, 0x81, 0x4a, 0x70, 0x1e, 0xb8, 0xe1, 0xe4, 0xc5, 0xbb, 0xc0,
+	0xf9, 0xad, 0x77, 0x05, 0x54, 0x3a, 0x66, 0x21, 0x90, 0x31, 0xae, 0xa2, 0xbc, 0x6b, 0xa7, 0x41,
+	0x8a, 0x46, 0xde, 0xb5, 0xb1, 0x8c, 0x56, 0x02, 0x32, 0x81, 0x50, 0xcc, 0x2f, 0xc9, 0x96, 0xd1,
+	0xf0, 0x2e, 0x5a, 0x8b, 0xac, 0x53, 0xb0, 0x63, 0x0f, 0x78, 0x1b, 0x92, 0xbc, 0xf8, 0x92, 0xc9,
+	0xd9, 0x71, 0x56, 0x8b, 0x49, 0x6a, 0x63, 0xa6, 0xc2, 0x1a, 0x42, 0x11, 0x23, 0x21, 0x33, 0x93,
+	0x2b, 0x22, 0x16, 0x97, 0x46, 0x59, 0x4b, 0xf4, 0x69, 0x9c, 0x72, 0xaa, 0x4b, 0x10, 0xfc, 0x17,
+	0x5a, 0x03, 0xdf, 0xce, 0x2c, 0x56, 0xbe, 0xc2, 0x62, 0x15, 0x7c, 0x9b, 0x1b, 0x20, 0xcb, 0x73,
+	0x47, 0xa3, 0xcc, 0xa2, 0xf4, 0xc2, 0x81, 0x96, 0x53, 0x4d, 0x6a, 0x60, 0xa0, 0xaa, 0x45, 0x7c,
+	0x0b, 0x3c, 0x8f, 0x0c, 0x3d, 0x30, 0x87, 0x13, 0x71, 0xb5, 0x29, 0xb4, 0xab, 0x3b, 0xbf, 0x3c,
+	0x37, 0x8e, 0x6c, 0x03, 0xb4, 0x07, 0x8d, 0x3a, 0x31, 0x2a, 0xd6, 0xfc, 0xef, 0xcf, 0x6f, 0x05,
+	0xf4, 0xc3, 0x02, 0x1a, 0x96, 0x50, 0xfd, 0x78, 0x60, 0xe8, 0xdd, 0x7f, 0x4d, 0xad, 0xdb, 0xd7,
+	0xf4, 0x5e, 0xaf, 0xab, 0xf6, 0x74, 0x53, 0x3d, 0x31, 0xfb, 0x87, 0x7d, 0xbd, 0x96, 0xc3, 0x0d,
+	0xb4, 0xb9, 0x18, 0x3f, 0xea, 0x9e, 0xe8, 0x46, 0x4d, 0xc0, 0x5b, 0xa8, 0xb1, 0x98, 0x60, 0xe8,
+	0xda, 0xc1, 0xd1, 0x81, 0xde, 0x1f, 0xd4, 0xf2, 0xcf, 0xaf, 0xa2, 0x1e, 0x0e, 0xf6, 0x6b, 0x05,
+	0xf5, 0xcf, 0xab, 0x3b, 0x49, 0xb8, 0xbe, 0x93, 0x84, 0xcf, 0x77, 0x92, 0x70, 0x71, 0x2f, 0xe5,
+	0xae, 0xef, 0xa5, 0xdc, 0x87, 0x7b, 0x29, 0xf7, 0xff, 0x4f, 0x8f, 0x0e, 0xf4, 0xf9, 0xe3, 0x27,
+	0x39, 0x7d, 0x8f, 0x87, 0xa5, 0xb4, 0xf6, 0xeb, 0x97, 0x01, 0x00, 0x53, 0xa5, 0xac, 0x82, 0xb6,
+	0x05, 0x00, 0x00,
 }
 
 func (m *Budget) Marshal() (dAtA []byte, err error) {
@@ -356,6 +511,82 @@ func (m *ContinuousFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Stream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Stream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Stream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CancellableBy != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CancellableBy))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CliffTime != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CliffTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CliffTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintTypes(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x32
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x2a
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintTypes(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -418,6 +649,35 @@ func (m *ContinuousFund) Size() (n int) {
 	return n
 }
 
+func (m *Stream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Schedule.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.CliffTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CliffTime)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CancellableBy != 0 {
+		n += 1 + sovTypes(uint64(m.CancellableBy))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -821,6 +1081,261 @@ func (m *ContinuousFund) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Stream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Stream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Stream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CliffTime == nil {
+				m.CliffTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CliffTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancellableBy", wireType)
+			}
+			m.CancellableBy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CancellableBy |= StreamCancellableBy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -24,6 +24,9 @@ func TestRegisterInterfaces(t *testing.T) {
 	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgCreateContinuousFund{}))
 	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgCancelContinuousFund{}))
 	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgWithdrawContinuousFund{}))
+	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgCreateStream{}))
+	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgClaimStream{}))
+	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgCancelStream{}))
 }
 
 func TestNewMsgFundCommunityPool(t *testing.T) {
@@ -81,6 +84,44 @@ func TestValidateGenesis(t *testing.T) {
 	require.EqualError(t, err, "recipient cannot be empty")
 }
 
+func TestValidateGenesisStreams(t *testing.T) {
+	startTime := time.Now()
+	stream := Stream{
+		Id:    1,
+		Payer: "cosmos1qypq2q2l8z4wz2z2l8z4wz2z2l8z4wz2z2l8z5",
+		Schedule: Budget{
+			RecipientAddress: "cosmos1qypq2q2l8z4wz2z2l8z4wz2z2l8z4wz2z2l8z4",
+			ClaimedAmount:    &sdk.Coin{},
+			LastClaimedAt:    &startTime,
+			TranchesLeft:     10,
+			BudgetPerTranche: &sdk.Coin{Denom: "stake", Amount: math.NewInt(100)},
+			Period:           &hour,
+		},
+		StartTime: startTime,
+		EndTime:   startTime.Add(10 * hour),
+	}
+
+	gs := DefaultGenesisState()
+	gs.Streams = []Stream{stream}
+	gs.NextStreamId = 2
+	require.NoError(t, ValidateGenesis(gs))
+
+	gs.Streams = []Stream{stream, stream}
+	require.EqualError(t, ValidateGenesis(gs), "duplicate stream id 1")
+
+	gs.Streams = []Stream{stream}
+	gs.NextStreamId = 1
+	require.EqualError(t, ValidateGenesis(gs), "stream id 1 must be lower than the next stream id 1")
+
+	gs.NextStreamId = 2
+	gs.Streams[0].EndTime = startTime
+	require.EqualError(t, ValidateGenesis(gs), "invalid stream 1: end time must be after the start time")
+
+	gs.Streams[0].EndTime = startTime.Add(10 * hour)
+	gs.Streams[0].Payer = ""
+	require.EqualError(t, ValidateGenesis(gs), "invalid stream 1: payer cannot be empty")
+}
+
 func TestValidateBudget(t *testing.T) {
 	testCases := []struct {
 		name      string
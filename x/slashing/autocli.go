@@ -34,6 +34,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "signing-infos",
 					Short:     "Query signing information of all validators",
 				},
+				{
+					RpcMethod: "InfractionHistory",
+					Use:       "infraction-history <validator-cons-address>",
+					Short:     "Query the infractions a validator was slashed for",
+					Example:   fmt.Sprintf("%s query slashing infraction-history cosmosvalcons1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "cons_address"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -54,6 +63,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod:      "RevertTombstone",
+					Use:            "revert-tombstone-proposal <validator-cons-address>",
+					Short:          "Submit a proposal to revert the tombstoning of a validator and refund its delegators from the community pool",
+					Example:        fmt.Sprintf("%s tx slashing revert-tombstone-proposal cosmosvalcons1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "cons_address"}},
+					GovProposal:    true,
+				},
 			},
 		},
 	}
@@ -41,6 +41,7 @@ type ModuleInputs struct {
 	AccountKeeper types.AccountKeeper
 	BankKeeper    types.BankKeeper
 	StakingKeeper types.StakingKeeper
+	PoolKeeper    types.PoolKeeper `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -63,7 +64,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		panic(fmt.Errorf("unable to decode authority in slashing: %w", err))
 	}
 
-	k := keeper.NewKeeper(in.Environment, in.Cdc, nil, in.StakingKeeper, authStr)
+	k := keeper.NewKeeper(in.Environment, in.Cdc, nil, in.AccountKeeper, in.StakingKeeper, in.PoolKeeper, authStr)
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.StakingKeeper, in.Registry, in.CometService)
 	return ModuleOutputs{
 		Keeper: k,
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"sort"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/slashing/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -59,6 +61,22 @@ func (keeper Keeper) InitGenesis(ctx context.Context, stakingKeeper types.Stakin
 		}
 	}
 
+	for _, record := range data.InfractionHistory {
+		address, err := keeper.sk.ConsensusAddressCodec().StringToBytes(record.Address)
+		if err != nil {
+			return err
+		}
+
+		seq, err := keeper.InfractionSequence.Next(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := keeper.InfractionHistory.Set(ctx, collections.Join(sdk.ConsAddress(address), seq), record); err != nil {
+			return err
+		}
+	}
+
 	if err := keeper.Params.Set(ctx, data.Params); err != nil {
 		return err
 	}
@@ -100,5 +118,24 @@ func (keeper Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, er
 	if err != nil {
 		return nil, err
 	}
-	return types.NewGenesisState(params, signingInfos, missedBlocks), nil
+
+	iter, err := keeper.InfractionHistory.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	records, err := iter.KeyValues()
+	if err != nil {
+		return nil, err
+	}
+
+	// the records are exported in the order they were recorded, not by validator
+	sort.SliceStable(records, func(i, j int) bool { return records[i].Key.K2() < records[j].Key.K2() })
+	infractionHistory := make([]types.InfractionRecord, 0, len(records))
+	for _, record := range records {
+		infractionHistory = append(infractionHistory, record.Value)
+	}
+
+	return types.NewGenesisState(params, signingInfos, missedBlocks, infractionHistory), nil
 }
@@ -6,10 +6,12 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/store/prefix"
 	"cosmossdk.io/x/slashing/types"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
@@ -80,3 +82,34 @@ func (k Querier) SigningInfos(ctx context.Context, req *types.QuerySigningInfosR
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+// InfractionHistory returns the infraction history of a specific validator.
+func (k Querier) InfractionHistory(ctx context.Context, req *types.QueryInfractionHistoryRequest) (*types.QueryInfractionHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := k.sk.ConsensusAddressCodec().StringToBytes(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	infractions, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.Keeper.InfractionHistory,
+		req.Pagination,
+		func(_ collections.Pair[sdk.ConsAddress, uint64], record types.InfractionRecord) (types.InfractionRecord, error) {
+			return record, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.ConsAddress, uint64](consAddr),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryInfractionHistoryResponse{Infractions: infractions, Pagination: pageRes}, nil
+}
@@ -0,0 +1,349 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/slashing/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// recordInfraction appends an infraction a validator was slashed for to its infraction history.
+func (k Keeper) recordInfraction(
+	ctx context.Context, consAddr sdk.ConsAddress, infraction stakingtypes.Infraction, evidenceType string,
+	infractionHeight int64, fraction sdkmath.LegacyDec, slashedTokens sdkmath.Int, slashedStakes []types.SlashedStake,
+) error {
+	consStr, err := k.sk.ConsensusAddressCodec().BytesToString(consAddr)
+	if err != nil {
+		return err
+	}
+
+	seq, err := k.InfractionSequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	return k.InfractionHistory.Set(ctx, collections.Join(consAddr, seq), types.InfractionRecord{
+		Address:          consStr,
+		Infraction:       infraction,
+		EvidenceType:     evidenceType,
+		InfractionHeight: infractionHeight,
+		Time:             k.HeaderService.HeaderInfo(ctx).Time,
+		SlashFraction:    fraction,
+		SlashedTokens:    slashedTokens,
+		SlashedStakes:    slashedStakes,
+	})
+}
+
+// redelegationKey identifies the delegation a redelegation from a slashed validator was made to.
+type redelegationKey struct {
+	delegator    string
+	dstValidator string
+}
+
+// stakeSnapshot is the stake of the delegators of a validator, taken before the validator is
+// slashed to record the amounts slashed from each of them.
+type stakeSnapshot struct {
+	valAddr       sdk.ValAddress
+	validator     sdk.ValidatorI
+	delegations   []stakingtypes.Delegation
+	unbondings    map[string]sdkmath.Int
+	redelegations map[redelegationKey]sdkmath.LegacyDec
+}
+
+// snapshotStake returns the stake of the delegators of a validator: its delegations, the balances
+// of the unbonding delegations from it and the tokens of the delegations redelegated from it.
+// It returns nil if the validator does not exist.
+func (k Keeper) snapshotStake(ctx context.Context, consAddr sdk.ConsAddress) (*stakeSnapshot, error) {
+	validator, err := k.sk.ValidatorByConsAddr(ctx, consAddr)
+	if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	if err != nil {
+		return nil, err
+	}
+
+	delegations, err := k.sk.GetValidatorDelegations(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	unbondings, err := k.unbondingBalances(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	reds, err := k.sk.GetRedelegationsFromSrcValidator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	redelegations := make(map[redelegationKey]sdkmath.LegacyDec, len(reds))
+	for _, red := range reds {
+		key := redelegationKey{delegator: red.DelegatorAddress, dstValidator: red.ValidatorDstAddress}
+		if redelegations[key], err = k.delegationTokens(ctx, key.delegator, key.dstValidator); err != nil {
+			return nil, err
+		}
+	}
+
+	return &stakeSnapshot{
+		valAddr:       valAddr,
+		validator:     validator,
+		delegations:   delegations,
+		unbondings:    unbondings,
+		redelegations: redelegations,
+	}, nil
+}
+
+// slashedStakes returns the amounts slashed from each delegator of a validator since the stake
+// snapshot was taken, sorted by delegator address.
+func (k Keeper) slashedStakes(ctx context.Context, snapshot *stakeSnapshot) ([]types.SlashedStake, error) {
+	if snapshot == nil {
+		return nil, nil
+	}
+
+	stakes := make(map[string]*types.SlashedStake)
+	stake := func(delegator string) *types.SlashedStake {
+		if _, ok := stakes[delegator]; !ok {
+			stakes[delegator] = &types.SlashedStake{
+				DelegatorAddress: delegator,
+				Delegation:       sdkmath.ZeroInt(),
+				Unbonding:        sdkmath.ZeroInt(),
+				Redelegation:     sdkmath.ZeroInt(),
+			}
+		}
+		return stakes[delegator]
+	}
+
+	// the slash only burns validator tokens, the delegator shares are left unchanged
+	validator, err := k.sk.Validator(ctx, snapshot.valAddr)
+	if err != nil {
+		return nil, err
+	}
+	for _, delegation := range snapshot.delegations {
+		slashed := snapshot.validator.TokensFromShares(delegation.Shares).Sub(validator.TokensFromShares(delegation.Shares))
+		if amount := slashed.TruncateInt(); amount.IsPositive() {
+			stake(delegation.DelegatorAddress).Delegation = amount
+		}
+	}
+
+	unbondings, err := k.unbondingBalances(ctx, snapshot.valAddr)
+	if err != nil {
+		return nil, err
+	}
+	for delegator, before := range snapshot.unbondings {
+		after, ok := unbondings[delegator]
+		if !ok {
+			continue
+		}
+		if amount := before.Sub(after); amount.IsPositive() {
+			stake(delegator).Unbonding = amount
+		}
+	}
+
+	for key, before := range snapshot.redelegations {
+		after, err := k.delegationTokens(ctx, key.delegator, key.dstValidator)
+		if err != nil {
+			return nil, err
+		}
+		if amount := before.Sub(after).TruncateInt(); amount.IsPositive() {
+			s := stake(key.delegator)
+			s.Redelegation = s.Redelegation.Add(amount)
+		}
+	}
+
+	slashed := make([]types.SlashedStake, 0, len(stakes))
+	for _, s := range stakes {
+		slashed = append(slashed, *s)
+	}
+	slices.SortFunc(slashed, func(a, b types.SlashedStake) int {
+		return strings.Compare(a.DelegatorAddress, b.DelegatorAddress)
+	})
+
+	return slashed, nil
+}
+
+// unbondingBalances returns the balances of the unbonding delegations from a validator, by delegator.
+func (k Keeper) unbondingBalances(ctx context.Context, valAddr sdk.ValAddress) (map[string]sdkmath.Int, error) {
+	ubds, err := k.sk.GetUnbondingDelegationsFromValidator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make(map[string]sdkmath.Int, len(ubds))
+	for _, ubd := range ubds {
+		balance := sdkmath.ZeroInt()
+		for _, entry := range ubd.Entries {
+			balance = balance.Add(entry.Balance)
+		}
+		balances[ubd.DelegatorAddress] = balance
+	}
+
+	return balances, nil
+}
+
+// delegationTokens returns the tokens a delegation is worth, or zero if the delegation or its
+// validator does not exist.
+func (k Keeper) delegationTokens(ctx context.Context, delegator, validator string) (sdkmath.LegacyDec, error) {
+	delAddr, err := k.ak.AddressCodec().StringToBytes(delegator)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(validator)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	val, err := k.sk.Validator(ctx, valAddr)
+	if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+		return sdkmath.LegacyZeroDec(), nil
+	} else if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	delegation, err := k.sk.Delegation(ctx, delAddr, valAddr)
+	if errors.Is(err, collections.ErrNotFound) || errors.Is(err, stakingtypes.ErrNoDelegation) {
+		return sdkmath.LegacyZeroDec(), nil
+	} else if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	return val.TokensFromShares(delegation.GetShares()), nil
+}
+
+// lastInfraction returns the sequence and the record of the last infraction of a validator,
+// and whether the validator has any infraction in its history.
+func (k Keeper) lastInfraction(ctx context.Context, consAddr sdk.ConsAddress) (uint64, types.InfractionRecord, bool, error) {
+	rng := collections.NewPrefixedPairRange[sdk.ConsAddress, uint64](consAddr).Descending()
+	iter, err := k.InfractionHistory.Iterate(ctx, rng)
+	if err != nil {
+		return 0, types.InfractionRecord{}, false, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, types.InfractionRecord{}, false, nil
+	}
+
+	kv, err := iter.KeyValue()
+	if err != nil {
+		return 0, types.InfractionRecord{}, false, err
+	}
+
+	return kv.Key.K2(), kv.Value, true, nil
+}
+
+// tombstoneLastInfraction marks the last infraction of a validator as the one it was
+// tombstoned for.
+func (k Keeper) tombstoneLastInfraction(ctx context.Context, consAddr sdk.ConsAddress) error {
+	seq, record, found, err := k.lastInfraction(ctx, consAddr)
+	if err != nil || !found {
+		return err
+	}
+
+	record.Tombstoned = true
+	return k.InfractionHistory.Set(ctx, collections.Join(consAddr, seq), record)
+}
+
+// RevertTombstone reverts the tombstoning of a validator, which can then unjail itself, and
+// refunds the tokens slashed for the infraction it was tombstoned for from the community pool
+// to the delegators they were slashed from. It fails if the keeper has no community pool keeper.
+func (k Keeper) RevertTombstone(ctx context.Context, consAddr sdk.ConsAddress) error {
+	if k.pk == nil {
+		return types.ErrNoPoolKeeper.Wrap("cannot refund the slashed tokens from the community pool")
+	}
+
+	consStr, err := k.sk.ConsensusAddressCodec().BytesToString(consAddr)
+	if err != nil {
+		return err
+	}
+
+	signInfo, err := k.ValidatorSigningInfo.Get(ctx, consAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ErrNoSigningInfoFound.Wrapf("cannot revert the tombstone of validator with consensus address %s that does not have any signing information", consStr)
+	} else if err != nil {
+		return err
+	}
+
+	if !signInfo.Tombstoned {
+		return types.ErrValidatorNotTombstoned.Wrapf("cannot revert the tombstone of validator with consensus address %s", consStr)
+	}
+
+	signInfo.Tombstoned = false
+	signInfo.JailedUntil = k.HeaderService.HeaderInfo(ctx).Time
+	if err := k.ValidatorSigningInfo.Set(ctx, consAddr, signInfo); err != nil {
+		return err
+	}
+
+	refunded := sdk.NewCoins()
+	seq, record, found, err := k.lastInfraction(ctx, consAddr)
+	if err != nil {
+		return err
+	}
+
+	if found && record.Tombstoned && !record.Reverted {
+		refunded, err = k.refundDelegators(ctx, record.SlashedStakes)
+		if err != nil {
+			return err
+		}
+
+		record.Reverted = true
+		if err := k.InfractionHistory.Set(ctx, collections.Join(consAddr, seq), record); err != nil {
+			return err
+		}
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRevertTombstone,
+		event.NewAttribute(types.AttributeKeyAddress, consStr),
+		event.NewAttribute(types.AttributeKeyRefunded, refunded.String()),
+	)
+}
+
+// refundDelegators refunds the tokens recorded as slashed from each delegator of a validator
+// from the community pool. The refunds are paid as liquid tokens to the delegators which were
+// slashed, whether they still delegate to the validator or not.
+func (k Keeper) refundDelegators(ctx context.Context, stakes []types.SlashedStake) (sdk.Coins, error) {
+	refunded := sdk.NewCoins()
+	if len(stakes) == 0 {
+		return refunded, nil
+	}
+
+	bondDenom, err := k.sk.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, stake := range stakes {
+		amount := stake.Delegation.Add(stake.Unbonding).Add(stake.Redelegation)
+		if !amount.IsPositive() {
+			continue
+		}
+
+		delAddr, err := k.ak.AddressCodec().StringToBytes(stake.DelegatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(bondDenom, amount))
+		if err := k.pk.DistributeFromCommunityPool(ctx, coins, delAddr); err != nil {
+			return nil, err
+		}
+
+		refunded = refunded.Add(coins...)
+	}
+
+	return refunded, nil
+}
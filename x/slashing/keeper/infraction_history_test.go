@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	st "cosmossdk.io/api/cosmos/staking/v1beta1"
+	coretesting "cosmossdk.io/core/testing"
+	sdkmath "cosmossdk.io/math"
+	slashingkeeper "cosmossdk.io/x/slashing/keeper"
+	slashingtypes "cosmossdk.io/x/slashing/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func (s *KeeperTestSuite) TestInfractionHistoryAndRevertTombstone() {
+	ctx, keeper, msgServer, queryClient := s.ctx, s.slashingKeeper, s.msgServer, s.queryClient
+	require := s.Require()
+
+	consStr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(consAddr)
+	require.NoError(err)
+	require.NoError(keeper.ValidatorSigningInfo.Set(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consStr, 0, time.Unix(0, 0), false, 0)))
+
+	// the stake of the delegators is snapshotted around the double sign slash: the validator
+	// tokens drop from 200 to 190, an unbonding entry from 100 to 95 and a redelegation from
+	// 100 to 95 tokens
+	valAddr := sdk.ValAddress(consAddr)
+	dstAddr := sdk.ValAddress("dst_________________")
+	valStr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	require.NoError(err)
+	dstStr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(dstAddr)
+	require.NoError(err)
+	delAddrs := make([]sdk.AccAddress, 4)
+	delStrs := make([]string, 4)
+	for i := range delAddrs {
+		_, _, delAddrs[i] = testdata.KeyTestPubAddr()
+		delStrs[i], err = s.accountKeeper.AddressCodec().BytesToString(delAddrs[i])
+		require.NoError(err)
+	}
+
+	validator := stakingtypes.Validator{OperatorAddress: valStr, Tokens: sdkmath.NewInt(200), DelegatorShares: sdkmath.LegacyNewDec(200)}
+	slashedValidator := validator
+	slashedValidator.Tokens = sdkmath.NewInt(190)
+	dstValidator := stakingtypes.Validator{OperatorAddress: dstStr, Tokens: sdkmath.NewInt(1000), DelegatorShares: sdkmath.LegacyNewDec(1000)}
+	ubd := func(balance int64) []stakingtypes.UnbondingDelegation {
+		return []stakingtypes.UnbondingDelegation{{
+			DelegatorAddress: delStrs[2],
+			ValidatorAddress: valStr,
+			Entries:          []stakingtypes.UnbondingDelegationEntry{{InitialBalance: sdkmath.NewInt(100), Balance: sdkmath.NewInt(balance)}},
+		}}
+	}
+
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(validator, nil)
+	s.stakingKeeper.EXPECT().GetValidatorDelegations(gomock.Any(), valAddr).Return([]stakingtypes.Delegation{
+		stakingtypes.NewDelegation(delStrs[0], valStr, sdkmath.LegacyNewDec(150)),
+		stakingtypes.NewDelegation(delStrs[1], valStr, sdkmath.LegacyNewDec(50)),
+	}, nil)
+	gomock.InOrder(
+		s.stakingKeeper.EXPECT().GetUnbondingDelegationsFromValidator(gomock.Any(), valAddr).Return(ubd(100), nil),
+		s.stakingKeeper.EXPECT().GetUnbondingDelegationsFromValidator(gomock.Any(), valAddr).Return(ubd(95), nil),
+	)
+	s.stakingKeeper.EXPECT().GetRedelegationsFromSrcValidator(gomock.Any(), valAddr).Return([]stakingtypes.Redelegation{
+		{DelegatorAddress: delStrs[3], ValidatorSrcAddress: valStr, ValidatorDstAddress: dstStr},
+	}, nil)
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), dstAddr).Return(dstValidator, nil).Times(2)
+	gomock.InOrder(
+		s.stakingKeeper.EXPECT().Delegation(gomock.Any(), delAddrs[3], dstAddr).Return(stakingtypes.NewDelegation(delStrs[3], dstStr, sdkmath.LegacyNewDec(100)), nil),
+		s.stakingKeeper.EXPECT().Delegation(gomock.Any(), delAddrs[3], dstAddr).Return(stakingtypes.NewDelegation(delStrs[3], dstStr, sdkmath.LegacyNewDec(95)), nil),
+	)
+
+	// the double sign is recorded along with the evidence type, and marked once tombstoned
+	fraction := sdkmath.LegacyNewDecWithPrec(5, 2)
+	s.stakingKeeper.EXPECT().SlashWithInfractionReason(ctx, consAddr, int64(9), int64(100), fraction, st.Infraction_INFRACTION_DOUBLE_SIGN).Return(sdkmath.NewInt(20), nil)
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(slashedValidator, nil)
+	require.NoError(keeper.SlashWithEvidence(ctx, consAddr, fraction, 100, 9, st.Infraction_INFRACTION_DOUBLE_SIGN, "equivocation"))
+	require.NoError(keeper.JailUntil(ctx, consAddr, time.Unix(253402300799, 0)))
+	require.NoError(keeper.Tombstone(ctx, consAddr))
+
+	res, err := queryClient.InfractionHistory(ctx, &slashingtypes.QueryInfractionHistoryRequest{ConsAddress: consStr})
+	require.NoError(err)
+	require.Len(res.Infractions, 1)
+	record := res.Infractions[0]
+	require.Equal(stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN, record.Infraction)
+	require.Equal("equivocation", record.EvidenceType)
+	require.Equal(int64(9+sdk.ValidatorUpdateDelay), record.InfractionHeight)
+	require.Equal(sdkmath.NewInt(20), record.SlashedTokens)
+	require.True(record.Tombstoned)
+	require.False(record.Reverted)
+
+	expected := map[string][3]int64{delStrs[0]: {7, 0, 0}, delStrs[1]: {2, 0, 0}, delStrs[2]: {0, 5, 0}, delStrs[3]: {0, 0, 5}}
+	require.Len(record.SlashedStakes, len(expected))
+	for i, stake := range record.SlashedStakes {
+		if i > 0 {
+			require.Less(record.SlashedStakes[i-1].DelegatorAddress, stake.DelegatorAddress)
+		}
+		amounts := expected[stake.DelegatorAddress]
+		require.Equal(sdkmath.NewInt(amounts[0]), stake.Delegation)
+		require.Equal(sdkmath.NewInt(amounts[1]), stake.Unbonding)
+		require.Equal(sdkmath.NewInt(amounts[2]), stake.Redelegation)
+	}
+
+	// only the authority can revert a tombstone
+	_, err = msgServer.RevertTombstone(ctx, slashingtypes.NewMsgRevertTombstone("invalid", consStr))
+	require.ErrorIs(err, slashingtypes.ErrInvalidSigner)
+
+	// the recorded amounts are refunded to the delegators they were slashed from, whatever
+	// they delegate now
+	s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil)
+	for i, amount := range []int64{7, 2, 5, 5} {
+		s.poolKeeper.EXPECT().DistributeFromCommunityPool(gomock.Any(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)), []byte(delAddrs[i])).Return(nil)
+	}
+
+	_, err = msgServer.RevertTombstone(ctx, slashingtypes.NewMsgRevertTombstone(keeper.GetAuthority(), consStr))
+	require.NoError(err)
+
+	info, err := keeper.ValidatorSigningInfo.Get(ctx, consAddr)
+	require.NoError(err)
+	require.False(info.Tombstoned)
+	require.Equal(ctx.HeaderInfo().Time, info.JailedUntil)
+
+	res, err = queryClient.InfractionHistory(ctx, &slashingtypes.QueryInfractionHistoryRequest{ConsAddress: consStr})
+	require.NoError(err)
+	require.True(res.Infractions[0].Reverted)
+
+	_, err = msgServer.RevertTombstone(ctx, slashingtypes.NewMsgRevertTombstone(keeper.GetAuthority(), consStr))
+	require.ErrorIs(err, slashingtypes.ErrValidatorNotTombstoned)
+
+	// the infraction history is exported and imported
+	genesis, err := keeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.Len(genesis.InfractionHistory, 1)
+	require.Equal(res.Infractions[0], genesis.InfractionHistory[0])
+}
+
+func (s *KeeperTestSuite) TestRevertTombstoneWithoutPoolKeeper() {
+	ctx := s.ctx
+	require := s.Require()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(s.key), coretesting.NewNopLogger())
+	keeper := slashingkeeper.NewKeeper(env, encCfg.Codec, encCfg.Amino, s.accountKeeper, s.stakingKeeper, nil, s.slashingKeeper.GetAuthority())
+
+	consStr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(consAddr)
+	require.NoError(err)
+	require.NoError(keeper.ValidatorSigningInfo.Set(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consStr, 0, time.Unix(0, 0), true, 0)))
+
+	// the tombstone is kept, as the slashed tokens cannot be refunded
+	require.ErrorIs(keeper.RevertTombstone(ctx, consAddr), slashingtypes.ErrNoPoolKeeper)
+	info, err := keeper.ValidatorSigningInfo.Get(ctx, consAddr)
+	require.NoError(err)
+	require.True(info.Tombstoned)
+}
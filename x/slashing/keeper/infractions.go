@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/core/event"
 	"cosmossdk.io/x/slashing/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -160,6 +161,10 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 				return err
 			}
 
+			if err := k.recordInfraction(ctx, consAddr, stakingtypes.Infraction_INFRACTION_DOWNTIME, "", height, slashFractionDowntime, coinsBurned, nil); err != nil {
+				return err
+			}
+
 			if err := k.EventService.EventManager(ctx).EmitKV(
 				types.EventTypeSlash,
 				event.NewAttribute(types.AttributeKeyAddress, consStr),
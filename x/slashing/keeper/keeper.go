@@ -10,6 +10,7 @@ import (
 	"cosmossdk.io/core/event"
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/slashing/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -23,7 +24,9 @@ type Keeper struct {
 	cdc codec.BinaryCodec
 	// deprecated!
 	legacyAmino *codec.LegacyAmino
+	ak          types.AccountKeeper
 	sk          types.StakingKeeper
+	pk          types.PoolKeeper
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -36,16 +39,25 @@ type Keeper struct {
 	AddrPubkeyRelation collections.Map[[]byte, cryptotypes.PubKey]
 	// ValidatorMissedBlockBitmap key: ConsAddr | value: byte key for a validator's missed block bitmap chunk
 	ValidatorMissedBlockBitmap collections.Map[collections.Pair[[]byte, uint64], []byte]
+	// InfractionHistory key: ConsAddr+Sequence | value: InfractionRecord
+	InfractionHistory collections.Map[collections.Pair[sdk.ConsAddress, uint64], types.InfractionRecord]
+	// InfractionSequence is the sequence ordering the infraction records
+	InfractionSequence collections.Sequence
 }
 
 // NewKeeper creates a slashing keeper
-func NewKeeper(environment appmodule.Environment, cdc codec.BinaryCodec, legacyAmino *codec.LegacyAmino, sk types.StakingKeeper, authority string) Keeper {
+func NewKeeper(
+	environment appmodule.Environment, cdc codec.BinaryCodec, legacyAmino *codec.LegacyAmino,
+	ak types.AccountKeeper, sk types.StakingKeeper, pk types.PoolKeeper, authority string,
+) Keeper {
 	sb := collections.NewSchemaBuilder(environment.KVStoreService)
 	k := Keeper{
 		Environment: environment,
 		cdc:         cdc,
 		legacyAmino: legacyAmino,
+		ak:          ak,
 		sk:          sk,
+		pk:          pk,
 		authority:   authority,
 		Params:      collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		ValidatorSigningInfo: collections.NewMap(
@@ -69,6 +81,14 @@ func NewKeeper(environment appmodule.Environment, cdc codec.BinaryCodec, legacyA
 			collections.PairKeyCodec(sdk.LengthPrefixedBytesKey, collections.Uint64Key),
 			collections.BytesValue,
 		),
+		InfractionHistory: collections.NewMap(
+			sb,
+			types.InfractionHistoryKeyPrefix,
+			"infraction_history",
+			collections.PairKeyCodec(sdk.ConsAddressKey, collections.Uint64Key),
+			codec.CollValue[types.InfractionRecord](cdc),
+		),
+		InfractionSequence: collections.NewSequence(sb, types.InfractionSequenceKey, "infraction_sequence"),
 	}
 
 	schema, err := sb.Build()
@@ -98,11 +118,50 @@ func (k Keeper) Slash(ctx context.Context, consAddr sdk.ConsAddress, fraction sd
 // SlashWithInfractionReason attempts to slash a validator. The slash is delegated to the staking
 // module to make the necessary validator changes. It specifies an infraction reason.
 func (k Keeper) SlashWithInfractionReason(ctx context.Context, consAddr sdk.ConsAddress, fraction sdkmath.LegacyDec, power, distributionHeight int64, infraction st.Infraction) error {
+	return k.slash(ctx, consAddr, fraction, power, distributionHeight, infraction, "")
+}
+
+// SlashWithEvidence attempts to slash a validator for the given evidence type. The slash is
+// delegated to the staking module to make the necessary validator changes. It specifies an
+// infraction reason, which is recorded in the infraction history of the validator along with
+// the evidence type.
+func (k Keeper) SlashWithEvidence(
+	ctx context.Context, consAddr sdk.ConsAddress, fraction sdkmath.LegacyDec, power, distributionHeight int64,
+	infraction st.Infraction, evidenceType string,
+) error {
+	return k.slash(ctx, consAddr, fraction, power, distributionHeight, infraction, evidenceType)
+}
+
+func (k Keeper) slash(
+	ctx context.Context, consAddr sdk.ConsAddress, fraction sdkmath.LegacyDec, power, distributionHeight int64,
+	infraction st.Infraction, evidenceType string,
+) error {
+	// the stake of the delegators is only snapshotted for double signs, the infractions a validator
+	// is tombstoned for, to record the amounts refunded to them if the tombstoning is reverted
+	var snapshot *stakeSnapshot
+	if infraction == st.Infraction_INFRACTION_DOUBLE_SIGN {
+		var err error
+		if snapshot, err = k.snapshotStake(ctx, consAddr); err != nil {
+			return err
+		}
+	}
+
 	coinsBurned, err := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, fraction, infraction)
 	if err != nil {
 		return err
 	}
 
+	slashedStakes, err := k.slashedStakes(ctx, snapshot)
+	if err != nil {
+		return err
+	}
+
+	// the distribution height is the infraction height minus the validator update delay
+	infractionHeight := distributionHeight + sdk.ValidatorUpdateDelay
+	if err := k.recordInfraction(ctx, consAddr, stakingtypes.Infraction(infraction), evidenceType, infractionHeight, fraction, coinsBurned, slashedStakes); err != nil {
+		return err
+	}
+
 	reasonAttr := event.NewAttribute(types.AttributeKeyReason, types.AttributeValueUnspecified)
 	switch infraction {
 	case st.Infraction_INFRACTION_DOUBLE_SIGN:
@@ -16,6 +16,7 @@ import (
 	slashingkeeper "cosmossdk.io/x/slashing/keeper"
 	slashingtestutil "cosmossdk.io/x/slashing/testutil"
 	slashingtypes "cosmossdk.io/x/slashing/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec/address"
@@ -35,7 +36,9 @@ type KeeperTestSuite struct {
 	suite.Suite
 
 	ctx            sdk.Context
+	accountKeeper  *slashingtestutil.MockAccountKeeper
 	stakingKeeper  *slashingtestutil.MockStakingKeeper
+	poolKeeper     *slashingtestutil.MockPoolKeeper
 	slashingKeeper slashingkeeper.Keeper
 	queryClient    slashingtypes.QueryClient
 	msgServer      slashingtypes.MsgServer
@@ -53,7 +56,10 @@ func (s *KeeperTestSuite) SetupTest() {
 
 	// gomock initializations
 	ctrl := gomock.NewController(s.T())
+	s.accountKeeper = slashingtestutil.NewMockAccountKeeper(ctrl)
+	s.accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
 	s.stakingKeeper = slashingtestutil.NewMockStakingKeeper(ctrl)
+	s.poolKeeper = slashingtestutil.NewMockPoolKeeper(ctrl)
 	s.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
 	s.stakingKeeper.EXPECT().ConsensusAddressCodec().Return(address.NewBech32Codec("cosmosvalcons")).AnyTimes()
 
@@ -65,7 +71,9 @@ func (s *KeeperTestSuite) SetupTest() {
 		env,
 		encCfg.Codec,
 		encCfg.Amino,
+		s.accountKeeper,
 		s.stakingKeeper,
+		s.poolKeeper,
 		authStr,
 	)
 	// set test params
@@ -119,6 +127,8 @@ func (s *KeeperTestSuite) TestJailAndSlashWithInfractionReason() {
 	slashFractionDoubleSign, err := s.slashingKeeper.SlashFractionDoubleSign(s.ctx)
 	s.Require().NoError(err)
 
+	// no stake is recorded for a validator which does not exist
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(s.ctx, consAddr).Return(nil, stakingtypes.ErrNoValidatorFound)
 	s.stakingKeeper.EXPECT().SlashWithInfractionReason(s.ctx,
 		consAddr,
 		s.ctx.BlockHeight(),
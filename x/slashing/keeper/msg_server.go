@@ -54,3 +54,22 @@ func (k msgServer) Unjail(ctx context.Context, msg *types.MsgUnjail) (*types.Msg
 
 	return &types.MsgUnjailResponse{}, nil
 }
+
+// RevertTombstone implements MsgServer.RevertTombstone method.
+// It defines a governance method to revert the tombstoning of a validator.
+func (k msgServer) RevertTombstone(ctx context.Context, msg *types.MsgRevertTombstone) (*types.MsgRevertTombstoneResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	consAddr, err := k.sk.ConsensusAddressCodec().StringToBytes(msg.ConsAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("consensus address: %s", err)
+	}
+
+	if err := k.Keeper.RevertTombstone(ctx, consAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevertTombstoneResponse{}, nil
+}
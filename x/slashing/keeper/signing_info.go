@@ -54,7 +54,11 @@ func (k Keeper) Tombstone(ctx context.Context, consAddr sdk.ConsAddress) error {
 	}
 
 	signInfo.Tombstoned = true
-	return k.ValidatorSigningInfo.Set(ctx, consAddr, signInfo)
+	if err := k.ValidatorSigningInfo.Set(ctx, consAddr, signInfo); err != nil {
+		return err
+	}
+
+	return k.tombstoneLastInfraction(ctx, consAddr)
 }
 
 // IsTombstoned returns if a given validator by consensus address is tombstoned.
@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 
 	"cosmossdk.io/x/slashing/types"
@@ -38,6 +39,15 @@ func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
 			}
 			return fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", pubKeyA, pubKeyB)
 
+		case bytes.Equal(kvA.Key[:1], types.InfractionHistoryKeyPrefix):
+			var recordA, recordB types.InfractionRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+		case bytes.Equal(kvA.Key[:1], types.InfractionSequenceKey):
+			return fmt.Sprintf("%v\n%v", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
 		default:
 			panic(fmt.Sprintf("invalid slashing key prefix %X", kvA.Key[:1]))
 		}
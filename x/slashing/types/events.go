@@ -2,8 +2,9 @@ package types
 
 // Slashing module event types
 const (
-	EventTypeSlash    = "slash"
-	EventTypeLiveness = "liveness"
+	EventTypeSlash           = "slash"
+	EventTypeLiveness        = "liveness"
+	EventTypeRevertTombstone = "revert_tombstone"
 
 	AttributeKeyAddress      = "address"
 	AttributeKeyHeight       = "height"
@@ -12,6 +13,7 @@ const (
 	AttributeKeyJailed       = "jailed"
 	AttributeKeyMissedBlocks = "missed_blocks"
 	AttributeKeyBurnedCoins  = "burned_coins"
+	AttributeKeyRefunded     = "refunded"
 
 	AttributeValueUnspecified      = "unspecified"
 	AttributeValueDoubleSign       = "double_sign"
@@ -25,10 +25,17 @@ type BankKeeper interface {
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 }
 
+// PoolKeeper defines the expected interface needed to refund slashed tokens from
+// the community pool.
+type PoolKeeper interface {
+	DistributeFromCommunityPool(ctx context.Context, amount sdk.Coins, receiveAddr []byte) error
+}
+
 // StakingKeeper expected staking keeper
 type StakingKeeper interface {
 	ValidatorAddressCodec() address.Codec
 	ConsensusAddressCodec() address.Codec
+	BondDenom(ctx context.Context) (string, error)
 	// iterate through validators by operator address, execute func for each validator
 	IterateValidators(context.Context,
 		func(index int64, validator sdk.ValidatorI) (stop bool)) error
@@ -46,6 +53,9 @@ type StakingKeeper interface {
 	// and delegator outside the scope of the staking module.
 	Delegation(context.Context, sdk.AccAddress, sdk.ValAddress) (sdk.DelegationI, error)
 	GetAllValidators(ctx context.Context) ([]stakingtypes.Validator, error)
+	GetValidatorDelegations(ctx context.Context, valAddr sdk.ValAddress) ([]stakingtypes.Delegation, error)
+	GetUnbondingDelegationsFromValidator(ctx context.Context, valAddr sdk.ValAddress) ([]stakingtypes.UnbondingDelegation, error)
+	GetRedelegationsFromSrcValidator(ctx context.Context, valAddr sdk.ValAddress) ([]stakingtypes.Redelegation, error)
 
 	// MaxValidators returns the maximum amount of bonded validators
 	MaxValidators(context.Context) (uint32, error)
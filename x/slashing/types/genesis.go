@@ -9,12 +9,13 @@ import (
 
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(
-	params Params, signingInfos []SigningInfo, missedBlocks []ValidatorMissedBlocks,
+	params Params, signingInfos []SigningInfo, missedBlocks []ValidatorMissedBlocks, infractionHistory []InfractionRecord,
 ) *GenesisState {
 	return &GenesisState{
-		Params:       params,
-		SigningInfos: signingInfos,
-		MissedBlocks: missedBlocks,
+		Params:            params,
+		SigningInfos:      signingInfos,
+		MissedBlocks:      missedBlocks,
+		InfractionHistory: infractionHistory,
 	}
 }
 
@@ -29,9 +30,10 @@ func NewMissedBlock(index int64, missed bool) MissedBlock {
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:       DefaultParams(),
-		SigningInfos: []SigningInfo{},
-		MissedBlocks: []ValidatorMissedBlocks{},
+		Params:            DefaultParams(),
+		SigningInfos:      []SigningInfo{},
+		MissedBlocks:      []ValidatorMissedBlocks{},
+		InfractionHistory: []InfractionRecord{},
 	}
 }
 
@@ -35,6 +35,9 @@ type GenesisState struct {
 	// missed_blocks represents a map between validator addresses and their
 	// missed blocks.
 	MissedBlocks []ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks"`
+	// infraction_history represents the infractions the validators were slashed
+	// for, in the order they were recorded.
+	InfractionHistory []InfractionRecord `protobuf:"bytes,4,rep,name=infraction_history,json=infractionHistory,proto3" json:"infraction_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -91,6 +94,13 @@ func (m *GenesisState) GetMissedBlocks() []ValidatorMissedBlocks {
 	return nil
 }
 
+func (m *GenesisState) GetInfractionHistory() []InfractionRecord {
+	if m != nil {
+		return m.InfractionHistory
+	}
+	return nil
+}
+
 // SigningInfo stores validator signing info of corresponding address.
 type SigningInfo struct {
 	// address is the validator address.
@@ -269,34 +279,38 @@ func init() {
 }
 
 var fileDescriptor_1923b9188b635394 = []byte{
-	// 432 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x31, 0xef, 0xd2, 0x40,
-	0x18, 0xc6, 0x7b, 0x10, 0xd1, 0xff, 0x15, 0x06, 0x2f, 0x88, 0x95, 0xc4, 0x82, 0x44, 0x0d, 0x31,
-	0xa1, 0x0d, 0x38, 0x38, 0x30, 0x59, 0x07, 0xe3, 0x60, 0x62, 0x0a, 0x71, 0x70, 0xb0, 0x39, 0xe8,
-	0x51, 0x2f, 0xd0, 0x3b, 0xd2, 0xb7, 0x12, 0xfc, 0x06, 0x8e, 0x7e, 0x0c, 0xe3, 0xe4, 0xe0, 0xe4,
-	0x27, 0x60, 0x24, 0x4e, 0x4e, 0xc6, 0xc0, 0xe0, 0xd7, 0x30, 0xde, 0x15, 0xa9, 0x86, 0x86, 0xe1,
-	0xbf, 0x34, 0xbd, 0xbb, 0xdf, 0xf3, 0xbc, 0xef, 0xfb, 0xb4, 0x87, 0xef, 0x4d, 0x25, 0xc4, 0x12,
-	0x5c, 0x58, 0x50, 0x78, 0xc3, 0x45, 0xe4, 0xae, 0xfa, 0x13, 0x96, 0xd2, 0xbe, 0x1b, 0x31, 0xc1,
-	0x80, 0x83, 0xb3, 0x4c, 0x64, 0x2a, 0xc9, 0x4d, 0x8d, 0x39, 0x07, 0xcc, 0xc9, 0xb0, 0x66, 0x3d,
-	0x92, 0x91, 0x54, 0x8c, 0xfb, 0xe7, 0x4d, 0xe3, 0xcd, 0xfb, 0x45, 0xae, 0x7f, 0xf5, 0x9a, 0xbb,
-	0xa5, 0xb9, 0x40, 0x1b, 0x64, 0x35, 0xf4, 0xd1, 0x75, 0x1a, 0x73, 0x21, 0x5d, 0xf5, 0xd4, 0x5b,
-	0x9d, 0xf7, 0x25, 0x5c, 0x7d, 0xaa, 0xdb, 0x1a, 0xa5, 0x34, 0x65, 0xc4, 0xc3, 0x95, 0x25, 0x4d,
-	0x68, 0x0c, 0x16, 0x6a, 0xa3, 0xae, 0x39, 0x68, 0x39, 0x05, 0x6d, 0x3a, 0x2f, 0x14, 0xe6, 0x5d,
-	0x6c, 0x7e, 0xb4, 0x8c, 0x8f, 0xbf, 0x3e, 0x3f, 0x40, 0x7e, 0xa6, 0x24, 0x63, 0x5c, 0x03, 0x1e,
-	0x09, 0x2e, 0xa2, 0x80, 0x8b, 0x99, 0x04, 0xab, 0xd4, 0x2e, 0x77, 0xcd, 0xc1, 0xdd, 0x42, 0xab,
-	0x91, 0xa6, 0x9f, 0x89, 0x99, 0xcc, 0xfb, 0x55, 0xe1, 0xb8, 0x0f, 0xe4, 0x35, 0xae, 0xc5, 0x1c,
-	0x80, 0x85, 0xc1, 0x64, 0x21, 0xa7, 0x73, 0xb0, 0xca, 0xca, 0xd5, 0x29, 0x74, 0x7d, 0x49, 0x17,
-	0x3c, 0xa4, 0xa9, 0x4c, 0x9e, 0x2b, 0x99, 0xa7, 0x54, 0xff, 0xf8, 0xc7, 0xb9, 0x83, 0xce, 0x57,
-	0x84, 0xcd, 0x5c, 0x23, 0x64, 0x88, 0xaf, 0xd2, 0x30, 0x4c, 0x18, 0xe8, 0x28, 0x2e, 0xbc, 0x3b,
-	0xdf, 0xbe, 0xf4, 0x6e, 0x67, 0xc5, 0x9e, 0x48, 0x01, 0x4c, 0xc0, 0x5b, 0x78, 0xac, 0x91, 0x51,
-	0x9a, 0x70, 0x11, 0xf9, 0x07, 0x05, 0x11, 0xb8, 0xb1, 0x3a, 0x94, 0x0f, 0xf2, 0x61, 0x58, 0x25,
-	0x15, 0x6b, 0xef, 0x7c, 0xd7, 0x05, 0xa1, 0xd4, 0x57, 0x27, 0x80, 0xce, 0x27, 0x84, 0x6f, 0x9c,
-	0x9c, 0xf7, 0x72, 0x63, 0x8c, 0xff, 0xcf, 0xfc, 0xdc, 0x97, 0xcc, 0x95, 0x2e, 0x4e, 0x7a, 0x88,
-	0xcd, 0x1c, 0x47, 0xea, 0xf8, 0x0a, 0x17, 0x21, 0x5b, 0xab, 0xfe, 0xca, 0xbe, 0x5e, 0x90, 0x06,
-	0xae, 0x68, 0x91, 0x4a, 0xec, 0x9a, 0x9f, 0xad, 0xbc, 0x47, 0x9b, 0x9d, 0x8d, 0xb6, 0x3b, 0x1b,
-	0xfd, 0xdc, 0xd9, 0xe8, 0xc3, 0xde, 0x36, 0xb6, 0x7b, 0xdb, 0xf8, 0xbe, 0xb7, 0x8d, 0x57, 0xd9,
-	0x50, 0x10, 0xce, 0x1d, 0x2e, 0xdd, 0xf5, 0xf1, 0xa6, 0xa4, 0xef, 0x96, 0x0c, 0x26, 0x15, 0xf5,
-	0xc7, 0x3f, 0xfc, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xaf, 0xca, 0xae, 0x5a, 0x9f, 0x03, 0x00, 0x00,
+	// 492 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xe3, 0x06, 0x02, 0xbd, 0xb4, 0x42, 0x3d, 0x85, 0x62, 0x2a, 0x70, 0x43, 0x04, 0xa8,
+	0x20, 0xc5, 0xa6, 0xad, 0x10, 0x43, 0x27, 0xcc, 0x00, 0x1d, 0x90, 0x90, 0x53, 0x31, 0x30, 0x60,
+	0x5d, 0xe2, 0x8b, 0x7b, 0x4a, 0x7c, 0x17, 0xdd, 0x3b, 0xa2, 0x76, 0xe3, 0x23, 0xf0, 0x31, 0x10,
+	0x13, 0x43, 0x27, 0x3e, 0x41, 0xc7, 0x8a, 0x09, 0x31, 0x20, 0x94, 0x0c, 0x7c, 0x0d, 0xd4, 0x7b,
+	0x4e, 0x63, 0x50, 0xad, 0x0e, 0x5d, 0xa2, 0xdc, 0xdd, 0xef, 0xff, 0xff, 0xdf, 0x7b, 0xcf, 0x47,
+	0x1e, 0xf4, 0x14, 0x64, 0x0a, 0x02, 0x18, 0x32, 0xd8, 0x17, 0x32, 0x0d, 0xc6, 0x9b, 0x5d, 0x6e,
+	0xd8, 0x66, 0x90, 0x72, 0xc9, 0x41, 0x80, 0x3f, 0xd2, 0xca, 0x28, 0x7a, 0x0b, 0x31, 0x7f, 0x86,
+	0xf9, 0x39, 0xb6, 0xd6, 0x48, 0x55, 0xaa, 0x2c, 0x13, 0x9c, 0xfe, 0x43, 0x7c, 0xed, 0x61, 0x99,
+	0xeb, 0x99, 0x1e, 0xb9, 0xdb, 0xc8, 0xc5, 0x68, 0x90, 0x67, 0xe0, 0xd1, 0x0a, 0xcb, 0x84, 0x54,
+	0x81, 0xfd, 0xc5, 0xad, 0xd6, 0xc7, 0x2a, 0x59, 0x7a, 0x89, 0xd7, 0xea, 0x18, 0x66, 0x38, 0x0d,
+	0x49, 0x6d, 0xc4, 0x34, 0xcb, 0xc0, 0x75, 0x9a, 0xce, 0x46, 0x7d, 0x6b, 0xdd, 0x2f, 0xb9, 0xa6,
+	0xff, 0xc6, 0x62, 0xe1, 0xe2, 0xf1, 0xaf, 0xf5, 0xca, 0xe7, 0x3f, 0x5f, 0x1f, 0x3b, 0x51, 0xae,
+	0xa4, 0x7b, 0x64, 0x19, 0x44, 0x2a, 0x85, 0x4c, 0x63, 0x21, 0xfb, 0x0a, 0xdc, 0x85, 0x66, 0x75,
+	0xa3, 0xbe, 0x75, 0xbf, 0xd4, 0xaa, 0x83, 0xf4, 0xae, 0xec, 0xab, 0xa2, 0xdf, 0x12, 0xcc, 0xf7,
+	0x81, 0xbe, 0x27, 0xcb, 0x99, 0x00, 0xe0, 0x49, 0xdc, 0x1d, 0xaa, 0xde, 0x00, 0xdc, 0xaa, 0x75,
+	0xf5, 0x4b, 0x5d, 0xdf, 0xb2, 0xa1, 0x48, 0x98, 0x51, 0xfa, 0xb5, 0x95, 0x85, 0x56, 0xf5, 0x8f,
+	0x7f, 0x56, 0x38, 0xa0, 0x63, 0x42, 0x85, 0xec, 0x6b, 0xd6, 0x33, 0x42, 0xc9, 0x78, 0x5f, 0x80,
+	0x51, 0xfa, 0xd0, 0xbd, 0x62, 0x43, 0x1e, 0x95, 0x86, 0xec, 0x9e, 0x49, 0x22, 0xde, 0x53, 0x3a,
+	0x09, 0xef, 0x9c, 0xfa, 0xff, 0x3c, 0x6a, 0xdf, 0x40, 0x45, 0x1b, 0x92, 0x41, 0xf3, 0x89, 0xff,
+	0x74, 0x1b, 0x23, 0x57, 0xe6, 0x11, 0xaf, 0x30, 0xa1, 0xf5, 0xcd, 0x21, 0xf5, 0x42, 0x03, 0xe8,
+	0x0e, 0xb9, 0xc6, 0x92, 0x44, 0x73, 0xc0, 0x11, 0x2c, 0x86, 0xf7, 0xbe, 0x1f, 0xb5, 0xef, 0xe6,
+	0xf9, 0x2f, 0x94, 0x04, 0x2e, 0xe1, 0x03, 0x3c, 0x47, 0xa4, 0x63, 0xb4, 0x90, 0x69, 0x34, 0x53,
+	0x50, 0x49, 0x56, 0xc7, 0xb3, 0xb2, 0xe3, 0xe2, 0x10, 0xdc, 0x05, 0x3b, 0xce, 0xf6, 0xc5, 0xdd,
+	0x2a, 0x19, 0x46, 0x63, 0x7c, 0x0e, 0xd0, 0xfa, 0xe2, 0x90, 0x9b, 0xe7, 0xf6, 0xf9, 0x72, 0x65,
+	0xec, 0xfd, 0x3f, 0xeb, 0x8b, 0xbe, 0xa0, 0x42, 0x74, 0xe9, 0x84, 0x5b, 0x3b, 0xa4, 0x5e, 0xe0,
+	0x68, 0x83, 0x5c, 0x15, 0x32, 0xe1, 0x07, 0xf6, 0x7e, 0xd5, 0x08, 0x17, 0x74, 0x95, 0xd4, 0x50,
+	0x64, 0x3b, 0x76, 0x3d, 0xca, 0x57, 0xe1, 0xb3, 0xe3, 0x89, 0xe7, 0x9c, 0x4c, 0x3c, 0xe7, 0xf7,
+	0xc4, 0x73, 0x3e, 0x4d, 0xbd, 0xca, 0xc9, 0xd4, 0xab, 0xfc, 0x98, 0x7a, 0x95, 0x77, 0x79, 0x51,
+	0x90, 0x0c, 0x7c, 0xa1, 0x82, 0x83, 0xf9, 0x0b, 0x35, 0x87, 0x23, 0x0e, 0xdd, 0x9a, 0x7d, 0x69,
+	0xdb, 0x7f, 0x07, 0x00, 0x0d, 0xf5, 0xf3, 0x3a, 0x17, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -319,6 +333,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.InfractionHistory) > 0 {
+		for iNdEx := len(m.InfractionHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InfractionHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.MissedBlocks) > 0 {
 		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -513,6 +541,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.InfractionHistory) > 0 {
+		for _, e := range m.InfractionHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -701,6 +735,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InfractionHistory = append(m.InfractionHistory, InfractionRecord{})
+			if err := m.InfractionHistory[len(m.InfractionHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -50,12 +50,18 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><chunk_index>: bitmap_chunk
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<consAddrLen (1 Byte)><consAddress_Bytes><sequence>: InfractionRecord
+//
+// - 0x05: uint64 (infraction sequence)
 
 var (
 	ParamsKey                           = collections.NewPrefix(0) // Prefix for params key
 	ValidatorSigningInfoKeyPrefix       = collections.NewPrefix(1) // Prefix for signing info
 	ValidatorMissedBlockBitmapKeyPrefix = collections.NewPrefix(2) // Prefix for missed block bitmap
 	AddrPubkeyRelationKeyPrefix         = collections.NewPrefix(3) // Prefix for address-pubkey relation
+	InfractionHistoryKeyPrefix          = collections.NewPrefix(4) // Prefix for infraction history
+	InfractionSequenceKey               = collections.NewPrefix(5) // Key for the infraction sequence
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
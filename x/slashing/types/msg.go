@@ -8,6 +8,7 @@ import (
 var (
 	_ sdk.Msg = &MsgUnjail{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgRevertTombstone{}
 )
 
 // NewMsgUnjail creates a new MsgUnjail instance
@@ -16,3 +17,11 @@ func NewMsgUnjail(validatorAddr string) *MsgUnjail {
 		ValidatorAddr: validatorAddr,
 	}
 }
+
+// NewMsgRevertTombstone creates a new MsgRevertTombstone instance
+func NewMsgRevertTombstone(authority, consAddr string) *MsgRevertTombstone {
+	return &MsgRevertTombstone{
+		Authority:   authority,
+		ConsAddress: consAddr,
+	}
+}
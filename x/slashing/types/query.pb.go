@@ -309,6 +309,116 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryInfractionHistoryRequest is the request type for the Query/InfractionHistory
+// RPC method
+type QueryInfractionHistoryRequest struct {
+	// cons_address is the address to query the infraction history of
+	ConsAddress string             `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInfractionHistoryRequest) Reset()         { *m = QueryInfractionHistoryRequest{} }
+func (m *QueryInfractionHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInfractionHistoryRequest) ProtoMessage()    {}
+func (*QueryInfractionHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{6}
+}
+func (m *QueryInfractionHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInfractionHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInfractionHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInfractionHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInfractionHistoryRequest.Merge(m, src)
+}
+func (m *QueryInfractionHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInfractionHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInfractionHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInfractionHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryInfractionHistoryRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+func (m *QueryInfractionHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryInfractionHistoryResponse is the response type for the Query/InfractionHistory
+// RPC method
+type QueryInfractionHistoryResponse struct {
+	// infractions is the infraction history of the requested val cons address
+	Infractions []InfractionRecord  `protobuf:"bytes,1,rep,name=infractions,proto3" json:"infractions"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInfractionHistoryResponse) Reset()         { *m = QueryInfractionHistoryResponse{} }
+func (m *QueryInfractionHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInfractionHistoryResponse) ProtoMessage()    {}
+func (*QueryInfractionHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{7}
+}
+func (m *QueryInfractionHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInfractionHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInfractionHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInfractionHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInfractionHistoryResponse.Merge(m, src)
+}
+func (m *QueryInfractionHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInfractionHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInfractionHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInfractionHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryInfractionHistoryResponse) GetInfractions() []InfractionRecord {
+	if m != nil {
+		return m.Infractions
+	}
+	return nil
+}
+
+func (m *QueryInfractionHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.slashing.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.slashing.v1beta1.QueryParamsResponse")
@@ -316,6 +426,8 @@ func init() {
 	proto.RegisterType((*QuerySigningInfoResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfoResponse")
 	proto.RegisterType((*QuerySigningInfosRequest)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosRequest")
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosResponse")
+	proto.RegisterType((*QueryInfractionHistoryRequest)(nil), "cosmos.slashing.v1beta1.QueryInfractionHistoryRequest")
+	proto.RegisterType((*QueryInfractionHistoryResponse)(nil), "cosmos.slashing.v1beta1.QueryInfractionHistoryResponse")
 }
 
 func init() {
@@ -323,43 +435,50 @@ func init() {
 }
 
 var fileDescriptor_791b11d41a861ed0 = []byte{
-	// 563 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0xad, 0x06, 0x3a, 0x29, 0xa2, 0x63, 0xa1, 0x6d, 0xd0, 0x8d, 0x5d, 0x21, 0x2d,
-	0xd5, 0xee, 0x98, 0x88, 0xf4, 0xe4, 0xc1, 0x28, 0x8a, 0xe0, 0x41, 0x53, 0x10, 0xf4, 0x12, 0x66,
-	0x9b, 0xe9, 0x38, 0xb8, 0x99, 0xd9, 0xee, 0x6c, 0x82, 0x45, 0xf4, 0xe0, 0xd9, 0x83, 0xe0, 0x67,
-	0x10, 0x3c, 0xaa, 0xf8, 0x21, 0x7a, 0x2c, 0x7a, 0xf1, 0x24, 0x92, 0x08, 0xde, 0xfd, 0x04, 0xb2,
-	0x33, 0x93, 0x64, 0x4a, 0x5c, 0x4d, 0xe9, 0x25, 0x0c, 0x6f, 0xde, 0xff, 0xfd, 0x7f, 0xef, 0xe5,
-	0xcd, 0xc2, 0x8b, 0xdb, 0x52, 0x75, 0xa4, 0xc2, 0x2a, 0x22, 0xea, 0x09, 0x17, 0x0c, 0xf7, 0x6a,
-	0x21, 0x4d, 0x49, 0x0d, 0xef, 0x76, 0x69, 0xb2, 0x17, 0xc4, 0x89, 0x4c, 0x25, 0x5a, 0x34, 0x49,
-	0xc1, 0x30, 0x29, 0xb0, 0x49, 0xe5, 0x75, 0xab, 0x0e, 0x89, 0xa2, 0x46, 0x31, 0xd2, 0xc7, 0x84,
-	0x71, 0x41, 0x52, 0x2e, 0x85, 0x29, 0x52, 0x5e, 0x60, 0x92, 0x49, 0x7d, 0xc4, 0xd9, 0xc9, 0x46,
-	0xcf, 0x31, 0x29, 0x59, 0x44, 0x31, 0x89, 0x39, 0x26, 0x42, 0xc8, 0x54, 0x4b, 0x94, 0xbd, 0xad,
-	0xe6, 0xd1, 0x8d, 0x48, 0x4c, 0xde, 0xb2, 0xc9, 0x6b, 0x99, 0xf2, 0x96, 0xd6, 0x5c, 0x9d, 0x21,
-	0x1d, 0x2e, 0x24, 0xd6, 0xbf, 0x26, 0xe4, 0x2f, 0x40, 0xf4, 0x20, 0x63, 0xbd, 0x4f, 0x12, 0xd2,
-	0x51, 0x4d, 0xba, 0xdb, 0xa5, 0x2a, 0xf5, 0x1f, 0xc1, 0xb3, 0x87, 0xa2, 0x2a, 0x96, 0x42, 0x51,
-	0xd4, 0x80, 0xc5, 0x58, 0x47, 0x96, 0xc0, 0x05, 0xb0, 0x56, 0xaa, 0x57, 0x82, 0x9c, 0x61, 0x04,
-	0x46, 0xd8, 0x98, 0xdb, 0xff, 0x5e, 0x29, 0xbc, 0xff, 0xf5, 0x61, 0x1d, 0x34, 0xad, 0xd2, 0x6f,
-	0xc1, 0x45, 0x5d, 0x7a, 0x8b, 0x33, 0xc1, 0x05, 0xbb, 0x2b, 0x76, 0xa4, 0x75, 0x45, 0xb7, 0xe0,
-	0xfc, 0xb6, 0x14, 0xaa, 0x45, 0xda, 0xed, 0x84, 0x2a, 0x63, 0x32, 0xd7, 0x58, 0xf9, 0xf2, 0x79,
-	0xe3, 0xbc, 0xf5, 0xb9, 0x99, 0x61, 0x08, 0xd5, 0x55, 0x37, 0x4c, 0xca, 0x56, 0x9a, 0x70, 0xc1,
-	0x9a, 0xa5, 0x4c, 0x66, 0x43, 0xfe, 0x4b, 0xb8, 0x34, 0x69, 0x60, 0x1b, 0x08, 0xe1, 0xe9, 0x1e,
-	0x89, 0x5a, 0xca, 0x5c, 0xb5, 0xb8, 0xd8, 0x91, 0xb6, 0x95, 0x8d, 0xdc, 0x56, 0x1e, 0x92, 0x88,
-	0xb7, 0x49, 0x2a, 0x13, 0xa7, 0xa0, 0xdb, 0xd8, 0xa9, 0x1e, 0x89, 0x9c, 0x2b, 0x3f, 0x9c, 0xf4,
-	0x1f, 0xce, 0x15, 0xdd, 0x86, 0x70, 0xbc, 0x0b, 0xd6, 0xb9, 0x3a, 0x74, 0xce, 0x16, 0x27, 0x30,
-	0xab, 0x36, 0x1e, 0x23, 0xa3, 0x56, 0xdb, 0x74, 0x94, 0xfe, 0x27, 0x00, 0x97, 0xff, 0x62, 0x62,
-	0xbb, 0xbc, 0x07, 0x4f, 0xd8, 0xce, 0x66, 0x8f, 0xd5, 0x99, 0xae, 0x82, 0xee, 0x1c, 0x62, 0x9e,
-	0xd1, 0xcc, 0xab, 0xff, 0x65, 0x36, 0x28, 0x2e, 0x74, 0xfd, 0xf7, 0x2c, 0x3c, 0xa9, 0xa1, 0xd1,
-	0x6b, 0x00, 0x8b, 0x66, 0x43, 0xd0, 0xa5, 0x5c, 0xba, 0xc9, 0xb5, 0x2c, 0x5f, 0x9e, 0x2e, 0xd9,
-	0x78, 0xfb, 0xab, 0xaf, 0xbe, 0xfe, 0x7c, 0x3b, 0xb3, 0x82, 0x2a, 0x38, 0xef, 0xe5, 0x98, 0x95,
-	0x44, 0x1f, 0x01, 0x2c, 0x39, 0x23, 0x40, 0x57, 0xfe, 0x6d, 0x33, 0xb9, 0xb9, 0xe5, 0xda, 0x11,
-	0x14, 0x96, 0xee, 0xba, 0xa6, 0xdb, 0x44, 0xd7, 0x72, 0xe9, 0xdc, 0x2d, 0x55, 0xf8, 0xb9, 0xfb,
-	0x34, 0x5e, 0xa0, 0x77, 0x00, 0xce, 0xbb, 0x7f, 0x3e, 0x9a, 0x1e, 0x61, 0x34, 0xce, 0xfa, 0x51,
-	0x24, 0x16, 0x3b, 0xd0, 0xd8, 0x6b, 0xa8, 0x3a, 0x1d, 0x76, 0x63, 0x73, 0xbf, 0xef, 0x81, 0x83,
-	0xbe, 0x07, 0x7e, 0xf4, 0x3d, 0xf0, 0x66, 0xe0, 0x15, 0x0e, 0x06, 0x5e, 0xe1, 0xdb, 0xc0, 0x2b,
-	0x3c, 0xb6, 0x6f, 0x5a, 0xb5, 0x9f, 0x06, 0x5c, 0xe2, 0x67, 0xe3, 0x42, 0xe9, 0x5e, 0x4c, 0x55,
-	0x58, 0xd4, 0xdf, 0xa7, 0xab, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x12, 0x73, 0x05, 0x05, 0x95,
-	0x05, 0x00, 0x00,
+	// 688 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0xd5, 0x16, 0x3a, 0x29, 0x6a, 0xa7, 0x85, 0xb6, 0xc1, 0x6e, 0xdb, 0x15, 0xda,
+	0x5a, 0xcd, 0x6e, 0x7f, 0x50, 0x0b, 0x45, 0x0f, 0x46, 0xa9, 0x16, 0x3c, 0x68, 0x8a, 0x05, 0xbd,
+	0x2c, 0x93, 0xec, 0x76, 0x3b, 0x34, 0x9d, 0xd9, 0xee, 0x6c, 0x8b, 0x41, 0xf4, 0xe0, 0xd9, 0x83,
+	0xe0, 0xdf, 0x20, 0x78, 0x54, 0xe9, 0x5f, 0xe0, 0xa9, 0x78, 0x0a, 0x15, 0x44, 0x10, 0x44, 0x12,
+	0xc1, 0x7f, 0x43, 0x76, 0x66, 0x92, 0x6c, 0xdc, 0x6c, 0x4d, 0xb4, 0x97, 0xb0, 0xcc, 0xbc, 0xef,
+	0x7b, 0x9f, 0xef, 0xcc, 0x9b, 0x17, 0x78, 0xa9, 0xc8, 0xf8, 0x2e, 0xe3, 0x26, 0x2f, 0x61, 0xbe,
+	0x4d, 0xa8, 0x6b, 0x1e, 0x2c, 0x14, 0x9c, 0x00, 0x2f, 0x98, 0x7b, 0xfb, 0x8e, 0x5f, 0x36, 0x3c,
+	0x9f, 0x05, 0x0c, 0x8d, 0xc8, 0x20, 0xa3, 0x1e, 0x64, 0xa8, 0xa0, 0xcc, 0x9c, 0x52, 0x17, 0x30,
+	0x77, 0xa4, 0xa2, 0xa1, 0xf7, 0xb0, 0x4b, 0x28, 0x0e, 0x08, 0xa3, 0x32, 0x49, 0x66, 0xd8, 0x65,
+	0x2e, 0x13, 0x9f, 0x66, 0xf8, 0xa5, 0x56, 0x2f, 0xba, 0x8c, 0xb9, 0x25, 0xc7, 0xc4, 0x1e, 0x31,
+	0x31, 0xa5, 0x2c, 0x10, 0x12, 0xae, 0x76, 0xa7, 0x93, 0xe8, 0x1a, 0x24, 0x32, 0x6e, 0x4c, 0xc6,
+	0x59, 0x32, 0xbd, 0xa2, 0x95, 0x5b, 0x83, 0x78, 0x97, 0x50, 0x66, 0x8a, 0x5f, 0xb9, 0xa4, 0x0f,
+	0x43, 0xf4, 0x20, 0x64, 0xbd, 0x8f, 0x7d, 0xbc, 0xcb, 0xf3, 0xce, 0xde, 0xbe, 0xc3, 0x03, 0xfd,
+	0x11, 0x1c, 0x6a, 0x59, 0xe5, 0x1e, 0xa3, 0xdc, 0x41, 0x39, 0xd8, 0xe7, 0x89, 0x95, 0x51, 0x30,
+	0x09, 0x66, 0xd3, 0x8b, 0x13, 0x46, 0xc2, 0x61, 0x18, 0x52, 0x98, 0xeb, 0x3f, 0xfa, 0x3e, 0x91,
+	0x7a, 0xfb, 0xeb, 0xdd, 0x1c, 0xc8, 0x2b, 0xa5, 0x6e, 0xc1, 0x11, 0x91, 0x7a, 0x83, 0xb8, 0x94,
+	0x50, 0x77, 0x9d, 0x6e, 0x31, 0x55, 0x15, 0xdd, 0x86, 0x03, 0x45, 0x46, 0xb9, 0x85, 0x6d, 0xdb,
+	0x77, 0xb8, 0x2c, 0xd2, 0x9f, 0x9b, 0x3a, 0x3e, 0xcc, 0x8e, 0xab, 0x3a, 0xb7, 0x42, 0x0c, 0xca,
+	0xf7, 0xf9, 0x4d, 0x19, 0xb2, 0x11, 0xf8, 0x84, 0xba, 0xf9, 0x74, 0x28, 0x53, 0x4b, 0xfa, 0x73,
+	0x38, 0x1a, 0x2f, 0xa0, 0x0c, 0x14, 0xe0, 0x85, 0x03, 0x5c, 0xb2, 0xb8, 0xdc, 0xb2, 0x08, 0xdd,
+	0x62, 0xca, 0x4a, 0x36, 0xd1, 0xca, 0x26, 0x2e, 0x11, 0x1b, 0x07, 0xcc, 0x8f, 0x24, 0x8c, 0x1a,
+	0x3b, 0x77, 0x80, 0x4b, 0x91, 0x2d, 0xbd, 0x10, 0xaf, 0x5f, 0x3f, 0x57, 0xb4, 0x06, 0x61, 0xb3,
+	0x17, 0x54, 0xe5, 0xe9, 0x7a, 0xe5, 0xb0, 0x71, 0x0c, 0xd9, 0x6a, 0xcd, 0x63, 0x74, 0x1d, 0xa5,
+	0xcd, 0x47, 0x94, 0xfa, 0x07, 0x00, 0xc7, 0xda, 0x14, 0x51, 0x2e, 0xef, 0xc1, 0xb3, 0xca, 0xd9,
+	0x99, 0xff, 0x72, 0x26, 0xb2, 0xa0, 0x3b, 0x2d, 0xcc, 0x3d, 0x82, 0x79, 0xe6, 0xaf, 0xcc, 0x12,
+	0xa5, 0x05, 0xfa, 0x23, 0x80, 0xe3, 0x02, 0x7a, 0x9d, 0x6e, 0xf9, 0xb8, 0x18, 0xae, 0xdd, 0x25,
+	0x3c, 0x60, 0x7e, 0xf9, 0x54, 0x1b, 0x00, 0xad, 0xb5, 0x01, 0xfe, 0x87, 0x43, 0x5e, 0x1d, 0x3a,
+	0x3e, 0xcc, 0x9e, 0x97, 0xb2, 0x2c, 0xb7, 0x77, 0x26, 0xe7, 0x8d, 0xe5, 0x25, 0xfd, 0x0b, 0x80,
+	0x5a, 0x92, 0x09, 0x75, 0xfc, 0x9b, 0x30, 0x4d, 0x1a, 0x9b, 0x5c, 0xdd, 0xc2, 0xe5, 0xc4, 0x5b,
+	0x68, 0x26, 0xca, 0x3b, 0x45, 0xe6, 0xdb, 0xd1, 0x1b, 0x88, 0x26, 0x3a, 0xb5, 0x8b, 0x68, 0x6b,
+	0x6c, 0xb1, 0xd2, 0x0b, 0x7b, 0x85, 0x31, 0xf4, 0x12, 0xc0, 0x3e, 0xf9, 0x7e, 0xd1, 0x95, 0x44,
+	0xea, 0xf8, 0xd0, 0xc8, 0x5c, 0xed, 0x2c, 0x58, 0x02, 0xe9, 0x33, 0x2f, 0x3e, 0xff, 0x7c, 0xdd,
+	0x33, 0x85, 0x26, 0xcc, 0xa4, 0xb9, 0x26, 0x07, 0x06, 0x7a, 0x0f, 0x60, 0x3a, 0xd2, 0xa0, 0x68,
+	0xfe, 0xe4, 0x32, 0xf1, 0xb9, 0x92, 0x59, 0xe8, 0x42, 0xa1, 0xe8, 0x6e, 0x08, 0xba, 0x15, 0xb4,
+	0x9c, 0x48, 0x17, 0x9d, 0x21, 0xdc, 0x7c, 0x1a, 0xed, 0xdb, 0x67, 0xe8, 0x0d, 0x80, 0x03, 0x91,
+	0xb4, 0x1c, 0x75, 0x8e, 0xd0, 0x38, 0xce, 0xc5, 0x6e, 0x24, 0x0a, 0xdb, 0x10, 0xd8, 0xb3, 0x68,
+	0xba, 0x33, 0x6c, 0xf4, 0x0d, 0xc0, 0xc1, 0x58, 0x23, 0xa3, 0x6b, 0x27, 0x57, 0x4e, 0x7a, 0xbe,
+	0x99, 0x95, 0xae, 0x75, 0x0a, 0xfb, 0xe1, 0xa7, 0x78, 0x43, 0x0a, 0x27, 0xd7, 0xd1, 0x6a, 0xa2,
+	0x93, 0xe6, 0xd3, 0xb0, 0xb6, 0x65, 0xbe, 0x3f, 0x6e, 0x21, 0xb7, 0x72, 0x54, 0xd5, 0x40, 0xa5,
+	0xaa, 0x81, 0x1f, 0x55, 0x0d, 0xbc, 0xaa, 0x69, 0xa9, 0x4a, 0x4d, 0x4b, 0x7d, 0xad, 0x69, 0xa9,
+	0xc7, 0x6a, 0x9c, 0x70, 0x7b, 0xc7, 0x20, 0xcc, 0x7c, 0xd2, 0x4c, 0x1e, 0x94, 0x3d, 0x87, 0x17,
+	0xfa, 0xc4, 0x7f, 0xe3, 0xd2, 0xef, 0x01, 0x00, 0xff, 0x05, 0x63, 0xc3, 0x11, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -380,6 +499,9 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// InfractionHistory queries the infractions a validator was slashed for,
+	// oldest first.
+	InfractionHistory(ctx context.Context, in *QueryInfractionHistoryRequest, opts ...grpc.CallOption) (*QueryInfractionHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -417,6 +539,15 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) InfractionHistory(ctx context.Context, in *QueryInfractionHistoryRequest, opts ...grpc.CallOption) (*QueryInfractionHistoryResponse, error) {
+	out := new(QueryInfractionHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/InfractionHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of slashing module
@@ -425,6 +556,9 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// InfractionHistory queries the infractions a validator was slashed for,
+	// oldest first.
+	InfractionHistory(context.Context, *QueryInfractionHistoryRequest) (*QueryInfractionHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -440,6 +574,9 @@ func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySign
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) InfractionHistory(ctx context.Context, req *QueryInfractionHistoryRequest) (*QueryInfractionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InfractionHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -499,6 +636,24 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InfractionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInfractionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InfractionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/InfractionHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InfractionHistory(ctx, req.(*QueryInfractionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Query",
@@ -516,6 +671,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "InfractionHistory",
+			Handler:    _Query_InfractionHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -724,6 +883,97 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryInfractionHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInfractionHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInfractionHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInfractionHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInfractionHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInfractionHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Infractions) > 0 {
+		for iNdEx := len(m.Infractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Infractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -811,6 +1061,42 @@ func (m *QuerySigningInfosResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryInfractionHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInfractionHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Infractions) > 0 {
+		for _, e := range m.Infractions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1321,6 +1607,244 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryInfractionHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInfractionHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInfractionHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInfractionHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInfractionHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInfractionHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infractions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Infractions = append(m.Infractions, InfractionRecord{})
+			if err := m.Infractions[len(m.Infractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
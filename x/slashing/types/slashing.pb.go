@@ -5,6 +5,7 @@ package types
 
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
+	types "cosmossdk.io/x/staking/types"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -184,9 +185,182 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+// InfractionRecord defines an infraction a validator was slashed for, as kept
+// in the infraction history of the validator.
+type InfractionRecord struct {
+	// address is the consensus address of the validator.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// infraction is the reason the validator was slashed for.
+	Infraction types.Infraction `protobuf:"varint,2,opt,name=infraction,proto3,enum=cosmos.staking.v1beta1.Infraction" json:"infraction,omitempty"`
+	// evidence_type is the type of the evidence the validator was slashed for, if
+	// the slash was triggered by evidence.
+	EvidenceType string `protobuf:"bytes,3,opt,name=evidence_type,json=evidenceType,proto3" json:"evidence_type,omitempty"`
+	// infraction_height is the height at which the infraction was committed.
+	InfractionHeight int64 `protobuf:"varint,4,opt,name=infraction_height,json=infractionHeight,proto3" json:"infraction_height,omitempty"`
+	// time is the block time at which the validator was slashed.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	// slash_fraction is the fraction of the stake of the validator that was slashed.
+	SlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=slash_fraction,json=slashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction"`
+	// slashed_tokens is the amount of staking tokens burned by the slash.
+	SlashedTokens cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=slashed_tokens,json=slashedTokens,proto3,customtype=cosmossdk.io/math.Int" json:"slashed_tokens"`
+	// tombstoned is whether the validator was tombstoned for the infraction.
+	Tombstoned bool `protobuf:"varint,8,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
+	// reverted is whether the tombstoning of the validator was reverted by governance,
+	// refunding the slashed tokens to its delegators.
+	Reverted bool `protobuf:"varint,9,opt,name=reverted,proto3" json:"reverted,omitempty"`
+	// slashed_stakes are the amounts slashed from each delegator of the validator, recorded
+	// for double sign infractions only. They are the amounts refunded if the tombstoning
+	// of the validator is reverted.
+	SlashedStakes []SlashedStake `protobuf:"bytes,10,rep,name=slashed_stakes,json=slashedStakes,proto3" json:"slashed_stakes"`
+}
+
+func (m *InfractionRecord) Reset()         { *m = InfractionRecord{} }
+func (m *InfractionRecord) String() string { return proto.CompactTextString(m) }
+func (*InfractionRecord) ProtoMessage()    {}
+func (*InfractionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
+}
+func (m *InfractionRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InfractionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InfractionRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InfractionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InfractionRecord.Merge(m, src)
+}
+func (m *InfractionRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *InfractionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_InfractionRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InfractionRecord proto.InternalMessageInfo
+
+func (m *InfractionRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *InfractionRecord) GetInfraction() types.Infraction {
+	if m != nil {
+		return m.Infraction
+	}
+	return types.Infraction_INFRACTION_UNSPECIFIED
+}
+
+func (m *InfractionRecord) GetEvidenceType() string {
+	if m != nil {
+		return m.EvidenceType
+	}
+	return ""
+}
+
+func (m *InfractionRecord) GetInfractionHeight() int64 {
+	if m != nil {
+		return m.InfractionHeight
+	}
+	return 0
+}
+
+func (m *InfractionRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *InfractionRecord) GetTombstoned() bool {
+	if m != nil {
+		return m.Tombstoned
+	}
+	return false
+}
+
+func (m *InfractionRecord) GetReverted() bool {
+	if m != nil {
+		return m.Reverted
+	}
+	return false
+}
+
+func (m *InfractionRecord) GetSlashedStakes() []SlashedStake {
+	if m != nil {
+		return m.SlashedStakes
+	}
+	return nil
+}
+
+// SlashedStake defines the amount of staking tokens slashed from the stake of a delegator
+// for an infraction of a validator.
+type SlashedStake struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// delegation is the amount slashed from the delegation to the validator.
+	Delegation cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=delegation,proto3,customtype=cosmossdk.io/math.Int" json:"delegation"`
+	// unbonding is the amount slashed from the unbonding delegation from the validator.
+	Unbonding cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=unbonding,proto3,customtype=cosmossdk.io/math.Int" json:"unbonding"`
+	// redelegation is the amount slashed from the redelegations from the validator.
+	Redelegation cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=redelegation,proto3,customtype=cosmossdk.io/math.Int" json:"redelegation"`
+}
+
+func (m *SlashedStake) Reset()         { *m = SlashedStake{} }
+func (m *SlashedStake) String() string { return proto.CompactTextString(m) }
+func (*SlashedStake) ProtoMessage()    {}
+func (*SlashedStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{3}
+}
+func (m *SlashedStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashedStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashedStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashedStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashedStake.Merge(m, src)
+}
+func (m *SlashedStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashedStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashedStake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashedStake proto.InternalMessageInfo
+
+func (m *SlashedStake) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
+	proto.RegisterType((*InfractionRecord)(nil), "cosmos.slashing.v1beta1.InfractionRecord")
+	proto.RegisterType((*SlashedStake)(nil), "cosmos.slashing.v1beta1.SlashedStake")
 }
 
 func init() {
@@ -194,47 +368,65 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 633 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xee, 0x00, 0x3f, 0x7e, 0x3a, 0xad, 0x07, 0xc7, 0x22, 0x4b, 0x95, 0x6d, 0x21, 0xd1, 0x34,
-	0x24, 0xec, 0x0a, 0x26, 0x1e, 0xe0, 0x64, 0x69, 0x8c, 0x1a, 0x12, 0xc9, 0xe2, 0x9f, 0xc4, 0x83,
-	0x9b, 0xd9, 0x9d, 0xe9, 0x76, 0x64, 0x77, 0xa6, 0xd9, 0x99, 0x15, 0xf8, 0x0a, 0x26, 0x26, 0x1c,
-	0x3d, 0x7a, 0xe4, 0xc8, 0x81, 0xaf, 0x60, 0xc2, 0x91, 0x70, 0x32, 0x1e, 0xd0, 0x94, 0x03, 0x7e,
-	0x0c, 0xb3, 0x33, 0xbb, 0x80, 0x70, 0xe3, 0xd2, 0xb4, 0xcf, 0xf3, 0xbc, 0xcf, 0x3b, 0xef, 0xf3,
-	0xbe, 0x29, 0x7c, 0x18, 0x0a, 0x99, 0x08, 0xe9, 0xca, 0x18, 0xcb, 0x3e, 0xe3, 0x91, 0xfb, 0x69,
-	0x21, 0xa0, 0x0a, 0x2f, 0x9c, 0x01, 0xce, 0x20, 0x15, 0x4a, 0xa0, 0x49, 0xa3, 0x73, 0xce, 0xe0,
-	0x42, 0xd7, 0xa8, 0x47, 0x22, 0x12, 0x5a, 0xe3, 0xe6, 0xdf, 0x8c, 0xbc, 0x61, 0x47, 0x42, 0x44,
-	0x31, 0x75, 0xf5, 0xaf, 0x20, 0xeb, 0xb9, 0x24, 0x4b, 0xb1, 0x62, 0x82, 0x17, 0x7c, 0xf3, 0x32,
-	0xaf, 0x58, 0x42, 0xa5, 0xc2, 0xc9, 0xa0, 0x10, 0x4c, 0x99, 0x7e, 0xbe, 0x71, 0x2e, 0x9a, 0x1b,
-	0xea, 0x36, 0x4e, 0x18, 0x17, 0xae, 0xfe, 0x34, 0xd0, 0xec, 0xf7, 0x11, 0x58, 0x7f, 0x8b, 0x63,
-	0x46, 0xb0, 0x12, 0xe9, 0x3a, 0x8b, 0x38, 0xe3, 0xd1, 0x0b, 0xde, 0x13, 0x68, 0x19, 0xfe, 0x8f,
-	0x09, 0x49, 0xa9, 0x94, 0x16, 0x68, 0x81, 0xf6, 0xcd, 0xce, 0xcc, 0xd1, 0xfe, 0xfc, 0x74, 0x61,
-	0xb7, 0x22, 0xb8, 0xa4, 0x5c, 0x66, 0xf2, 0xa9, 0x91, 0xac, 0xab, 0x94, 0xf1, 0xc8, 0x2b, 0x2b,
-	0xd0, 0x0c, 0xac, 0x49, 0x85, 0x53, 0xe5, 0xf7, 0x29, 0x8b, 0xfa, 0xca, 0x1a, 0x69, 0x81, 0xf6,
-	0xa8, 0x57, 0xd5, 0xd8, 0x73, 0x0d, 0xa1, 0x07, 0xb0, 0xc6, 0x38, 0xa1, 0x5b, 0xbe, 0xe8, 0xf5,
-	0x24, 0x55, 0xd6, 0x68, 0x2e, 0xe9, 0x8c, 0x58, 0xc0, 0xab, 0x6a, 0xfc, 0x95, 0x86, 0xd1, 0x2a,
-	0xac, 0x7d, 0xc4, 0x2c, 0xa6, 0xc4, 0xcf, 0xb8, 0x62, 0xb1, 0x35, 0xd6, 0x02, 0xed, 0xea, 0x62,
-	0xc3, 0x31, 0x29, 0x38, 0x65, 0x0a, 0xce, 0xeb, 0x32, 0x85, 0xce, 0xad, 0x83, 0xe3, 0x66, 0x65,
-	0xe7, 0x57, 0x13, 0xec, 0x9e, 0xee, 0xcd, 0x01, 0xaf, 0x6a, 0xca, 0xdf, 0xe4, 0xd5, 0xc8, 0x86,
-	0x50, 0x89, 0x24, 0x90, 0x4a, 0x70, 0x4a, 0xac, 0xff, 0x5a, 0xa0, 0x7d, 0xc3, 0xbb, 0x80, 0xa0,
-	0x45, 0x38, 0x91, 0x30, 0x29, 0x29, 0xf1, 0x83, 0x58, 0x84, 0x1b, 0xd2, 0x0f, 0x45, 0xc6, 0x15,
-	0x4d, 0xad, 0x71, 0x3d, 0xc0, 0x1d, 0x43, 0x76, 0x34, 0xb7, 0x62, 0xa8, 0xa5, 0xb1, 0x3f, 0xdf,
-	0x9a, 0x60, 0xf6, 0xcb, 0x18, 0x1c, 0x5f, 0xc3, 0x29, 0x4e, 0x24, 0x7a, 0x04, 0xeb, 0x92, 0x45,
-	0xfc, 0xdc, 0x64, 0x93, 0x71, 0x22, 0x36, 0x75, 0x8c, 0xa3, 0x1e, 0x32, 0x9c, 0xf1, 0x78, 0xa7,
-	0x19, 0xc4, 0xf2, 0xb6, 0xdc, 0x2f, 0xaa, 0x06, 0x34, 0x2d, 0x4b, 0xf2, 0xdc, 0x6a, 0x9d, 0x27,
-	0xf9, 0x44, 0x3f, 0x8f, 0x9b, 0xf7, 0x4c, 0xfa, 0x92, 0x6c, 0x38, 0x4c, 0xb8, 0x09, 0x56, 0x7d,
-	0x67, 0x95, 0x46, 0x38, 0xdc, 0xee, 0xd2, 0xf0, 0x68, 0x7f, 0x1e, 0x16, 0xcb, 0xe9, 0xd2, 0xd0,
-	0x8c, 0x8e, 0x12, 0xc6, 0xd7, 0xb5, 0xe7, 0x1a, 0x4d, 0x8b, 0x56, 0x1f, 0xe0, 0x5d, 0x22, 0x36,
-	0x79, 0x7e, 0x34, 0x7e, 0x9e, 0x8c, 0x5f, 0x9e, 0x97, 0x5e, 0x40, 0x75, 0x71, 0xea, 0x4a, 0xb2,
-	0xdd, 0x42, 0x60, 0x82, 0xfd, 0x7a, 0x16, 0x6c, 0xbd, 0xf4, 0x79, 0x89, 0x59, 0x5c, 0x8a, 0x90,
-	0x84, 0x0d, 0x7d, 0xe8, 0x7e, 0x2f, 0xc5, 0x61, 0x8e, 0xf8, 0x44, 0x64, 0x41, 0x4c, 0xf5, 0x70,
-	0x7a, 0x7b, 0xd7, 0x9f, 0x67, 0x52, 0x3b, 0x3f, 0x2b, 0x8c, 0xbb, 0xda, 0x37, 0x9f, 0x0f, 0x71,
-	0x38, 0x79, 0xa5, 0xa9, 0x79, 0x9b, 0xde, 0xf1, 0xf5, 0x3b, 0x4e, 0x5c, 0xea, 0x68, 0x4c, 0x97,
-	0x66, 0x3e, 0x9f, 0xee, 0xcd, 0xdd, 0x37, 0xe2, 0x79, 0x49, 0x36, 0xdc, 0xad, 0xf3, 0x7f, 0x01,
-	0x73, 0x04, 0x9d, 0xe5, 0xdd, 0xa1, 0x0d, 0x0e, 0x86, 0x36, 0x38, 0x1c, 0xda, 0xe0, 0xf7, 0xd0,
-	0x06, 0x3b, 0x27, 0x76, 0xe5, 0xf0, 0xc4, 0xae, 0xfc, 0x38, 0xb1, 0x2b, 0xef, 0xa7, 0xff, 0x79,
-	0xc7, 0x85, 0x6a, 0xb5, 0x3d, 0xa0, 0x32, 0x18, 0xd7, 0xe1, 0x3f, 0xfe, 0x1b, 0x00, 0x00, 0xff,
-	0xff, 0x57, 0xe6, 0xe5, 0x80, 0x63, 0x04, 0x00, 0x00,
+	// 924 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x41, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xc6, 0x4e, 0x1a, 0x8f, 0x9d, 0xfe, 0x93, 0xa9, 0xf3, 0xcf, 0xd6, 0xd0, 0xb5, 0x63,
+	0x28, 0xb2, 0x8a, 0xbc, 0x4e, 0x5d, 0xc1, 0x21, 0x15, 0x07, 0xdc, 0x80, 0x08, 0xaa, 0x20, 0xb2,
+	0x03, 0x95, 0x90, 0x60, 0x35, 0xde, 0x19, 0x6f, 0x06, 0xef, 0xce, 0x58, 0x3b, 0xe3, 0xa4, 0xf9,
+	0x02, 0x1c, 0x90, 0x90, 0x7a, 0xe4, 0xc8, 0xb1, 0xc7, 0x1e, 0xf2, 0x15, 0x90, 0x7a, 0xac, 0x72,
+	0x42, 0x1c, 0x0a, 0x72, 0x0e, 0xe5, 0x63, 0xa0, 0x9d, 0x99, 0xb5, 0xd7, 0x09, 0x1c, 0x48, 0x2e,
+	0x51, 0xf6, 0xbd, 0xdf, 0xfb, 0xbd, 0xf7, 0x7e, 0xef, 0xbd, 0x31, 0x78, 0xcf, 0xe7, 0x22, 0xe2,
+	0xa2, 0x25, 0x42, 0x24, 0x0e, 0x29, 0x0b, 0x5a, 0x47, 0xf7, 0xfb, 0x44, 0xa2, 0xfb, 0x53, 0x83,
+	0x3b, 0x8a, 0xb9, 0xe4, 0x70, 0x53, 0xe3, 0xdc, 0xa9, 0xd9, 0xe0, 0x2a, 0xe5, 0x80, 0x07, 0x5c,
+	0x61, 0x5a, 0xc9, 0x7f, 0x1a, 0x5e, 0x71, 0x02, 0xce, 0x83, 0x90, 0xb4, 0xd4, 0x57, 0x7f, 0x3c,
+	0x68, 0xe1, 0x71, 0x8c, 0x24, 0xe5, 0xcc, 0xf8, 0xab, 0x17, 0xfd, 0x92, 0x46, 0x44, 0x48, 0x14,
+	0x8d, 0x0c, 0xe0, 0xb6, 0xce, 0xe7, 0x69, 0x66, 0x93, 0x5c, 0xbb, 0xd6, 0x51, 0x44, 0x19, 0x6f,
+	0xa9, 0xbf, 0xc6, 0xf4, 0x6e, 0xda, 0x85, 0x44, 0xc3, 0xb9, 0x26, 0xf4, 0xb7, 0x46, 0xd5, 0x7f,
+	0x5d, 0x04, 0xe5, 0xaf, 0x51, 0x48, 0x31, 0x92, 0x3c, 0xee, 0xd1, 0x80, 0x51, 0x16, 0xec, 0xb1,
+	0x01, 0x87, 0x0f, 0xc1, 0x0d, 0x84, 0x71, 0x4c, 0x84, 0xb0, 0xad, 0x9a, 0xd5, 0x28, 0x74, 0xb6,
+	0xce, 0x4e, 0x9b, 0x77, 0x4c, 0xd2, 0x47, 0x9c, 0x09, 0xc2, 0xc4, 0x58, 0x7c, 0xac, 0x21, 0x3d,
+	0x19, 0x53, 0x16, 0x74, 0xd3, 0x08, 0xb8, 0x05, 0x4a, 0x42, 0xa2, 0x58, 0x7a, 0x87, 0x84, 0x06,
+	0x87, 0xd2, 0x5e, 0xac, 0x59, 0x8d, 0x5c, 0xb7, 0xa8, 0x6c, 0x9f, 0x29, 0x13, 0xbc, 0x0b, 0x4a,
+	0x94, 0x61, 0xf2, 0xd4, 0xe3, 0x83, 0x81, 0x20, 0xd2, 0xce, 0x25, 0x90, 0xce, 0xa2, 0x6d, 0x75,
+	0x8b, 0xca, 0xfe, 0xa5, 0x32, 0xc3, 0xc7, 0xa0, 0xf4, 0x3d, 0xa2, 0x21, 0xc1, 0xde, 0x98, 0x49,
+	0x1a, 0xda, 0xf9, 0x9a, 0xd5, 0x28, 0xb6, 0x2b, 0xae, 0xd6, 0xca, 0x4d, 0xb5, 0x72, 0x0f, 0x52,
+	0xad, 0x3a, 0xab, 0x2f, 0x5f, 0x57, 0x17, 0x9e, 0xfd, 0x51, 0xb5, 0x9e, 0xbf, 0x79, 0x71, 0xcf,
+	0xea, 0x16, 0x75, 0xf8, 0x57, 0x49, 0x34, 0x74, 0x00, 0x90, 0x3c, 0xea, 0x0b, 0xc9, 0x19, 0xc1,
+	0xf6, 0x52, 0xcd, 0x6a, 0xac, 0x74, 0x33, 0x16, 0xd8, 0x06, 0x1b, 0x11, 0x15, 0x82, 0x60, 0xaf,
+	0x1f, 0x72, 0x7f, 0x28, 0x3c, 0x9f, 0x8f, 0x99, 0x24, 0xb1, 0xbd, 0xac, 0x1a, 0xb8, 0xa5, 0x9d,
+	0x1d, 0xe5, 0x7b, 0xa4, 0x5d, 0x3b, 0xf9, 0xbf, 0x7e, 0xa9, 0x5a, 0xf5, 0x9f, 0xf2, 0x60, 0x79,
+	0x1f, 0xc5, 0x28, 0x12, 0x70, 0x1b, 0x94, 0x05, 0x0d, 0xd8, 0x8c, 0xe4, 0x98, 0x32, 0xcc, 0x8f,
+	0x95, 0x8c, 0xb9, 0x2e, 0xd4, 0x3e, 0xcd, 0xf1, 0x44, 0x79, 0x20, 0x4d, 0xd2, 0x32, 0xcf, 0x44,
+	0x8d, 0x48, 0x9c, 0x86, 0x24, 0xba, 0x95, 0x3a, 0x1f, 0x26, 0x1d, 0xfd, 0xfe, 0xba, 0xfa, 0x96,
+	0x56, 0x5f, 0xe0, 0xa1, 0x4b, 0x79, 0x2b, 0x42, 0xf2, 0xd0, 0x7d, 0x4c, 0x02, 0xe4, 0x9f, 0xec,
+	0x12, 0xff, 0xec, 0xb4, 0x09, 0xcc, 0x70, 0x76, 0x89, 0xaf, 0x5b, 0x87, 0x11, 0x65, 0x3d, 0xc5,
+	0xb9, 0x4f, 0x62, 0x93, 0xea, 0x3b, 0xf0, 0x7f, 0xcc, 0x8f, 0x59, 0xb2, 0x5a, 0x5e, 0xa2, 0x8c,
+	0x97, 0x2e, 0xa1, 0x1a, 0x40, 0xb1, 0x7d, 0xfb, 0x92, 0xb2, 0xbb, 0x06, 0xa0, 0x85, 0xfd, 0x79,
+	0x2a, 0x6c, 0x39, 0xe5, 0xf9, 0x1c, 0xd1, 0x30, 0x05, 0x41, 0x01, 0x2a, 0xea, 0x1c, 0xbc, 0x41,
+	0x8c, 0xfc, 0xc4, 0xe2, 0x61, 0x3e, 0xee, 0x87, 0x44, 0x35, 0x67, 0xe7, 0xaf, 0xd5, 0xcf, 0xa6,
+	0x62, 0xfe, 0xd4, 0x10, 0xef, 0x2a, 0xde, 0xa4, 0x3f, 0xc8, 0xc0, 0xe6, 0xa5, 0xa4, 0xba, 0x36,
+	0x7b, 0xe9, 0x5a, 0x19, 0x37, 0x2e, 0x64, 0xd4, 0xa4, 0x3b, 0x5b, 0x3f, 0xbe, 0x79, 0x71, 0xef,
+	0x6d, 0x0d, 0x6e, 0x0a, 0x3c, 0x6c, 0x3d, 0x9d, 0xbd, 0x15, 0x7a, 0x09, 0xea, 0x3f, 0x2c, 0x81,
+	0xb5, 0x3d, 0x96, 0xd6, 0xd3, 0x25, 0x3e, 0x8f, 0xf1, 0xf5, 0x6e, 0xaa, 0x03, 0x00, 0x9d, 0x12,
+	0xaa, 0xcd, 0xb8, 0xd9, 0xae, 0xbb, 0x26, 0x38, 0x3d, 0x6a, 0x73, 0xe4, 0x6e, 0x26, 0x75, 0x26,
+	0x0a, 0xbe, 0x03, 0x56, 0xc9, 0x11, 0xc5, 0x84, 0xf9, 0xc4, 0x93, 0x27, 0x23, 0xa2, 0x86, 0x5e,
+	0xe8, 0x96, 0x52, 0xe3, 0xc1, 0xc9, 0x88, 0xc0, 0xf7, 0xc1, 0xfa, 0x2c, 0x24, 0xbd, 0xe0, 0xbc,
+	0x5a, 0xde, 0xb5, 0x99, 0xc3, 0x9c, 0xf1, 0x47, 0x20, 0x3f, 0xd5, 0xf9, 0x3f, 0xdd, 0xa5, 0x0a,
+	0x83, 0xdf, 0x82, 0x9b, 0xf3, 0x93, 0xb3, 0x97, 0xaf, 0x35, 0xb0, 0xd5, 0xb9, 0x81, 0xc1, 0x27,
+	0x86, 0x9e, 0x60, 0x4f, 0xf2, 0x21, 0x61, 0xc2, 0xbe, 0xa1, 0x74, 0xdf, 0x36, 0xf4, 0x1b, 0x97,
+	0xe9, 0xf7, 0x98, 0xcc, 0x10, 0xef, 0x31, 0x99, 0x25, 0x26, 0xf8, 0x40, 0xd1, 0x5c, 0x78, 0x48,
+	0x56, 0x2e, 0x3d, 0x24, 0x15, 0xb0, 0x12, 0x93, 0x23, 0x12, 0x4b, 0x82, 0xed, 0x82, 0xf2, 0x4e,
+	0xbf, 0xb3, 0x45, 0x25, 0x63, 0x23, 0xc2, 0x06, 0xb5, 0x5c, 0xa3, 0xd8, 0xbe, 0xeb, 0xfe, 0xcb,
+	0xef, 0x89, 0xdb, 0xd3, 0xf0, 0x5e, 0x82, 0xee, 0x14, 0x92, 0xda, 0xe7, 0x8b, 0x52, 0x0e, 0xb1,
+	0x73, 0xeb, 0xec, 0xb4, 0xf9, 0xbf, 0xd9, 0x56, 0xd6, 0xb6, 0xdd, 0x0f, 0x1e, 0xd4, 0x27, 0x8b,
+	0xa0, 0x94, 0x8d, 0x87, 0x9f, 0x80, 0x75, 0x4c, 0x42, 0x12, 0x24, 0x0f, 0xbe, 0x37, 0xbf, 0x8e,
+	0xf6, 0xd9, 0x69, 0xb3, 0x6c, 0x8a, 0x98, 0xdf, 0xc2, 0xb5, 0x69, 0x88, 0xb1, 0xc3, 0x7d, 0x00,
+	0x8c, 0x2d, 0x5d, 0xc7, 0xab, 0xc8, 0x9a, 0xe1, 0x80, 0x5f, 0x80, 0xc2, 0x98, 0xf5, 0x39, 0xc3,
+	0x94, 0x05, 0x76, 0xee, 0x8a, 0x84, 0x33, 0x0a, 0x78, 0x00, 0x4a, 0x31, 0xc9, 0xd4, 0x98, 0xbf,
+	0x22, 0xe5, 0x1c, 0xcb, 0x3f, 0x8a, 0xdc, 0x79, 0xf8, 0x7c, 0xe2, 0x58, 0x2f, 0x27, 0x8e, 0xf5,
+	0x6a, 0xe2, 0x58, 0x7f, 0x4e, 0x1c, 0xeb, 0xd9, 0xb9, 0xb3, 0xf0, 0xea, 0xdc, 0x59, 0xf8, 0xed,
+	0xdc, 0x59, 0xf8, 0xe6, 0xce, 0x5c, 0xaa, 0xcc, 0x5b, 0x91, 0x9c, 0xa0, 0xe8, 0x2f, 0xab, 0x63,
+	0x79, 0xf0, 0xf7, 0x00, 0x18, 0x65, 0xc3, 0x45, 0x77, 0x08, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -312,6 +504,95 @@ func (this *Params) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *InfractionRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InfractionRecord)
+	if !ok {
+		that2, ok := that.(InfractionRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Infraction != that1.Infraction {
+		return false
+	}
+	if this.EvidenceType != that1.EvidenceType {
+		return false
+	}
+	if this.InfractionHeight != that1.InfractionHeight {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if !this.SlashedTokens.Equal(that1.SlashedTokens) {
+		return false
+	}
+	if this.Tombstoned != that1.Tombstoned {
+		return false
+	}
+	if this.Reverted != that1.Reverted {
+		return false
+	}
+	if len(this.SlashedStakes) != len(that1.SlashedStakes) {
+		return false
+	}
+	for i := range this.SlashedStakes {
+		if !this.SlashedStakes[i].Equal(&that1.SlashedStakes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SlashedStake) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SlashedStake)
+	if !ok {
+		that2, ok := that.(SlashedStake)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DelegatorAddress != that1.DelegatorAddress {
+		return false
+	}
+	if !this.Delegation.Equal(that1.Delegation) {
+		return false
+	}
+	if !this.Unbonding.Equal(that1.Unbonding) {
+		return false
+	}
+	if !this.Redelegation.Equal(that1.Redelegation) {
+		return false
+	}
+	return true
+}
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -441,71 +722,693 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSlashing(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *InfractionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ValidatorSigningInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *InfractionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InfractionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovSlashing(uint64(l))
-	}
-	if m.StartHeight != 0 {
-		n += 1 + sovSlashing(uint64(m.StartHeight))
+	if len(m.SlashedStakes) > 0 {
+		for iNdEx := len(m.SlashedStakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashedStakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	if m.IndexOffset != 0 {
-		n += 1 + sovSlashing(uint64(m.IndexOffset))
+	if m.Reverted {
+		i--
+		if m.Reverted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
-	n += 1 + l + sovSlashing(uint64(l))
 	if m.Tombstoned {
-		n += 2
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.MissedBlocksCounter != 0 {
-		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
+	{
+		size := m.SlashedTokens.Size()
+		i -= size
+		if _, err := m.SlashedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	if m.InfractionHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.InfractionHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.EvidenceType) > 0 {
+		i -= len(m.EvidenceType)
+		copy(dAtA[i:], m.EvidenceType)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.EvidenceType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Infraction != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Infraction))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashedStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashedStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashedStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Redelegation.Size()
+		i -= size
+		if _, err := m.Redelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Unbonding.Size()
+		i -= size
+		if _, err := m.Unbonding.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Delegation.Size()
+		i -= size
+		if _, err := m.Delegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSlashing(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.StartHeight))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovSlashing(uint64(m.IndexOffset))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.Tombstoned {
+		n += 2
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovSlashing(uint64(m.SignedBlocksWindow))
+	}
+	l = m.MinSignedPerWindow.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionDoubleSign.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionDowntime.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func (m *InfractionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.Infraction != 0 {
+		n += 1 + sovSlashing(uint64(m.Infraction))
+	}
+	l = len(m.EvidenceType)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.InfractionHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.InfractionHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashedTokens.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.Tombstoned {
+		n += 2
+	}
+	if m.Reverted {
+		n += 2
+	}
+	if len(m.SlashedStakes) > 0 {
+		for _, e := range m.SlashedStakes {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SlashedStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = m.Delegation.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.Unbonding.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.Redelegation.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func sovSlashing(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSlashing(x uint64) (n int) {
+	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSign", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SignedBlocksWindow != 0 {
-		n += 1 + sovSlashing(uint64(m.SignedBlocksWindow))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.MinSignedPerWindow.Size()
-	n += 1 + l + sovSlashing(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration)
-	n += 1 + l + sovSlashing(uint64(l))
-	l = m.SlashFractionDoubleSign.Size()
-	n += 1 + l + sovSlashing(uint64(l))
-	l = m.SlashFractionDowntime.Size()
-	n += 1 + l + sovSlashing(uint64(l))
-	return n
-}
-
-func sovSlashing(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozSlashing(x uint64) (n int) {
-	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
+func (m *InfractionRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -528,10 +1431,10 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: InfractionRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InfractionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -568,9 +1471,9 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Infraction", wireType)
 			}
-			m.StartHeight = 0
+			m.Infraction = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -580,16 +1483,16 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= int64(b&0x7F) << shift
+				m.Infraction |= types.Infraction(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceType", wireType)
 			}
-			m.IndexOffset = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -599,14 +1502,46 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IndexOffset |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionHeight", wireType)
+			}
+			m.InfractionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InfractionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -633,11 +1568,78 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
 			}
@@ -657,11 +1659,11 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Tombstoned = bool(v != 0)
-		case 6:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverted", wireType)
 			}
-			m.MissedBlocksCounter = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -671,11 +1673,46 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reverted = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedStakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashedStakes = append(m.SlashedStakes, SlashedStake{})
+			if err := m.SlashedStakes[len(m.SlashedStakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -697,7 +1734,7 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *SlashedStake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -720,36 +1757,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashedStake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashedStake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
-			}
-			m.SignedBlocksWindow = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSlashing
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SignedBlocksWindow |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -759,30 +1777,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSlashing
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSlashing
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -792,30 +1809,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSlashing
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSlashing
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSign", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbonding", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -825,30 +1843,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSlashing
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSlashing
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionDoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Unbonding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -858,22 +1877,23 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSlashing
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSlashing
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -201,47 +201,149 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgRevertTombstone is the Msg/RevertTombstone request type.
+type MsgRevertTombstone struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// cons_address is the consensus address of the tombstoned validator.
+	ConsAddress string `protobuf:"bytes,2,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+}
+
+func (m *MsgRevertTombstone) Reset()         { *m = MsgRevertTombstone{} }
+func (m *MsgRevertTombstone) String() string { return proto.CompactTextString(m) }
+func (*MsgRevertTombstone) ProtoMessage()    {}
+func (*MsgRevertTombstone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{4}
+}
+func (m *MsgRevertTombstone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevertTombstone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevertTombstone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevertTombstone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevertTombstone.Merge(m, src)
+}
+func (m *MsgRevertTombstone) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevertTombstone) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevertTombstone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevertTombstone proto.InternalMessageInfo
+
+func (m *MsgRevertTombstone) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRevertTombstone) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+// MsgRevertTombstoneResponse defines the response structure for executing a
+// MsgRevertTombstone message.
+type MsgRevertTombstoneResponse struct {
+}
+
+func (m *MsgRevertTombstoneResponse) Reset()         { *m = MsgRevertTombstoneResponse{} }
+func (m *MsgRevertTombstoneResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevertTombstoneResponse) ProtoMessage()    {}
+func (*MsgRevertTombstoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{5}
+}
+func (m *MsgRevertTombstoneResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevertTombstoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevertTombstoneResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevertTombstoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevertTombstoneResponse.Merge(m, src)
+}
+func (m *MsgRevertTombstoneResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevertTombstoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevertTombstoneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevertTombstoneResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUnjail)(nil), "cosmos.slashing.v1beta1.MsgUnjail")
 	proto.RegisterType((*MsgUnjailResponse)(nil), "cosmos.slashing.v1beta1.MsgUnjailResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.slashing.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.slashing.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRevertTombstone)(nil), "cosmos.slashing.v1beta1.MsgRevertTombstone")
+	proto.RegisterType((*MsgRevertTombstoneResponse)(nil), "cosmos.slashing.v1beta1.MsgRevertTombstoneResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/slashing/v1beta1/tx.proto", fileDescriptor_3c5611c0c4a59d9d) }
 
 var fileDescriptor_3c5611c0c4a59d9d = []byte{
-	// 475 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0xce, 0x49, 0x2c, 0xce, 0xc8, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd1, 0x07,
-	0xb1, 0x20, 0xca, 0xa5, 0xd4, 0x70, 0x19, 0x08, 0xd7, 0x0f, 0x51, 0x27, 0x09, 0x51, 0x17, 0x0f,
-	0x31, 0x00, 0x6a, 0x07, 0x44, 0x0a, 0x6a, 0xa3, 0x7e, 0x6e, 0x31, 0x48, 0x37, 0x88, 0x82, 0x4a,
-	0x08, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x90, 0xd2, 0x62, 0x46, 0x2e, 0x4e,
-	0xdf, 0xe2, 0xf4, 0xd0, 0xbc, 0xac, 0xc4, 0xcc, 0x1c, 0xa1, 0x14, 0x2e, 0xbe, 0xb2, 0xc4, 0x9c,
-	0xcc, 0x94, 0xc4, 0x92, 0xfc, 0xa2, 0xf8, 0xc4, 0x94, 0x94, 0x22, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x4e, 0x27, 0xdb, 0x57, 0xf7, 0xe4, 0xd9, 0x41, 0xfc, 0xd4, 0xe2, 0xe2, 0x4b, 0x5b, 0x74, 0x65,
-	0xa1, 0xd6, 0x85, 0xc1, 0xd4, 0x3a, 0x42, 0xa4, 0x82, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x17, 0x3d,
-	0xdf, 0xa0, 0x05, 0x53, 0xbc, 0xe2, 0xf9, 0x06, 0x2d, 0xc6, 0x20, 0xde, 0x32, 0x64, 0x85, 0x56,
-	0x06, 0x1d, 0x0b, 0xe4, 0x19, 0x9a, 0x9e, 0x6f, 0xd0, 0x42, 0xb3, 0xac, 0xeb, 0xf9, 0x06, 0x2d,
-	0x11, 0x88, 0xd1, 0xba, 0xc5, 0x29, 0xd9, 0xfa, 0x70, 0x77, 0x29, 0x09, 0x73, 0x09, 0xc2, 0x39,
-	0x41, 0xa9, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x4a, 0xb7, 0x18, 0xb9, 0xf8, 0x41, 0xa2, 0x05,
-	0x29, 0x89, 0x25, 0xa9, 0x01, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x42, 0x66, 0x5c, 0x9c, 0x89, 0xa5,
-	0x25, 0x19, 0xf9, 0x45, 0x99, 0x25, 0x95, 0x50, 0xb7, 0x4b, 0x5c, 0xda, 0xa2, 0x0b, 0x35, 0x55,
-	0x0f, 0xc5, 0x9d, 0x41, 0x08, 0xa5, 0x42, 0x4e, 0x5c, 0x6c, 0x05, 0x60, 0x13, 0x24, 0x98, 0x14,
-	0x18, 0x35, 0xb8, 0x8d, 0xe4, 0xf5, 0x70, 0xc4, 0x9a, 0x1e, 0xc4, 0x22, 0x27, 0xce, 0x13, 0xf7,
-	0xe4, 0x19, 0x20, 0xbe, 0x83, 0xea, 0xb4, 0xf2, 0xbe, 0xb4, 0x45, 0x97, 0x1f, 0xe1, 0x7c, 0x05,
-	0x03, 0x3d, 0x13, 0x73, 0x90, 0x2f, 0x11, 0xd6, 0x80, 0x3c, 0xa8, 0x8a, 0xe4, 0xc1, 0x0a, 0x44,
-	0x2c, 0xa3, 0x79, 0x44, 0x49, 0x8f, 0x4b, 0x1c, 0x4d, 0x08, 0xe6, 0x6f, 0x2b, 0x61, 0x2c, 0xf6,
-	0x18, 0x3d, 0x67, 0xe4, 0x62, 0xf6, 0x2d, 0x4e, 0x17, 0x8a, 0xe0, 0x62, 0x83, 0xc6, 0xa5, 0x12,
-	0x4e, 0x2f, 0xc0, 0x83, 0x52, 0x4a, 0x8b, 0xb0, 0x1a, 0x98, 0xb5, 0x42, 0xf5, 0x5c, 0x3c, 0x28,
-	0x41, 0xad, 0x81, 0x57, 0x2f, 0x92, 0x4a, 0x29, 0x03, 0x62, 0x55, 0xc2, 0xa3, 0x56, 0xf8, 0x14,
-	0xa6, 0x17, 0xa5, 0x58, 0x1b, 0x40, 0xc1, 0xed, 0x64, 0xbd, 0xe2, 0x91, 0x1c, 0xe3, 0x89, 0x47,
-	0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85,
-	0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x41, 0x93, 0x66, 0x71, 0x4a, 0xb6, 0x5e, 0x66,
-	0x3e, 0x72, 0x00, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x53, 0xbd, 0x31, 0x20, 0x00,
-	0x00, 0xff, 0xff, 0x2d, 0x0b, 0x6f, 0x20, 0xb7, 0x03, 0x00, 0x00,
+	// 578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x6f, 0x12, 0x41,
+	0x14, 0xc7, 0x99, 0x1a, 0x31, 0x4c, 0xab, 0xa4, 0x4b, 0x93, 0xe2, 0x26, 0x5d, 0x70, 0x13, 0x0d,
+	0xc1, 0xb0, 0x0b, 0x45, 0x34, 0xc1, 0x78, 0x90, 0x7a, 0x33, 0x24, 0x06, 0x7f, 0xc4, 0x78, 0x21,
+	0x43, 0x77, 0xb3, 0x5d, 0x0b, 0x3b, 0x64, 0xdf, 0x94, 0xb4, 0x27, 0x8d, 0xa7, 0xc6, 0x93, 0x7f,
+	0x82, 0xd1, 0x4b, 0x8f, 0x1c, 0xf8, 0x23, 0x1a, 0x4f, 0x0d, 0x17, 0x8d, 0x87, 0xc6, 0xc0, 0x81,
+	0xc4, 0xa3, 0x7f, 0x81, 0x19, 0x66, 0xf8, 0xb5, 0x08, 0x6a, 0x2f, 0xc0, 0xbe, 0xf9, 0xbc, 0xf7,
+	0x7d, 0x6f, 0xbe, 0x8f, 0xc5, 0xc9, 0x5d, 0x0a, 0x0d, 0x0a, 0x26, 0xd4, 0x09, 0xec, 0xb9, 0x9e,
+	0x63, 0xb6, 0x72, 0x35, 0x9b, 0x91, 0x9c, 0xc9, 0x0e, 0x8d, 0xa6, 0x4f, 0x19, 0x55, 0x36, 0x05,
+	0x61, 0x8c, 0x08, 0x43, 0x12, 0xea, 0x86, 0x43, 0x1d, 0x3a, 0x64, 0x4c, 0xfe, 0x4b, 0xe0, 0xea,
+	0xad, 0x45, 0x05, 0xc7, 0xf9, 0x82, 0xbb, 0x2e, 0xb8, 0xaa, 0x28, 0x20, 0x35, 0xc4, 0x91, 0x54,
+	0x34, 0x1b, 0xc0, 0xb3, 0xf9, 0x97, 0x3c, 0x58, 0x27, 0x0d, 0xd7, 0xa3, 0xe6, 0xf0, 0x53, 0x84,
+	0xf4, 0xcf, 0x08, 0x47, 0xca, 0xe0, 0x3c, 0xf7, 0x5e, 0x13, 0xb7, 0xae, 0x58, 0xf8, 0x5a, 0x8b,
+	0xd4, 0x5d, 0x8b, 0x30, 0xea, 0x57, 0x89, 0x65, 0xf9, 0x71, 0x94, 0x44, 0xa9, 0x48, 0xe9, 0xc1,
+	0xcf, 0xf3, 0xc4, 0x15, 0xfe, 0x6c, 0x03, 0x74, 0x3b, 0x99, 0x2d, 0x29, 0xf7, 0x62, 0xc4, 0x3e,
+	0x14, 0x47, 0x4f, 0x99, 0xef, 0x7a, 0xce, 0xa7, 0x41, 0x3b, 0x3d, 0x82, 0x4f, 0x06, 0xed, 0x34,
+	0xaa, 0x5c, 0x6d, 0x4d, 0x83, 0xc5, 0xec, 0xf1, 0xc7, 0x44, 0xe8, 0xdd, 0xa0, 0x9d, 0x0e, 0x88,
+	0xbd, 0x1f, 0xb4, 0xd3, 0x1b, 0xa2, 0x74, 0x06, 0xac, 0x7d, 0x73, 0xdc, 0x97, 0x1e, 0xc3, 0xeb,
+	0xe3, 0x87, 0x8a, 0x0d, 0x4d, 0xea, 0x81, 0xad, 0x7f, 0x47, 0x38, 0xca, 0xa3, 0x4d, 0x8b, 0x30,
+	0xfb, 0x09, 0xf1, 0x49, 0x03, 0x94, 0xbb, 0x38, 0x42, 0x0e, 0xd8, 0x1e, 0xf5, 0x5d, 0x76, 0x24,
+	0x7b, 0x8f, 0x77, 0x3b, 0x19, 0x59, 0xd5, 0x98, 0xe9, 0xb3, 0x32, 0x41, 0x95, 0x12, 0x0e, 0x37,
+	0x87, 0x15, 0xe2, 0x2b, 0x49, 0x94, 0x5a, 0xdd, 0x4e, 0x18, 0x0b, 0x5c, 0x33, 0x84, 0x50, 0x29,
+	0x72, 0x7a, 0x9e, 0x08, 0x89, 0xe9, 0x64, 0x66, 0xf1, 0x71, 0xb7, 0x93, 0x89, 0x4e, 0xda, 0x4f,
+	0x66, 0x8d, 0x3b, 0xf7, 0xf8, 0x94, 0x13, 0x19, 0x3e, 0xe0, 0xcd, 0xa9, 0x01, 0x0f, 0x27, 0x2e,
+	0x07, 0x06, 0xd1, 0x0d, 0xbc, 0x19, 0x08, 0x8d, 0xe6, 0x2e, 0xc6, 0xfe, 0xa0, 0xa3, 0x7f, 0x45,
+	0x58, 0x29, 0x83, 0x53, 0xb1, 0x5b, 0xb6, 0xcf, 0x9e, 0xd1, 0x46, 0x0d, 0x18, 0xf5, 0xec, 0x0b,
+	0xdf, 0xc7, 0x23, 0xbc, 0xb6, 0x4b, 0x3d, 0xa8, 0x4a, 0x1b, 0x87, 0xb7, 0x12, 0x29, 0xdd, 0x98,
+	0xf2, 0x7e, 0x87, 0xf7, 0xe2, 0xc1, 0x01, 0xcc, 0xd6, 0x58, 0xe5, 0x69, 0x32, 0x54, 0xdc, 0x99,
+	0xeb, 0xb4, 0x90, 0x9f, 0xbf, 0x91, 0xad, 0x59, 0xcb, 0x03, 0x23, 0xe8, 0x39, 0xac, 0xce, 0x47,
+	0x97, 0x5c, 0x46, 0x21, 0xbf, 0xfd, 0x6b, 0x05, 0x5f, 0x2a, 0x83, 0xa3, 0xbc, 0xc4, 0x61, 0xb9,
+	0xd8, 0xfa, 0x42, 0x3f, 0xc7, 0x7b, 0xa5, 0xa6, 0xff, 0xce, 0x8c, 0x64, 0x95, 0x37, 0x78, 0x6d,
+	0x66, 0xef, 0x52, 0x4b, 0x73, 0xa7, 0x48, 0x35, 0xfb, 0xaf, 0xe4, 0x78, 0xcf, 0x63, 0x5f, 0xe6,
+	0xfd, 0x56, 0x8e, 0x11, 0x8e, 0x06, 0xcd, 0xbe, 0xbd, 0xac, 0x74, 0x00, 0x56, 0xf3, 0xff, 0x01,
+	0x2f, 0x69, 0xa5, 0x90, 0x57, 0x2f, 0xbf, 0xe5, 0x7f, 0x83, 0xd2, 0xfd, 0x93, 0x9e, 0x86, 0x4e,
+	0x7b, 0x1a, 0x3a, 0xeb, 0x69, 0xe8, 0x47, 0x4f, 0x43, 0x1f, 0xfa, 0x5a, 0xe8, 0xac, 0xaf, 0x85,
+	0xbe, 0xf5, 0xb5, 0xd0, 0x2b, 0x69, 0x32, 0x58, 0xfb, 0x86, 0x4b, 0xa7, 0x17, 0x9f, 0x1d, 0x35,
+	0x6d, 0xa8, 0x85, 0x87, 0x6f, 0xa3, 0xfc, 0xef, 0x01, 0x00, 0xec, 0x92, 0xdf, 0x0b, 0x4f, 0x05,
+	0x00, 0x00,
 }
 
 func (this *MsgUnjail) Equal(that interface{}) bool {
@@ -337,6 +439,54 @@ func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgRevertTombstone) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRevertTombstone)
+	if !ok {
+		that2, ok := that.(MsgRevertTombstone)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if this.ConsAddress != that1.ConsAddress {
+		return false
+	}
+	return true
+}
+func (this *MsgRevertTombstoneResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRevertTombstoneResponse)
+	if !ok {
+		that2, ok := that.(MsgRevertTombstoneResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -357,6 +507,12 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/slashing module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RevertTombstone defines a governance operation for reverting the tombstoning
+	// of a validator proven to be a false positive, e.g. after a key rotation mishap.
+	// The tokens slashed for the infraction are refunded to the delegators of the
+	// validator from the community pool. The authority defaults to the x/gov module
+	// account.
+	RevertTombstone(ctx context.Context, in *MsgRevertTombstone, opts ...grpc.CallOption) (*MsgRevertTombstoneResponse, error)
 }
 
 type msgClient struct {
@@ -385,6 +541,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RevertTombstone(ctx context.Context, in *MsgRevertTombstone, opts ...grpc.CallOption) (*MsgRevertTombstoneResponse, error) {
+	out := new(MsgRevertTombstoneResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Msg/RevertTombstone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Unjail defines a method for unjailing a jailed validator, thus returning
@@ -394,6 +559,12 @@ type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/slashing module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RevertTombstone defines a governance operation for reverting the tombstoning
+	// of a validator proven to be a false positive, e.g. after a key rotation mishap.
+	// The tokens slashed for the infraction are refunded to the delegators of the
+	// validator from the community pool. The authority defaults to the x/gov module
+	// account.
+	RevertTombstone(context.Context, *MsgRevertTombstone) (*MsgRevertTombstoneResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -406,6 +577,9 @@ func (*UnimplementedMsgServer) Unjail(ctx context.Context, req *MsgUnjail) (*Msg
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) RevertTombstone(ctx context.Context, req *MsgRevertTombstone) (*MsgRevertTombstoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertTombstone not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -447,6 +621,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RevertTombstone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevertTombstone)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevertTombstone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Msg/RevertTombstone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevertTombstone(ctx, req.(*MsgRevertTombstone))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Msg",
@@ -460,6 +652,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RevertTombstone",
+			Handler:    _Msg_RevertTombstone_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/tx.proto",
@@ -581,6 +777,66 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRevertTombstone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevertTombstone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevertTombstone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevertTombstoneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevertTombstoneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevertTombstoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -638,6 +894,32 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRevertTombstone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevertTombstoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -941,6 +1223,170 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRevertTombstone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevertTombstone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevertTombstone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevertTombstoneResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevertTombstoneResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevertTombstoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
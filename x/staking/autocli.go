@@ -29,6 +29,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "validator_addr"},
 					},
 				},
+				{
+					RpcMethod: "ValidatorScheduledCommission",
+					Use:       "scheduled-commission <validator-addr>",
+					Short:     "Query the commission change scheduled for a validator",
+					Long:      "Query the commission rate a validator scheduled, and the epochs remaining before it applies.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_addr"},
+					},
+				},
 				{
 					RpcMethod: "ValidatorDelegations",
 					Use:       "delegations-to <validator-addr>",
@@ -175,6 +184,27 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf(`%s tx staking rotate-cons-pubkey myvalidator {"@type":"/cosmos.crypto.ed25519.PubKey","key":"oWg2ISpLF405Jcm2vXV+2v4fnjodh6aafuIdeoW+rUw="}`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "new_pubkey"}},
 				},
+				{
+					RpcMethod:      "TokenizeShares",
+					Use:            "tokenize-shares <validator-addr> <amount> --from <delegator_address>",
+					Short:          "Tokenize a delegation into liquid staking share tokens",
+					Example:        fmt.Sprintf(`%s tx staking tokenize-shares cosmosvaloper... 100stake --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "RedeemTokensForShares",
+					Use:            "redeem-tokens <amount> --from <delegator_address>",
+					Short:          "Redeem liquid staking share tokens back into a delegation",
+					Example:        fmt.Sprintf(`%s tx staking redeem-tokens 100liquid/cosmosvaloper... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "TransferDelegation",
+					Use:            "transfer-delegation <validator-addr> <recipient-addr> <amount> --from <delegator_address>",
+					Short:          "Transfer the ownership of a delegation without unbonding it",
+					Example:        fmt.Sprintf(`%s tx staking transfer-delegation cosmosvaloper... cosmos1... 100stake --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "recipient_address"}, {ProtoField: "amount"}},
+				},
 				{
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal <params>",
@@ -82,7 +82,10 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.CometInfoService,
 	)
 	m := NewAppModule(in.Cdc, k)
-	return ModuleOutputs{StakingKeeper: k, Module: m}
+	return ModuleOutputs{
+		StakingKeeper: k,
+		Module:        m,
+	}
 }
 
 func InvokeSetStakingHooks(
@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+)
+
+// EpochHooks implements the x/epochs EpochHooks interface for the staking keeper, counting
+// down the epochs of the scheduled commission changes and applying them.
+type EpochHooks struct {
+	k *Keeper
+}
+
+// EpochHooks returns the epoch hooks of the staking keeper, which must be registered in
+// x/epochs by the app for the scheduled commission changes to be applied. x/staking does
+// not depend on x/epochs, so the hooks are not provided by its depinject module.
+func (k *Keeper) EpochHooks() EpochHooks {
+	return EpochHooks{k: k}
+}
+
+// BeforeEpochStart counts down the epochs of the scheduled commission changes counted in
+// epochIdentifier epochs, and applies the changes whose delay is over.
+func (h EpochHooks) BeforeEpochStart(ctx context.Context, epochIdentifier string, _ int64) error {
+	return h.k.advanceScheduledCommissionChanges(ctx, epochIdentifier)
+}
+
+// AfterEpochEnd is a noop
+func (h EpochHooks) AfterEpochEnd(_ context.Context, _ string, _ int64) error {
+	return nil
+}
+
+// ScheduleCommissionChange validates a new commission rate of a validator against its current
+// commission, and schedules its change after the commission change delay. It replaces any
+// commission change previously scheduled for the validator.
+func (k Keeper) ScheduleCommissionChange(
+	ctx context.Context, validator types.Validator, newRate math.LegacyDec,
+) (types.ScheduledCommissionChange, error) {
+	if _, err := k.UpdateValidatorCommission(ctx, validator, newRate); err != nil {
+		return types.ScheduledCommissionChange{}, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.ScheduledCommissionChange{}, err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return types.ScheduledCommissionChange{}, err
+	}
+
+	change := types.ScheduledCommissionChange{
+		ValidatorAddress: validator.GetOperator(),
+		CommissionRate:   newRate,
+		EpochIdentifier:  params.CommissionChangeEpochIdentifier,
+		RemainingEpochs:  params.CommissionChangeDelayEpochs,
+	}
+
+	return change, k.ScheduledCommissionChanges.Set(ctx, valAddr, change)
+}
+
+// advanceScheduledCommissionChanges decrements the remaining epochs of the scheduled commission
+// changes counted in epochIdentifier epochs, and applies the ones reaching zero.
+func (k Keeper) advanceScheduledCommissionChanges(ctx context.Context, epochIdentifier string) error {
+	var changes []types.ScheduledCommissionChange
+	err := k.ScheduledCommissionChanges.Walk(ctx, nil, func(_ []byte, change types.ScheduledCommissionChange) (bool, error) {
+		if change.EpochIdentifier == epochIdentifier {
+			changes = append(changes, change)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, change := range changes {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(change.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		if change.RemainingEpochs > 1 {
+			change.RemainingEpochs--
+			if err := k.ScheduledCommissionChanges.Set(ctx, valAddr, change); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := k.ScheduledCommissionChanges.Remove(ctx, valAddr); err != nil {
+			return err
+		}
+
+		if err := k.applyCommissionChange(ctx, valAddr, change.CommissionRate); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyCommissionChange sets the commission rate of a validator, raised to the minimum
+// commission rate if it was lowered since the change was scheduled.
+func (k Keeper) applyCommissionChange(ctx context.Context, valAddr []byte, rate math.LegacyDec) error {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if errors.Is(err, types.ErrNoValidatorFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	minRate, err := k.MinCommissionRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := k.Hooks().BeforeValidatorModified(ctx, valAddr); err != nil {
+		return err
+	}
+
+	validator.Commission.Rate = math.LegacyMaxDec(rate, minRate)
+	validator.Commission.UpdateTime = k.HeaderService.HeaderInfo(ctx).Time
+	if err := k.SetValidator(ctx, validator); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCommissionChange,
+		event.NewAttribute(types.AttributeKeyValidator, validator.GetOperator()),
+		event.NewAttribute(types.AttributeKeyCommissionRate, validator.Commission.Rate.String()),
+	)
+}
+
+// enforceMinCommissionRate raises the commission rate of the validators below the minimum
+// commission rate to it, along with their max rate.
+func (k Keeper) enforceMinCommissionRate(ctx context.Context, minRate math.LegacyDec) error {
+	vals, err := k.GetAllValidators(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, val := range vals {
+		// set the commission rate to min rate
+		if val.Commission.CommissionRates.Rate.LT(minRate) {
+			val.Commission.CommissionRates.Rate = minRate
+			// set the max rate to minRate if it is less than min rate
+			if val.Commission.CommissionRates.MaxRate.LT(minRate) {
+				val.Commission.CommissionRates.MaxRate = minRate
+			}
+
+			val.Commission.UpdateTime = k.HeaderService.HeaderInfo(ctx).Time
+			if err := k.SetValidator(ctx, val); err != nil {
+				return fmt.Errorf("failed to set validator after MinCommissionRate param change: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// enforceMinSelfDelegation raises the minimum self delegation of the validators below the
+// chain-wide minimum self delegation to it.
+func (k Keeper) enforceMinSelfDelegation(ctx context.Context, minSelfDelegation math.Int) error {
+	vals, err := k.GetAllValidators(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, val := range vals {
+		if val.MinSelfDelegation.GTE(minSelfDelegation) {
+			continue
+		}
+
+		val.MinSelfDelegation = minSelfDelegation
+		if err := k.SetValidator(ctx, val); err != nil {
+			return fmt.Errorf("failed to set validator after MinSelfDelegation param change: %w", err)
+		}
+	}
+
+	return nil
+}
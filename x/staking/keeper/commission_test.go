@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestScheduledCommissionChanges() {
+	ctx, keeper, msgServer, queryClient := s.ctx, s.stakingKeeper, s.msgServer, s.queryClient
+	require := s.Require()
+	s.execExpectCalls()
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.MinSelfDelegation = math.NewInt(2)
+	params.CommissionChangeDelayEpochs = 2
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	// the min self delegation param is enforced on validator creation
+	comm := stakingtypes.NewCommissionRates(math.LegacyNewDecWithPrec(1, 1), math.LegacyOneDec(), math.LegacyNewDecWithPrec(5, 1))
+	msg, err := stakingtypes.NewMsgCreateValidator(s.valAddressToString(ValAddr), ed25519.GenPrivKey().PubKey(), sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(10)), stakingtypes.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.ErrorIs(err, stakingtypes.ErrMinSelfDelegationLTMinParam)
+
+	msg.MinSelfDelegation = math.NewInt(2)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	// the commission change is scheduled rather than applied
+	ctx = ctx.WithHeaderInfo(header.Info{Time: ctx.HeaderInfo().Time.AddDate(0, 0, 1)})
+	newRate := math.LegacyNewDecWithPrec(2, 1)
+	_, err = msgServer.EditValidator(ctx, &stakingtypes.MsgEditValidator{
+		Description:      stakingtypes.Description{Moniker: "NewVal"},
+		ValidatorAddress: s.valAddressToString(ValAddr),
+		CommissionRate:   &newRate,
+	})
+	require.NoError(err)
+
+	commissionRate := func() math.LegacyDec {
+		validator, err := keeper.GetValidator(ctx, ValAddr)
+		require.NoError(err)
+		return validator.Commission.Rate
+	}
+	require.Equal(comm.Rate, commissionRate())
+
+	res, err := queryClient.ValidatorScheduledCommission(ctx, &stakingtypes.QueryValidatorScheduledCommissionRequest{ValidatorAddr: s.valAddressToString(ValAddr)})
+	require.NoError(err)
+	require.Equal(newRate, res.ScheduledCommission.CommissionRate)
+	require.Equal(stakingtypes.DefaultCommissionChangeEpochIdentifier, res.ScheduledCommission.EpochIdentifier)
+	require.Equal(uint64(2), res.ScheduledCommission.RemainingEpochs)
+
+	// the commission change is applied once its epochs have elapsed
+	hooks := keeper.EpochHooks()
+	require.NoError(hooks.BeforeEpochStart(ctx, "week", 1))
+	require.NoError(hooks.BeforeEpochStart(ctx, params.CommissionChangeEpochIdentifier, 1))
+	require.Equal(comm.Rate, commissionRate())
+
+	res, err = queryClient.ValidatorScheduledCommission(ctx, &stakingtypes.QueryValidatorScheduledCommissionRequest{ValidatorAddr: s.valAddressToString(ValAddr)})
+	require.NoError(err)
+	require.Equal(uint64(1), res.ScheduledCommission.RemainingEpochs)
+
+	require.NoError(hooks.BeforeEpochStart(ctx, params.CommissionChangeEpochIdentifier, 2))
+	require.Equal(newRate, commissionRate())
+
+	_, err = queryClient.ValidatorScheduledCommission(ctx, &stakingtypes.QueryValidatorScheduledCommissionRequest{ValidatorAddr: s.valAddressToString(ValAddr)})
+	require.ErrorContains(err, "no commission change scheduled")
+
+	// raising the min self delegation param raises the min self delegation of the validators
+	params.MinSelfDelegation = math.NewInt(5)
+	_, err = msgServer.UpdateParams(ctx, &stakingtypes.MsgUpdateParams{Authority: keeper.GetAuthority(), Params: params})
+	require.NoError(err)
+
+	validator, err := keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.NewInt(5), validator.MinSelfDelegation)
+}
@@ -78,6 +78,31 @@ func (k Keeper) GetDelegatorDelegations(ctx context.Context, delegator sdk.AccAd
 	return delegations[:i], nil // trim if the array length < maxRetrieve
 }
 
+// GetDelegatorDelegationsFrom returns up to maxRetrieve delegations of a delegator,
+// in validator address order, starting from the delegation to startVal, or from the
+// first delegation if startVal is empty.
+func (k Keeper) GetDelegatorDelegationsFrom(ctx context.Context, delegator sdk.AccAddress, startVal sdk.ValAddress, maxRetrieve uint64) ([]types.Delegation, error) {
+	if maxRetrieve == 0 {
+		return nil, nil
+	}
+
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](delegator)
+	if len(startVal) > 0 {
+		rng = rng.StartInclusive(startVal)
+	}
+
+	var delegations []types.Delegation
+	err := k.Delegations.Walk(ctx, rng, func(_ collections.Pair[sdk.AccAddress, sdk.ValAddress], del types.Delegation) (stop bool, err error) {
+		delegations = append(delegations, del)
+		return uint64(len(delegations)) == maxRetrieve, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return delegations, nil
+}
+
 // SetDelegation sets a delegation.
 func (k Keeper) SetDelegation(ctx context.Context, delegation types.Delegation) error {
 	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(delegation.DelegatorAddress)
@@ -120,6 +120,15 @@ func (s *KeeperTestSuite) TestDelegation() {
 	resBonds, err = keeper.GetDelegatorDelegations(ctx, addrDels[0], 2)
 	require.NoError(err)
 	require.Equal(2, len(resBonds))
+	resBonds, err = keeper.GetDelegatorDelegationsFrom(ctx, addrDels[0], nil, 2)
+	require.NoError(err)
+	require.Equal([]stakingtypes.Delegation{bond1to1, bond1to2}, resBonds)
+	resBonds, err = keeper.GetDelegatorDelegationsFrom(ctx, addrDels[0], valAddrs[1], 5)
+	require.NoError(err)
+	require.Equal([]stakingtypes.Delegation{bond1to2, bond1to3}, resBonds)
+	resBonds, err = keeper.GetDelegatorDelegationsFrom(ctx, addrDels[0], valAddrs[1], 1)
+	require.NoError(err)
+	require.Equal([]stakingtypes.Delegation{bond1to2}, resBonds)
 	resBonds, err = keeper.GetDelegatorDelegations(ctx, addrDels[1], 5)
 	require.NoError(err)
 	require.Equal(3, len(resBonds))
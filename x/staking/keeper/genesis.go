@@ -195,6 +195,17 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) ([]ap
 		}
 	}
 
+	for _, change := range data.ScheduledCommissionChanges {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(change.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.ScheduledCommissionChanges.Set(ctx, valAddr, change); err != nil {
+			return nil, err
+		}
+	}
+
 	// don't need to run CometBFT updates if we exported
 	var moduleValidatorUpdates []appmodule.ValidatorUpdate
 	if data.Exported {
@@ -331,6 +342,15 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	scheduledCommissionChanges := []types.ScheduledCommissionChange{}
+	err = k.ScheduledCommissionChanges.Walk(ctx, nil, func(_ []byte, change types.ScheduledCommissionChange) (stop bool, err error) {
+		scheduledCommissionChanges = append(scheduledCommissionChanges, change)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
 		Params:               params,
 		LastTotalPower:       totalPower,
@@ -343,5 +363,7 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		RotationIndexRecords: rotationIndex,
 		RotationHistory:      conspubKeyRotationHistory,
 		RotationQueue:        rotationQueue,
+
+		ScheduledCommissionChanges: scheduledCommissionChanges,
 	}, nil
 }
@@ -111,6 +111,29 @@ func (k Querier) Validator(ctx context.Context, req *types.QueryValidatorRequest
 	return &types.QueryValidatorResponse{Validator: validator}, nil
 }
 
+// ValidatorScheduledCommission queries the commission change scheduled for a validator
+func (k Querier) ValidatorScheduledCommission(ctx context.Context, req *types.QueryValidatorScheduledCommissionRequest) (*types.QueryValidatorScheduledCommissionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	change, err := k.ScheduledCommissionChanges.Get(ctx, valAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "no commission change scheduled for validator %s", req.ValidatorAddr)
+	}
+
+	return &types.QueryValidatorScheduledCommissionResponse{ScheduledCommission: change}, nil
+}
+
 // ValidatorDelegations queries delegate info for given validator
 func (k Querier) ValidatorDelegations(ctx context.Context, req *types.QueryValidatorDelegationsRequest) (*types.QueryValidatorDelegationsResponse, error) {
 	if req == nil {
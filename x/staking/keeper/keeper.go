@@ -112,6 +112,8 @@ type Keeper struct {
 	// ValidatorConsPubKeyRotationHistory: consPubkey rotation history by validator
 	// A index is being added with key `BlockConsPubKeyRotationHistory`: consPubkey rotation history by height
 	RotationHistory *collections.IndexedMap[collections.Pair[[]byte, uint64], types.ConsPubKeyRotationHistory, rotationHistoryIndexes]
+	// ScheduledCommissionChanges key: valAddr | value: ScheduledCommissionChange
+	ScheduledCommissionChanges collections.Map[[]byte, types.ScheduledCommissionChange]
 }
 
 // NewKeeper creates a new staking Keeper instance
@@ -289,6 +291,14 @@ func NewKeeper(
 			codec.CollValue[types.ConsPubKeyRotationHistory](cdc),
 			NewRotationHistoryIndexes(sb),
 		),
+
+		// key format is: 114 | valAddr
+		ScheduledCommissionChanges: collections.NewMap(
+			sb, types.ScheduledCommissionChangesKey,
+			"scheduled_commission_changes",
+			collections.BytesKey,
+			codec.CollValue[types.ScheduledCommissionChange](cdc),
+		),
 	}
 
 	schema, err := sb.Build()
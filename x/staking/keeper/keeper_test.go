@@ -141,6 +141,26 @@ func (s *KeeperTestSuite) TestParams() {
 	require.True(expParams.Equal(resParams))
 }
 
+func (s *KeeperTestSuite) TestMigrate6to7() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	// the params added in consensus version 7 are decoded as empty from the version 6 state
+	params := stakingtypes.DefaultParams()
+	params.MinSelfDelegation = math.Int{}
+	params.CommissionChangeEpochIdentifier = ""
+	params.GlobalLiquidStakingCap = math.LegacyDec{}
+	params.ValidatorLiquidStakingCap = math.LegacyDec{}
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	require.NoError(stakingkeeper.NewMigrator(keeper).Migrate6to7(ctx))
+
+	resParams, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	require.Equal(stakingtypes.DefaultParams(), resParams)
+	require.NoError(resParams.Validate())
+}
+
 func (s *KeeperTestSuite) TestLastTotalPower() {
 	ctx, keeper := s.ctx, s.stakingKeeper
 	require := s.Require()
@@ -284,7 +304,7 @@ func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
 
 			s.ctx.KVStore(s.key).Set(getLastValidatorPowerKey(valAddrs[i]), bz)
 		},
-		"d9690cb1904ab91c618a3f6d27ef90bfe6fb57a2c01970b7c088ec4ecd0613eb",
+		"23f87aae84fae6a06362b496c480efa64424e0daa2c3df3c8a3459441ec27700",
 	)
 	s.Require().NoError(err)
 
@@ -299,7 +319,7 @@ func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
 			err = s.stakingKeeper.LastValidatorPower.Set(s.ctx, valAddrs[i], intV)
 			s.Require().NoError(err)
 		},
-		"d9690cb1904ab91c618a3f6d27ef90bfe6fb57a2c01970b7c088ec4ecd0613eb",
+		"23f87aae84fae6a06362b496c480efa64424e0daa2c3df3c8a3459441ec27700",
 	)
 	s.Require().NoError(err)
 }
@@ -317,7 +337,7 @@ func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
 			// legacy method to set in the state
 			s.ctx.KVStore(s.key).Set(getREDByValSrcIndexKey(addrs[i], valAddrs[i], valAddrs[i+1]), []byte{})
 		},
-		"43ab9766738a05bfe5f1fd5dd0fb01c05b574f7d43c004dbf228deb437e0eb7c",
+		"976b61ba5ecc5c4b9d5d19d9bd56fe53985fd22640b32d3c5bccc774b062f88d",
 	)
 	s.Require().NoError(err)
 
@@ -330,7 +350,7 @@ func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
 			err := s.stakingKeeper.RedelegationsByValSrc.Set(s.ctx, collections.Join3(valAddrs[i].Bytes(), addrs[i].Bytes(), valAddrs[i+1].Bytes()), []byte{})
 			s.Require().NoError(err)
 		},
-		"43ab9766738a05bfe5f1fd5dd0fb01c05b574f7d43c004dbf228deb437e0eb7c",
+		"976b61ba5ecc5c4b9d5d19d9bd56fe53985fd22640b32d3c5bccc774b062f88d",
 	)
 
 	s.Require().NoError(err)
@@ -349,7 +369,7 @@ func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
 			// legacy method to set in the state
 			s.ctx.KVStore(s.key).Set(getREDByValDstIndexKey(addrs[i], valAddrs[i], valAddrs[i+1]), []byte{})
 		},
-		"70c00b5171cbef019742d236096df60fc423cd7568c4933ab165baa3c68a64a1", // this hash obtained when ran this test in main branch
+		"62d7e2e5aef4806122badfc693ad55589f9a7b7c0922631c2d1da4e884e6ef13", // this hash obtained when ran this test in main branch
 	)
 	s.Require().NoError(err)
 
@@ -362,7 +382,7 @@ func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
 			err := s.stakingKeeper.RedelegationsByValDst.Set(s.ctx, collections.Join3(valAddrs[i+1].Bytes(), addrs[i].Bytes(), valAddrs[i].Bytes()), []byte{})
 			s.Require().NoError(err)
 		},
-		"70c00b5171cbef019742d236096df60fc423cd7568c4933ab165baa3c68a64a1",
+		"62d7e2e5aef4806122badfc693ad55589f9a7b7c0922631c2d1da4e884e6ef13",
 	)
 
 	s.Require().NoError(err)
@@ -393,7 +413,7 @@ func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
 			s.ctx.KVStore(s.key).Set(getUBDKey(delAddrs[i], valAddrs[i]), bz)
 			s.ctx.KVStore(s.key).Set(getUBDByValIndexKey(delAddrs[i], valAddrs[i]), []byte{})
 		},
-		"bae8a1f2070bea541bfeca8e7e4a1203cb316126451325b846b303897e8e7082",
+		"ddce938e6f048c96e15d19b4d34bd6d8c792e5e3ad7680fb1436586873dc3cc4",
 	)
 	s.Require().NoError(err)
 
@@ -417,7 +437,7 @@ func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
 			err := s.stakingKeeper.SetUnbondingDelegation(s.ctx, ubd)
 			s.Require().NoError(err)
 		},
-		"bae8a1f2070bea541bfeca8e7e4a1203cb316126451325b846b303897e8e7082",
+		"ddce938e6f048c96e15d19b4d34bd6d8c792e5e3ad7680fb1436586873dc3cc4",
 	)
 	s.Require().NoError(err)
 }
@@ -434,7 +454,7 @@ func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getUnbondingDelegationTimeKey(date), []byte{})
 		},
-		"3f2de3f984c99cce5307db45961237220212c02981654b01b7b52f7a68b5b21b",
+		"801f16bb3ad630a58f643ba0628461b72f1cccdaf8a301c251c0772a8960604d",
 	)
 	s.Require().NoError(err)
 
@@ -447,7 +467,7 @@ func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
 			err := s.stakingKeeper.SetUBDQueueTimeSlice(s.ctx, date, nil)
 			s.Require().NoError(err)
 		},
-		"3f2de3f984c99cce5307db45961237220212c02981654b01b7b52f7a68b5b21b",
+		"801f16bb3ad630a58f643ba0628461b72f1cccdaf8a301c251c0772a8960604d",
 	)
 	s.Require().NoError(err)
 }
@@ -481,7 +501,7 @@ func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getValidatorKey(valAddrs[i]), valBz)
 		},
-		"d8acdcf8b7c8e17f3e83f0a4c293f89ad619a5dcb14d232911ccc5da15653177",
+		"79fbb37ef525f4eaa16da059fcf1749ca6595728de0d36036b55aacab2516a85",
 	)
 	s.Require().NoError(err)
 
@@ -507,7 +527,7 @@ func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
 			err := s.stakingKeeper.SetValidator(s.ctx, val)
 			s.Require().NoError(err)
 		},
-		"d8acdcf8b7c8e17f3e83f0a4c293f89ad619a5dcb14d232911ccc5da15653177",
+		"79fbb37ef525f4eaa16da059fcf1749ca6595728de0d36036b55aacab2516a85",
 	)
 	s.Require().NoError(err)
 }
@@ -530,7 +550,7 @@ func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getValidatorQueueKey(endTime, endHeight), bz)
 		},
-		"a631942cd94450d778706c98afc4f83231524e3e94c88474cdab79a01a4899a0",
+		"162be230a0b20cf7a1cad1bc447f8da4369272123398ed62b6d27b7645196719",
 	)
 	s.Require().NoError(err)
 
@@ -545,7 +565,7 @@ func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
 			err := s.stakingKeeper.SetUnbondingValidatorsQueue(s.ctx, endTime, endHeight, addrs)
 			s.Require().NoError(err)
 		},
-		"a631942cd94450d778706c98afc4f83231524e3e94c88474cdab79a01a4899a0",
+		"162be230a0b20cf7a1cad1bc447f8da4369272123398ed62b6d27b7645196719",
 	)
 	s.Require().NoError(err)
 }
@@ -573,7 +593,7 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 			s.Require().NoError(err)
 			s.ctx.KVStore(s.key).Set(getRedelegationTimeKey(date), bz)
 		},
-		"58722ccde0cacda42aa81d71d7da1123b2c4a8e35d961d55f1507c3f10ffbc96",
+		"5f2f40ede0935770c34f6ef745f290baa54d445cd9ab0c2237d2e15c8d0ee808",
 	)
 	s.Require().NoError(err)
 
@@ -595,7 +615,7 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 			err := s.stakingKeeper.SetRedelegationQueueTimeSlice(s.ctx, date, dvvTriplets.Triplets)
 			s.Require().NoError(err)
 		},
-		"58722ccde0cacda42aa81d71d7da1123b2c4a8e35d961d55f1507c3f10ffbc96",
+		"5f2f40ede0935770c34f6ef745f290baa54d445cd9ab0c2237d2e15c8d0ee808",
 	)
 	s.Require().NoError(err)
 }
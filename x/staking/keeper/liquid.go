@@ -0,0 +1,362 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// vestingAccount defines the subset of the vesting accounts used to detect delegated vesting coins.
+type vestingAccount interface {
+	GetDelegatedVesting() sdk.Coins
+}
+
+// GetLiquidStakingPool returns the address of the liquid staking pool, which holds the
+// tokenized delegations.
+func (k Keeper) GetLiquidStakingPool() (sdk.AccAddress, error) {
+	pool := k.authKeeper.GetModuleAddress(types.LiquidStakingPoolName)
+	if pool == nil {
+		return nil, fmt.Errorf("%s module account has not been set", types.LiquidStakingPoolName)
+	}
+
+	return pool, nil
+}
+
+// GetLiquidStakedTokens returns the total amount of tokens delegated by the liquid staking pool.
+func (k Keeper) GetLiquidStakedTokens(ctx context.Context) (math.Int, error) {
+	pool, err := k.GetLiquidStakingPool()
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	return k.GetDelegatorBonded(ctx, pool)
+}
+
+// TokenizeShares moves shares of a delegation to the liquid staking pool, and mints to the
+// delegator the share tokens of the validator representing them. The share tokens are a
+// pro-rata claim on the delegation of the pool to the validator, whose rewards are compounded.
+func (k Keeper) TokenizeShares(
+	ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec,
+) (sdk.Coin, error) {
+	pool, err := k.GetLiquidStakingPool()
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, err := k.compoundLiquidStakingRewards(ctx, pool, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	poolShares, err := k.getDelegationShares(ctx, pool, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the first share tokens are minted 1:1 with the shares
+	denom := types.LiquidSharesDenom(validator.GetOperator())
+	supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+	amount := shares.TruncateInt()
+	if supply.IsPositive() && poolShares.IsPositive() {
+		amount = shares.MulInt(supply).Quo(poolShares).TruncateInt()
+	}
+	if !amount.IsPositive() {
+		return sdk.Coin{}, types.ErrTinyLiquidSharesAmount
+	}
+
+	if err := k.transferDelegation(ctx, delAddr, pool, validator, shares); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.checkLiquidStakingCaps(ctx, pool, validator); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
+	if err := k.bankKeeper.MintCoins(ctx, types.LiquidStakingPoolName, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.LiquidStakingPoolName, delAddr, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return coins[0], nil
+}
+
+// RedeemTokensForShares burns share tokens of a validator, and moves the shares of the liquid
+// staking pool they represent back to a delegation of the delegator. It returns the amount of
+// tokens delegated back.
+func (k Keeper) RedeemTokensForShares(
+	ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int,
+) (math.Int, error) {
+	pool, err := k.GetLiquidStakingPool()
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	validator, err := k.compoundLiquidStakingRewards(ctx, pool, valAddr)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	poolShares, err := k.getDelegationShares(ctx, pool, valAddr)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	denom := types.LiquidSharesDenom(validator.GetOperator())
+	supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+
+	coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.LiquidStakingPoolName, coins); err != nil {
+		return math.ZeroInt(), err
+	}
+
+	// the last share tokens redeem all the remaining shares
+	shares := poolShares
+	if amount.LT(supply) {
+		shares = poolShares.MulInt(amount).QuoInt(supply)
+	}
+	if !shares.IsPositive() {
+		return math.ZeroInt(), types.ErrTinyLiquidSharesAmount
+	}
+
+	if err := k.transferDelegation(ctx, pool, delAddr, validator, shares); err != nil {
+		return math.ZeroInt(), err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, pool, coins); err != nil {
+		return math.ZeroInt(), err
+	}
+
+	return validator.TokensFromSharesTruncated(shares).TruncateInt(), nil
+}
+
+// TransferDelegation transfers shares of a delegation to a recipient, without unbonding them.
+func (k Keeper) TransferDelegation(
+	ctx context.Context, delAddr, recipientAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec,
+) error {
+	if delAddr.Equals(recipientAddr) {
+		return errorsmod.Wrap(types.ErrDelegationTransferNotAllowed, "cannot transfer a delegation to its delegator")
+	}
+
+	pool, err := k.GetLiquidStakingPool()
+	if err != nil {
+		return err
+	}
+	if recipientAddr.Equals(pool) {
+		return errorsmod.Wrap(types.ErrDelegationTransferNotAllowed, "delegations must be tokenized to be transferred to the liquid staking pool")
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.transferDelegation(ctx, delAddr, recipientAddr, validator, shares)
+}
+
+// transferDelegation moves shares of the delegation of fromAddr to the delegation of toAddr.
+// The tokens and shares of the validator are left unchanged.
+func (k Keeper) transferDelegation(
+	ctx context.Context, fromAddr, toAddr sdk.AccAddress, validator types.Validator, shares math.LegacyDec,
+) error {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	delegation, err := k.Delegations.Get(ctx, collections.Join(fromAddr, sdk.ValAddress(valAddr)))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ErrNoDelegatorForAddress
+	} else if err != nil {
+		return err
+	}
+
+	if delegation.Shares.LT(shares) {
+		return errorsmod.Wrap(types.ErrNotEnoughDelegationShares, delegation.Shares.String())
+	}
+
+	// a delegation being redelegated to the validator must remain slashable for the
+	// infractions of the source validator
+	hasRedelegation, err := k.HasReceivingRedelegation(ctx, fromAddr, valAddr)
+	if err != nil {
+		return err
+	}
+	if hasRedelegation {
+		return errorsmod.Wrap(types.ErrDelegationTransferNotAllowed, "delegation has a redelegation in progress to the validator")
+	}
+
+	// the delegated vesting coins are tracked by the account of the delegator
+	if acc, ok := k.authKeeper.GetAccount(ctx, fromAddr).(vestingAccount); ok && !acc.GetDelegatedVesting().IsZero() {
+		return errorsmod.Wrap(types.ErrDelegationTransferNotAllowed, "delegator has delegated vesting coins")
+	}
+
+	remaining := delegation.Shares.Sub(shares)
+	if bytes.Equal(fromAddr, valAddr) && !validator.Jailed &&
+		validator.TokensFromShares(remaining).TruncateInt().LT(validator.MinSelfDelegation) {
+		return types.ErrSelfDelegationBelowMinimum
+	}
+
+	if err := k.Hooks().BeforeDelegationSharesModified(ctx, fromAddr, valAddr); err != nil {
+		return err
+	}
+
+	delegation.Shares = remaining
+	if delegation.Shares.IsZero() {
+		if err := k.RemoveDelegation(ctx, delegation); err != nil {
+			return err
+		}
+	} else {
+		if err := k.SetDelegation(ctx, delegation); err != nil {
+			return err
+		}
+
+		if err := k.Hooks().AfterDelegationModified(ctx, fromAddr, valAddr); err != nil {
+			return err
+		}
+	}
+
+	recipient, err := k.Delegations.Get(ctx, collections.Join(toAddr, sdk.ValAddress(valAddr)))
+	if err == nil {
+		err = k.Hooks().BeforeDelegationSharesModified(ctx, toAddr, valAddr)
+	} else if errors.Is(err, collections.ErrNotFound) {
+		toAddrStr, err1 := k.authKeeper.AddressCodec().BytesToString(toAddr)
+		if err1 != nil {
+			return err1
+		}
+
+		recipient = types.NewDelegation(toAddrStr, validator.GetOperator(), math.LegacyZeroDec())
+		err = k.Hooks().BeforeDelegationCreated(ctx, toAddr, valAddr)
+	}
+	if err != nil {
+		return err
+	}
+
+	recipient.Shares = recipient.Shares.Add(shares)
+	if err := k.SetDelegation(ctx, recipient); err != nil {
+		return err
+	}
+
+	return k.Hooks().AfterDelegationModified(ctx, toAddr, valAddr)
+}
+
+// compoundLiquidStakingRewards withdraws the rewards of the delegation of the liquid staking pool
+// to a validator, through the delegation hooks, delegates back its bond denom rewards and forwards
+// the rewards in other denoms to the community pool. It returns the updated validator.
+func (k Keeper) compoundLiquidStakingRewards(ctx context.Context, pool sdk.AccAddress, valAddr sdk.ValAddress) (types.Validator, error) {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return validator, err
+	}
+
+	has, err := k.Delegations.Has(ctx, collections.Join(pool, valAddr))
+	if err != nil || !has || validator.InvalidExRate() {
+		return validator, err
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return validator, err
+	}
+
+	balances := k.bankKeeper.GetAllBalances(ctx, pool)
+	if err := k.Hooks().BeforeDelegationSharesModified(ctx, pool, valAddr); err != nil {
+		return validator, err
+	}
+	if err := k.Hooks().AfterDelegationModified(ctx, pool, valAddr); err != nil {
+		return validator, err
+	}
+
+	rewards, hasNeg := k.bankKeeper.GetAllBalances(ctx, pool).SafeSub(balances...)
+	if hasNeg || rewards.IsZero() {
+		return validator, nil
+	}
+
+	// the share tokens are only backed by bond denom tokens, the other rewards would be left unclaimed
+	var otherRewards sdk.Coins
+	for _, reward := range rewards {
+		if reward.Denom != bondDenom {
+			otherRewards = append(otherRewards, reward)
+		}
+	}
+	if !otherRewards.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.LiquidStakingPoolName, types.PoolModuleName, otherRewards); err != nil {
+			return validator, err
+		}
+	}
+
+	bondRewards := rewards.AmountOf(bondDenom)
+	if !bondRewards.IsPositive() {
+		return validator, nil
+	}
+
+	if _, err := k.Delegate(ctx, pool, bondRewards, types.Unbonded, validator, true); err != nil {
+		return validator, err
+	}
+
+	return k.GetValidator(ctx, valAddr)
+}
+
+// checkLiquidStakingCaps checks that the liquid staked tokens don't exceed the global liquid
+// staking cap, and that the liquid staked shares of the validator don't exceed the validator
+// liquid staking cap.
+func (k Keeper) checkLiquidStakingCaps(ctx context.Context, pool sdk.AccAddress, validator types.Validator) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	poolShares, err := k.getDelegationShares(ctx, pool, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if poolShares.GT(params.ValidatorLiquidStakingCap.Mul(validator.DelegatorShares)) {
+		return errorsmod.Wrapf(types.ErrValidatorLiquidStakingCapExceeded,
+			"liquid staked shares %s exceed %s of the validator shares %s", poolShares, params.ValidatorLiquidStakingCap, validator.DelegatorShares)
+	}
+
+	liquidStaked, err := k.GetDelegatorBonded(ctx, pool)
+	if err != nil {
+		return err
+	}
+
+	totalBonded, err := k.TotalBondedTokens(ctx)
+	if err != nil {
+		return err
+	}
+
+	if math.LegacyNewDecFromInt(liquidStaked).GT(params.GlobalLiquidStakingCap.MulInt(totalBonded)) {
+		return errorsmod.Wrapf(types.ErrGlobalLiquidStakingCapExceeded,
+			"liquid staked tokens %s exceed %s of the bonded tokens %s", liquidStaked, params.GlobalLiquidStakingCap, totalBonded)
+	}
+
+	return nil
+}
+
+// getDelegationShares returns the shares of a delegation, or zero if it doesn't exist.
+func (k Keeper) getDelegationShares(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (math.LegacyDec, error) {
+	delegation, err := k.Delegations.Get(ctx, collections.Join(delAddr, valAddr))
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.LegacyZeroDec(), nil
+	} else if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return delegation.Shares, nil
+}
@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	"cosmossdk.io/x/staking/testutil"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+var liquidAcc = authtypes.NewEmptyModuleAccount(stakingtypes.LiquidStakingPoolName, authtypes.Minter, authtypes.Burner)
+
+func (s *KeeperTestSuite) TestLiquidStaking() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(2)
+	pool := liquidAcc.GetAddress()
+	startTokens := keeper.TokensFromConsensusPower(ctx, 10)
+	tokens := func(power int64) math.Int { return keeper.TokensFromConsensusPower(ctx, power) }
+	bondCoin := func(power int64) sdk.Coin { return sdk.NewCoin(sdk.DefaultBondDenom, tokens(power)) }
+
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	_ = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+	require.NoError(keeper.SetDelegation(ctx, stakingtypes.NewDelegation(s.addressToString(delAddrs[0]), s.valAddressToString(valAddrs[0]), issuedShares)))
+
+	vestingAcc, err := vestingtypes.NewBaseVestingAccount(authtypes.NewBaseAccountWithAddress(delAddrs[1]), sdk.NewCoins(bondCoin(1)), 0)
+	require.NoError(err)
+	vestingAcc.DelegatedVesting = sdk.NewCoins(bondCoin(1))
+
+	s.accountKeeper.EXPECT().GetModuleAddress(stakingtypes.LiquidStakingPoolName).Return(pool).AnyTimes()
+	s.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), stakingtypes.BondedPoolName).Return(bondedAcc).AnyTimes()
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), delAddrs[0]).Return(authtypes.NewBaseAccountWithAddress(delAddrs[0])).AnyTimes()
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), delAddrs[1]).Return(vestingAcc).AnyTimes()
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), pool).Return(liquidAcc).AnyTimes()
+	// the rewards withdrawn by the delegation hooks are added to the pool balance after it is read
+	var poolBalance, poolRewards sdk.Coins
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), pool).DoAndReturn(func(context.Context, sdk.AccAddress) sdk.Coins {
+		balance := poolBalance
+		poolBalance, poolRewards = poolBalance.Add(poolRewards...), nil
+		return balance
+	}).AnyTimes()
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), bondedAcc.GetAddress(), sdk.DefaultBondDenom).Return(bondCoin(10)).AnyTimes()
+
+	shares := func(addr sdk.AccAddress) math.Int {
+		delegation, err := keeper.Delegations.Get(ctx, collections.Join(addr, valAddrs[0]))
+		if err != nil {
+			return math.ZeroInt()
+		}
+		return delegation.Shares.TruncateInt()
+	}
+
+	// the first share tokens are minted 1:1 with the tokenized shares
+	denom := stakingtypes.LiquidSharesDenom(s.valAddressToString(valAddrs[0]))
+	shareTokens := sdk.NewCoins(sdk.NewCoin(denom, tokens(4)))
+	s.bankKeeper.EXPECT().GetSupply(gomock.Any(), denom).Return(sdk.NewCoin(denom, math.ZeroInt()))
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), stakingtypes.LiquidStakingPoolName, shareTokens).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), stakingtypes.LiquidStakingPoolName, delAddrs[0], shareTokens).Return(nil)
+	tokenizeRes, err := msgServer.TokenizeShares(ctx, stakingtypes.NewMsgTokenizeShares(s.addressToString(delAddrs[0]), s.valAddressToString(valAddrs[0]), bondCoin(4)))
+	require.NoError(err)
+	require.Equal(shareTokens[0], tokenizeRes.Amount)
+	require.Equal(tokens(6), shares(delAddrs[0]))
+	require.Equal(tokens(4), shares(pool))
+
+	validator, err = keeper.GetValidator(ctx, valAddrs[0])
+	require.NoError(err)
+	require.Equal(startTokens, validator.Tokens)
+
+	// the liquid staking caps bound the tokenized shares
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	for _, tc := range []struct {
+		globalCap, validatorCap math.LegacyDec
+		expErr                  error
+	}{
+		{math.LegacyOneDec(), math.LegacyNewDecWithPrec(5, 1), stakingtypes.ErrValidatorLiquidStakingCapExceeded},
+		{math.LegacyNewDecWithPrec(5, 1), math.LegacyOneDec(), stakingtypes.ErrGlobalLiquidStakingCapExceeded},
+	} {
+		cacheCtx, _ := ctx.CacheContext()
+		params.GlobalLiquidStakingCap, params.ValidatorLiquidStakingCap = tc.globalCap, tc.validatorCap
+		require.NoError(keeper.Params.Set(cacheCtx, params))
+		s.bankKeeper.EXPECT().GetSupply(gomock.Any(), denom).Return(sdk.NewCoin(denom, tokens(4)))
+		_, err = keeper.TokenizeShares(cacheCtx, delAddrs[0], valAddrs[0], math.LegacyNewDecFromInt(tokens(2)))
+		require.ErrorIs(err, tc.expErr)
+	}
+
+	// the share tokens are redeemed pro-rata, and the rewards in other denoms are sent to the community pool
+	poolRewards = sdk.NewCoins(sdk.NewInt64Coin("uatom", 5))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.LiquidStakingPoolName, stakingtypes.PoolModuleName, poolRewards).Return(nil)
+	redeemed := sdk.NewCoins(sdk.NewCoin(denom, tokens(2)))
+	s.bankKeeper.EXPECT().GetSupply(gomock.Any(), denom).Return(sdk.NewCoin(denom, tokens(4)))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), delAddrs[0], stakingtypes.LiquidStakingPoolName, redeemed).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), []byte(pool), redeemed).Return(nil)
+	redeemRes, err := msgServer.RedeemTokensForShares(ctx, stakingtypes.NewMsgRedeemTokensForShares(s.addressToString(delAddrs[0]), redeemed[0]))
+	require.NoError(err)
+	require.Equal(bondCoin(2), redeemRes.Amount)
+	require.Equal(tokens(8), shares(delAddrs[0]))
+	require.Equal(tokens(2), shares(pool))
+
+	_, err = msgServer.RedeemTokensForShares(ctx, stakingtypes.NewMsgRedeemTokensForShares(s.addressToString(delAddrs[0]), bondCoin(2)))
+	require.ErrorIs(err, stakingtypes.ErrInvalidLiquidSharesDenom)
+
+	// a delegation is transferred without unbonding
+	_, err = msgServer.TransferDelegation(ctx, stakingtypes.NewMsgTransferDelegation(
+		s.addressToString(delAddrs[0]), s.valAddressToString(valAddrs[0]), s.addressToString(delAddrs[1]), bondCoin(3)))
+	require.NoError(err)
+	require.Equal(tokens(5), shares(delAddrs[0]))
+	require.Equal(tokens(3), shares(delAddrs[1]))
+
+	validator, err = keeper.GetValidator(ctx, valAddrs[0])
+	require.NoError(err)
+	require.Equal(startTokens, validator.Tokens)
+
+	for _, tc := range []struct {
+		from, to sdk.AccAddress
+	}{
+		{delAddrs[0], delAddrs[0]},
+		{delAddrs[0], pool},
+		{delAddrs[1], delAddrs[0]}, // delegated vesting coins
+	} {
+		err = keeper.TransferDelegation(ctx, tc.from, tc.to, valAddrs[0], math.LegacyNewDecFromInt(tokens(1)))
+		require.ErrorIs(err, stakingtypes.ErrDelegationTransferNotAllowed)
+	}
+
+	err = keeper.TransferDelegation(ctx, delAddrs[0], delAddrs[1], valAddrs[0], math.LegacyNewDecFromInt(tokens(6)))
+	require.ErrorIs(err, stakingtypes.ErrNotEnoughDelegationShares)
+}
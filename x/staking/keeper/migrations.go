@@ -5,6 +5,7 @@ import (
 
 	v5 "cosmossdk.io/x/staking/migrations/v5"
 	v6 "cosmossdk.io/x/staking/migrations/v6"
+	"cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 )
@@ -47,3 +48,40 @@ func (m Migrator) Migrate5to6(ctx context.Context) error {
 	store := runtime.KVStoreAdapter(m.keeper.KVStoreService.OpenKVStore(ctx))
 	return v6.MigrateStore(ctx, store, m.keeper.cdc)
 }
+
+// Migrate6to7 migrates x/staking state from consensus version 6 to 7.
+// It sets the MinSelfDelegation, CommissionChangeEpochIdentifier and liquid
+// staking cap params to their defaults, and raises the commission rate and the
+// min self delegation of the validators not complying with the MinCommissionRate
+// and MinSelfDelegation params. Params missing from version 6 state decode as
+// zero values, so zero values are treated as unset.
+func (m Migrator) Migrate6to7(ctx context.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	defaultParams := types.DefaultParams()
+	if params.MinSelfDelegation.IsNil() || params.MinSelfDelegation.IsZero() {
+		params.MinSelfDelegation = defaultParams.MinSelfDelegation
+	}
+	if params.CommissionChangeEpochIdentifier == "" {
+		params.CommissionChangeEpochIdentifier = defaultParams.CommissionChangeEpochIdentifier
+	}
+	if params.GlobalLiquidStakingCap.IsNil() || params.GlobalLiquidStakingCap.IsZero() {
+		params.GlobalLiquidStakingCap = defaultParams.GlobalLiquidStakingCap
+	}
+	if params.ValidatorLiquidStakingCap.IsNil() || params.ValidatorLiquidStakingCap.IsZero() {
+		params.ValidatorLiquidStakingCap = defaultParams.ValidatorLiquidStakingCap
+	}
+
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	if err := m.keeper.enforceMinCommissionRate(ctx, params.MinCommissionRate); err != nil {
+		return err
+	}
+
+	return m.keeper.enforceMinSelfDelegation(ctx, params.MinSelfDelegation)
+}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"context"
 	"errors"
-	"fmt"
 	"slices"
 	"strconv"
 	"time"
@@ -59,6 +58,15 @@ func (k msgServer) CreateValidator(ctx context.Context, msg *types.MsgCreateVali
 		return nil, errorsmod.Wrapf(types.ErrCommissionLTMinRate, "cannot set validator commission to less than minimum rate of %s", minCommRate)
 	}
 
+	minSelfDelegation, err := k.MinSelfDelegation(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.MinSelfDelegation.LT(minSelfDelegation) {
+		return nil, errorsmod.Wrapf(types.ErrMinSelfDelegationLTMinParam, "%s is less than %s", msg.MinSelfDelegation, minSelfDelegation)
+	}
+
 	// check to see if the pubkey or sender has been registered before
 	if _, err := k.GetValidator(ctx, valAddr); err == nil {
 		return nil, types.ErrValidatorOwnerExists
@@ -218,7 +226,28 @@ func (k msgServer) EditValidator(ctx context.Context, msg *types.MsgEditValidato
 
 	validator.Description = description
 
-	if msg.CommissionRate != nil {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.CommissionRate != nil && params.CommissionChangeDelayEpochs > 0 {
+		// the commission change is applied after the commission change delay
+		change, err := k.ScheduleCommissionChange(ctx, validator, *msg.CommissionRate)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeScheduleCommissionChange,
+			event.NewAttribute(types.AttributeKeyValidator, change.ValidatorAddress),
+			event.NewAttribute(types.AttributeKeyCommissionRate, change.CommissionRate.String()),
+			event.NewAttribute(types.AttributeKeyEpochIdentifier, change.EpochIdentifier),
+			event.NewAttribute(types.AttributeKeyRemainingEpochs, strconv.FormatUint(change.RemainingEpochs, 10)),
+		); err != nil {
+			return nil, err
+		}
+	} else if msg.CommissionRate != nil {
 		commission, err := k.UpdateValidatorCommission(ctx, validator, *msg.CommissionRate)
 		if err != nil {
 			return nil, err
@@ -241,6 +270,10 @@ func (k msgServer) EditValidator(ctx context.Context, msg *types.MsgEditValidato
 			return nil, types.ErrSelfDelegationBelowMinimum
 		}
 
+		if msg.MinSelfDelegation.LT(params.MinSelfDelegation) {
+			return nil, errorsmod.Wrapf(types.ErrMinSelfDelegationLTMinParam, "%s is less than %s", msg.MinSelfDelegation, params.MinSelfDelegation)
+		}
+
 		validator.MinSelfDelegation = *msg.MinSelfDelegation
 	}
 
@@ -622,27 +655,15 @@ func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams)
 
 	// when min commission rate is updated, we need to update the commission rate of all validators
 	if !previousParams.MinCommissionRate.Equal(msg.Params.MinCommissionRate) {
-		minRate := msg.Params.MinCommissionRate
-
-		vals, err := k.GetAllValidators(ctx)
-		if err != nil {
+		if err := k.enforceMinCommissionRate(ctx, msg.Params.MinCommissionRate); err != nil {
 			return nil, err
 		}
+	}
 
-		for _, val := range vals {
-			// set the commission rate to min rate
-			if val.Commission.CommissionRates.Rate.LT(minRate) {
-				val.Commission.CommissionRates.Rate = minRate
-				// set the max rate to minRate if it is less than min rate
-				if val.Commission.CommissionRates.MaxRate.LT(minRate) {
-					val.Commission.CommissionRates.MaxRate = minRate
-				}
-
-				val.Commission.UpdateTime = k.HeaderService.HeaderInfo(ctx).Time
-				if err := k.SetValidator(ctx, val); err != nil {
-					return nil, fmt.Errorf("failed to set validator after MinCommissionRate param change: %w", err)
-				}
-			}
+	// when min self delegation is raised, we need to raise the min self delegation of all validators
+	if previousParams.MinSelfDelegation.IsNil() || previousParams.MinSelfDelegation.LT(msg.Params.MinSelfDelegation) {
+		if err := k.enforceMinSelfDelegation(ctx, msg.Params.MinSelfDelegation); err != nil {
+			return nil, err
 		}
 	}
 
@@ -733,6 +754,155 @@ func (k msgServer) RotateConsPubKey(ctx context.Context, msg *types.MsgRotateCon
 	return res, nil
 }
 
+// TokenizeShares defines a method for tokenizing a delegation into liquid staking share tokens
+func (k msgServer) TokenizeShares(ctx context.Context, msg *types.MsgTokenizeShares) (*types.MsgTokenizeSharesResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if err := k.validateBondAmount(ctx, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delegatorAddress, valAddr, msg.Amount.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	shareTokens, err := k.Keeper.TokenizeShares(ctx, delegatorAddress, valAddr, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeTokenizeShares,
+		event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+		event.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		event.NewAttribute(types.AttributeKeyShareTokens, shareTokens.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTokenizeSharesResponse{Amount: shareTokens}, nil
+}
+
+// RedeemTokensForShares defines a method for redeeming liquid staking share tokens back into a delegation
+func (k msgServer) RedeemTokensForShares(ctx context.Context, msg *types.MsgRedeemTokensForShares) (*types.MsgRedeemTokensForSharesResponse, error) {
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid amount")
+	}
+
+	validatorAddress, err := types.ParseLiquidSharesDenom(msg.Amount.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(validatorAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidLiquidSharesDenom, "invalid validator address: %s", err)
+	}
+
+	redeemed, err := k.Keeper.RedeemTokensForShares(ctx, delegatorAddress, valAddr, msg.Amount.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	redeemedCoin := sdk.NewCoin(bondDenom, redeemed)
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRedeemShares,
+		event.NewAttribute(types.AttributeKeyValidator, validatorAddress),
+		event.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+		event.NewAttribute(sdk.AttributeKeyAmount, redeemedCoin.String()),
+		event.NewAttribute(types.AttributeKeyShareTokens, msg.Amount.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRedeemTokensForSharesResponse{Amount: redeemedCoin}, nil
+}
+
+// TransferDelegation defines a method for transferring the ownership of a delegation without unbonding it
+func (k msgServer) TransferDelegation(ctx context.Context, msg *types.MsgTransferDelegation) (*types.MsgTransferDelegationResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	recipientAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.RecipientAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if err := k.validateBondAmount(ctx, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delegatorAddress, valAddr, msg.Amount.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.TransferDelegation(ctx, delegatorAddress, recipientAddress, valAddr, shares); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeTransferDelegation,
+		event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+		event.NewAttribute(types.AttributeKeyRecipient, msg.RecipientAddress),
+		event.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		event.NewAttribute(types.AttributeKeyNewShares, shares.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferDelegationResponse{}, nil
+}
+
+// validateBondAmount returns an error if the amount is not a positive amount of the bond denom.
+func (k msgServer) validateBondAmount(ctx context.Context, amount sdk.Coin) error {
+	if !amount.IsValid() || !amount.Amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid shares amount")
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	if amount.Denom != bondDenom {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", amount.Denom, bondDenom,
+		)
+	}
+
+	return nil
+}
+
 // checkConsKeyAlreadyUsed returns an error if the consensus public key is already used,
 // in ConsAddrToValidatorIdentifierMap, OldToNewConsAddrMap, or in the current block (RotationHistory).
 func (k msgServer) checkConsKeyAlreadyUsed(ctx context.Context, newConsPubKey cryptotypes.PubKey) error {
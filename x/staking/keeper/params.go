@@ -54,3 +54,9 @@ func (k Keeper) MinCommissionRate(ctx context.Context) (math.LegacyDec, error) {
 	params, err := k.Params.Get(ctx)
 	return params.MinCommissionRate, err
 }
+
+// MinSelfDelegation - chain-wide minimum of the minimum self delegation of the validators
+func (k Keeper) MinSelfDelegation(ctx context.Context) (math.Int, error) {
+	params, err := k.Params.Get(ctx)
+	return params.MinSelfDelegation, err
+}
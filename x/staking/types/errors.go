@@ -50,4 +50,13 @@ var (
 	// consensus key errors
 	ErrExceedingMaxConsPubKeyRotations = errors.Register(ModuleName, 46, "exceeding maximum consensus pubkey rotations within unbonding period")
 	ErrConsensusPubKeyLenInvalid       = errors.Register(ModuleName, 47, "consensus pubkey len is invalid")
+
+	// liquid staking errors
+	ErrGlobalLiquidStakingCapExceeded    = errors.Register(ModuleName, 48, "global liquid staking cap exceeded")
+	ErrValidatorLiquidStakingCapExceeded = errors.Register(ModuleName, 49, "validator liquid staking cap exceeded")
+	ErrInvalidLiquidSharesDenom          = errors.Register(ModuleName, 50, "invalid liquid staking shares denom")
+	ErrTinyLiquidSharesAmount            = errors.Register(ModuleName, 51, "too few tokens to tokenize or redeem (truncates to zero)")
+	ErrDelegationTransferNotAllowed      = errors.Register(ModuleName, 52, "delegation transfer not allowed")
+
+	ErrMinSelfDelegationLTMinParam = errors.Register(ModuleName, 53, "minimum self delegation cannot be less than the chain-wide minimum self delegation")
 )
@@ -10,6 +10,11 @@ const (
 	EventTypeUnbond                    = "unbond"
 	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
 	EventTypeRedelegate                = "redelegate"
+	EventTypeTokenizeShares            = "tokenize_shares"
+	EventTypeRedeemShares              = "redeem_shares"
+	EventTypeTransferDelegation        = "transfer_delegation"
+	EventTypeScheduleCommissionChange  = "schedule_commission_change"
+	EventTypeCommissionChange          = "commission_change"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -20,4 +25,8 @@ const (
 	AttributeKeyCreationHeight    = "creation_height"
 	AttributeKeyCompletionTime    = "completion_time"
 	AttributeKeyNewShares         = "new_shares"
+	AttributeKeyShareTokens       = "share_tokens"
+	AttributeKeyRecipient         = "recipient"
+	AttributeKeyEpochIdentifier   = "epoch_identifier"
+	AttributeKeyRemainingEpochs   = "remaining_epochs"
 )
@@ -53,6 +53,8 @@ type GenesisState struct {
 	RotationIndexRecords []RotationIndexRecord       `protobuf:"bytes,9,rep,name=rotation_index_records,json=rotationIndexRecords,proto3" json:"rotation_index_records"`
 	RotationHistory      []ConsPubKeyRotationHistory `protobuf:"bytes,10,rep,name=rotation_history,json=rotationHistory,proto3" json:"rotation_history"`
 	RotationQueue        []RotationQueueRecord       `protobuf:"bytes,11,rep,name=rotation_queue,json=rotationQueue,proto3" json:"rotation_queue"`
+	// scheduled_commission_changes defines the scheduled commission changes of the validators.
+	ScheduledCommissionChanges []ScheduledCommissionChange `protobuf:"bytes,12,rep,name=scheduled_commission_changes,json=scheduledCommissionChanges,proto3" json:"scheduled_commission_changes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -158,6 +160,13 @@ func (m *GenesisState) GetRotationQueue() []RotationQueueRecord {
 	return nil
 }
 
+func (m *GenesisState) GetScheduledCommissionChanges() []ScheduledCommissionChange {
+	if m != nil {
+		return m.ScheduledCommissionChanges
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -301,52 +310,55 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 705 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xc7, 0xbb, 0xfc, 0x29, 0xed, 0x14, 0xf8, 0xf1, 0x1b, 0x0a, 0x59, 0x1b, 0xd3, 0xd6, 0x86,
-	0x43, 0x83, 0x61, 0xd7, 0xa2, 0xf1, 0xe0, 0x8d, 0x6a, 0xa2, 0x04, 0x22, 0xb8, 0x80, 0x07, 0x12,
-	0xb3, 0x99, 0xb2, 0xc3, 0xb2, 0x61, 0xbb, 0x53, 0x67, 0xa6, 0x95, 0xbe, 0x03, 0x8f, 0xdc, 0xbd,
-	0x70, 0xf4, 0xe8, 0x81, 0x17, 0xc1, 0xc5, 0x84, 0x70, 0x32, 0x1e, 0xd0, 0xc0, 0x41, 0x5f, 0x86,
-	0xd9, 0x99, 0xdd, 0x65, 0x9a, 0xb2, 0x44, 0x2f, 0x4d, 0xa7, 0xcf, 0xf7, 0xfb, 0xf9, 0x3e, 0xcf,
-	0x76, 0xe7, 0x01, 0x0b, 0x7b, 0x84, 0xb5, 0x09, 0x33, 0x19, 0x47, 0x87, 0x5e, 0xe0, 0x9a, 0xbd,
-	0x46, 0x0b, 0x73, 0xd4, 0x30, 0x5d, 0x1c, 0x60, 0xe6, 0x31, 0xa3, 0x43, 0x09, 0x27, 0x70, 0x5e,
-	0xaa, 0x8c, 0x48, 0x65, 0x44, 0xaa, 0x52, 0xd1, 0x25, 0x2e, 0x11, 0x12, 0x33, 0xfc, 0x26, 0xd5,
-	0xa5, 0x34, 0x66, 0xec, 0x96, 0xaa, 0x7b, 0x52, 0x65, 0x4b, 0x7b, 0x14, 0x20, 0x4b, 0xff, 0xa3,
-	0xb6, 0x17, 0x10, 0x53, 0x7c, 0x46, 0x3f, 0x55, 0x5c, 0x42, 0x5c, 0x1f, 0x9b, 0xe2, 0xd4, 0xea,
-	0xee, 0x9b, 0xdc, 0x6b, 0x63, 0xc6, 0x51, 0xbb, 0x23, 0x05, 0xb5, 0xaf, 0x13, 0x60, 0xf2, 0xa5,
-	0x6c, 0x7a, 0x8b, 0x23, 0x8e, 0xe1, 0x0a, 0xc8, 0x76, 0x10, 0x45, 0x6d, 0xa6, 0x6b, 0x55, 0xad,
-	0x5e, 0x58, 0x2e, 0x1b, 0xb7, 0x0f, 0x61, 0x6c, 0x0a, 0x55, 0x33, 0x7f, 0x76, 0x59, 0xc9, 0x7c,
-	0xfe, 0xf5, 0x65, 0x51, 0xb3, 0x22, 0x23, 0xdc, 0x05, 0x33, 0x3e, 0x62, 0xdc, 0xe6, 0x84, 0x23,
-	0xdf, 0xee, 0x90, 0x0f, 0x98, 0xea, 0x23, 0x55, 0xad, 0x3e, 0xd9, 0x7c, 0x14, 0x8a, 0xbf, 0x5f,
-	0x56, 0xe6, 0x24, 0x93, 0x39, 0x87, 0x86, 0x47, 0xcc, 0x36, 0xe2, 0x07, 0xc6, 0x6a, 0xc0, 0x2f,
-	0x4e, 0x97, 0x40, 0x14, 0xb6, 0x1a, 0x70, 0xc9, 0x9c, 0x0e, 0x49, 0xdb, 0x21, 0x68, 0x33, 0xe4,
-	0x40, 0x0f, 0xcc, 0x09, 0x76, 0x0f, 0xf9, 0x9e, 0x83, 0x38, 0xa1, 0x92, 0xcf, 0xf4, 0xd1, 0xea,
-	0x68, 0xbd, 0xb0, 0xbc, 0x98, 0xd6, 0xed, 0x3a, 0x62, 0xfc, 0x6d, 0xec, 0x11, 0x28, 0xb5, 0xf3,
-	0x59, 0x7f, 0xa8, 0xcc, 0xe0, 0x3a, 0x00, 0x49, 0x0a, 0xd3, 0xc7, 0x04, 0xff, 0x41, 0x1a, 0x3f,
-	0x31, 0xab, 0x58, 0xc5, 0x0f, 0x37, 0x40, 0xc1, 0xc1, 0x3e, 0x76, 0x11, 0xf7, 0x48, 0xc0, 0xf4,
-	0x71, 0x81, 0xab, 0xa5, 0xe1, 0x5e, 0x24, 0x52, 0x95, 0xa7, 0x12, 0xe0, 0x21, 0x98, 0xeb, 0x06,
-	0x2d, 0x12, 0x38, 0x5e, 0xe0, 0xda, 0x2a, 0x3a, 0x2b, 0xd0, 0x0f, 0xd3, 0xd0, 0x3b, 0xb1, 0xe9,
-	0xf6, 0x8c, 0x62, 0x77, 0xb8, 0xce, 0xe0, 0x0e, 0x98, 0xa2, 0x58, 0x0d, 0x99, 0x10, 0x21, 0x0b,
-	0x69, 0x21, 0x96, 0x22, 0x56, 0xe9, 0x83, 0x14, 0x58, 0x02, 0x39, 0x7c, 0xd4, 0x21, 0x94, 0x63,
-	0x47, 0xcf, 0x55, 0xb5, 0x7a, 0xce, 0x4a, 0xce, 0xd0, 0x07, 0xf3, 0x94, 0x70, 0x21, 0xb4, 0xbd,
-	0xc0, 0xc1, 0x47, 0x36, 0xc5, 0x7b, 0x84, 0x3a, 0x4c, 0xcf, 0xdf, 0x3d, 0xa0, 0x15, 0xb9, 0x56,
-	0x43, 0x93, 0x25, 0x3c, 0x03, 0x03, 0xd2, 0xe1, 0x3a, 0x83, 0x2e, 0x98, 0x49, 0xd2, 0x0e, 0x3c,
-	0xc6, 0x09, 0xed, 0xeb, 0x40, 0xe4, 0x34, 0xd2, 0x72, 0x9e, 0x93, 0x80, 0x6d, 0x76, 0x5b, 0x6b,
-	0xb8, 0x1f, 0x27, 0xbe, 0x92, 0x46, 0x35, 0xed, 0x3f, 0x3a, 0x58, 0x83, 0xef, 0xc0, 0x74, 0x12,
-	0xf4, 0xbe, 0x8b, 0xbb, 0x58, 0x2f, 0xfc, 0xdd, 0x38, 0x6f, 0x42, 0xf1, 0xf0, 0x38, 0x53, 0x54,
-	0xad, 0xd7, 0x0e, 0x00, 0x1c, 0x7e, 0xd5, 0xe1, 0x32, 0x98, 0x40, 0x8e, 0x43, 0x31, 0x93, 0xb7,
-	0x3a, 0xdf, 0xd4, 0x2f, 0x4e, 0x97, 0x8a, 0x51, 0xe0, 0x8a, 0xac, 0x6c, 0x71, 0xea, 0x05, 0xae,
-	0x15, 0x0b, 0x61, 0x11, 0x8c, 0xdf, 0x5c, 0xdd, 0x51, 0x4b, 0x1e, 0x9e, 0xe5, 0x3e, 0x9e, 0x54,
-	0x32, 0xbf, 0x4f, 0x2a, 0x99, 0x1a, 0x01, 0xb3, 0xb7, 0x3c, 0x69, 0xa8, 0x0f, 0x46, 0x4d, 0xde,
-	0x00, 0x9f, 0x80, 0xb1, 0x70, 0xfb, 0xe8, 0x59, 0xb1, 0x57, 0x4a, 0x86, 0x5c, 0x4d, 0x46, 0xbc,
-	0x9a, 0x8c, 0xed, 0x78, 0x35, 0x35, 0xc7, 0x8e, 0x7f, 0x54, 0x34, 0x4b, 0xa8, 0x95, 0xc0, 0x4f,
-	0xda, 0x4d, 0xa2, 0xf2, 0x30, 0xe0, 0x6b, 0x90, 0xef, 0x21, 0xdf, 0x0e, 0x63, 0xe2, 0xa5, 0xd5,
-	0xb8, 0xe3, 0x9a, 0x86, 0xe3, 0xb2, 0x8d, 0x7d, 0x41, 0xc2, 0x4e, 0xf8, 0x27, 0xae, 0xe1, 0x3e,
-	0xb3, 0x72, 0xbd, 0xa8, 0x94, 0xf4, 0x39, 0xf2, 0x2f, 0x7d, 0x36, 0x9f, 0x9e, 0x5d, 0x95, 0xb5,
-	0xf3, 0xab, 0xb2, 0xf6, 0xf3, 0xaa, 0xac, 0x1d, 0x5f, 0x97, 0x33, 0xe7, 0xd7, 0xe5, 0xcc, 0xb7,
-	0xeb, 0x72, 0x66, 0xf7, 0xfe, 0xc0, 0xb2, 0x3b, 0x4a, 0xf6, 0x3b, 0xef, 0x77, 0x30, 0x6b, 0x65,
-	0x05, 0xf7, 0xf1, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x22, 0x7b, 0xa0, 0x65, 0x52, 0x06, 0x00,
-	0x00,
+	// 764 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0x4f, 0x4f, 0xe3, 0x46,
+	0x18, 0xc6, 0x63, 0xfe, 0x84, 0x64, 0x12, 0xfe, 0x74, 0x08, 0xc8, 0x8d, 0x50, 0x92, 0x46, 0x1c,
+	0x22, 0xaa, 0xd8, 0x04, 0xda, 0x1e, 0x7a, 0x23, 0x54, 0x6a, 0x11, 0xa8, 0x50, 0x03, 0x3d, 0x20,
+	0x55, 0xd6, 0x24, 0x1e, 0x1c, 0x2b, 0xb6, 0x27, 0xf5, 0x4c, 0xb2, 0xe4, 0x1b, 0xec, 0x91, 0x3d,
+	0xef, 0x85, 0xe3, 0x1e, 0xf7, 0xc0, 0x87, 0xe0, 0x88, 0x38, 0xad, 0xf6, 0xc0, 0xee, 0xc2, 0x61,
+	0xf7, 0x63, 0xac, 0x3c, 0x63, 0x1b, 0x47, 0x89, 0xd1, 0xee, 0x25, 0xca, 0xe4, 0x7d, 0x9e, 0xdf,
+	0xf3, 0xbe, 0xb1, 0xe6, 0x35, 0x58, 0x6f, 0x13, 0xea, 0x10, 0xaa, 0x52, 0x86, 0xba, 0x96, 0x6b,
+	0xaa, 0x83, 0x46, 0x0b, 0x33, 0xd4, 0x50, 0x4d, 0xec, 0x62, 0x6a, 0x51, 0xa5, 0xe7, 0x11, 0x46,
+	0xe0, 0xaa, 0x50, 0x29, 0x81, 0x4a, 0x09, 0x54, 0xc5, 0x82, 0x49, 0x4c, 0xc2, 0x25, 0xaa, 0xff,
+	0x4d, 0xa8, 0x8b, 0x49, 0xcc, 0xd0, 0x2d, 0x54, 0x3f, 0x0a, 0x95, 0x2e, 0xec, 0x41, 0x80, 0x28,
+	0xfd, 0x80, 0x1c, 0xcb, 0x25, 0x2a, 0xff, 0x0c, 0x7e, 0x2a, 0x9b, 0x84, 0x98, 0x36, 0x56, 0xf9,
+	0xa9, 0xd5, 0x3f, 0x57, 0x99, 0xe5, 0x60, 0xca, 0x90, 0xd3, 0x13, 0x82, 0xea, 0xa7, 0x0c, 0xc8,
+	0xff, 0x29, 0x9a, 0x3e, 0x66, 0x88, 0x61, 0xb8, 0x03, 0xd2, 0x3d, 0xe4, 0x21, 0x87, 0xca, 0x52,
+	0x45, 0xaa, 0xe5, 0xb6, 0x4a, 0xca, 0xe4, 0x21, 0x94, 0x23, 0xae, 0x6a, 0x66, 0x6f, 0xee, 0xcb,
+	0xa9, 0x37, 0x9f, 0xdf, 0x6e, 0x48, 0x5a, 0x60, 0x84, 0x67, 0x60, 0xc9, 0x46, 0x94, 0xe9, 0x8c,
+	0x30, 0x64, 0xeb, 0x3d, 0xf2, 0x02, 0x7b, 0xf2, 0x54, 0x45, 0xaa, 0xe5, 0x9b, 0x9b, 0xbe, 0xf8,
+	0xfd, 0x7d, 0x79, 0x45, 0x30, 0xa9, 0xd1, 0x55, 0x2c, 0xa2, 0x3a, 0x88, 0x75, 0x94, 0x3d, 0x97,
+	0xdd, 0x5d, 0xd7, 0x41, 0x10, 0xb6, 0xe7, 0x32, 0xc1, 0x5c, 0xf0, 0x49, 0x27, 0x3e, 0xe8, 0xc8,
+	0xe7, 0x40, 0x0b, 0xac, 0x70, 0xf6, 0x00, 0xd9, 0x96, 0x81, 0x18, 0xf1, 0x04, 0x9f, 0xca, 0xd3,
+	0x95, 0xe9, 0x5a, 0x6e, 0x6b, 0x23, 0xa9, 0xdb, 0x03, 0x44, 0xd9, 0xbf, 0xa1, 0x87, 0xa3, 0xe2,
+	0x9d, 0x2f, 0xdb, 0x63, 0x65, 0x0a, 0x0f, 0x00, 0x88, 0x52, 0xa8, 0x3c, 0xc3, 0xf9, 0x3f, 0x25,
+	0xf1, 0x23, 0x73, 0x1c, 0x1b, 0xf3, 0xc3, 0x43, 0x90, 0x33, 0xb0, 0x8d, 0x4d, 0xc4, 0x2c, 0xe2,
+	0x52, 0x79, 0x96, 0xe3, 0xaa, 0x49, 0xb8, 0x3f, 0x22, 0x69, 0x9c, 0x17, 0x27, 0xc0, 0x2e, 0x58,
+	0xe9, 0xbb, 0x2d, 0xe2, 0x1a, 0x96, 0x6b, 0xea, 0x71, 0x74, 0x9a, 0xa3, 0x7f, 0x4e, 0x42, 0x9f,
+	0x86, 0xa6, 0xc9, 0x19, 0x85, 0xfe, 0x78, 0x9d, 0xc2, 0x53, 0x30, 0xef, 0xe1, 0x78, 0xc8, 0x1c,
+	0x0f, 0x59, 0x4f, 0x0a, 0xd1, 0xb0, 0x31, 0x91, 0x3e, 0x4a, 0x81, 0x45, 0x90, 0xc1, 0x17, 0x3d,
+	0xe2, 0x31, 0x6c, 0xc8, 0x99, 0x8a, 0x54, 0xcb, 0x68, 0xd1, 0x19, 0xda, 0x60, 0xd5, 0x23, 0x8c,
+	0x0b, 0x75, 0xcb, 0x35, 0xf0, 0x85, 0xee, 0xe1, 0x36, 0xf1, 0x0c, 0x2a, 0x67, 0x9f, 0x1f, 0x50,
+	0x0b, 0x5c, 0x7b, 0xbe, 0x49, 0xe3, 0x9e, 0x91, 0x01, 0xbd, 0xf1, 0x3a, 0x85, 0x26, 0x58, 0x8a,
+	0xd2, 0x3a, 0x16, 0x65, 0xc4, 0x1b, 0xca, 0x80, 0xe7, 0x34, 0x92, 0x72, 0x76, 0x89, 0x4b, 0x8f,
+	0xfa, 0xad, 0x7d, 0x3c, 0x0c, 0x13, 0xff, 0x12, 0xc6, 0x78, 0xda, 0xa2, 0x37, 0x5a, 0x83, 0xff,
+	0x81, 0x85, 0x28, 0xe8, 0xff, 0x3e, 0xee, 0x63, 0x39, 0xf7, 0x6d, 0xe3, 0xfc, 0xe3, 0x8b, 0xc7,
+	0xc7, 0x99, 0xf7, 0xe2, 0x75, 0xf8, 0x4a, 0x02, 0x6b, 0xb4, 0xdd, 0xc1, 0x46, 0xdf, 0xc6, 0x86,
+	0xde, 0x26, 0x8e, 0x63, 0x51, 0xea, 0x67, 0xb5, 0x3b, 0xc8, 0x35, 0x31, 0x95, 0xf3, 0xcf, 0x0f,
+	0x75, 0x1c, 0x7a, 0x77, 0x23, 0xeb, 0x2e, 0x77, 0x36, 0xd7, 0xf8, 0xdd, 0xbd, 0xae, 0x2f, 0x0a,
+	0x67, 0x9d, 0x1a, 0xdd, 0xca, 0xa6, 0xf2, 0xeb, 0xb6, 0x68, 0xa3, 0x48, 0x93, 0x8c, 0xb4, 0xda,
+	0x01, 0x70, 0xfc, 0xfa, 0xc1, 0x2d, 0x30, 0x87, 0x0c, 0xc3, 0xc3, 0x54, 0x6c, 0x9a, 0x6c, 0x53,
+	0xbe, 0xbb, 0xae, 0x17, 0x82, 0xb6, 0x76, 0x44, 0xe5, 0x98, 0x79, 0x96, 0x6b, 0x6a, 0xa1, 0x10,
+	0x16, 0xc0, 0xec, 0xd3, 0x3a, 0x99, 0xd6, 0xc4, 0xe1, 0xf7, 0xcc, 0xcb, 0xab, 0x72, 0xea, 0xcb,
+	0x55, 0x39, 0x55, 0x25, 0x60, 0x79, 0xc2, 0xd3, 0x87, 0xf2, 0x68, 0x54, 0xfe, 0x09, 0xf8, 0x0b,
+	0x98, 0xf1, 0x37, 0xa2, 0x9c, 0xe6, 0xbb, 0xae, 0xa8, 0x88, 0x75, 0xa9, 0x84, 0xeb, 0x52, 0x39,
+	0x09, 0xd7, 0x65, 0x73, 0xe6, 0xf2, 0x43, 0x59, 0xd2, 0xb8, 0x3a, 0x16, 0xf8, 0x5a, 0x7a, 0x4a,
+	0x8c, 0x3d, 0x20, 0xf8, 0x37, 0xc8, 0x0e, 0x90, 0xad, 0xfb, 0x31, 0xe1, 0x22, 0x6d, 0x3c, 0xb3,
+	0x3a, 0xfc, 0x71, 0xe9, 0xe1, 0x39, 0x27, 0xf9, 0xff, 0xa0, 0x4b, 0xf7, 0xf1, 0x90, 0x6a, 0x99,
+	0x41, 0x50, 0x8a, 0xfa, 0x9c, 0xfa, 0x9e, 0x3e, 0x9b, 0xbf, 0xdd, 0x3c, 0x94, 0xa4, 0xdb, 0x87,
+	0x92, 0xf4, 0xf1, 0xa1, 0x24, 0x5d, 0x3e, 0x96, 0x52, 0xb7, 0x8f, 0xa5, 0xd4, 0xbb, 0xc7, 0x52,
+	0xea, 0x6c, 0x6d, 0x64, 0x01, 0x5f, 0x44, 0xef, 0x1c, 0x36, 0xec, 0x61, 0xda, 0x4a, 0x73, 0xee,
+	0xf6, 0xd7, 0x01, 0x00, 0x39, 0xa3, 0x12, 0x44, 0xe6, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -369,6 +381,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScheduledCommissionChanges) > 0 {
+		for iNdEx := len(m.ScheduledCommissionChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledCommissionChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.RotationQueue) > 0 {
 		for iNdEx := len(m.RotationQueue) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -706,6 +732,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ScheduledCommissionChanges) > 0 {
+		for _, e := range m.ScheduledCommissionChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1152,6 +1184,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledCommissionChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledCommissionChanges = append(m.ScheduledCommissionChanges, ScheduledCommissionChange{})
+			if err := m.ScheduledCommissionChanges[len(m.ScheduledCommissionChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -61,6 +61,8 @@ var (
 
 	DelegationByValIndexKey = collections.NewPrefix(113) // key for delegations by a validator
 
+	ScheduledCommissionChangesKey = collections.NewPrefix(114) // prefix for the scheduled commission changes by validator
+
 	ValidatorConsPubKeyRotationHistoryKey       = collections.NewPrefix(101) // prefix for consPubkey rotation history by validator
 	BlockConsPubKeyRotationHistoryKey           = collections.NewPrefix(102) // prefix for consPubkey rotation history by height
 	ValidatorConsensusKeyRotationRecordQueueKey = collections.NewPrefix(103) // this key is used to set the unbonding period time on each rotation
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// LiquidSharesDenomPrefix is the prefix of the denoms of the liquid staking share tokens.
+const LiquidSharesDenomPrefix = "liquid/"
+
+// LiquidSharesDenom returns the denom of the liquid staking share tokens of a validator.
+func LiquidSharesDenom(valAddr string) string {
+	return LiquidSharesDenomPrefix + valAddr
+}
+
+// ParseLiquidSharesDenom returns the validator address of a liquid staking share tokens denom.
+func ParseLiquidSharesDenom(denom string) (string, error) {
+	valAddr, ok := strings.CutPrefix(denom, LiquidSharesDenomPrefix)
+	if !ok || valAddr == "" {
+		return "", errorsmod.Wrapf(ErrInvalidLiquidSharesDenom, "%s is not a liquid staking shares denom", denom)
+	}
+
+	return valAddr, nil
+}
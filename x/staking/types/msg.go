@@ -23,6 +23,9 @@ var (
 	_ coretransaction.Msg                  = &MsgBeginRedelegate{}
 	_ coretransaction.Msg                  = &MsgCancelUnbondingDelegation{}
 	_ coretransaction.Msg                  = &MsgUpdateParams{}
+	_ coretransaction.Msg                  = &MsgTokenizeShares{}
+	_ coretransaction.Msg                  = &MsgRedeemTokensForShares{}
+	_ coretransaction.Msg                  = &MsgTransferDelegation{}
 )
 
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
@@ -151,6 +154,33 @@ func NewMsgCancelUnbondingDelegation(delAddr, valAddr string, creationHeight int
 	}
 }
 
+// NewMsgTokenizeShares creates a new MsgTokenizeShares instance.
+func NewMsgTokenizeShares(delAddr, valAddr string, amount sdk.Coin) *MsgTokenizeShares {
+	return &MsgTokenizeShares{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Amount:           amount,
+	}
+}
+
+// NewMsgRedeemTokensForShares creates a new MsgRedeemTokensForShares instance.
+func NewMsgRedeemTokensForShares(delAddr string, amount sdk.Coin) *MsgRedeemTokensForShares {
+	return &MsgRedeemTokensForShares{
+		DelegatorAddress: delAddr,
+		Amount:           amount,
+	}
+}
+
+// NewMsgTransferDelegation creates a new MsgTransferDelegation instance.
+func NewMsgTransferDelegation(delAddr, valAddr, recipientAddr string, amount sdk.Coin) *MsgTransferDelegation {
+	return &MsgTransferDelegation{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		RecipientAddress: recipientAddr,
+		Amount:           amount,
+	}
+}
+
 // NewMsgRotateConsPubKey creates a new MsgRotateConsPubKey instance.
 func NewMsgRotateConsPubKey(valAddr string, pubKey cryptotypes.PubKey) (*MsgRotateConsPubKey, error) {
 	var pkAny *codectypes.Any
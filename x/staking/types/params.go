@@ -24,6 +24,13 @@ const (
 
 	// Default maximum entries in a UBD/RED pair
 	DefaultMaxEntries uint32 = 7
+
+	// DefaultCommissionChangeEpochIdentifier is the default identifier of the epochs
+	// counting the commission change delay
+	DefaultCommissionChangeEpochIdentifier = "day"
+
+	// DefaultCommissionChangeDelayEpochs is set to 0, applying the commission changes immediately
+	DefaultCommissionChangeDelayEpochs uint64 = 0
 )
 
 var (
@@ -32,9 +39,19 @@ var (
 
 	// DefaultKeyRotationFee is fees used to rotate the ConsPubkey or Operator key
 	DefaultKeyRotationFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)
+
+	// DefaultGlobalLiquidStakingCap is set to 100%
+	DefaultGlobalLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultValidatorLiquidStakingCap is set to 100%
+	DefaultValidatorLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultMinSelfDelegation is set to 1, the lowest minimum self delegation of a validator
+	DefaultMinSelfDelegation = math.OneInt()
 )
 
-// NewParams creates a new Params instance
+// NewParams creates a new Params instance, with the default liquid staking caps,
+// minimum self delegation and commission change delay
 func NewParams(unbondingTime time.Duration,
 	maxValidators, maxEntries uint32,
 	bondDenom string, minCommissionRate math.LegacyDec,
@@ -48,6 +65,13 @@ func NewParams(unbondingTime time.Duration,
 		BondDenom:         bondDenom,
 		MinCommissionRate: minCommissionRate,
 		KeyRotationFee:    keyRotationFee,
+
+		GlobalLiquidStakingCap:    DefaultGlobalLiquidStakingCap,
+		ValidatorLiquidStakingCap: DefaultValidatorLiquidStakingCap,
+
+		MinSelfDelegation:               DefaultMinSelfDelegation,
+		CommissionChangeEpochIdentifier: DefaultCommissionChangeEpochIdentifier,
+		CommissionChangeDelayEpochs:     DefaultCommissionChangeDelayEpochs,
 	}
 }
 
@@ -113,6 +137,22 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateLiquidStakingCap(p.GlobalLiquidStakingCap); err != nil {
+		return fmt.Errorf("global %w", err)
+	}
+
+	if err := validateLiquidStakingCap(p.ValidatorLiquidStakingCap); err != nil {
+		return fmt.Errorf("validator %w", err)
+	}
+
+	if err := validateMinSelfDelegation(p.MinSelfDelegation); err != nil {
+		return err
+	}
+
+	if err := validateCommissionChangeEpochIdentifier(p.CommissionChangeEpochIdentifier); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -228,3 +268,51 @@ func validateKeyRotationFee(i interface{}) error {
 
 	return nil
 }
+
+func validateLiquidStakingCap(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("liquid staking cap cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("liquid staking cap cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("liquid staking cap cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinSelfDelegation(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("minimum self delegation cannot be nil: %s", v)
+	}
+	if !v.IsPositive() {
+		return fmt.Errorf("minimum self delegation must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateCommissionChangeEpochIdentifier(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if strings.TrimSpace(v) == "" {
+		return errors.New("commission change epoch identifier cannot be blank")
+	}
+
+	return nil
+}
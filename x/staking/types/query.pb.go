@@ -1452,6 +1452,106 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryValidatorScheduledCommissionRequest is request type for the
+// Query/ValidatorScheduledCommission RPC method.
+type QueryValidatorScheduledCommissionRequest struct {
+	// validator_addr defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryValidatorScheduledCommissionRequest) Reset() {
+	*m = QueryValidatorScheduledCommissionRequest{}
+}
+func (m *QueryValidatorScheduledCommissionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorScheduledCommissionRequest) ProtoMessage()    {}
+func (*QueryValidatorScheduledCommissionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{29}
+}
+func (m *QueryValidatorScheduledCommissionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorScheduledCommissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorScheduledCommissionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorScheduledCommissionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorScheduledCommissionRequest.Merge(m, src)
+}
+func (m *QueryValidatorScheduledCommissionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorScheduledCommissionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorScheduledCommissionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorScheduledCommissionRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorScheduledCommissionRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+// QueryValidatorScheduledCommissionResponse is response type for the
+// Query/ValidatorScheduledCommission RPC method.
+type QueryValidatorScheduledCommissionResponse struct {
+	// scheduled_commission defines the scheduled commission change of the validator.
+	ScheduledCommission ScheduledCommissionChange `protobuf:"bytes,1,opt,name=scheduled_commission,json=scheduledCommission,proto3" json:"scheduled_commission"`
+}
+
+func (m *QueryValidatorScheduledCommissionResponse) Reset() {
+	*m = QueryValidatorScheduledCommissionResponse{}
+}
+func (m *QueryValidatorScheduledCommissionResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryValidatorScheduledCommissionResponse) ProtoMessage() {}
+func (*QueryValidatorScheduledCommissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{30}
+}
+func (m *QueryValidatorScheduledCommissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorScheduledCommissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorScheduledCommissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorScheduledCommissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorScheduledCommissionResponse.Merge(m, src)
+}
+func (m *QueryValidatorScheduledCommissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorScheduledCommissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorScheduledCommissionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorScheduledCommissionResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorScheduledCommissionResponse) GetScheduledCommission() ScheduledCommissionChange {
+	if m != nil {
+		return m.ScheduledCommission
+	}
+	return ScheduledCommissionChange{}
+}
+
 func init() {
 	proto.RegisterType((*QueryValidatorsRequest)(nil), "cosmos.staking.v1beta1.QueryValidatorsRequest")
 	proto.RegisterType((*ValidatorInfo)(nil), "cosmos.staking.v1beta1.ValidatorInfo")
@@ -1482,6 +1582,8 @@ func init() {
 	proto.RegisterType((*QueryPoolResponse)(nil), "cosmos.staking.v1beta1.QueryPoolResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.staking.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.staking.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryValidatorScheduledCommissionRequest)(nil), "cosmos.staking.v1beta1.QueryValidatorScheduledCommissionRequest")
+	proto.RegisterType((*QueryValidatorScheduledCommissionResponse)(nil), "cosmos.staking.v1beta1.QueryValidatorScheduledCommissionResponse")
 }
 
 func init() {
@@ -1489,99 +1591,106 @@ func init() {
 }
 
 var fileDescriptor_f270127f442bbcd8 = []byte{
-	// 1467 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xdd, 0x6b, 0x1c, 0x55,
-	0x14, 0xcf, 0xdd, 0xc4, 0x60, 0x4e, 0x69, 0x49, 0xef, 0x6e, 0xe3, 0x76, 0x9a, 0x6e, 0xb6, 0x43,
-	0xad, 0x69, 0x6a, 0x66, 0xda, 0x54, 0xdb, 0x58, 0xa1, 0xed, 0xc6, 0xa2, 0xad, 0x2d, 0x35, 0x5d,
-	0x31, 0x8a, 0x1f, 0x84, 0x49, 0x76, 0x3a, 0x19, 0x9a, 0xcc, 0x6c, 0xe7, 0x4e, 0x42, 0x4b, 0x29,
-	0x82, 0x0f, 0x52, 0x5f, 0x44, 0xf0, 0x5d, 0xfa, 0x28, 0xa2, 0x20, 0x98, 0x0a, 0x22, 0xf6, 0x51,
-	0xfa, 0x20, 0x52, 0x2a, 0x15, 0xf5, 0xa1, 0x4a, 0x23, 0xe8, 0x8b, 0xff, 0x81, 0x88, 0xcc, 0xcc,
-	0x99, 0xaf, 0xcc, 0xe7, 0x6e, 0x76, 0x21, 0x7d, 0x09, 0xd9, 0x3b, 0xf7, 0x9c, 0xf3, 0xfb, 0xfd,
-	0xce, 0x39, 0x77, 0xce, 0xdd, 0x05, 0x7e, 0x5e, 0x67, 0x4b, 0x3a, 0x13, 0x99, 0x29, 0x5d, 0x52,
-	0x35, 0x45, 0x5c, 0x39, 0x34, 0x27, 0x9b, 0xd2, 0x21, 0xf1, 0xf2, 0xb2, 0x6c, 0x5c, 0x15, 0x9a,
-	0x86, 0x6e, 0xea, 0x74, 0xc8, 0xd9, 0x23, 0xe0, 0x1e, 0x01, 0xf7, 0x70, 0x63, 0x68, 0x3b, 0x27,
-	0x31, 0xd9, 0x31, 0xf0, 0xcc, 0x9b, 0x92, 0xa2, 0x6a, 0x92, 0xa9, 0xea, 0x9a, 0xe3, 0x83, 0x2b,
-	0x29, 0xba, 0xa2, 0xdb, 0xff, 0x8a, 0xd6, 0x7f, 0xb8, 0x3a, 0xac, 0xe8, 0xba, 0xb2, 0x28, 0x8b,
-	0x52, 0x53, 0x15, 0x25, 0x4d, 0xd3, 0x4d, 0xdb, 0x84, 0xe1, 0xd3, 0xbd, 0x09, 0xd8, 0x5c, 0x1c,
-	0xce, 0xae, 0x9d, 0xce, 0xae, 0x59, 0xc7, 0x39, 0x42, 0x75, 0x1e, 0xed, 0x42, 0x07, 0x2e, 0xb6,
-	0x20, 0x2b, 0x6e, 0xbb, 0xb4, 0xa4, 0x6a, 0xba, 0x68, 0xff, 0x75, 0x96, 0xf8, 0x2b, 0x30, 0x74,
-	0xc1, 0xda, 0x31, 0x23, 0x2d, 0xaa, 0x0d, 0xc9, 0xd4, 0x0d, 0x56, 0x97, 0x2f, 0x2f, 0xcb, 0xcc,
-	0xa4, 0x43, 0xd0, 0xcf, 0x4c, 0xc9, 0x5c, 0x66, 0x65, 0x52, 0x25, 0xa3, 0x03, 0x75, 0xfc, 0x44,
-	0x5f, 0x04, 0xf0, 0xa9, 0x96, 0x0b, 0x55, 0x32, 0xba, 0x65, 0x62, 0x9f, 0x80, 0x20, 0x2c, 0x5d,
-	0x04, 0x27, 0x24, 0x42, 0x17, 0xa6, 0x25, 0x45, 0x46, 0x9f, 0xf5, 0x80, 0x25, 0xbf, 0x00, 0x5b,
-	0xbd, 0xa0, 0x67, 0xb4, 0x8b, 0x3a, 0xad, 0xc1, 0xf6, 0x79, 0x5d, 0x63, 0xb2, 0xc6, 0x96, 0xd9,
-	0xac, 0xd4, 0x68, 0x18, 0x32, 0xc3, 0xd8, 0x53, 0xa5, 0xdf, 0x56, 0xc7, 0x07, 0xaf, 0xb8, 0x2a,
-	0x54, 0x57, 0x0e, 0x0a, 0x13, 0xc2, 0xc1, 0xfa, 0xa0, 0xb7, 0xbd, 0xe6, 0xec, 0x3e, 0x56, 0xba,
-	0x17, 0xb3, 0x8f, 0xff, 0xa0, 0x00, 0x4f, 0x44, 0x48, 0xb2, 0xa6, 0x65, 0x4c, 0xcf, 0x01, 0xac,
-	0x78, 0xab, 0x65, 0x52, 0xed, 0x1d, 0xdd, 0x32, 0xb1, 0x47, 0x88, 0xcf, 0xbe, 0xe0, 0xd9, 0x4f,
-	0x0d, 0xdc, 0x79, 0x30, 0xd2, 0xf3, 0xe9, 0x5f, 0x5f, 0x8e, 0x91, 0x7a, 0xc0, 0x9e, 0xbe, 0x0e,
-	0xdb, 0xbc, 0x4f, 0xb3, 0xaa, 0x76, 0x51, 0x2f, 0x17, 0x6c, 0x8f, 0x4f, 0x66, 0x7a, 0xb4, 0x14,
-	0x08, 0x7a, 0xdd, 0xba, 0x12, 0xd2, 0xe6, 0xa5, 0x90, 0xe8, 0xbd, 0xb6, 0xe8, 0x4f, 0x65, 0x8a,
-	0xee, 0x70, 0x0c, 0xa9, 0x2e, 0xc1, 0x8e, 0xb0, 0x14, 0x6e, 0xba, 0x4f, 0x07, 0xa1, 0x5b, 0xea,
-	0xa3, 0xf4, 0x7b, 0xee, 0xad, 0x8e, 0xef, 0xc6, 0x40, 0x9e, 0x11, 0xea, 0xfd, 0xaa, 0x69, 0xa8,
-	0x9a, 0x12, 0xc0, 0x6a, 0xad, 0xf3, 0x8d, 0xf5, 0x25, 0xe5, 0x89, 0xfd, 0x32, 0x0c, 0x78, 0x5b,
-	0x6d, 0xf7, 0xad, 0x6a, 0xed, 0x9b, 0xf3, 0xab, 0x04, 0xaa, 0xe1, 0x30, 0xa7, 0xe4, 0x45, 0x59,
-	0x71, 0xba, 0xa9, 0xe3, 0xa4, 0x3a, 0x56, 0xf5, 0xff, 0x10, 0xd8, 0x93, 0x02, 0x1b, 0x85, 0x7a,
-	0x17, 0x4a, 0x0d, 0x6f, 0x79, 0xd6, 0xc0, 0x65, 0xb7, 0x3e, 0xc7, 0x92, 0x34, 0xf3, 0x5d, 0xb9,
-	0x9e, 0xa6, 0xaa, 0x96, 0x78, 0x9f, 0xfd, 0x3e, 0x52, 0x8c, 0x3e, 0x63, 0x8e, 0xa6, 0xc5, 0x46,
-	0xf4, 0xc9, 0xba, 0x7a, 0x2b, 0xb4, 0x5f, 0x6f, 0xdf, 0x11, 0xd8, 0x1f, 0xe6, 0xfb, 0x9a, 0x36,
-	0xa7, 0x6b, 0x0d, 0x55, 0x53, 0x1e, 0x89, 0x7c, 0x3d, 0x20, 0x30, 0x96, 0x07, 0x3f, 0x26, 0x4e,
-	0x81, 0xe2, 0xb2, 0xfb, 0x3c, 0x92, 0xb7, 0x03, 0x49, 0x79, 0x8b, 0x71, 0x19, 0xac, 0x7a, 0xea,
-	0xb9, 0xec, 0x42, 0x82, 0xbe, 0x20, 0xd8, 0xae, 0xc1, 0x02, 0x71, 0xb2, 0x71, 0x02, 0xb6, 0x61,
-	0x6d, 0x84, 0xb3, 0x51, 0xbe, 0xb7, 0x3a, 0x5e, 0xc2, 0x50, 0xeb, 0x92, 0xe0, 0xed, 0xb7, 0x93,
-	0x10, 0x4d, 0x67, 0xa1, 0xbd, 0x74, 0x1e, 0x7b, 0xfc, 0xc6, 0xcd, 0x91, 0x9e, 0xbf, 0x6f, 0x8e,
-	0xf4, 0xf0, 0x2b, 0x78, 0x96, 0x47, 0xeb, 0x99, 0xbe, 0x05, 0xc5, 0x98, 0xae, 0xc1, 0x83, 0xa6,
-	0x85, 0xa6, 0xa9, 0xd3, 0x68, 0x4b, 0xf0, 0x5f, 0x13, 0x18, 0xb1, 0x03, 0xc7, 0x24, 0x6b, 0x53,
-	0x0b, 0x66, 0xe0, 0x39, 0x19, 0x8b, 0x1b, 0x95, 0x3b, 0x0f, 0xfd, 0x4e, 0x8d, 0xa1, 0x58, 0xed,
-	0x56, 0x2a, 0x7a, 0xe1, 0x6f, 0xb9, 0x87, 0xf3, 0x29, 0x97, 0x5e, 0x4c, 0xb3, 0x6f, 0x58, 0xad,
-	0x0e, 0xf5, 0x78, 0x40, 0xab, 0x9f, 0xdd, 0xd3, 0x39, 0x1e, 0x37, 0xaa, 0xb5, 0xd0, 0xb1, 0xd3,
-	0x39, 0x20, 0x5d, 0x77, 0x8f, 0xe1, 0xdb, 0xee, 0x31, 0xec, 0x11, 0x4b, 0x3b, 0x86, 0x37, 0x61,
-	0x66, 0xbc, 0x73, 0x38, 0x83, 0xc0, 0x23, 0x7b, 0x0e, 0xdf, 0x2e, 0xc0, 0x4e, 0x9b, 0x60, 0x5d,
-	0x6e, 0x74, 0x25, 0x23, 0x94, 0x19, 0xf3, 0xb3, 0xb1, 0xa7, 0x4b, 0xb2, 0x93, 0x41, 0x66, 0xcc,
-	0xcf, 0xac, 0x7b, 0xaf, 0xd2, 0x06, 0x33, 0xd7, 0xfb, 0xe9, 0xcd, 0xf2, 0xd3, 0x60, 0xe6, 0x4c,
-	0xca, 0xfb, 0xb9, 0xaf, 0x03, 0x15, 0x72, 0x9f, 0x00, 0x17, 0x27, 0x20, 0x56, 0x84, 0x06, 0x43,
-	0x86, 0x9c, 0xd2, 0xb6, 0x4f, 0x27, 0x15, 0x45, 0xd0, 0x5d, 0x5c, 0xe3, 0xee, 0x30, 0xe4, 0xae,
-	0xb6, 0xee, 0xaa, 0xfb, 0xe2, 0xf1, 0x2a, 0x3f, 0x7a, 0x57, 0xdb, 0x84, 0x0d, 0xfb, 0x4d, 0xe4,
-	0x15, 0xd0, 0xf5, 0xdb, 0x57, 0xc7, 0x24, 0xbf, 0x45, 0xa0, 0x92, 0x80, 0x7d, 0x53, 0xbf, 0xea,
-	0x97, 0x12, 0x2b, 0xa5, 0x2b, 0x57, 0xb0, 0x49, 0x6c, 0xb8, 0xd3, 0x2a, 0x33, 0x75, 0x43, 0x9d,
-	0x97, 0x16, 0xad, 0xbb, 0x6a, 0xe0, 0xfb, 0x83, 0x05, 0x59, 0x55, 0x16, 0x4c, 0x3b, 0x4c, 0x6f,
-	0x1d, 0x3f, 0x1d, 0x2b, 0x94, 0x09, 0x2f, 0xc1, 0xae, 0x58, 0x4b, 0x04, 0x79, 0x1c, 0xfa, 0x16,
-	0x54, 0x66, 0x22, 0xbe, 0x7d, 0x49, 0xf8, 0xc2, 0xd6, 0x53, 0x85, 0x32, 0xa9, 0xdb, 0x76, 0x76,
-	0x08, 0x0a, 0x83, 0x76, 0x88, 0x69, 0x5d, 0x5f, 0x44, 0x48, 0xfc, 0x34, 0x6c, 0x0f, 0xac, 0x61,
-	0xb0, 0xe7, 0xa1, 0xaf, 0xa9, 0xeb, 0x8b, 0x18, 0x6c, 0x38, 0x29, 0x98, 0x65, 0x13, 0xd4, 0xc1,
-	0x36, 0xe2, 0x4b, 0x40, 0x1d, 0x8f, 0x92, 0x21, 0x2d, 0xb9, 0xed, 0xc8, 0xbf, 0x01, 0xc5, 0xd0,
-	0x2a, 0x46, 0xaa, 0x41, 0x7f, 0xd3, 0x5e, 0xc1, 0x58, 0x95, 0xc4, 0x58, 0xf6, 0xae, 0xd0, 0x60,
-	0xe5, 0x18, 0x4e, 0x7c, 0x35, 0x04, 0x8f, 0xd9, 0xae, 0xe9, 0x27, 0x04, 0xc0, 0xef, 0x28, 0x2a,
-	0x24, 0xf9, 0x8a, 0xff, 0x76, 0x87, 0x13, 0x73, 0xef, 0xc7, 0xf9, 0x57, 0xbc, 0x61, 0x01, 0x79,
-	0xef, 0xa7, 0x3f, 0x3f, 0x2e, 0xec, 0xa5, 0xbc, 0x98, 0xf0, 0x3d, 0x55, 0xa0, 0x1b, 0x3f, 0x27,
-	0x30, 0xe0, 0xf9, 0xa1, 0xe3, 0xf9, 0xe2, 0xb9, 0xf0, 0x84, 0xbc, 0xdb, 0x11, 0xdd, 0x49, 0x1f,
-	0xdd, 0xb3, 0xf4, 0x70, 0x36, 0x3a, 0xf1, 0x5a, 0xb8, 0xf9, 0xae, 0xd3, 0x5f, 0x09, 0x94, 0xe2,
-	0xee, 0xe4, 0x74, 0x32, 0x1f, 0x94, 0xe8, 0x18, 0xc5, 0x3d, 0xd7, 0x86, 0x25, 0xf2, 0x39, 0xe7,
-	0xf3, 0xa9, 0xd1, 0x13, 0x6d, 0xf0, 0x11, 0x03, 0xef, 0x40, 0xfa, 0x1f, 0x81, 0xdd, 0xa9, 0xf7,
-	0x57, 0x5a, 0xcb, 0x07, 0x35, 0x65, 0x68, 0xe4, 0xa6, 0x36, 0xe2, 0x02, 0x69, 0xcf, 0xf8, 0xb4,
-	0xcf, 0xd2, 0x33, 0xed, 0xd0, 0xf6, 0xa7, 0xbe, 0xa0, 0x00, 0x3f, 0x10, 0x00, 0x3f, 0x5e, 0x46,
-	0xb3, 0x44, 0xee, 0x75, 0x19, 0xcd, 0x12, 0x9d, 0xeb, 0xf9, 0x77, 0x7c, 0x1e, 0x75, 0x3a, 0xbd,
-	0xc1, 0xf4, 0x89, 0xd7, 0xc2, 0x6f, 0x9a, 0xeb, 0xf4, 0x5f, 0x02, 0xc5, 0x18, 0x1d, 0xe9, 0xd1,
-	0x54, 0x9c, 0xc9, 0x17, 0x57, 0x6e, 0xb2, 0x75, 0x43, 0x64, 0x6a, 0xf8, 0x4c, 0x15, 0x2a, 0x77,
-	0x9a, 0x69, 0x6c, 0x3a, 0xe9, 0x8f, 0x04, 0x4a, 0x71, 0x17, 0xb4, 0x8c, 0x56, 0x4d, 0xb9, 0x8b,
-	0x66, 0xb4, 0x6a, 0xda, 0x6d, 0x90, 0xaf, 0xf9, 0x0a, 0x1c, 0xa1, 0xcf, 0x24, 0x29, 0x90, 0x9a,
-	0x4f, 0xab, 0x3f, 0x53, 0xef, 0x35, 0x19, 0xfd, 0x99, 0xe7, 0x52, 0x97, 0xd1, 0x9f, 0xb9, 0xae,
-	0x55, 0x39, 0xfb, 0xd3, 0xa3, 0x97, 0x33, 0xa1, 0x8c, 0x7e, 0x4f, 0x60, 0x6b, 0x68, 0x6c, 0xa7,
-	0x87, 0x52, 0xd1, 0xc6, 0xdd, 0x91, 0xb8, 0x89, 0x56, 0x4c, 0x90, 0xd0, 0x79, 0x9f, 0xd0, 0x0b,
-	0xb4, 0xd6, 0x0e, 0x21, 0x23, 0x04, 0xfb, 0x3e, 0x81, 0x62, 0xcc, 0xc0, 0x9b, 0xd1, 0x99, 0xc9,
-	0x93, 0x3d, 0x37, 0xd9, 0xba, 0x21, 0x52, 0x3b, 0xeb, 0x53, 0x3b, 0x49, 0x8f, 0xb7, 0x43, 0x2d,
-	0xf0, 0x32, 0x5f, 0x23, 0x40, 0xa3, 0xc1, 0xe8, 0x91, 0x16, 0xd1, 0xb9, 0xac, 0x8e, 0xb6, 0x6c,
-	0x87, 0xa4, 0xde, 0xf6, 0x49, 0x5d, 0xa0, 0xaf, 0x6c, 0x8c, 0x54, 0x74, 0x06, 0xf8, 0x96, 0xc0,
-	0xb6, 0xf0, 0x50, 0x49, 0xd3, 0x8b, 0x2a, 0x76, 0xf2, 0xe5, 0x0e, 0xb7, 0x64, 0x13, 0x9d, 0x60,
-	0x26, 0xe8, 0xc1, 0x24, 0x66, 0x0b, 0x9e, 0xb1, 0xfd, 0xf3, 0x92, 0x78, 0xcd, 0x19, 0xaa, 0xaf,
-	0xdf, 0x28, 0x10, 0xfa, 0x3e, 0x81, 0x3e, 0x6b, 0x4a, 0xa5, 0xa3, 0xa9, 0xf1, 0x03, 0x03, 0x31,
-	0xb7, 0x3f, 0xc7, 0x4e, 0xc4, 0xb7, 0xdf, 0xc7, 0x57, 0xa1, 0xc3, 0x49, 0xf8, 0xac, 0xa1, 0x98,
-	0x7e, 0x48, 0xa0, 0xdf, 0x19, 0x61, 0xe9, 0x58, 0x7a, 0x80, 0xe0, 0xd4, 0xcc, 0x1d, 0xc8, 0xb5,
-	0x17, 0xe1, 0x1c, 0xf0, 0xe1, 0x54, 0x69, 0x25, 0x11, 0x8e, 0x33, 0x48, 0x1f, 0xb9, 0xf3, 0xb0,
-	0x42, 0xee, 0x3e, 0xac, 0x90, 0x3f, 0x1e, 0x56, 0xc8, 0x47, 0x6b, 0x95, 0x9e, 0xbb, 0x6b, 0x95,
-	0x9e, 0x5f, 0xd6, 0x2a, 0x3d, 0x6f, 0x0e, 0x3b, 0x86, 0xac, 0x71, 0x49, 0x50, 0x75, 0xd1, 0xfb,
-	0xe5, 0x50, 0x34, 0xaf, 0x36, 0x65, 0x36, 0xd7, 0x6f, 0xff, 0x46, 0x7a, 0xf8, 0xff, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x02, 0x10, 0x70, 0xd8, 0x32, 0x1e, 0x00, 0x00,
+	// 1580 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xdb, 0x6b, 0x1c, 0x55,
+	0x18, 0xcf, 0xd9, 0xc4, 0x60, 0xbe, 0xd2, 0x9a, 0x9e, 0xdd, 0xa6, 0xdb, 0x69, 0xba, 0xd9, 0x0e,
+	0x55, 0xd3, 0xd4, 0xcc, 0xe4, 0x62, 0xdb, 0x18, 0xa1, 0xed, 0xa6, 0xc5, 0x5e, 0xa9, 0xe9, 0x16,
+	0xa3, 0x78, 0x21, 0x4c, 0x76, 0xa6, 0xb3, 0x43, 0x37, 0x33, 0xdb, 0x39, 0x93, 0xd0, 0x52, 0x8a,
+	0xe0, 0x83, 0xb4, 0x0f, 0x8a, 0xe0, 0xbb, 0xf4, 0x51, 0x44, 0x41, 0x21, 0x15, 0x44, 0xec, 0x8b,
+	0x20, 0x45, 0x44, 0x4a, 0xa4, 0xa2, 0x3e, 0x54, 0x69, 0x04, 0x7d, 0xf1, 0x3f, 0x10, 0x91, 0x99,
+	0x39, 0x73, 0xcb, 0x5c, 0x76, 0x76, 0xb3, 0x0b, 0xe9, 0x4b, 0xc8, 0x9e, 0xf9, 0x2e, 0xbf, 0xdf,
+	0xef, 0x3b, 0xdf, 0x99, 0xf3, 0xed, 0x02, 0x5b, 0xd1, 0xc8, 0xa2, 0x46, 0x78, 0x62, 0x08, 0x97,
+	0x15, 0x55, 0xe6, 0x97, 0xc7, 0x17, 0x24, 0x43, 0x18, 0xe7, 0xaf, 0x2c, 0x49, 0xfa, 0x35, 0xae,
+	0xae, 0x6b, 0x86, 0x86, 0x07, 0x6c, 0x1b, 0x8e, 0xda, 0x70, 0xd4, 0x86, 0x19, 0xa1, 0xbe, 0x0b,
+	0x02, 0x91, 0x6c, 0x07, 0xd7, 0xbd, 0x2e, 0xc8, 0x8a, 0x2a, 0x18, 0x8a, 0xa6, 0xda, 0x31, 0x98,
+	0x9c, 0xac, 0xc9, 0x9a, 0xf5, 0x2f, 0x6f, 0xfe, 0x47, 0x57, 0x07, 0x65, 0x4d, 0x93, 0x6b, 0x12,
+	0x2f, 0xd4, 0x15, 0x5e, 0x50, 0x55, 0xcd, 0xb0, 0x5c, 0x08, 0x7d, 0xba, 0x2f, 0x06, 0x9b, 0x83,
+	0xc3, 0xb6, 0xda, 0x65, 0x5b, 0xcd, 0xdb, 0xc1, 0x29, 0x54, 0xfb, 0xd1, 0x6e, 0x1a, 0xc0, 0xc1,
+	0xe6, 0x67, 0xc5, 0x6c, 0x17, 0x16, 0x15, 0x55, 0xe3, 0xad, 0xbf, 0xf6, 0x12, 0x7b, 0x15, 0x06,
+	0x2e, 0x98, 0x16, 0x73, 0x42, 0x4d, 0x11, 0x05, 0x43, 0xd3, 0x49, 0x59, 0xba, 0xb2, 0x24, 0x11,
+	0x03, 0x0f, 0x40, 0x2f, 0x31, 0x04, 0x63, 0x89, 0xe4, 0x51, 0x11, 0x0d, 0xf7, 0x95, 0xe9, 0x27,
+	0xfc, 0x12, 0x80, 0x47, 0x35, 0x9f, 0x29, 0xa2, 0xe1, 0x2d, 0x13, 0xcf, 0x70, 0x14, 0x84, 0xa9,
+	0x0b, 0x67, 0xa7, 0xa4, 0xd0, 0xb9, 0x59, 0x41, 0x96, 0x68, 0xcc, 0xb2, 0xcf, 0x93, 0xad, 0xc2,
+	0x56, 0x37, 0xe9, 0x69, 0xf5, 0x92, 0x86, 0x4b, 0xb0, 0xbd, 0xa2, 0xa9, 0x44, 0x52, 0xc9, 0x12,
+	0x99, 0x17, 0x44, 0x51, 0x97, 0x08, 0xcd, 0x3d, 0x93, 0xfb, 0x6d, 0x65, 0xb4, 0xff, 0xaa, 0xa3,
+	0x42, 0x71, 0x79, 0x8c, 0x9b, 0xe0, 0xc6, 0xca, 0xfd, 0xae, 0x79, 0xc9, 0xb6, 0x9e, 0xce, 0xad,
+	0x46, 0xd8, 0xb1, 0xb7, 0x32, 0xb0, 0x33, 0x44, 0x92, 0xd4, 0x4d, 0x67, 0x7c, 0x0e, 0x60, 0xd9,
+	0x5d, 0xcd, 0xa3, 0x62, 0xf7, 0xf0, 0x96, 0x89, 0xbd, 0x5c, 0x74, 0xf5, 0x39, 0xd7, 0x7f, 0xa6,
+	0xef, 0xde, 0xc3, 0xa1, 0xae, 0x8f, 0xff, 0xfa, 0x7c, 0x04, 0x95, 0x7d, 0xfe, 0xf8, 0x55, 0xd8,
+	0xe6, 0x7e, 0x9a, 0x57, 0xd4, 0x4b, 0x5a, 0x3e, 0x63, 0x45, 0x7c, 0xba, 0x61, 0x44, 0x53, 0x01,
+	0x7f, 0xd4, 0xad, 0xcb, 0x01, 0x6d, 0x4e, 0x06, 0x44, 0xef, 0xb6, 0x44, 0x7f, 0xb6, 0xa1, 0xe8,
+	0x36, 0xc7, 0x80, 0xea, 0x02, 0xec, 0x08, 0x4a, 0xe1, 0x94, 0xfb, 0x94, 0x1f, 0xba, 0xa9, 0x3e,
+	0x95, 0x7e, 0xef, 0xea, 0xca, 0xe8, 0x1e, 0x9a, 0xc8, 0x75, 0xa2, 0x7a, 0x5f, 0x34, 0x74, 0x45,
+	0x95, 0x7d, 0x58, 0xcd, 0x75, 0x56, 0x5c, 0xbf, 0xa5, 0x5c, 0xb1, 0xcf, 0x40, 0x9f, 0x6b, 0x6a,
+	0x85, 0x6f, 0x56, 0x6b, 0xcf, 0x9d, 0x5d, 0x41, 0x50, 0x0c, 0xa6, 0x39, 0x21, 0xd5, 0x24, 0xd9,
+	0xee, 0xa6, 0xb6, 0x93, 0x6a, 0xdb, 0xae, 0xff, 0x07, 0xc1, 0xde, 0x04, 0xd8, 0x54, 0xa8, 0xb7,
+	0x21, 0x27, 0xba, 0xcb, 0xf3, 0x3a, 0x5d, 0x76, 0xf6, 0xe7, 0x48, 0x9c, 0x66, 0x5e, 0x28, 0x27,
+	0xd2, 0x4c, 0xd1, 0x14, 0xef, 0x93, 0xdf, 0x87, 0xb2, 0xe1, 0x67, 0xc4, 0xd6, 0x34, 0x2b, 0x86,
+	0x9f, 0xe0, 0x93, 0x11, 0x74, 0x5b, 0xda, 0x6f, 0xdf, 0x20, 0xd8, 0x1f, 0xe4, 0xfb, 0x8a, 0xba,
+	0xa0, 0xa9, 0xa2, 0xa2, 0xca, 0x8f, 0x45, 0xbd, 0x1e, 0x22, 0x18, 0x49, 0x83, 0x9f, 0x16, 0x4e,
+	0x86, 0xec, 0x92, 0xf3, 0x3c, 0x54, 0xb7, 0x03, 0x71, 0x75, 0x8b, 0x08, 0xe9, 0xdf, 0xf5, 0xd8,
+	0x0d, 0xd9, 0x81, 0x02, 0x7d, 0x86, 0x68, 0xbb, 0xfa, 0x37, 0x88, 0x5d, 0x8d, 0xa3, 0xb0, 0x8d,
+	0xee, 0x8d, 0x60, 0x35, 0xf2, 0xab, 0x2b, 0xa3, 0x39, 0x9a, 0x6a, 0x5d, 0x11, 0x5c, 0x7b, 0xab,
+	0x08, 0xe1, 0x72, 0x66, 0x5a, 0x2b, 0xe7, 0xf4, 0x93, 0x37, 0x6f, 0x0f, 0x75, 0xfd, 0x7d, 0x7b,
+	0xa8, 0x8b, 0x5d, 0x86, 0x9d, 0x21, 0xb8, 0x54, 0xfc, 0x37, 0x20, 0x1b, 0xd1, 0x35, 0xf4, 0xa0,
+	0x69, 0xa2, 0x69, 0xca, 0x38, 0xdc, 0x12, 0xec, 0x97, 0x08, 0x86, 0xac, 0xc4, 0x11, 0xc5, 0xda,
+	0xd4, 0x82, 0xe9, 0x50, 0x8c, 0xc7, 0x4d, 0x95, 0x3b, 0x0f, 0xbd, 0xf6, 0x1e, 0xa3, 0x62, 0xb5,
+	0xba, 0x53, 0x69, 0x14, 0xf6, 0x8e, 0x73, 0x38, 0x9f, 0x70, 0xe8, 0x45, 0x34, 0xfb, 0x86, 0xd5,
+	0x6a, 0x53, 0x8f, 0xfb, 0xb4, 0xfa, 0xd9, 0x39, 0x9d, 0xa3, 0x71, 0x53, 0xb5, 0xaa, 0x6d, 0x3b,
+	0x9d, 0x7d, 0xd2, 0x75, 0xf6, 0x18, 0xbe, 0xeb, 0x1c, 0xc3, 0x2e, 0xb1, 0xa4, 0x63, 0x78, 0x13,
+	0x56, 0xc6, 0x3d, 0x87, 0x1b, 0x10, 0x78, 0x6c, 0xcf, 0xe1, 0xbb, 0x19, 0xd8, 0x65, 0x11, 0x2c,
+	0x4b, 0x62, 0x47, 0x2a, 0x82, 0x89, 0x5e, 0x99, 0x8f, 0x3c, 0x5d, 0xe2, 0x83, 0xf4, 0x13, 0xbd,
+	0x32, 0xb7, 0xee, 0xbd, 0x8a, 0x45, 0x62, 0xac, 0x8f, 0xd3, 0xdd, 0x28, 0x8e, 0x48, 0x8c, 0xb9,
+	0x84, 0xf7, 0x73, 0x4f, 0x1b, 0x76, 0xc8, 0x03, 0x04, 0x4c, 0x94, 0x80, 0x74, 0x47, 0xa8, 0x30,
+	0xa0, 0x4b, 0x09, 0x6d, 0xfb, 0x5c, 0xdc, 0xa6, 0xf0, 0x87, 0x8b, 0x6a, 0xdc, 0x1d, 0xba, 0xd4,
+	0xd1, 0xd6, 0x5d, 0x71, 0x5e, 0x3c, 0xee, 0xce, 0x0f, 0xcf, 0x6a, 0x9b, 0xb0, 0x61, 0xbf, 0x0a,
+	0xbd, 0x02, 0x3a, 0x3e, 0x7d, 0xb5, 0x4d, 0xf2, 0x3b, 0x08, 0x0a, 0x31, 0xd8, 0x37, 0xf5, 0xab,
+	0x7e, 0x31, 0x76, 0xa7, 0x74, 0x64, 0x04, 0x9b, 0xa2, 0x0d, 0x77, 0x4a, 0x21, 0x86, 0xa6, 0x2b,
+	0x15, 0xa1, 0x66, 0xce, 0xaa, 0xbe, 0xef, 0x0f, 0xaa, 0x92, 0x22, 0x57, 0x0d, 0x2b, 0x4d, 0x77,
+	0x99, 0x7e, 0x9a, 0xce, 0xe4, 0x11, 0x2b, 0xc0, 0xee, 0x48, 0x4f, 0x0a, 0xf2, 0x08, 0xf4, 0x54,
+	0x15, 0x62, 0xe4, 0x51, 0x70, 0x1f, 0xae, 0xc7, 0x17, 0xf4, 0x9e, 0xc9, 0xe4, 0x51, 0xd9, 0xf2,
+	0xb3, 0x52, 0x60, 0xe8, 0xb7, 0x52, 0xcc, 0x6a, 0x5a, 0x8d, 0x42, 0x62, 0x67, 0x61, 0xbb, 0x6f,
+	0x8d, 0x26, 0x7b, 0x11, 0x7a, 0xea, 0x9a, 0x56, 0xa3, 0xc9, 0x06, 0xe3, 0x92, 0x99, 0x3e, 0x7e,
+	0x1d, 0x2c, 0x27, 0x36, 0x07, 0xd8, 0x8e, 0x28, 0xe8, 0xc2, 0xa2, 0xd3, 0x8e, 0xec, 0x6b, 0x90,
+	0x0d, 0xac, 0xd2, 0x4c, 0x25, 0xe8, 0xad, 0x5b, 0x2b, 0x34, 0x57, 0x21, 0x36, 0x97, 0x65, 0x15,
+	0xb8, 0x58, 0xd9, 0x8e, 0xec, 0x2d, 0x04, 0xc3, 0xc1, 0x71, 0xe4, 0x62, 0xa5, 0x2a, 0x89, 0x4b,
+	0x35, 0x49, 0x3c, 0xae, 0x2d, 0x2e, 0x2a, 0x84, 0xf8, 0xae, 0xa3, 0x6d, 0x9b, 0xa6, 0xa6, 0xb3,
+	0xab, 0x2b, 0xa3, 0x4f, 0xd9, 0x1e, 0xa3, 0x44, 0xbc, 0x5c, 0x1c, 0xe3, 0x0e, 0x4e, 0xb2, 0x5f,
+	0x84, 0x46, 0xbb, 0x48, 0x2c, 0x94, 0xbc, 0x06, 0x39, 0xe2, 0x3c, 0x9e, 0xaf, 0xb8, 0xcf, 0xa9,
+	0x14, 0xe3, 0x71, 0x52, 0x44, 0x84, 0x3c, 0x5e, 0x15, 0x54, 0x39, 0x78, 0x77, 0x22, 0x61, 0xab,
+	0x48, 0xcc, 0x13, 0xdf, 0xe6, 0xe1, 0x09, 0x0b, 0x33, 0xfe, 0x08, 0x01, 0x78, 0x27, 0x12, 0xe6,
+	0xe2, 0x00, 0x44, 0x7f, 0x3b, 0xc6, 0xf0, 0xa9, 0xed, 0xe9, 0xfc, 0xc0, 0xdf, 0x34, 0xa1, 0xbe,
+	0xf3, 0xd3, 0x9f, 0x1f, 0x66, 0xf6, 0x61, 0x96, 0x8f, 0xf9, 0x9e, 0xcf, 0x77, 0x9a, 0x7d, 0x8a,
+	0xa0, 0xcf, 0x8d, 0x83, 0x47, 0xd3, 0xe5, 0x73, 0xe0, 0x71, 0x69, 0xcd, 0x29, 0xba, 0x63, 0x1e,
+	0xba, 0x83, 0x78, 0xb2, 0x31, 0x3a, 0xfe, 0x7a, 0x70, 0x67, 0xdd, 0xc0, 0xbf, 0x22, 0xc8, 0x45,
+	0x7d, 0xa7, 0x81, 0xa7, 0xd2, 0x41, 0x09, 0x5f, 0x43, 0x99, 0x17, 0x5a, 0xf0, 0xa4, 0x7c, 0xce,
+	0x79, 0x7c, 0x4a, 0xf8, 0x68, 0x0b, 0x7c, 0x78, 0xdf, 0x1d, 0x02, 0xff, 0x87, 0x60, 0x4f, 0xe2,
+	0xfc, 0x8f, 0x4b, 0xe9, 0xa0, 0x26, 0x5c, 0xba, 0x99, 0x99, 0x8d, 0x84, 0xa0, 0xb4, 0xe7, 0x3c,
+	0xda, 0x67, 0xf1, 0xe9, 0x56, 0x68, 0x7b, 0xb7, 0x66, 0xbf, 0x00, 0x3f, 0x20, 0x00, 0x2f, 0x5f,
+	0x83, 0x66, 0x09, 0xcd, 0xc5, 0x0c, 0x9f, 0xda, 0x9e, 0xf2, 0x78, 0xcb, 0xe3, 0x51, 0xc6, 0xb3,
+	0x1b, 0x2c, 0x1f, 0x7f, 0x3d, 0xf8, 0xa6, 0xbe, 0x81, 0xff, 0x45, 0x90, 0x8d, 0xd0, 0x11, 0x1f,
+	0x4e, 0xc4, 0x19, 0x3f, 0xf8, 0x33, 0x53, 0xcd, 0x3b, 0x52, 0xa6, 0xba, 0xc7, 0x54, 0xc6, 0x52,
+	0xbb, 0x99, 0x46, 0x96, 0x13, 0xff, 0x88, 0x20, 0x17, 0x35, 0xe0, 0x36, 0x68, 0xd5, 0x84, 0x59,
+	0xbe, 0x41, 0xab, 0x26, 0x4d, 0xd3, 0x6c, 0xc9, 0x53, 0xe0, 0x10, 0x7e, 0x3e, 0x4e, 0x81, 0xc4,
+	0x7a, 0x9a, 0xfd, 0x99, 0x38, 0x17, 0x36, 0xe8, 0xcf, 0x34, 0x43, 0x71, 0x83, 0xfe, 0x4c, 0x35,
+	0x96, 0xa6, 0xec, 0x4f, 0x97, 0x5e, 0xca, 0x82, 0x12, 0xfc, 0x1d, 0x82, 0xad, 0x81, 0xb1, 0x07,
+	0x8f, 0x27, 0xa2, 0x8d, 0x9a, 0x31, 0x99, 0x89, 0x66, 0x5c, 0x28, 0xa1, 0xf3, 0x1e, 0xa1, 0xe3,
+	0xb8, 0xd4, 0x0a, 0x21, 0x3d, 0x00, 0xfb, 0x01, 0x82, 0x6c, 0xc4, 0xc0, 0xd0, 0xa0, 0x33, 0xe3,
+	0x27, 0x23, 0x66, 0xaa, 0x79, 0x47, 0x4a, 0xed, 0xac, 0x47, 0xed, 0x18, 0x3e, 0xd2, 0x0a, 0x35,
+	0xdf, 0xcb, 0x7c, 0x0d, 0x01, 0x0e, 0x27, 0xc3, 0x87, 0x9a, 0x44, 0xe7, 0xb0, 0x3a, 0xdc, 0xb4,
+	0x1f, 0x25, 0xf5, 0xa6, 0x47, 0xea, 0x02, 0x7e, 0x79, 0x63, 0xa4, 0xc2, 0x77, 0x80, 0xaf, 0x11,
+	0x6c, 0x0b, 0x5e, 0xca, 0x71, 0xf2, 0xa6, 0x8a, 0x9c, 0x1c, 0x98, 0xc9, 0xa6, 0x7c, 0xc2, 0x37,
+	0x98, 0x09, 0x3c, 0x16, 0xc7, 0xac, 0xea, 0x3a, 0x5b, 0x3f, 0xcf, 0xf1, 0xd7, 0xed, 0xa1, 0xe4,
+	0xc6, 0xcd, 0x0c, 0xc2, 0xef, 0x22, 0xe8, 0x31, 0x6f, 0xf9, 0x78, 0x38, 0x31, 0xbf, 0x6f, 0xa0,
+	0x60, 0xf6, 0xa7, 0xb0, 0xa4, 0xf8, 0xf6, 0x7b, 0xf8, 0x0a, 0x78, 0x30, 0x0e, 0x9f, 0x39, 0x54,
+	0xe0, 0xf7, 0x11, 0xf4, 0xda, 0x23, 0x00, 0x1e, 0x49, 0x4e, 0xe0, 0x9f, 0x3a, 0x98, 0x03, 0xa9,
+	0x6c, 0x29, 0x9c, 0x03, 0x1e, 0x9c, 0x22, 0x2e, 0xc4, 0xc2, 0xb1, 0x51, 0xbc, 0x97, 0x81, 0xc1,
+	0xa4, 0x4b, 0x3e, 0x3e, 0x96, 0xee, 0xee, 0x12, 0x3f, 0xab, 0x30, 0xa5, 0x0d, 0x44, 0xa0, 0x94,
+	0xaa, 0xdf, 0x87, 0x2f, 0xfc, 0x1e, 0xcb, 0x33, 0xf8, 0x54, 0x2b, 0x6f, 0xd7, 0xa8, 0x99, 0x65,
+	0xe6, 0xd0, 0xbd, 0x47, 0x05, 0x74, 0xff, 0x51, 0x01, 0xfd, 0xf1, 0xa8, 0x80, 0x3e, 0x58, 0x2b,
+	0x74, 0xdd, 0x5f, 0x2b, 0x74, 0xfd, 0xb2, 0x56, 0xe8, 0x7a, 0x7d, 0xd0, 0x4e, 0x41, 0xc4, 0xcb,
+	0x9c, 0xa2, 0xf1, 0xee, 0x2f, 0xd1, 0xbc, 0x71, 0xad, 0x2e, 0x91, 0x85, 0x5e, 0xeb, 0x37, 0xf7,
+	0xc9, 0xff, 0x07, 0x00, 0xe3, 0x3f, 0xe1, 0x87, 0x82, 0x20, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1649,6 +1758,8 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ValidatorScheduledCommission queries the scheduled commission change of a validator.
+	ValidatorScheduledCommission(ctx context.Context, in *QueryValidatorScheduledCommissionRequest, opts ...grpc.CallOption) (*QueryValidatorScheduledCommissionResponse, error)
 }
 
 type queryClient struct {
@@ -1786,6 +1897,15 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ValidatorScheduledCommission(ctx context.Context, in *QueryValidatorScheduledCommissionRequest, opts ...grpc.CallOption) (*QueryValidatorScheduledCommissionResponse, error) {
+	out := new(QueryValidatorScheduledCommissionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/ValidatorScheduledCommission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Validators queries all validators that match the given status.
@@ -1841,6 +1961,8 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ValidatorScheduledCommission queries the scheduled commission change of a validator.
+	ValidatorScheduledCommission(context.Context, *QueryValidatorScheduledCommissionRequest) (*QueryValidatorScheduledCommissionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1889,6 +2011,9 @@ func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) ValidatorScheduledCommission(ctx context.Context, req *QueryValidatorScheduledCommissionRequest) (*QueryValidatorScheduledCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorScheduledCommission not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2146,6 +2271,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorScheduledCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorScheduledCommissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorScheduledCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/ValidatorScheduledCommission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorScheduledCommission(ctx, req.(*QueryValidatorScheduledCommissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.staking.v1beta1.Query",
@@ -2207,6 +2350,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "ValidatorScheduledCommission",
+			Handler:    _Query_ValidatorScheduledCommission_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
@@ -3357,6 +3504,69 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryValidatorScheduledCommissionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorScheduledCommissionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorScheduledCommissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorScheduledCommissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorScheduledCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorScheduledCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ScheduledCommission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3822,6 +4032,30 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryValidatorScheduledCommissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorScheduledCommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScheduledCommission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6854,6 +7088,171 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryValidatorScheduledCommissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorScheduledCommissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorScheduledCommissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorScheduledCommissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorScheduledCommissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorScheduledCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledCommission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScheduledCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -716,6 +716,261 @@ func (m *MsgRotateConsPubKeyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRotateConsPubKeyResponse proto.InternalMessageInfo
 
+// MsgTokenizeShares defines a SDK message for tokenizing a delegation into
+// liquid staking share tokens.
+type MsgTokenizeShares struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// amount is the amount of delegated bond tokens to tokenize.
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgTokenizeShares) Reset()         { *m = MsgTokenizeShares{} }
+func (m *MsgTokenizeShares) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenizeShares) ProtoMessage()    {}
+func (*MsgTokenizeShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{16}
+}
+func (m *MsgTokenizeShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenizeShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenizeShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenizeShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenizeShares.Merge(m, src)
+}
+func (m *MsgTokenizeShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenizeShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenizeShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenizeShares proto.InternalMessageInfo
+
+// MsgTokenizeSharesResponse defines the Msg/TokenizeShares response type.
+type MsgTokenizeSharesResponse struct {
+	// amount is the amount of minted share tokens.
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgTokenizeSharesResponse) Reset()         { *m = MsgTokenizeSharesResponse{} }
+func (m *MsgTokenizeSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenizeSharesResponse) ProtoMessage()    {}
+func (*MsgTokenizeSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{17}
+}
+func (m *MsgTokenizeSharesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenizeSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenizeSharesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenizeSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenizeSharesResponse.Merge(m, src)
+}
+func (m *MsgTokenizeSharesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenizeSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenizeSharesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenizeSharesResponse proto.InternalMessageInfo
+
+func (m *MsgTokenizeSharesResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// MsgRedeemTokensForShares defines a SDK message for redeeming liquid staking
+// share tokens back into a delegation.
+type MsgRedeemTokensForShares struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// amount is the amount of share tokens to redeem.
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgRedeemTokensForShares) Reset()         { *m = MsgRedeemTokensForShares{} }
+func (m *MsgRedeemTokensForShares) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemTokensForShares) ProtoMessage()    {}
+func (*MsgRedeemTokensForShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{18}
+}
+func (m *MsgRedeemTokensForShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemTokensForShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemTokensForShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedeemTokensForShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemTokensForShares.Merge(m, src)
+}
+func (m *MsgRedeemTokensForShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemTokensForShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemTokensForShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedeemTokensForShares proto.InternalMessageInfo
+
+// MsgRedeemTokensForSharesResponse defines the Msg/RedeemTokensForShares response type.
+type MsgRedeemTokensForSharesResponse struct {
+	// amount is the amount of bond tokens delegated back to the delegator.
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgRedeemTokensForSharesResponse) Reset()         { *m = MsgRedeemTokensForSharesResponse{} }
+func (m *MsgRedeemTokensForSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemTokensForSharesResponse) ProtoMessage()    {}
+func (*MsgRedeemTokensForSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{19}
+}
+func (m *MsgRedeemTokensForSharesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemTokensForSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemTokensForSharesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedeemTokensForSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemTokensForSharesResponse.Merge(m, src)
+}
+func (m *MsgRedeemTokensForSharesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemTokensForSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemTokensForSharesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedeemTokensForSharesResponse proto.InternalMessageInfo
+
+func (m *MsgRedeemTokensForSharesResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// MsgTransferDelegation defines a SDK message for transferring the ownership of
+// a delegation to another account.
+type MsgTransferDelegation struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	RecipientAddress string `protobuf:"bytes,3,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+	// amount is the amount of delegated bond tokens to transfer.
+	Amount types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgTransferDelegation) Reset()         { *m = MsgTransferDelegation{} }
+func (m *MsgTransferDelegation) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferDelegation) ProtoMessage()    {}
+func (*MsgTransferDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{20}
+}
+func (m *MsgTransferDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferDelegation.Merge(m, src)
+}
+func (m *MsgTransferDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferDelegation proto.InternalMessageInfo
+
+// MsgTransferDelegationResponse defines the Msg/TransferDelegation response type.
+type MsgTransferDelegationResponse struct {
+}
+
+func (m *MsgTransferDelegationResponse) Reset()         { *m = MsgTransferDelegationResponse{} }
+func (m *MsgTransferDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferDelegationResponse) ProtoMessage()    {}
+func (*MsgTransferDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{21}
+}
+func (m *MsgTransferDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferDelegationResponse.Merge(m, src)
+}
+func (m *MsgTransferDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferDelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferDelegationResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateValidator)(nil), "cosmos.staking.v1beta1.MsgCreateValidator")
 	proto.RegisterType((*MsgCreateValidatorResponse)(nil), "cosmos.staking.v1beta1.MsgCreateValidatorResponse")
@@ -733,92 +988,111 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.staking.v1beta1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgRotateConsPubKey)(nil), "cosmos.staking.v1beta1.MsgRotateConsPubKey")
 	proto.RegisterType((*MsgRotateConsPubKeyResponse)(nil), "cosmos.staking.v1beta1.MsgRotateConsPubKeyResponse")
+	proto.RegisterType((*MsgTokenizeShares)(nil), "cosmos.staking.v1beta1.MsgTokenizeShares")
+	proto.RegisterType((*MsgTokenizeSharesResponse)(nil), "cosmos.staking.v1beta1.MsgTokenizeSharesResponse")
+	proto.RegisterType((*MsgRedeemTokensForShares)(nil), "cosmos.staking.v1beta1.MsgRedeemTokensForShares")
+	proto.RegisterType((*MsgRedeemTokensForSharesResponse)(nil), "cosmos.staking.v1beta1.MsgRedeemTokensForSharesResponse")
+	proto.RegisterType((*MsgTransferDelegation)(nil), "cosmos.staking.v1beta1.MsgTransferDelegation")
+	proto.RegisterType((*MsgTransferDelegationResponse)(nil), "cosmos.staking.v1beta1.MsgTransferDelegationResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/staking/v1beta1/tx.proto", fileDescriptor_0926ef28816b35ab) }
 
 var fileDescriptor_0926ef28816b35ab = []byte{
-	// 1277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x41, 0x4f, 0x1b, 0x47,
-	0x14, 0xf6, 0xda, 0x09, 0x29, 0x43, 0xc1, 0xb0, 0x40, 0x62, 0x16, 0x6a, 0xd3, 0x0d, 0x15, 0x94,
-	0xca, 0x6b, 0x20, 0x01, 0x54, 0x27, 0xaa, 0x82, 0x21, 0x6d, 0xd3, 0x96, 0x16, 0x2d, 0x21, 0x95,
-	0xaa, 0xb6, 0xee, 0x78, 0x77, 0x58, 0x56, 0x78, 0x77, 0x9c, 0x9d, 0x31, 0x89, 0x0f, 0x95, 0xaa,
-	0x9e, 0x9a, 0x9e, 0x72, 0xaf, 0x2a, 0xa5, 0x52, 0x2b, 0xf5, 0xc8, 0x81, 0x63, 0x7f, 0x40, 0x94,
-	0x53, 0x44, 0x2f, 0x51, 0x0e, 0xb4, 0x82, 0x03, 0xfd, 0x0f, 0xb9, 0x54, 0xbb, 0x3b, 0x5e, 0x7b,
-	0xd7, 0xde, 0xc5, 0xd0, 0xe6, 0x92, 0x4b, 0x62, 0xde, 0x7c, 0xf3, 0xcd, 0xcc, 0xf7, 0xbe, 0x79,
-	0xf3, 0x16, 0x64, 0x14, 0x4c, 0x0c, 0x4c, 0x72, 0x84, 0xc2, 0x6d, 0xdd, 0xd4, 0x72, 0x3b, 0xb3,
-	0x25, 0x44, 0xe1, 0x6c, 0x8e, 0xde, 0x97, 0x2a, 0x16, 0xa6, 0x98, 0xbf, 0xe8, 0x02, 0x24, 0x06,
-	0x90, 0x18, 0x40, 0x18, 0xd1, 0x30, 0xd6, 0xca, 0x28, 0xe7, 0xa0, 0x4a, 0xd5, 0xcd, 0x1c, 0x34,
-	0x6b, 0xee, 0x14, 0x21, 0x13, 0x1c, 0xa2, 0xba, 0x81, 0x08, 0x85, 0x46, 0x85, 0x01, 0x86, 0x34,
-	0xac, 0x61, 0xe7, 0x67, 0xce, 0xfe, 0xc5, 0xa2, 0x23, 0xee, 0x4a, 0x45, 0x77, 0x80, 0x2d, 0xeb,
-	0x0e, 0xa5, 0xd9, 0x2e, 0x4b, 0x90, 0x20, 0x6f, 0x8b, 0x0a, 0xd6, 0x4d, 0x36, 0x3e, 0x11, 0x72,
-	0x8a, 0xfa, 0xa6, 0x5d, 0xd4, 0x25, 0x86, 0x32, 0x88, 0x8d, 0xb0, 0xff, 0x63, 0x03, 0x03, 0xd0,
-	0xd0, 0x4d, 0x9c, 0x73, 0xfe, 0x75, 0x43, 0xe2, 0x8b, 0x73, 0x80, 0x5f, 0x25, 0xda, 0xb2, 0x85,
-	0x20, 0x45, 0x77, 0x60, 0x59, 0x57, 0x21, 0xc5, 0x16, 0xbf, 0x06, 0x7a, 0x54, 0x44, 0x14, 0x4b,
-	0xaf, 0x50, 0x1d, 0x9b, 0x29, 0x6e, 0x9c, 0x9b, 0xea, 0x99, 0xbb, 0x2c, 0xb5, 0xd7, 0x48, 0x5a,
-	0x69, 0x40, 0x0b, 0xdd, 0x8f, 0x0f, 0x32, 0xb1, 0xdf, 0x8f, 0x77, 0xa7, 0x39, 0xb9, 0x99, 0x82,
-	0x97, 0x01, 0x50, 0xb0, 0x61, 0xe8, 0x84, 0xd8, 0x84, 0x71, 0x87, 0x70, 0x32, 0x8c, 0x70, 0xd9,
-	0x43, 0xca, 0x90, 0x22, 0xd2, 0x4c, 0xda, 0xc4, 0xc2, 0x7f, 0x03, 0x06, 0x0d, 0xdd, 0x2c, 0x12,
-	0x54, 0xde, 0x2c, 0xaa, 0xa8, 0x8c, 0x34, 0xe8, 0xec, 0x36, 0x31, 0xce, 0x4d, 0x75, 0x17, 0x66,
-	0xec, 0x39, 0xcf, 0x0f, 0x32, 0xc3, 0xee, 0x1a, 0x44, 0xdd, 0x96, 0x74, 0x9c, 0x33, 0x20, 0xdd,
-	0x92, 0x6e, 0x99, 0x74, 0x7f, 0x2f, 0x0b, 0xd8, 0xe2, 0xb7, 0x4c, 0xea, 0x52, 0x0f, 0x18, 0xba,
-	0xb9, 0x8e, 0xca, 0x9b, 0x2b, 0x1e, 0x15, 0xff, 0x01, 0x18, 0x60, 0xc4, 0xd8, 0x2a, 0x42, 0x55,
-	0xb5, 0x10, 0x21, 0xa9, 0x73, 0x0e, 0xbf, 0xb0, 0xbf, 0x97, 0x1d, 0x62, 0x14, 0x4b, 0xee, 0xc8,
-	0x3a, 0xb5, 0x74, 0x53, 0x4b, 0x71, 0x72, 0xbf, 0x37, 0x89, 0x8d, 0xf0, 0x9f, 0x82, 0x81, 0x9d,
-	0xba, 0xba, 0x1e, 0xd1, 0x79, 0x87, 0xe8, 0xcd, 0xfd, 0xbd, 0xec, 0x1b, 0x8c, 0xc8, 0xcb, 0x80,
-	0x8f, 0x51, 0xee, 0xdf, 0x09, 0xc4, 0xf9, 0xf7, 0x41, 0x57, 0xa5, 0x5a, 0xda, 0x46, 0xb5, 0x54,
-	0x97, 0x23, 0xe5, 0x90, 0xe4, 0x9a, 0x51, 0xaa, 0x9b, 0x51, 0x5a, 0x32, 0x6b, 0x85, 0xd4, 0x93,
-	0xc6, 0x1e, 0x15, 0xab, 0x56, 0xa1, 0x58, 0x5a, 0xab, 0x96, 0x3e, 0x46, 0x35, 0x99, 0xcd, 0xe6,
-	0xf3, 0xe0, 0xfc, 0x0e, 0x2c, 0x57, 0x51, 0xea, 0x82, 0x43, 0x33, 0x52, 0xcf, 0x88, 0xed, 0xc0,
-	0xa6, 0x74, 0xe8, 0xbe, 0xc4, 0xba, 0x53, 0xf2, 0x37, 0x7e, 0x78, 0x94, 0x89, 0xfd, 0xf3, 0x28,
-	0x13, 0xfb, 0xfe, 0x78, 0x77, 0xba, 0xf5, 0x78, 0x3f, 0x1e, 0xef, 0x4e, 0xb3, 0x73, 0x65, 0x89,
-	0xba, 0x9d, 0x6b, 0xb5, 0x99, 0x38, 0x06, 0x84, 0xd6, 0xa8, 0x8c, 0x48, 0x05, 0x9b, 0x04, 0x89,
-	0xbf, 0x25, 0x40, 0xff, 0x2a, 0xd1, 0x6e, 0xaa, 0x3a, 0x7d, 0x99, 0xce, 0x6c, 0x9b, 0x9a, 0xf8,
-	0xd9, 0x53, 0x73, 0x07, 0x24, 0x1b, 0x1e, 0x2d, 0x5a, 0x90, 0x22, 0xe6, 0xc8, 0xec, 0xf3, 0x83,
-	0xcc, 0x68, 0xab, 0x1b, 0x3f, 0x41, 0x1a, 0x54, 0x6a, 0x2b, 0x48, 0x69, 0xf2, 0xe4, 0x0a, 0x52,
-	0xe4, 0x3e, 0xc5, 0x77, 0x0b, 0xf8, 0xcf, 0xdb, 0xbb, 0xdd, 0x75, 0xe3, 0x64, 0x87, 0x4e, 0x6f,
-	0x63, 0xf2, 0xfc, 0x7b, 0x27, 0xe7, 0x71, 0xd4, 0x9f, 0x47, 0x5f, 0x4a, 0x44, 0x01, 0xa4, 0x82,
-	0x31, 0x2f, 0x87, 0x3f, 0xc7, 0x41, 0xcf, 0x2a, 0xd1, 0xd8, 0x6a, 0x88, 0xbf, 0xd9, 0xee, 0x42,
-	0x71, 0xce, 0x11, 0x52, 0x61, 0x17, 0xaa, 0xd3, 0xeb, 0xf4, 0x1f, 0x72, 0x76, 0x1d, 0x74, 0x41,
-	0x03, 0x57, 0x4d, 0xea, 0xa4, 0xaa, 0xd3, 0x7b, 0xc0, 0xe6, 0xe4, 0xdf, 0xf5, 0x09, 0xd8, 0x72,
-	0x3e, 0x5b, 0xc0, 0x8b, 0x7e, 0x01, 0xeb, 0x7a, 0x88, 0xc3, 0x60, 0xb0, 0xe9, 0x4f, 0x4f, 0xb6,
-	0x07, 0x09, 0xa7, 0x2c, 0x17, 0x90, 0xa6, 0x9b, 0x32, 0x52, 0xff, 0x67, 0xf5, 0x36, 0xc0, 0x70,
-	0x43, 0x3d, 0x62, 0x29, 0xa7, 0x57, 0x70, 0xd0, 0x9b, 0xbf, 0x6e, 0x29, 0x6d, 0x69, 0x55, 0x42,
-	0x3d, 0xda, 0xc4, 0xe9, 0x69, 0x57, 0x08, 0x6d, 0xcd, 0xcd, 0xb9, 0x33, 0xe4, 0xe6, 0xc6, 0xc9,
-	0xb9, 0x09, 0x14, 0xa9, 0x80, 0xe8, 0x62, 0xc5, 0x29, 0x52, 0x81, 0x68, 0x3d, 0x53, 0xbc, 0xec,
-	0xdc, 0xf6, 0x4a, 0x19, 0xd9, 0x57, 0xa9, 0x68, 0x77, 0x00, 0xac, 0x26, 0x09, 0x2d, 0x15, 0xf9,
-	0x76, 0xbd, 0x3d, 0x28, 0xf4, 0xda, 0xfb, 0x7c, 0xf8, 0x57, 0x86, 0x73, 0xf7, 0xda, 0xd7, 0x60,
-	0xb0, 0x31, 0xe2, 0x2f, 0x71, 0xd0, 0xbb, 0x4a, 0xb4, 0x0d, 0x53, 0x7d, 0xa5, 0xaf, 0xcd, 0xb5,
-	0x93, 0x53, 0x93, 0xf2, 0xa7, 0xa6, 0xa1, 0x88, 0xf8, 0x07, 0x07, 0x86, 0x7d, 0x91, 0x97, 0x99,
-	0x11, 0xfe, 0x33, 0xef, 0xa0, 0xf1, 0x93, 0x0e, 0x3a, 0xe6, 0xf4, 0x1d, 0x7b, 0xd9, 0x64, 0x63,
-	0xeb, 0xe3, 0x33, 0xd2, 0xfc, 0x8c, 0xef, 0xec, 0xe2, 0x8b, 0x38, 0x18, 0xb3, 0x9f, 0x3e, 0x68,
-	0x2a, 0xa8, 0xbc, 0x61, 0x96, 0xb0, 0xa9, 0xea, 0xa6, 0xd6, 0xd4, 0x79, 0xbc, 0x8a, 0x19, 0xe7,
-	0x27, 0x41, 0x52, 0xb1, 0x1f, 0x7b, 0x3b, 0x31, 0x5b, 0x48, 0xd7, 0xb6, 0xdc, 0x3b, 0x9d, 0x90,
-	0xfb, 0xea, 0xe1, 0x0f, 0x9d, 0x68, 0xfe, 0xab, 0xba, 0x35, 0xf6, 0x83, 0x42, 0x5e, 0x5d, 0x08,
-	0x77, 0xcb, 0x64, 0xa0, 0xdb, 0x08, 0x13, 0x57, 0xbc, 0x06, 0x26, 0xa2, 0xc6, 0xeb, 0x56, 0xca,
-	0x0f, 0xb6, 0x59, 0x5e, 0x7c, 0xc6, 0x81, 0xa4, 0xed, 0xbc, 0x8a, 0x0a, 0x29, 0x5a, 0x83, 0x16,
-	0x34, 0x08, 0xbf, 0x00, 0xba, 0x61, 0x95, 0x6e, 0x61, 0x4b, 0xa7, 0xb5, 0x13, 0xb3, 0xd4, 0x80,
-	0xf2, 0x4b, 0xa0, 0xab, 0xe2, 0x30, 0x30, 0x5f, 0xa5, 0xc3, 0x1a, 0x19, 0x77, 0x1d, 0x9f, 0xa6,
-	0xee, 0xc4, 0xfc, 0x47, 0xad, 0x7b, 0x5c, 0xb4, 0x25, 0x6a, 0xac, 0x62, 0x4b, 0x33, 0xd1, 0x24,
-	0xcd, 0x7d, 0xef, 0xfb, 0x21, 0x70, 0x0c, 0x51, 0x02, 0x97, 0x02, 0xa1, 0x28, 0x29, 0x16, 0xc5,
-	0x9f, 0xe2, 0xce, 0xf3, 0x25, 0x63, 0x0a, 0x29, 0x5a, 0xc6, 0x26, 0x71, 0xbb, 0xcb, 0xf6, 0xae,
-	0xe3, 0xce, 0xee, 0xba, 0xaf, 0x01, 0x30, 0xd1, 0xbd, 0x22, 0xeb, 0x78, 0xe3, 0x11, 0x1d, 0xef,
-	0xdb, 0x61, 0x1d, 0xef, 0xfe, 0x5e, 0xb6, 0x97, 0xc5, 0x59, 0x0b, 0xdc, 0x6d, 0xa2, 0x7b, 0x6b,
-	0x0e, 0x63, 0xfe, 0x76, 0xa8, 0xdd, 0xe6, 0x67, 0xc3, 0x9b, 0xa2, 0xb4, 0xdf, 0x6e, 0x41, 0x15,
-	0xc4, 0x39, 0x30, 0xda, 0x26, 0x1c, 0xa1, 0xe8, 0xfc, 0xec, 0xdc, 0x9f, 0x17, 0x40, 0x62, 0x95,
-	0x68, 0xfc, 0x5d, 0x90, 0x0c, 0x7e, 0x93, 0x4d, 0x87, 0x79, 0xa3, 0xb5, 0x85, 0x16, 0xe6, 0x3a,
-	0xc7, 0x7a, 0x75, 0x73, 0x1b, 0xf4, 0xfa, 0x5b, 0xed, 0xa9, 0x08, 0x12, 0x1f, 0x52, 0x98, 0xe9,
-	0x14, 0xe9, 0x2d, 0xf6, 0x25, 0x78, 0xcd, 0xeb, 0x09, 0x2f, 0x47, 0xcc, 0xae, 0x83, 0x84, 0x77,
-	0x3a, 0x00, 0x79, 0xec, 0x77, 0x41, 0x32, 0xd8, 0x3a, 0x45, 0xa9, 0x17, 0xc0, 0x46, 0xaa, 0x17,
-	0xd6, 0x07, 0x94, 0x00, 0x68, 0x7a, 0xaf, 0xdf, 0x8a, 0x60, 0x68, 0xc0, 0x84, 0x6c, 0x47, 0x30,
-	0x6f, 0x8d, 0x5f, 0x39, 0x30, 0x12, 0xfe, 0x62, 0x5c, 0x8d, 0xca, 0x79, 0xd8, 0x2c, 0xe1, 0xfa,
-	0x59, 0x66, 0x79, 0x7d, 0xea, 0xe0, 0x93, 0xd6, 0x02, 0xc9, 0x7f, 0x0b, 0x5e, 0xf7, 0x15, 0xc7,
-	0xc9, 0xa8, 0x53, 0x36, 0x01, 0x85, 0x5c, 0x87, 0xc0, 0xa8, 0xe5, 0x17, 0xf9, 0x07, 0x1c, 0xe8,
-	0x6f, 0xa9, 0x48, 0x51, 0xf6, 0x09, 0x82, 0x85, 0x2b, 0xa7, 0x00, 0x47, 0xec, 0x65, 0x7e, 0x56,
-	0x38, 0xff, 0x9d, 0x5d, 0xab, 0x0b, 0x0b, 0x8f, 0x0f, 0xd3, 0xdc, 0xd3, 0xc3, 0x34, 0xf7, 0xf7,
-	0x61, 0x9a, 0x7b, 0x78, 0x94, 0x8e, 0x3d, 0x3d, 0x4a, 0xc7, 0x9e, 0x1d, 0xa5, 0x63, 0x5f, 0x8c,
-	0xf9, 0xbe, 0xd9, 0x1a, 0x95, 0x99, 0xd6, 0x2a, 0x88, 0x94, 0xba, 0x9c, 0xda, 0x76, 0xe5, 0xdf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x0b, 0xcb, 0xe0, 0xf9, 0xbe, 0x12, 0x00, 0x00,
+	// 1479 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xdf, 0x6f, 0x14, 0xd5,
+	0x17, 0xef, 0xec, 0x42, 0xbf, 0xdf, 0x9e, 0xda, 0x5f, 0x53, 0x0a, 0xdb, 0xa1, 0xec, 0xd6, 0x01,
+	0x53, 0xa8, 0xd9, 0xd9, 0xfe, 0xa0, 0xa0, 0x0b, 0x31, 0x50, 0x0a, 0x8a, 0x5a, 0x6d, 0xa6, 0x05,
+	0xa3, 0x51, 0xd7, 0xd9, 0x99, 0xdb, 0xe9, 0xa4, 0x3b, 0x77, 0x96, 0xb9, 0x77, 0x0b, 0x6b, 0x62,
+	0x62, 0x34, 0x31, 0xa2, 0x0f, 0xf2, 0x68, 0x62, 0x4c, 0x30, 0xd1, 0xc4, 0x47, 0x1e, 0xfa, 0xc8,
+	0x1f, 0x40, 0x78, 0x22, 0x7d, 0x22, 0x3c, 0xa0, 0x81, 0x07, 0xfc, 0x1b, 0xe4, 0xc5, 0xcc, 0x8f,
+	0x9d, 0xdd, 0xf9, 0xd9, 0xdd, 0x15, 0x12, 0xc3, 0x0b, 0x6c, 0xcf, 0xfd, 0x9c, 0x73, 0xcf, 0xfd,
+	0x9c, 0xcf, 0x99, 0x7b, 0x66, 0x20, 0x27, 0x1b, 0x44, 0x37, 0x48, 0x81, 0x50, 0x69, 0x53, 0xc3,
+	0x6a, 0x61, 0x6b, 0xb6, 0x8c, 0xa8, 0x34, 0x5b, 0xa0, 0xd7, 0x84, 0xaa, 0x69, 0x50, 0x83, 0xdd,
+	0xef, 0x00, 0x04, 0x17, 0x20, 0xb8, 0x00, 0x6e, 0x5c, 0x35, 0x0c, 0xb5, 0x82, 0x0a, 0x36, 0xaa,
+	0x5c, 0x5b, 0x2f, 0x48, 0xb8, 0xee, 0xb8, 0x70, 0xb9, 0xe0, 0x12, 0xd5, 0x74, 0x44, 0xa8, 0xa4,
+	0x57, 0x5d, 0xc0, 0x3e, 0xd5, 0x50, 0x0d, 0xfb, 0x67, 0xc1, 0xfa, 0xe5, 0x5a, 0xc7, 0x9d, 0x9d,
+	0x4a, 0xce, 0x82, 0xbb, 0xad, 0xb3, 0x94, 0x75, 0xb3, 0x2c, 0x4b, 0x04, 0x79, 0x29, 0xca, 0x86,
+	0x86, 0xdd, 0xf5, 0x23, 0x31, 0xa7, 0x68, 0x24, 0xed, 0xa0, 0x0e, 0xb8, 0x28, 0x9d, 0x58, 0x08,
+	0xeb, 0x3f, 0x77, 0x61, 0x44, 0xd2, 0x35, 0x6c, 0x14, 0xec, 0x7f, 0x1d, 0x13, 0xff, 0x74, 0x0f,
+	0xb0, 0xcb, 0x44, 0x3d, 0x67, 0x22, 0x89, 0xa2, 0xcb, 0x52, 0x45, 0x53, 0x24, 0x6a, 0x98, 0xec,
+	0x0a, 0xf4, 0x2b, 0x88, 0xc8, 0xa6, 0x56, 0xa5, 0x9a, 0x81, 0x33, 0xcc, 0x24, 0x73, 0xb4, 0x7f,
+	0xee, 0xb0, 0x10, 0xcd, 0x91, 0xb0, 0xd4, 0x84, 0x2e, 0xf6, 0xdd, 0x79, 0x98, 0xeb, 0xf9, 0xfd,
+	0xc9, 0xad, 0x69, 0x46, 0x6c, 0x0d, 0xc1, 0x8a, 0x00, 0xb2, 0xa1, 0xeb, 0x1a, 0x21, 0x56, 0xc0,
+	0x94, 0x1d, 0x70, 0x2a, 0x2e, 0xe0, 0x39, 0x0f, 0x29, 0x4a, 0x14, 0x91, 0xd6, 0xa0, 0x2d, 0x51,
+	0xd8, 0xcf, 0x60, 0x54, 0xd7, 0x70, 0x89, 0xa0, 0xca, 0x7a, 0x49, 0x41, 0x15, 0xa4, 0x4a, 0x76,
+	0xb6, 0xe9, 0x49, 0xe6, 0x68, 0xdf, 0xe2, 0x8c, 0xe5, 0xf3, 0xe0, 0x61, 0x6e, 0xcc, 0xd9, 0x83,
+	0x28, 0x9b, 0x82, 0x66, 0x14, 0x74, 0x89, 0x6e, 0x08, 0x17, 0x31, 0xdd, 0xd9, 0xce, 0x83, 0xbb,
+	0xf9, 0x45, 0x4c, 0x9d, 0xd0, 0x23, 0xba, 0x86, 0x57, 0x51, 0x65, 0x7d, 0xc9, 0x0b, 0xc5, 0xbe,
+	0x09, 0x23, 0x6e, 0x60, 0xc3, 0x2c, 0x49, 0x8a, 0x62, 0x22, 0x42, 0x32, 0x7b, 0xec, 0xf8, 0xdc,
+	0xce, 0x76, 0x7e, 0x9f, 0x1b, 0xe2, 0xac, 0xb3, 0xb2, 0x4a, 0x4d, 0x0d, 0xab, 0x19, 0x46, 0x1c,
+	0xf6, 0x9c, 0xdc, 0x15, 0xf6, 0x3d, 0x18, 0xd9, 0x6a, 0xb0, 0xeb, 0x05, 0xda, 0x6b, 0x07, 0x7a,
+	0x79, 0x67, 0x3b, 0x7f, 0xc8, 0x0d, 0xe4, 0x55, 0xc0, 0x17, 0x51, 0x1c, 0xde, 0x0a, 0xd8, 0xd9,
+	0x0b, 0xd0, 0x5b, 0xad, 0x95, 0x37, 0x51, 0x3d, 0xd3, 0x6b, 0x53, 0xb9, 0x4f, 0x70, 0xc4, 0x28,
+	0x34, 0xc4, 0x28, 0x9c, 0xc5, 0xf5, 0xc5, 0xcc, 0xdd, 0x66, 0x8e, 0xb2, 0x59, 0xaf, 0x52, 0x43,
+	0x58, 0xa9, 0x95, 0xdf, 0x41, 0x75, 0xd1, 0xf5, 0x66, 0x8b, 0xb0, 0x77, 0x4b, 0xaa, 0xd4, 0x50,
+	0xe6, 0x7f, 0x76, 0x98, 0xf1, 0x46, 0x45, 0x2c, 0x05, 0xb6, 0x94, 0x43, 0xf3, 0x15, 0xd6, 0x71,
+	0x29, 0x9e, 0xf9, 0xf6, 0x66, 0xae, 0xe7, 0xaf, 0x9b, 0xb9, 0x9e, 0xaf, 0x9e, 0xdc, 0x9a, 0x0e,
+	0x1f, 0xef, 0xbb, 0x27, 0xb7, 0xa6, 0xdd, 0x73, 0xe5, 0x89, 0xb2, 0x59, 0x08, 0xcb, 0x8c, 0x9f,
+	0x00, 0x2e, 0x6c, 0x15, 0x11, 0xa9, 0x1a, 0x98, 0x20, 0xfe, 0xb7, 0x34, 0x0c, 0x2f, 0x13, 0xf5,
+	0xbc, 0xa2, 0xd1, 0xe7, 0xa9, 0xcc, 0xc8, 0xd2, 0xa4, 0xba, 0x2f, 0xcd, 0x65, 0x18, 0x6a, 0x6a,
+	0xb4, 0x64, 0x4a, 0x14, 0xb9, 0x8a, 0xcc, 0x3f, 0x78, 0x98, 0x3b, 0x18, 0x56, 0xe3, 0xbb, 0x48,
+	0x95, 0xe4, 0xfa, 0x12, 0x92, 0x5b, 0x34, 0xb9, 0x84, 0x64, 0x71, 0x50, 0xf6, 0x75, 0x01, 0xfb,
+	0x41, 0xb4, 0xda, 0x1d, 0x35, 0x4e, 0xb5, 0xa9, 0xf4, 0x08, 0x91, 0x17, 0xdf, 0xd8, 0xbd, 0x8e,
+	0x07, 0xfd, 0x75, 0xf4, 0x95, 0x84, 0xe7, 0x20, 0x13, 0xb4, 0x79, 0x35, 0xfc, 0x39, 0x05, 0xfd,
+	0xcb, 0x44, 0x75, 0x77, 0x43, 0xec, 0xf9, 0xa8, 0x86, 0x62, 0xec, 0x23, 0x64, 0xe2, 0x1a, 0xaa,
+	0xdd, 0x76, 0xfa, 0x17, 0x35, 0x3b, 0x0d, 0xbd, 0x92, 0x6e, 0xd4, 0x30, 0xcd, 0xa4, 0x3b, 0xe8,
+	0x03, 0xd7, 0xa7, 0xf8, 0xba, 0x8f, 0xc0, 0xd0, 0xf9, 0x2c, 0x02, 0xf7, 0xfb, 0x09, 0x6c, 0xf0,
+	0xc1, 0x8f, 0xc1, 0x68, 0xcb, 0x9f, 0x1e, 0x6d, 0xd7, 0xd3, 0xf6, 0x63, 0x79, 0x11, 0xa9, 0x1a,
+	0x16, 0x91, 0xf2, 0x8c, 0xd9, 0xbb, 0x04, 0x63, 0x4d, 0xf6, 0x88, 0x29, 0x77, 0xce, 0xe0, 0xa8,
+	0xe7, 0xbf, 0x6a, 0xca, 0x91, 0x61, 0x15, 0x42, 0xbd, 0xb0, 0xe9, 0xce, 0xc3, 0x2e, 0x11, 0x1a,
+	0xae, 0xcd, 0x9e, 0x2e, 0x6a, 0x73, 0x66, 0xf7, 0xda, 0x04, 0x1e, 0x52, 0x01, 0xd2, 0xf9, 0x2a,
+	0x70, 0x61, 0x6b, 0xa3, 0x52, 0xac, 0x68, 0x77, 0x7b, 0xb5, 0x82, 0xac, 0x56, 0x2a, 0x59, 0x13,
+	0x80, 0xfb, 0x4c, 0xe2, 0x42, 0x4f, 0xe4, 0xb5, 0xc6, 0x78, 0xb0, 0x38, 0x60, 0xe5, 0x79, 0xe3,
+	0x8f, 0x1c, 0xe3, 0xe4, 0x3a, 0xd8, 0x8c, 0x60, 0x61, 0xf8, 0x5f, 0x52, 0x30, 0xb0, 0x4c, 0xd4,
+	0x4b, 0x58, 0x79, 0xa1, 0xdb, 0xe6, 0xd4, 0xee, 0xa5, 0xc9, 0xf8, 0x4b, 0xd3, 0x64, 0x84, 0xbf,
+	0xcd, 0xc0, 0x98, 0xcf, 0xf2, 0x3c, 0x2b, 0xc2, 0xbe, 0xef, 0x1d, 0x34, 0xb5, 0xdb, 0x41, 0x27,
+	0xec, 0xb9, 0x63, 0x3b, 0x3f, 0xd4, 0x4c, 0x7d, 0x72, 0x46, 0x58, 0x98, 0xf1, 0x9d, 0x9d, 0x7f,
+	0x9a, 0x82, 0x09, 0xeb, 0xea, 0x93, 0xb0, 0x8c, 0x2a, 0x97, 0x70, 0xd9, 0xc0, 0x8a, 0x86, 0xd5,
+	0x96, 0xc9, 0xe3, 0x45, 0xac, 0x38, 0x3b, 0x05, 0x43, 0xb2, 0x75, 0xd9, 0x5b, 0x85, 0xd9, 0x40,
+	0x9a, 0xba, 0xe1, 0xf4, 0x74, 0x5a, 0x1c, 0x6c, 0x98, 0xdf, 0xb2, 0xad, 0xc5, 0x4f, 0x1a, 0xd2,
+	0xd8, 0x09, 0x12, 0x79, 0xfc, 0x44, 0xbc, 0x5a, 0xa6, 0x02, 0xd3, 0x46, 0x1c, 0xb9, 0xfc, 0x29,
+	0x38, 0x92, 0xb4, 0xde, 0x90, 0x52, 0x71, 0x34, 0x62, 0x7b, 0xfe, 0x3e, 0x03, 0x43, 0x96, 0xf2,
+	0xaa, 0x8a, 0x44, 0xd1, 0x8a, 0x64, 0x4a, 0x3a, 0x61, 0x4f, 0x40, 0x9f, 0x54, 0xa3, 0x1b, 0x86,
+	0xa9, 0xd1, 0xfa, 0xae, 0x55, 0x6a, 0x42, 0xd9, 0xb3, 0xd0, 0x5b, 0xb5, 0x23, 0xb8, 0xba, 0xca,
+	0xc6, 0x0d, 0x32, 0xce, 0x3e, 0x3e, 0x4e, 0x1d, 0xc7, 0xe2, 0xdb, 0xe1, 0x1c, 0x4f, 0x5a, 0x14,
+	0x35, 0x77, 0xb1, 0xa8, 0x39, 0xd2, 0x42, 0xcd, 0x35, 0xef, 0xfd, 0x21, 0x70, 0x0c, 0x5e, 0x80,
+	0x03, 0x01, 0x53, 0x12, 0x15, 0x27, 0xf9, 0x9f, 0x52, 0xf6, 0xf5, 0x25, 0x1a, 0x54, 0xa2, 0xe8,
+	0x9c, 0x81, 0x89, 0x33, 0x5d, 0x46, 0xab, 0x8e, 0xe9, 0x5e, 0x75, 0x9f, 0x02, 0x60, 0x74, 0xb5,
+	0xe4, 0x4e, 0xbc, 0xa9, 0x84, 0x89, 0xf7, 0x58, 0xdc, 0xc4, 0xbb, 0xb3, 0x9d, 0x1f, 0x70, 0xed,
+	0x8e, 0x41, 0xec, 0xc3, 0xe8, 0xea, 0x8a, 0x1d, 0xb1, 0xb8, 0x16, 0x2b, 0xb7, 0x85, 0xd9, 0xf8,
+	0xa1, 0x28, 0xeb, 0x97, 0x5b, 0x90, 0x05, 0x7e, 0x0e, 0x0e, 0x46, 0x98, 0x13, 0x18, 0x5d, 0x98,
+	0xe5, 0x6f, 0xa7, 0x60, 0x64, 0x99, 0xa8, 0x6b, 0xc6, 0x26, 0xc2, 0xda, 0xe7, 0x68, 0x75, 0x43,
+	0x32, 0x11, 0x79, 0x31, 0x1f, 0xff, 0x62, 0x3c, 0xe9, 0xf3, 0xf1, 0x3d, 0x3e, 0xe1, 0x27, 0xdd,
+	0x4f, 0x14, 0x8f, 0x61, 0x3c, 0x64, 0xf4, 0x2e, 0x86, 0x66, 0xba, 0x4c, 0x17, 0xe9, 0x46, 0x94,
+	0x6b, 0x9e, 0xff, 0x9b, 0xb1, 0x67, 0x5f, 0x6b, 0x2e, 0x40, 0xba, 0xbd, 0x2d, 0xb9, 0x60, 0x98,
+	0xcf, 0xb6, 0x6a, 0xa7, 0xdb, 0xbf, 0x7b, 0x22, 0xd2, 0xfe, 0xb0, 0x2b, 0x96, 0x0f, 0x07, 0xa4,
+	0x1d, 0x75, 0x3e, 0xbe, 0x06, 0x93, 0x71, 0x6b, 0xcf, 0x93, 0xf3, 0xef, 0xd3, 0xf6, 0xcd, 0xbf,
+	0x66, 0x4a, 0x98, 0xac, 0x23, 0xf3, 0xbf, 0x7f, 0x67, 0x9e, 0x87, 0x11, 0x13, 0xc9, 0x5a, 0x55,
+	0x43, 0x38, 0x38, 0x13, 0x27, 0xa4, 0xe5, 0xb9, 0x3c, 0x9b, 0x39, 0xf8, 0x72, 0x57, 0x3a, 0x98,
+	0x0c, 0x74, 0x5b, 0x88, 0x73, 0xfe, 0x38, 0x1c, 0x8a, 0x5c, 0x48, 0x7a, 0xcc, 0xcd, 0xcf, 0x7d,
+	0xd3, 0x0f, 0xe9, 0x65, 0xa2, 0xb2, 0x57, 0x60, 0x28, 0xf8, 0xe9, 0x69, 0x3a, 0xee, 0x0a, 0x0c,
+	0x7f, 0x29, 0xe0, 0xe6, 0xda, 0xc7, 0x7a, 0x8a, 0xdc, 0x84, 0x01, 0xff, 0x17, 0x85, 0xa3, 0x09,
+	0x41, 0x7c, 0x48, 0x6e, 0xa6, 0x5d, 0xa4, 0xb7, 0xd9, 0xc7, 0xf0, 0x7f, 0xef, 0xd5, 0xf7, 0x70,
+	0x82, 0x77, 0x03, 0xc4, 0xbd, 0xda, 0x06, 0xc8, 0x8b, 0x7e, 0x05, 0x86, 0x82, 0x6f, 0x88, 0x49,
+	0xec, 0x05, 0xb0, 0xdc, 0x5c, 0xfb, 0x58, 0x6f, 0xcb, 0x32, 0x40, 0xcb, 0x6b, 0xc9, 0x2b, 0x09,
+	0x11, 0x9a, 0x30, 0x2e, 0xdf, 0x16, 0xcc, 0xdb, 0xe3, 0x57, 0x06, 0xc6, 0xe3, 0x07, 0xe3, 0xe3,
+	0x49, 0x35, 0x8f, 0xf3, 0xe2, 0x4e, 0x77, 0xe3, 0xe5, 0xbd, 0x8e, 0x8f, 0xde, 0x0d, 0xcf, 0x81,
+	0xec, 0x17, 0xf0, 0x92, 0x6f, 0x06, 0x9c, 0x4a, 0x3a, 0x65, 0x0b, 0x90, 0x2b, 0xb4, 0x09, 0x4c,
+	0xda, 0xfe, 0x24, 0x7b, 0x9d, 0x81, 0xe1, 0xd0, 0xe0, 0x95, 0x24, 0x9f, 0x20, 0x98, 0x9b, 0xef,
+	0x00, 0x9c, 0x90, 0xcb, 0xc2, 0x2c, 0xfb, 0x35, 0x03, 0x83, 0x81, 0x91, 0xe5, 0x58, 0x42, 0x70,
+	0x3f, 0x94, 0x9b, 0x6d, 0x1b, 0x9a, 0x94, 0xc5, 0x3c, 0xfb, 0x23, 0x03, 0x63, 0xd1, 0x37, 0x71,
+	0x52, 0xe3, 0x46, 0x7a, 0x70, 0xaf, 0x75, 0xea, 0x91, 0x9c, 0xda, 0x0f, 0x0c, 0xb0, 0x11, 0x17,
+	0x56, 0x52, 0x63, 0x84, 0xe1, 0xdc, 0x42, 0x47, 0xf0, 0xc4, 0x8c, 0xb8, 0xbd, 0x5f, 0x5a, 0xd7,
+	0xc3, 0xe2, 0x89, 0x3b, 0x8f, 0xb2, 0xcc, 0xbd, 0x47, 0x59, 0xe6, 0xcf, 0x47, 0x59, 0xe6, 0xc6,
+	0xe3, 0x6c, 0xcf, 0xbd, 0xc7, 0xd9, 0x9e, 0xfb, 0x8f, 0xb3, 0x3d, 0x1f, 0x4d, 0xf8, 0xbe, 0x26,
+	0x36, 0xdf, 0x19, 0x68, 0xbd, 0x8a, 0x48, 0xb9, 0xd7, 0x9e, 0xba, 0xe7, 0xff, 0x19, 0x00, 0xfa,
+	0x04, 0x8f, 0xa8, 0x58, 0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -855,6 +1129,15 @@ type MsgClient interface {
 	// RotateConsPubKey defines an operation for rotating the consensus keys
 	// of a validator.
 	RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error)
+	// TokenizeShares defines a method for tokenizing a delegation into liquid
+	// staking share tokens of its validator.
+	TokenizeShares(ctx context.Context, in *MsgTokenizeShares, opts ...grpc.CallOption) (*MsgTokenizeSharesResponse, error)
+	// RedeemTokensForShares defines a method for redeeming liquid staking share
+	// tokens back into a delegation.
+	RedeemTokensForShares(ctx context.Context, in *MsgRedeemTokensForShares, opts ...grpc.CallOption) (*MsgRedeemTokensForSharesResponse, error)
+	// TransferDelegation defines a method for transferring the ownership of a
+	// delegation to another account without unbonding it.
+	TransferDelegation(ctx context.Context, in *MsgTransferDelegation, opts ...grpc.CallOption) (*MsgTransferDelegationResponse, error)
 }
 
 type msgClient struct {
@@ -937,6 +1220,33 @@ func (c *msgClient) RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKe
 	return out, nil
 }
 
+func (c *msgClient) TokenizeShares(ctx context.Context, in *MsgTokenizeShares, opts ...grpc.CallOption) (*MsgTokenizeSharesResponse, error) {
+	out := new(MsgTokenizeSharesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/TokenizeShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RedeemTokensForShares(ctx context.Context, in *MsgRedeemTokensForShares, opts ...grpc.CallOption) (*MsgRedeemTokensForSharesResponse, error) {
+	out := new(MsgRedeemTokensForSharesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/RedeemTokensForShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferDelegation(ctx context.Context, in *MsgTransferDelegation, opts ...grpc.CallOption) (*MsgTransferDelegationResponse, error) {
+	out := new(MsgTransferDelegationResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/TransferDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateValidator defines a method for creating a new validator.
@@ -961,6 +1271,15 @@ type MsgServer interface {
 	// RotateConsPubKey defines an operation for rotating the consensus keys
 	// of a validator.
 	RotateConsPubKey(context.Context, *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error)
+	// TokenizeShares defines a method for tokenizing a delegation into liquid
+	// staking share tokens of its validator.
+	TokenizeShares(context.Context, *MsgTokenizeShares) (*MsgTokenizeSharesResponse, error)
+	// RedeemTokensForShares defines a method for redeeming liquid staking share
+	// tokens back into a delegation.
+	RedeemTokensForShares(context.Context, *MsgRedeemTokensForShares) (*MsgRedeemTokensForSharesResponse, error)
+	// TransferDelegation defines a method for transferring the ownership of a
+	// delegation to another account without unbonding it.
+	TransferDelegation(context.Context, *MsgTransferDelegation) (*MsgTransferDelegationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -991,6 +1310,15 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) RotateConsPubKey(ctx context.Context, req *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RotateConsPubKey not implemented")
 }
+func (*UnimplementedMsgServer) TokenizeShares(ctx context.Context, req *MsgTokenizeShares) (*MsgTokenizeSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShares not implemented")
+}
+func (*UnimplementedMsgServer) RedeemTokensForShares(ctx context.Context, req *MsgRedeemTokensForShares) (*MsgRedeemTokensForSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemTokensForShares not implemented")
+}
+func (*UnimplementedMsgServer) TransferDelegation(ctx context.Context, req *MsgTransferDelegation) (*MsgTransferDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferDelegation not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1140,6 +1468,60 @@ func _Msg_RotateConsPubKey_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TokenizeShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenizeShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TokenizeShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/TokenizeShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TokenizeShares(ctx, req.(*MsgTokenizeShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedeemTokensForShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeemTokensForShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedeemTokensForShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/RedeemTokensForShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedeemTokensForShares(ctx, req.(*MsgRedeemTokensForShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/TransferDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferDelegation(ctx, req.(*MsgTransferDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.staking.v1beta1.Msg",
@@ -1177,6 +1559,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RotateConsPubKey",
 			Handler:    _Msg_RotateConsPubKey_Handler,
 		},
+		{
+			MethodName: "TokenizeShares",
+			Handler:    _Msg_TokenizeShares_Handler,
+		},
+		{
+			MethodName: "RedeemTokensForShares",
+			Handler:    _Msg_RedeemTokensForShares_Handler,
+		},
+		{
+			MethodName: "TransferDelegation",
+			Handler:    _Msg_TransferDelegation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/tx.proto",
@@ -1827,36 +2221,266 @@ func (m *MsgRotateConsPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgTokenizeShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgTokenizeShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenizeShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Description.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Commission.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MinSelfDelegation.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenizeSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenizeSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenizeSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemTokensForShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemTokensForShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemTokensForShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemTokensForSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemTokensForSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemTokensForSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Description.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Commission.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinSelfDelegation.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	if m.Pubkey != nil {
 		l = m.Pubkey.Size()
@@ -2083,26 +2707,114 @@ func (m *MsgRotateConsPubKeyResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgTokenizeShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgTokenizeSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
 }
-func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
+
+func (m *MsgRedeemTokensForShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRedeemTokensForSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTransferDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTransferDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
@@ -2165,28 +2877,360 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pubkey == nil {
+				m.Pubkey = &any.Any{}
+			}
+			if err := m.Pubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2214,13 +3258,15 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v cosmossdk_io_math.LegacyDec
+			m.CommissionRate = &v
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2248,11 +3294,115 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			var v cosmossdk_io_math.Int
+			m.MinSelfDelegation = &v
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2280,13 +3430,13 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2296,31 +3446,27 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pubkey == nil {
-				m.Pubkey = &any.Any{}
-			}
-			if err := m.Pubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2347,7 +3493,7 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2372,7 +3518,7 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2395,10 +3541,10 @@ func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2422,7 +3568,7 @@ func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
+func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2445,17 +3591,17 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBeginRedelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBeginRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2465,28 +3611,27 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2514,11 +3659,11 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2546,17 +3691,13 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.LegacyDec
-			m.CommissionRate = &v
-			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2566,25 +3707,22 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.MinSelfDelegation = &v
-			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2609,7 +3747,7 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBeginRedelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2632,12 +3770,45 @@ func (m *MsgEditValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBeginRedelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBeginRedelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2659,7 +3830,7 @@ func (m *MsgEditValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2682,10 +3853,10 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2806,7 +3977,7 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2829,12 +4000,78 @@ func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2856,7 +4093,7 @@ func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelUnbondingDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2879,10 +4116,10 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginRedelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2919,7 +4156,7 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2947,13 +4184,13 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2963,29 +4200,30 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
 			}
-			var msglen int
+			m.CreationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2995,25 +4233,11 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CreationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3035,7 +4259,7 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginRedelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3058,45 +4282,12 @@ func (m *MsgBeginRedelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginRedelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginRedelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3118,7 +4309,7 @@ func (m *MsgBeginRedelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3141,15 +4332,15 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3177,13 +4368,13 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3193,57 +4384,75 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3265,7 +4474,7 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateConsPubKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3288,17 +4497,17 @@ func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateConsPubKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateConsPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3308,28 +4517,27 @@ func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubkey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3356,7 +4564,10 @@ func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.NewPubkey == nil {
+				m.NewPubkey = &any.Any{}
+			}
+			if err := m.NewPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3381,7 +4592,7 @@ func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelUnbondingDelegation) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateConsPubKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3404,10 +4615,60 @@ func (m *MsgCancelUnbondingDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelUnbondingDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateConsPubKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelUnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateConsPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenizeShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenizeShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenizeShares: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3507,25 +4768,6 @@ func (m *MsgCancelUnbondingDelegation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
-			}
-			m.CreationHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3547,7 +4789,7 @@ func (m *MsgCancelUnbondingDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTokenizeSharesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3570,12 +4812,45 @@ func (m *MsgCancelUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelUnbondingDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTokenizeSharesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelUnbondingDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTokenizeSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3597,7 +4872,7 @@ func (m *MsgCancelUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemTokensForShares) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3620,15 +4895,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemTokensForShares: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemTokensForShares: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3656,11 +4931,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3687,7 +4962,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3712,7 +4987,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemTokensForSharesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3735,12 +5010,45 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemTokensForSharesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemTokensForSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3762,7 +5070,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRotateConsPubKey) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3785,13 +5093,45 @@ func (m *MsgRotateConsPubKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRotateConsPubKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRotateConsPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
@@ -3823,9 +5163,41 @@ func (m *MsgRotateConsPubKey) Unmarshal(dAtA []byte) error {
 			}
 			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3852,10 +5224,7 @@ func (m *MsgRotateConsPubKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewPubkey == nil {
-				m.NewPubkey = &any.Any{}
-			}
-			if err := m.NewPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3880,7 +5249,7 @@ func (m *MsgRotateConsPubKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRotateConsPubKeyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3903,10 +5272,10 @@ func (m *MsgRotateConsPubKeyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRotateConsPubKeyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRotateConsPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
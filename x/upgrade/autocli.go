@@ -40,6 +40,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "authority",
 					Short:     "Get the upgrade authority address",
 				},
+				{
+					RpcMethod: "UpgradeReadiness",
+					Use:       "upgrade-readiness",
+					Short:     "Query the validators ready for the upgrade plan and their voting power",
+					Long:      "Gets the readiness signals of the validators for the currently scheduled upgrade plan, with the bonded voting power of the ready validators and the total bonded voting power.",
+				},
 				{
 					RpcMethod: "UpgradedConsensusState",
 					Skip:      true, // Skipping this command as the query is deprecated.
@@ -55,6 +61,17 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:       "Submit a proposal to cancel a planned chain upgrade.",
 					GovProposal: true,
 				},
+				{
+					RpcMethod: "SignalUpgradeReadiness",
+					Use:       "signal-upgrade-readiness <validator-address> <plan-name> [checksum]",
+					Short:     "Signal that a validator is ready for the scheduled upgrade",
+					Long:      "Signal that the validator has installed the binary of the scheduled upgrade. The checksum of the binary is required when the plan info has binary descriptors.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+						{ProtoField: "plan_name"},
+						{ProtoField: "checksum", Optional: true},
+					},
+				},
 				{
 					RpcMethod: "SoftwareUpgrade",
 					Skip:      true, // skipped because authority gated
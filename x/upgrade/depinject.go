@@ -42,6 +42,7 @@ type ModuleInputs struct {
 	ConsensusKeeper    types.ConsensusKeeper
 
 	DynamicConfig coreserver.DynamicConfig `optional:"true"`
+	StakingKeeper types.StakingKeeper      `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -78,7 +79,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	}
 
 	// set the governance module account as the authority for conducting upgrades
-	k := keeper.NewKeeper(in.Environment, skipUpgradeHeights, in.Cdc, homePath, in.AppVersionModifier, authorityStr, in.ConsensusKeeper)
+	k := keeper.NewKeeper(in.Environment, skipUpgradeHeights, in.Cdc, homePath, in.AppVersionModifier, authorityStr, in.ConsensusKeeper, in.StakingKeeper)
 	m := NewAppModule(k)
 
 	return ModuleOutputs{UpgradeKeeper: k, Module: m}
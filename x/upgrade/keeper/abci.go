@@ -53,6 +53,7 @@ func (k Keeper) PreBlocker(ctx context.Context) error {
 	}
 
 	if !found {
+		k.syncPendingUpgradeInfo(nil)
 		return nil
 	}
 
@@ -103,6 +104,10 @@ func (k Keeper) PreBlocker(ctx context.Context) error {
 		// Returning an error will end up in a panic
 		return errors.New(downgradeMsg)
 	}
+
+	// Write the upgrade info to disk ahead of the upgrade height, so that its binary can be downloaded
+	// and verified before the chain halts.
+	k.syncPendingUpgradeInfo(&plan)
 	return nil
 }
 
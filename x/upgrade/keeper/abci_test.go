@@ -142,7 +142,7 @@ func setupTest(t *testing.T, height int64, skip map[int64]bool) *TestSuite {
 
 	ctrl := gomock.NewController(t)
 	ck := upgradetestutil.NewMockConsensusKeeper(ctrl)
-	s.keeper = keeper.NewKeeper(s.env, skip, s.encCfg.Codec, t.TempDir(), s.baseApp, authority, ck)
+	s.keeper = keeper.NewKeeper(s.env, skip, s.encCfg.Codec, t.TempDir(), s.baseApp, authority, ck, nil)
 
 	s.ctx = testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now(), Height: height})
 
@@ -537,7 +537,7 @@ func TestDowngradeVerification(t *testing.T) {
 		// downgrade. now keeper does not have the handler.
 		ck := upgradetestutil.NewMockConsensusKeeper(ctrl)
 		ck.EXPECT().AppVersion(gomock.Any()).Return(uint64(0), nil).AnyTimes()
-		k := keeper.NewKeeper(s.env, map[int64]bool{}, s.encCfg.Codec, t.TempDir(), nil, authority, ck)
+		k := keeper.NewKeeper(s.env, map[int64]bool{}, s.encCfg.Codec, t.TempDir(), nil, authority, ck, nil)
 		m := upgrade.NewAppModule(k)
 
 		// assertions
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/upgrade/types"
 )
 
@@ -77,3 +78,35 @@ func (k Keeper) ModuleVersions(ctx context.Context, req *types.QueryModuleVersio
 func (k Keeper) Authority(c context.Context, req *types.QueryAuthorityRequest) (*types.QueryAuthorityResponse, error) {
 	return &types.QueryAuthorityResponse{Address: k.authority}, nil
 }
+
+// UpgradeReadiness implements the Query/UpgradeReadiness gRPC method
+func (k Keeper) UpgradeReadiness(ctx context.Context, req *types.QueryUpgradeReadinessRequest) (*types.QueryUpgradeReadinessResponse, error) {
+	plan, err := k.GetUpgradePlan(ctx)
+	if err != nil {
+		if errors.Is(err, types.ErrNoUpgradePlanFound) {
+			return &types.QueryUpgradeReadinessResponse{
+				ReadyVotingPower: math.ZeroInt(),
+				TotalVotingPower: math.ZeroInt(),
+			}, nil
+		}
+
+		return nil, err
+	}
+
+	readiness, err := k.GetUpgradeReadiness(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ready, total, err := k.GetReadyVotingPower(ctx, readiness)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryUpgradeReadinessResponse{
+		PlanName:         plan.Name,
+		ReadyVotingPower: ready,
+		TotalVotingPower: total,
+		Readiness:        readiness,
+	}, nil
+}
@@ -51,7 +51,7 @@ func (suite *UpgradeTestSuite) SetupTest() {
 	suite.encodedAuthority = authority
 	ctrl := gomock.NewController(suite.T())
 	ck := upgradetestutil.NewMockConsensusKeeper(ctrl)
-	suite.upgradeKeeper = keeper.NewKeeper(env, skipUpgradeHeights, suite.encCfg.Codec, suite.T().TempDir(), nil, authority, ck)
+	suite.upgradeKeeper = keeper.NewKeeper(env, skipUpgradeHeights, suite.encCfg.Codec, suite.T().TempDir(), nil, authority, ck, nil)
 	err = suite.upgradeKeeper.SetModuleVersionMap(suite.ctx, appmodule.VersionMap{
 		"bank": 0,
 	})
@@ -18,6 +18,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
+	upgradeplan "cosmossdk.io/x/upgrade/plan"
 	"cosmossdk.io/x/upgrade/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -40,6 +41,16 @@ type Keeper struct {
 	initVersionMap     appmodule.VersionMap            // the module version map at init genesis
 
 	consensusKeeper types.ConsensusKeeper
+	stakingKeeper   types.StakingKeeper
+
+	pendingUpgradeInfo *pendingUpgradeInfo // the plan last written to PendingUpgradeInfoFilename
+}
+
+// pendingUpgradeInfo tracks the plan written to the pending upgrade info file, to only write it when the
+// scheduled plan changes.
+type pendingUpgradeInfo struct {
+	synced bool
+	plan   *types.Plan
 }
 
 // NewKeeper constructs an upgrade Keeper which requires the following arguments:
@@ -48,6 +59,7 @@ type Keeper struct {
 // cdc - the app-wide binary codec
 // homePath - root directory of the application's config
 // vs - the interface implemented by baseapp which allows setting baseapp's protocol version field
+// sk - the staking keeper used to weigh the upgrade readiness signals of the validators, it can be nil
 func NewKeeper(
 	env appmodule.Environment,
 	skipUpgradeHeights map[int64]bool,
@@ -56,6 +68,7 @@ func NewKeeper(
 	vs server.VersionModifier,
 	authority string,
 	ck types.ConsensusKeeper,
+	sk types.StakingKeeper,
 ) *Keeper {
 	k := &Keeper{
 		Environment:        env,
@@ -66,6 +79,8 @@ func NewKeeper(
 		versionModifier:    vs,
 		authority:          authority,
 		consensusKeeper:    ck,
+		stakingKeeper:      sk,
+		pendingUpgradeInfo: &pendingUpgradeInfo{},
 	}
 
 	if homePath == "" {
@@ -199,6 +214,10 @@ func (k Keeper) ScheduleUpgrade(ctx context.Context, plan types.Plan) error {
 		return err
 	}
 
+	if _, err := upgradeplan.ParseBinaryDescriptors(plan.Info); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
 	// NOTE: allow for the possibility of chains to schedule upgrades in begin block of the same block
 	// as a strategy for emergency hard fork recoveries
 	if plan.Height < k.HeaderService.HeaderInfo(ctx).Height {
@@ -230,6 +249,11 @@ func (k Keeper) ScheduleUpgrade(ctx context.Context, plan types.Plan) error {
 		}
 	}
 
+	// the validators must signal their readiness for the new plan
+	if err := k.clearUpgradeReadiness(ctx); err != nil {
+		return err
+	}
+
 	bz, err := k.cdc.Marshal(&plan)
 	if err != nil {
 		return err
@@ -357,7 +381,7 @@ func (k Keeper) ClearIBCState(ctx context.Context, lastHeight int64) error {
 	return store.Delete(types.UpgradedConsStateKey(lastHeight))
 }
 
-// ClearUpgradePlan clears any schedule upgrade and associated IBC states.
+// ClearUpgradePlan clears any schedule upgrade and associated IBC states and readiness signals.
 func (k Keeper) ClearUpgradePlan(ctx context.Context) error {
 	// clear IBC states every time upgrade plan is removed
 	oldPlan, err := k.GetUpgradePlan(ctx)
@@ -374,6 +398,10 @@ func (k Keeper) ClearUpgradePlan(ctx context.Context) error {
 		return err
 	}
 
+	if err := k.clearUpgradeReadiness(ctx); err != nil {
+		return err
+	}
+
 	store := k.KVStoreService.OpenKVStore(ctx)
 	return store.Delete(types.PlanKey())
 }
@@ -491,12 +519,70 @@ func (k Keeper) DumpUpgradeInfoToDisk(height int64, p types.Plan) error {
 
 // GetUpgradeInfoPath returns the upgrade info file path
 func (k Keeper) GetUpgradeInfoPath() (string, error) {
+	return k.getDataFilePath(types.UpgradeInfoFilename)
+}
+
+// GetPendingUpgradeInfoPath returns the pending upgrade info file path
+func (k Keeper) GetPendingUpgradeInfoPath() (string, error) {
+	return k.getDataFilePath(types.PendingUpgradeInfoFilename)
+}
+
+func (k Keeper) getDataFilePath(filename string) (string, error) {
 	upgradeInfoFileDir := filepath.Join(k.homePath, "data")
 	if err := os.MkdirAll(upgradeInfoFileDir, os.ModePerm); err != nil {
 		return "", fmt.Errorf("could not create directory %q: %w", upgradeInfoFileDir, err)
 	}
 
-	return filepath.Join(upgradeInfoFileDir, types.UpgradeInfoFilename), nil
+	return filepath.Join(upgradeInfoFileDir, filename), nil
+}
+
+// DumpPendingUpgradeInfoToDisk writes the information of the scheduled upgrade to PendingUpgradeInfoFilename
+// before its height is reached, so that tools such as cosmovisor can download its binary ahead of time.
+// The file is removed if p is nil.
+func (k Keeper) DumpPendingUpgradeInfoToDisk(p *types.Plan) error {
+	pendingInfoFilePath, err := k.GetPendingUpgradeInfoPath()
+	if err != nil {
+		return err
+	}
+
+	if p == nil {
+		if err := os.Remove(pendingInfoFilePath); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	info, err := json.Marshal(types.Plan{
+		Name:   p.Name,
+		Height: p.Height,
+		Info:   p.Info,
+	})
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(pendingInfoFilePath, info, 0o600)
+}
+
+// syncPendingUpgradeInfo writes the scheduled plan to the pending upgrade info file when it changed since
+// the last write. Nothing is written when the home path is not set.
+func (k Keeper) syncPendingUpgradeInfo(p *types.Plan) {
+	if k.pendingUpgradeInfo == nil || k.homePath == "" {
+		return
+	}
+
+	last := k.pendingUpgradeInfo
+	if last.synced && (p == nil) == (last.plan == nil) &&
+		(p == nil || (p.Name == last.plan.Name && p.Height == last.plan.Height && p.Info == last.plan.Info)) {
+		return
+	}
+
+	if err := k.DumpPendingUpgradeInfoToDisk(p); err != nil {
+		k.Logger.Error("unable to write pending upgrade info to filesystem", "error", err)
+		return
+	}
+
+	last.synced, last.plan = true, p
 }
 
 // ReadUpgradeInfoFromDisk returns the name and height of the upgrade which is
@@ -2,7 +2,10 @@ package keeper_test
 
 import (
 	"context"
+	"encoding/json"
+	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
@@ -37,6 +40,7 @@ type KeeperTestSuite struct {
 	key              *storetypes.KVStoreKey
 	baseApp          *baseapp.BaseApp
 	upgradeKeeper    *keeper.Keeper
+	stakingKeeper    *upgradetestutil.MockStakingKeeper
 	homeDir          string
 	ctx              sdk.Context
 	msgSrvr          types.MsgServer
@@ -77,7 +81,9 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.encodedAuthority = authority
 
 	ctrl := gomock.NewController(s.T())
-	s.upgradeKeeper = keeper.NewKeeper(env, skipUpgradeHeights, s.encCfg.Codec, homeDir, s.baseApp, authority, upgradetestutil.NewMockConsensusKeeper(ctrl))
+	s.stakingKeeper = upgradetestutil.NewMockStakingKeeper(ctrl)
+	s.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(addresscodec.NewBech32Codec("cosmosvaloper")).AnyTimes()
+	s.upgradeKeeper = keeper.NewKeeper(env, skipUpgradeHeights, s.encCfg.Codec, homeDir, s.baseApp, authority, upgradetestutil.NewMockConsensusKeeper(ctrl), s.stakingKeeper)
 
 	s.T().Log("home dir:", homeDir)
 	s.homeDir = homeDir
@@ -156,6 +162,26 @@ func (s *KeeperTestSuite) TestScheduleUpgrade() {
 			setup:   func() {},
 			expPass: false,
 		},
+		{
+			name: "successful schedule with binary descriptors",
+			plan: types.Plan{
+				Name:   "all-good",
+				Info:   `{"binary_descriptors":{"linux/amd64":{"url":"https://example.com/bin","checksum":"sha256:` + strings.Repeat("a", 64) + `"}}}`,
+				Height: 123450000,
+			},
+			setup:   func() {},
+			expPass: true,
+		},
+		{
+			name: "unsuccessful schedule: invalid binary descriptors",
+			plan: types.Plan{
+				Name:   "all-good",
+				Info:   `{"binary_descriptors":{"linux/amd64":{"url":"https://example.com/bin","checksum":"sha256:abc"}}}`,
+				Height: 123450000,
+			},
+			setup:   func() {},
+			expPass: false,
+		},
 		{
 			name: "unsuccessful height schedule: due date in past",
 			plan: types.Plan{
@@ -260,7 +286,7 @@ func (s *KeeperTestSuite) TestIsSkipHeight() {
 	storeService := runtime.NewKVStoreService(s.key)
 	env := runtime.NewEnvironment(storeService, coretesting.NewNopLogger())
 	ctrl := gomock.NewController(s.T())
-	upgradeKeeper := keeper.NewKeeper(env, skip, s.encCfg.Codec, s.T().TempDir(), s.baseApp, s.encodedAuthority, upgradetestutil.NewMockConsensusKeeper(ctrl))
+	upgradeKeeper := keeper.NewKeeper(env, skip, s.encCfg.Codec, s.T().TempDir(), s.baseApp, s.encodedAuthority, upgradetestutil.NewMockConsensusKeeper(ctrl), nil)
 	s.Require().True(upgradeKeeper.IsSkipHeight(9))
 	s.Require().False(upgradeKeeper.IsSkipHeight(10))
 }
@@ -417,6 +443,27 @@ func (s *KeeperTestSuite) TestLastCompletedUpgradeOrdering() {
 	require.NoError(err)
 }
 
+func (s *KeeperTestSuite) TestPendingUpgradeInfo() {
+	pendingInfoPath := filepath.Join(s.homeDir, "data", types.PendingUpgradeInfoFilename)
+
+	plan := types.Plan{Name: "pending", Info: "some info", Height: 20}
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, plan))
+
+	// the plan is written before its height
+	s.Require().NoError(s.upgradeKeeper.PreBlocker(s.ctx))
+	bz, err := os.ReadFile(pendingInfoPath)
+	s.Require().NoError(err)
+	var pending types.Plan
+	s.Require().NoError(json.Unmarshal(bz, &pending))
+	s.Require().Equal(plan, pending)
+
+	// the file is removed once there is no scheduled plan
+	s.Require().NoError(s.upgradeKeeper.ClearUpgradePlan(s.ctx))
+	s.Require().NoError(s.upgradeKeeper.PreBlocker(s.ctx))
+	_, err = os.Stat(pendingInfoPath)
+	s.Require().True(os.IsNotExist(err))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/x/upgrade/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 type msgServer struct {
@@ -22,8 +23,8 @@ func NewMsgServerImpl(k *Keeper) types.MsgServer {
 }
 
 var (
-	_    types.MsgServer = msgServer{}
-	_, _ sdk.Msg         = &types.MsgSoftwareUpgrade{}, &types.MsgCancelUpgrade{}
+	_       types.MsgServer = msgServer{}
+	_, _, _ sdk.Msg         = &types.MsgSoftwareUpgrade{}, &types.MsgCancelUpgrade{}, &types.MsgSignalUpgradeReadiness{}
 )
 
 // SoftwareUpgrade implements the Msg/SoftwareUpgrade Msg service.
@@ -53,3 +54,21 @@ func (k msgServer) CancelUpgrade(ctx context.Context, msg *types.MsgCancelUpgrad
 
 	return &types.MsgCancelUpgradeResponse{}, nil
 }
+
+// SignalUpgradeReadiness implements the Msg/SignalUpgradeReadiness Msg service.
+func (k msgServer) SignalUpgradeReadiness(ctx context.Context, msg *types.MsgSignalUpgradeReadiness) (*types.MsgSignalUpgradeReadinessResponse, error) {
+	if k.stakingKeeper == nil {
+		return nil, errors.Wrap(sdkerrors.ErrLogic, "x/upgrade: staking keeper is not set")
+	}
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if err := k.Keeper.SignalUpgradeReadiness(ctx, valAddr, msg.PlanName, msg.Checksum); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSignalUpgradeReadinessResponse{}, nil
+}
@@ -1,9 +1,27 @@
 package keeper_test
 
 import (
+	"errors"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// bondedValidator is a bonded validator with the given tokens.
+type bondedValidator struct {
+	sdk.ValidatorI
+	tokens math.Int
+}
+
+func (v bondedValidator) IsBonded() bool { return true }
+
+func (v bondedValidator) GetBondedTokens() math.Int { return v.tokens }
+
 func (s *KeeperTestSuite) TestSoftwareUpgrade() {
 	testCases := []struct {
 		name      string
@@ -130,3 +148,107 @@ func (s *KeeperTestSuite) TestCancelUpgrade() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestSignalUpgradeReadiness() {
+	checksum := "sha256:" + strings.Repeat("a", 64)
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(s.addrs[0]), sdk.ValAddress("validator2__________")}
+	encodedValAddrs := make([]string, len(valAddrs))
+	for i, valAddr := range valAddrs {
+		addr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+		s.Require().NoError(err)
+		encodedValAddrs[i] = addr
+	}
+	unknownValAddr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(sdk.ValAddress("unknown_validator___"))
+	s.Require().NoError(err)
+
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddrs[0]).Return(bondedValidator{tokens: math.NewInt(30)}, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddrs[1]).Return(bondedValidator{tokens: math.NewInt(20)}, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), sdk.ValAddress("unknown_validator___")).Return(nil, errors.New("validator does not exist")).AnyTimes()
+	s.stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(math.NewInt(100), nil).AnyTimes()
+
+	// no readiness without a plan
+	_, err = s.msgSrvr.SignalUpgradeReadiness(s.ctx, &types.MsgSignalUpgradeReadiness{ValidatorAddress: encodedValAddrs[0], PlanName: "v2"})
+	s.Require().ErrorIs(err, types.ErrInvalidReadinessSignal)
+
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{
+		Name:   "v2",
+		Info:   `{"binary_descriptors":{"linux/amd64":{"url":"https://example.com/bin","checksum":"` + checksum + `"}}}`,
+		Height: 123450000,
+	}))
+
+	testCases := []struct {
+		name   string
+		req    *types.MsgSignalUpgradeReadiness
+		errMsg string
+	}{
+		{
+			"invalid validator address",
+			&types.MsgSignalUpgradeReadiness{ValidatorAddress: "validator", PlanName: "v2", Checksum: checksum},
+			"invalid validator address",
+		},
+		{
+			"unknown validator",
+			&types.MsgSignalUpgradeReadiness{ValidatorAddress: unknownValAddr, PlanName: "v2", Checksum: checksum},
+			"validator does not exist",
+		},
+		{
+			"wrong plan name",
+			&types.MsgSignalUpgradeReadiness{ValidatorAddress: encodedValAddrs[0], PlanName: "v3", Checksum: checksum},
+			"expected plan v2 got v3",
+		},
+		{
+			"missing checksum",
+			&types.MsgSignalUpgradeReadiness{ValidatorAddress: encodedValAddrs[0], PlanName: "v2"},
+			"checksum is required",
+		},
+		{
+			"checksum not matching the binary descriptors",
+			&types.MsgSignalUpgradeReadiness{ValidatorAddress: encodedValAddrs[0], PlanName: "v2", Checksum: "sha256:" + strings.Repeat("b", 64)},
+			"does not match the plan binary descriptors",
+		},
+		{
+			"validator ready",
+			&types.MsgSignalUpgradeReadiness{ValidatorAddress: encodedValAddrs[0], PlanName: "v2", Checksum: checksum},
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgSrvr.SignalUpgradeReadiness(s.ctx, tc.req)
+			if tc.errMsg != "" {
+				s.Require().ErrorContains(err, tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	res, err := s.upgradeKeeper.UpgradeReadiness(s.ctx, &types.QueryUpgradeReadinessRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal("v2", res.PlanName)
+	s.Require().Equal(math.NewInt(30), res.ReadyVotingPower)
+	s.Require().Equal(math.NewInt(100), res.TotalVotingPower)
+	s.Require().Equal([]types.ValidatorUpgradeReadiness{{
+		ValidatorAddress: encodedValAddrs[0],
+		PlanName:         "v2",
+		Checksum:         checksum,
+		Height:           10,
+	}}, res.Readiness)
+
+	// the readiness signals are cleared when the plan is replaced
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "v3", Height: 123450000}))
+	_, err = s.msgSrvr.SignalUpgradeReadiness(s.ctx, &types.MsgSignalUpgradeReadiness{ValidatorAddress: encodedValAddrs[1], PlanName: "v3"})
+	s.Require().NoError(err)
+
+	res, err = s.upgradeKeeper.UpgradeReadiness(s.ctx, &types.QueryUpgradeReadinessRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(20), res.ReadyVotingPower)
+	s.Require().Len(res.Readiness, 1)
+	s.Require().Equal(encodedValAddrs[1], res.Readiness[0].ValidatorAddress)
+
+	// and when the plan is cancelled
+	s.Require().NoError(s.upgradeKeeper.ClearUpgradePlan(s.ctx))
+	readiness, err := s.upgradeKeeper.GetUpgradeReadiness(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(readiness)
+}
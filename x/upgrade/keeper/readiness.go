@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	upgradeplan "cosmossdk.io/x/upgrade/plan"
+	"cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SignalUpgradeReadiness records that the validator is ready for the scheduled upgrade plan.
+// If the plan info has binary descriptors, the checksum of the binary installed by the
+// validator is required and must match one of them.
+func (k Keeper) SignalUpgradeReadiness(ctx context.Context, valAddr sdk.ValAddress, planName, checksum string) error {
+	if k.stakingKeeper == nil {
+		return errors.New("x/upgrade: staking keeper is not set")
+	}
+
+	plan, err := k.GetUpgradePlan(ctx)
+	if err != nil {
+		if errors.Is(err, types.ErrNoUpgradePlanFound) {
+			return errorsmod.Wrap(types.ErrInvalidReadinessSignal, "no upgrade plan is scheduled")
+		}
+		return err
+	}
+
+	if plan.Name != planName {
+		return errorsmod.Wrapf(types.ErrInvalidReadinessSignal, "expected plan %s got %s", plan.Name, planName)
+	}
+
+	validator, err := k.stakingKeeper.Validator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	if validator == nil {
+		return errorsmod.Wrapf(types.ErrInvalidReadinessSignal, "validator %s not found", valAddr)
+	}
+
+	descriptors, err := upgradeplan.ParseBinaryDescriptors(plan.Info)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case descriptors != nil && checksum == "":
+		return errorsmod.Wrap(types.ErrInvalidReadinessSignal, "checksum is required by the plan binary descriptors")
+	case descriptors != nil && !descriptors.HasChecksum(checksum):
+		return errorsmod.Wrapf(types.ErrInvalidReadinessSignal, "checksum %s does not match the plan binary descriptors", checksum)
+	case checksum != "":
+		if err := upgradeplan.ValidateChecksum(checksum); err != nil {
+			return errorsmod.Wrap(types.ErrInvalidReadinessSignal, err.Error())
+		}
+	}
+
+	valAddrStr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&types.ValidatorUpgradeReadiness{
+		ValidatorAddress: valAddrStr,
+		PlanName:         planName,
+		Checksum:         checksum,
+		Height:           k.HeaderService.HeaderInfo(ctx).Height,
+	})
+	if err != nil {
+		return err
+	}
+
+	store := k.KVStoreService.OpenKVStore(ctx)
+	return store.Set(types.ReadinessKey(valAddr), bz)
+}
+
+// GetUpgradeReadiness returns the upgrade readiness signals of the validators for the scheduled upgrade plan.
+func (k Keeper) GetUpgradeReadiness(ctx context.Context) ([]types.ValidatorUpgradeReadiness, error) {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	prefix := []byte{types.ReadinessByte}
+	it, err := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	readiness := make([]types.ValidatorUpgradeReadiness, 0)
+	for ; it.Valid(); it.Next() {
+		var signal types.ValidatorUpgradeReadiness
+		if err := k.cdc.Unmarshal(it.Value(), &signal); err != nil {
+			return nil, err
+		}
+		readiness = append(readiness, signal)
+	}
+
+	return readiness, nil
+}
+
+// GetReadyVotingPower returns the bonded tokens of the validators which signaled their readiness
+// for the scheduled upgrade plan, and the total bonded tokens.
+func (k Keeper) GetReadyVotingPower(ctx context.Context, readiness []types.ValidatorUpgradeReadiness) (ready, total math.Int, err error) {
+	if k.stakingKeeper == nil {
+		return math.Int{}, math.Int{}, errors.New("x/upgrade: staking keeper is not set")
+	}
+
+	ready = math.ZeroInt()
+	for _, signal := range readiness {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(signal.ValidatorAddress)
+		if err != nil {
+			return math.Int{}, math.Int{}, err
+		}
+
+		// validators removed since they signaled have no voting power
+		validator, err := k.stakingKeeper.Validator(ctx, valAddr)
+		if err != nil || validator == nil || !validator.IsBonded() {
+			continue
+		}
+
+		ready = ready.Add(validator.GetBondedTokens())
+	}
+
+	total, err = k.stakingKeeper.TotalBondedTokens(ctx)
+	if err != nil {
+		return math.Int{}, math.Int{}, err
+	}
+
+	return ready, total, nil
+}
+
+// clearUpgradeReadiness removes the upgrade readiness signals of all the validators.
+func (k Keeper) clearUpgradeReadiness(ctx context.Context) error {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	prefix := []byte{types.ReadinessByte}
+	it, err := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return err
+	}
+
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	if err := it.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := store.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
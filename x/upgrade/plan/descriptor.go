@@ -0,0 +1,152 @@
+package plan
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	neturl "net/url"
+	"regexp"
+	"strings"
+
+	"cosmossdk.io/x/upgrade/internal/conv"
+)
+
+// checksumHexLengths are the hex lengths of the checksums supported by the downloader, by type.
+var checksumHexLengths = map[string]int{
+	"md5":    32,
+	"sha1":   40,
+	"sha256": 64,
+	"sha512": 128,
+}
+
+// BinaryDescriptor describes the binary of an upgrade for a platform: where to download it
+// from and its expected checksum.
+type BinaryDescriptor struct {
+	// URL is the url of the binary or of an archive containing it, without checksum query parameter.
+	URL string `json:"url"`
+	// Checksum is the checksum of the file returned by the URL, in the "<type>:<hex>" format,
+	// e.g. "sha256:...". The supported types are md5, sha1, sha256 and sha512.
+	Checksum string `json:"checksum"`
+}
+
+// BinaryDescriptorMap is a map of os/architecture strings to the descriptor of the binary.
+type BinaryDescriptorMap map[string]BinaryDescriptor
+
+// DownloadURL returns the url of the binary with its checksum query parameter, so that the
+// checksum is verified on download.
+func (d BinaryDescriptor) DownloadURL() string {
+	url, err := neturl.Parse(d.URL)
+	if err != nil {
+		return d.URL
+	}
+
+	query := url.Query()
+	query.Set("checksum", d.Checksum)
+	url.RawQuery = query.Encode()
+	return url.String()
+}
+
+// ValidateBasic does stateless validation of this BinaryDescriptor.
+func (d BinaryDescriptor) ValidateBasic() error {
+	url, err := neturl.ParseRequestURI(d.URL)
+	if err != nil {
+		return fmt.Errorf("invalid url \"%s\": %w", d.URL, err)
+	}
+	if url.Query().Has("checksum") {
+		return fmt.Errorf("url \"%s\" must not have a checksum query parameter", d.URL)
+	}
+
+	return ValidateChecksum(d.Checksum)
+}
+
+// ValidateChecksum checks that the checksum has the "<type>:<hex>" format with a supported type.
+func ValidateChecksum(checksum string) error {
+	checksumType, value, ok := strings.Cut(checksum, ":")
+	if !ok {
+		return fmt.Errorf("invalid checksum \"%s\": expected <type>:<hex> format", checksum)
+	}
+
+	length, ok := checksumHexLengths[checksumType]
+	if !ok {
+		return fmt.Errorf("invalid checksum \"%s\": unsupported checksum type %s", checksum, checksumType)
+	}
+
+	if _, err := hex.DecodeString(value); err != nil || len(value) != length {
+		return fmt.Errorf("invalid checksum \"%s\": expected %d hex characters", checksum, length)
+	}
+
+	return nil
+}
+
+// ValidateBasic does stateless validation of this BinaryDescriptorMap.
+// It validates that:
+//   - This has at least one entry.
+//   - All entry keys have the format "os/arch" or are "any".
+//   - All entries have a valid url and checksum.
+func (m BinaryDescriptorMap) ValidateBasic() error {
+	if len(m) == 0 {
+		return errors.New("no \"binary_descriptors\" entries found")
+	}
+
+	osArchRx := regexp.MustCompile(`[a-zA-Z0-9]+/[a-zA-Z0-9]+`)
+	for key, descriptor := range m {
+		if key != "any" && !osArchRx.MatchString(key) {
+			return fmt.Errorf("invalid os/arch format in key \"%s\"", key)
+		}
+
+		if err := descriptor.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid binary_descriptors[%s]: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+// HasChecksum returns whether one of the descriptors has the given checksum.
+func (m BinaryDescriptorMap) HasChecksum(checksum string) bool {
+	for _, descriptor := range m {
+		if strings.EqualFold(descriptor.Checksum, checksum) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseBinaryDescriptors parses and validates the binary descriptors of a Plan.Info string,
+// without any network request, so that it can be used on chain when an upgrade is submitted.
+// Nil is returned for info strings which are not a json object, such as urls, or which have
+// no binary descriptors.
+func ParseBinaryDescriptors(infoStr string) (BinaryDescriptorMap, error) {
+	infoStr = strings.TrimSpace(infoStr)
+	if !strings.HasPrefix(infoStr, "{") {
+		return nil, nil
+	}
+
+	var info struct {
+		Binaries          map[string]json.RawMessage `json:"binaries"`
+		BinaryDescriptors json.RawMessage            `json:"binary_descriptors"`
+	}
+	if err := json.Unmarshal(conv.UnsafeStrToBytes(infoStr), &info); err != nil || info.BinaryDescriptors == nil {
+		// free form info
+		return nil, nil
+	}
+
+	var descriptors BinaryDescriptorMap
+	if err := json.Unmarshal(info.BinaryDescriptors, &descriptors); err != nil {
+		return nil, fmt.Errorf("could not parse binary descriptors: %w", err)
+	}
+
+	if err := descriptors.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	for key := range descriptors {
+		if _, ok := info.Binaries[key]; ok {
+			return nil, fmt.Errorf("binaries[%s] is also defined in binary_descriptors", key)
+		}
+	}
+
+	return descriptors, nil
+}
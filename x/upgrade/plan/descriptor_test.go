@@ -0,0 +1,103 @@
+package plan
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBinaryDescriptors(t *testing.T) {
+	checksum := "sha256:" + strings.Repeat("a", 64)
+
+	tests := []struct {
+		name     string
+		infoStr  string
+		expected BinaryDescriptorMap
+		errMsg   string
+	}{
+		{
+			name:    "free form info",
+			infoStr: "some text here",
+		},
+		{
+			name:    "url info",
+			infoStr: "https://example.com/info.json?checksum=" + checksum,
+		},
+		{
+			name:    "json without binary descriptors",
+			infoStr: `{"binaries":{"linux/amd64":"https://example.com/bin"}}`,
+		},
+		{
+			name:     "valid binary descriptors",
+			infoStr:  `{"binary_descriptors":{"linux/amd64":{"url":"https://example.com/bin","checksum":"` + checksum + `"},"any":{"url":"https://example.com/any","checksum":"` + checksum + `"}}}`,
+			expected: BinaryDescriptorMap{"linux/amd64": {URL: "https://example.com/bin", Checksum: checksum}, "any": {URL: "https://example.com/any", Checksum: checksum}},
+		},
+		{
+			name:    "empty binary descriptors",
+			infoStr: `{"binary_descriptors":{}}`,
+			errMsg:  "no \"binary_descriptors\" entries found",
+		},
+		{
+			name:    "wrong binary descriptors data type",
+			infoStr: `{"binary_descriptors":["https://example.com/bin"]}`,
+			errMsg:  "could not parse binary descriptors",
+		},
+		{
+			name:    "invalid os/arch",
+			infoStr: `{"binary_descriptors":{"linux":{"url":"https://example.com/bin","checksum":"` + checksum + `"}}}`,
+			errMsg:  "invalid os/arch format in key \"linux\"",
+		},
+		{
+			name:    "invalid url",
+			infoStr: `{"binary_descriptors":{"linux/amd64":{"url":"bin","checksum":"` + checksum + `"}}}`,
+			errMsg:  "invalid url \"bin\"",
+		},
+		{
+			name:    "url with checksum",
+			infoStr: `{"binary_descriptors":{"linux/amd64":{"url":"https://example.com/bin?checksum=` + checksum + `","checksum":"` + checksum + `"}}}`,
+			errMsg:  "must not have a checksum query parameter",
+		},
+		{
+			name:    "missing checksum",
+			infoStr: `{"binary_descriptors":{"linux/amd64":{"url":"https://example.com/bin"}}}`,
+			errMsg:  "expected <type>:<hex> format",
+		},
+		{
+			name:    "unsupported checksum type",
+			infoStr: `{"binary_descriptors":{"linux/amd64":{"url":"https://example.com/bin","checksum":"crc32:abcd1234"}}}`,
+			errMsg:  "unsupported checksum type crc32",
+		},
+		{
+			name:    "checksum of the wrong length",
+			infoStr: `{"binary_descriptors":{"linux/amd64":{"url":"https://example.com/bin","checksum":"sha512:` + strings.Repeat("a", 64) + `"}}}`,
+			errMsg:  "expected 128 hex characters",
+		},
+		{
+			name:    "binary descriptors also in binaries",
+			infoStr: `{"binaries":{"linux/amd64":"https://example.com/bin"},"binary_descriptors":{"linux/amd64":{"url":"https://example.com/bin","checksum":"` + checksum + `"}}}`,
+			errMsg:  "binaries[linux/amd64] is also defined in binary_descriptors",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			descriptors, err := ParseBinaryDescriptors(tc.infoStr)
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, descriptors)
+		})
+	}
+}
+
+func TestBinaryDescriptorDownloadURL(t *testing.T) {
+	checksum := "sha256:" + strings.Repeat("a", 64)
+	descriptor := BinaryDescriptor{URL: "https://example.com/bin.tar.gz?archive=tar.gz", Checksum: checksum}
+	require.Equal(t, "https://example.com/bin.tar.gz?archive=tar.gz&checksum=sha256%3A"+strings.Repeat("a", 64), descriptor.DownloadURL())
+	require.True(t, BinaryDescriptorMap{"any": descriptor}.HasChecksum(checksum))
+	require.False(t, BinaryDescriptorMap{"any": descriptor}.HasChecksum("sha256:"+strings.Repeat("b", 64)))
+}
@@ -18,6 +18,9 @@ type Info struct {
 	parseConfig ParseConfig
 
 	Binaries BinaryDownloadURLMap `json:"binaries"`
+	// BinaryDescriptors are the binaries described with their checksum, which can be verified
+	// on chain. They are also added to Binaries when parsed, with their checksum in the url.
+	BinaryDescriptors BinaryDescriptorMap `json:"binary_descriptors,omitempty"`
 }
 
 // BinaryDownloadURLMap is a map of os/architecture strings to a URL where the binary can be downloaded.
@@ -42,6 +45,7 @@ func ParseOptionEnforceChecksum(enforce bool) ParseOption {
 
 // ParseInfo parses an info string into a map of os/arch strings to URL string.
 // If the infoStr is a url, an GET request will be made to it, and its response will be parsed instead.
+// The binary descriptors are validated and added to the binaries, with their checksum in the url.
 func ParseInfo(infoStr string, opts ...ParseOption) (*Info, error) {
 	parseConfig := &ParseConfig{}
 	for _, opt := range opts {
@@ -73,6 +77,22 @@ func ParseInfo(infoStr string, opts ...ParseOption) (*Info, error) {
 
 	planInfo.parseConfig = *parseConfig
 
+	if planInfo.BinaryDescriptors != nil {
+		if err := planInfo.BinaryDescriptors.ValidateBasic(); err != nil {
+			return nil, err
+		}
+
+		if planInfo.Binaries == nil {
+			planInfo.Binaries = make(BinaryDownloadURLMap, len(planInfo.BinaryDescriptors))
+		}
+		for key, descriptor := range planInfo.BinaryDescriptors {
+			if _, ok := planInfo.Binaries[key]; ok {
+				return nil, fmt.Errorf("binaries[%s] is also defined in binary_descriptors", key)
+			}
+			planInfo.Binaries[key] = descriptor.DownloadURL()
+		}
+	}
+
 	return &planInfo, nil
 }
 
@@ -1,6 +1,7 @@
 package plan
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -39,6 +40,8 @@ func (s *InfoTestSuite) TestParseInfo() {
 	goodJSONPath := s.saveTestFile(NewTestFile("good.json", goodJSON))
 	binariesWrongJSONPath := s.saveTestFile(NewTestFile("binaries-wrong.json", binariesWrongJSON))
 	binariesWrongValueJSONPath := s.saveTestFile(NewTestFile("binaries-wrong-value.json", binariesWrongValueJSON))
+	descriptorsJSON := `{"binaries":{"os1/arch1":"url1"},"binary_descriptors":{"os2/arch2":{"url":"https://example.com/bin2","checksum":"sha256:` + strings.Repeat("a", 64) + `"}}}`
+	descriptorsConflictJSON := `{"binaries":{"os1/arch1":"url1"},"binary_descriptors":{"os1/arch1":{"url":"https://example.com/bin1","checksum":"sha256:` + strings.Repeat("a", 64) + `"}}}`
 	goodJSONAsInfo := &Info{
 		Binaries: BinaryDownloadURLMap{
 			"os1/arch1": "url1",
@@ -94,6 +97,26 @@ func (s *InfoTestSuite) TestParseInfo() {
 			expectedInfo:    nil,
 			expectedInError: []string{"could not parse plan info", "cannot unmarshal number into Go struct field Info.binaries"},
 		},
+		{
+			name:         "json binary descriptors",
+			infoStrMaker: makeInfoStrFuncString(descriptorsJSON),
+			expectedInfo: &Info{
+				Binaries: BinaryDownloadURLMap{
+					"os1/arch1": "url1",
+					"os2/arch2": "https://example.com/bin2?checksum=sha256%3A" + strings.Repeat("a", 64),
+				},
+				BinaryDescriptors: BinaryDescriptorMap{
+					"os2/arch2": {URL: "https://example.com/bin2", Checksum: "sha256:" + strings.Repeat("a", 64)},
+				},
+			},
+			expectedInError: nil,
+		},
+		{
+			name:            "json binary descriptors also in binaries",
+			infoStrMaker:    makeInfoStrFuncString(descriptorsConflictJSON),
+			expectedInfo:    nil,
+			expectedInError: []string{"binaries[os1/arch1] is also defined in binary_descriptors"},
+		},
 		{
 			name:            "url does not exist",
 			infoStrMaker:    makeInfoStrFuncString("file:///this/file/does/not/exist?checksum=sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"),
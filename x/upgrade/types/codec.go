@@ -15,6 +15,7 @@ func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	registrar.RegisterConcrete(&CancelSoftwareUpgradeProposal{}, "cosmos-sdk/CancelSoftwareUpgradeProposal")
 	legacy.RegisterAminoMsg(registrar, &MsgSoftwareUpgrade{}, "cosmos-sdk/MsgSoftwareUpgrade")
 	legacy.RegisterAminoMsg(registrar, &MsgCancelUpgrade{}, "cosmos-sdk/MsgCancelUpgrade")
+	legacy.RegisterAminoMsg(registrar, &MsgSignalUpgradeReadiness{}, "cosmos-sdk/MsgSignalUpgradeReadiness")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -22,6 +23,7 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	registrar.RegisterImplementations((*coretransaction.Msg)(nil),
 		&MsgSoftwareUpgrade{},
 		&MsgCancelUpgrade{},
+		&MsgSignalUpgradeReadiness{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
@@ -16,4 +16,6 @@ var (
 	ErrNoUpgradedConsensusStateFound = errors.Register(ModuleName, 5, "upgraded consensus state not found")
 	// ErrInvalidSigner error if the authority is not the signer for a proposal message
 	ErrInvalidSigner = errors.Register(ModuleName, 6, "expected authority account as only signer for proposal message")
+	// ErrInvalidReadinessSignal error if an upgrade readiness signal doesn't match the scheduled upgrade plan
+	ErrInvalidReadinessSignal = errors.Register(ModuleName, 7, "invalid upgrade readiness signal")
 )
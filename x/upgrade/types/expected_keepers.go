@@ -1,7 +1,22 @@
 package types
 
-import "context"
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 type ConsensusKeeper interface {
 	AppVersion(ctx context.Context) (uint64, error)
 }
+
+// StakingKeeper defines the expected staking keeper, used to weigh the upgrade readiness
+// signals of the validators by their voting power.
+type StakingKeeper interface {
+	ValidatorAddressCodec() address.Codec
+	Validator(ctx context.Context, addr sdk.ValAddress) (sdk.ValidatorI, error)
+	TotalBondedTokens(ctx context.Context) (math.Int, error)
+}
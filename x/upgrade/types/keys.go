@@ -1,6 +1,10 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	// ModuleName is the name of this module
@@ -28,6 +32,10 @@ const (
 	// VersionMapByte is a prefix to look up module names (key) and versions (value)
 	VersionMapByte = 0x2
 
+	// ReadinessByte is a prefix to look up the upgrade readiness signals by validator address.
+	// 0x3 is skipped as it was used by the legacy protocol version.
+	ReadinessByte = 0x4
+
 	// KeyUpgradedIBCState is the key under which upgraded ibc state is stored in the upgrade store
 	KeyUpgradedIBCState = "upgradedIBCState"
 
@@ -44,6 +52,11 @@ func PlanKey() []byte {
 	return []byte{PlanByte}
 }
 
+// ReadinessKey is the key under which the upgrade readiness signal of a validator is saved
+func ReadinessKey(valAddr []byte) []byte {
+	return append([]byte{ReadinessByte}, address.MustLengthPrefix(valAddr)...)
+}
+
 // UpgradedClientKey is the key under which the upgraded client state is saved
 // Connecting IBC chains can verify against the upgraded client in this path before
 // upgrading their clients
@@ -11,6 +11,10 @@ import (
 // UpgradeInfoFileName file to store upgrade information
 const UpgradeInfoFilename = "upgrade-info.json"
 
+// PendingUpgradeInfoFilename file to store the information of the scheduled upgrade before its height is
+// reached, so that its binary can be downloaded ahead of time
+const PendingUpgradeInfoFilename = "upgrade-info.pending.json"
+
 // ValidateBasic does basic validation of a Plan
 func (p Plan) ValidateBasic() error {
 	if !p.Time.IsZero() {
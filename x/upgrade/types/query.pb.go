@@ -5,8 +5,10 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -484,6 +486,104 @@ func (m *QueryAuthorityResponse) GetAddress() string {
 	return ""
 }
 
+// QueryUpgradeReadinessRequest is the request type for the Query/UpgradeReadiness
+// RPC method.
+type QueryUpgradeReadinessRequest struct {
+}
+
+func (m *QueryUpgradeReadinessRequest) Reset()         { *m = QueryUpgradeReadinessRequest{} }
+func (m *QueryUpgradeReadinessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeReadinessRequest) ProtoMessage()    {}
+func (*QueryUpgradeReadinessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{10}
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeReadinessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeReadinessRequest.Merge(m, src)
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeReadinessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeReadinessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeReadinessRequest proto.InternalMessageInfo
+
+// QueryUpgradeReadinessResponse is the response type for the Query/UpgradeReadiness
+// RPC method.
+type QueryUpgradeReadinessResponse struct {
+	// plan_name is the name of the current upgrade plan.
+	PlanName string `protobuf:"bytes,1,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty"`
+	// ready_voting_power is the bonded voting power of the validators ready for the upgrade.
+	ReadyVotingPower cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=ready_voting_power,json=readyVotingPower,proto3,customtype=cosmossdk.io/math.Int" json:"ready_voting_power"`
+	// total_voting_power is the total bonded voting power.
+	TotalVotingPower cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=total_voting_power,json=totalVotingPower,proto3,customtype=cosmossdk.io/math.Int" json:"total_voting_power"`
+	// readiness are the readiness signals of the validators for the current upgrade plan.
+	Readiness []ValidatorUpgradeReadiness `protobuf:"bytes,4,rep,name=readiness,proto3" json:"readiness"`
+}
+
+func (m *QueryUpgradeReadinessResponse) Reset()         { *m = QueryUpgradeReadinessResponse{} }
+func (m *QueryUpgradeReadinessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeReadinessResponse) ProtoMessage()    {}
+func (*QueryUpgradeReadinessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{11}
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeReadinessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeReadinessResponse.Merge(m, src)
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeReadinessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeReadinessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeReadinessResponse proto.InternalMessageInfo
+
+func (m *QueryUpgradeReadinessResponse) GetPlanName() string {
+	if m != nil {
+		return m.PlanName
+	}
+	return ""
+}
+
+func (m *QueryUpgradeReadinessResponse) GetReadiness() []ValidatorUpgradeReadiness {
+	if m != nil {
+		return m.Readiness
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryCurrentPlanRequest)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanRequest")
 	proto.RegisterType((*QueryCurrentPlanResponse)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanResponse")
@@ -495,6 +595,8 @@ func init() {
 	proto.RegisterType((*QueryModuleVersionsResponse)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsResponse")
 	proto.RegisterType((*QueryAuthorityRequest)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityRequest")
 	proto.RegisterType((*QueryAuthorityResponse)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityResponse")
+	proto.RegisterType((*QueryUpgradeReadinessRequest)(nil), "cosmos.upgrade.v1beta1.QueryUpgradeReadinessRequest")
+	proto.RegisterType((*QueryUpgradeReadinessResponse)(nil), "cosmos.upgrade.v1beta1.QueryUpgradeReadinessResponse")
 }
 
 func init() {
@@ -502,50 +604,62 @@ func init() {
 }
 
 var fileDescriptor_4a334d07ad8374f0 = []byte{
-	// 685 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x4f, 0x13, 0x4f,
-	0x1c, 0x65, 0x0a, 0x5f, 0xf8, 0xf2, 0xa9, 0x01, 0x33, 0xc4, 0xba, 0xac, 0xa4, 0xd6, 0x01, 0x15,
-	0x23, 0xdd, 0x85, 0xd6, 0x70, 0x40, 0x63, 0x14, 0x0e, 0x8a, 0x11, 0xa2, 0x35, 0x7a, 0xf0, 0xd2,
-	0x0c, 0xec, 0xa4, 0x34, 0xb4, 0xbb, 0xcb, 0xce, 0x2c, 0x91, 0x10, 0x3c, 0x70, 0xf2, 0x68, 0xe2,
-	0xcd, 0x83, 0x37, 0x13, 0xff, 0x00, 0xaf, 0xde, 0x8d, 0x27, 0xa2, 0x17, 0x63, 0x3c, 0x18, 0xf0,
-	0x0f, 0x31, 0x3b, 0x33, 0x25, 0xfd, 0xb1, 0xbb, 0xa0, 0xb7, 0xce, 0xce, 0x7b, 0xef, 0xf3, 0xde,
-	0xce, 0xbc, 0x2d, 0x90, 0x75, 0x8f, 0x37, 0x3d, 0x6e, 0x87, 0x7e, 0x2d, 0xa0, 0x0e, 0xb3, 0xb7,
-	0xe7, 0xd6, 0x98, 0xa0, 0x73, 0xf6, 0x56, 0xc8, 0x82, 0x1d, 0xcb, 0x0f, 0x3c, 0xe1, 0xe1, 0x9c,
-	0xc2, 0x58, 0x1a, 0x63, 0x69, 0x8c, 0x39, 0x51, 0xf3, 0xbc, 0x5a, 0x83, 0xd9, 0xd4, 0xaf, 0xdb,
-	0xd4, 0x75, 0x3d, 0x41, 0x45, 0xdd, 0x73, 0xb9, 0x62, 0x99, 0x53, 0x09, 0xca, 0x2d, 0x15, 0x85,
-	0x1a, 0x57, 0xa8, 0xaa, 0x5c, 0xd9, 0x7a, 0x90, 0x5c, 0x90, 0x71, 0x38, 0xff, 0x38, 0x72, 0xb1,
-	0x14, 0x06, 0x01, 0x73, 0xc5, 0xa3, 0x06, 0x75, 0x2b, 0x6c, 0x2b, 0x64, 0x5c, 0x90, 0x87, 0x60,
-	0xf4, 0x6e, 0x71, 0xdf, 0x73, 0x39, 0xc3, 0xb3, 0x30, 0xe0, 0x37, 0xa8, 0x6b, 0xa0, 0x02, 0x9a,
-	0xce, 0x96, 0x26, 0xac, 0x78, 0xf3, 0x96, 0xe4, 0x48, 0x24, 0x29, 0xea, 0x41, 0x77, 0x7d, 0xbf,
-	0x51, 0x67, 0x4e, 0xdb, 0x20, 0x8c, 0x61, 0xc0, 0xa5, 0x4d, 0x26, 0xc5, 0x86, 0x2b, 0xf2, 0x37,
-	0x29, 0xe9, 0xe1, 0x1d, 0x70, 0x3d, 0x3c, 0x07, 0x83, 0x1b, 0xac, 0x5e, 0xdb, 0x10, 0x92, 0xd1,
-	0x5f, 0xd1, 0x2b, 0xb2, 0x0c, 0x44, 0x72, 0x9e, 0x2a, 0x17, 0xce, 0x52, 0x84, 0x76, 0x79, 0xc8,
-	0x9f, 0x08, 0x2a, 0x58, 0x6b, 0xda, 0x45, 0xc8, 0x36, 0x28, 0x17, 0xd5, 0x0e, 0x09, 0x88, 0x1e,
-	0xdd, 0x97, 0x4f, 0x16, 0x32, 0x06, 0x22, 0x2f, 0x61, 0x32, 0x55, 0x4a, 0x3b, 0x59, 0x01, 0x43,
-	0x47, 0x76, 0xaa, 0xeb, 0x2d, 0x48, 0x95, 0x47, 0x18, 0x23, 0x53, 0x40, 0xd3, 0x67, 0x16, 0xc7,
-	0x7e, 0x7c, 0x2c, 0x8e, 0xaa, 0xb7, 0x53, 0xe4, 0xce, 0x66, 0x61, 0xd6, 0xba, 0x51, 0xae, 0xe4,
-	0xc2, 0x58, 0xd9, 0x68, 0xf2, 0x83, 0x81, 0xff, 0xd1, 0xd9, 0x0c, 0xa9, 0x80, 0x29, 0xe7, 0xaf,
-	0x78, 0x4e, 0xd8, 0x60, 0xcf, 0x58, 0xc0, 0xa3, 0x43, 0x6f, 0x8b, 0xd0, 0x94, 0x1b, 0xd5, 0xb6,
-	0xf7, 0x06, 0xea, 0xd1, 0x2a, 0x6d, 0xb2, 0x85, 0xb1, 0xaf, 0xbd, 0x53, 0xc9, 0x3e, 0x82, 0x0b,
-	0xb1, 0xa2, 0x3a, 0xcc, 0x2a, 0x8c, 0x6a, 0xd5, 0x6d, 0xbd, 0x65, 0xa0, 0x42, 0xff, 0x74, 0xb6,
-	0x74, 0x39, 0xe9, 0x78, 0x3b, 0x84, 0x2a, 0x23, 0xcd, 0x0e, 0xdd, 0x78, 0x13, 0x33, 0x70, 0x4e,
-	0x9d, 0x6b, 0x28, 0x36, 0xbc, 0xa0, 0x2e, 0x76, 0x74, 0xa6, 0x38, 0xf4, 0x3c, 0xb9, 0x07, 0xb9,
-	0x6e, 0xb4, 0x36, 0x6b, 0xc0, 0x10, 0x75, 0x9c, 0x80, 0x71, 0xae, 0xe3, 0xb7, 0x96, 0xb1, 0x42,
-	0xa5, 0xb7, 0x43, 0xf0, 0x9f, 0x54, 0xc2, 0xef, 0x10, 0x64, 0xdb, 0x6e, 0x34, 0xb6, 0x93, 0xc2,
-	0x25, 0xd4, 0xc2, 0x9c, 0x3d, 0x3d, 0x41, 0x79, 0x25, 0x33, 0xfb, 0xdf, 0x7e, 0xbf, 0xc9, 0x5c,
-	0xc1, 0x53, 0x76, 0x42, 0x5b, 0xd7, 0x15, 0xa9, 0x1a, 0x15, 0x05, 0xbf, 0x47, 0x90, 0x6d, 0xbb,
-	0xf5, 0x27, 0x18, 0xec, 0xad, 0xd3, 0x09, 0x06, 0x63, 0x0a, 0x45, 0xca, 0xd2, 0x60, 0x11, 0x5f,
-	0x4f, 0x32, 0x48, 0x15, 0x49, 0x1a, 0xb4, 0x77, 0xa3, 0x4b, 0xb7, 0x87, 0x7f, 0x22, 0xc8, 0xc5,
-	0xd7, 0x03, 0x2f, 0xa4, 0x3a, 0x48, 0xad, 0xa7, 0x79, 0xf3, 0x9f, 0xb8, 0x3a, 0xc8, 0xb2, 0x0c,
-	0x72, 0x07, 0xdf, 0xb6, 0xd3, 0xbf, 0x8b, 0x3d, 0x6d, 0xb5, 0x77, 0xdb, 0xbe, 0x09, 0x7b, 0xaf,
-	0x32, 0x08, 0x7f, 0x42, 0x30, 0xd2, 0x59, 0x14, 0x5c, 0x4a, 0xb5, 0x16, 0x5b, 0x55, 0xb3, 0xfc,
-	0x57, 0x1c, 0x1d, 0x63, 0xf1, 0x4b, 0x6f, 0x73, 0x64, 0xb2, 0x6b, 0xf8, 0x6a, 0x52, 0xb2, 0xae,
-	0xea, 0xe2, 0x0f, 0x08, 0x86, 0x8f, 0x6b, 0x83, 0x8b, 0xe9, 0x77, 0xa2, 0xab, 0x8c, 0xa6, 0x75,
-	0x5a, 0xb8, 0x36, 0x7c, 0xab, 0xd7, 0xf0, 0xbc, 0x34, 0x3c, 0x89, 0x2f, 0x25, 0xde, 0xa9, 0x96,
-	0xca, 0xe2, 0xfc, 0xe7, 0xc3, 0x3c, 0x3a, 0x38, 0xcc, 0xa3, 0x5f, 0x87, 0x79, 0xf4, 0xfa, 0x28,
-	0xdf, 0x77, 0x70, 0x94, 0xef, 0xfb, 0x7e, 0x94, 0xef, 0x7b, 0x3e, 0xa1, 0xb8, 0xdc, 0xd9, 0xb4,
-	0xea, 0x9e, 0xfd, 0xe2, 0x58, 0x43, 0xec, 0xf8, 0x8c, 0xaf, 0x0d, 0xca, 0xbf, 0xb0, 0xf2, 0x9f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x04, 0x0d, 0x4f, 0xe3, 0x5f, 0x07, 0x00, 0x00,
+	// 880 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0xde, 0xc9, 0x86, 0xb2, 0x79, 0x41, 0xed, 0x6a, 0x4a, 0x83, 0xeb, 0x2e, 0xd9, 0xe0, 0x16,
+	0xd8, 0x6a, 0x1b, 0x3b, 0x9b, 0x94, 0x3d, 0x2c, 0x08, 0x41, 0x8a, 0x04, 0x8b, 0x68, 0x55, 0x8c,
+	0xba, 0x12, 0x5c, 0xac, 0xe9, 0x7a, 0x94, 0xb5, 0xea, 0x78, 0x5c, 0xcf, 0x78, 0x61, 0x55, 0x95,
+	0x43, 0x4f, 0x1c, 0x91, 0xb8, 0x73, 0x43, 0xe2, 0x0f, 0xe8, 0x95, 0x23, 0x52, 0xd5, 0x53, 0x55,
+	0x2e, 0xa8, 0x42, 0x2b, 0x94, 0xe5, 0x0f, 0x41, 0x1e, 0x4f, 0x52, 0x27, 0xb6, 0xd3, 0xb4, 0x37,
+	0x7b, 0xe6, 0x7b, 0xdf, 0xfb, 0xde, 0x0f, 0x7f, 0x09, 0x18, 0xfb, 0x8c, 0x0f, 0x19, 0xb7, 0xe2,
+	0x70, 0x10, 0x11, 0x97, 0x5a, 0x87, 0x5b, 0xb7, 0xa9, 0x20, 0x5b, 0xd6, 0xdd, 0x98, 0x46, 0x47,
+	0x66, 0x18, 0x31, 0xc1, 0x70, 0x23, 0xc5, 0x98, 0x0a, 0x63, 0x2a, 0x8c, 0xbe, 0x36, 0x60, 0x6c,
+	0xe0, 0x53, 0x8b, 0x84, 0x9e, 0x45, 0x82, 0x80, 0x09, 0x22, 0x3c, 0x16, 0xf0, 0x34, 0x4a, 0xbf,
+	0x54, 0xc2, 0x3c, 0x66, 0x49, 0x51, 0xe7, 0x53, 0x94, 0x23, 0xdf, 0x2c, 0x95, 0x28, 0xbd, 0x7a,
+	0x73, 0xc0, 0x06, 0x2c, 0x3d, 0x4f, 0x9e, 0xd2, 0x53, 0xe3, 0x3c, 0xbc, 0xf5, 0x75, 0xa2, 0xed,
+	0x5a, 0x1c, 0x45, 0x34, 0x10, 0x37, 0x7d, 0x12, 0xd8, 0xf4, 0x6e, 0x4c, 0xb9, 0x30, 0xbe, 0x02,
+	0x2d, 0x7f, 0xc5, 0x43, 0x16, 0x70, 0x8a, 0x3b, 0x50, 0x0d, 0x7d, 0x12, 0x68, 0xa8, 0x85, 0x36,
+	0xea, 0xdd, 0x35, 0xb3, 0xb8, 0x24, 0x53, 0xc6, 0x48, 0xa4, 0xd1, 0x56, 0x89, 0x3e, 0x0d, 0x43,
+	0xdf, 0xa3, 0x6e, 0x26, 0x11, 0xc6, 0x50, 0x0d, 0xc8, 0x90, 0x4a, 0xb2, 0x9a, 0x2d, 0x9f, 0x8d,
+	0x2e, 0x68, 0x79, 0xb8, 0x4a, 0xde, 0x80, 0x53, 0x07, 0xd4, 0x1b, 0x1c, 0x08, 0x19, 0xb1, 0x6c,
+	0xab, 0x37, 0x63, 0x17, 0x0c, 0x19, 0x73, 0x2b, 0x55, 0xe1, 0x5e, 0x4b, 0xd0, 0x01, 0x8f, 0xf9,
+	0x37, 0x82, 0x08, 0x3a, 0xce, 0xb6, 0x0e, 0x75, 0x9f, 0x70, 0xe1, 0x4c, 0x51, 0x40, 0x72, 0xf4,
+	0x85, 0x3c, 0xd9, 0xa9, 0x68, 0xc8, 0xf8, 0x11, 0x2e, 0xce, 0xa5, 0x52, 0x4a, 0xae, 0x83, 0xa6,
+	0x4a, 0x76, 0x9d, 0xfd, 0x31, 0xc4, 0xe1, 0x09, 0x46, 0xab, 0xb4, 0xd0, 0xc6, 0x1b, 0xfd, 0xb3,
+	0xcf, 0x1e, 0xb6, 0xcf, 0xa4, 0xdd, 0x69, 0x73, 0xf7, 0x4e, 0xab, 0x63, 0x5e, 0xed, 0xd9, 0x8d,
+	0xb8, 0x90, 0x36, 0xc9, 0xfc, 0x65, 0x75, 0x05, 0xad, 0x56, 0x0c, 0x1b, 0x74, 0x99, 0xff, 0x3a,
+	0x73, 0x63, 0x9f, 0xee, 0xd1, 0x88, 0x27, 0xab, 0x90, 0x29, 0x61, 0x28, 0x2f, 0x9c, 0x4c, 0xdf,
+	0x20, 0x3d, 0xba, 0x41, 0x86, 0x74, 0xe7, 0xec, 0xd3, 0x7c, 0x56, 0xe3, 0x01, 0x82, 0x0b, 0x85,
+	0xa4, 0xaa, 0x98, 0x1b, 0x70, 0x46, 0xb1, 0x1e, 0xaa, 0x2b, 0x0d, 0xb5, 0x96, 0x37, 0xea, 0xdd,
+	0x77, 0xcb, 0xc6, 0x3b, 0x45, 0x64, 0x9f, 0x1e, 0x4e, 0xf1, 0x16, 0x8b, 0xb8, 0x02, 0xe7, 0xd2,
+	0xb9, 0xc6, 0xe2, 0x80, 0x45, 0x9e, 0x38, 0x52, 0x35, 0x15, 0xa1, 0xb7, 0x8d, 0xcf, 0xa1, 0x31,
+	0x8b, 0x56, 0x62, 0x35, 0x78, 0x9d, 0xb8, 0x6e, 0x44, 0x39, 0x57, 0xe5, 0x8f, 0x5f, 0x8b, 0x89,
+	0x7a, 0xb0, 0x96, 0x9d, 0xa7, 0x4d, 0x89, 0xeb, 0x05, 0x94, 0xf3, 0xa9, 0xec, 0x3f, 0x8c, 0x3f,
+	0xa6, 0x56, 0xc7, 0xec, 0x9a, 0x1d, 0xe3, 0xb8, 0x02, 0x6f, 0x97, 0x44, 0x29, 0x15, 0x17, 0xa0,
+	0x96, 0x2c, 0x77, 0x76, 0x0c, 0x2b, 0xc9, 0x41, 0x32, 0x04, 0xfc, 0x2d, 0xe0, 0x88, 0x12, 0xf7,
+	0xc8, 0x39, 0x64, 0xc2, 0x0b, 0x06, 0x4e, 0xc8, 0xbe, 0xa7, 0x91, 0x5c, 0x8b, 0x5a, 0x7f, 0xf3,
+	0xd1, 0xf1, 0xfa, 0xd2, 0xb3, 0xe3, 0xf5, 0x73, 0xa9, 0x50, 0xee, 0xde, 0x31, 0x3d, 0x66, 0x0d,
+	0x89, 0x38, 0x30, 0x77, 0x03, 0xf1, 0xf4, 0x61, 0x1b, 0x54, 0xcb, 0x77, 0x03, 0x61, 0xaf, 0x4a,
+	0x9a, 0x3d, 0xc9, 0x72, 0x33, 0x21, 0x49, 0xa8, 0x05, 0x13, 0xc4, 0x9f, 0xa6, 0x5e, 0x7e, 0x05,
+	0x6a, 0x49, 0x93, 0xa5, 0xbe, 0x05, 0xb5, 0x68, 0x5c, 0xa7, 0x56, 0x95, 0xf3, 0xdf, 0x2a, 0x9b,
+	0xff, 0x1e, 0xf1, 0x3d, 0x97, 0x08, 0x16, 0xcd, 0x36, 0xa8, 0x5f, 0x4d, 0x44, 0xd8, 0xcf, 0x99,
+	0x0a, 0x1b, 0xdc, 0x1d, 0xad, 0xc0, 0x6b, 0xb2, 0xc1, 0xf8, 0x57, 0x04, 0xf5, 0x8c, 0xcf, 0x60,
+	0xab, 0x2c, 0x65, 0x89, 0x59, 0xe9, 0x9d, 0xc5, 0x03, 0xd2, 0xd9, 0x19, 0x57, 0x1e, 0xfc, 0xf5,
+	0xdf, 0x2f, 0x95, 0xf7, 0xf0, 0x25, 0xab, 0xc4, 0x59, 0xf7, 0xd3, 0x20, 0x27, 0x19, 0x28, 0xfe,
+	0x0d, 0x41, 0x3d, 0xe3, 0x45, 0x2f, 0x10, 0x98, 0x37, 0x39, 0xbd, 0xb3, 0x78, 0x80, 0x12, 0xd8,
+	0x93, 0x02, 0xdb, 0x78, 0xb3, 0x4c, 0x20, 0x49, 0x83, 0xa4, 0x40, 0xeb, 0x5e, 0xb2, 0x83, 0xf7,
+	0xf1, 0x3f, 0x08, 0x1a, 0xc5, 0xa6, 0x85, 0x77, 0xe6, 0x2a, 0x98, 0x6b, 0x9a, 0xfa, 0x87, 0xaf,
+	0x14, 0xab, 0x0a, 0xd9, 0x95, 0x85, 0x7c, 0x82, 0x3f, 0xb6, 0xe6, 0xff, 0x86, 0xe5, 0x3c, 0xd4,
+	0xba, 0x97, 0x71, 0xea, 0xfb, 0x3f, 0x55, 0x10, 0xfe, 0x03, 0xc1, 0xe9, 0x69, 0xfb, 0xc2, 0xdd,
+	0xb9, 0xd2, 0x0a, 0x0d, 0x54, 0xef, 0xbd, 0x54, 0x8c, 0x2a, 0xa3, 0xff, 0x38, 0xef, 0x67, 0xb2,
+	0xb2, 0xcb, 0xf8, 0xfd, 0xb2, 0xca, 0x66, 0x0c, 0x15, 0xff, 0x8e, 0xa0, 0x36, 0x31, 0x33, 0xdc,
+	0x9e, 0xbf, 0x13, 0x33, 0x16, 0xa9, 0x9b, 0x8b, 0xc2, 0x95, 0xe0, 0x8f, 0xf2, 0x82, 0xb7, 0xa5,
+	0xe0, 0x8b, 0xf8, 0x9d, 0xd2, 0x9d, 0x9a, 0x88, 0xfb, 0x13, 0xc1, 0xea, 0xec, 0x77, 0x8d, 0xaf,
+	0x2e, 0xb2, 0x07, 0xb3, 0xee, 0xaa, 0x7f, 0xf0, 0x92, 0x51, 0x4a, 0xff, 0x67, 0x8f, 0xf3, 0x9e,
+	0x21, 0xf5, 0x6f, 0xe2, 0xcb, 0x2f, 0x58, 0x25, 0x67, 0xe2, 0x3c, 0xfd, 0xed, 0x47, 0xa3, 0x26,
+	0x7a, 0x32, 0x6a, 0xa2, 0x7f, 0x47, 0x4d, 0xf4, 0xf3, 0x49, 0x73, 0xe9, 0xc9, 0x49, 0x73, 0xe9,
+	0xef, 0x93, 0xe6, 0xd2, 0x77, 0x6b, 0x53, 0x0e, 0x39, 0x49, 0x64, 0x89, 0xa3, 0x90, 0xf2, 0xdb,
+	0xa7, 0xe4, 0x1f, 0xa4, 0xde, 0xff, 0x03, 0x00, 0xf0, 0x4c, 0xa2, 0x4a, 0xd3, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -575,6 +689,9 @@ type QueryClient interface {
 	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error)
+	// UpgradeReadiness returns the validators ready for the current upgrade plan, and
+	// their share of the voting power.
+	UpgradeReadiness(ctx context.Context, in *QueryUpgradeReadinessRequest, opts ...grpc.CallOption) (*QueryUpgradeReadinessResponse, error)
 }
 
 type queryClient struct {
@@ -631,6 +748,15 @@ func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest,
 	return out, nil
 }
 
+func (c *queryClient) UpgradeReadiness(ctx context.Context, in *QueryUpgradeReadinessRequest, opts ...grpc.CallOption) (*QueryUpgradeReadinessResponse, error) {
+	out := new(QueryUpgradeReadinessResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/UpgradeReadiness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CurrentPlan queries the current upgrade plan.
@@ -648,6 +774,9 @@ type QueryServer interface {
 	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error)
+	// UpgradeReadiness returns the validators ready for the current upgrade plan, and
+	// their share of the voting power.
+	UpgradeReadiness(context.Context, *QueryUpgradeReadinessRequest) (*QueryUpgradeReadinessResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -669,6 +798,9 @@ func (*UnimplementedQueryServer) ModuleVersions(ctx context.Context, req *QueryM
 func (*UnimplementedQueryServer) Authority(ctx context.Context, req *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
+func (*UnimplementedQueryServer) UpgradeReadiness(ctx context.Context, req *QueryUpgradeReadinessRequest) (*QueryUpgradeReadinessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeReadiness not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -764,6 +896,24 @@ func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UpgradeReadiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpgradeReadinessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpgradeReadiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/UpgradeReadiness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpgradeReadiness(ctx, req.(*QueryUpgradeReadinessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Query",
@@ -789,6 +939,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
 		},
+		{
+			MethodName: "UpgradeReadiness",
+			Handler:    _Query_UpgradeReadiness_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -1088,6 +1242,93 @@ func (m *QueryAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryUpgradeReadinessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeReadinessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeReadinessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradeReadinessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeReadinessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeReadinessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Readiness) > 0 {
+		for iNdEx := len(m.Readiness) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Readiness[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.TotalVotingPower.Size()
+		i -= size
+		if _, err := m.TotalVotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ReadyVotingPower.Size()
+		i -= size
+		if _, err := m.ReadyVotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PlanName) > 0 {
+		i -= len(m.PlanName)
+		copy(dAtA[i:], m.PlanName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1221,6 +1462,38 @@ func (m *QueryAuthorityResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryUpgradeReadinessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUpgradeReadinessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ReadyVotingPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalVotingPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Readiness) > 0 {
+		for _, e := range m.Readiness {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1965,6 +2238,240 @@ func (m *QueryAuthorityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryUpgradeReadinessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeReadinessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeReadinessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpgradeReadinessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeReadinessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeReadinessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyVotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReadyVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Readiness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Readiness = append(m.Readiness, ValidatorUpgradeReadiness{})
+			if err := m.Readiness[len(m.Readiness)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
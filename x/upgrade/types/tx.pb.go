@@ -206,44 +206,158 @@ func (m *MsgCancelUpgradeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelUpgradeResponse proto.InternalMessageInfo
 
+// MsgSignalUpgradeReadiness is the Msg/SignalUpgradeReadiness request type.
+type MsgSignalUpgradeReadiness struct {
+	// validator_address is the operator address of the validator ready for the upgrade.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// plan_name is the name of the scheduled upgrade plan.
+	PlanName string `protobuf:"bytes,2,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty"`
+	// checksum is the checksum of the binary installed by the validator, in the
+	// "<type>:<hex>" format. It is required if the plan info has binary descriptors,
+	// and must match one of them.
+	Checksum string `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
+}
+
+func (m *MsgSignalUpgradeReadiness) Reset()         { *m = MsgSignalUpgradeReadiness{} }
+func (m *MsgSignalUpgradeReadiness) String() string { return proto.CompactTextString(m) }
+func (*MsgSignalUpgradeReadiness) ProtoMessage()    {}
+func (*MsgSignalUpgradeReadiness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{4}
+}
+func (m *MsgSignalUpgradeReadiness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSignalUpgradeReadiness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSignalUpgradeReadiness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSignalUpgradeReadiness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSignalUpgradeReadiness.Merge(m, src)
+}
+func (m *MsgSignalUpgradeReadiness) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSignalUpgradeReadiness) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSignalUpgradeReadiness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSignalUpgradeReadiness proto.InternalMessageInfo
+
+func (m *MsgSignalUpgradeReadiness) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgSignalUpgradeReadiness) GetPlanName() string {
+	if m != nil {
+		return m.PlanName
+	}
+	return ""
+}
+
+func (m *MsgSignalUpgradeReadiness) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
+	}
+	return ""
+}
+
+// MsgSignalUpgradeReadinessResponse is the Msg/SignalUpgradeReadiness response type.
+type MsgSignalUpgradeReadinessResponse struct {
+}
+
+func (m *MsgSignalUpgradeReadinessResponse) Reset()         { *m = MsgSignalUpgradeReadinessResponse{} }
+func (m *MsgSignalUpgradeReadinessResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSignalUpgradeReadinessResponse) ProtoMessage()    {}
+func (*MsgSignalUpgradeReadinessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{5}
+}
+func (m *MsgSignalUpgradeReadinessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSignalUpgradeReadinessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSignalUpgradeReadinessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSignalUpgradeReadinessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSignalUpgradeReadinessResponse.Merge(m, src)
+}
+func (m *MsgSignalUpgradeReadinessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSignalUpgradeReadinessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSignalUpgradeReadinessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSignalUpgradeReadinessResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSoftwareUpgrade)(nil), "cosmos.upgrade.v1beta1.MsgSoftwareUpgrade")
 	proto.RegisterType((*MsgSoftwareUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.MsgSoftwareUpgradeResponse")
 	proto.RegisterType((*MsgCancelUpgrade)(nil), "cosmos.upgrade.v1beta1.MsgCancelUpgrade")
 	proto.RegisterType((*MsgCancelUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.MsgCancelUpgradeResponse")
+	proto.RegisterType((*MsgSignalUpgradeReadiness)(nil), "cosmos.upgrade.v1beta1.MsgSignalUpgradeReadiness")
+	proto.RegisterType((*MsgSignalUpgradeReadinessResponse)(nil), "cosmos.upgrade.v1beta1.MsgSignalUpgradeReadinessResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/upgrade/v1beta1/tx.proto", fileDescriptor_2852c16e3ab79fef) }
 
 var fileDescriptor_2852c16e3ab79fef = []byte{
-	// 423 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0x2d, 0x48, 0x2f, 0x4a, 0x4c, 0x49, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x83, 0x28, 0xd0,
-	0x83, 0x2a, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd1, 0x07, 0xb1,
-	0x20, 0xaa, 0xa5, 0x24, 0x21, 0xaa, 0xe3, 0x21, 0x12, 0x50, 0xad, 0x10, 0x29, 0x15, 0x1c, 0x36,
-	0xc1, 0x0c, 0x86, 0xa8, 0x12, 0x87, 0xaa, 0xca, 0x2d, 0x4e, 0xd7, 0x2f, 0x33, 0x04, 0x51, 0x50,
-	0x09, 0xc1, 0xc4, 0xdc, 0xcc, 0xbc, 0x7c, 0x7d, 0x30, 0x09, 0x11, 0x52, 0x3a, 0xc7, 0xc8, 0x25,
-	0xe4, 0x5b, 0x9c, 0x1e, 0x9c, 0x9f, 0x56, 0x52, 0x9e, 0x58, 0x94, 0x1a, 0x0a, 0x31, 0x48, 0xc8,
-	0x8c, 0x8b, 0x33, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4, 0x52, 0x82, 0x51, 0x81, 0x51,
-	0x83, 0xd3, 0x49, 0xe2, 0xd2, 0x16, 0x5d, 0x11, 0xa8, 0x6b, 0x1c, 0x53, 0x52, 0x8a, 0x52, 0x8b,
-	0x8b, 0x83, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x83, 0x10, 0x4a, 0x85, 0xac, 0xb9, 0x58, 0x0a, 0x72,
-	0x12, 0xf3, 0x24, 0x98, 0x14, 0x18, 0x35, 0xb8, 0x8d, 0x64, 0xf4, 0xb0, 0x7b, 0x5c, 0x2f, 0x20,
-	0x27, 0x31, 0xcf, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06, 0x81,
-	0x35, 0x59, 0x39, 0x5f, 0xda, 0xa2, 0xcb, 0x0f, 0xd1, 0xa1, 0x5b, 0x9c, 0x92, 0xad, 0x60, 0xa0,
-	0x67, 0x62, 0xd6, 0xf4, 0x7c, 0x83, 0x16, 0xc2, 0xfc, 0xae, 0xe7, 0x1b, 0xb4, 0x64, 0x11, 0x2a,
-	0xf4, 0x31, 0x5d, 0xae, 0x64, 0xc8, 0x25, 0x85, 0x29, 0x1a, 0x94, 0x5a, 0x5c, 0x90, 0x9f, 0x57,
-	0x9c, 0x6a, 0x25, 0x8c, 0xc5, 0x0a, 0xa5, 0x5e, 0x46, 0x2e, 0x01, 0xdf, 0xe2, 0x74, 0xe7, 0xc4,
-	0xbc, 0xe4, 0xd4, 0x1c, 0x0a, 0x43, 0xc0, 0xca, 0x91, 0x28, 0x4f, 0x48, 0xa3, 0x7a, 0x02, 0xc5,
-	0x6a, 0x25, 0x7d, 0x2e, 0x09, 0x74, 0x31, 0xbc, 0x1e, 0x30, 0x9a, 0xc1, 0xc4, 0xc5, 0xec, 0x5b,
-	0x9c, 0x2e, 0xd4, 0xc6, 0xc8, 0xc5, 0x8f, 0x1e, 0x93, 0x5a, 0xb8, 0xe2, 0x00, 0x33, 0x94, 0xa4,
-	0x8c, 0x88, 0x57, 0x0b, 0x73, 0x90, 0x92, 0xf0, 0x29, 0x4c, 0x07, 0x09, 0x35, 0x30, 0x72, 0xf1,
-	0xa2, 0x06, 0xa7, 0x06, 0x1e, 0xa3, 0x51, 0x54, 0x4a, 0x19, 0x10, 0xab, 0x12, 0xaf, 0x13, 0xa4,
-	0x58, 0x1b, 0x40, 0x29, 0xcb, 0xc9, 0xec, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f,
-	0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18,
-	0xa2, 0x64, 0x20, 0x3a, 0x8a, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x2b, 0xe0, 0x79, 0xaa, 0xa4,
-	0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x9c, 0x3d, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x63,
-	0xb7, 0xaa, 0xa0, 0xdc, 0x03, 0x00, 0x00,
+	// 572 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x3f, 0x6f, 0xd3, 0x5e,
+	0x14, 0xcd, 0xfb, 0xe5, 0x07, 0x6a, 0x1e, 0x42, 0x6d, 0xdd, 0xaa, 0xa4, 0x6e, 0x71, 0x53, 0xab,
+	0x43, 0x14, 0x29, 0xef, 0x25, 0x01, 0x45, 0x10, 0xa6, 0xa6, 0x73, 0x2a, 0x94, 0x0a, 0x06, 0x96,
+	0xe8, 0x35, 0x7e, 0xb8, 0x56, 0x62, 0x3f, 0xcb, 0xcf, 0x09, 0xed, 0x56, 0x31, 0x30, 0x20, 0x21,
+	0xb1, 0xf3, 0x05, 0x18, 0x33, 0x44, 0x7c, 0x86, 0x8a, 0x01, 0x55, 0x99, 0x98, 0x10, 0x4a, 0x86,
+	0x7c, 0x05, 0x46, 0x64, 0x3f, 0x9b, 0xc8, 0x76, 0x12, 0x05, 0xb1, 0xe4, 0xcf, 0xbd, 0xe7, 0xdc,
+	0x7b, 0xce, 0xbd, 0x57, 0x0f, 0x1e, 0xb4, 0x19, 0x37, 0x19, 0xc7, 0x3d, 0x5b, 0x77, 0x88, 0x46,
+	0x71, 0xbf, 0x7c, 0x4e, 0x5d, 0x52, 0xc6, 0xee, 0x25, 0xb2, 0x1d, 0xe6, 0x32, 0x69, 0x47, 0x00,
+	0x50, 0x00, 0x40, 0x01, 0x40, 0xde, 0xd6, 0x99, 0xce, 0x7c, 0x08, 0xf6, 0x7e, 0x09, 0xb4, 0xbc,
+	0x2b, 0xd0, 0x2d, 0x91, 0x08, 0xa8, 0x22, 0x75, 0xb4, 0xa0, 0x53, 0x58, 0x58, 0xa0, 0x1e, 0x04,
+	0x28, 0x93, 0xeb, 0xb8, 0x5f, 0xf6, 0xbe, 0x82, 0xc4, 0x26, 0x31, 0x0d, 0x8b, 0x61, 0xff, 0x53,
+	0x84, 0xd4, 0x6f, 0x00, 0x4a, 0x0d, 0xae, 0x9f, 0xb1, 0xd7, 0xee, 0x1b, 0xe2, 0xd0, 0x17, 0xa2,
+	0x90, 0x54, 0x85, 0x19, 0xd2, 0x73, 0x2f, 0x98, 0x63, 0xb8, 0x57, 0x59, 0x90, 0x03, 0xf9, 0x4c,
+	0x3d, 0x3b, 0x1a, 0x16, 0xb7, 0x03, 0x35, 0xc7, 0x9a, 0xe6, 0x50, 0xce, 0xcf, 0x5c, 0xc7, 0xb0,
+	0xf4, 0xe6, 0x0c, 0x2a, 0x3d, 0x83, 0xff, 0xdb, 0x5d, 0x62, 0x65, 0xff, 0xcb, 0x81, 0xfc, 0xbd,
+	0xca, 0x3e, 0x9a, 0x6f, 0x1c, 0x3d, 0xef, 0x12, 0xab, 0x9e, 0xb9, 0xf9, 0x71, 0x90, 0xfa, 0x3c,
+	0x1d, 0x14, 0x40, 0xd3, 0x27, 0xd5, 0x4e, 0x46, 0xc3, 0xe2, 0xba, 0x60, 0x14, 0xb9, 0xd6, 0xc9,
+	0x95, 0xd0, 0xe3, 0xea, 0xdb, 0xe9, 0xa0, 0x30, 0xab, 0xff, 0x7e, 0x3a, 0x28, 0x3c, 0x9c, 0x21,
+	0x70, 0x52, 0xb9, 0x5a, 0x86, 0x72, 0x32, 0xda, 0xa4, 0xdc, 0x66, 0x16, 0xa7, 0xb5, 0xad, 0x39,
+	0x2d, 0xd4, 0x0f, 0x00, 0x6e, 0x34, 0xb8, 0x7e, 0x42, 0xac, 0x36, 0xed, 0xfe, 0xe3, 0x04, 0x6a,
+	0xc7, 0x2b, 0x99, 0xd8, 0x8b, 0x9a, 0x88, 0xb4, 0x56, 0x31, 0xcc, 0xc6, 0x63, 0xcb, 0x0d, 0xfc,
+	0x02, 0x70, 0xd7, 0x33, 0x6d, 0xe8, 0x16, 0x99, 0x31, 0x88, 0x66, 0x58, 0x94, 0x73, 0xe9, 0x14,
+	0x6e, 0xf6, 0x49, 0xd7, 0xd0, 0x88, 0xcb, 0x9c, 0x16, 0x11, 0xba, 0x03, 0x47, 0x87, 0xa3, 0x61,
+	0x31, 0x98, 0x27, 0x7a, 0x19, 0x62, 0xa2, 0xd6, 0x36, 0xfa, 0xb1, 0xb8, 0xb4, 0x07, 0x33, 0xde,
+	0xba, 0x5a, 0x16, 0x31, 0xa9, 0xbf, 0xe8, 0x4c, 0x73, 0xcd, 0x0b, 0x9c, 0x12, 0x93, 0x4a, 0x32,
+	0x5c, 0x6b, 0x5f, 0xd0, 0x76, 0x87, 0xf7, 0xcc, 0x6c, 0x5a, 0xe4, 0xc2, 0xff, 0xb5, 0xa6, 0xa7,
+	0xfd, 0x32, 0x3c, 0xd6, 0x5c, 0x09, 0x55, 0x50, 0xc9, 0x1b, 0x4d, 0x52, 0x9e, 0x37, 0xa2, 0xa3,
+	0xd8, 0x9e, 0xe7, 0x9a, 0x53, 0x9f, 0xc0, 0xc3, 0x85, 0xc9, 0xe8, 0xd0, 0x62, 0x8d, 0x2b, 0x5f,
+	0xd2, 0x30, 0xdd, 0xe0, 0xba, 0xf4, 0x0e, 0xc0, 0xf5, 0xf8, 0xf9, 0x17, 0x16, 0x1d, 0x6e, 0xf2,
+	0xb4, 0xe4, 0xca, 0xea, 0xd8, 0x50, 0x90, 0xba, 0xf5, 0x35, 0xb9, 0x45, 0xe9, 0x1a, 0xc0, 0xfb,
+	0xd1, 0x1b, 0xcc, 0x2f, 0x29, 0x1d, 0x41, 0xca, 0xa5, 0x55, 0x91, 0xcb, 0x25, 0x7c, 0x02, 0x70,
+	0x67, 0xc1, 0x15, 0x95, 0x97, 0xd9, 0x9c, 0x4b, 0x91, 0x9f, 0xfe, 0x35, 0x25, 0xaa, 0x2e, 0xb6,
+	0x31, 0xf9, 0xce, 0xb5, 0xf7, 0x58, 0xd4, 0xab, 0x37, 0x63, 0x05, 0xdc, 0x8e, 0x15, 0xf0, 0x73,
+	0xac, 0x80, 0x8f, 0x13, 0x25, 0x75, 0x3b, 0x51, 0x52, 0xdf, 0x27, 0x4a, 0xea, 0xd5, 0xbe, 0xe8,
+	0xc7, 0xb5, 0x0e, 0x32, 0x18, 0xfe, 0x43, 0xc7, 0xee, 0x95, 0x4d, 0xf9, 0xf9, 0x5d, 0xff, 0xc5,
+	0x7b, 0xf4, 0x7b, 0x00, 0xf0, 0xf6, 0xdf, 0x93, 0xaf, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -263,6 +377,9 @@ type MsgClient interface {
 	// CancelUpgrade is a governance operation for cancelling a previously
 	// approved software upgrade.
 	CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opts ...grpc.CallOption) (*MsgCancelUpgradeResponse, error)
+	// SignalUpgradeReadiness is used by a validator to signal it is ready for the
+	// scheduled upgrade, having installed and verified its binary.
+	SignalUpgradeReadiness(ctx context.Context, in *MsgSignalUpgradeReadiness, opts ...grpc.CallOption) (*MsgSignalUpgradeReadinessResponse, error)
 }
 
 type msgClient struct {
@@ -291,6 +408,15 @@ func (c *msgClient) CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opt
 	return out, nil
 }
 
+func (c *msgClient) SignalUpgradeReadiness(ctx context.Context, in *MsgSignalUpgradeReadiness, opts ...grpc.CallOption) (*MsgSignalUpgradeReadinessResponse, error) {
+	out := new(MsgSignalUpgradeReadinessResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Msg/SignalUpgradeReadiness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SoftwareUpgrade is a governance operation for initiating a software upgrade.
@@ -298,6 +424,9 @@ type MsgServer interface {
 	// CancelUpgrade is a governance operation for cancelling a previously
 	// approved software upgrade.
 	CancelUpgrade(context.Context, *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error)
+	// SignalUpgradeReadiness is used by a validator to signal it is ready for the
+	// scheduled upgrade, having installed and verified its binary.
+	SignalUpgradeReadiness(context.Context, *MsgSignalUpgradeReadiness) (*MsgSignalUpgradeReadinessResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -310,6 +439,9 @@ func (*UnimplementedMsgServer) SoftwareUpgrade(ctx context.Context, req *MsgSoft
 func (*UnimplementedMsgServer) CancelUpgrade(ctx context.Context, req *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelUpgrade not implemented")
 }
+func (*UnimplementedMsgServer) SignalUpgradeReadiness(ctx context.Context, req *MsgSignalUpgradeReadiness) (*MsgSignalUpgradeReadinessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalUpgradeReadiness not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -351,6 +483,24 @@ func _Msg_CancelUpgrade_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SignalUpgradeReadiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSignalUpgradeReadiness)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SignalUpgradeReadiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Msg/SignalUpgradeReadiness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SignalUpgradeReadiness(ctx, req.(*MsgSignalUpgradeReadiness))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Msg",
@@ -364,6 +514,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelUpgrade",
 			Handler:    _Msg_CancelUpgrade_Handler,
 		},
+		{
+			MethodName: "SignalUpgradeReadiness",
+			Handler:    _Msg_SignalUpgradeReadiness_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/tx.proto",
@@ -485,6 +639,73 @@ func (m *MsgCancelUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSignalUpgradeReadiness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignalUpgradeReadiness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignalUpgradeReadiness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PlanName) > 0 {
+		i -= len(m.PlanName)
+		copy(dAtA[i:], m.PlanName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PlanName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSignalUpgradeReadinessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignalUpgradeReadinessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignalUpgradeReadinessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -542,6 +763,36 @@ func (m *MsgCancelUpgradeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSignalUpgradeReadiness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PlanName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSignalUpgradeReadinessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -845,6 +1096,202 @@ func (m *MsgCancelUpgradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSignalUpgradeReadiness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignalUpgradeReadiness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignalUpgradeReadiness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSignalUpgradeReadinessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignalUpgradeReadinessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignalUpgradeReadinessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
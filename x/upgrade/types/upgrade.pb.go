@@ -223,11 +223,57 @@ func (m *ModuleVersion) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
 
+// ValidatorUpgradeReadiness is the readiness signal of a validator for an upgrade.
+type ValidatorUpgradeReadiness struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// plan_name is the name of the upgrade plan the validator is ready for.
+	PlanName string `protobuf:"bytes,2,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty"`
+	// checksum is the checksum of the binary installed by the validator, if any.
+	Checksum string `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// height is the height at which the validator signaled its readiness.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ValidatorUpgradeReadiness) Reset()         { *m = ValidatorUpgradeReadiness{} }
+func (m *ValidatorUpgradeReadiness) String() string { return proto.CompactTextString(m) }
+func (*ValidatorUpgradeReadiness) ProtoMessage()    {}
+func (*ValidatorUpgradeReadiness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{4}
+}
+func (m *ValidatorUpgradeReadiness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorUpgradeReadiness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorUpgradeReadiness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorUpgradeReadiness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorUpgradeReadiness.Merge(m, src)
+}
+func (m *ValidatorUpgradeReadiness) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorUpgradeReadiness) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorUpgradeReadiness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorUpgradeReadiness proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Plan)(nil), "cosmos.upgrade.v1beta1.Plan")
 	proto.RegisterType((*SoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.SoftwareUpgradeProposal")
 	proto.RegisterType((*CancelSoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal")
 	proto.RegisterType((*ModuleVersion)(nil), "cosmos.upgrade.v1beta1.ModuleVersion")
+	proto.RegisterType((*ValidatorUpgradeReadiness)(nil), "cosmos.upgrade.v1beta1.ValidatorUpgradeReadiness")
 }
 
 func init() {
@@ -235,41 +281,47 @@ func init() {
 }
 
 var fileDescriptor_ccf2a7d4d7b48dca = []byte{
-	// 532 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xbf, 0x6e, 0xd3, 0x40,
-	0x18, 0xcf, 0xb5, 0x6e, 0x51, 0x2e, 0x42, 0x15, 0x26, 0xb4, 0xd7, 0xa8, 0x38, 0x96, 0xc5, 0x10,
-	0x55, 0x8a, 0x4d, 0x5b, 0x26, 0x33, 0x20, 0x92, 0x11, 0x90, 0x8a, 0x0b, 0x1d, 0x58, 0xa2, 0x4b,
-	0x7c, 0x71, 0xad, 0xda, 0x77, 0x96, 0xef, 0x12, 0xc8, 0x2b, 0x30, 0xf5, 0x11, 0x18, 0x11, 0x53,
-	0x87, 0x3e, 0x44, 0xc4, 0x54, 0x31, 0x21, 0x21, 0xf1, 0x27, 0x19, 0xca, 0xc6, 0x2b, 0xa0, 0xbb,
-	0xb3, 0xab, 0x08, 0x0a, 0x62, 0x60, 0x89, 0xbe, 0xef, 0xbb, 0xef, 0x97, 0xdf, 0x9f, 0x3b, 0xc3,
-	0x3b, 0x03, 0xc6, 0x53, 0xc6, 0xbd, 0x51, 0x16, 0xe5, 0x38, 0x24, 0xde, 0x78, 0xa7, 0x4f, 0x04,
-	0xde, 0x29, 0x7b, 0x37, 0xcb, 0x99, 0x60, 0xe6, 0xba, 0xde, 0x72, 0xcb, 0x69, 0xb1, 0xd5, 0xd8,
-	0x8c, 0x18, 0x8b, 0x12, 0xe2, 0xa9, 0xad, 0xfe, 0x68, 0xe8, 0x61, 0x3a, 0xd1, 0x90, 0x46, 0x3d,
-	0x62, 0x11, 0x53, 0xa5, 0x27, 0xab, 0x62, 0xda, 0xfc, 0x15, 0x20, 0xe2, 0x94, 0x70, 0x81, 0xd3,
-	0xac, 0x58, 0xd8, 0xd4, 0x4c, 0x3d, 0x8d, 0x2c, 0x68, 0xf5, 0xd1, 0x0d, 0x9c, 0xc6, 0x94, 0x79,
-	0xea, 0x57, 0x8f, 0x9c, 0x1f, 0x00, 0x1a, 0xfb, 0x09, 0xa6, 0xa6, 0x09, 0x0d, 0x8a, 0x53, 0x82,
-	0x80, 0x0d, 0x5a, 0xd5, 0x40, 0xd5, 0xe6, 0x03, 0x68, 0xc8, 0x7f, 0x47, 0x4b, 0x36, 0x68, 0xd5,
-	0x76, 0x1b, 0xae, 0xa6, 0x76, 0x4b, 0x6a, 0xf7, 0x59, 0x49, 0xdd, 0x59, 0x9b, 0x7e, 0x6e, 0x56,
-	0x4e, 0xbe, 0x34, 0xc1, 0xdb, 0x8b, 0xd3, 0x6d, 0x80, 0x40, 0xa0, 0x80, 0xe6, 0x3a, 0x5c, 0x3d,
-	0x22, 0x71, 0x74, 0x24, 0xd0, 0xb2, 0x0d, 0x5a, 0xcb, 0x41, 0xd1, 0x49, 0xb2, 0x98, 0x0e, 0x19,
-	0x32, 0x34, 0x99, 0xac, 0xcd, 0xc7, 0xf0, 0x56, 0x11, 0x4e, 0xd8, 0x1b, 0x24, 0x31, 0xa1, 0xa2,
-	0xc7, 0x05, 0x16, 0x04, 0xad, 0x28, 0xf6, 0xfa, 0x6f, 0xec, 0x0f, 0xe9, 0xa4, 0xb3, 0x84, 0x40,
-	0x70, 0xb3, 0x84, 0x75, 0x15, 0xea, 0x40, 0x82, 0x7c, 0xf4, 0xfd, 0x4d, 0x13, 0xbc, 0xbe, 0x38,
-	0xdd, 0x5e, 0xd3, 0x09, 0xb4, 0x79, 0x78, 0xec, 0x49, 0xa3, 0xce, 0x27, 0x00, 0x37, 0x0e, 0xd8,
-	0x50, 0xbc, 0xc4, 0x39, 0x79, 0xae, 0x91, 0xfb, 0x39, 0xcb, 0x18, 0xc7, 0x89, 0x59, 0x87, 0x2b,
-	0x22, 0x16, 0x49, 0x99, 0x82, 0x6e, 0x4c, 0x1b, 0xd6, 0x42, 0xc2, 0x07, 0x79, 0x9c, 0x89, 0x98,
-	0x51, 0x95, 0x46, 0x35, 0x58, 0x1c, 0x99, 0xf7, 0xa1, 0x91, 0x25, 0x98, 0x2a, 0x97, 0xb5, 0xdd,
-	0x2d, 0xf7, 0xea, 0xcb, 0x76, 0x25, 0x7f, 0xa7, 0x2a, 0xa3, 0x52, 0x31, 0x05, 0x0a, 0xe4, 0x3f,
-	0x92, 0x52, 0xdf, 0x9f, 0xb5, 0x1b, 0x05, 0x2a, 0x62, 0xe3, 0x4b, 0x44, 0x97, 0x51, 0x41, 0xa8,
-	0x90, 0x46, 0x9c, 0x05, 0x23, 0x7f, 0xd0, 0x8f, 0x80, 0xf3, 0x0e, 0xc0, 0xdb, 0x5d, 0x4c, 0x07,
-	0x24, 0xf9, 0xcf, 0x1e, 0xfd, 0xa7, 0xff, 0x26, 0xb3, 0xb5, 0x20, 0xf3, 0xaf, 0x42, 0x10, 0x70,
-	0x0e, 0xe1, 0xf5, 0x27, 0x2c, 0x1c, 0x25, 0xe4, 0x90, 0xe4, 0x5c, 0xe6, 0x78, 0xd5, 0x23, 0x44,
-	0xf0, 0xda, 0x58, 0x1f, 0x2b, 0x55, 0x46, 0x50, 0xb6, 0xfe, 0x86, 0x54, 0xf4, 0xe1, 0xac, 0xbd,
-	0x70, 0xc5, 0xf6, 0x5d, 0xf7, 0xde, 0x5e, 0xc7, 0x9f, 0x7e, 0xb3, 0x2a, 0xd3, 0x99, 0x05, 0xce,
-	0x67, 0x16, 0xf8, 0x3a, 0xb3, 0xc0, 0xc9, 0xdc, 0xaa, 0x9c, 0xcf, 0xad, 0xca, 0xc7, 0xb9, 0x55,
-	0x79, 0xb1, 0xa5, 0xd7, 0x79, 0x78, 0xec, 0xc6, 0xcc, 0x7b, 0x75, 0xf9, 0xe1, 0x8a, 0x49, 0x46,
-	0x78, 0x7f, 0x55, 0xbd, 0xaf, 0xbd, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9b, 0x6a, 0x37, 0x42,
-	0xd7, 0x03, 0x00, 0x00,
+	// 634 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x4f, 0xd4, 0x4e,
+	0x18, 0xde, 0x81, 0xc2, 0x8f, 0x1d, 0xf2, 0x0b, 0x52, 0x10, 0xca, 0x0a, 0xdd, 0x75, 0xe3, 0x61,
+	0x43, 0xb2, 0x2d, 0x7f, 0x3c, 0xd5, 0x83, 0x61, 0x39, 0xaa, 0x04, 0x8b, 0x72, 0xf0, 0xb2, 0x19,
+	0xda, 0xa1, 0x4c, 0x68, 0x67, 0x9a, 0xce, 0xec, 0x2a, 0x5f, 0xc1, 0x13, 0x1f, 0xc1, 0xa3, 0xf1,
+	0xc4, 0x81, 0x0f, 0x41, 0x3c, 0x21, 0x27, 0x13, 0x13, 0xff, 0xb0, 0x07, 0xbc, 0xf9, 0x15, 0xcc,
+	0xcc, 0xb4, 0x9b, 0x06, 0xd1, 0x78, 0xf0, 0xd2, 0xbc, 0xef, 0x3b, 0xef, 0x33, 0xcf, 0xf3, 0x3e,
+	0x33, 0x53, 0x78, 0x2f, 0x60, 0x3c, 0x61, 0xdc, 0xed, 0xa5, 0x51, 0x86, 0x42, 0xec, 0xf6, 0x57,
+	0xf7, 0xb0, 0x40, 0xab, 0x45, 0xee, 0xa4, 0x19, 0x13, 0xcc, 0x9c, 0xd3, 0x5d, 0x4e, 0x51, 0xcd,
+	0xbb, 0x6a, 0x0b, 0x11, 0x63, 0x51, 0x8c, 0x5d, 0xd5, 0xb5, 0xd7, 0xdb, 0x77, 0x11, 0x3d, 0xd2,
+	0x90, 0xda, 0x6c, 0xc4, 0x22, 0xa6, 0x42, 0x57, 0x46, 0x79, 0xb5, 0x7e, 0x1d, 0x20, 0x48, 0x82,
+	0xb9, 0x40, 0x49, 0x9a, 0x37, 0x2c, 0x68, 0xa6, 0xae, 0x46, 0xe6, 0xb4, 0x7a, 0x69, 0x1a, 0x25,
+	0x84, 0x32, 0x57, 0x7d, 0x75, 0xa9, 0xf9, 0x03, 0x40, 0x63, 0x3b, 0x46, 0xd4, 0x34, 0xa1, 0x41,
+	0x51, 0x82, 0x2d, 0xd0, 0x00, 0xad, 0xaa, 0xaf, 0x62, 0xf3, 0x21, 0x34, 0xe4, 0xee, 0xd6, 0x48,
+	0x03, 0xb4, 0x26, 0xd7, 0x6a, 0x8e, 0xa6, 0x76, 0x0a, 0x6a, 0xe7, 0x59, 0x41, 0xdd, 0x99, 0x3a,
+	0xfb, 0x5c, 0xaf, 0x1c, 0x7f, 0xa9, 0x83, 0xb7, 0x57, 0x27, 0xcb, 0xc0, 0x02, 0xbe, 0x02, 0x9a,
+	0x73, 0x70, 0xfc, 0x00, 0x93, 0xe8, 0x40, 0x58, 0xa3, 0x0d, 0xd0, 0x1a, 0xf5, 0xf3, 0x4c, 0x92,
+	0x11, 0xba, 0xcf, 0x2c, 0x43, 0x93, 0xc9, 0xd8, 0x7c, 0x0c, 0x6f, 0xe7, 0xe6, 0x84, 0xdd, 0x20,
+	0x26, 0x98, 0x8a, 0x2e, 0x17, 0x48, 0x60, 0x6b, 0x4c, 0xb1, 0xcf, 0xfe, 0xc2, 0xbe, 0x41, 0x8f,
+	0x3a, 0x23, 0x16, 0xf0, 0x67, 0x0a, 0xd8, 0xa6, 0x42, 0xed, 0x48, 0x90, 0x67, 0x7d, 0x7f, 0x53,
+	0x07, 0xaf, 0xaf, 0x4e, 0x96, 0xa7, 0xb4, 0x03, 0x6d, 0x1e, 0x1e, 0xba, 0x72, 0xd0, 0xe6, 0x27,
+	0x00, 0xe7, 0x77, 0xd8, 0xbe, 0x78, 0x89, 0x32, 0xfc, 0x5c, 0x23, 0xb7, 0x33, 0x96, 0x32, 0x8e,
+	0x62, 0x73, 0x16, 0x8e, 0x09, 0x22, 0xe2, 0xc2, 0x05, 0x9d, 0x98, 0x0d, 0x38, 0x19, 0x62, 0x1e,
+	0x64, 0x24, 0x15, 0x84, 0x51, 0xe5, 0x46, 0xd5, 0x2f, 0x97, 0xcc, 0x07, 0xd0, 0x48, 0x63, 0x44,
+	0xd5, 0x94, 0x93, 0x6b, 0x8b, 0xce, 0xcd, 0x87, 0xed, 0x48, 0xfe, 0x4e, 0x55, 0x5a, 0xa5, 0x6c,
+	0xf2, 0x15, 0xc8, 0x7b, 0x24, 0xa5, 0xbe, 0x3f, 0x6d, 0xd7, 0x72, 0x54, 0xc4, 0xfa, 0x43, 0xc4,
+	0x26, 0xa3, 0x02, 0x53, 0x21, 0x07, 0x69, 0x96, 0x06, 0xf9, 0x8d, 0x7e, 0x0b, 0x34, 0xdf, 0x01,
+	0xb8, 0xb4, 0x89, 0x68, 0x80, 0xe3, 0x7f, 0x3c, 0xa3, 0xf7, 0xf4, 0xef, 0x64, 0xb6, 0x4a, 0x32,
+	0xff, 0x28, 0xc4, 0x02, 0xcd, 0x5d, 0xf8, 0xff, 0x13, 0x16, 0xf6, 0x62, 0xbc, 0x8b, 0x33, 0x4e,
+	0xd8, 0xcd, 0x97, 0xd0, 0x82, 0xff, 0xf5, 0xf5, 0xb2, 0x52, 0x65, 0xf8, 0x45, 0xea, 0xcd, 0x4b,
+	0x45, 0x17, 0xa7, 0xed, 0xd2, 0x11, 0x37, 0x56, 0x9c, 0xfb, 0xeb, 0xcd, 0x0f, 0x00, 0x2e, 0xec,
+	0xa2, 0x98, 0x84, 0x48, 0xb0, 0x2c, 0xa7, 0xf5, 0x31, 0x0a, 0x09, 0xc5, 0x9c, 0x9b, 0x5b, 0x70,
+	0xba, 0x5f, 0x2c, 0x76, 0x51, 0x18, 0x66, 0x98, 0x73, 0xcd, 0xd8, 0xb9, 0x7b, 0x71, 0xda, 0x5e,
+	0xca, 0xe7, 0x1b, 0x6e, 0xb0, 0xa1, 0x5b, 0x76, 0x44, 0x46, 0x68, 0xe4, 0xdf, 0xea, 0x5f, 0xab,
+	0x9b, 0x77, 0x60, 0x55, 0x9e, 0x63, 0x57, 0x29, 0xd7, 0xc6, 0x4d, 0xc8, 0xc2, 0x96, 0x54, 0x5f,
+	0x83, 0x13, 0xc1, 0x01, 0x0e, 0x0e, 0x79, 0x2f, 0x51, 0xb7, 0xa3, 0xea, 0x0f, 0xf3, 0xd2, 0xeb,
+	0x30, 0xca, 0xaf, 0xc3, 0x9b, 0x91, 0x33, 0xbd, 0x2a, 0x7e, 0x20, 0x8d, 0x15, 0x67, 0xcd, 0x59,
+	0xe9, 0x78, 0x67, 0xdf, 0xec, 0xca, 0xd9, 0xa5, 0x0d, 0xce, 0x2f, 0x6d, 0xf0, 0xf5, 0xd2, 0x06,
+	0xc7, 0x03, 0xbb, 0x72, 0x3e, 0xb0, 0x2b, 0x1f, 0x07, 0x76, 0xe5, 0xc5, 0xa2, 0x16, 0xcd, 0xc3,
+	0x43, 0x87, 0x30, 0x77, 0x88, 0x75, 0xc5, 0x51, 0x8a, 0xf9, 0xde, 0xb8, 0x7a, 0x33, 0xeb, 0x3f,
+	0x07, 0x00, 0xea, 0xc8, 0x71, 0xa8, 0xab, 0x04, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -573,6 +625,55 @@ func (m *ModuleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorUpgradeReadiness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorUpgradeReadiness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorUpgradeReadiness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PlanName) > 0 {
+		i -= len(m.PlanName)
+		copy(dAtA[i:], m.PlanName)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.PlanName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
 	offset -= sovUpgrade(v)
 	base := offset
@@ -662,6 +763,30 @@ func (m *ModuleVersion) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorUpgradeReadiness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.PlanName)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovUpgrade(uint64(m.Height))
+	}
+	return n
+}
+
 func sovUpgrade(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1232,6 +1357,171 @@ func (m *ModuleVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorUpgradeReadiness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorUpgradeReadiness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorUpgradeReadiness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipUpgrade(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0